@@ -13,6 +13,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/config"
 	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/domain"
+	auditv1 "github.com/chaitin/MonkeyCode/backend/internal/audit/handler/http/v1"
 	billingv1 "github.com/chaitin/MonkeyCode/backend/internal/billing/handler/http/v1"
 	codesnippetv1 "github.com/chaitin/MonkeyCode/backend/internal/codesnippet/handler/http/v1"
 	dashv1 "github.com/chaitin/MonkeyCode/backend/internal/dashboard/handler/v1"
@@ -42,6 +43,7 @@ type Server struct {
 	euse          domain.ExtensionUsecase
 	securityV1    *securityv1.SecurityHandler
 	codeSnippetV1 *codesnippetv1.CodeSnippetHandler
+	auditV1       *auditv1.AuditHandler
 }
 
 func newServer() (*Server, error) {
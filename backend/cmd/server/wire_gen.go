@@ -11,24 +11,27 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/config"
 	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/domain"
+	v1_8 "github.com/chaitin/MonkeyCode/backend/internal/audit/handler/http/v1"
+	repo12 "github.com/chaitin/MonkeyCode/backend/internal/audit/repo"
+	usecase11 "github.com/chaitin/MonkeyCode/backend/internal/audit/usecase"
 	v1_5 "github.com/chaitin/MonkeyCode/backend/internal/billing/handler/http/v1"
-	repo8 "github.com/chaitin/MonkeyCode/backend/internal/billing/repo"
+	repo4 "github.com/chaitin/MonkeyCode/backend/internal/billing/repo"
 	usecase7 "github.com/chaitin/MonkeyCode/backend/internal/billing/usecase"
 	v1_7 "github.com/chaitin/MonkeyCode/backend/internal/codesnippet/handler/http/v1"
 	repo10 "github.com/chaitin/MonkeyCode/backend/internal/codesnippet/repo"
 	"github.com/chaitin/MonkeyCode/backend/internal/codesnippet/service"
 	usecase9 "github.com/chaitin/MonkeyCode/backend/internal/codesnippet/usecase"
 	v1_4 "github.com/chaitin/MonkeyCode/backend/internal/dashboard/handler/v1"
-	repo7 "github.com/chaitin/MonkeyCode/backend/internal/dashboard/repo"
+	repo8 "github.com/chaitin/MonkeyCode/backend/internal/dashboard/repo"
 	usecase6 "github.com/chaitin/MonkeyCode/backend/internal/dashboard/usecase"
-	repo5 "github.com/chaitin/MonkeyCode/backend/internal/extension/repo"
+	repo6 "github.com/chaitin/MonkeyCode/backend/internal/extension/repo"
 	usecase2 "github.com/chaitin/MonkeyCode/backend/internal/extension/usecase"
 	"github.com/chaitin/MonkeyCode/backend/internal/middleware"
 	v1_2 "github.com/chaitin/MonkeyCode/backend/internal/model/handler/http/v1"
 	repo2 "github.com/chaitin/MonkeyCode/backend/internal/model/repo"
 	usecase4 "github.com/chaitin/MonkeyCode/backend/internal/model/usecase"
 	"github.com/chaitin/MonkeyCode/backend/internal/openai/handler/v1"
-	repo4 "github.com/chaitin/MonkeyCode/backend/internal/openai/repo"
+	repo5 "github.com/chaitin/MonkeyCode/backend/internal/openai/repo"
 	"github.com/chaitin/MonkeyCode/backend/internal/openai/usecase"
 	"github.com/chaitin/MonkeyCode/backend/internal/proxy"
 	"github.com/chaitin/MonkeyCode/backend/internal/proxy/repo"
@@ -40,7 +43,7 @@ import (
 	usecase5 "github.com/chaitin/MonkeyCode/backend/internal/security/usecase"
 	"github.com/chaitin/MonkeyCode/backend/internal/socket/handler"
 	v1_3 "github.com/chaitin/MonkeyCode/backend/internal/user/handler/v1"
-	repo6 "github.com/chaitin/MonkeyCode/backend/internal/user/repo"
+	repo7 "github.com/chaitin/MonkeyCode/backend/internal/user/repo"
 	usecase3 "github.com/chaitin/MonkeyCode/backend/internal/user/usecase"
 	repo9 "github.com/chaitin/MonkeyCode/backend/internal/workspace/repo"
 	usecase8 "github.com/chaitin/MonkeyCode/backend/internal/workspace/usecase"
@@ -72,39 +75,40 @@ func newServer() (*Server, error) {
 	proxyRepo := repo.NewProxyRepo(client, redisClient)
 	modelRepo := repo2.NewModelRepo(client)
 	securityScanningRepo := repo3.NewSecurityScanningRepo(client)
-	proxyUsecase := usecase.NewProxyUsecase(proxyRepo, modelRepo, securityScanningRepo, slogLogger, configConfig, redisClient)
+	billingRepo := repo4.NewBillingRepo(client)
+	auditRepo := repo12.NewAuditRepo(client)
+	embeddingService := service.NewOpenAIEmbeddingService(configConfig)
+	proxyUsecase := usecase.NewProxyUsecase(proxyRepo, modelRepo, securityScanningRepo, billingRepo, auditRepo, embeddingService, slogLogger, configConfig, redisClient)
 	llmProxy := proxy.NewLLMProxy(slogLogger, configConfig, proxyUsecase)
-	openAIRepo := repo4.NewOpenAIRepo(client)
+	openAIRepo := repo5.NewOpenAIRepo(client)
 	openAIUsecase := openai.NewOpenAIUsecase(configConfig, openAIRepo, modelRepo, slogLogger)
-	extensionRepo := repo5.NewExtensionRepo(client)
+	extensionRepo := repo6.NewExtensionRepo(client)
 	extensionUsecase := usecase2.NewExtensionUsecase(extensionRepo, configConfig, slogLogger)
 	ipdbIPDB, err := ipdb.NewIPDB(slogLogger)
 	if err != nil {
 		return nil, err
 	}
-	userRepo := repo6.NewUserRepo(client, ipdbIPDB, redisClient, configConfig)
+	userRepo := repo7.NewUserRepo(client, ipdbIPDB, redisClient, configConfig)
 	sessionSession := session.NewSession(configConfig)
 	userUsecase := usecase3.NewUserUsecase(configConfig, redisClient, userRepo, slogLogger, sessionSession)
-	proxyMiddleware := middleware.NewProxyMiddleware(proxyUsecase)
+	proxyMiddleware := middleware.NewProxyMiddleware(proxyUsecase, slogLogger)
 	activeMiddleware := middleware.NewActiveMiddleware(redisClient, slogLogger)
 	v1Handler := v1.NewV1Handler(slogLogger, web, llmProxy, proxyUsecase, openAIUsecase, extensionUsecase, userUsecase, proxyMiddleware, activeMiddleware, configConfig)
-	modelUsecase := usecase4.NewModelUsecase(slogLogger, modelRepo, configConfig)
+	modelUsecase := usecase4.NewModelUsecase(slogLogger, modelRepo, configConfig, redisClient)
 	authMiddleware := middleware.NewAuthMiddleware(userUsecase, sessionSession, slogLogger)
 	readOnlyMiddleware := middleware.NewReadOnlyMiddleware(configConfig)
 	modelHandler := v1_2.NewModelHandler(web, modelUsecase, authMiddleware, activeMiddleware, readOnlyMiddleware, slogLogger)
 	securityScanningUsecase := usecase5.NewSecurityScanningUsecase(securityScanningRepo)
-	dashboardRepo := repo7.NewDashboardRepo(client)
+	dashboardRepo := repo8.NewDashboardRepo(client)
 	dashboardUsecase := usecase6.NewDashboardUsecase(dashboardRepo)
-	billingRepo := repo8.NewBillingRepo(client)
-	billingUsecase := usecase7.NewBillingUsecase(billingRepo)
+	billingUsecase := usecase7.NewBillingUsecase(billingRepo, configConfig, redisClient, slogLogger)
 	userHandler := v1_3.NewUserHandler(web, userUsecase, extensionUsecase, securityScanningUsecase, dashboardUsecase, billingUsecase, authMiddleware, activeMiddleware, readOnlyMiddleware, sessionSession, slogLogger, configConfig)
 	dashboardHandler := v1_4.NewDashboardHandler(web, dashboardUsecase, authMiddleware, activeMiddleware)
-	billingHandler := v1_5.NewBillingHandler(web, billingUsecase, authMiddleware, activeMiddleware)
+	billingHandler := v1_5.NewBillingHandler(web, billingUsecase, proxyUsecase, authMiddleware, activeMiddleware, readOnlyMiddleware)
 	workspaceFileRepo := repo9.NewWorkspaceFileRepo(client)
 	workspaceRepo := repo9.NewWorkspaceRepo(client)
 	workspaceUsecase := usecase8.NewWorkspaceUsecase(workspaceRepo, configConfig, slogLogger)
 	codeSnippetRepo := repo10.NewCodeSnippetRepo(client, slogLogger)
-	embeddingService := service.NewOpenAIEmbeddingService(configConfig)
 	codeSnippetUsecase := usecase9.NewCodeSnippetUsecase(codeSnippetRepo, embeddingService, slogLogger)
 	workspaceFileUsecase := usecase8.NewWorkspaceFileUsecase(workspaceFileRepo, workspaceUsecase, codeSnippetUsecase, configConfig, slogLogger)
 	socketHandler, err := handler.NewSocketHandler(configConfig, slogLogger, workspaceFileUsecase, workspaceUsecase, userUsecase)
@@ -117,6 +121,8 @@ func newServer() (*Server, error) {
 	reportUsecase := usecase10.NewReportUsecase(reportRepo, slogLogger, reporter, redisClient)
 	securityHandler := v1_6.NewSecurityHandler(web, securityScanningUsecase, authMiddleware, activeMiddleware)
 	codeSnippetHandler := v1_7.NewCodeSnippetHandler(web, codeSnippetUsecase, embeddingService, authMiddleware, activeMiddleware, readOnlyMiddleware, proxyMiddleware, slogLogger)
+	auditUsecase := usecase11.NewAuditUsecase(auditRepo, configConfig, redisClient, slogLogger)
+	auditHandler := v1_8.NewAuditHandler(web, auditUsecase, authMiddleware, activeMiddleware, readOnlyMiddleware)
 	server := &Server{
 		config:        configConfig,
 		web:           web,
@@ -134,6 +140,7 @@ func newServer() (*Server, error) {
 		euse:          extensionUsecase,
 		securityV1:    securityHandler,
 		codeSnippetV1: codeSnippetHandler,
+		auditV1:       auditHandler,
 	}
 	return server, nil
 }
@@ -157,4 +164,5 @@ type Server struct {
 	euse          domain.ExtensionUsecase
 	securityV1    *v1_6.SecurityHandler
 	codeSnippetV1 *v1_7.CodeSnippetHandler
+	auditV1       *v1_8.AuditHandler
 }
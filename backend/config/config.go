@@ -54,11 +54,58 @@ type Config struct {
 	} `mapstructure:"redis"`
 
 	LLMProxy struct {
-		Timeout              string `mapstructure:"timeout"`
-		KeepAlive            string `mapstructure:"keep_alive"`
-		ClientPoolSize       int    `mapstructure:"client_pool_size"`
-		StreamClientPoolSize int    `mapstructure:"stream_client_pool_size"`
-		RequestLogPath       string `mapstructure:"request_log_path"`
+		Timeout              string              `mapstructure:"timeout"`
+		KeepAlive            string              `mapstructure:"keep_alive"`
+		ClientPoolSize       int                 `mapstructure:"client_pool_size"`
+		StreamClientPoolSize int                 `mapstructure:"stream_client_pool_size"`
+		RequestLogPath       string              `mapstructure:"request_log_path"`
+		LoadBalance          map[string]string   `mapstructure:"load_balance"`        // 按模型类型配置负载均衡策略
+		Fallback             map[string][]string `mapstructure:"fallback"`            // 按模型类型配置回退模型，请求的模型都不可用时按顺序尝试
+		MaxRetries           int                 `mapstructure:"max_retries"`         // 上游失败时切换候选模型重试的最大次数
+		StreamIdleTimeout    int                 `mapstructure:"stream_idle_timeout"` // 流式响应两次收到数据的最长间隔秒数，超时后断开上游，0 表示不限制
+		Breaker              struct {
+			FailureThreshold int `mapstructure:"failure_threshold"` // 连续失败多少次后熔断
+			OpenSecond       int `mapstructure:"open_second"`       // 熔断持续秒数
+		} `mapstructure:"breaker"`
+		Cache struct {
+			TTL        int     `mapstructure:"ttl"`        // 缓存默认有效秒数，模型可单独配置
+			Semantic   bool    `mapstructure:"semantic"`   // 精确匹配未命中时，是否按向量相似度查找
+			Similarity float64 `mapstructure:"similarity"` // 相似度查找的最低余弦相似度
+		} `mapstructure:"cache"`
+		HealthCheck struct {
+			Interval           int `mapstructure:"interval"`            // 探测间隔秒数，0 表示关闭，探测会产生上游调用费用
+			Timeout            int `mapstructure:"timeout"`             // 单次探测超时秒数
+			UnhealthyThreshold int `mapstructure:"unhealthy_threshold"` // 连续失败多少次后标记为不健康
+			RetentionDay       int `mapstructure:"retention_day"`       // 探测记录保留天数
+		} `mapstructure:"health_check"`
+		DLP struct {
+			Enabled bool              `mapstructure:"enabled"` // 是否检测外发请求中的密钥和个人信息
+			Action  string            `mapstructure:"action"`  // 默认处理方式 alert:只记录 redact:脱敏 block:拒绝
+			Actions map[string]string `mapstructure:"actions"` // 按规则ID或类别(Secrets、PII)覆盖处理方式，off 表示停用
+			Entropy struct {
+				Enabled   bool    `mapstructure:"enabled"`    // 是否检测高熵字符串
+				Threshold float64 `mapstructure:"threshold"`  // 每个字符的最低香农熵
+				MinLength int     `mapstructure:"min_length"` // 最短长度
+			} `mapstructure:"entropy"`
+			Rules []DLPRule `mapstructure:"rules"` // 自定义正则规则
+		} `mapstructure:"dlp"`
+		CodeGuard struct {
+			Enabled  bool     `mapstructure:"enabled"`  // 是否检测模型生成代码中的危险写法
+			Warn     bool     `mapstructure:"warn"`     // 是否在对话的流式响应末尾追加风险提示
+			Disabled []string `mapstructure:"disabled"` // 停用的规则ID
+		} `mapstructure:"code_guard"`
+		RecordQueue struct {
+			Workers     int `mapstructure:"workers"`      // 写入数据库的并发协程数
+			MaxAttempts int `mapstructure:"max_attempts"` // 最多写入次数，超过后转入死信
+			Backoff     int `mapstructure:"backoff"`      // 首次重试的等待秒数，之后每次翻倍
+			MaxBackoff  int `mapstructure:"max_backoff"`  // 重试等待秒数上限
+			DeadMaxLen  int `mapstructure:"dead_max_len"` // 死信最多保留条数，死信包含完整的提示词和代码
+		} `mapstructure:"record_queue"`
+		Usage struct {
+			IncludeUsage  bool     `mapstructure:"include_usage"`  // 流式请求是否要求上游在最后返回 usage
+			SkipProviders []string `mapstructure:"skip_providers"` // 不支持 stream_options 的供应商
+			Estimate      bool     `mapstructure:"estimate"`       // 上游未返回 usage 时是否计算 token 数
+		} `mapstructure:"usage"`
 	} `mapstructure:"llm_proxy"`
 
 	InitModel struct {
@@ -86,6 +133,30 @@ type Config struct {
 	Security struct {
 		QueueLimit int `mapstructure:"queue_limit"`
 	} `mapstructure:"security"`
+
+	Billing struct {
+		Currency            string `mapstructure:"currency"`              // 模型价格和用户组预算未设置货币时使用的默认货币
+		BudgetCheckInterval int    `mapstructure:"budget_check_interval"` // 用户组预算检查间隔秒数，0 表示不检查
+		BudgetThresholds    []int  `mapstructure:"budget_thresholds"`     // 默认告警阈值，为预算的百分比
+	} `mapstructure:"billing"`
+
+	Audit struct {
+		Enabled         bool `mapstructure:"enabled"`          // 是否归档请求和响应原文
+		RetentionDay    int  `mapstructure:"retention_day"`    // 默认保留天数，0 表示不按时间清理
+		MaxSizeMB       int  `mapstructure:"max_size_mb"`      // 归档总大小上限，超过后删除最早的归档，0 表示不限制
+		MaxBodyKB       int  `mapstructure:"max_body_kb"`      // 单个请求或响应归档的最大长度，超出部分截断
+		CleanupInterval int  `mapstructure:"cleanup_interval"` // 清理间隔秒数
+	} `mapstructure:"audit"`
+}
+
+// DLPRule 外发数据防泄漏的自定义正则规则
+type DLPRule struct {
+	ID       string `mapstructure:"id"`
+	Name     string `mapstructure:"name"`
+	Category string `mapstructure:"category"` // 规则类别，默认为 Secrets
+	Pattern  string `mapstructure:"pattern"`  // 正则表达式
+	Group    int    `mapstructure:"group"`    // 需要处理的捕获组，0 表示整个匹配
+	Action   string `mapstructure:"action"`   // 处理方式，为空时使用默认处理方式
 }
 
 func (c *Config) GetBaseURL(req *http.Request, settings *domain.Setting) string {
@@ -145,6 +216,37 @@ func Init() (*Config, error) {
 	v.SetDefault("llm_proxy.client_pool_size", 100)
 	v.SetDefault("llm_proxy.stream_client_pool_size", 5000)
 	v.SetDefault("llm_proxy.request_log_path", "/app/request/logs")
+	v.SetDefault("llm_proxy.load_balance.llm", "weighted_round_robin")
+	v.SetDefault("llm_proxy.load_balance.coder", "weighted_round_robin")
+	v.SetDefault("llm_proxy.load_balance.embedding", "weighted_round_robin")
+	v.SetDefault("llm_proxy.max_retries", 2)
+	v.SetDefault("llm_proxy.stream_idle_timeout", 120)
+	v.SetDefault("llm_proxy.breaker.failure_threshold", 5)
+	v.SetDefault("llm_proxy.breaker.open_second", 30)
+	v.SetDefault("llm_proxy.cache.ttl", 86400)
+	v.SetDefault("llm_proxy.cache.semantic", false)
+	v.SetDefault("llm_proxy.cache.similarity", 0.97)
+	v.SetDefault("llm_proxy.health_check.interval", 0)
+	v.SetDefault("llm_proxy.health_check.timeout", 30)
+	v.SetDefault("llm_proxy.health_check.unhealthy_threshold", 3)
+	v.SetDefault("llm_proxy.health_check.retention_day", 30)
+	v.SetDefault("llm_proxy.dlp.enabled", true)
+	v.SetDefault("llm_proxy.dlp.action", "alert")
+	v.SetDefault("llm_proxy.dlp.actions", map[string]string{"PII": "alert"})
+	v.SetDefault("llm_proxy.dlp.entropy.enabled", true)
+	v.SetDefault("llm_proxy.dlp.entropy.threshold", 4.5)
+	v.SetDefault("llm_proxy.dlp.entropy.min_length", 32)
+	v.SetDefault("llm_proxy.code_guard.enabled", false)
+	v.SetDefault("llm_proxy.code_guard.warn", false)
+	v.SetDefault("llm_proxy.code_guard.disabled", []string{})
+	v.SetDefault("llm_proxy.record_queue.workers", 4)
+	v.SetDefault("llm_proxy.record_queue.max_attempts", 8)
+	v.SetDefault("llm_proxy.record_queue.backoff", 30)
+	v.SetDefault("llm_proxy.record_queue.max_backoff", 600)
+	v.SetDefault("llm_proxy.record_queue.dead_max_len", 10000)
+	v.SetDefault("llm_proxy.usage.include_usage", true)
+	v.SetDefault("llm_proxy.usage.skip_providers", []string{})
+	v.SetDefault("llm_proxy.usage.estimate", true)
 	v.SetDefault("init_model.name", "")
 	v.SetDefault("init_model.key", "")
 	v.SetDefault("init_model.url", "")
@@ -153,6 +255,14 @@ func Init() (*Config, error) {
 	v.SetDefault("extension.limit_second", 10)
 	v.SetDefault("data_report.key", "")
 	v.SetDefault("security.queue_limit", 5)
+	v.SetDefault("billing.currency", "CNY")
+	v.SetDefault("billing.budget_check_interval", 300)
+	v.SetDefault("billing.budget_thresholds", []int{80, 100})
+	v.SetDefault("audit.enabled", true)
+	v.SetDefault("audit.retention_day", 180)
+	v.SetDefault("audit.max_size_mb", 10240)
+	v.SetDefault("audit.max_body_kb", 1024)
+	v.SetDefault("audit.cleanup_interval", 3600)
 	v.SetDefault("embedding.model_name", "qwen3-embedding-0.6b")
 	v.SetDefault("embedding.api_endpoint", "https://aiapi.chaitin.net/v1/embeddings")
 	v.SetDefault("embedding.api_key", "")
@@ -0,0 +1,5 @@
+package consts
+
+const (
+	AuditCleanupLock = "monkeycode:audit:cleanup:lock"
+)
@@ -0,0 +1,5 @@
+package consts
+
+const (
+	BudgetCheckLock = "monkeycode:billing:budget:lock"
+)
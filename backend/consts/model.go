@@ -32,5 +32,23 @@ const (
 	ModelProviderVolcengine  ModelProvider = "Volcengine"
 	ModelProviderZhiPu       ModelProvider = "ZhiPu"
 	ModelProviderGemini      ModelProvider = "Gemini"
+	ModelProviderAnthropic   ModelProvider = "Anthropic"
 	ModelProviderOther       ModelProvider = "Other"
 )
+
+type ModelHealth string
+
+const (
+	ModelHealthUnknown   ModelHealth = "unknown"
+	ModelHealthHealthy   ModelHealth = "healthy"
+	ModelHealthUnhealthy ModelHealth = "unhealthy"
+)
+
+const (
+	ModelChangedChannel  = "monkeycode:model:changed"
+	ModelBreakerKey      = "monkeycode:model:breaker"
+	ModelHealthKey       = "monkeycode:model:health"
+	ModelHealthCheckLock = "monkeycode:model:health:lock"
+
+	TransformChangedChannel = "monkeycode:transform:changed"
+)
@@ -11,3 +11,36 @@ const (
 	ReportActionFeedbackTask ReportAction = "feedback_task"
 	ReportActionAbortTask    ReportAction = "abort_task"
 )
+
+const (
+	RateLimitKeyFmt       = "ratelimit:%s:%s:%s" // scope:id:kind
+	RateLimitPolicyKeyFmt = "ratelimit:policy:%s"
+	UserGroupsKeyFmt      = "proxy:user:groups:%s"
+	RecordStream          = "proxy:records"
+	RecordGroup           = "recorder"
+)
+
+type RateLimitScope string
+
+const (
+	RateLimitScopeAPIKey RateLimitScope = "api_key"
+	RateLimitScopeUser   RateLimitScope = "user"
+	RateLimitScopeGroup  RateLimitScope = "group"
+)
+
+// TaskStatus 任务最近一次请求的结束状态
+type TaskStatus string
+
+const (
+	TaskStatusCompleted     TaskStatus = "completed"      // 正常结束
+	TaskStatusAborted       TaskStatus = "aborted"        // 客户端中断
+	TaskStatusUpstreamError TaskStatus = "upstream_error" // 上游中途出错或长时间没有返回数据
+)
+
+// UsageSource token 数的来源
+type UsageSource string
+
+const (
+	UsageSourceReported  UsageSource = "reported"  // 上游返回的 usage
+	UsageSourceEstimated UsageSource = "estimated" // 上游未返回，由分词器计算
+)
@@ -2,6 +2,7 @@ package consts
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 )
 
@@ -12,8 +13,70 @@ const (
 	SecurityScanningStatusRunning SecurityScanningStatus = "running"
 	SecurityScanningStatusSuccess SecurityScanningStatus = "success"
 	SecurityScanningStatusFailed  SecurityScanningStatus = "failed"
+	SecurityScanningStatusSkipped SecurityScanningStatus = "skipped" // 增量扫描中没有变化文件的子扫描
 )
 
+// SecurityScanningFindingState 发现相对上一次扫描的状态
+type SecurityScanningFindingState string
+
+const (
+	SecurityScanningFindingNew        SecurityScanningFindingState = "new"        // 本次新增
+	SecurityScanningFindingPersisting SecurityScanningFindingState = "persisting" // 上一次扫描已存在
+	SecurityScanningFindingFixed      SecurityScanningFindingState = "fixed"      // 上一次扫描存在，本次已消失
+)
+
+// SecurityScanningTriageStatus 发现的处置状态
+type SecurityScanningTriageStatus string
+
+const (
+	SecurityScanningTriageOpen          SecurityScanningTriageStatus = "open"           // 待处理
+	SecurityScanningTriageConfirmed     SecurityScanningTriageStatus = "confirmed"      // 已确认
+	SecurityScanningTriageFalsePositive SecurityScanningTriageStatus = "false_positive" // 误报
+	SecurityScanningTriageAcceptedRisk  SecurityScanningTriageStatus = "accepted_risk"  // 接受风险
+	SecurityScanningTriageFixed         SecurityScanningTriageStatus = "fixed"          // 已修复
+)
+
+func (s SecurityScanningTriageStatus) Valid() bool {
+	switch s {
+	case SecurityScanningTriageOpen, SecurityScanningTriageConfirmed, SecurityScanningTriageFalsePositive,
+		SecurityScanningTriageAcceptedRisk, SecurityScanningTriageFixed:
+		return true
+	}
+	return false
+}
+
+// Suppressed 误报和接受风险的发现会被屏蔽，不计入风险统计
+func (s SecurityScanningTriageStatus) Suppressed() bool {
+	return s == SecurityScanningTriageFalsePositive || s == SecurityScanningTriageAcceptedRisk
+}
+
+// SecurityRuleLevel 扫描规则的置信度和影响等级
+type SecurityRuleLevel string
+
+const (
+	SecurityRuleLevelHigh   SecurityRuleLevel = "HIGH"
+	SecurityRuleLevelMedium SecurityRuleLevel = "MEDIUM"
+	SecurityRuleLevelLow    SecurityRuleLevel = "LOW"
+)
+
+var securityRuleLevels = []SecurityRuleLevel{SecurityRuleLevelHigh, SecurityRuleLevelMedium, SecurityRuleLevelLow}
+
+func (l SecurityRuleLevel) Valid() bool {
+	return slices.Contains(securityRuleLevels, l)
+}
+
+// AtLeast 不低于该等级的所有等级，作为扫描的过滤条件
+func (l SecurityRuleLevel) AtLeast() []string {
+	var ls []string
+	for _, v := range securityRuleLevels {
+		ls = append(ls, string(v))
+		if v == l {
+			break
+		}
+	}
+	return ls
+}
+
 // 风险等级
 type SecurityScanningRiskLevel string
 
@@ -51,8 +114,42 @@ const (
 	SecurityScanningLanguageLua        SecurityScanningLanguage = "Lua"
 	SecurityScanningLanguageSecrets    SecurityScanningLanguage = "Secrets"
 	SecurityScanningLanguageIaC        SecurityScanningLanguage = "IaC"
+	SecurityScanningLanguageAuto       SecurityScanningLanguage = "auto" // 按工作区文件识别语言，每种语言一个子扫描
 )
 
+var securityScanningLanguages = []SecurityScanningLanguage{
+	SecurityScanningLanguageCpp,
+	SecurityScanningLanguageJava,
+	SecurityScanningLanguagePython,
+	SecurityScanningLanguageJavaScript,
+	SecurityScanningLanguageGo,
+	SecurityScanningLanguagePHP,
+	SecurityScanningLanguageCS,
+	SecurityScanningLanguageSwift,
+	SecurityScanningLanguageRuby,
+	SecurityScanningLanguageRust,
+	SecurityScanningLanguageHTML,
+	SecurityScanningLanguageObjectiveC,
+	SecurityScanningLanguageOCaml,
+	SecurityScanningLanguageKotlin,
+	SecurityScanningLanguageScala,
+	SecurityScanningLanguageSolidity,
+	SecurityScanningLanguageCOBOL,
+	SecurityScanningLanguageShell,
+	SecurityScanningLanguageSQL,
+	SecurityScanningLanguageFortran,
+	SecurityScanningLanguageDart,
+	SecurityScanningLanguageGroovy,
+	SecurityScanningLanguageLua,
+	SecurityScanningLanguageSecrets,
+	SecurityScanningLanguageIaC,
+	SecurityScanningLanguageAuto,
+}
+
+func (s SecurityScanningLanguage) Valid() bool {
+	return slices.Contains(securityScanningLanguages, s)
+}
+
 func (s SecurityScanningLanguage) Rule() string {
 	if s == SecurityScanningLanguageCpp {
 		return "c"
@@ -67,5 +164,38 @@ func (s SecurityScanningLanguage) RuleName() string {
 	if s == SecurityScanningLanguageSecrets {
 		return "硬编码敏感信息检测"
 	}
+	if s == SecurityScanningLanguageAuto {
+		return "多语言安全扫描"
+	}
 	return fmt.Sprintf("%s 安全扫描", s)
 }
+
+// DLPAction 外发数据防泄漏规则命中后的处理方式
+type DLPAction string
+
+const (
+	DLPActionAlert  DLPAction = "alert"  // 只记录，不改写请求
+	DLPActionRedact DLPAction = "redact" // 将命中内容替换为占位符
+	DLPActionBlock  DLPAction = "block"  // 拒绝请求
+)
+
+// Level 处理方式的严格程度，多条规则命中时取最严格的处理方式
+func (a DLPAction) Level() int {
+	switch a {
+	case DLPActionBlock:
+		return 3
+	case DLPActionRedact:
+		return 2
+	case DLPActionAlert:
+		return 1
+	}
+	return 0
+}
+
+// DLPCategory 外发数据防泄漏规则的类别，密钥类规则与安全扫描的 Secrets 规则集对应
+type DLPCategory string
+
+const (
+	DLPCategorySecrets DLPCategory = DLPCategory(SecurityScanningLanguageSecrets)
+	DLPCategoryPII     DLPCategory = "PII"
+)
@@ -0,0 +1,1434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
+	"github.com/google/uuid"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRequestID sets the "request_id" field.
+func (alc *AuditLogCreate) SetRequestID(s string) *AuditLogCreate {
+	alc.mutation.SetRequestID(s)
+	return alc
+}
+
+// SetTaskID sets the "task_id" field.
+func (alc *AuditLogCreate) SetTaskID(s string) *AuditLogCreate {
+	alc.mutation.SetTaskID(s)
+	return alc
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTaskID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTaskID(*s)
+	}
+	return alc
+}
+
+// SetUserID sets the "user_id" field.
+func (alc *AuditLogCreate) SetUserID(u uuid.UUID) *AuditLogCreate {
+	alc.mutation.SetUserID(u)
+	return alc
+}
+
+// SetModelID sets the "model_id" field.
+func (alc *AuditLogCreate) SetModelID(u uuid.UUID) *AuditLogCreate {
+	alc.mutation.SetModelID(u)
+	return alc
+}
+
+// SetModelName sets the "model_name" field.
+func (alc *AuditLogCreate) SetModelName(s string) *AuditLogCreate {
+	alc.mutation.SetModelName(s)
+	return alc
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableModelName(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetModelName(*s)
+	}
+	return alc
+}
+
+// SetModelType sets the "model_type" field.
+func (alc *AuditLogCreate) SetModelType(ct consts.ModelType) *AuditLogCreate {
+	alc.mutation.SetModelType(ct)
+	return alc
+}
+
+// SetPath sets the "path" field.
+func (alc *AuditLogCreate) SetPath(s string) *AuditLogCreate {
+	alc.mutation.SetPath(s)
+	return alc
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillablePath(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetPath(*s)
+	}
+	return alc
+}
+
+// SetPrompt sets the "prompt" field.
+func (alc *AuditLogCreate) SetPrompt(s string) *AuditLogCreate {
+	alc.mutation.SetPrompt(s)
+	return alc
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillablePrompt(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetPrompt(*s)
+	}
+	return alc
+}
+
+// SetCompletion sets the "completion" field.
+func (alc *AuditLogCreate) SetCompletion(s string) *AuditLogCreate {
+	alc.mutation.SetCompletion(s)
+	return alc
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCompletion(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetCompletion(*s)
+	}
+	return alc
+}
+
+// SetRequest sets the "request" field.
+func (alc *AuditLogCreate) SetRequest(b []byte) *AuditLogCreate {
+	alc.mutation.SetRequest(b)
+	return alc
+}
+
+// SetResponse sets the "response" field.
+func (alc *AuditLogCreate) SetResponse(b []byte) *AuditLogCreate {
+	alc.mutation.SetResponse(b)
+	return alc
+}
+
+// SetRawSize sets the "raw_size" field.
+func (alc *AuditLogCreate) SetRawSize(i int64) *AuditLogCreate {
+	alc.mutation.SetRawSize(i)
+	return alc
+}
+
+// SetNillableRawSize sets the "raw_size" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableRawSize(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetRawSize(*i)
+	}
+	return alc
+}
+
+// SetSize sets the "size" field.
+func (alc *AuditLogCreate) SetSize(i int64) *AuditLogCreate {
+	alc.mutation.SetSize(i)
+	return alc
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableSize(i *int64) *AuditLogCreate {
+	if i != nil {
+		alc.SetSize(*i)
+	}
+	return alc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(u uuid.UUID) *AuditLogCreate {
+	alc.mutation.SetID(u)
+	return alc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableID(u *uuid.UUID) *AuditLogCreate {
+	if u != nil {
+		alc.SetID(*u)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.RawSize(); !ok {
+		v := auditlog.DefaultRawSize
+		alc.mutation.SetRawSize(v)
+	}
+	if _, ok := alc.mutation.Size(); !ok {
+		v := auditlog.DefaultSize
+		alc.mutation.SetSize(v)
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := alc.mutation.ID(); !ok {
+		v := auditlog.DefaultID()
+		alc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`db: missing required field "AuditLog.request_id"`)}
+	}
+	if _, ok := alc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`db: missing required field "AuditLog.user_id"`)}
+	}
+	if _, ok := alc.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`db: missing required field "AuditLog.model_id"`)}
+	}
+	if _, ok := alc.mutation.ModelType(); !ok {
+		return &ValidationError{Name: "model_type", err: errors.New(`db: missing required field "AuditLog.model_type"`)}
+	}
+	if _, ok := alc.mutation.RawSize(); !ok {
+		return &ValidationError{Name: "raw_size", err: errors.New(`db: missing required field "AuditLog.raw_size"`)}
+	}
+	if _, ok := alc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`db: missing required field "AuditLog.size"`)}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "AuditLog.created_at"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = alc.conflict
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := alc.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := alc.mutation.TaskID(); ok {
+		_spec.SetField(auditlog.FieldTaskID, field.TypeString, value)
+		_node.TaskID = value
+	}
+	if value, ok := alc.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := alc.mutation.ModelID(); ok {
+		_spec.SetField(auditlog.FieldModelID, field.TypeUUID, value)
+		_node.ModelID = value
+	}
+	if value, ok := alc.mutation.ModelName(); ok {
+		_spec.SetField(auditlog.FieldModelName, field.TypeString, value)
+		_node.ModelName = value
+	}
+	if value, ok := alc.mutation.ModelType(); ok {
+		_spec.SetField(auditlog.FieldModelType, field.TypeString, value)
+		_node.ModelType = value
+	}
+	if value, ok := alc.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := alc.mutation.Prompt(); ok {
+		_spec.SetField(auditlog.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := alc.mutation.Completion(); ok {
+		_spec.SetField(auditlog.FieldCompletion, field.TypeString, value)
+		_node.Completion = value
+	}
+	if value, ok := alc.mutation.Request(); ok {
+		_spec.SetField(auditlog.FieldRequest, field.TypeBytes, value)
+		_node.Request = value
+	}
+	if value, ok := alc.mutation.Response(); ok {
+		_spec.SetField(auditlog.FieldResponse, field.TypeBytes, value)
+		_node.Response = value
+	}
+	if value, ok := alc.mutation.RawSize(); ok {
+		_spec.SetField(auditlog.FieldRawSize, field.TypeInt64, value)
+		_node.RawSize = value
+	}
+	if value, ok := alc.mutation.Size(); ok {
+		_spec.SetField(auditlog.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetRequestID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetRequestID(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRequestID sets the "request_id" field.
+func (u *AuditLogUpsert) SetRequestID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldRequestID, v)
+	return u
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRequestID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRequestID)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *AuditLogUpsert) SetTaskID(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateTaskID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldTaskID)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *AuditLogUpsert) ClearTaskID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldTaskID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuditLogUpsert) SetUserID(v uuid.UUID) *AuditLogUpsert {
+	u.Set(auditlog.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateUserID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldUserID)
+	return u
+}
+
+// SetModelID sets the "model_id" field.
+func (u *AuditLogUpsert) SetModelID(v uuid.UUID) *AuditLogUpsert {
+	u.Set(auditlog.FieldModelID, v)
+	return u
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateModelID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldModelID)
+	return u
+}
+
+// SetModelName sets the "model_name" field.
+func (u *AuditLogUpsert) SetModelName(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldModelName, v)
+	return u
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateModelName() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldModelName)
+	return u
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *AuditLogUpsert) ClearModelName() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldModelName)
+	return u
+}
+
+// SetModelType sets the "model_type" field.
+func (u *AuditLogUpsert) SetModelType(v consts.ModelType) *AuditLogUpsert {
+	u.Set(auditlog.FieldModelType, v)
+	return u
+}
+
+// UpdateModelType sets the "model_type" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateModelType() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldModelType)
+	return u
+}
+
+// SetPath sets the "path" field.
+func (u *AuditLogUpsert) SetPath(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPath, v)
+	return u
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePath() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPath)
+	return u
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *AuditLogUpsert) ClearPath() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPath)
+	return u
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *AuditLogUpsert) SetPrompt(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldPrompt, v)
+	return u
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdatePrompt() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldPrompt)
+	return u
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *AuditLogUpsert) ClearPrompt() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldPrompt)
+	return u
+}
+
+// SetCompletion sets the "completion" field.
+func (u *AuditLogUpsert) SetCompletion(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldCompletion, v)
+	return u
+}
+
+// UpdateCompletion sets the "completion" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateCompletion() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldCompletion)
+	return u
+}
+
+// ClearCompletion clears the value of the "completion" field.
+func (u *AuditLogUpsert) ClearCompletion() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldCompletion)
+	return u
+}
+
+// SetRequest sets the "request" field.
+func (u *AuditLogUpsert) SetRequest(v []byte) *AuditLogUpsert {
+	u.Set(auditlog.FieldRequest, v)
+	return u
+}
+
+// UpdateRequest sets the "request" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRequest() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRequest)
+	return u
+}
+
+// ClearRequest clears the value of the "request" field.
+func (u *AuditLogUpsert) ClearRequest() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldRequest)
+	return u
+}
+
+// SetResponse sets the "response" field.
+func (u *AuditLogUpsert) SetResponse(v []byte) *AuditLogUpsert {
+	u.Set(auditlog.FieldResponse, v)
+	return u
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateResponse() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldResponse)
+	return u
+}
+
+// ClearResponse clears the value of the "response" field.
+func (u *AuditLogUpsert) ClearResponse() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldResponse)
+	return u
+}
+
+// SetRawSize sets the "raw_size" field.
+func (u *AuditLogUpsert) SetRawSize(v int64) *AuditLogUpsert {
+	u.Set(auditlog.FieldRawSize, v)
+	return u
+}
+
+// UpdateRawSize sets the "raw_size" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateRawSize() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldRawSize)
+	return u
+}
+
+// AddRawSize adds v to the "raw_size" field.
+func (u *AuditLogUpsert) AddRawSize(v int64) *AuditLogUpsert {
+	u.Add(auditlog.FieldRawSize, v)
+	return u
+}
+
+// SetSize sets the "size" field.
+func (u *AuditLogUpsert) SetSize(v int64) *AuditLogUpsert {
+	u.Set(auditlog.FieldSize, v)
+	return u
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateSize() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldSize)
+	return u
+}
+
+// AddSize adds v to the "size" field.
+func (u *AuditLogUpsert) AddSize(v int64) *AuditLogUpsert {
+	u.Add(auditlog.FieldSize, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AuditLogUpsert) SetCreatedAt(v time.Time) *AuditLogUpsert {
+	u.Set(auditlog.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateCreatedAt() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *AuditLogUpsertOne) SetRequestID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRequestID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *AuditLogUpsertOne) SetTaskID(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateTaskID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *AuditLogUpsertOne) ClearTaskID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuditLogUpsertOne) SetUserID(v uuid.UUID) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateUserID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *AuditLogUpsertOne) SetModelID(v uuid.UUID) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateModelID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *AuditLogUpsertOne) SetModelName(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateModelName() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *AuditLogUpsertOne) ClearModelName() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearModelName()
+	})
+}
+
+// SetModelType sets the "model_type" field.
+func (u *AuditLogUpsertOne) SetModelType(v consts.ModelType) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetModelType(v)
+	})
+}
+
+// UpdateModelType sets the "model_type" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateModelType() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateModelType()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *AuditLogUpsertOne) SetPath(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePath() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *AuditLogUpsertOne) ClearPath() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPath()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *AuditLogUpsertOne) SetPrompt(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdatePrompt() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *AuditLogUpsertOne) ClearPrompt() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrompt()
+	})
+}
+
+// SetCompletion sets the "completion" field.
+func (u *AuditLogUpsertOne) SetCompletion(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetCompletion(v)
+	})
+}
+
+// UpdateCompletion sets the "completion" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateCompletion() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateCompletion()
+	})
+}
+
+// ClearCompletion clears the value of the "completion" field.
+func (u *AuditLogUpsertOne) ClearCompletion() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearCompletion()
+	})
+}
+
+// SetRequest sets the "request" field.
+func (u *AuditLogUpsertOne) SetRequest(v []byte) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRequest(v)
+	})
+}
+
+// UpdateRequest sets the "request" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRequest() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRequest()
+	})
+}
+
+// ClearRequest clears the value of the "request" field.
+func (u *AuditLogUpsertOne) ClearRequest() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearRequest()
+	})
+}
+
+// SetResponse sets the "response" field.
+func (u *AuditLogUpsertOne) SetResponse(v []byte) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetResponse(v)
+	})
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateResponse() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateResponse()
+	})
+}
+
+// ClearResponse clears the value of the "response" field.
+func (u *AuditLogUpsertOne) ClearResponse() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearResponse()
+	})
+}
+
+// SetRawSize sets the "raw_size" field.
+func (u *AuditLogUpsertOne) SetRawSize(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRawSize(v)
+	})
+}
+
+// AddRawSize adds v to the "raw_size" field.
+func (u *AuditLogUpsertOne) AddRawSize(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddRawSize(v)
+	})
+}
+
+// UpdateRawSize sets the "raw_size" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateRawSize() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRawSize()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AuditLogUpsertOne) SetSize(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AuditLogUpsertOne) AddSize(v int64) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateSize() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AuditLogUpsertOne) SetCreatedAt(v time.Time) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateCreatedAt() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: AuditLogUpsertOne.ID is not supported by MySQL driver. Use AuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	err      error
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	if alcb.err != nil {
+		return nil, alcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetRequestID(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *AuditLogUpsertBulk) SetRequestID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRequestID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *AuditLogUpsertBulk) SetTaskID(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateTaskID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *AuditLogUpsertBulk) ClearTaskID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *AuditLogUpsertBulk) SetUserID(v uuid.UUID) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateUserID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *AuditLogUpsertBulk) SetModelID(v uuid.UUID) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateModelID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *AuditLogUpsertBulk) SetModelName(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateModelName() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *AuditLogUpsertBulk) ClearModelName() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearModelName()
+	})
+}
+
+// SetModelType sets the "model_type" field.
+func (u *AuditLogUpsertBulk) SetModelType(v consts.ModelType) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetModelType(v)
+	})
+}
+
+// UpdateModelType sets the "model_type" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateModelType() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateModelType()
+	})
+}
+
+// SetPath sets the "path" field.
+func (u *AuditLogUpsertBulk) SetPath(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPath(v)
+	})
+}
+
+// UpdatePath sets the "path" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePath() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePath()
+	})
+}
+
+// ClearPath clears the value of the "path" field.
+func (u *AuditLogUpsertBulk) ClearPath() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPath()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *AuditLogUpsertBulk) SetPrompt(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdatePrompt() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *AuditLogUpsertBulk) ClearPrompt() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearPrompt()
+	})
+}
+
+// SetCompletion sets the "completion" field.
+func (u *AuditLogUpsertBulk) SetCompletion(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetCompletion(v)
+	})
+}
+
+// UpdateCompletion sets the "completion" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateCompletion() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateCompletion()
+	})
+}
+
+// ClearCompletion clears the value of the "completion" field.
+func (u *AuditLogUpsertBulk) ClearCompletion() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearCompletion()
+	})
+}
+
+// SetRequest sets the "request" field.
+func (u *AuditLogUpsertBulk) SetRequest(v []byte) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRequest(v)
+	})
+}
+
+// UpdateRequest sets the "request" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRequest() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRequest()
+	})
+}
+
+// ClearRequest clears the value of the "request" field.
+func (u *AuditLogUpsertBulk) ClearRequest() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearRequest()
+	})
+}
+
+// SetResponse sets the "response" field.
+func (u *AuditLogUpsertBulk) SetResponse(v []byte) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetResponse(v)
+	})
+}
+
+// UpdateResponse sets the "response" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateResponse() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateResponse()
+	})
+}
+
+// ClearResponse clears the value of the "response" field.
+func (u *AuditLogUpsertBulk) ClearResponse() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearResponse()
+	})
+}
+
+// SetRawSize sets the "raw_size" field.
+func (u *AuditLogUpsertBulk) SetRawSize(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetRawSize(v)
+	})
+}
+
+// AddRawSize adds v to the "raw_size" field.
+func (u *AuditLogUpsertBulk) AddRawSize(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddRawSize(v)
+	})
+}
+
+// UpdateRawSize sets the "raw_size" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateRawSize() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateRawSize()
+	})
+}
+
+// SetSize sets the "size" field.
+func (u *AuditLogUpsertBulk) SetSize(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetSize(v)
+	})
+}
+
+// AddSize adds v to the "size" field.
+func (u *AuditLogUpsertBulk) AddSize(v int64) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.AddSize(v)
+	})
+}
+
+// UpdateSize sets the "size" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateSize() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateSize()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *AuditLogUpsertBulk) SetCreatedAt(v time.Time) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateCreatedAt() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
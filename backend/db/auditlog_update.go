@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetRequestID sets the "request_id" field.
+func (alu *AuditLogUpdate) SetRequestID(s string) *AuditLogUpdate {
+	alu.mutation.SetRequestID(s)
+	return alu
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableRequestID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetRequestID(*s)
+	}
+	return alu
+}
+
+// SetTaskID sets the "task_id" field.
+func (alu *AuditLogUpdate) SetTaskID(s string) *AuditLogUpdate {
+	alu.mutation.SetTaskID(s)
+	return alu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableTaskID(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetTaskID(*s)
+	}
+	return alu
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (alu *AuditLogUpdate) ClearTaskID() *AuditLogUpdate {
+	alu.mutation.ClearTaskID()
+	return alu
+}
+
+// SetUserID sets the "user_id" field.
+func (alu *AuditLogUpdate) SetUserID(u uuid.UUID) *AuditLogUpdate {
+	alu.mutation.SetUserID(u)
+	return alu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserID(u *uuid.UUID) *AuditLogUpdate {
+	if u != nil {
+		alu.SetUserID(*u)
+	}
+	return alu
+}
+
+// SetModelID sets the "model_id" field.
+func (alu *AuditLogUpdate) SetModelID(u uuid.UUID) *AuditLogUpdate {
+	alu.mutation.SetModelID(u)
+	return alu
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableModelID(u *uuid.UUID) *AuditLogUpdate {
+	if u != nil {
+		alu.SetModelID(*u)
+	}
+	return alu
+}
+
+// SetModelName sets the "model_name" field.
+func (alu *AuditLogUpdate) SetModelName(s string) *AuditLogUpdate {
+	alu.mutation.SetModelName(s)
+	return alu
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableModelName(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetModelName(*s)
+	}
+	return alu
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (alu *AuditLogUpdate) ClearModelName() *AuditLogUpdate {
+	alu.mutation.ClearModelName()
+	return alu
+}
+
+// SetModelType sets the "model_type" field.
+func (alu *AuditLogUpdate) SetModelType(ct consts.ModelType) *AuditLogUpdate {
+	alu.mutation.SetModelType(ct)
+	return alu
+}
+
+// SetNillableModelType sets the "model_type" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableModelType(ct *consts.ModelType) *AuditLogUpdate {
+	if ct != nil {
+		alu.SetModelType(*ct)
+	}
+	return alu
+}
+
+// SetPath sets the "path" field.
+func (alu *AuditLogUpdate) SetPath(s string) *AuditLogUpdate {
+	alu.mutation.SetPath(s)
+	return alu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillablePath(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetPath(*s)
+	}
+	return alu
+}
+
+// ClearPath clears the value of the "path" field.
+func (alu *AuditLogUpdate) ClearPath() *AuditLogUpdate {
+	alu.mutation.ClearPath()
+	return alu
+}
+
+// SetPrompt sets the "prompt" field.
+func (alu *AuditLogUpdate) SetPrompt(s string) *AuditLogUpdate {
+	alu.mutation.SetPrompt(s)
+	return alu
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillablePrompt(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetPrompt(*s)
+	}
+	return alu
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (alu *AuditLogUpdate) ClearPrompt() *AuditLogUpdate {
+	alu.mutation.ClearPrompt()
+	return alu
+}
+
+// SetCompletion sets the "completion" field.
+func (alu *AuditLogUpdate) SetCompletion(s string) *AuditLogUpdate {
+	alu.mutation.SetCompletion(s)
+	return alu
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCompletion(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetCompletion(*s)
+	}
+	return alu
+}
+
+// ClearCompletion clears the value of the "completion" field.
+func (alu *AuditLogUpdate) ClearCompletion() *AuditLogUpdate {
+	alu.mutation.ClearCompletion()
+	return alu
+}
+
+// SetRequest sets the "request" field.
+func (alu *AuditLogUpdate) SetRequest(b []byte) *AuditLogUpdate {
+	alu.mutation.SetRequest(b)
+	return alu
+}
+
+// ClearRequest clears the value of the "request" field.
+func (alu *AuditLogUpdate) ClearRequest() *AuditLogUpdate {
+	alu.mutation.ClearRequest()
+	return alu
+}
+
+// SetResponse sets the "response" field.
+func (alu *AuditLogUpdate) SetResponse(b []byte) *AuditLogUpdate {
+	alu.mutation.SetResponse(b)
+	return alu
+}
+
+// ClearResponse clears the value of the "response" field.
+func (alu *AuditLogUpdate) ClearResponse() *AuditLogUpdate {
+	alu.mutation.ClearResponse()
+	return alu
+}
+
+// SetRawSize sets the "raw_size" field.
+func (alu *AuditLogUpdate) SetRawSize(i int64) *AuditLogUpdate {
+	alu.mutation.ResetRawSize()
+	alu.mutation.SetRawSize(i)
+	return alu
+}
+
+// SetNillableRawSize sets the "raw_size" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableRawSize(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetRawSize(*i)
+	}
+	return alu
+}
+
+// AddRawSize adds i to the "raw_size" field.
+func (alu *AuditLogUpdate) AddRawSize(i int64) *AuditLogUpdate {
+	alu.mutation.AddRawSize(i)
+	return alu
+}
+
+// SetSize sets the "size" field.
+func (alu *AuditLogUpdate) SetSize(i int64) *AuditLogUpdate {
+	alu.mutation.ResetSize()
+	alu.mutation.SetSize(i)
+	return alu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableSize(i *int64) *AuditLogUpdate {
+	if i != nil {
+		alu.SetSize(*i)
+	}
+	return alu
+}
+
+// AddSize adds i to the "size" field.
+func (alu *AuditLogUpdate) AddSize(i int64) *AuditLogUpdate {
+	alu.mutation.AddSize(i)
+	return alu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (alu *AuditLogUpdate) SetCreatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetCreatedAt(t)
+	return alu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableCreatedAt(t *time.Time) *AuditLogUpdate {
+	if t != nil {
+		alu.SetCreatedAt(*t)
+	}
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (alu *AuditLogUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdate {
+	alu.modifiers = append(alu.modifiers, modifiers...)
+	return alu
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.TaskID(); ok {
+		_spec.SetField(auditlog.FieldTaskID, field.TypeString, value)
+	}
+	if alu.mutation.TaskIDCleared() {
+		_spec.ClearField(auditlog.FieldTaskID, field.TypeString)
+	}
+	if value, ok := alu.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := alu.mutation.ModelID(); ok {
+		_spec.SetField(auditlog.FieldModelID, field.TypeUUID, value)
+	}
+	if value, ok := alu.mutation.ModelName(); ok {
+		_spec.SetField(auditlog.FieldModelName, field.TypeString, value)
+	}
+	if alu.mutation.ModelNameCleared() {
+		_spec.ClearField(auditlog.FieldModelName, field.TypeString)
+	}
+	if value, ok := alu.mutation.ModelType(); ok {
+		_spec.SetField(auditlog.FieldModelType, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+	}
+	if alu.mutation.PathCleared() {
+		_spec.ClearField(auditlog.FieldPath, field.TypeString)
+	}
+	if value, ok := alu.mutation.Prompt(); ok {
+		_spec.SetField(auditlog.FieldPrompt, field.TypeString, value)
+	}
+	if alu.mutation.PromptCleared() {
+		_spec.ClearField(auditlog.FieldPrompt, field.TypeString)
+	}
+	if value, ok := alu.mutation.Completion(); ok {
+		_spec.SetField(auditlog.FieldCompletion, field.TypeString, value)
+	}
+	if alu.mutation.CompletionCleared() {
+		_spec.ClearField(auditlog.FieldCompletion, field.TypeString)
+	}
+	if value, ok := alu.mutation.Request(); ok {
+		_spec.SetField(auditlog.FieldRequest, field.TypeBytes, value)
+	}
+	if alu.mutation.RequestCleared() {
+		_spec.ClearField(auditlog.FieldRequest, field.TypeBytes)
+	}
+	if value, ok := alu.mutation.Response(); ok {
+		_spec.SetField(auditlog.FieldResponse, field.TypeBytes, value)
+	}
+	if alu.mutation.ResponseCleared() {
+		_spec.ClearField(auditlog.FieldResponse, field.TypeBytes)
+	}
+	if value, ok := alu.mutation.RawSize(); ok {
+		_spec.SetField(auditlog.FieldRawSize, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.AddedRawSize(); ok {
+		_spec.AddField(auditlog.FieldRawSize, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.Size(); ok {
+		_spec.SetField(auditlog.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.AddedSize(); ok {
+		_spec.AddField(auditlog.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := alu.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(alu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *AuditLogMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRequestID sets the "request_id" field.
+func (aluo *AuditLogUpdateOne) SetRequestID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetRequestID(s)
+	return aluo
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableRequestID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetRequestID(*s)
+	}
+	return aluo
+}
+
+// SetTaskID sets the "task_id" field.
+func (aluo *AuditLogUpdateOne) SetTaskID(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetTaskID(s)
+	return aluo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableTaskID(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetTaskID(*s)
+	}
+	return aluo
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (aluo *AuditLogUpdateOne) ClearTaskID() *AuditLogUpdateOne {
+	aluo.mutation.ClearTaskID()
+	return aluo
+}
+
+// SetUserID sets the "user_id" field.
+func (aluo *AuditLogUpdateOne) SetUserID(u uuid.UUID) *AuditLogUpdateOne {
+	aluo.mutation.SetUserID(u)
+	return aluo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserID(u *uuid.UUID) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetUserID(*u)
+	}
+	return aluo
+}
+
+// SetModelID sets the "model_id" field.
+func (aluo *AuditLogUpdateOne) SetModelID(u uuid.UUID) *AuditLogUpdateOne {
+	aluo.mutation.SetModelID(u)
+	return aluo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableModelID(u *uuid.UUID) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetModelID(*u)
+	}
+	return aluo
+}
+
+// SetModelName sets the "model_name" field.
+func (aluo *AuditLogUpdateOne) SetModelName(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetModelName(s)
+	return aluo
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableModelName(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetModelName(*s)
+	}
+	return aluo
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (aluo *AuditLogUpdateOne) ClearModelName() *AuditLogUpdateOne {
+	aluo.mutation.ClearModelName()
+	return aluo
+}
+
+// SetModelType sets the "model_type" field.
+func (aluo *AuditLogUpdateOne) SetModelType(ct consts.ModelType) *AuditLogUpdateOne {
+	aluo.mutation.SetModelType(ct)
+	return aluo
+}
+
+// SetNillableModelType sets the "model_type" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableModelType(ct *consts.ModelType) *AuditLogUpdateOne {
+	if ct != nil {
+		aluo.SetModelType(*ct)
+	}
+	return aluo
+}
+
+// SetPath sets the "path" field.
+func (aluo *AuditLogUpdateOne) SetPath(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetPath(s)
+	return aluo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillablePath(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetPath(*s)
+	}
+	return aluo
+}
+
+// ClearPath clears the value of the "path" field.
+func (aluo *AuditLogUpdateOne) ClearPath() *AuditLogUpdateOne {
+	aluo.mutation.ClearPath()
+	return aluo
+}
+
+// SetPrompt sets the "prompt" field.
+func (aluo *AuditLogUpdateOne) SetPrompt(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetPrompt(s)
+	return aluo
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillablePrompt(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetPrompt(*s)
+	}
+	return aluo
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (aluo *AuditLogUpdateOne) ClearPrompt() *AuditLogUpdateOne {
+	aluo.mutation.ClearPrompt()
+	return aluo
+}
+
+// SetCompletion sets the "completion" field.
+func (aluo *AuditLogUpdateOne) SetCompletion(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetCompletion(s)
+	return aluo
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCompletion(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetCompletion(*s)
+	}
+	return aluo
+}
+
+// ClearCompletion clears the value of the "completion" field.
+func (aluo *AuditLogUpdateOne) ClearCompletion() *AuditLogUpdateOne {
+	aluo.mutation.ClearCompletion()
+	return aluo
+}
+
+// SetRequest sets the "request" field.
+func (aluo *AuditLogUpdateOne) SetRequest(b []byte) *AuditLogUpdateOne {
+	aluo.mutation.SetRequest(b)
+	return aluo
+}
+
+// ClearRequest clears the value of the "request" field.
+func (aluo *AuditLogUpdateOne) ClearRequest() *AuditLogUpdateOne {
+	aluo.mutation.ClearRequest()
+	return aluo
+}
+
+// SetResponse sets the "response" field.
+func (aluo *AuditLogUpdateOne) SetResponse(b []byte) *AuditLogUpdateOne {
+	aluo.mutation.SetResponse(b)
+	return aluo
+}
+
+// ClearResponse clears the value of the "response" field.
+func (aluo *AuditLogUpdateOne) ClearResponse() *AuditLogUpdateOne {
+	aluo.mutation.ClearResponse()
+	return aluo
+}
+
+// SetRawSize sets the "raw_size" field.
+func (aluo *AuditLogUpdateOne) SetRawSize(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetRawSize()
+	aluo.mutation.SetRawSize(i)
+	return aluo
+}
+
+// SetNillableRawSize sets the "raw_size" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableRawSize(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetRawSize(*i)
+	}
+	return aluo
+}
+
+// AddRawSize adds i to the "raw_size" field.
+func (aluo *AuditLogUpdateOne) AddRawSize(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddRawSize(i)
+	return aluo
+}
+
+// SetSize sets the "size" field.
+func (aluo *AuditLogUpdateOne) SetSize(i int64) *AuditLogUpdateOne {
+	aluo.mutation.ResetSize()
+	aluo.mutation.SetSize(i)
+	return aluo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableSize(i *int64) *AuditLogUpdateOne {
+	if i != nil {
+		aluo.SetSize(*i)
+	}
+	return aluo
+}
+
+// AddSize adds i to the "size" field.
+func (aluo *AuditLogUpdateOne) AddSize(i int64) *AuditLogUpdateOne {
+	aluo.mutation.AddSize(i)
+	return aluo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aluo *AuditLogUpdateOne) SetCreatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetCreatedAt(t)
+	return aluo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableCreatedAt(t *time.Time) *AuditLogUpdateOne {
+	if t != nil {
+		aluo.SetCreatedAt(*t)
+	}
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (aluo *AuditLogUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *AuditLogUpdateOne {
+	aluo.modifiers = append(aluo.modifiers, modifiers...)
+	return aluo
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.RequestID(); ok {
+		_spec.SetField(auditlog.FieldRequestID, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.TaskID(); ok {
+		_spec.SetField(auditlog.FieldTaskID, field.TypeString, value)
+	}
+	if aluo.mutation.TaskIDCleared() {
+		_spec.ClearField(auditlog.FieldTaskID, field.TypeString)
+	}
+	if value, ok := aluo.mutation.UserID(); ok {
+		_spec.SetField(auditlog.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := aluo.mutation.ModelID(); ok {
+		_spec.SetField(auditlog.FieldModelID, field.TypeUUID, value)
+	}
+	if value, ok := aluo.mutation.ModelName(); ok {
+		_spec.SetField(auditlog.FieldModelName, field.TypeString, value)
+	}
+	if aluo.mutation.ModelNameCleared() {
+		_spec.ClearField(auditlog.FieldModelName, field.TypeString)
+	}
+	if value, ok := aluo.mutation.ModelType(); ok {
+		_spec.SetField(auditlog.FieldModelType, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.Path(); ok {
+		_spec.SetField(auditlog.FieldPath, field.TypeString, value)
+	}
+	if aluo.mutation.PathCleared() {
+		_spec.ClearField(auditlog.FieldPath, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Prompt(); ok {
+		_spec.SetField(auditlog.FieldPrompt, field.TypeString, value)
+	}
+	if aluo.mutation.PromptCleared() {
+		_spec.ClearField(auditlog.FieldPrompt, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Completion(); ok {
+		_spec.SetField(auditlog.FieldCompletion, field.TypeString, value)
+	}
+	if aluo.mutation.CompletionCleared() {
+		_spec.ClearField(auditlog.FieldCompletion, field.TypeString)
+	}
+	if value, ok := aluo.mutation.Request(); ok {
+		_spec.SetField(auditlog.FieldRequest, field.TypeBytes, value)
+	}
+	if aluo.mutation.RequestCleared() {
+		_spec.ClearField(auditlog.FieldRequest, field.TypeBytes)
+	}
+	if value, ok := aluo.mutation.Response(); ok {
+		_spec.SetField(auditlog.FieldResponse, field.TypeBytes, value)
+	}
+	if aluo.mutation.ResponseCleared() {
+		_spec.ClearField(auditlog.FieldResponse, field.TypeBytes)
+	}
+	if value, ok := aluo.mutation.RawSize(); ok {
+		_spec.SetField(auditlog.FieldRawSize, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.AddedRawSize(); ok {
+		_spec.AddField(auditlog.FieldRawSize, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.Size(); ok {
+		_spec.SetField(auditlog.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.AddedSize(); ok {
+		_spec.AddField(auditlog.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := aluo.mutation.CreatedAt(); ok {
+		_spec.SetField(auditlog.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(aluo.modifiers...)
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
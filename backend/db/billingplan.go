@@ -24,6 +24,8 @@ type BillingPlan struct {
 	Description string `json:"description,omitempty"`
 	// Rules holds the value of the "rules" field.
 	Rules map[string]interface{} `json:"rules,omitempty"`
+	// 套餐授予的 token 数
+	Quota int64 `json:"quota,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -38,6 +40,8 @@ func (*BillingPlan) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case billingplan.FieldRules:
 			values[i] = new([]byte)
+		case billingplan.FieldQuota:
+			values[i] = new(sql.NullInt64)
 		case billingplan.FieldID, billingplan.FieldName, billingplan.FieldDescription:
 			values[i] = new(sql.NullString)
 		case billingplan.FieldCreatedAt, billingplan.FieldUpdatedAt:
@@ -83,6 +87,12 @@ func (bp *BillingPlan) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field rules: %w", err)
 				}
 			}
+		case billingplan.FieldQuota:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quota", values[i])
+			} else if value.Valid {
+				bp.Quota = value.Int64
+			}
 		case billingplan.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -140,6 +150,9 @@ func (bp *BillingPlan) String() string {
 	builder.WriteString("rules=")
 	builder.WriteString(fmt.Sprintf("%v", bp.Rules))
 	builder.WriteString(", ")
+	builder.WriteString("quota=")
+	builder.WriteString(fmt.Sprintf("%v", bp.Quota))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(bp.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -19,6 +19,8 @@ const (
 	FieldDescription = "description"
 	// FieldRules holds the string denoting the rules field in the database.
 	FieldRules = "rules"
+	// FieldQuota holds the string denoting the quota field in the database.
+	FieldQuota = "quota"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -33,6 +35,7 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldRules,
+	FieldQuota,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -48,6 +51,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultQuota holds the default value on creation for the "quota" field.
+	DefaultQuota int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -74,6 +79,11 @@ func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
 }
 
+// ByQuota orders the results by the quota field.
+func ByQuota(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuota, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -74,6 +74,11 @@ func Description(v string) predicate.BillingPlan {
 	return predicate.BillingPlan(sql.FieldEQ(FieldDescription, v))
 }
 
+// Quota applies equality check predicate on the "quota" field. It's identical to QuotaEQ.
+func Quota(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldEQ(FieldQuota, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.BillingPlan {
 	return predicate.BillingPlan(sql.FieldEQ(FieldCreatedAt, v))
@@ -204,6 +209,16 @@ func DescriptionHasSuffix(v string) predicate.BillingPlan {
 	return predicate.BillingPlan(sql.FieldHasSuffix(FieldDescription, v))
 }
 
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldNotNull(FieldDescription))
+}
+
 // DescriptionEqualFold applies the EqualFold predicate on the "description" field.
 func DescriptionEqualFold(v string) predicate.BillingPlan {
 	return predicate.BillingPlan(sql.FieldEqualFold(FieldDescription, v))
@@ -214,6 +229,56 @@ func DescriptionContainsFold(v string) predicate.BillingPlan {
 	return predicate.BillingPlan(sql.FieldContainsFold(FieldDescription, v))
 }
 
+// RulesIsNil applies the IsNil predicate on the "rules" field.
+func RulesIsNil() predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldIsNull(FieldRules))
+}
+
+// RulesNotNil applies the NotNil predicate on the "rules" field.
+func RulesNotNil() predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldNotNull(FieldRules))
+}
+
+// QuotaEQ applies the EQ predicate on the "quota" field.
+func QuotaEQ(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldEQ(FieldQuota, v))
+}
+
+// QuotaNEQ applies the NEQ predicate on the "quota" field.
+func QuotaNEQ(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldNEQ(FieldQuota, v))
+}
+
+// QuotaIn applies the In predicate on the "quota" field.
+func QuotaIn(vs ...int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldIn(FieldQuota, vs...))
+}
+
+// QuotaNotIn applies the NotIn predicate on the "quota" field.
+func QuotaNotIn(vs ...int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldNotIn(FieldQuota, vs...))
+}
+
+// QuotaGT applies the GT predicate on the "quota" field.
+func QuotaGT(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldGT(FieldQuota, v))
+}
+
+// QuotaGTE applies the GTE predicate on the "quota" field.
+func QuotaGTE(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldGTE(FieldQuota, v))
+}
+
+// QuotaLT applies the LT predicate on the "quota" field.
+func QuotaLT(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldLT(FieldQuota, v))
+}
+
+// QuotaLTE applies the LTE predicate on the "quota" field.
+func QuotaLTE(v int64) predicate.BillingPlan {
+	return predicate.BillingPlan(sql.FieldLTE(FieldQuota, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.BillingPlan {
 	return predicate.BillingPlan(sql.FieldEQ(FieldCreatedAt, v))
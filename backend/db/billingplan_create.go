@@ -35,12 +35,34 @@ func (bpc *BillingPlanCreate) SetDescription(s string) *BillingPlanCreate {
 	return bpc
 }
 
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (bpc *BillingPlanCreate) SetNillableDescription(s *string) *BillingPlanCreate {
+	if s != nil {
+		bpc.SetDescription(*s)
+	}
+	return bpc
+}
+
 // SetRules sets the "rules" field.
 func (bpc *BillingPlanCreate) SetRules(m map[string]interface{}) *BillingPlanCreate {
 	bpc.mutation.SetRules(m)
 	return bpc
 }
 
+// SetQuota sets the "quota" field.
+func (bpc *BillingPlanCreate) SetQuota(i int64) *BillingPlanCreate {
+	bpc.mutation.SetQuota(i)
+	return bpc
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (bpc *BillingPlanCreate) SetNillableQuota(i *int64) *BillingPlanCreate {
+	if i != nil {
+		bpc.SetQuota(*i)
+	}
+	return bpc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (bpc *BillingPlanCreate) SetCreatedAt(t time.Time) *BillingPlanCreate {
 	bpc.mutation.SetCreatedAt(t)
@@ -110,6 +132,10 @@ func (bpc *BillingPlanCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (bpc *BillingPlanCreate) defaults() {
+	if _, ok := bpc.mutation.Quota(); !ok {
+		v := billingplan.DefaultQuota
+		bpc.mutation.SetQuota(v)
+	}
 	if _, ok := bpc.mutation.CreatedAt(); !ok {
 		v := billingplan.DefaultCreatedAt()
 		bpc.mutation.SetCreatedAt(v)
@@ -125,11 +151,8 @@ func (bpc *BillingPlanCreate) check() error {
 	if _, ok := bpc.mutation.Name(); !ok {
 		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "BillingPlan.name"`)}
 	}
-	if _, ok := bpc.mutation.Description(); !ok {
-		return &ValidationError{Name: "description", err: errors.New(`db: missing required field "BillingPlan.description"`)}
-	}
-	if _, ok := bpc.mutation.Rules(); !ok {
-		return &ValidationError{Name: "rules", err: errors.New(`db: missing required field "BillingPlan.rules"`)}
+	if _, ok := bpc.mutation.Quota(); !ok {
+		return &ValidationError{Name: "quota", err: errors.New(`db: missing required field "BillingPlan.quota"`)}
 	}
 	if _, ok := bpc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "BillingPlan.created_at"`)}
@@ -185,6 +208,10 @@ func (bpc *BillingPlanCreate) createSpec() (*BillingPlan, *sqlgraph.CreateSpec)
 		_spec.SetField(billingplan.FieldRules, field.TypeJSON, value)
 		_node.Rules = value
 	}
+	if value, ok := bpc.mutation.Quota(); ok {
+		_spec.SetField(billingplan.FieldQuota, field.TypeInt64, value)
+		_node.Quota = value
+	}
 	if value, ok := bpc.mutation.CreatedAt(); ok {
 		_spec.SetField(billingplan.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -269,6 +296,12 @@ func (u *BillingPlanUpsert) UpdateDescription() *BillingPlanUpsert {
 	return u
 }
 
+// ClearDescription clears the value of the "description" field.
+func (u *BillingPlanUpsert) ClearDescription() *BillingPlanUpsert {
+	u.SetNull(billingplan.FieldDescription)
+	return u
+}
+
 // SetRules sets the "rules" field.
 func (u *BillingPlanUpsert) SetRules(v map[string]interface{}) *BillingPlanUpsert {
 	u.Set(billingplan.FieldRules, v)
@@ -281,6 +314,30 @@ func (u *BillingPlanUpsert) UpdateRules() *BillingPlanUpsert {
 	return u
 }
 
+// ClearRules clears the value of the "rules" field.
+func (u *BillingPlanUpsert) ClearRules() *BillingPlanUpsert {
+	u.SetNull(billingplan.FieldRules)
+	return u
+}
+
+// SetQuota sets the "quota" field.
+func (u *BillingPlanUpsert) SetQuota(v int64) *BillingPlanUpsert {
+	u.Set(billingplan.FieldQuota, v)
+	return u
+}
+
+// UpdateQuota sets the "quota" field to the value that was provided on create.
+func (u *BillingPlanUpsert) UpdateQuota() *BillingPlanUpsert {
+	u.SetExcluded(billingplan.FieldQuota)
+	return u
+}
+
+// AddQuota adds v to the "quota" field.
+func (u *BillingPlanUpsert) AddQuota(v int64) *BillingPlanUpsert {
+	u.Add(billingplan.FieldQuota, v)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *BillingPlanUpsert) SetCreatedAt(v time.Time) *BillingPlanUpsert {
 	u.Set(billingplan.FieldCreatedAt, v)
@@ -381,6 +438,13 @@ func (u *BillingPlanUpsertOne) UpdateDescription() *BillingPlanUpsertOne {
 	})
 }
 
+// ClearDescription clears the value of the "description" field.
+func (u *BillingPlanUpsertOne) ClearDescription() *BillingPlanUpsertOne {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.ClearDescription()
+	})
+}
+
 // SetRules sets the "rules" field.
 func (u *BillingPlanUpsertOne) SetRules(v map[string]interface{}) *BillingPlanUpsertOne {
 	return u.Update(func(s *BillingPlanUpsert) {
@@ -395,6 +459,34 @@ func (u *BillingPlanUpsertOne) UpdateRules() *BillingPlanUpsertOne {
 	})
 }
 
+// ClearRules clears the value of the "rules" field.
+func (u *BillingPlanUpsertOne) ClearRules() *BillingPlanUpsertOne {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.ClearRules()
+	})
+}
+
+// SetQuota sets the "quota" field.
+func (u *BillingPlanUpsertOne) SetQuota(v int64) *BillingPlanUpsertOne {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.SetQuota(v)
+	})
+}
+
+// AddQuota adds v to the "quota" field.
+func (u *BillingPlanUpsertOne) AddQuota(v int64) *BillingPlanUpsertOne {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.AddQuota(v)
+	})
+}
+
+// UpdateQuota sets the "quota" field to the value that was provided on create.
+func (u *BillingPlanUpsertOne) UpdateQuota() *BillingPlanUpsertOne {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.UpdateQuota()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *BillingPlanUpsertOne) SetCreatedAt(v time.Time) *BillingPlanUpsertOne {
 	return u.Update(func(s *BillingPlanUpsert) {
@@ -666,6 +758,13 @@ func (u *BillingPlanUpsertBulk) UpdateDescription() *BillingPlanUpsertBulk {
 	})
 }
 
+// ClearDescription clears the value of the "description" field.
+func (u *BillingPlanUpsertBulk) ClearDescription() *BillingPlanUpsertBulk {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.ClearDescription()
+	})
+}
+
 // SetRules sets the "rules" field.
 func (u *BillingPlanUpsertBulk) SetRules(v map[string]interface{}) *BillingPlanUpsertBulk {
 	return u.Update(func(s *BillingPlanUpsert) {
@@ -680,6 +779,34 @@ func (u *BillingPlanUpsertBulk) UpdateRules() *BillingPlanUpsertBulk {
 	})
 }
 
+// ClearRules clears the value of the "rules" field.
+func (u *BillingPlanUpsertBulk) ClearRules() *BillingPlanUpsertBulk {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.ClearRules()
+	})
+}
+
+// SetQuota sets the "quota" field.
+func (u *BillingPlanUpsertBulk) SetQuota(v int64) *BillingPlanUpsertBulk {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.SetQuota(v)
+	})
+}
+
+// AddQuota adds v to the "quota" field.
+func (u *BillingPlanUpsertBulk) AddQuota(v int64) *BillingPlanUpsertBulk {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.AddQuota(v)
+	})
+}
+
+// UpdateQuota sets the "quota" field to the value that was provided on create.
+func (u *BillingPlanUpsertBulk) UpdateQuota() *BillingPlanUpsertBulk {
+	return u.Update(func(s *BillingPlanUpsert) {
+		s.UpdateQuota()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *BillingPlanUpsertBulk) SetCreatedAt(v time.Time) *BillingPlanUpsertBulk {
 	return u.Update(func(s *BillingPlanUpsert) {
@@ -57,12 +57,45 @@ func (bpu *BillingPlanUpdate) SetNillableDescription(s *string) *BillingPlanUpda
 	return bpu
 }
 
+// ClearDescription clears the value of the "description" field.
+func (bpu *BillingPlanUpdate) ClearDescription() *BillingPlanUpdate {
+	bpu.mutation.ClearDescription()
+	return bpu
+}
+
 // SetRules sets the "rules" field.
 func (bpu *BillingPlanUpdate) SetRules(m map[string]interface{}) *BillingPlanUpdate {
 	bpu.mutation.SetRules(m)
 	return bpu
 }
 
+// ClearRules clears the value of the "rules" field.
+func (bpu *BillingPlanUpdate) ClearRules() *BillingPlanUpdate {
+	bpu.mutation.ClearRules()
+	return bpu
+}
+
+// SetQuota sets the "quota" field.
+func (bpu *BillingPlanUpdate) SetQuota(i int64) *BillingPlanUpdate {
+	bpu.mutation.ResetQuota()
+	bpu.mutation.SetQuota(i)
+	return bpu
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (bpu *BillingPlanUpdate) SetNillableQuota(i *int64) *BillingPlanUpdate {
+	if i != nil {
+		bpu.SetQuota(*i)
+	}
+	return bpu
+}
+
+// AddQuota adds i to the "quota" field.
+func (bpu *BillingPlanUpdate) AddQuota(i int64) *BillingPlanUpdate {
+	bpu.mutation.AddQuota(i)
+	return bpu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (bpu *BillingPlanUpdate) SetCreatedAt(t time.Time) *BillingPlanUpdate {
 	bpu.mutation.SetCreatedAt(t)
@@ -145,9 +178,21 @@ func (bpu *BillingPlanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bpu.mutation.Description(); ok {
 		_spec.SetField(billingplan.FieldDescription, field.TypeString, value)
 	}
+	if bpu.mutation.DescriptionCleared() {
+		_spec.ClearField(billingplan.FieldDescription, field.TypeString)
+	}
 	if value, ok := bpu.mutation.Rules(); ok {
 		_spec.SetField(billingplan.FieldRules, field.TypeJSON, value)
 	}
+	if bpu.mutation.RulesCleared() {
+		_spec.ClearField(billingplan.FieldRules, field.TypeJSON)
+	}
+	if value, ok := bpu.mutation.Quota(); ok {
+		_spec.SetField(billingplan.FieldQuota, field.TypeInt64, value)
+	}
+	if value, ok := bpu.mutation.AddedQuota(); ok {
+		_spec.AddField(billingplan.FieldQuota, field.TypeInt64, value)
+	}
 	if value, ok := bpu.mutation.CreatedAt(); ok {
 		_spec.SetField(billingplan.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -204,12 +249,45 @@ func (bpuo *BillingPlanUpdateOne) SetNillableDescription(s *string) *BillingPlan
 	return bpuo
 }
 
+// ClearDescription clears the value of the "description" field.
+func (bpuo *BillingPlanUpdateOne) ClearDescription() *BillingPlanUpdateOne {
+	bpuo.mutation.ClearDescription()
+	return bpuo
+}
+
 // SetRules sets the "rules" field.
 func (bpuo *BillingPlanUpdateOne) SetRules(m map[string]interface{}) *BillingPlanUpdateOne {
 	bpuo.mutation.SetRules(m)
 	return bpuo
 }
 
+// ClearRules clears the value of the "rules" field.
+func (bpuo *BillingPlanUpdateOne) ClearRules() *BillingPlanUpdateOne {
+	bpuo.mutation.ClearRules()
+	return bpuo
+}
+
+// SetQuota sets the "quota" field.
+func (bpuo *BillingPlanUpdateOne) SetQuota(i int64) *BillingPlanUpdateOne {
+	bpuo.mutation.ResetQuota()
+	bpuo.mutation.SetQuota(i)
+	return bpuo
+}
+
+// SetNillableQuota sets the "quota" field if the given value is not nil.
+func (bpuo *BillingPlanUpdateOne) SetNillableQuota(i *int64) *BillingPlanUpdateOne {
+	if i != nil {
+		bpuo.SetQuota(*i)
+	}
+	return bpuo
+}
+
+// AddQuota adds i to the "quota" field.
+func (bpuo *BillingPlanUpdateOne) AddQuota(i int64) *BillingPlanUpdateOne {
+	bpuo.mutation.AddQuota(i)
+	return bpuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (bpuo *BillingPlanUpdateOne) SetCreatedAt(t time.Time) *BillingPlanUpdateOne {
 	bpuo.mutation.SetCreatedAt(t)
@@ -322,9 +400,21 @@ func (bpuo *BillingPlanUpdateOne) sqlSave(ctx context.Context) (_node *BillingPl
 	if value, ok := bpuo.mutation.Description(); ok {
 		_spec.SetField(billingplan.FieldDescription, field.TypeString, value)
 	}
+	if bpuo.mutation.DescriptionCleared() {
+		_spec.ClearField(billingplan.FieldDescription, field.TypeString)
+	}
 	if value, ok := bpuo.mutation.Rules(); ok {
 		_spec.SetField(billingplan.FieldRules, field.TypeJSON, value)
 	}
+	if bpuo.mutation.RulesCleared() {
+		_spec.ClearField(billingplan.FieldRules, field.TypeJSON)
+	}
+	if value, ok := bpuo.mutation.Quota(); ok {
+		_spec.SetField(billingplan.FieldQuota, field.TypeInt64, value)
+	}
+	if value, ok := bpuo.mutation.AddedQuota(); ok {
+		_spec.AddField(billingplan.FieldQuota, field.TypeInt64, value)
+	}
 	if value, ok := bpuo.mutation.CreatedAt(); ok {
 		_spec.SetField(billingplan.FieldCreatedAt, field.TypeTime, value)
 	}
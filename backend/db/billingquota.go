@@ -21,6 +21,8 @@ type BillingQuota struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
 	// Total holds the value of the "total" field.
 	Total int64 `json:"total,omitempty"`
 	// Used holds the value of the "used" field.
@@ -41,7 +43,7 @@ func (*BillingQuota) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case billingquota.FieldTotal, billingquota.FieldUsed, billingquota.FieldRemain:
 			values[i] = new(sql.NullInt64)
-		case billingquota.FieldID, billingquota.FieldUserID:
+		case billingquota.FieldID, billingquota.FieldUserID, billingquota.FieldPlanID:
 			values[i] = new(sql.NullString)
 		case billingquota.FieldDeletedAt, billingquota.FieldCreatedAt, billingquota.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -78,6 +80,12 @@ func (bq *BillingQuota) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bq.UserID = value.String
 			}
+		case billingquota.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				bq.PlanID = value.String
+			}
 		case billingquota.FieldTotal:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field total", values[i])
@@ -150,6 +158,9 @@ func (bq *BillingQuota) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(bq.UserID)
 	builder.WriteString(", ")
+	builder.WriteString("plan_id=")
+	builder.WriteString(bq.PlanID)
+	builder.WriteString(", ")
 	builder.WriteString("total=")
 	builder.WriteString(fmt.Sprintf("%v", bq.Total))
 	builder.WriteString(", ")
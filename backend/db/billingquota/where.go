@@ -74,6 +74,11 @@ func UserID(v string) predicate.BillingQuota {
 	return predicate.BillingQuota(sql.FieldEQ(FieldUserID, v))
 }
 
+// PlanID applies equality check predicate on the "plan_id" field. It's identical to PlanIDEQ.
+func PlanID(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldEQ(FieldPlanID, v))
+}
+
 // Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
 func Total(v int64) predicate.BillingQuota {
 	return predicate.BillingQuota(sql.FieldEQ(FieldTotal, v))
@@ -214,6 +219,81 @@ func UserIDContainsFold(v string) predicate.BillingQuota {
 	return predicate.BillingQuota(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// PlanIDEQ applies the EQ predicate on the "plan_id" field.
+func PlanIDEQ(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldEQ(FieldPlanID, v))
+}
+
+// PlanIDNEQ applies the NEQ predicate on the "plan_id" field.
+func PlanIDNEQ(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldNEQ(FieldPlanID, v))
+}
+
+// PlanIDIn applies the In predicate on the "plan_id" field.
+func PlanIDIn(vs ...string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldIn(FieldPlanID, vs...))
+}
+
+// PlanIDNotIn applies the NotIn predicate on the "plan_id" field.
+func PlanIDNotIn(vs ...string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldNotIn(FieldPlanID, vs...))
+}
+
+// PlanIDGT applies the GT predicate on the "plan_id" field.
+func PlanIDGT(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldGT(FieldPlanID, v))
+}
+
+// PlanIDGTE applies the GTE predicate on the "plan_id" field.
+func PlanIDGTE(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldGTE(FieldPlanID, v))
+}
+
+// PlanIDLT applies the LT predicate on the "plan_id" field.
+func PlanIDLT(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldLT(FieldPlanID, v))
+}
+
+// PlanIDLTE applies the LTE predicate on the "plan_id" field.
+func PlanIDLTE(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldLTE(FieldPlanID, v))
+}
+
+// PlanIDContains applies the Contains predicate on the "plan_id" field.
+func PlanIDContains(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldContains(FieldPlanID, v))
+}
+
+// PlanIDHasPrefix applies the HasPrefix predicate on the "plan_id" field.
+func PlanIDHasPrefix(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldHasPrefix(FieldPlanID, v))
+}
+
+// PlanIDHasSuffix applies the HasSuffix predicate on the "plan_id" field.
+func PlanIDHasSuffix(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldHasSuffix(FieldPlanID, v))
+}
+
+// PlanIDIsNil applies the IsNil predicate on the "plan_id" field.
+func PlanIDIsNil() predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldIsNull(FieldPlanID))
+}
+
+// PlanIDNotNil applies the NotNil predicate on the "plan_id" field.
+func PlanIDNotNil() predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldNotNull(FieldPlanID))
+}
+
+// PlanIDEqualFold applies the EqualFold predicate on the "plan_id" field.
+func PlanIDEqualFold(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldEqualFold(FieldPlanID, v))
+}
+
+// PlanIDContainsFold applies the ContainsFold predicate on the "plan_id" field.
+func PlanIDContainsFold(v string) predicate.BillingQuota {
+	return predicate.BillingQuota(sql.FieldContainsFold(FieldPlanID, v))
+}
+
 // TotalEQ applies the EQ predicate on the "total" field.
 func TotalEQ(v int64) predicate.BillingQuota {
 	return predicate.BillingQuota(sql.FieldEQ(FieldTotal, v))
@@ -43,6 +43,20 @@ func (bqc *BillingQuotaCreate) SetUserID(s string) *BillingQuotaCreate {
 	return bqc
 }
 
+// SetPlanID sets the "plan_id" field.
+func (bqc *BillingQuotaCreate) SetPlanID(s string) *BillingQuotaCreate {
+	bqc.mutation.SetPlanID(s)
+	return bqc
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (bqc *BillingQuotaCreate) SetNillablePlanID(s *string) *BillingQuotaCreate {
+	if s != nil {
+		bqc.SetPlanID(*s)
+	}
+	return bqc
+}
+
 // SetTotal sets the "total" field.
 func (bqc *BillingQuotaCreate) SetTotal(i int64) *BillingQuotaCreate {
 	bqc.mutation.SetTotal(i)
@@ -213,6 +227,10 @@ func (bqc *BillingQuotaCreate) createSpec() (*BillingQuota, *sqlgraph.CreateSpec
 		_spec.SetField(billingquota.FieldUserID, field.TypeString, value)
 		_node.UserID = value
 	}
+	if value, ok := bqc.mutation.PlanID(); ok {
+		_spec.SetField(billingquota.FieldPlanID, field.TypeString, value)
+		_node.PlanID = value
+	}
 	if value, ok := bqc.mutation.Total(); ok {
 		_spec.SetField(billingquota.FieldTotal, field.TypeInt64, value)
 		_node.Total = value
@@ -315,6 +333,24 @@ func (u *BillingQuotaUpsert) UpdateUserID() *BillingQuotaUpsert {
 	return u
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *BillingQuotaUpsert) SetPlanID(v string) *BillingQuotaUpsert {
+	u.Set(billingquota.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *BillingQuotaUpsert) UpdatePlanID() *BillingQuotaUpsert {
+	u.SetExcluded(billingquota.FieldPlanID)
+	return u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *BillingQuotaUpsert) ClearPlanID() *BillingQuotaUpsert {
+	u.SetNull(billingquota.FieldPlanID)
+	return u
+}
+
 // SetTotal sets the "total" field.
 func (u *BillingQuotaUpsert) SetTotal(v int64) *BillingQuotaUpsert {
 	u.Set(billingquota.FieldTotal, v)
@@ -476,6 +512,27 @@ func (u *BillingQuotaUpsertOne) UpdateUserID() *BillingQuotaUpsertOne {
 	})
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *BillingQuotaUpsertOne) SetPlanID(v string) *BillingQuotaUpsertOne {
+	return u.Update(func(s *BillingQuotaUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *BillingQuotaUpsertOne) UpdatePlanID() *BillingQuotaUpsertOne {
+	return u.Update(func(s *BillingQuotaUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *BillingQuotaUpsertOne) ClearPlanID() *BillingQuotaUpsertOne {
+	return u.Update(func(s *BillingQuotaUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetTotal sets the "total" field.
 func (u *BillingQuotaUpsertOne) SetTotal(v int64) *BillingQuotaUpsertOne {
 	return u.Update(func(s *BillingQuotaUpsert) {
@@ -817,6 +874,27 @@ func (u *BillingQuotaUpsertBulk) UpdateUserID() *BillingQuotaUpsertBulk {
 	})
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *BillingQuotaUpsertBulk) SetPlanID(v string) *BillingQuotaUpsertBulk {
+	return u.Update(func(s *BillingQuotaUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *BillingQuotaUpsertBulk) UpdatePlanID() *BillingQuotaUpsertBulk {
+	return u.Update(func(s *BillingQuotaUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *BillingQuotaUpsertBulk) ClearPlanID() *BillingQuotaUpsertBulk {
+	return u.Update(func(s *BillingQuotaUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetTotal sets the "total" field.
 func (u *BillingQuotaUpsertBulk) SetTotal(v int64) *BillingQuotaUpsertBulk {
 	return u.Update(func(s *BillingQuotaUpsert) {
@@ -63,6 +63,26 @@ func (bqu *BillingQuotaUpdate) SetNillableUserID(s *string) *BillingQuotaUpdate
 	return bqu
 }
 
+// SetPlanID sets the "plan_id" field.
+func (bqu *BillingQuotaUpdate) SetPlanID(s string) *BillingQuotaUpdate {
+	bqu.mutation.SetPlanID(s)
+	return bqu
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (bqu *BillingQuotaUpdate) SetNillablePlanID(s *string) *BillingQuotaUpdate {
+	if s != nil {
+		bqu.SetPlanID(*s)
+	}
+	return bqu
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (bqu *BillingQuotaUpdate) ClearPlanID() *BillingQuotaUpdate {
+	bqu.mutation.ClearPlanID()
+	return bqu
+}
+
 // SetTotal sets the "total" field.
 func (bqu *BillingQuotaUpdate) SetTotal(i int64) *BillingQuotaUpdate {
 	bqu.mutation.ResetTotal()
@@ -217,6 +237,12 @@ func (bqu *BillingQuotaUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := bqu.mutation.UserID(); ok {
 		_spec.SetField(billingquota.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := bqu.mutation.PlanID(); ok {
+		_spec.SetField(billingquota.FieldPlanID, field.TypeString, value)
+	}
+	if bqu.mutation.PlanIDCleared() {
+		_spec.ClearField(billingquota.FieldPlanID, field.TypeString)
+	}
 	if value, ok := bqu.mutation.Total(); ok {
 		_spec.SetField(billingquota.FieldTotal, field.TypeInt64, value)
 	}
@@ -297,6 +323,26 @@ func (bquo *BillingQuotaUpdateOne) SetNillableUserID(s *string) *BillingQuotaUpd
 	return bquo
 }
 
+// SetPlanID sets the "plan_id" field.
+func (bquo *BillingQuotaUpdateOne) SetPlanID(s string) *BillingQuotaUpdateOne {
+	bquo.mutation.SetPlanID(s)
+	return bquo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (bquo *BillingQuotaUpdateOne) SetNillablePlanID(s *string) *BillingQuotaUpdateOne {
+	if s != nil {
+		bquo.SetPlanID(*s)
+	}
+	return bquo
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (bquo *BillingQuotaUpdateOne) ClearPlanID() *BillingQuotaUpdateOne {
+	bquo.mutation.ClearPlanID()
+	return bquo
+}
+
 // SetTotal sets the "total" field.
 func (bquo *BillingQuotaUpdateOne) SetTotal(i int64) *BillingQuotaUpdateOne {
 	bquo.mutation.ResetTotal()
@@ -481,6 +527,12 @@ func (bquo *BillingQuotaUpdateOne) sqlSave(ctx context.Context) (_node *BillingQ
 	if value, ok := bquo.mutation.UserID(); ok {
 		_spec.SetField(billingquota.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := bquo.mutation.PlanID(); ok {
+		_spec.SetField(billingquota.FieldPlanID, field.TypeString, value)
+	}
+	if bquo.mutation.PlanIDCleared() {
+		_spec.ClearField(billingquota.FieldPlanID, field.TypeString)
+	}
 	if value, ok := bquo.mutation.Total(); ok {
 		_spec.SetField(billingquota.FieldTotal, field.TypeInt64, value)
 	}
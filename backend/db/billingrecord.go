@@ -22,6 +22,8 @@ type BillingRecord struct {
 	TenantID string `json:"tenant_id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID *string `json:"request_id,omitempty"`
 	// Model holds the value of the "model" field.
 	Model string `json:"model,omitempty"`
 	// Operation holds the value of the "operation" field.
@@ -52,7 +54,7 @@ func (*BillingRecord) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case billingrecord.FieldInputTokens, billingrecord.FieldOutputTokens, billingrecord.FieldCost:
 			values[i] = new(sql.NullInt64)
-		case billingrecord.FieldID, billingrecord.FieldTenantID, billingrecord.FieldUserID, billingrecord.FieldModel, billingrecord.FieldOperation:
+		case billingrecord.FieldID, billingrecord.FieldTenantID, billingrecord.FieldUserID, billingrecord.FieldRequestID, billingrecord.FieldModel, billingrecord.FieldOperation:
 			values[i] = new(sql.NullString)
 		case billingrecord.FieldRequestTime, billingrecord.FieldCreatedAt, billingrecord.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -89,6 +91,13 @@ func (br *BillingRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				br.UserID = value.String
 			}
+		case billingrecord.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				br.RequestID = new(string)
+				*br.RequestID = value.String
+			}
 		case billingrecord.FieldModel:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field model", values[i])
@@ -187,6 +196,11 @@ func (br *BillingRecord) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(br.UserID)
 	builder.WriteString(", ")
+	if v := br.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("model=")
 	builder.WriteString(br.Model)
 	builder.WriteString(", ")
@@ -74,6 +74,11 @@ func UserID(v string) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldEQ(FieldUserID, v))
 }
 
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldEQ(FieldRequestID, v))
+}
+
 // Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
 func Model(v string) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldEQ(FieldModel, v))
@@ -169,6 +174,16 @@ func TenantIDHasSuffix(v string) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldHasSuffix(FieldTenantID, v))
 }
 
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldNotNull(FieldTenantID))
+}
+
 // TenantIDEqualFold applies the EqualFold predicate on the "tenant_id" field.
 func TenantIDEqualFold(v string) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldEqualFold(FieldTenantID, v))
@@ -244,6 +259,81 @@ func UserIDContainsFold(v string) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldContainsFold(FieldRequestID, v))
+}
+
 // ModelEQ applies the EQ predicate on the "model" field.
 func ModelEQ(v string) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldEQ(FieldModel, v))
@@ -534,6 +624,16 @@ func RequestTimeLTE(v time.Time) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldLTE(FieldRequestTime, v))
 }
 
+// MetadataIsNil applies the IsNil predicate on the "metadata" field.
+func MetadataIsNil() predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldIsNull(FieldMetadata))
+}
+
+// MetadataNotNil applies the NotNil predicate on the "metadata" field.
+func MetadataNotNil() predicate.BillingRecord {
+	return predicate.BillingRecord(sql.FieldNotNull(FieldMetadata))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.BillingRecord {
 	return predicate.BillingRecord(sql.FieldEQ(FieldCreatedAt, v))
@@ -29,12 +29,34 @@ func (brc *BillingRecordCreate) SetTenantID(s string) *BillingRecordCreate {
 	return brc
 }
 
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (brc *BillingRecordCreate) SetNillableTenantID(s *string) *BillingRecordCreate {
+	if s != nil {
+		brc.SetTenantID(*s)
+	}
+	return brc
+}
+
 // SetUserID sets the "user_id" field.
 func (brc *BillingRecordCreate) SetUserID(s string) *BillingRecordCreate {
 	brc.mutation.SetUserID(s)
 	return brc
 }
 
+// SetRequestID sets the "request_id" field.
+func (brc *BillingRecordCreate) SetRequestID(s string) *BillingRecordCreate {
+	brc.mutation.SetRequestID(s)
+	return brc
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (brc *BillingRecordCreate) SetNillableRequestID(s *string) *BillingRecordCreate {
+	if s != nil {
+		brc.SetRequestID(*s)
+	}
+	return brc
+}
+
 // SetModel sets the "model" field.
 func (brc *BillingRecordCreate) SetModel(s string) *BillingRecordCreate {
 	brc.mutation.SetModel(s)
@@ -170,9 +192,6 @@ func (brc *BillingRecordCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (brc *BillingRecordCreate) check() error {
-	if _, ok := brc.mutation.TenantID(); !ok {
-		return &ValidationError{Name: "tenant_id", err: errors.New(`db: missing required field "BillingRecord.tenant_id"`)}
-	}
 	if _, ok := brc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`db: missing required field "BillingRecord.user_id"`)}
 	}
@@ -194,9 +213,6 @@ func (brc *BillingRecordCreate) check() error {
 	if _, ok := brc.mutation.RequestTime(); !ok {
 		return &ValidationError{Name: "request_time", err: errors.New(`db: missing required field "BillingRecord.request_time"`)}
 	}
-	if _, ok := brc.mutation.Metadata(); !ok {
-		return &ValidationError{Name: "metadata", err: errors.New(`db: missing required field "BillingRecord.metadata"`)}
-	}
 	if _, ok := brc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "BillingRecord.created_at"`)}
 	}
@@ -247,6 +263,10 @@ func (brc *BillingRecordCreate) createSpec() (*BillingRecord, *sqlgraph.CreateSp
 		_spec.SetField(billingrecord.FieldUserID, field.TypeString, value)
 		_node.UserID = value
 	}
+	if value, ok := brc.mutation.RequestID(); ok {
+		_spec.SetField(billingrecord.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
 	if value, ok := brc.mutation.Model(); ok {
 		_spec.SetField(billingrecord.FieldModel, field.TypeString, value)
 		_node.Model = value
@@ -347,6 +367,12 @@ func (u *BillingRecordUpsert) UpdateTenantID() *BillingRecordUpsert {
 	return u
 }
 
+// ClearTenantID clears the value of the "tenant_id" field.
+func (u *BillingRecordUpsert) ClearTenantID() *BillingRecordUpsert {
+	u.SetNull(billingrecord.FieldTenantID)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *BillingRecordUpsert) SetUserID(v string) *BillingRecordUpsert {
 	u.Set(billingrecord.FieldUserID, v)
@@ -359,6 +385,24 @@ func (u *BillingRecordUpsert) UpdateUserID() *BillingRecordUpsert {
 	return u
 }
 
+// SetRequestID sets the "request_id" field.
+func (u *BillingRecordUpsert) SetRequestID(v string) *BillingRecordUpsert {
+	u.Set(billingrecord.FieldRequestID, v)
+	return u
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *BillingRecordUpsert) UpdateRequestID() *BillingRecordUpsert {
+	u.SetExcluded(billingrecord.FieldRequestID)
+	return u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *BillingRecordUpsert) ClearRequestID() *BillingRecordUpsert {
+	u.SetNull(billingrecord.FieldRequestID)
+	return u
+}
+
 // SetModel sets the "model" field.
 func (u *BillingRecordUpsert) SetModel(v string) *BillingRecordUpsert {
 	u.Set(billingrecord.FieldModel, v)
@@ -461,6 +505,12 @@ func (u *BillingRecordUpsert) UpdateMetadata() *BillingRecordUpsert {
 	return u
 }
 
+// ClearMetadata clears the value of the "metadata" field.
+func (u *BillingRecordUpsert) ClearMetadata() *BillingRecordUpsert {
+	u.SetNull(billingrecord.FieldMetadata)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *BillingRecordUpsert) SetCreatedAt(v time.Time) *BillingRecordUpsert {
 	u.Set(billingrecord.FieldCreatedAt, v)
@@ -547,6 +597,13 @@ func (u *BillingRecordUpsertOne) UpdateTenantID() *BillingRecordUpsertOne {
 	})
 }
 
+// ClearTenantID clears the value of the "tenant_id" field.
+func (u *BillingRecordUpsertOne) ClearTenantID() *BillingRecordUpsertOne {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.ClearTenantID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *BillingRecordUpsertOne) SetUserID(v string) *BillingRecordUpsertOne {
 	return u.Update(func(s *BillingRecordUpsert) {
@@ -561,6 +618,27 @@ func (u *BillingRecordUpsertOne) UpdateUserID() *BillingRecordUpsertOne {
 	})
 }
 
+// SetRequestID sets the "request_id" field.
+func (u *BillingRecordUpsertOne) SetRequestID(v string) *BillingRecordUpsertOne {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *BillingRecordUpsertOne) UpdateRequestID() *BillingRecordUpsertOne {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *BillingRecordUpsertOne) ClearRequestID() *BillingRecordUpsertOne {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.ClearRequestID()
+	})
+}
+
 // SetModel sets the "model" field.
 func (u *BillingRecordUpsertOne) SetModel(v string) *BillingRecordUpsertOne {
 	return u.Update(func(s *BillingRecordUpsert) {
@@ -680,6 +758,13 @@ func (u *BillingRecordUpsertOne) UpdateMetadata() *BillingRecordUpsertOne {
 	})
 }
 
+// ClearMetadata clears the value of the "metadata" field.
+func (u *BillingRecordUpsertOne) ClearMetadata() *BillingRecordUpsertOne {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.ClearMetadata()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *BillingRecordUpsertOne) SetCreatedAt(v time.Time) *BillingRecordUpsertOne {
 	return u.Update(func(s *BillingRecordUpsert) {
@@ -937,6 +1022,13 @@ func (u *BillingRecordUpsertBulk) UpdateTenantID() *BillingRecordUpsertBulk {
 	})
 }
 
+// ClearTenantID clears the value of the "tenant_id" field.
+func (u *BillingRecordUpsertBulk) ClearTenantID() *BillingRecordUpsertBulk {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.ClearTenantID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *BillingRecordUpsertBulk) SetUserID(v string) *BillingRecordUpsertBulk {
 	return u.Update(func(s *BillingRecordUpsert) {
@@ -951,6 +1043,27 @@ func (u *BillingRecordUpsertBulk) UpdateUserID() *BillingRecordUpsertBulk {
 	})
 }
 
+// SetRequestID sets the "request_id" field.
+func (u *BillingRecordUpsertBulk) SetRequestID(v string) *BillingRecordUpsertBulk {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *BillingRecordUpsertBulk) UpdateRequestID() *BillingRecordUpsertBulk {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *BillingRecordUpsertBulk) ClearRequestID() *BillingRecordUpsertBulk {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.ClearRequestID()
+	})
+}
+
 // SetModel sets the "model" field.
 func (u *BillingRecordUpsertBulk) SetModel(v string) *BillingRecordUpsertBulk {
 	return u.Update(func(s *BillingRecordUpsert) {
@@ -1070,6 +1183,13 @@ func (u *BillingRecordUpsertBulk) UpdateMetadata() *BillingRecordUpsertBulk {
 	})
 }
 
+// ClearMetadata clears the value of the "metadata" field.
+func (u *BillingRecordUpsertBulk) ClearMetadata() *BillingRecordUpsertBulk {
+	return u.Update(func(s *BillingRecordUpsert) {
+		s.ClearMetadata()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *BillingRecordUpsertBulk) SetCreatedAt(v time.Time) *BillingRecordUpsertBulk {
 	return u.Update(func(s *BillingRecordUpsert) {
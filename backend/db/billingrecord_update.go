@@ -43,6 +43,12 @@ func (bru *BillingRecordUpdate) SetNillableTenantID(s *string) *BillingRecordUpd
 	return bru
 }
 
+// ClearTenantID clears the value of the "tenant_id" field.
+func (bru *BillingRecordUpdate) ClearTenantID() *BillingRecordUpdate {
+	bru.mutation.ClearTenantID()
+	return bru
+}
+
 // SetUserID sets the "user_id" field.
 func (bru *BillingRecordUpdate) SetUserID(s string) *BillingRecordUpdate {
 	bru.mutation.SetUserID(s)
@@ -57,6 +63,26 @@ func (bru *BillingRecordUpdate) SetNillableUserID(s *string) *BillingRecordUpdat
 	return bru
 }
 
+// SetRequestID sets the "request_id" field.
+func (bru *BillingRecordUpdate) SetRequestID(s string) *BillingRecordUpdate {
+	bru.mutation.SetRequestID(s)
+	return bru
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (bru *BillingRecordUpdate) SetNillableRequestID(s *string) *BillingRecordUpdate {
+	if s != nil {
+		bru.SetRequestID(*s)
+	}
+	return bru
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (bru *BillingRecordUpdate) ClearRequestID() *BillingRecordUpdate {
+	bru.mutation.ClearRequestID()
+	return bru
+}
+
 // SetModel sets the "model" field.
 func (bru *BillingRecordUpdate) SetModel(s string) *BillingRecordUpdate {
 	bru.mutation.SetModel(s)
@@ -168,6 +194,12 @@ func (bru *BillingRecordUpdate) SetMetadata(m map[string]interface{}) *BillingRe
 	return bru
 }
 
+// ClearMetadata clears the value of the "metadata" field.
+func (bru *BillingRecordUpdate) ClearMetadata() *BillingRecordUpdate {
+	bru.mutation.ClearMetadata()
+	return bru
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (bru *BillingRecordUpdate) SetCreatedAt(t time.Time) *BillingRecordUpdate {
 	bru.mutation.SetCreatedAt(t)
@@ -247,9 +279,18 @@ func (bru *BillingRecordUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := bru.mutation.TenantID(); ok {
 		_spec.SetField(billingrecord.FieldTenantID, field.TypeString, value)
 	}
+	if bru.mutation.TenantIDCleared() {
+		_spec.ClearField(billingrecord.FieldTenantID, field.TypeString)
+	}
 	if value, ok := bru.mutation.UserID(); ok {
 		_spec.SetField(billingrecord.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := bru.mutation.RequestID(); ok {
+		_spec.SetField(billingrecord.FieldRequestID, field.TypeString, value)
+	}
+	if bru.mutation.RequestIDCleared() {
+		_spec.ClearField(billingrecord.FieldRequestID, field.TypeString)
+	}
 	if value, ok := bru.mutation.Model(); ok {
 		_spec.SetField(billingrecord.FieldModel, field.TypeString, value)
 	}
@@ -280,6 +321,9 @@ func (bru *BillingRecordUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := bru.mutation.Metadata(); ok {
 		_spec.SetField(billingrecord.FieldMetadata, field.TypeJSON, value)
 	}
+	if bru.mutation.MetadataCleared() {
+		_spec.ClearField(billingrecord.FieldMetadata, field.TypeJSON)
+	}
 	if value, ok := bru.mutation.CreatedAt(); ok {
 		_spec.SetField(billingrecord.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -322,6 +366,12 @@ func (bruo *BillingRecordUpdateOne) SetNillableTenantID(s *string) *BillingRecor
 	return bruo
 }
 
+// ClearTenantID clears the value of the "tenant_id" field.
+func (bruo *BillingRecordUpdateOne) ClearTenantID() *BillingRecordUpdateOne {
+	bruo.mutation.ClearTenantID()
+	return bruo
+}
+
 // SetUserID sets the "user_id" field.
 func (bruo *BillingRecordUpdateOne) SetUserID(s string) *BillingRecordUpdateOne {
 	bruo.mutation.SetUserID(s)
@@ -336,6 +386,26 @@ func (bruo *BillingRecordUpdateOne) SetNillableUserID(s *string) *BillingRecordU
 	return bruo
 }
 
+// SetRequestID sets the "request_id" field.
+func (bruo *BillingRecordUpdateOne) SetRequestID(s string) *BillingRecordUpdateOne {
+	bruo.mutation.SetRequestID(s)
+	return bruo
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (bruo *BillingRecordUpdateOne) SetNillableRequestID(s *string) *BillingRecordUpdateOne {
+	if s != nil {
+		bruo.SetRequestID(*s)
+	}
+	return bruo
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (bruo *BillingRecordUpdateOne) ClearRequestID() *BillingRecordUpdateOne {
+	bruo.mutation.ClearRequestID()
+	return bruo
+}
+
 // SetModel sets the "model" field.
 func (bruo *BillingRecordUpdateOne) SetModel(s string) *BillingRecordUpdateOne {
 	bruo.mutation.SetModel(s)
@@ -447,6 +517,12 @@ func (bruo *BillingRecordUpdateOne) SetMetadata(m map[string]interface{}) *Billi
 	return bruo
 }
 
+// ClearMetadata clears the value of the "metadata" field.
+func (bruo *BillingRecordUpdateOne) ClearMetadata() *BillingRecordUpdateOne {
+	bruo.mutation.ClearMetadata()
+	return bruo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (bruo *BillingRecordUpdateOne) SetCreatedAt(t time.Time) *BillingRecordUpdateOne {
 	bruo.mutation.SetCreatedAt(t)
@@ -556,9 +632,18 @@ func (bruo *BillingRecordUpdateOne) sqlSave(ctx context.Context) (_node *Billing
 	if value, ok := bruo.mutation.TenantID(); ok {
 		_spec.SetField(billingrecord.FieldTenantID, field.TypeString, value)
 	}
+	if bruo.mutation.TenantIDCleared() {
+		_spec.ClearField(billingrecord.FieldTenantID, field.TypeString)
+	}
 	if value, ok := bruo.mutation.UserID(); ok {
 		_spec.SetField(billingrecord.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := bruo.mutation.RequestID(); ok {
+		_spec.SetField(billingrecord.FieldRequestID, field.TypeString, value)
+	}
+	if bruo.mutation.RequestIDCleared() {
+		_spec.ClearField(billingrecord.FieldRequestID, field.TypeString)
+	}
 	if value, ok := bruo.mutation.Model(); ok {
 		_spec.SetField(billingrecord.FieldModel, field.TypeString, value)
 	}
@@ -589,6 +674,9 @@ func (bruo *BillingRecordUpdateOne) sqlSave(ctx context.Context) (_node *Billing
 	if value, ok := bruo.mutation.Metadata(); ok {
 		_spec.SetField(billingrecord.FieldMetadata, field.TypeJSON, value)
 	}
+	if bruo.mutation.MetadataCleared() {
+		_spec.ClearField(billingrecord.FieldMetadata, field.TypeJSON)
+	}
 	if value, ok := bruo.mutation.CreatedAt(); ok {
 		_spec.SetField(billingrecord.FieldCreatedAt, field.TypeTime, value)
 	}
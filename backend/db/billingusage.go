@@ -21,6 +21,8 @@ type BillingUsage struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID *string `json:"request_id,omitempty"`
 	// ModelName holds the value of the "model_name" field.
 	ModelName string `json:"model_name,omitempty"`
 	// Tokens holds the value of the "tokens" field.
@@ -41,7 +43,7 @@ func (*BillingUsage) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case billingusage.FieldTokens:
 			values[i] = new(sql.NullInt64)
-		case billingusage.FieldID, billingusage.FieldUserID, billingusage.FieldModelName, billingusage.FieldOperation:
+		case billingusage.FieldID, billingusage.FieldUserID, billingusage.FieldRequestID, billingusage.FieldModelName, billingusage.FieldOperation:
 			values[i] = new(sql.NullString)
 		case billingusage.FieldDeletedAt, billingusage.FieldCreatedAt, billingusage.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -78,6 +80,13 @@ func (bu *BillingUsage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				bu.UserID = value.String
 			}
+		case billingusage.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				bu.RequestID = new(string)
+				*bu.RequestID = value.String
+			}
 		case billingusage.FieldModelName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field model_name", values[i])
@@ -150,6 +159,11 @@ func (bu *BillingUsage) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(bu.UserID)
 	builder.WriteString(", ")
+	if v := bu.RequestID; v != nil {
+		builder.WriteString("request_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("model_name=")
 	builder.WriteString(bu.ModelName)
 	builder.WriteString(", ")
@@ -74,6 +74,11 @@ func UserID(v string) predicate.BillingUsage {
 	return predicate.BillingUsage(sql.FieldEQ(FieldUserID, v))
 }
 
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldEQ(FieldRequestID, v))
+}
+
 // ModelName applies equality check predicate on the "model_name" field. It's identical to ModelNameEQ.
 func ModelName(v string) predicate.BillingUsage {
 	return predicate.BillingUsage(sql.FieldEQ(FieldModelName, v))
@@ -214,6 +219,81 @@ func UserIDContainsFold(v string) predicate.BillingUsage {
 	return predicate.BillingUsage(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.BillingUsage {
+	return predicate.BillingUsage(sql.FieldContainsFold(FieldRequestID, v))
+}
+
 // ModelNameEQ applies the EQ predicate on the "model_name" field.
 func ModelNameEQ(v string) predicate.BillingUsage {
 	return predicate.BillingUsage(sql.FieldEQ(FieldModelName, v))
@@ -43,6 +43,20 @@ func (buc *BillingUsageCreate) SetUserID(s string) *BillingUsageCreate {
 	return buc
 }
 
+// SetRequestID sets the "request_id" field.
+func (buc *BillingUsageCreate) SetRequestID(s string) *BillingUsageCreate {
+	buc.mutation.SetRequestID(s)
+	return buc
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (buc *BillingUsageCreate) SetNillableRequestID(s *string) *BillingUsageCreate {
+	if s != nil {
+		buc.SetRequestID(*s)
+	}
+	return buc
+}
+
 // SetModelName sets the "model_name" field.
 func (buc *BillingUsageCreate) SetModelName(s string) *BillingUsageCreate {
 	buc.mutation.SetModelName(s)
@@ -213,6 +227,10 @@ func (buc *BillingUsageCreate) createSpec() (*BillingUsage, *sqlgraph.CreateSpec
 		_spec.SetField(billingusage.FieldUserID, field.TypeString, value)
 		_node.UserID = value
 	}
+	if value, ok := buc.mutation.RequestID(); ok {
+		_spec.SetField(billingusage.FieldRequestID, field.TypeString, value)
+		_node.RequestID = &value
+	}
 	if value, ok := buc.mutation.ModelName(); ok {
 		_spec.SetField(billingusage.FieldModelName, field.TypeString, value)
 		_node.ModelName = value
@@ -315,6 +333,24 @@ func (u *BillingUsageUpsert) UpdateUserID() *BillingUsageUpsert {
 	return u
 }
 
+// SetRequestID sets the "request_id" field.
+func (u *BillingUsageUpsert) SetRequestID(v string) *BillingUsageUpsert {
+	u.Set(billingusage.FieldRequestID, v)
+	return u
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *BillingUsageUpsert) UpdateRequestID() *BillingUsageUpsert {
+	u.SetExcluded(billingusage.FieldRequestID)
+	return u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *BillingUsageUpsert) ClearRequestID() *BillingUsageUpsert {
+	u.SetNull(billingusage.FieldRequestID)
+	return u
+}
+
 // SetModelName sets the "model_name" field.
 func (u *BillingUsageUpsert) SetModelName(v string) *BillingUsageUpsert {
 	u.Set(billingusage.FieldModelName, v)
@@ -464,6 +500,27 @@ func (u *BillingUsageUpsertOne) UpdateUserID() *BillingUsageUpsertOne {
 	})
 }
 
+// SetRequestID sets the "request_id" field.
+func (u *BillingUsageUpsertOne) SetRequestID(v string) *BillingUsageUpsertOne {
+	return u.Update(func(s *BillingUsageUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *BillingUsageUpsertOne) UpdateRequestID() *BillingUsageUpsertOne {
+	return u.Update(func(s *BillingUsageUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *BillingUsageUpsertOne) ClearRequestID() *BillingUsageUpsertOne {
+	return u.Update(func(s *BillingUsageUpsert) {
+		s.ClearRequestID()
+	})
+}
+
 // SetModelName sets the "model_name" field.
 func (u *BillingUsageUpsertOne) SetModelName(v string) *BillingUsageUpsertOne {
 	return u.Update(func(s *BillingUsageUpsert) {
@@ -791,6 +848,27 @@ func (u *BillingUsageUpsertBulk) UpdateUserID() *BillingUsageUpsertBulk {
 	})
 }
 
+// SetRequestID sets the "request_id" field.
+func (u *BillingUsageUpsertBulk) SetRequestID(v string) *BillingUsageUpsertBulk {
+	return u.Update(func(s *BillingUsageUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *BillingUsageUpsertBulk) UpdateRequestID() *BillingUsageUpsertBulk {
+	return u.Update(func(s *BillingUsageUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *BillingUsageUpsertBulk) ClearRequestID() *BillingUsageUpsertBulk {
+	return u.Update(func(s *BillingUsageUpsert) {
+		s.ClearRequestID()
+	})
+}
+
 // SetModelName sets the "model_name" field.
 func (u *BillingUsageUpsertBulk) SetModelName(v string) *BillingUsageUpsertBulk {
 	return u.Update(func(s *BillingUsageUpsert) {
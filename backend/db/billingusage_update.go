@@ -63,6 +63,26 @@ func (buu *BillingUsageUpdate) SetNillableUserID(s *string) *BillingUsageUpdate
 	return buu
 }
 
+// SetRequestID sets the "request_id" field.
+func (buu *BillingUsageUpdate) SetRequestID(s string) *BillingUsageUpdate {
+	buu.mutation.SetRequestID(s)
+	return buu
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (buu *BillingUsageUpdate) SetNillableRequestID(s *string) *BillingUsageUpdate {
+	if s != nil {
+		buu.SetRequestID(*s)
+	}
+	return buu
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (buu *BillingUsageUpdate) ClearRequestID() *BillingUsageUpdate {
+	buu.mutation.ClearRequestID()
+	return buu
+}
+
 // SetModelName sets the "model_name" field.
 func (buu *BillingUsageUpdate) SetModelName(s string) *BillingUsageUpdate {
 	buu.mutation.SetModelName(s)
@@ -203,6 +223,12 @@ func (buu *BillingUsageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := buu.mutation.UserID(); ok {
 		_spec.SetField(billingusage.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := buu.mutation.RequestID(); ok {
+		_spec.SetField(billingusage.FieldRequestID, field.TypeString, value)
+	}
+	if buu.mutation.RequestIDCleared() {
+		_spec.ClearField(billingusage.FieldRequestID, field.TypeString)
+	}
 	if value, ok := buu.mutation.ModelName(); ok {
 		_spec.SetField(billingusage.FieldModelName, field.TypeString, value)
 	}
@@ -277,6 +303,26 @@ func (buuo *BillingUsageUpdateOne) SetNillableUserID(s *string) *BillingUsageUpd
 	return buuo
 }
 
+// SetRequestID sets the "request_id" field.
+func (buuo *BillingUsageUpdateOne) SetRequestID(s string) *BillingUsageUpdateOne {
+	buuo.mutation.SetRequestID(s)
+	return buuo
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (buuo *BillingUsageUpdateOne) SetNillableRequestID(s *string) *BillingUsageUpdateOne {
+	if s != nil {
+		buuo.SetRequestID(*s)
+	}
+	return buuo
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (buuo *BillingUsageUpdateOne) ClearRequestID() *BillingUsageUpdateOne {
+	buuo.mutation.ClearRequestID()
+	return buuo
+}
+
 // SetModelName sets the "model_name" field.
 func (buuo *BillingUsageUpdateOne) SetModelName(s string) *BillingUsageUpdateOne {
 	buuo.mutation.SetModelName(s)
@@ -447,6 +493,12 @@ func (buuo *BillingUsageUpdateOne) sqlSave(ctx context.Context) (_node *BillingU
 	if value, ok := buuo.mutation.UserID(); ok {
 		_spec.SetField(billingusage.FieldUserID, field.TypeString, value)
 	}
+	if value, ok := buuo.mutation.RequestID(); ok {
+		_spec.SetField(billingusage.FieldRequestID, field.TypeString, value)
+	}
+	if buuo.mutation.RequestIDCleared() {
+		_spec.ClearField(billingusage.FieldRequestID, field.TypeString)
+	}
 	if value, ok := buuo.mutation.ModelName(); ok {
 		_spec.SetField(billingusage.FieldModelName, field.TypeString, value)
 	}
@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/google/uuid"
+)
+
+// BudgetAlert is the model entity for the BudgetAlert schema.
+type BudgetAlert struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// GroupName holds the value of the "group_name" field.
+	GroupName string `json:"group_name,omitempty"`
+	// Month holds the value of the "month" field.
+	Month string `json:"month,omitempty"`
+	// Threshold holds the value of the "threshold" field.
+	Threshold int `json:"threshold,omitempty"`
+	// Spend holds the value of the "spend" field.
+	Spend int64 `json:"spend,omitempty"`
+	// Budget holds the value of the "budget" field.
+	Budget int64 `json:"budget,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BudgetAlert) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case budgetalert.FieldThreshold, budgetalert.FieldSpend, budgetalert.FieldBudget:
+			values[i] = new(sql.NullInt64)
+		case budgetalert.FieldGroupName, budgetalert.FieldMonth, budgetalert.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case budgetalert.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case budgetalert.FieldID, budgetalert.FieldGroupID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BudgetAlert fields.
+func (ba *BudgetAlert) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case budgetalert.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ba.ID = *value
+			}
+		case budgetalert.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				ba.GroupID = *value
+			}
+		case budgetalert.FieldGroupName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field group_name", values[i])
+			} else if value.Valid {
+				ba.GroupName = value.String
+			}
+		case budgetalert.FieldMonth:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field month", values[i])
+			} else if value.Valid {
+				ba.Month = value.String
+			}
+		case budgetalert.FieldThreshold:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold", values[i])
+			} else if value.Valid {
+				ba.Threshold = int(value.Int64)
+			}
+		case budgetalert.FieldSpend:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field spend", values[i])
+			} else if value.Valid {
+				ba.Spend = value.Int64
+			}
+		case budgetalert.FieldBudget:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field budget", values[i])
+			} else if value.Valid {
+				ba.Budget = value.Int64
+			}
+		case budgetalert.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				ba.Currency = value.String
+			}
+		case budgetalert.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ba.CreatedAt = value.Time
+			}
+		default:
+			ba.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BudgetAlert.
+// This includes values selected through modifiers, order, etc.
+func (ba *BudgetAlert) Value(name string) (ent.Value, error) {
+	return ba.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BudgetAlert.
+// Note that you need to call BudgetAlert.Unwrap() before calling this method if this BudgetAlert
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ba *BudgetAlert) Update() *BudgetAlertUpdateOne {
+	return NewBudgetAlertClient(ba.config).UpdateOne(ba)
+}
+
+// Unwrap unwraps the BudgetAlert entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ba *BudgetAlert) Unwrap() *BudgetAlert {
+	_tx, ok := ba.config.driver.(*txDriver)
+	if !ok {
+		panic("db: BudgetAlert is not a transactional entity")
+	}
+	ba.config.driver = _tx.drv
+	return ba
+}
+
+// String implements the fmt.Stringer.
+func (ba *BudgetAlert) String() string {
+	var builder strings.Builder
+	builder.WriteString("BudgetAlert(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ba.ID))
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", ba.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("group_name=")
+	builder.WriteString(ba.GroupName)
+	builder.WriteString(", ")
+	builder.WriteString("month=")
+	builder.WriteString(ba.Month)
+	builder.WriteString(", ")
+	builder.WriteString("threshold=")
+	builder.WriteString(fmt.Sprintf("%v", ba.Threshold))
+	builder.WriteString(", ")
+	builder.WriteString("spend=")
+	builder.WriteString(fmt.Sprintf("%v", ba.Spend))
+	builder.WriteString(", ")
+	builder.WriteString("budget=")
+	builder.WriteString(fmt.Sprintf("%v", ba.Budget))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(ba.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ba.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BudgetAlerts is a parsable slice of BudgetAlert.
+type BudgetAlerts []*BudgetAlert
@@ -0,0 +1,526 @@
+// Code generated by ent, DO NOT EDIT.
+
+package budgetalert
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldID, id))
+}
+
+// GroupID applies equality check predicate on the "group_id" field. It's identical to GroupIDEQ.
+func GroupID(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupName applies equality check predicate on the "group_name" field. It's identical to GroupNameEQ.
+func GroupName(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldGroupName, v))
+}
+
+// Month applies equality check predicate on the "month" field. It's identical to MonthEQ.
+func Month(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldMonth, v))
+}
+
+// Threshold applies equality check predicate on the "threshold" field. It's identical to ThresholdEQ.
+func Threshold(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThreshold, v))
+}
+
+// Spend applies equality check predicate on the "spend" field. It's identical to SpendEQ.
+func Spend(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldSpend, v))
+}
+
+// Budget applies equality check predicate on the "budget" field. It's identical to BudgetEQ.
+func Budget(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldBudget, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GroupIDEQ applies the EQ predicate on the "group_id" field.
+func GroupIDEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldGroupID, v))
+}
+
+// GroupIDNEQ applies the NEQ predicate on the "group_id" field.
+func GroupIDNEQ(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldGroupID, v))
+}
+
+// GroupIDIn applies the In predicate on the "group_id" field.
+func GroupIDIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldGroupID, vs...))
+}
+
+// GroupIDNotIn applies the NotIn predicate on the "group_id" field.
+func GroupIDNotIn(vs ...uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldGroupID, vs...))
+}
+
+// GroupIDGT applies the GT predicate on the "group_id" field.
+func GroupIDGT(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldGroupID, v))
+}
+
+// GroupIDGTE applies the GTE predicate on the "group_id" field.
+func GroupIDGTE(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldGroupID, v))
+}
+
+// GroupIDLT applies the LT predicate on the "group_id" field.
+func GroupIDLT(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldGroupID, v))
+}
+
+// GroupIDLTE applies the LTE predicate on the "group_id" field.
+func GroupIDLTE(v uuid.UUID) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldGroupID, v))
+}
+
+// GroupNameEQ applies the EQ predicate on the "group_name" field.
+func GroupNameEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldGroupName, v))
+}
+
+// GroupNameNEQ applies the NEQ predicate on the "group_name" field.
+func GroupNameNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldGroupName, v))
+}
+
+// GroupNameIn applies the In predicate on the "group_name" field.
+func GroupNameIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldGroupName, vs...))
+}
+
+// GroupNameNotIn applies the NotIn predicate on the "group_name" field.
+func GroupNameNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldGroupName, vs...))
+}
+
+// GroupNameGT applies the GT predicate on the "group_name" field.
+func GroupNameGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldGroupName, v))
+}
+
+// GroupNameGTE applies the GTE predicate on the "group_name" field.
+func GroupNameGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldGroupName, v))
+}
+
+// GroupNameLT applies the LT predicate on the "group_name" field.
+func GroupNameLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldGroupName, v))
+}
+
+// GroupNameLTE applies the LTE predicate on the "group_name" field.
+func GroupNameLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldGroupName, v))
+}
+
+// GroupNameContains applies the Contains predicate on the "group_name" field.
+func GroupNameContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldGroupName, v))
+}
+
+// GroupNameHasPrefix applies the HasPrefix predicate on the "group_name" field.
+func GroupNameHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldGroupName, v))
+}
+
+// GroupNameHasSuffix applies the HasSuffix predicate on the "group_name" field.
+func GroupNameHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldGroupName, v))
+}
+
+// GroupNameIsNil applies the IsNil predicate on the "group_name" field.
+func GroupNameIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldGroupName))
+}
+
+// GroupNameNotNil applies the NotNil predicate on the "group_name" field.
+func GroupNameNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldGroupName))
+}
+
+// GroupNameEqualFold applies the EqualFold predicate on the "group_name" field.
+func GroupNameEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldGroupName, v))
+}
+
+// GroupNameContainsFold applies the ContainsFold predicate on the "group_name" field.
+func GroupNameContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldGroupName, v))
+}
+
+// MonthEQ applies the EQ predicate on the "month" field.
+func MonthEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldMonth, v))
+}
+
+// MonthNEQ applies the NEQ predicate on the "month" field.
+func MonthNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldMonth, v))
+}
+
+// MonthIn applies the In predicate on the "month" field.
+func MonthIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldMonth, vs...))
+}
+
+// MonthNotIn applies the NotIn predicate on the "month" field.
+func MonthNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldMonth, vs...))
+}
+
+// MonthGT applies the GT predicate on the "month" field.
+func MonthGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldMonth, v))
+}
+
+// MonthGTE applies the GTE predicate on the "month" field.
+func MonthGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldMonth, v))
+}
+
+// MonthLT applies the LT predicate on the "month" field.
+func MonthLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldMonth, v))
+}
+
+// MonthLTE applies the LTE predicate on the "month" field.
+func MonthLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldMonth, v))
+}
+
+// MonthContains applies the Contains predicate on the "month" field.
+func MonthContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldMonth, v))
+}
+
+// MonthHasPrefix applies the HasPrefix predicate on the "month" field.
+func MonthHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldMonth, v))
+}
+
+// MonthHasSuffix applies the HasSuffix predicate on the "month" field.
+func MonthHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldMonth, v))
+}
+
+// MonthEqualFold applies the EqualFold predicate on the "month" field.
+func MonthEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldMonth, v))
+}
+
+// MonthContainsFold applies the ContainsFold predicate on the "month" field.
+func MonthContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldMonth, v))
+}
+
+// ThresholdEQ applies the EQ predicate on the "threshold" field.
+func ThresholdEQ(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldThreshold, v))
+}
+
+// ThresholdNEQ applies the NEQ predicate on the "threshold" field.
+func ThresholdNEQ(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldThreshold, v))
+}
+
+// ThresholdIn applies the In predicate on the "threshold" field.
+func ThresholdIn(vs ...int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldThreshold, vs...))
+}
+
+// ThresholdNotIn applies the NotIn predicate on the "threshold" field.
+func ThresholdNotIn(vs ...int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldThreshold, vs...))
+}
+
+// ThresholdGT applies the GT predicate on the "threshold" field.
+func ThresholdGT(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldThreshold, v))
+}
+
+// ThresholdGTE applies the GTE predicate on the "threshold" field.
+func ThresholdGTE(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldThreshold, v))
+}
+
+// ThresholdLT applies the LT predicate on the "threshold" field.
+func ThresholdLT(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldThreshold, v))
+}
+
+// ThresholdLTE applies the LTE predicate on the "threshold" field.
+func ThresholdLTE(v int) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldThreshold, v))
+}
+
+// SpendEQ applies the EQ predicate on the "spend" field.
+func SpendEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldSpend, v))
+}
+
+// SpendNEQ applies the NEQ predicate on the "spend" field.
+func SpendNEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldSpend, v))
+}
+
+// SpendIn applies the In predicate on the "spend" field.
+func SpendIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldSpend, vs...))
+}
+
+// SpendNotIn applies the NotIn predicate on the "spend" field.
+func SpendNotIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldSpend, vs...))
+}
+
+// SpendGT applies the GT predicate on the "spend" field.
+func SpendGT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldSpend, v))
+}
+
+// SpendGTE applies the GTE predicate on the "spend" field.
+func SpendGTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldSpend, v))
+}
+
+// SpendLT applies the LT predicate on the "spend" field.
+func SpendLT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldSpend, v))
+}
+
+// SpendLTE applies the LTE predicate on the "spend" field.
+func SpendLTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldSpend, v))
+}
+
+// BudgetEQ applies the EQ predicate on the "budget" field.
+func BudgetEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldBudget, v))
+}
+
+// BudgetNEQ applies the NEQ predicate on the "budget" field.
+func BudgetNEQ(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldBudget, v))
+}
+
+// BudgetIn applies the In predicate on the "budget" field.
+func BudgetIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldBudget, vs...))
+}
+
+// BudgetNotIn applies the NotIn predicate on the "budget" field.
+func BudgetNotIn(vs ...int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldBudget, vs...))
+}
+
+// BudgetGT applies the GT predicate on the "budget" field.
+func BudgetGT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldBudget, v))
+}
+
+// BudgetGTE applies the GTE predicate on the "budget" field.
+func BudgetGTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldBudget, v))
+}
+
+// BudgetLT applies the LT predicate on the "budget" field.
+func BudgetLT(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldBudget, v))
+}
+
+// BudgetLTE applies the LTE predicate on the "budget" field.
+func BudgetLTE(v int64) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldBudget, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BudgetAlert) predicate.BudgetAlert {
+	return predicate.BudgetAlert(sql.NotPredicates(p))
+}
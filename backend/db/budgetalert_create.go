@@ -0,0 +1,1002 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/google/uuid"
+)
+
+// BudgetAlertCreate is the builder for creating a BudgetAlert entity.
+type BudgetAlertCreate struct {
+	config
+	mutation *BudgetAlertMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGroupID sets the "group_id" field.
+func (bac *BudgetAlertCreate) SetGroupID(u uuid.UUID) *BudgetAlertCreate {
+	bac.mutation.SetGroupID(u)
+	return bac
+}
+
+// SetGroupName sets the "group_name" field.
+func (bac *BudgetAlertCreate) SetGroupName(s string) *BudgetAlertCreate {
+	bac.mutation.SetGroupName(s)
+	return bac
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (bac *BudgetAlertCreate) SetNillableGroupName(s *string) *BudgetAlertCreate {
+	if s != nil {
+		bac.SetGroupName(*s)
+	}
+	return bac
+}
+
+// SetMonth sets the "month" field.
+func (bac *BudgetAlertCreate) SetMonth(s string) *BudgetAlertCreate {
+	bac.mutation.SetMonth(s)
+	return bac
+}
+
+// SetThreshold sets the "threshold" field.
+func (bac *BudgetAlertCreate) SetThreshold(i int) *BudgetAlertCreate {
+	bac.mutation.SetThreshold(i)
+	return bac
+}
+
+// SetSpend sets the "spend" field.
+func (bac *BudgetAlertCreate) SetSpend(i int64) *BudgetAlertCreate {
+	bac.mutation.SetSpend(i)
+	return bac
+}
+
+// SetBudget sets the "budget" field.
+func (bac *BudgetAlertCreate) SetBudget(i int64) *BudgetAlertCreate {
+	bac.mutation.SetBudget(i)
+	return bac
+}
+
+// SetCurrency sets the "currency" field.
+func (bac *BudgetAlertCreate) SetCurrency(s string) *BudgetAlertCreate {
+	bac.mutation.SetCurrency(s)
+	return bac
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bac *BudgetAlertCreate) SetNillableCurrency(s *string) *BudgetAlertCreate {
+	if s != nil {
+		bac.SetCurrency(*s)
+	}
+	return bac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bac *BudgetAlertCreate) SetCreatedAt(t time.Time) *BudgetAlertCreate {
+	bac.mutation.SetCreatedAt(t)
+	return bac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bac *BudgetAlertCreate) SetNillableCreatedAt(t *time.Time) *BudgetAlertCreate {
+	if t != nil {
+		bac.SetCreatedAt(*t)
+	}
+	return bac
+}
+
+// SetID sets the "id" field.
+func (bac *BudgetAlertCreate) SetID(u uuid.UUID) *BudgetAlertCreate {
+	bac.mutation.SetID(u)
+	return bac
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (bac *BudgetAlertCreate) SetNillableID(u *uuid.UUID) *BudgetAlertCreate {
+	if u != nil {
+		bac.SetID(*u)
+	}
+	return bac
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (bac *BudgetAlertCreate) Mutation() *BudgetAlertMutation {
+	return bac.mutation
+}
+
+// Save creates the BudgetAlert in the database.
+func (bac *BudgetAlertCreate) Save(ctx context.Context) (*BudgetAlert, error) {
+	bac.defaults()
+	return withHooks(ctx, bac.sqlSave, bac.mutation, bac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bac *BudgetAlertCreate) SaveX(ctx context.Context) *BudgetAlert {
+	v, err := bac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bac *BudgetAlertCreate) Exec(ctx context.Context) error {
+	_, err := bac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bac *BudgetAlertCreate) ExecX(ctx context.Context) {
+	if err := bac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bac *BudgetAlertCreate) defaults() {
+	if _, ok := bac.mutation.CreatedAt(); !ok {
+		v := budgetalert.DefaultCreatedAt()
+		bac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bac.mutation.ID(); !ok {
+		v := budgetalert.DefaultID()
+		bac.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bac *BudgetAlertCreate) check() error {
+	if _, ok := bac.mutation.GroupID(); !ok {
+		return &ValidationError{Name: "group_id", err: errors.New(`db: missing required field "BudgetAlert.group_id"`)}
+	}
+	if _, ok := bac.mutation.Month(); !ok {
+		return &ValidationError{Name: "month", err: errors.New(`db: missing required field "BudgetAlert.month"`)}
+	}
+	if _, ok := bac.mutation.Threshold(); !ok {
+		return &ValidationError{Name: "threshold", err: errors.New(`db: missing required field "BudgetAlert.threshold"`)}
+	}
+	if _, ok := bac.mutation.Spend(); !ok {
+		return &ValidationError{Name: "spend", err: errors.New(`db: missing required field "BudgetAlert.spend"`)}
+	}
+	if _, ok := bac.mutation.Budget(); !ok {
+		return &ValidationError{Name: "budget", err: errors.New(`db: missing required field "BudgetAlert.budget"`)}
+	}
+	if _, ok := bac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "BudgetAlert.created_at"`)}
+	}
+	return nil
+}
+
+func (bac *BudgetAlertCreate) sqlSave(ctx context.Context) (*BudgetAlert, error) {
+	if err := bac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	bac.mutation.id = &_node.ID
+	bac.mutation.done = true
+	return _node, nil
+}
+
+func (bac *BudgetAlertCreate) createSpec() (*BudgetAlert, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BudgetAlert{config: bac.config}
+		_spec = sqlgraph.NewCreateSpec(budgetalert.Table, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = bac.conflict
+	if id, ok := bac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := bac.mutation.GroupID(); ok {
+		_spec.SetField(budgetalert.FieldGroupID, field.TypeUUID, value)
+		_node.GroupID = value
+	}
+	if value, ok := bac.mutation.GroupName(); ok {
+		_spec.SetField(budgetalert.FieldGroupName, field.TypeString, value)
+		_node.GroupName = value
+	}
+	if value, ok := bac.mutation.Month(); ok {
+		_spec.SetField(budgetalert.FieldMonth, field.TypeString, value)
+		_node.Month = value
+	}
+	if value, ok := bac.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeInt, value)
+		_node.Threshold = value
+	}
+	if value, ok := bac.mutation.Spend(); ok {
+		_spec.SetField(budgetalert.FieldSpend, field.TypeInt64, value)
+		_node.Spend = value
+	}
+	if value, ok := bac.mutation.Budget(); ok {
+		_spec.SetField(budgetalert.FieldBudget, field.TypeInt64, value)
+		_node.Budget = value
+	}
+	if value, ok := bac.mutation.Currency(); ok {
+		_spec.SetField(budgetalert.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := bac.mutation.CreatedAt(); ok {
+		_spec.SetField(budgetalert.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetAlert.Create().
+//		SetGroupID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetAlertUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (bac *BudgetAlertCreate) OnConflict(opts ...sql.ConflictOption) *BudgetAlertUpsertOne {
+	bac.conflict = opts
+	return &BudgetAlertUpsertOne{
+		create: bac,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bac *BudgetAlertCreate) OnConflictColumns(columns ...string) *BudgetAlertUpsertOne {
+	bac.conflict = append(bac.conflict, sql.ConflictColumns(columns...))
+	return &BudgetAlertUpsertOne{
+		create: bac,
+	}
+}
+
+type (
+	// BudgetAlertUpsertOne is the builder for "upsert"-ing
+	//  one BudgetAlert node.
+	BudgetAlertUpsertOne struct {
+		create *BudgetAlertCreate
+	}
+
+	// BudgetAlertUpsert is the "OnConflict" setter.
+	BudgetAlertUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetGroupID sets the "group_id" field.
+func (u *BudgetAlertUpsert) SetGroupID(v uuid.UUID) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateGroupID() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldGroupID)
+	return u
+}
+
+// SetGroupName sets the "group_name" field.
+func (u *BudgetAlertUpsert) SetGroupName(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldGroupName, v)
+	return u
+}
+
+// UpdateGroupName sets the "group_name" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateGroupName() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldGroupName)
+	return u
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (u *BudgetAlertUpsert) ClearGroupName() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldGroupName)
+	return u
+}
+
+// SetMonth sets the "month" field.
+func (u *BudgetAlertUpsert) SetMonth(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldMonth, v)
+	return u
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateMonth() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldMonth)
+	return u
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsert) SetThreshold(v int) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldThreshold, v)
+	return u
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateThreshold() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldThreshold)
+	return u
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsert) AddThreshold(v int) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldThreshold, v)
+	return u
+}
+
+// SetSpend sets the "spend" field.
+func (u *BudgetAlertUpsert) SetSpend(v int64) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldSpend, v)
+	return u
+}
+
+// UpdateSpend sets the "spend" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateSpend() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldSpend)
+	return u
+}
+
+// AddSpend adds v to the "spend" field.
+func (u *BudgetAlertUpsert) AddSpend(v int64) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldSpend, v)
+	return u
+}
+
+// SetBudget sets the "budget" field.
+func (u *BudgetAlertUpsert) SetBudget(v int64) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldBudget, v)
+	return u
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateBudget() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldBudget)
+	return u
+}
+
+// AddBudget adds v to the "budget" field.
+func (u *BudgetAlertUpsert) AddBudget(v int64) *BudgetAlertUpsert {
+	u.Add(budgetalert.FieldBudget, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BudgetAlertUpsert) SetCurrency(v string) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateCurrency() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *BudgetAlertUpsert) ClearCurrency() *BudgetAlertUpsert {
+	u.SetNull(budgetalert.FieldCurrency)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BudgetAlertUpsert) SetCreatedAt(v time.Time) *BudgetAlertUpsert {
+	u.Set(budgetalert.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsert) UpdateCreatedAt() *BudgetAlertUpsert {
+	u.SetExcluded(budgetalert.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetalert.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertOne) UpdateNewValues() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(budgetalert.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BudgetAlertUpsertOne) Ignore() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetAlertUpsertOne) DoNothing() *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetAlertCreate.OnConflict
+// documentation for more info.
+func (u *BudgetAlertUpsertOne) Update(set func(*BudgetAlertUpsert)) *BudgetAlertUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetAlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *BudgetAlertUpsertOne) SetGroupID(v uuid.UUID) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateGroupID() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetGroupName sets the "group_name" field.
+func (u *BudgetAlertUpsertOne) SetGroupName(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetGroupName(v)
+	})
+}
+
+// UpdateGroupName sets the "group_name" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateGroupName() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateGroupName()
+	})
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (u *BudgetAlertUpsertOne) ClearGroupName() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearGroupName()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *BudgetAlertUpsertOne) SetMonth(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateMonth() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsertOne) SetThreshold(v int) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsertOne) AddThreshold(v int) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateThreshold() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetSpend sets the "spend" field.
+func (u *BudgetAlertUpsertOne) SetSpend(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetSpend(v)
+	})
+}
+
+// AddSpend adds v to the "spend" field.
+func (u *BudgetAlertUpsertOne) AddSpend(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddSpend(v)
+	})
+}
+
+// UpdateSpend sets the "spend" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateSpend() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateSpend()
+	})
+}
+
+// SetBudget sets the "budget" field.
+func (u *BudgetAlertUpsertOne) SetBudget(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetBudget(v)
+	})
+}
+
+// AddBudget adds v to the "budget" field.
+func (u *BudgetAlertUpsertOne) AddBudget(v int64) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddBudget(v)
+	})
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateBudget() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateBudget()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BudgetAlertUpsertOne) SetCurrency(v string) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateCurrency() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *BudgetAlertUpsertOne) ClearCurrency() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BudgetAlertUpsertOne) SetCreatedAt(v time.Time) *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertOne) UpdateCreatedAt() *BudgetAlertUpsertOne {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetAlertUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for BudgetAlertCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetAlertUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BudgetAlertUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: BudgetAlertUpsertOne.ID is not supported by MySQL driver. Use BudgetAlertUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BudgetAlertUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// BudgetAlertCreateBulk is the builder for creating many BudgetAlert entities in bulk.
+type BudgetAlertCreateBulk struct {
+	config
+	err      error
+	builders []*BudgetAlertCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the BudgetAlert entities in the database.
+func (bacb *BudgetAlertCreateBulk) Save(ctx context.Context) ([]*BudgetAlert, error) {
+	if bacb.err != nil {
+		return nil, bacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bacb.builders))
+	nodes := make([]*BudgetAlert, len(bacb.builders))
+	mutators := make([]Mutator, len(bacb.builders))
+	for i := range bacb.builders {
+		func(i int, root context.Context) {
+			builder := bacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BudgetAlertMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bacb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bacb *BudgetAlertCreateBulk) SaveX(ctx context.Context) []*BudgetAlert {
+	v, err := bacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bacb *BudgetAlertCreateBulk) Exec(ctx context.Context) error {
+	_, err := bacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bacb *BudgetAlertCreateBulk) ExecX(ctx context.Context) {
+	if err := bacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BudgetAlert.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BudgetAlertUpsert) {
+//			SetGroupID(v+v).
+//		}).
+//		Exec(ctx)
+func (bacb *BudgetAlertCreateBulk) OnConflict(opts ...sql.ConflictOption) *BudgetAlertUpsertBulk {
+	bacb.conflict = opts
+	return &BudgetAlertUpsertBulk{
+		create: bacb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bacb *BudgetAlertCreateBulk) OnConflictColumns(columns ...string) *BudgetAlertUpsertBulk {
+	bacb.conflict = append(bacb.conflict, sql.ConflictColumns(columns...))
+	return &BudgetAlertUpsertBulk{
+		create: bacb,
+	}
+}
+
+// BudgetAlertUpsertBulk is the builder for "upsert"-ing
+// a bulk of BudgetAlert nodes.
+type BudgetAlertUpsertBulk struct {
+	create *BudgetAlertCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(budgetalert.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertBulk) UpdateNewValues() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(budgetalert.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BudgetAlert.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BudgetAlertUpsertBulk) Ignore() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BudgetAlertUpsertBulk) DoNothing() *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BudgetAlertCreateBulk.OnConflict
+// documentation for more info.
+func (u *BudgetAlertUpsertBulk) Update(set func(*BudgetAlertUpsert)) *BudgetAlertUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BudgetAlertUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *BudgetAlertUpsertBulk) SetGroupID(v uuid.UUID) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateGroupID() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// SetGroupName sets the "group_name" field.
+func (u *BudgetAlertUpsertBulk) SetGroupName(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetGroupName(v)
+	})
+}
+
+// UpdateGroupName sets the "group_name" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateGroupName() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateGroupName()
+	})
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (u *BudgetAlertUpsertBulk) ClearGroupName() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearGroupName()
+	})
+}
+
+// SetMonth sets the "month" field.
+func (u *BudgetAlertUpsertBulk) SetMonth(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetMonth(v)
+	})
+}
+
+// UpdateMonth sets the "month" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateMonth() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateMonth()
+	})
+}
+
+// SetThreshold sets the "threshold" field.
+func (u *BudgetAlertUpsertBulk) SetThreshold(v int) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetThreshold(v)
+	})
+}
+
+// AddThreshold adds v to the "threshold" field.
+func (u *BudgetAlertUpsertBulk) AddThreshold(v int) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddThreshold(v)
+	})
+}
+
+// UpdateThreshold sets the "threshold" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateThreshold() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateThreshold()
+	})
+}
+
+// SetSpend sets the "spend" field.
+func (u *BudgetAlertUpsertBulk) SetSpend(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetSpend(v)
+	})
+}
+
+// AddSpend adds v to the "spend" field.
+func (u *BudgetAlertUpsertBulk) AddSpend(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddSpend(v)
+	})
+}
+
+// UpdateSpend sets the "spend" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateSpend() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateSpend()
+	})
+}
+
+// SetBudget sets the "budget" field.
+func (u *BudgetAlertUpsertBulk) SetBudget(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetBudget(v)
+	})
+}
+
+// AddBudget adds v to the "budget" field.
+func (u *BudgetAlertUpsertBulk) AddBudget(v int64) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.AddBudget(v)
+	})
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateBudget() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateBudget()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *BudgetAlertUpsertBulk) SetCurrency(v string) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateCurrency() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *BudgetAlertUpsertBulk) ClearCurrency() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *BudgetAlertUpsertBulk) SetCreatedAt(v time.Time) *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *BudgetAlertUpsertBulk) UpdateCreatedAt() *BudgetAlertUpsertBulk {
+	return u.Update(func(s *BudgetAlertUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *BudgetAlertUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the BudgetAlertCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for BudgetAlertCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BudgetAlertUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
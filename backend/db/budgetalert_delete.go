@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+)
+
+// BudgetAlertDelete is the builder for deleting a BudgetAlert entity.
+type BudgetAlertDelete struct {
+	config
+	hooks    []Hook
+	mutation *BudgetAlertMutation
+}
+
+// Where appends a list predicates to the BudgetAlertDelete builder.
+func (bad *BudgetAlertDelete) Where(ps ...predicate.BudgetAlert) *BudgetAlertDelete {
+	bad.mutation.Where(ps...)
+	return bad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (bad *BudgetAlertDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, bad.sqlExec, bad.mutation, bad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bad *BudgetAlertDelete) ExecX(ctx context.Context) int {
+	n, err := bad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (bad *BudgetAlertDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(budgetalert.Table, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	if ps := bad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, bad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	bad.mutation.done = true
+	return affected, err
+}
+
+// BudgetAlertDeleteOne is the builder for deleting a single BudgetAlert entity.
+type BudgetAlertDeleteOne struct {
+	bad *BudgetAlertDelete
+}
+
+// Where appends a list predicates to the BudgetAlertDelete builder.
+func (bado *BudgetAlertDeleteOne) Where(ps ...predicate.BudgetAlert) *BudgetAlertDeleteOne {
+	bado.bad.mutation.Where(ps...)
+	return bado
+}
+
+// Exec executes the deletion query.
+func (bado *BudgetAlertDeleteOne) Exec(ctx context.Context) error {
+	n, err := bado.bad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{budgetalert.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bado *BudgetAlertDeleteOne) ExecX(ctx context.Context) {
+	if err := bado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// BudgetAlertQuery is the builder for querying BudgetAlert entities.
+type BudgetAlertQuery struct {
+	config
+	ctx        *QueryContext
+	order      []budgetalert.OrderOption
+	inters     []Interceptor
+	predicates []predicate.BudgetAlert
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the BudgetAlertQuery builder.
+func (baq *BudgetAlertQuery) Where(ps ...predicate.BudgetAlert) *BudgetAlertQuery {
+	baq.predicates = append(baq.predicates, ps...)
+	return baq
+}
+
+// Limit the number of records to be returned by this query.
+func (baq *BudgetAlertQuery) Limit(limit int) *BudgetAlertQuery {
+	baq.ctx.Limit = &limit
+	return baq
+}
+
+// Offset to start from.
+func (baq *BudgetAlertQuery) Offset(offset int) *BudgetAlertQuery {
+	baq.ctx.Offset = &offset
+	return baq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (baq *BudgetAlertQuery) Unique(unique bool) *BudgetAlertQuery {
+	baq.ctx.Unique = &unique
+	return baq
+}
+
+// Order specifies how the records should be ordered.
+func (baq *BudgetAlertQuery) Order(o ...budgetalert.OrderOption) *BudgetAlertQuery {
+	baq.order = append(baq.order, o...)
+	return baq
+}
+
+// First returns the first BudgetAlert entity from the query.
+// Returns a *NotFoundError when no BudgetAlert was found.
+func (baq *BudgetAlertQuery) First(ctx context.Context) (*BudgetAlert, error) {
+	nodes, err := baq.Limit(1).All(setContextOp(ctx, baq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{budgetalert.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (baq *BudgetAlertQuery) FirstX(ctx context.Context) *BudgetAlert {
+	node, err := baq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first BudgetAlert ID from the query.
+// Returns a *NotFoundError when no BudgetAlert ID was found.
+func (baq *BudgetAlertQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = baq.Limit(1).IDs(setContextOp(ctx, baq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{budgetalert.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (baq *BudgetAlertQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := baq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single BudgetAlert entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one BudgetAlert entity is found.
+// Returns a *NotFoundError when no BudgetAlert entities are found.
+func (baq *BudgetAlertQuery) Only(ctx context.Context) (*BudgetAlert, error) {
+	nodes, err := baq.Limit(2).All(setContextOp(ctx, baq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{budgetalert.Label}
+	default:
+		return nil, &NotSingularError{budgetalert.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (baq *BudgetAlertQuery) OnlyX(ctx context.Context) *BudgetAlert {
+	node, err := baq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only BudgetAlert ID in the query.
+// Returns a *NotSingularError when more than one BudgetAlert ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (baq *BudgetAlertQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = baq.Limit(2).IDs(setContextOp(ctx, baq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{budgetalert.Label}
+	default:
+		err = &NotSingularError{budgetalert.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (baq *BudgetAlertQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := baq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of BudgetAlerts.
+func (baq *BudgetAlertQuery) All(ctx context.Context) ([]*BudgetAlert, error) {
+	ctx = setContextOp(ctx, baq.ctx, ent.OpQueryAll)
+	if err := baq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*BudgetAlert, *BudgetAlertQuery]()
+	return withInterceptors[[]*BudgetAlert](ctx, baq, qr, baq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (baq *BudgetAlertQuery) AllX(ctx context.Context) []*BudgetAlert {
+	nodes, err := baq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of BudgetAlert IDs.
+func (baq *BudgetAlertQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if baq.ctx.Unique == nil && baq.path != nil {
+		baq.Unique(true)
+	}
+	ctx = setContextOp(ctx, baq.ctx, ent.OpQueryIDs)
+	if err = baq.Select(budgetalert.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (baq *BudgetAlertQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := baq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (baq *BudgetAlertQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, baq.ctx, ent.OpQueryCount)
+	if err := baq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, baq, querierCount[*BudgetAlertQuery](), baq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (baq *BudgetAlertQuery) CountX(ctx context.Context) int {
+	count, err := baq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (baq *BudgetAlertQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, baq.ctx, ent.OpQueryExist)
+	switch _, err := baq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (baq *BudgetAlertQuery) ExistX(ctx context.Context) bool {
+	exist, err := baq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the BudgetAlertQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (baq *BudgetAlertQuery) Clone() *BudgetAlertQuery {
+	if baq == nil {
+		return nil
+	}
+	return &BudgetAlertQuery{
+		config:     baq.config,
+		ctx:        baq.ctx.Clone(),
+		order:      append([]budgetalert.OrderOption{}, baq.order...),
+		inters:     append([]Interceptor{}, baq.inters...),
+		predicates: append([]predicate.BudgetAlert{}, baq.predicates...),
+		// clone intermediate query.
+		sql:       baq.sql.Clone(),
+		path:      baq.path,
+		modifiers: append([]func(*sql.Selector){}, baq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID uuid.UUID `json:"group_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.BudgetAlert.Query().
+//		GroupBy(budgetalert.FieldGroupID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (baq *BudgetAlertQuery) GroupBy(field string, fields ...string) *BudgetAlertGroupBy {
+	baq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &BudgetAlertGroupBy{build: baq}
+	grbuild.flds = &baq.ctx.Fields
+	grbuild.label = budgetalert.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GroupID uuid.UUID `json:"group_id,omitempty"`
+//	}
+//
+//	client.BudgetAlert.Query().
+//		Select(budgetalert.FieldGroupID).
+//		Scan(ctx, &v)
+func (baq *BudgetAlertQuery) Select(fields ...string) *BudgetAlertSelect {
+	baq.ctx.Fields = append(baq.ctx.Fields, fields...)
+	sbuild := &BudgetAlertSelect{BudgetAlertQuery: baq}
+	sbuild.label = budgetalert.Label
+	sbuild.flds, sbuild.scan = &baq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a BudgetAlertSelect configured with the given aggregations.
+func (baq *BudgetAlertQuery) Aggregate(fns ...AggregateFunc) *BudgetAlertSelect {
+	return baq.Select().Aggregate(fns...)
+}
+
+func (baq *BudgetAlertQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range baq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, baq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range baq.ctx.Fields {
+		if !budgetalert.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if baq.path != nil {
+		prev, err := baq.path(ctx)
+		if err != nil {
+			return err
+		}
+		baq.sql = prev
+	}
+	return nil
+}
+
+func (baq *BudgetAlertQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*BudgetAlert, error) {
+	var (
+		nodes = []*BudgetAlert{}
+		_spec = baq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*BudgetAlert).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &BudgetAlert{config: baq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(baq.modifiers) > 0 {
+		_spec.Modifiers = baq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, baq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (baq *BudgetAlertQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := baq.querySpec()
+	if len(baq.modifiers) > 0 {
+		_spec.Modifiers = baq.modifiers
+	}
+	_spec.Node.Columns = baq.ctx.Fields
+	if len(baq.ctx.Fields) > 0 {
+		_spec.Unique = baq.ctx.Unique != nil && *baq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, baq.driver, _spec)
+}
+
+func (baq *BudgetAlertQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	_spec.From = baq.sql
+	if unique := baq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if baq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := baq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetalert.FieldID)
+		for i := range fields {
+			if fields[i] != budgetalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := baq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := baq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := baq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := baq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (baq *BudgetAlertQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(baq.driver.Dialect())
+	t1 := builder.Table(budgetalert.Table)
+	columns := baq.ctx.Fields
+	if len(columns) == 0 {
+		columns = budgetalert.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if baq.sql != nil {
+		selector = baq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if baq.ctx.Unique != nil && *baq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range baq.modifiers {
+		m(selector)
+	}
+	for _, p := range baq.predicates {
+		p(selector)
+	}
+	for _, p := range baq.order {
+		p(selector)
+	}
+	if offset := baq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := baq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (baq *BudgetAlertQuery) ForUpdate(opts ...sql.LockOption) *BudgetAlertQuery {
+	if baq.driver.Dialect() == dialect.Postgres {
+		baq.Unique(false)
+	}
+	baq.modifiers = append(baq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return baq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (baq *BudgetAlertQuery) ForShare(opts ...sql.LockOption) *BudgetAlertQuery {
+	if baq.driver.Dialect() == dialect.Postgres {
+		baq.Unique(false)
+	}
+	baq.modifiers = append(baq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return baq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (baq *BudgetAlertQuery) Modify(modifiers ...func(s *sql.Selector)) *BudgetAlertSelect {
+	baq.modifiers = append(baq.modifiers, modifiers...)
+	return baq.Select()
+}
+
+// BudgetAlertGroupBy is the group-by builder for BudgetAlert entities.
+type BudgetAlertGroupBy struct {
+	selector
+	build *BudgetAlertQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bagb *BudgetAlertGroupBy) Aggregate(fns ...AggregateFunc) *BudgetAlertGroupBy {
+	bagb.fns = append(bagb.fns, fns...)
+	return bagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bagb *BudgetAlertGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bagb.build.ctx, ent.OpQueryGroupBy)
+	if err := bagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BudgetAlertQuery, *BudgetAlertGroupBy](ctx, bagb.build, bagb, bagb.build.inters, v)
+}
+
+func (bagb *BudgetAlertGroupBy) sqlScan(ctx context.Context, root *BudgetAlertQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(bagb.fns))
+	for _, fn := range bagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*bagb.flds)+len(bagb.fns))
+		for _, f := range *bagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*bagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// BudgetAlertSelect is the builder for selecting fields of BudgetAlert entities.
+type BudgetAlertSelect struct {
+	*BudgetAlertQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (bas *BudgetAlertSelect) Aggregate(fns ...AggregateFunc) *BudgetAlertSelect {
+	bas.fns = append(bas.fns, fns...)
+	return bas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (bas *BudgetAlertSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, bas.ctx, ent.OpQuerySelect)
+	if err := bas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*BudgetAlertQuery, *BudgetAlertSelect](ctx, bas.BudgetAlertQuery, bas, bas.inters, v)
+}
+
+func (bas *BudgetAlertSelect) sqlScan(ctx context.Context, root *BudgetAlertQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(bas.fns))
+	for _, fn := range bas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*bas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := bas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (bas *BudgetAlertSelect) Modify(modifiers ...func(s *sql.Selector)) *BudgetAlertSelect {
+	bas.modifiers = append(bas.modifiers, modifiers...)
+	return bas
+}
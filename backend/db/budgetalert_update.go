@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// BudgetAlertUpdate is the builder for updating BudgetAlert entities.
+type BudgetAlertUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BudgetAlertMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BudgetAlertUpdate builder.
+func (bau *BudgetAlertUpdate) Where(ps ...predicate.BudgetAlert) *BudgetAlertUpdate {
+	bau.mutation.Where(ps...)
+	return bau
+}
+
+// SetGroupID sets the "group_id" field.
+func (bau *BudgetAlertUpdate) SetGroupID(u uuid.UUID) *BudgetAlertUpdate {
+	bau.mutation.SetGroupID(u)
+	return bau
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableGroupID(u *uuid.UUID) *BudgetAlertUpdate {
+	if u != nil {
+		bau.SetGroupID(*u)
+	}
+	return bau
+}
+
+// SetGroupName sets the "group_name" field.
+func (bau *BudgetAlertUpdate) SetGroupName(s string) *BudgetAlertUpdate {
+	bau.mutation.SetGroupName(s)
+	return bau
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableGroupName(s *string) *BudgetAlertUpdate {
+	if s != nil {
+		bau.SetGroupName(*s)
+	}
+	return bau
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (bau *BudgetAlertUpdate) ClearGroupName() *BudgetAlertUpdate {
+	bau.mutation.ClearGroupName()
+	return bau
+}
+
+// SetMonth sets the "month" field.
+func (bau *BudgetAlertUpdate) SetMonth(s string) *BudgetAlertUpdate {
+	bau.mutation.SetMonth(s)
+	return bau
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableMonth(s *string) *BudgetAlertUpdate {
+	if s != nil {
+		bau.SetMonth(*s)
+	}
+	return bau
+}
+
+// SetThreshold sets the "threshold" field.
+func (bau *BudgetAlertUpdate) SetThreshold(i int) *BudgetAlertUpdate {
+	bau.mutation.ResetThreshold()
+	bau.mutation.SetThreshold(i)
+	return bau
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableThreshold(i *int) *BudgetAlertUpdate {
+	if i != nil {
+		bau.SetThreshold(*i)
+	}
+	return bau
+}
+
+// AddThreshold adds i to the "threshold" field.
+func (bau *BudgetAlertUpdate) AddThreshold(i int) *BudgetAlertUpdate {
+	bau.mutation.AddThreshold(i)
+	return bau
+}
+
+// SetSpend sets the "spend" field.
+func (bau *BudgetAlertUpdate) SetSpend(i int64) *BudgetAlertUpdate {
+	bau.mutation.ResetSpend()
+	bau.mutation.SetSpend(i)
+	return bau
+}
+
+// SetNillableSpend sets the "spend" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableSpend(i *int64) *BudgetAlertUpdate {
+	if i != nil {
+		bau.SetSpend(*i)
+	}
+	return bau
+}
+
+// AddSpend adds i to the "spend" field.
+func (bau *BudgetAlertUpdate) AddSpend(i int64) *BudgetAlertUpdate {
+	bau.mutation.AddSpend(i)
+	return bau
+}
+
+// SetBudget sets the "budget" field.
+func (bau *BudgetAlertUpdate) SetBudget(i int64) *BudgetAlertUpdate {
+	bau.mutation.ResetBudget()
+	bau.mutation.SetBudget(i)
+	return bau
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableBudget(i *int64) *BudgetAlertUpdate {
+	if i != nil {
+		bau.SetBudget(*i)
+	}
+	return bau
+}
+
+// AddBudget adds i to the "budget" field.
+func (bau *BudgetAlertUpdate) AddBudget(i int64) *BudgetAlertUpdate {
+	bau.mutation.AddBudget(i)
+	return bau
+}
+
+// SetCurrency sets the "currency" field.
+func (bau *BudgetAlertUpdate) SetCurrency(s string) *BudgetAlertUpdate {
+	bau.mutation.SetCurrency(s)
+	return bau
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableCurrency(s *string) *BudgetAlertUpdate {
+	if s != nil {
+		bau.SetCurrency(*s)
+	}
+	return bau
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (bau *BudgetAlertUpdate) ClearCurrency() *BudgetAlertUpdate {
+	bau.mutation.ClearCurrency()
+	return bau
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bau *BudgetAlertUpdate) SetCreatedAt(t time.Time) *BudgetAlertUpdate {
+	bau.mutation.SetCreatedAt(t)
+	return bau
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bau *BudgetAlertUpdate) SetNillableCreatedAt(t *time.Time) *BudgetAlertUpdate {
+	if t != nil {
+		bau.SetCreatedAt(*t)
+	}
+	return bau
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (bau *BudgetAlertUpdate) Mutation() *BudgetAlertMutation {
+	return bau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bau *BudgetAlertUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bau.sqlSave, bau.mutation, bau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bau *BudgetAlertUpdate) SaveX(ctx context.Context) int {
+	affected, err := bau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bau *BudgetAlertUpdate) Exec(ctx context.Context) error {
+	_, err := bau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bau *BudgetAlertUpdate) ExecX(ctx context.Context) {
+	if err := bau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bau *BudgetAlertUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetAlertUpdate {
+	bau.modifiers = append(bau.modifiers, modifiers...)
+	return bau
+}
+
+func (bau *BudgetAlertUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	if ps := bau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bau.mutation.GroupID(); ok {
+		_spec.SetField(budgetalert.FieldGroupID, field.TypeUUID, value)
+	}
+	if value, ok := bau.mutation.GroupName(); ok {
+		_spec.SetField(budgetalert.FieldGroupName, field.TypeString, value)
+	}
+	if bau.mutation.GroupNameCleared() {
+		_spec.ClearField(budgetalert.FieldGroupName, field.TypeString)
+	}
+	if value, ok := bau.mutation.Month(); ok {
+		_spec.SetField(budgetalert.FieldMonth, field.TypeString, value)
+	}
+	if value, ok := bau.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := bau.mutation.AddedThreshold(); ok {
+		_spec.AddField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := bau.mutation.Spend(); ok {
+		_spec.SetField(budgetalert.FieldSpend, field.TypeInt64, value)
+	}
+	if value, ok := bau.mutation.AddedSpend(); ok {
+		_spec.AddField(budgetalert.FieldSpend, field.TypeInt64, value)
+	}
+	if value, ok := bau.mutation.Budget(); ok {
+		_spec.SetField(budgetalert.FieldBudget, field.TypeInt64, value)
+	}
+	if value, ok := bau.mutation.AddedBudget(); ok {
+		_spec.AddField(budgetalert.FieldBudget, field.TypeInt64, value)
+	}
+	if value, ok := bau.mutation.Currency(); ok {
+		_spec.SetField(budgetalert.FieldCurrency, field.TypeString, value)
+	}
+	if bau.mutation.CurrencyCleared() {
+		_spec.ClearField(budgetalert.FieldCurrency, field.TypeString)
+	}
+	if value, ok := bau.mutation.CreatedAt(); ok {
+		_spec.SetField(budgetalert.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(bau.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, bau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bau.mutation.done = true
+	return n, nil
+}
+
+// BudgetAlertUpdateOne is the builder for updating a single BudgetAlert entity.
+type BudgetAlertUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BudgetAlertMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetGroupID sets the "group_id" field.
+func (bauo *BudgetAlertUpdateOne) SetGroupID(u uuid.UUID) *BudgetAlertUpdateOne {
+	bauo.mutation.SetGroupID(u)
+	return bauo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableGroupID(u *uuid.UUID) *BudgetAlertUpdateOne {
+	if u != nil {
+		bauo.SetGroupID(*u)
+	}
+	return bauo
+}
+
+// SetGroupName sets the "group_name" field.
+func (bauo *BudgetAlertUpdateOne) SetGroupName(s string) *BudgetAlertUpdateOne {
+	bauo.mutation.SetGroupName(s)
+	return bauo
+}
+
+// SetNillableGroupName sets the "group_name" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableGroupName(s *string) *BudgetAlertUpdateOne {
+	if s != nil {
+		bauo.SetGroupName(*s)
+	}
+	return bauo
+}
+
+// ClearGroupName clears the value of the "group_name" field.
+func (bauo *BudgetAlertUpdateOne) ClearGroupName() *BudgetAlertUpdateOne {
+	bauo.mutation.ClearGroupName()
+	return bauo
+}
+
+// SetMonth sets the "month" field.
+func (bauo *BudgetAlertUpdateOne) SetMonth(s string) *BudgetAlertUpdateOne {
+	bauo.mutation.SetMonth(s)
+	return bauo
+}
+
+// SetNillableMonth sets the "month" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableMonth(s *string) *BudgetAlertUpdateOne {
+	if s != nil {
+		bauo.SetMonth(*s)
+	}
+	return bauo
+}
+
+// SetThreshold sets the "threshold" field.
+func (bauo *BudgetAlertUpdateOne) SetThreshold(i int) *BudgetAlertUpdateOne {
+	bauo.mutation.ResetThreshold()
+	bauo.mutation.SetThreshold(i)
+	return bauo
+}
+
+// SetNillableThreshold sets the "threshold" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableThreshold(i *int) *BudgetAlertUpdateOne {
+	if i != nil {
+		bauo.SetThreshold(*i)
+	}
+	return bauo
+}
+
+// AddThreshold adds i to the "threshold" field.
+func (bauo *BudgetAlertUpdateOne) AddThreshold(i int) *BudgetAlertUpdateOne {
+	bauo.mutation.AddThreshold(i)
+	return bauo
+}
+
+// SetSpend sets the "spend" field.
+func (bauo *BudgetAlertUpdateOne) SetSpend(i int64) *BudgetAlertUpdateOne {
+	bauo.mutation.ResetSpend()
+	bauo.mutation.SetSpend(i)
+	return bauo
+}
+
+// SetNillableSpend sets the "spend" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableSpend(i *int64) *BudgetAlertUpdateOne {
+	if i != nil {
+		bauo.SetSpend(*i)
+	}
+	return bauo
+}
+
+// AddSpend adds i to the "spend" field.
+func (bauo *BudgetAlertUpdateOne) AddSpend(i int64) *BudgetAlertUpdateOne {
+	bauo.mutation.AddSpend(i)
+	return bauo
+}
+
+// SetBudget sets the "budget" field.
+func (bauo *BudgetAlertUpdateOne) SetBudget(i int64) *BudgetAlertUpdateOne {
+	bauo.mutation.ResetBudget()
+	bauo.mutation.SetBudget(i)
+	return bauo
+}
+
+// SetNillableBudget sets the "budget" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableBudget(i *int64) *BudgetAlertUpdateOne {
+	if i != nil {
+		bauo.SetBudget(*i)
+	}
+	return bauo
+}
+
+// AddBudget adds i to the "budget" field.
+func (bauo *BudgetAlertUpdateOne) AddBudget(i int64) *BudgetAlertUpdateOne {
+	bauo.mutation.AddBudget(i)
+	return bauo
+}
+
+// SetCurrency sets the "currency" field.
+func (bauo *BudgetAlertUpdateOne) SetCurrency(s string) *BudgetAlertUpdateOne {
+	bauo.mutation.SetCurrency(s)
+	return bauo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableCurrency(s *string) *BudgetAlertUpdateOne {
+	if s != nil {
+		bauo.SetCurrency(*s)
+	}
+	return bauo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (bauo *BudgetAlertUpdateOne) ClearCurrency() *BudgetAlertUpdateOne {
+	bauo.mutation.ClearCurrency()
+	return bauo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bauo *BudgetAlertUpdateOne) SetCreatedAt(t time.Time) *BudgetAlertUpdateOne {
+	bauo.mutation.SetCreatedAt(t)
+	return bauo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bauo *BudgetAlertUpdateOne) SetNillableCreatedAt(t *time.Time) *BudgetAlertUpdateOne {
+	if t != nil {
+		bauo.SetCreatedAt(*t)
+	}
+	return bauo
+}
+
+// Mutation returns the BudgetAlertMutation object of the builder.
+func (bauo *BudgetAlertUpdateOne) Mutation() *BudgetAlertMutation {
+	return bauo.mutation
+}
+
+// Where appends a list predicates to the BudgetAlertUpdate builder.
+func (bauo *BudgetAlertUpdateOne) Where(ps ...predicate.BudgetAlert) *BudgetAlertUpdateOne {
+	bauo.mutation.Where(ps...)
+	return bauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (bauo *BudgetAlertUpdateOne) Select(field string, fields ...string) *BudgetAlertUpdateOne {
+	bauo.fields = append([]string{field}, fields...)
+	return bauo
+}
+
+// Save executes the query and returns the updated BudgetAlert entity.
+func (bauo *BudgetAlertUpdateOne) Save(ctx context.Context) (*BudgetAlert, error) {
+	return withHooks(ctx, bauo.sqlSave, bauo.mutation, bauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bauo *BudgetAlertUpdateOne) SaveX(ctx context.Context) *BudgetAlert {
+	node, err := bauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (bauo *BudgetAlertUpdateOne) Exec(ctx context.Context) error {
+	_, err := bauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bauo *BudgetAlertUpdateOne) ExecX(ctx context.Context) {
+	if err := bauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (bauo *BudgetAlertUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BudgetAlertUpdateOne {
+	bauo.modifiers = append(bauo.modifiers, modifiers...)
+	return bauo
+}
+
+func (bauo *BudgetAlertUpdateOne) sqlSave(ctx context.Context) (_node *BudgetAlert, err error) {
+	_spec := sqlgraph.NewUpdateSpec(budgetalert.Table, budgetalert.Columns, sqlgraph.NewFieldSpec(budgetalert.FieldID, field.TypeUUID))
+	id, ok := bauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "BudgetAlert.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := bauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, budgetalert.FieldID)
+		for _, f := range fields {
+			if !budgetalert.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != budgetalert.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := bauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bauo.mutation.GroupID(); ok {
+		_spec.SetField(budgetalert.FieldGroupID, field.TypeUUID, value)
+	}
+	if value, ok := bauo.mutation.GroupName(); ok {
+		_spec.SetField(budgetalert.FieldGroupName, field.TypeString, value)
+	}
+	if bauo.mutation.GroupNameCleared() {
+		_spec.ClearField(budgetalert.FieldGroupName, field.TypeString)
+	}
+	if value, ok := bauo.mutation.Month(); ok {
+		_spec.SetField(budgetalert.FieldMonth, field.TypeString, value)
+	}
+	if value, ok := bauo.mutation.Threshold(); ok {
+		_spec.SetField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := bauo.mutation.AddedThreshold(); ok {
+		_spec.AddField(budgetalert.FieldThreshold, field.TypeInt, value)
+	}
+	if value, ok := bauo.mutation.Spend(); ok {
+		_spec.SetField(budgetalert.FieldSpend, field.TypeInt64, value)
+	}
+	if value, ok := bauo.mutation.AddedSpend(); ok {
+		_spec.AddField(budgetalert.FieldSpend, field.TypeInt64, value)
+	}
+	if value, ok := bauo.mutation.Budget(); ok {
+		_spec.SetField(budgetalert.FieldBudget, field.TypeInt64, value)
+	}
+	if value, ok := bauo.mutation.AddedBudget(); ok {
+		_spec.AddField(budgetalert.FieldBudget, field.TypeInt64, value)
+	}
+	if value, ok := bauo.mutation.Currency(); ok {
+		_spec.SetField(budgetalert.FieldCurrency, field.TypeString, value)
+	}
+	if bauo.mutation.CurrencyCleared() {
+		_spec.ClearField(budgetalert.FieldCurrency, field.TypeString)
+	}
+	if value, ok := bauo.mutation.CreatedAt(); ok {
+		_spec.SetField(budgetalert.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(bauo.modifiers...)
+	_node = &BudgetAlert{config: bauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, bauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{budgetalert.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	bauo.mutation.done = true
+	return _node, nil
+}
@@ -20,23 +20,35 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/adminloginhistory"
 	"github.com/chaitin/MonkeyCode/backend/db/adminrole"
 	"github.com/chaitin/MonkeyCode/backend/db/apikey"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
 	"github.com/chaitin/MonkeyCode/backend/db/billingplan"
 	"github.com/chaitin/MonkeyCode/backend/db/billingquota"
 	"github.com/chaitin/MonkeyCode/backend/db/billingrecord"
 	"github.com/chaitin/MonkeyCode/backend/db/billingusage"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
 	"github.com/chaitin/MonkeyCode/backend/db/codesnippet"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
 	"github.com/chaitin/MonkeyCode/backend/db/extension"
 	"github.com/chaitin/MonkeyCode/backend/db/invitecode"
 	"github.com/chaitin/MonkeyCode/backend/db/license"
 	"github.com/chaitin/MonkeyCode/backend/db/model"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovider"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovidermodel"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
 	"github.com/chaitin/MonkeyCode/backend/db/role"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
 	"github.com/chaitin/MonkeyCode/backend/db/setting"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupadmin"
@@ -62,6 +74,8 @@ type Client struct {
 	AdminRole *AdminRoleClient
 	// ApiKey is the client for interacting with the ApiKey builders.
 	ApiKey *ApiKeyClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BillingPlan is the client for interacting with the BillingPlan builders.
 	BillingPlan *BillingPlanClient
 	// BillingQuota is the client for interacting with the BillingQuota builders.
@@ -70,8 +84,12 @@ type Client struct {
 	BillingRecord *BillingRecordClient
 	// BillingUsage is the client for interacting with the BillingUsage builders.
 	BillingUsage *BillingUsageClient
+	// BudgetAlert is the client for interacting with the BudgetAlert builders.
+	BudgetAlert *BudgetAlertClient
 	// CodeSnippet is the client for interacting with the CodeSnippet builders.
 	CodeSnippet *CodeSnippetClient
+	// DLPHit is the client for interacting with the DLPHit builders.
+	DLPHit *DLPHitClient
 	// Extension is the client for interacting with the Extension builders.
 	Extension *ExtensionClient
 	// InviteCode is the client for interacting with the InviteCode builders.
@@ -80,22 +98,40 @@ type Client struct {
 	License *LicenseClient
 	// Model is the client for interacting with the Model builders.
 	Model *ModelClient
+	// ModelHealthCheck is the client for interacting with the ModelHealthCheck builders.
+	ModelHealthCheck *ModelHealthCheckClient
 	// ModelProvider is the client for interacting with the ModelProvider builders.
 	ModelProvider *ModelProviderClient
 	// ModelProviderModel is the client for interacting with the ModelProviderModel builders.
 	ModelProviderModel *ModelProviderModelClient
+	// ResponseCache is the client for interacting with the ResponseCache builders.
+	ResponseCache *ResponseCacheClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SecurityRulePack is the client for interacting with the SecurityRulePack builders.
+	SecurityRulePack *SecurityRulePackClient
+	// SecurityRulePackVersion is the client for interacting with the SecurityRulePackVersion builders.
+	SecurityRulePackVersion *SecurityRulePackVersionClient
 	// SecurityScanning is the client for interacting with the SecurityScanning builders.
 	SecurityScanning *SecurityScanningClient
+	// SecurityScanningComment is the client for interacting with the SecurityScanningComment builders.
+	SecurityScanningComment *SecurityScanningCommentClient
+	// SecurityScanningPolicy is the client for interacting with the SecurityScanningPolicy builders.
+	SecurityScanningPolicy *SecurityScanningPolicyClient
 	// SecurityScanningResult is the client for interacting with the SecurityScanningResult builders.
 	SecurityScanningResult *SecurityScanningResultClient
+	// SecurityScanningSuppression is the client for interacting with the SecurityScanningSuppression builders.
+	SecurityScanningSuppression *SecurityScanningSuppressionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// TaskRecord is the client for interacting with the TaskRecord builders.
 	TaskRecord *TaskRecordClient
+	// TransformPolicy is the client for interacting with the TransformPolicy builders.
+	TransformPolicy *TransformPolicyClient
+	// TransformRule is the client for interacting with the TransformRule builders.
+	TransformRule *TransformRuleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserGroup is the client for interacting with the UserGroup builders.
@@ -127,23 +163,35 @@ func (c *Client) init() {
 	c.AdminLoginHistory = NewAdminLoginHistoryClient(c.config)
 	c.AdminRole = NewAdminRoleClient(c.config)
 	c.ApiKey = NewApiKeyClient(c.config)
+	c.AuditLog = NewAuditLogClient(c.config)
 	c.BillingPlan = NewBillingPlanClient(c.config)
 	c.BillingQuota = NewBillingQuotaClient(c.config)
 	c.BillingRecord = NewBillingRecordClient(c.config)
 	c.BillingUsage = NewBillingUsageClient(c.config)
+	c.BudgetAlert = NewBudgetAlertClient(c.config)
 	c.CodeSnippet = NewCodeSnippetClient(c.config)
+	c.DLPHit = NewDLPHitClient(c.config)
 	c.Extension = NewExtensionClient(c.config)
 	c.InviteCode = NewInviteCodeClient(c.config)
 	c.License = NewLicenseClient(c.config)
 	c.Model = NewModelClient(c.config)
+	c.ModelHealthCheck = NewModelHealthCheckClient(c.config)
 	c.ModelProvider = NewModelProviderClient(c.config)
 	c.ModelProviderModel = NewModelProviderModelClient(c.config)
+	c.ResponseCache = NewResponseCacheClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.SecurityRulePack = NewSecurityRulePackClient(c.config)
+	c.SecurityRulePackVersion = NewSecurityRulePackVersionClient(c.config)
 	c.SecurityScanning = NewSecurityScanningClient(c.config)
+	c.SecurityScanningComment = NewSecurityScanningCommentClient(c.config)
+	c.SecurityScanningPolicy = NewSecurityScanningPolicyClient(c.config)
 	c.SecurityScanningResult = NewSecurityScanningResultClient(c.config)
+	c.SecurityScanningSuppression = NewSecurityScanningSuppressionClient(c.config)
 	c.Setting = NewSettingClient(c.config)
 	c.Task = NewTaskClient(c.config)
 	c.TaskRecord = NewTaskRecordClient(c.config)
+	c.TransformPolicy = NewTransformPolicyClient(c.config)
+	c.TransformRule = NewTransformRuleClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserGroup = NewUserGroupClient(c.config)
 	c.UserGroupAdmin = NewUserGroupAdminClient(c.config)
@@ -242,37 +290,49 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		Admin:                  NewAdminClient(cfg),
-		AdminLoginHistory:      NewAdminLoginHistoryClient(cfg),
-		AdminRole:              NewAdminRoleClient(cfg),
-		ApiKey:                 NewApiKeyClient(cfg),
-		BillingPlan:            NewBillingPlanClient(cfg),
-		BillingQuota:           NewBillingQuotaClient(cfg),
-		BillingRecord:          NewBillingRecordClient(cfg),
-		BillingUsage:           NewBillingUsageClient(cfg),
-		CodeSnippet:            NewCodeSnippetClient(cfg),
-		Extension:              NewExtensionClient(cfg),
-		InviteCode:             NewInviteCodeClient(cfg),
-		License:                NewLicenseClient(cfg),
-		Model:                  NewModelClient(cfg),
-		ModelProvider:          NewModelProviderClient(cfg),
-		ModelProviderModel:     NewModelProviderModelClient(cfg),
-		Role:                   NewRoleClient(cfg),
-		SecurityScanning:       NewSecurityScanningClient(cfg),
-		SecurityScanningResult: NewSecurityScanningResultClient(cfg),
-		Setting:                NewSettingClient(cfg),
-		Task:                   NewTaskClient(cfg),
-		TaskRecord:             NewTaskRecordClient(cfg),
-		User:                   NewUserClient(cfg),
-		UserGroup:              NewUserGroupClient(cfg),
-		UserGroupAdmin:         NewUserGroupAdminClient(cfg),
-		UserGroupUser:          NewUserGroupUserClient(cfg),
-		UserIdentity:           NewUserIdentityClient(cfg),
-		UserLoginHistory:       NewUserLoginHistoryClient(cfg),
-		Workspace:              NewWorkspaceClient(cfg),
-		WorkspaceFile:          NewWorkspaceFileClient(cfg),
+		ctx:                         ctx,
+		config:                      cfg,
+		Admin:                       NewAdminClient(cfg),
+		AdminLoginHistory:           NewAdminLoginHistoryClient(cfg),
+		AdminRole:                   NewAdminRoleClient(cfg),
+		ApiKey:                      NewApiKeyClient(cfg),
+		AuditLog:                    NewAuditLogClient(cfg),
+		BillingPlan:                 NewBillingPlanClient(cfg),
+		BillingQuota:                NewBillingQuotaClient(cfg),
+		BillingRecord:               NewBillingRecordClient(cfg),
+		BillingUsage:                NewBillingUsageClient(cfg),
+		BudgetAlert:                 NewBudgetAlertClient(cfg),
+		CodeSnippet:                 NewCodeSnippetClient(cfg),
+		DLPHit:                      NewDLPHitClient(cfg),
+		Extension:                   NewExtensionClient(cfg),
+		InviteCode:                  NewInviteCodeClient(cfg),
+		License:                     NewLicenseClient(cfg),
+		Model:                       NewModelClient(cfg),
+		ModelHealthCheck:            NewModelHealthCheckClient(cfg),
+		ModelProvider:               NewModelProviderClient(cfg),
+		ModelProviderModel:          NewModelProviderModelClient(cfg),
+		ResponseCache:               NewResponseCacheClient(cfg),
+		Role:                        NewRoleClient(cfg),
+		SecurityRulePack:            NewSecurityRulePackClient(cfg),
+		SecurityRulePackVersion:     NewSecurityRulePackVersionClient(cfg),
+		SecurityScanning:            NewSecurityScanningClient(cfg),
+		SecurityScanningComment:     NewSecurityScanningCommentClient(cfg),
+		SecurityScanningPolicy:      NewSecurityScanningPolicyClient(cfg),
+		SecurityScanningResult:      NewSecurityScanningResultClient(cfg),
+		SecurityScanningSuppression: NewSecurityScanningSuppressionClient(cfg),
+		Setting:                     NewSettingClient(cfg),
+		Task:                        NewTaskClient(cfg),
+		TaskRecord:                  NewTaskRecordClient(cfg),
+		TransformPolicy:             NewTransformPolicyClient(cfg),
+		TransformRule:               NewTransformRuleClient(cfg),
+		User:                        NewUserClient(cfg),
+		UserGroup:                   NewUserGroupClient(cfg),
+		UserGroupAdmin:              NewUserGroupAdminClient(cfg),
+		UserGroupUser:               NewUserGroupUserClient(cfg),
+		UserIdentity:                NewUserIdentityClient(cfg),
+		UserLoginHistory:            NewUserLoginHistoryClient(cfg),
+		Workspace:                   NewWorkspaceClient(cfg),
+		WorkspaceFile:               NewWorkspaceFileClient(cfg),
 	}, nil
 }
 
@@ -290,37 +350,49 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                    ctx,
-		config:                 cfg,
-		Admin:                  NewAdminClient(cfg),
-		AdminLoginHistory:      NewAdminLoginHistoryClient(cfg),
-		AdminRole:              NewAdminRoleClient(cfg),
-		ApiKey:                 NewApiKeyClient(cfg),
-		BillingPlan:            NewBillingPlanClient(cfg),
-		BillingQuota:           NewBillingQuotaClient(cfg),
-		BillingRecord:          NewBillingRecordClient(cfg),
-		BillingUsage:           NewBillingUsageClient(cfg),
-		CodeSnippet:            NewCodeSnippetClient(cfg),
-		Extension:              NewExtensionClient(cfg),
-		InviteCode:             NewInviteCodeClient(cfg),
-		License:                NewLicenseClient(cfg),
-		Model:                  NewModelClient(cfg),
-		ModelProvider:          NewModelProviderClient(cfg),
-		ModelProviderModel:     NewModelProviderModelClient(cfg),
-		Role:                   NewRoleClient(cfg),
-		SecurityScanning:       NewSecurityScanningClient(cfg),
-		SecurityScanningResult: NewSecurityScanningResultClient(cfg),
-		Setting:                NewSettingClient(cfg),
-		Task:                   NewTaskClient(cfg),
-		TaskRecord:             NewTaskRecordClient(cfg),
-		User:                   NewUserClient(cfg),
-		UserGroup:              NewUserGroupClient(cfg),
-		UserGroupAdmin:         NewUserGroupAdminClient(cfg),
-		UserGroupUser:          NewUserGroupUserClient(cfg),
-		UserIdentity:           NewUserIdentityClient(cfg),
-		UserLoginHistory:       NewUserLoginHistoryClient(cfg),
-		Workspace:              NewWorkspaceClient(cfg),
-		WorkspaceFile:          NewWorkspaceFileClient(cfg),
+		ctx:                         ctx,
+		config:                      cfg,
+		Admin:                       NewAdminClient(cfg),
+		AdminLoginHistory:           NewAdminLoginHistoryClient(cfg),
+		AdminRole:                   NewAdminRoleClient(cfg),
+		ApiKey:                      NewApiKeyClient(cfg),
+		AuditLog:                    NewAuditLogClient(cfg),
+		BillingPlan:                 NewBillingPlanClient(cfg),
+		BillingQuota:                NewBillingQuotaClient(cfg),
+		BillingRecord:               NewBillingRecordClient(cfg),
+		BillingUsage:                NewBillingUsageClient(cfg),
+		BudgetAlert:                 NewBudgetAlertClient(cfg),
+		CodeSnippet:                 NewCodeSnippetClient(cfg),
+		DLPHit:                      NewDLPHitClient(cfg),
+		Extension:                   NewExtensionClient(cfg),
+		InviteCode:                  NewInviteCodeClient(cfg),
+		License:                     NewLicenseClient(cfg),
+		Model:                       NewModelClient(cfg),
+		ModelHealthCheck:            NewModelHealthCheckClient(cfg),
+		ModelProvider:               NewModelProviderClient(cfg),
+		ModelProviderModel:          NewModelProviderModelClient(cfg),
+		ResponseCache:               NewResponseCacheClient(cfg),
+		Role:                        NewRoleClient(cfg),
+		SecurityRulePack:            NewSecurityRulePackClient(cfg),
+		SecurityRulePackVersion:     NewSecurityRulePackVersionClient(cfg),
+		SecurityScanning:            NewSecurityScanningClient(cfg),
+		SecurityScanningComment:     NewSecurityScanningCommentClient(cfg),
+		SecurityScanningPolicy:      NewSecurityScanningPolicyClient(cfg),
+		SecurityScanningResult:      NewSecurityScanningResultClient(cfg),
+		SecurityScanningSuppression: NewSecurityScanningSuppressionClient(cfg),
+		Setting:                     NewSettingClient(cfg),
+		Task:                        NewTaskClient(cfg),
+		TaskRecord:                  NewTaskRecordClient(cfg),
+		TransformPolicy:             NewTransformPolicyClient(cfg),
+		TransformRule:               NewTransformRuleClient(cfg),
+		User:                        NewUserClient(cfg),
+		UserGroup:                   NewUserGroupClient(cfg),
+		UserGroupAdmin:              NewUserGroupAdminClient(cfg),
+		UserGroupUser:               NewUserGroupUserClient(cfg),
+		UserIdentity:                NewUserIdentityClient(cfg),
+		UserLoginHistory:            NewUserLoginHistoryClient(cfg),
+		Workspace:                   NewWorkspaceClient(cfg),
+		WorkspaceFile:               NewWorkspaceFileClient(cfg),
 	}, nil
 }
 
@@ -350,12 +422,16 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Admin, c.AdminLoginHistory, c.AdminRole, c.ApiKey, c.BillingPlan,
-		c.BillingQuota, c.BillingRecord, c.BillingUsage, c.CodeSnippet, c.Extension,
-		c.InviteCode, c.License, c.Model, c.ModelProvider, c.ModelProviderModel,
-		c.Role, c.SecurityScanning, c.SecurityScanningResult, c.Setting, c.Task,
-		c.TaskRecord, c.User, c.UserGroup, c.UserGroupAdmin, c.UserGroupUser,
-		c.UserIdentity, c.UserLoginHistory, c.Workspace, c.WorkspaceFile,
+		c.Admin, c.AdminLoginHistory, c.AdminRole, c.ApiKey, c.AuditLog, c.BillingPlan,
+		c.BillingQuota, c.BillingRecord, c.BillingUsage, c.BudgetAlert, c.CodeSnippet,
+		c.DLPHit, c.Extension, c.InviteCode, c.License, c.Model, c.ModelHealthCheck,
+		c.ModelProvider, c.ModelProviderModel, c.ResponseCache, c.Role,
+		c.SecurityRulePack, c.SecurityRulePackVersion, c.SecurityScanning,
+		c.SecurityScanningComment, c.SecurityScanningPolicy, c.SecurityScanningResult,
+		c.SecurityScanningSuppression, c.Setting, c.Task, c.TaskRecord,
+		c.TransformPolicy, c.TransformRule, c.User, c.UserGroup, c.UserGroupAdmin,
+		c.UserGroupUser, c.UserIdentity, c.UserLoginHistory, c.Workspace,
+		c.WorkspaceFile,
 	} {
 		n.Use(hooks...)
 	}
@@ -365,12 +441,16 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Admin, c.AdminLoginHistory, c.AdminRole, c.ApiKey, c.BillingPlan,
-		c.BillingQuota, c.BillingRecord, c.BillingUsage, c.CodeSnippet, c.Extension,
-		c.InviteCode, c.License, c.Model, c.ModelProvider, c.ModelProviderModel,
-		c.Role, c.SecurityScanning, c.SecurityScanningResult, c.Setting, c.Task,
-		c.TaskRecord, c.User, c.UserGroup, c.UserGroupAdmin, c.UserGroupUser,
-		c.UserIdentity, c.UserLoginHistory, c.Workspace, c.WorkspaceFile,
+		c.Admin, c.AdminLoginHistory, c.AdminRole, c.ApiKey, c.AuditLog, c.BillingPlan,
+		c.BillingQuota, c.BillingRecord, c.BillingUsage, c.BudgetAlert, c.CodeSnippet,
+		c.DLPHit, c.Extension, c.InviteCode, c.License, c.Model, c.ModelHealthCheck,
+		c.ModelProvider, c.ModelProviderModel, c.ResponseCache, c.Role,
+		c.SecurityRulePack, c.SecurityRulePackVersion, c.SecurityScanning,
+		c.SecurityScanningComment, c.SecurityScanningPolicy, c.SecurityScanningResult,
+		c.SecurityScanningSuppression, c.Setting, c.Task, c.TaskRecord,
+		c.TransformPolicy, c.TransformRule, c.User, c.UserGroup, c.UserGroupAdmin,
+		c.UserGroupUser, c.UserIdentity, c.UserLoginHistory, c.Workspace,
+		c.WorkspaceFile,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -387,6 +467,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AdminRole.mutate(ctx, m)
 	case *ApiKeyMutation:
 		return c.ApiKey.mutate(ctx, m)
+	case *AuditLogMutation:
+		return c.AuditLog.mutate(ctx, m)
 	case *BillingPlanMutation:
 		return c.BillingPlan.mutate(ctx, m)
 	case *BillingQuotaMutation:
@@ -395,8 +477,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.BillingRecord.mutate(ctx, m)
 	case *BillingUsageMutation:
 		return c.BillingUsage.mutate(ctx, m)
+	case *BudgetAlertMutation:
+		return c.BudgetAlert.mutate(ctx, m)
 	case *CodeSnippetMutation:
 		return c.CodeSnippet.mutate(ctx, m)
+	case *DLPHitMutation:
+		return c.DLPHit.mutate(ctx, m)
 	case *ExtensionMutation:
 		return c.Extension.mutate(ctx, m)
 	case *InviteCodeMutation:
@@ -405,22 +491,40 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.License.mutate(ctx, m)
 	case *ModelMutation:
 		return c.Model.mutate(ctx, m)
+	case *ModelHealthCheckMutation:
+		return c.ModelHealthCheck.mutate(ctx, m)
 	case *ModelProviderMutation:
 		return c.ModelProvider.mutate(ctx, m)
 	case *ModelProviderModelMutation:
 		return c.ModelProviderModel.mutate(ctx, m)
+	case *ResponseCacheMutation:
+		return c.ResponseCache.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *SecurityRulePackMutation:
+		return c.SecurityRulePack.mutate(ctx, m)
+	case *SecurityRulePackVersionMutation:
+		return c.SecurityRulePackVersion.mutate(ctx, m)
 	case *SecurityScanningMutation:
 		return c.SecurityScanning.mutate(ctx, m)
+	case *SecurityScanningCommentMutation:
+		return c.SecurityScanningComment.mutate(ctx, m)
+	case *SecurityScanningPolicyMutation:
+		return c.SecurityScanningPolicy.mutate(ctx, m)
 	case *SecurityScanningResultMutation:
 		return c.SecurityScanningResult.mutate(ctx, m)
+	case *SecurityScanningSuppressionMutation:
+		return c.SecurityScanningSuppression.mutate(ctx, m)
 	case *SettingMutation:
 		return c.Setting.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
 	case *TaskRecordMutation:
 		return c.TaskRecord.mutate(ctx, m)
+	case *TransformPolicyMutation:
+		return c.TransformPolicy.mutate(ctx, m)
+	case *TransformRuleMutation:
+		return c.TransformRule.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserGroupMutation:
@@ -1134,6 +1238,139 @@ func (c *ApiKeyClient) mutate(ctx context.Context, m *ApiKeyMutation) (Value, er
 	}
 }
 
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditlog.Intercept(f(g(h())))`.
+func (c *AuditLogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditLog = append(c.inters.AuditLog, interceptors...)
+}
+
+// Create returns a builder for creating a AuditLog entity.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditLog entities.
+func (c *AuditLogClient) CreateBulk(builders ...*AuditLogCreate) *AuditLogCreateBulk {
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditLogClient) MapCreateBulk(slice any, setFunc func(*AuditLogCreate, int)) *AuditLogCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditLogCreateBulk{err: fmt.Errorf("calling to AuditLogClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditLogCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditLogCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLog(al))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id uuid.UUID) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne, withAuditLogID(id))
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditLogClient) DeleteOneID(id uuid.UUID) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditLog},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id uuid.UUID) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id uuid.UUID) *AuditLog {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	return c.hooks.AuditLog
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditLogClient) Interceptors() []Interceptor {
+	return c.inters.AuditLog
+}
+
+func (c *AuditLogClient) mutate(ctx context.Context, m *AuditLogMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown AuditLog mutation op: %q", m.Op())
+	}
+}
+
 // BillingPlanClient is a client for the BillingPlan schema.
 type BillingPlanClient struct {
 	config
@@ -1670,6 +1907,139 @@ func (c *BillingUsageClient) mutate(ctx context.Context, m *BillingUsageMutation
 	}
 }
 
+// BudgetAlertClient is a client for the BudgetAlert schema.
+type BudgetAlertClient struct {
+	config
+}
+
+// NewBudgetAlertClient returns a client for the BudgetAlert from the given config.
+func NewBudgetAlertClient(c config) *BudgetAlertClient {
+	return &BudgetAlertClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `budgetalert.Hooks(f(g(h())))`.
+func (c *BudgetAlertClient) Use(hooks ...Hook) {
+	c.hooks.BudgetAlert = append(c.hooks.BudgetAlert, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `budgetalert.Intercept(f(g(h())))`.
+func (c *BudgetAlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BudgetAlert = append(c.inters.BudgetAlert, interceptors...)
+}
+
+// Create returns a builder for creating a BudgetAlert entity.
+func (c *BudgetAlertClient) Create() *BudgetAlertCreate {
+	mutation := newBudgetAlertMutation(c.config, OpCreate)
+	return &BudgetAlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BudgetAlert entities.
+func (c *BudgetAlertClient) CreateBulk(builders ...*BudgetAlertCreate) *BudgetAlertCreateBulk {
+	return &BudgetAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BudgetAlertClient) MapCreateBulk(slice any, setFunc func(*BudgetAlertCreate, int)) *BudgetAlertCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BudgetAlertCreateBulk{err: fmt.Errorf("calling to BudgetAlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BudgetAlertCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BudgetAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BudgetAlert.
+func (c *BudgetAlertClient) Update() *BudgetAlertUpdate {
+	mutation := newBudgetAlertMutation(c.config, OpUpdate)
+	return &BudgetAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BudgetAlertClient) UpdateOne(ba *BudgetAlert) *BudgetAlertUpdateOne {
+	mutation := newBudgetAlertMutation(c.config, OpUpdateOne, withBudgetAlert(ba))
+	return &BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BudgetAlertClient) UpdateOneID(id uuid.UUID) *BudgetAlertUpdateOne {
+	mutation := newBudgetAlertMutation(c.config, OpUpdateOne, withBudgetAlertID(id))
+	return &BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BudgetAlert.
+func (c *BudgetAlertClient) Delete() *BudgetAlertDelete {
+	mutation := newBudgetAlertMutation(c.config, OpDelete)
+	return &BudgetAlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BudgetAlertClient) DeleteOne(ba *BudgetAlert) *BudgetAlertDeleteOne {
+	return c.DeleteOneID(ba.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BudgetAlertClient) DeleteOneID(id uuid.UUID) *BudgetAlertDeleteOne {
+	builder := c.Delete().Where(budgetalert.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BudgetAlertDeleteOne{builder}
+}
+
+// Query returns a query builder for BudgetAlert.
+func (c *BudgetAlertClient) Query() *BudgetAlertQuery {
+	return &BudgetAlertQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBudgetAlert},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BudgetAlert entity by its id.
+func (c *BudgetAlertClient) Get(ctx context.Context, id uuid.UUID) (*BudgetAlert, error) {
+	return c.Query().Where(budgetalert.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BudgetAlertClient) GetX(ctx context.Context, id uuid.UUID) *BudgetAlert {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BudgetAlertClient) Hooks() []Hook {
+	return c.hooks.BudgetAlert
+}
+
+// Interceptors returns the client interceptors.
+func (c *BudgetAlertClient) Interceptors() []Interceptor {
+	return c.inters.BudgetAlert
+}
+
+func (c *BudgetAlertClient) mutate(ctx context.Context, m *BudgetAlertMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BudgetAlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BudgetAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BudgetAlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown BudgetAlert mutation op: %q", m.Op())
+	}
+}
+
 // CodeSnippetClient is a client for the CodeSnippet schema.
 type CodeSnippetClient struct {
 	config
@@ -1819,6 +2189,139 @@ func (c *CodeSnippetClient) mutate(ctx context.Context, m *CodeSnippetMutation)
 	}
 }
 
+// DLPHitClient is a client for the DLPHit schema.
+type DLPHitClient struct {
+	config
+}
+
+// NewDLPHitClient returns a client for the DLPHit from the given config.
+func NewDLPHitClient(c config) *DLPHitClient {
+	return &DLPHitClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dlphit.Hooks(f(g(h())))`.
+func (c *DLPHitClient) Use(hooks ...Hook) {
+	c.hooks.DLPHit = append(c.hooks.DLPHit, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dlphit.Intercept(f(g(h())))`.
+func (c *DLPHitClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DLPHit = append(c.inters.DLPHit, interceptors...)
+}
+
+// Create returns a builder for creating a DLPHit entity.
+func (c *DLPHitClient) Create() *DLPHitCreate {
+	mutation := newDLPHitMutation(c.config, OpCreate)
+	return &DLPHitCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DLPHit entities.
+func (c *DLPHitClient) CreateBulk(builders ...*DLPHitCreate) *DLPHitCreateBulk {
+	return &DLPHitCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DLPHitClient) MapCreateBulk(slice any, setFunc func(*DLPHitCreate, int)) *DLPHitCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DLPHitCreateBulk{err: fmt.Errorf("calling to DLPHitClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DLPHitCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DLPHitCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DLPHit.
+func (c *DLPHitClient) Update() *DLPHitUpdate {
+	mutation := newDLPHitMutation(c.config, OpUpdate)
+	return &DLPHitUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DLPHitClient) UpdateOne(dh *DLPHit) *DLPHitUpdateOne {
+	mutation := newDLPHitMutation(c.config, OpUpdateOne, withDLPHit(dh))
+	return &DLPHitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DLPHitClient) UpdateOneID(id uuid.UUID) *DLPHitUpdateOne {
+	mutation := newDLPHitMutation(c.config, OpUpdateOne, withDLPHitID(id))
+	return &DLPHitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DLPHit.
+func (c *DLPHitClient) Delete() *DLPHitDelete {
+	mutation := newDLPHitMutation(c.config, OpDelete)
+	return &DLPHitDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DLPHitClient) DeleteOne(dh *DLPHit) *DLPHitDeleteOne {
+	return c.DeleteOneID(dh.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DLPHitClient) DeleteOneID(id uuid.UUID) *DLPHitDeleteOne {
+	builder := c.Delete().Where(dlphit.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DLPHitDeleteOne{builder}
+}
+
+// Query returns a query builder for DLPHit.
+func (c *DLPHitClient) Query() *DLPHitQuery {
+	return &DLPHitQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDLPHit},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DLPHit entity by its id.
+func (c *DLPHitClient) Get(ctx context.Context, id uuid.UUID) (*DLPHit, error) {
+	return c.Query().Where(dlphit.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DLPHitClient) GetX(ctx context.Context, id uuid.UUID) *DLPHit {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DLPHitClient) Hooks() []Hook {
+	return c.hooks.DLPHit
+}
+
+// Interceptors returns the client interceptors.
+func (c *DLPHitClient) Interceptors() []Interceptor {
+	return c.inters.DLPHit
+}
+
+func (c *DLPHitClient) mutate(ctx context.Context, m *DLPHitMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DLPHitCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DLPHitUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DLPHitUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DLPHitDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown DLPHit mutation op: %q", m.Op())
+	}
+}
+
 // ExtensionClient is a client for the Extension schema.
 type ExtensionClient struct {
 	config
@@ -2383,74 +2886,207 @@ func (c *ModelClient) mutate(ctx context.Context, m *ModelMutation) (Value, erro
 	}
 }
 
-// ModelProviderClient is a client for the ModelProvider schema.
-type ModelProviderClient struct {
+// ModelHealthCheckClient is a client for the ModelHealthCheck schema.
+type ModelHealthCheckClient struct {
 	config
 }
 
-// NewModelProviderClient returns a client for the ModelProvider from the given config.
-func NewModelProviderClient(c config) *ModelProviderClient {
-	return &ModelProviderClient{config: c}
+// NewModelHealthCheckClient returns a client for the ModelHealthCheck from the given config.
+func NewModelHealthCheckClient(c config) *ModelHealthCheckClient {
+	return &ModelHealthCheckClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `modelprovider.Hooks(f(g(h())))`.
-func (c *ModelProviderClient) Use(hooks ...Hook) {
-	c.hooks.ModelProvider = append(c.hooks.ModelProvider, hooks...)
+// A call to `Use(f, g, h)` equals to `modelhealthcheck.Hooks(f(g(h())))`.
+func (c *ModelHealthCheckClient) Use(hooks ...Hook) {
+	c.hooks.ModelHealthCheck = append(c.hooks.ModelHealthCheck, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `modelprovider.Intercept(f(g(h())))`.
-func (c *ModelProviderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ModelProvider = append(c.inters.ModelProvider, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `modelhealthcheck.Intercept(f(g(h())))`.
+func (c *ModelHealthCheckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModelHealthCheck = append(c.inters.ModelHealthCheck, interceptors...)
 }
 
-// Create returns a builder for creating a ModelProvider entity.
-func (c *ModelProviderClient) Create() *ModelProviderCreate {
-	mutation := newModelProviderMutation(c.config, OpCreate)
-	return &ModelProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ModelHealthCheck entity.
+func (c *ModelHealthCheckClient) Create() *ModelHealthCheckCreate {
+	mutation := newModelHealthCheckMutation(c.config, OpCreate)
+	return &ModelHealthCheckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ModelProvider entities.
-func (c *ModelProviderClient) CreateBulk(builders ...*ModelProviderCreate) *ModelProviderCreateBulk {
-	return &ModelProviderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ModelHealthCheck entities.
+func (c *ModelHealthCheckClient) CreateBulk(builders ...*ModelHealthCheckCreate) *ModelHealthCheckCreateBulk {
+	return &ModelHealthCheckCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ModelProviderClient) MapCreateBulk(slice any, setFunc func(*ModelProviderCreate, int)) *ModelProviderCreateBulk {
+func (c *ModelHealthCheckClient) MapCreateBulk(slice any, setFunc func(*ModelHealthCheckCreate, int)) *ModelHealthCheckCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ModelProviderCreateBulk{err: fmt.Errorf("calling to ModelProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ModelHealthCheckCreateBulk{err: fmt.Errorf("calling to ModelHealthCheckClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ModelProviderCreate, rv.Len())
+	builders := make([]*ModelHealthCheckCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ModelProviderCreateBulk{config: c.config, builders: builders}
+	return &ModelHealthCheckCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ModelProvider.
-func (c *ModelProviderClient) Update() *ModelProviderUpdate {
-	mutation := newModelProviderMutation(c.config, OpUpdate)
-	return &ModelProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ModelHealthCheck.
+func (c *ModelHealthCheckClient) Update() *ModelHealthCheckUpdate {
+	mutation := newModelHealthCheckMutation(c.config, OpUpdate)
+	return &ModelHealthCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ModelProviderClient) UpdateOne(mp *ModelProvider) *ModelProviderUpdateOne {
-	mutation := newModelProviderMutation(c.config, OpUpdateOne, withModelProvider(mp))
-	return &ModelProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ModelHealthCheckClient) UpdateOne(mhc *ModelHealthCheck) *ModelHealthCheckUpdateOne {
+	mutation := newModelHealthCheckMutation(c.config, OpUpdateOne, withModelHealthCheck(mhc))
+	return &ModelHealthCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ModelProviderClient) UpdateOneID(id string) *ModelProviderUpdateOne {
-	mutation := newModelProviderMutation(c.config, OpUpdateOne, withModelProviderID(id))
-	return &ModelProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ModelHealthCheckClient) UpdateOneID(id uuid.UUID) *ModelHealthCheckUpdateOne {
+	mutation := newModelHealthCheckMutation(c.config, OpUpdateOne, withModelHealthCheckID(id))
+	return &ModelHealthCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ModelProvider.
-func (c *ModelProviderClient) Delete() *ModelProviderDelete {
+// Delete returns a delete builder for ModelHealthCheck.
+func (c *ModelHealthCheckClient) Delete() *ModelHealthCheckDelete {
+	mutation := newModelHealthCheckMutation(c.config, OpDelete)
+	return &ModelHealthCheckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModelHealthCheckClient) DeleteOne(mhc *ModelHealthCheck) *ModelHealthCheckDeleteOne {
+	return c.DeleteOneID(mhc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModelHealthCheckClient) DeleteOneID(id uuid.UUID) *ModelHealthCheckDeleteOne {
+	builder := c.Delete().Where(modelhealthcheck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModelHealthCheckDeleteOne{builder}
+}
+
+// Query returns a query builder for ModelHealthCheck.
+func (c *ModelHealthCheckClient) Query() *ModelHealthCheckQuery {
+	return &ModelHealthCheckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModelHealthCheck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModelHealthCheck entity by its id.
+func (c *ModelHealthCheckClient) Get(ctx context.Context, id uuid.UUID) (*ModelHealthCheck, error) {
+	return c.Query().Where(modelhealthcheck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModelHealthCheckClient) GetX(ctx context.Context, id uuid.UUID) *ModelHealthCheck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModelHealthCheckClient) Hooks() []Hook {
+	return c.hooks.ModelHealthCheck
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModelHealthCheckClient) Interceptors() []Interceptor {
+	return c.inters.ModelHealthCheck
+}
+
+func (c *ModelHealthCheckClient) mutate(ctx context.Context, m *ModelHealthCheckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModelHealthCheckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModelHealthCheckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModelHealthCheckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModelHealthCheckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ModelHealthCheck mutation op: %q", m.Op())
+	}
+}
+
+// ModelProviderClient is a client for the ModelProvider schema.
+type ModelProviderClient struct {
+	config
+}
+
+// NewModelProviderClient returns a client for the ModelProvider from the given config.
+func NewModelProviderClient(c config) *ModelProviderClient {
+	return &ModelProviderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `modelprovider.Hooks(f(g(h())))`.
+func (c *ModelProviderClient) Use(hooks ...Hook) {
+	c.hooks.ModelProvider = append(c.hooks.ModelProvider, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `modelprovider.Intercept(f(g(h())))`.
+func (c *ModelProviderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModelProvider = append(c.inters.ModelProvider, interceptors...)
+}
+
+// Create returns a builder for creating a ModelProvider entity.
+func (c *ModelProviderClient) Create() *ModelProviderCreate {
+	mutation := newModelProviderMutation(c.config, OpCreate)
+	return &ModelProviderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModelProvider entities.
+func (c *ModelProviderClient) CreateBulk(builders ...*ModelProviderCreate) *ModelProviderCreateBulk {
+	return &ModelProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModelProviderClient) MapCreateBulk(slice any, setFunc func(*ModelProviderCreate, int)) *ModelProviderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModelProviderCreateBulk{err: fmt.Errorf("calling to ModelProviderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModelProviderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModelProviderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModelProvider.
+func (c *ModelProviderClient) Update() *ModelProviderUpdate {
+	mutation := newModelProviderMutation(c.config, OpUpdate)
+	return &ModelProviderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModelProviderClient) UpdateOne(mp *ModelProvider) *ModelProviderUpdateOne {
+	mutation := newModelProviderMutation(c.config, OpUpdateOne, withModelProvider(mp))
+	return &ModelProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModelProviderClient) UpdateOneID(id string) *ModelProviderUpdateOne {
+	mutation := newModelProviderMutation(c.config, OpUpdateOne, withModelProviderID(id))
+	return &ModelProviderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModelProvider.
+func (c *ModelProviderClient) Delete() *ModelProviderDelete {
 	mutation := newModelProviderMutation(c.config, OpDelete)
 	return &ModelProviderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -2681,6 +3317,139 @@ func (c *ModelProviderModelClient) mutate(ctx context.Context, m *ModelProviderM
 	}
 }
 
+// ResponseCacheClient is a client for the ResponseCache schema.
+type ResponseCacheClient struct {
+	config
+}
+
+// NewResponseCacheClient returns a client for the ResponseCache from the given config.
+func NewResponseCacheClient(c config) *ResponseCacheClient {
+	return &ResponseCacheClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `responsecache.Hooks(f(g(h())))`.
+func (c *ResponseCacheClient) Use(hooks ...Hook) {
+	c.hooks.ResponseCache = append(c.hooks.ResponseCache, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `responsecache.Intercept(f(g(h())))`.
+func (c *ResponseCacheClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ResponseCache = append(c.inters.ResponseCache, interceptors...)
+}
+
+// Create returns a builder for creating a ResponseCache entity.
+func (c *ResponseCacheClient) Create() *ResponseCacheCreate {
+	mutation := newResponseCacheMutation(c.config, OpCreate)
+	return &ResponseCacheCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ResponseCache entities.
+func (c *ResponseCacheClient) CreateBulk(builders ...*ResponseCacheCreate) *ResponseCacheCreateBulk {
+	return &ResponseCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ResponseCacheClient) MapCreateBulk(slice any, setFunc func(*ResponseCacheCreate, int)) *ResponseCacheCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ResponseCacheCreateBulk{err: fmt.Errorf("calling to ResponseCacheClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ResponseCacheCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ResponseCacheCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ResponseCache.
+func (c *ResponseCacheClient) Update() *ResponseCacheUpdate {
+	mutation := newResponseCacheMutation(c.config, OpUpdate)
+	return &ResponseCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ResponseCacheClient) UpdateOne(rc *ResponseCache) *ResponseCacheUpdateOne {
+	mutation := newResponseCacheMutation(c.config, OpUpdateOne, withResponseCache(rc))
+	return &ResponseCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ResponseCacheClient) UpdateOneID(id uuid.UUID) *ResponseCacheUpdateOne {
+	mutation := newResponseCacheMutation(c.config, OpUpdateOne, withResponseCacheID(id))
+	return &ResponseCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ResponseCache.
+func (c *ResponseCacheClient) Delete() *ResponseCacheDelete {
+	mutation := newResponseCacheMutation(c.config, OpDelete)
+	return &ResponseCacheDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ResponseCacheClient) DeleteOne(rc *ResponseCache) *ResponseCacheDeleteOne {
+	return c.DeleteOneID(rc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ResponseCacheClient) DeleteOneID(id uuid.UUID) *ResponseCacheDeleteOne {
+	builder := c.Delete().Where(responsecache.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ResponseCacheDeleteOne{builder}
+}
+
+// Query returns a query builder for ResponseCache.
+func (c *ResponseCacheClient) Query() *ResponseCacheQuery {
+	return &ResponseCacheQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeResponseCache},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ResponseCache entity by its id.
+func (c *ResponseCacheClient) Get(ctx context.Context, id uuid.UUID) (*ResponseCache, error) {
+	return c.Query().Where(responsecache.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ResponseCacheClient) GetX(ctx context.Context, id uuid.UUID) *ResponseCache {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ResponseCacheClient) Hooks() []Hook {
+	return c.hooks.ResponseCache
+}
+
+// Interceptors returns the client interceptors.
+func (c *ResponseCacheClient) Interceptors() []Interceptor {
+	return c.inters.ResponseCache
+}
+
+func (c *ResponseCacheClient) mutate(ctx context.Context, m *ResponseCacheMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ResponseCacheCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ResponseCacheUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ResponseCacheUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ResponseCacheDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown ResponseCache mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -2846,6 +3615,304 @@ func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error)
 	}
 }
 
+// SecurityRulePackClient is a client for the SecurityRulePack schema.
+type SecurityRulePackClient struct {
+	config
+}
+
+// NewSecurityRulePackClient returns a client for the SecurityRulePack from the given config.
+func NewSecurityRulePackClient(c config) *SecurityRulePackClient {
+	return &SecurityRulePackClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityrulepack.Hooks(f(g(h())))`.
+func (c *SecurityRulePackClient) Use(hooks ...Hook) {
+	c.hooks.SecurityRulePack = append(c.hooks.SecurityRulePack, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityrulepack.Intercept(f(g(h())))`.
+func (c *SecurityRulePackClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityRulePack = append(c.inters.SecurityRulePack, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityRulePack entity.
+func (c *SecurityRulePackClient) Create() *SecurityRulePackCreate {
+	mutation := newSecurityRulePackMutation(c.config, OpCreate)
+	return &SecurityRulePackCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityRulePack entities.
+func (c *SecurityRulePackClient) CreateBulk(builders ...*SecurityRulePackCreate) *SecurityRulePackCreateBulk {
+	return &SecurityRulePackCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityRulePackClient) MapCreateBulk(slice any, setFunc func(*SecurityRulePackCreate, int)) *SecurityRulePackCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityRulePackCreateBulk{err: fmt.Errorf("calling to SecurityRulePackClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityRulePackCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityRulePackCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityRulePack.
+func (c *SecurityRulePackClient) Update() *SecurityRulePackUpdate {
+	mutation := newSecurityRulePackMutation(c.config, OpUpdate)
+	return &SecurityRulePackUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityRulePackClient) UpdateOne(srp *SecurityRulePack) *SecurityRulePackUpdateOne {
+	mutation := newSecurityRulePackMutation(c.config, OpUpdateOne, withSecurityRulePack(srp))
+	return &SecurityRulePackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityRulePackClient) UpdateOneID(id uuid.UUID) *SecurityRulePackUpdateOne {
+	mutation := newSecurityRulePackMutation(c.config, OpUpdateOne, withSecurityRulePackID(id))
+	return &SecurityRulePackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityRulePack.
+func (c *SecurityRulePackClient) Delete() *SecurityRulePackDelete {
+	mutation := newSecurityRulePackMutation(c.config, OpDelete)
+	return &SecurityRulePackDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityRulePackClient) DeleteOne(srp *SecurityRulePack) *SecurityRulePackDeleteOne {
+	return c.DeleteOneID(srp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityRulePackClient) DeleteOneID(id uuid.UUID) *SecurityRulePackDeleteOne {
+	builder := c.Delete().Where(securityrulepack.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityRulePackDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityRulePack.
+func (c *SecurityRulePackClient) Query() *SecurityRulePackQuery {
+	return &SecurityRulePackQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityRulePack},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityRulePack entity by its id.
+func (c *SecurityRulePackClient) Get(ctx context.Context, id uuid.UUID) (*SecurityRulePack, error) {
+	return c.Query().Where(securityrulepack.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityRulePackClient) GetX(ctx context.Context, id uuid.UUID) *SecurityRulePack {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVersions queries the versions edge of a SecurityRulePack.
+func (c *SecurityRulePackClient) QueryVersions(srp *SecurityRulePack) *SecurityRulePackVersionQuery {
+	query := (&SecurityRulePackVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := srp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityrulepack.Table, securityrulepack.FieldID, id),
+			sqlgraph.To(securityrulepackversion.Table, securityrulepackversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, securityrulepack.VersionsTable, securityrulepack.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(srp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SecurityRulePackClient) Hooks() []Hook {
+	return c.hooks.SecurityRulePack
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecurityRulePackClient) Interceptors() []Interceptor {
+	return c.inters.SecurityRulePack
+}
+
+func (c *SecurityRulePackClient) mutate(ctx context.Context, m *SecurityRulePackMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecurityRulePackCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecurityRulePackUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecurityRulePackUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecurityRulePackDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SecurityRulePack mutation op: %q", m.Op())
+	}
+}
+
+// SecurityRulePackVersionClient is a client for the SecurityRulePackVersion schema.
+type SecurityRulePackVersionClient struct {
+	config
+}
+
+// NewSecurityRulePackVersionClient returns a client for the SecurityRulePackVersion from the given config.
+func NewSecurityRulePackVersionClient(c config) *SecurityRulePackVersionClient {
+	return &SecurityRulePackVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityrulepackversion.Hooks(f(g(h())))`.
+func (c *SecurityRulePackVersionClient) Use(hooks ...Hook) {
+	c.hooks.SecurityRulePackVersion = append(c.hooks.SecurityRulePackVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityrulepackversion.Intercept(f(g(h())))`.
+func (c *SecurityRulePackVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityRulePackVersion = append(c.inters.SecurityRulePackVersion, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityRulePackVersion entity.
+func (c *SecurityRulePackVersionClient) Create() *SecurityRulePackVersionCreate {
+	mutation := newSecurityRulePackVersionMutation(c.config, OpCreate)
+	return &SecurityRulePackVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityRulePackVersion entities.
+func (c *SecurityRulePackVersionClient) CreateBulk(builders ...*SecurityRulePackVersionCreate) *SecurityRulePackVersionCreateBulk {
+	return &SecurityRulePackVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityRulePackVersionClient) MapCreateBulk(slice any, setFunc func(*SecurityRulePackVersionCreate, int)) *SecurityRulePackVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityRulePackVersionCreateBulk{err: fmt.Errorf("calling to SecurityRulePackVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityRulePackVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityRulePackVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityRulePackVersion.
+func (c *SecurityRulePackVersionClient) Update() *SecurityRulePackVersionUpdate {
+	mutation := newSecurityRulePackVersionMutation(c.config, OpUpdate)
+	return &SecurityRulePackVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityRulePackVersionClient) UpdateOne(srpv *SecurityRulePackVersion) *SecurityRulePackVersionUpdateOne {
+	mutation := newSecurityRulePackVersionMutation(c.config, OpUpdateOne, withSecurityRulePackVersion(srpv))
+	return &SecurityRulePackVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityRulePackVersionClient) UpdateOneID(id uuid.UUID) *SecurityRulePackVersionUpdateOne {
+	mutation := newSecurityRulePackVersionMutation(c.config, OpUpdateOne, withSecurityRulePackVersionID(id))
+	return &SecurityRulePackVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityRulePackVersion.
+func (c *SecurityRulePackVersionClient) Delete() *SecurityRulePackVersionDelete {
+	mutation := newSecurityRulePackVersionMutation(c.config, OpDelete)
+	return &SecurityRulePackVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityRulePackVersionClient) DeleteOne(srpv *SecurityRulePackVersion) *SecurityRulePackVersionDeleteOne {
+	return c.DeleteOneID(srpv.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityRulePackVersionClient) DeleteOneID(id uuid.UUID) *SecurityRulePackVersionDeleteOne {
+	builder := c.Delete().Where(securityrulepackversion.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityRulePackVersionDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityRulePackVersion.
+func (c *SecurityRulePackVersionClient) Query() *SecurityRulePackVersionQuery {
+	return &SecurityRulePackVersionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityRulePackVersion},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityRulePackVersion entity by its id.
+func (c *SecurityRulePackVersionClient) Get(ctx context.Context, id uuid.UUID) (*SecurityRulePackVersion, error) {
+	return c.Query().Where(securityrulepackversion.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityRulePackVersionClient) GetX(ctx context.Context, id uuid.UUID) *SecurityRulePackVersion {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPack queries the pack edge of a SecurityRulePackVersion.
+func (c *SecurityRulePackVersionClient) QueryPack(srpv *SecurityRulePackVersion) *SecurityRulePackQuery {
+	query := (&SecurityRulePackClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := srpv.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityrulepackversion.Table, securityrulepackversion.FieldID, id),
+			sqlgraph.To(securityrulepack.Table, securityrulepack.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, securityrulepackversion.PackTable, securityrulepackversion.PackColumn),
+		)
+		fromV = sqlgraph.Neighbors(srpv.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SecurityRulePackVersionClient) Hooks() []Hook {
+	return c.hooks.SecurityRulePackVersion
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecurityRulePackVersionClient) Interceptors() []Interceptor {
+	return c.inters.SecurityRulePackVersion
+}
+
+func (c *SecurityRulePackVersionClient) mutate(ctx context.Context, m *SecurityRulePackVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecurityRulePackVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecurityRulePackVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecurityRulePackVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecurityRulePackVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SecurityRulePackVersion mutation op: %q", m.Op())
+	}
+}
+
 // SecurityScanningClient is a client for the SecurityScanning schema.
 type SecurityScanningClient struct {
 	config
@@ -2999,31 +4066,313 @@ func (c *SecurityScanningClient) QueryWorkspaceEdge(ss *SecurityScanning) *Works
 		fromV = sqlgraph.Neighbors(ss.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SecurityScanningClient) Hooks() []Hook {
+	return c.hooks.SecurityScanning
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecurityScanningClient) Interceptors() []Interceptor {
+	return c.inters.SecurityScanning
+}
+
+func (c *SecurityScanningClient) mutate(ctx context.Context, m *SecurityScanningMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecurityScanningCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecurityScanningUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecurityScanningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecurityScanningDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SecurityScanning mutation op: %q", m.Op())
+	}
+}
+
+// SecurityScanningCommentClient is a client for the SecurityScanningComment schema.
+type SecurityScanningCommentClient struct {
+	config
+}
+
+// NewSecurityScanningCommentClient returns a client for the SecurityScanningComment from the given config.
+func NewSecurityScanningCommentClient(c config) *SecurityScanningCommentClient {
+	return &SecurityScanningCommentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityscanningcomment.Hooks(f(g(h())))`.
+func (c *SecurityScanningCommentClient) Use(hooks ...Hook) {
+	c.hooks.SecurityScanningComment = append(c.hooks.SecurityScanningComment, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityscanningcomment.Intercept(f(g(h())))`.
+func (c *SecurityScanningCommentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityScanningComment = append(c.inters.SecurityScanningComment, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityScanningComment entity.
+func (c *SecurityScanningCommentClient) Create() *SecurityScanningCommentCreate {
+	mutation := newSecurityScanningCommentMutation(c.config, OpCreate)
+	return &SecurityScanningCommentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityScanningComment entities.
+func (c *SecurityScanningCommentClient) CreateBulk(builders ...*SecurityScanningCommentCreate) *SecurityScanningCommentCreateBulk {
+	return &SecurityScanningCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityScanningCommentClient) MapCreateBulk(slice any, setFunc func(*SecurityScanningCommentCreate, int)) *SecurityScanningCommentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityScanningCommentCreateBulk{err: fmt.Errorf("calling to SecurityScanningCommentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityScanningCommentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityScanningCommentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityScanningComment.
+func (c *SecurityScanningCommentClient) Update() *SecurityScanningCommentUpdate {
+	mutation := newSecurityScanningCommentMutation(c.config, OpUpdate)
+	return &SecurityScanningCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityScanningCommentClient) UpdateOne(ssc *SecurityScanningComment) *SecurityScanningCommentUpdateOne {
+	mutation := newSecurityScanningCommentMutation(c.config, OpUpdateOne, withSecurityScanningComment(ssc))
+	return &SecurityScanningCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityScanningCommentClient) UpdateOneID(id uuid.UUID) *SecurityScanningCommentUpdateOne {
+	mutation := newSecurityScanningCommentMutation(c.config, OpUpdateOne, withSecurityScanningCommentID(id))
+	return &SecurityScanningCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityScanningComment.
+func (c *SecurityScanningCommentClient) Delete() *SecurityScanningCommentDelete {
+	mutation := newSecurityScanningCommentMutation(c.config, OpDelete)
+	return &SecurityScanningCommentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityScanningCommentClient) DeleteOne(ssc *SecurityScanningComment) *SecurityScanningCommentDeleteOne {
+	return c.DeleteOneID(ssc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityScanningCommentClient) DeleteOneID(id uuid.UUID) *SecurityScanningCommentDeleteOne {
+	builder := c.Delete().Where(securityscanningcomment.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityScanningCommentDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityScanningComment.
+func (c *SecurityScanningCommentClient) Query() *SecurityScanningCommentQuery {
+	return &SecurityScanningCommentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityScanningComment},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityScanningComment entity by its id.
+func (c *SecurityScanningCommentClient) Get(ctx context.Context, id uuid.UUID) (*SecurityScanningComment, error) {
+	return c.Query().Where(securityscanningcomment.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityScanningCommentClient) GetX(ctx context.Context, id uuid.UUID) *SecurityScanningComment {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryResult queries the result edge of a SecurityScanningComment.
+func (c *SecurityScanningCommentClient) QueryResult(ssc *SecurityScanningComment) *SecurityScanningResultQuery {
+	query := (&SecurityScanningResultClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ssc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityscanningcomment.Table, securityscanningcomment.FieldID, id),
+			sqlgraph.To(securityscanningresult.Table, securityscanningresult.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, securityscanningcomment.ResultTable, securityscanningcomment.ResultColumn),
+		)
+		fromV = sqlgraph.Neighbors(ssc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SecurityScanningCommentClient) Hooks() []Hook {
+	return c.hooks.SecurityScanningComment
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecurityScanningCommentClient) Interceptors() []Interceptor {
+	return c.inters.SecurityScanningComment
+}
+
+func (c *SecurityScanningCommentClient) mutate(ctx context.Context, m *SecurityScanningCommentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecurityScanningCommentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecurityScanningCommentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecurityScanningCommentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecurityScanningCommentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SecurityScanningComment mutation op: %q", m.Op())
+	}
+}
+
+// SecurityScanningPolicyClient is a client for the SecurityScanningPolicy schema.
+type SecurityScanningPolicyClient struct {
+	config
+}
+
+// NewSecurityScanningPolicyClient returns a client for the SecurityScanningPolicy from the given config.
+func NewSecurityScanningPolicyClient(c config) *SecurityScanningPolicyClient {
+	return &SecurityScanningPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityscanningpolicy.Hooks(f(g(h())))`.
+func (c *SecurityScanningPolicyClient) Use(hooks ...Hook) {
+	c.hooks.SecurityScanningPolicy = append(c.hooks.SecurityScanningPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityscanningpolicy.Intercept(f(g(h())))`.
+func (c *SecurityScanningPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityScanningPolicy = append(c.inters.SecurityScanningPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityScanningPolicy entity.
+func (c *SecurityScanningPolicyClient) Create() *SecurityScanningPolicyCreate {
+	mutation := newSecurityScanningPolicyMutation(c.config, OpCreate)
+	return &SecurityScanningPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityScanningPolicy entities.
+func (c *SecurityScanningPolicyClient) CreateBulk(builders ...*SecurityScanningPolicyCreate) *SecurityScanningPolicyCreateBulk {
+	return &SecurityScanningPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityScanningPolicyClient) MapCreateBulk(slice any, setFunc func(*SecurityScanningPolicyCreate, int)) *SecurityScanningPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityScanningPolicyCreateBulk{err: fmt.Errorf("calling to SecurityScanningPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityScanningPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityScanningPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityScanningPolicy.
+func (c *SecurityScanningPolicyClient) Update() *SecurityScanningPolicyUpdate {
+	mutation := newSecurityScanningPolicyMutation(c.config, OpUpdate)
+	return &SecurityScanningPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityScanningPolicyClient) UpdateOne(ssp *SecurityScanningPolicy) *SecurityScanningPolicyUpdateOne {
+	mutation := newSecurityScanningPolicyMutation(c.config, OpUpdateOne, withSecurityScanningPolicy(ssp))
+	return &SecurityScanningPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityScanningPolicyClient) UpdateOneID(id uuid.UUID) *SecurityScanningPolicyUpdateOne {
+	mutation := newSecurityScanningPolicyMutation(c.config, OpUpdateOne, withSecurityScanningPolicyID(id))
+	return &SecurityScanningPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityScanningPolicy.
+func (c *SecurityScanningPolicyClient) Delete() *SecurityScanningPolicyDelete {
+	mutation := newSecurityScanningPolicyMutation(c.config, OpDelete)
+	return &SecurityScanningPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityScanningPolicyClient) DeleteOne(ssp *SecurityScanningPolicy) *SecurityScanningPolicyDeleteOne {
+	return c.DeleteOneID(ssp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityScanningPolicyClient) DeleteOneID(id uuid.UUID) *SecurityScanningPolicyDeleteOne {
+	builder := c.Delete().Where(securityscanningpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityScanningPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityScanningPolicy.
+func (c *SecurityScanningPolicyClient) Query() *SecurityScanningPolicyQuery {
+	return &SecurityScanningPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityScanningPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityScanningPolicy entity by its id.
+func (c *SecurityScanningPolicyClient) Get(ctx context.Context, id uuid.UUID) (*SecurityScanningPolicy, error) {
+	return c.Query().Where(securityscanningpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityScanningPolicyClient) GetX(ctx context.Context, id uuid.UUID) *SecurityScanningPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *SecurityScanningClient) Hooks() []Hook {
-	return c.hooks.SecurityScanning
+func (c *SecurityScanningPolicyClient) Hooks() []Hook {
+	return c.hooks.SecurityScanningPolicy
 }
 
 // Interceptors returns the client interceptors.
-func (c *SecurityScanningClient) Interceptors() []Interceptor {
-	return c.inters.SecurityScanning
+func (c *SecurityScanningPolicyClient) Interceptors() []Interceptor {
+	return c.inters.SecurityScanningPolicy
 }
 
-func (c *SecurityScanningClient) mutate(ctx context.Context, m *SecurityScanningMutation) (Value, error) {
+func (c *SecurityScanningPolicyClient) mutate(ctx context.Context, m *SecurityScanningPolicyMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SecurityScanningCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecurityScanningPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SecurityScanningUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecurityScanningPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SecurityScanningUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&SecurityScanningPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SecurityScanningDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&SecurityScanningPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("db: unknown SecurityScanning mutation op: %q", m.Op())
+		return nil, fmt.Errorf("db: unknown SecurityScanningPolicy mutation op: %q", m.Op())
 	}
 }
 
@@ -3151,6 +4500,22 @@ func (c *SecurityScanningResultClient) QuerySecurityScanning(ssr *SecurityScanni
 	return query
 }
 
+// QueryComments queries the comments edge of a SecurityScanningResult.
+func (c *SecurityScanningResultClient) QueryComments(ssr *SecurityScanningResult) *SecurityScanningCommentQuery {
+	query := (&SecurityScanningCommentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ssr.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityscanningresult.Table, securityscanningresult.FieldID, id),
+			sqlgraph.To(securityscanningcomment.Table, securityscanningcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, securityscanningresult.CommentsTable, securityscanningresult.CommentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ssr.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SecurityScanningResultClient) Hooks() []Hook {
 	return c.hooks.SecurityScanningResult
@@ -3176,6 +4541,139 @@ func (c *SecurityScanningResultClient) mutate(ctx context.Context, m *SecuritySc
 	}
 }
 
+// SecurityScanningSuppressionClient is a client for the SecurityScanningSuppression schema.
+type SecurityScanningSuppressionClient struct {
+	config
+}
+
+// NewSecurityScanningSuppressionClient returns a client for the SecurityScanningSuppression from the given config.
+func NewSecurityScanningSuppressionClient(c config) *SecurityScanningSuppressionClient {
+	return &SecurityScanningSuppressionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `securityscanningsuppression.Hooks(f(g(h())))`.
+func (c *SecurityScanningSuppressionClient) Use(hooks ...Hook) {
+	c.hooks.SecurityScanningSuppression = append(c.hooks.SecurityScanningSuppression, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `securityscanningsuppression.Intercept(f(g(h())))`.
+func (c *SecurityScanningSuppressionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SecurityScanningSuppression = append(c.inters.SecurityScanningSuppression, interceptors...)
+}
+
+// Create returns a builder for creating a SecurityScanningSuppression entity.
+func (c *SecurityScanningSuppressionClient) Create() *SecurityScanningSuppressionCreate {
+	mutation := newSecurityScanningSuppressionMutation(c.config, OpCreate)
+	return &SecurityScanningSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SecurityScanningSuppression entities.
+func (c *SecurityScanningSuppressionClient) CreateBulk(builders ...*SecurityScanningSuppressionCreate) *SecurityScanningSuppressionCreateBulk {
+	return &SecurityScanningSuppressionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SecurityScanningSuppressionClient) MapCreateBulk(slice any, setFunc func(*SecurityScanningSuppressionCreate, int)) *SecurityScanningSuppressionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SecurityScanningSuppressionCreateBulk{err: fmt.Errorf("calling to SecurityScanningSuppressionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SecurityScanningSuppressionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SecurityScanningSuppressionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SecurityScanningSuppression.
+func (c *SecurityScanningSuppressionClient) Update() *SecurityScanningSuppressionUpdate {
+	mutation := newSecurityScanningSuppressionMutation(c.config, OpUpdate)
+	return &SecurityScanningSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SecurityScanningSuppressionClient) UpdateOne(sss *SecurityScanningSuppression) *SecurityScanningSuppressionUpdateOne {
+	mutation := newSecurityScanningSuppressionMutation(c.config, OpUpdateOne, withSecurityScanningSuppression(sss))
+	return &SecurityScanningSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SecurityScanningSuppressionClient) UpdateOneID(id uuid.UUID) *SecurityScanningSuppressionUpdateOne {
+	mutation := newSecurityScanningSuppressionMutation(c.config, OpUpdateOne, withSecurityScanningSuppressionID(id))
+	return &SecurityScanningSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SecurityScanningSuppression.
+func (c *SecurityScanningSuppressionClient) Delete() *SecurityScanningSuppressionDelete {
+	mutation := newSecurityScanningSuppressionMutation(c.config, OpDelete)
+	return &SecurityScanningSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SecurityScanningSuppressionClient) DeleteOne(sss *SecurityScanningSuppression) *SecurityScanningSuppressionDeleteOne {
+	return c.DeleteOneID(sss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SecurityScanningSuppressionClient) DeleteOneID(id uuid.UUID) *SecurityScanningSuppressionDeleteOne {
+	builder := c.Delete().Where(securityscanningsuppression.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SecurityScanningSuppressionDeleteOne{builder}
+}
+
+// Query returns a query builder for SecurityScanningSuppression.
+func (c *SecurityScanningSuppressionClient) Query() *SecurityScanningSuppressionQuery {
+	return &SecurityScanningSuppressionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSecurityScanningSuppression},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SecurityScanningSuppression entity by its id.
+func (c *SecurityScanningSuppressionClient) Get(ctx context.Context, id uuid.UUID) (*SecurityScanningSuppression, error) {
+	return c.Query().Where(securityscanningsuppression.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SecurityScanningSuppressionClient) GetX(ctx context.Context, id uuid.UUID) *SecurityScanningSuppression {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SecurityScanningSuppressionClient) Hooks() []Hook {
+	return c.hooks.SecurityScanningSuppression
+}
+
+// Interceptors returns the client interceptors.
+func (c *SecurityScanningSuppressionClient) Interceptors() []Interceptor {
+	return c.inters.SecurityScanningSuppression
+}
+
+func (c *SecurityScanningSuppressionClient) mutate(ctx context.Context, m *SecurityScanningSuppressionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SecurityScanningSuppressionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SecurityScanningSuppressionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SecurityScanningSuppressionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SecurityScanningSuppressionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown SecurityScanningSuppression mutation op: %q", m.Op())
+	}
+}
+
 // SettingClient is a client for the Setting schema.
 type SettingClient struct {
 	config
@@ -3639,6 +5137,272 @@ func (c *TaskRecordClient) mutate(ctx context.Context, m *TaskRecordMutation) (V
 	}
 }
 
+// TransformPolicyClient is a client for the TransformPolicy schema.
+type TransformPolicyClient struct {
+	config
+}
+
+// NewTransformPolicyClient returns a client for the TransformPolicy from the given config.
+func NewTransformPolicyClient(c config) *TransformPolicyClient {
+	return &TransformPolicyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transformpolicy.Hooks(f(g(h())))`.
+func (c *TransformPolicyClient) Use(hooks ...Hook) {
+	c.hooks.TransformPolicy = append(c.hooks.TransformPolicy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transformpolicy.Intercept(f(g(h())))`.
+func (c *TransformPolicyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TransformPolicy = append(c.inters.TransformPolicy, interceptors...)
+}
+
+// Create returns a builder for creating a TransformPolicy entity.
+func (c *TransformPolicyClient) Create() *TransformPolicyCreate {
+	mutation := newTransformPolicyMutation(c.config, OpCreate)
+	return &TransformPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TransformPolicy entities.
+func (c *TransformPolicyClient) CreateBulk(builders ...*TransformPolicyCreate) *TransformPolicyCreateBulk {
+	return &TransformPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransformPolicyClient) MapCreateBulk(slice any, setFunc func(*TransformPolicyCreate, int)) *TransformPolicyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransformPolicyCreateBulk{err: fmt.Errorf("calling to TransformPolicyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransformPolicyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransformPolicyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TransformPolicy.
+func (c *TransformPolicyClient) Update() *TransformPolicyUpdate {
+	mutation := newTransformPolicyMutation(c.config, OpUpdate)
+	return &TransformPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransformPolicyClient) UpdateOne(tp *TransformPolicy) *TransformPolicyUpdateOne {
+	mutation := newTransformPolicyMutation(c.config, OpUpdateOne, withTransformPolicy(tp))
+	return &TransformPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransformPolicyClient) UpdateOneID(id uuid.UUID) *TransformPolicyUpdateOne {
+	mutation := newTransformPolicyMutation(c.config, OpUpdateOne, withTransformPolicyID(id))
+	return &TransformPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TransformPolicy.
+func (c *TransformPolicyClient) Delete() *TransformPolicyDelete {
+	mutation := newTransformPolicyMutation(c.config, OpDelete)
+	return &TransformPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransformPolicyClient) DeleteOne(tp *TransformPolicy) *TransformPolicyDeleteOne {
+	return c.DeleteOneID(tp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransformPolicyClient) DeleteOneID(id uuid.UUID) *TransformPolicyDeleteOne {
+	builder := c.Delete().Where(transformpolicy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransformPolicyDeleteOne{builder}
+}
+
+// Query returns a query builder for TransformPolicy.
+func (c *TransformPolicyClient) Query() *TransformPolicyQuery {
+	return &TransformPolicyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransformPolicy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TransformPolicy entity by its id.
+func (c *TransformPolicyClient) Get(ctx context.Context, id uuid.UUID) (*TransformPolicy, error) {
+	return c.Query().Where(transformpolicy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransformPolicyClient) GetX(ctx context.Context, id uuid.UUID) *TransformPolicy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TransformPolicyClient) Hooks() []Hook {
+	return c.hooks.TransformPolicy
+}
+
+// Interceptors returns the client interceptors.
+func (c *TransformPolicyClient) Interceptors() []Interceptor {
+	return c.inters.TransformPolicy
+}
+
+func (c *TransformPolicyClient) mutate(ctx context.Context, m *TransformPolicyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransformPolicyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TransformPolicyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TransformPolicyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TransformPolicyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown TransformPolicy mutation op: %q", m.Op())
+	}
+}
+
+// TransformRuleClient is a client for the TransformRule schema.
+type TransformRuleClient struct {
+	config
+}
+
+// NewTransformRuleClient returns a client for the TransformRule from the given config.
+func NewTransformRuleClient(c config) *TransformRuleClient {
+	return &TransformRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `transformrule.Hooks(f(g(h())))`.
+func (c *TransformRuleClient) Use(hooks ...Hook) {
+	c.hooks.TransformRule = append(c.hooks.TransformRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `transformrule.Intercept(f(g(h())))`.
+func (c *TransformRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TransformRule = append(c.inters.TransformRule, interceptors...)
+}
+
+// Create returns a builder for creating a TransformRule entity.
+func (c *TransformRuleClient) Create() *TransformRuleCreate {
+	mutation := newTransformRuleMutation(c.config, OpCreate)
+	return &TransformRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TransformRule entities.
+func (c *TransformRuleClient) CreateBulk(builders ...*TransformRuleCreate) *TransformRuleCreateBulk {
+	return &TransformRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TransformRuleClient) MapCreateBulk(slice any, setFunc func(*TransformRuleCreate, int)) *TransformRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TransformRuleCreateBulk{err: fmt.Errorf("calling to TransformRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TransformRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TransformRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TransformRule.
+func (c *TransformRuleClient) Update() *TransformRuleUpdate {
+	mutation := newTransformRuleMutation(c.config, OpUpdate)
+	return &TransformRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TransformRuleClient) UpdateOne(tr *TransformRule) *TransformRuleUpdateOne {
+	mutation := newTransformRuleMutation(c.config, OpUpdateOne, withTransformRule(tr))
+	return &TransformRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TransformRuleClient) UpdateOneID(id uuid.UUID) *TransformRuleUpdateOne {
+	mutation := newTransformRuleMutation(c.config, OpUpdateOne, withTransformRuleID(id))
+	return &TransformRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TransformRule.
+func (c *TransformRuleClient) Delete() *TransformRuleDelete {
+	mutation := newTransformRuleMutation(c.config, OpDelete)
+	return &TransformRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TransformRuleClient) DeleteOne(tr *TransformRule) *TransformRuleDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TransformRuleClient) DeleteOneID(id uuid.UUID) *TransformRuleDeleteOne {
+	builder := c.Delete().Where(transformrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TransformRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for TransformRule.
+func (c *TransformRuleClient) Query() *TransformRuleQuery {
+	return &TransformRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTransformRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TransformRule entity by its id.
+func (c *TransformRuleClient) Get(ctx context.Context, id uuid.UUID) (*TransformRule, error) {
+	return c.Query().Where(transformrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TransformRuleClient) GetX(ctx context.Context, id uuid.UUID) *TransformRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TransformRuleClient) Hooks() []Hook {
+	return c.hooks.TransformRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *TransformRuleClient) Interceptors() []Interceptor {
+	return c.inters.TransformRule
+}
+
+func (c *TransformRuleClient) mutate(ctx context.Context, m *TransformRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TransformRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TransformRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TransformRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TransformRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("db: unknown TransformRule mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -5142,18 +6906,24 @@ func (c *WorkspaceFileClient) mutate(ctx context.Context, m *WorkspaceFileMutati
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Admin, AdminLoginHistory, AdminRole, ApiKey, BillingPlan, BillingQuota,
-		BillingRecord, BillingUsage, CodeSnippet, Extension, InviteCode, License,
-		Model, ModelProvider, ModelProviderModel, Role, SecurityScanning,
-		SecurityScanningResult, Setting, Task, TaskRecord, User, UserGroup,
+		Admin, AdminLoginHistory, AdminRole, ApiKey, AuditLog, BillingPlan,
+		BillingQuota, BillingRecord, BillingUsage, BudgetAlert, CodeSnippet, DLPHit,
+		Extension, InviteCode, License, Model, ModelHealthCheck, ModelProvider,
+		ModelProviderModel, ResponseCache, Role, SecurityRulePack,
+		SecurityRulePackVersion, SecurityScanning, SecurityScanningComment,
+		SecurityScanningPolicy, SecurityScanningResult, SecurityScanningSuppression,
+		Setting, Task, TaskRecord, TransformPolicy, TransformRule, User, UserGroup,
 		UserGroupAdmin, UserGroupUser, UserIdentity, UserLoginHistory, Workspace,
 		WorkspaceFile []ent.Hook
 	}
 	inters struct {
-		Admin, AdminLoginHistory, AdminRole, ApiKey, BillingPlan, BillingQuota,
-		BillingRecord, BillingUsage, CodeSnippet, Extension, InviteCode, License,
-		Model, ModelProvider, ModelProviderModel, Role, SecurityScanning,
-		SecurityScanningResult, Setting, Task, TaskRecord, User, UserGroup,
+		Admin, AdminLoginHistory, AdminRole, ApiKey, AuditLog, BillingPlan,
+		BillingQuota, BillingRecord, BillingUsage, BudgetAlert, CodeSnippet, DLPHit,
+		Extension, InviteCode, License, Model, ModelHealthCheck, ModelProvider,
+		ModelProviderModel, ResponseCache, Role, SecurityRulePack,
+		SecurityRulePackVersion, SecurityScanning, SecurityScanningComment,
+		SecurityScanningPolicy, SecurityScanningResult, SecurityScanningSuppression,
+		Setting, Task, TaskRecord, TransformPolicy, TransformRule, User, UserGroup,
 		UserGroupAdmin, UserGroupUser, UserIdentity, UserLoginHistory, Workspace,
 		WorkspaceFile []ent.Interceptor
 	}
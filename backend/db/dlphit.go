@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
+	"github.com/google/uuid"
+)
+
+// DLPHit is the model entity for the DLPHit schema.
+type DLPHit struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TaskID holds the value of the "task_id" field.
+	TaskID string `json:"task_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID string `json:"rule_id,omitempty"`
+	// RuleName holds the value of the "rule_name" field.
+	RuleName string `json:"rule_name,omitempty"`
+	// Category holds the value of the "category" field.
+	Category consts.DLPCategory `json:"category,omitempty"`
+	// Action holds the value of the "action" field.
+	Action consts.DLPAction `json:"action,omitempty"`
+	// Preview holds the value of the "preview" field.
+	Preview string `json:"preview,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int `json:"count,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DLPHit) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dlphit.FieldCount:
+			values[i] = new(sql.NullInt64)
+		case dlphit.FieldTaskID, dlphit.FieldRequestID, dlphit.FieldRuleID, dlphit.FieldRuleName, dlphit.FieldCategory, dlphit.FieldAction, dlphit.FieldPreview:
+			values[i] = new(sql.NullString)
+		case dlphit.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case dlphit.FieldID, dlphit.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DLPHit fields.
+func (dh *DLPHit) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dlphit.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				dh.ID = *value
+			}
+		case dlphit.FieldTaskID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field task_id", values[i])
+			} else if value.Valid {
+				dh.TaskID = value.String
+			}
+		case dlphit.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				dh.RequestID = value.String
+			}
+		case dlphit.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				dh.UserID = *value
+			}
+		case dlphit.FieldRuleID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				dh.RuleID = value.String
+			}
+		case dlphit.FieldRuleName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_name", values[i])
+			} else if value.Valid {
+				dh.RuleName = value.String
+			}
+		case dlphit.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				dh.Category = consts.DLPCategory(value.String)
+			}
+		case dlphit.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				dh.Action = consts.DLPAction(value.String)
+			}
+		case dlphit.FieldPreview:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field preview", values[i])
+			} else if value.Valid {
+				dh.Preview = value.String
+			}
+		case dlphit.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				dh.Count = int(value.Int64)
+			}
+		case dlphit.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dh.CreatedAt = value.Time
+			}
+		default:
+			dh.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DLPHit.
+// This includes values selected through modifiers, order, etc.
+func (dh *DLPHit) Value(name string) (ent.Value, error) {
+	return dh.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DLPHit.
+// Note that you need to call DLPHit.Unwrap() before calling this method if this DLPHit
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dh *DLPHit) Update() *DLPHitUpdateOne {
+	return NewDLPHitClient(dh.config).UpdateOne(dh)
+}
+
+// Unwrap unwraps the DLPHit entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dh *DLPHit) Unwrap() *DLPHit {
+	_tx, ok := dh.config.driver.(*txDriver)
+	if !ok {
+		panic("db: DLPHit is not a transactional entity")
+	}
+	dh.config.driver = _tx.drv
+	return dh
+}
+
+// String implements the fmt.Stringer.
+func (dh *DLPHit) String() string {
+	var builder strings.Builder
+	builder.WriteString("DLPHit(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dh.ID))
+	builder.WriteString("task_id=")
+	builder.WriteString(dh.TaskID)
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(dh.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", dh.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("rule_id=")
+	builder.WriteString(dh.RuleID)
+	builder.WriteString(", ")
+	builder.WriteString("rule_name=")
+	builder.WriteString(dh.RuleName)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(fmt.Sprintf("%v", dh.Category))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", dh.Action))
+	builder.WriteString(", ")
+	builder.WriteString("preview=")
+	builder.WriteString(dh.Preview)
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", dh.Count))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dh.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DLPHits is a parsable slice of DLPHit.
+type DLPHits []*DLPHit
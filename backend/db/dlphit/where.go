@@ -0,0 +1,777 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dlphit
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldID, id))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldTaskID, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldRequestID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldUserID, v))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleName applies equality check predicate on the "rule_name" field. It's identical to RuleNameEQ.
+func RuleName(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldRuleName, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldEQ(FieldCategory, vc))
+}
+
+// Action applies equality check predicate on the "action" field. It's identical to ActionEQ.
+func Action(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldEQ(FieldAction, vc))
+}
+
+// Preview applies equality check predicate on the "preview" field. It's identical to PreviewEQ.
+func Preview(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldPreview, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldCount, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDContains applies the Contains predicate on the "task_id" field.
+func TaskIDContains(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContains(FieldTaskID, v))
+}
+
+// TaskIDHasPrefix applies the HasPrefix predicate on the "task_id" field.
+func TaskIDHasPrefix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldTaskID, v))
+}
+
+// TaskIDHasSuffix applies the HasSuffix predicate on the "task_id" field.
+func TaskIDHasSuffix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldTaskID, v))
+}
+
+// TaskIDEqualFold applies the EqualFold predicate on the "task_id" field.
+func TaskIDEqualFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEqualFold(FieldTaskID, v))
+}
+
+// TaskIDContainsFold applies the ContainsFold predicate on the "task_id" field.
+func TaskIDContainsFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContainsFold(FieldTaskID, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDIsNil applies the IsNil predicate on the "request_id" field.
+func RequestIDIsNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIsNull(FieldRequestID))
+}
+
+// RequestIDNotNil applies the NotNil predicate on the "request_id" field.
+func RequestIDNotNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotNull(FieldRequestID))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotNull(FieldUserID))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// RuleIDGT applies the GT predicate on the "rule_id" field.
+func RuleIDGT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldRuleID, v))
+}
+
+// RuleIDGTE applies the GTE predicate on the "rule_id" field.
+func RuleIDGTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldRuleID, v))
+}
+
+// RuleIDLT applies the LT predicate on the "rule_id" field.
+func RuleIDLT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldRuleID, v))
+}
+
+// RuleIDLTE applies the LTE predicate on the "rule_id" field.
+func RuleIDLTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldRuleID, v))
+}
+
+// RuleIDContains applies the Contains predicate on the "rule_id" field.
+func RuleIDContains(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContains(FieldRuleID, v))
+}
+
+// RuleIDHasPrefix applies the HasPrefix predicate on the "rule_id" field.
+func RuleIDHasPrefix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldRuleID, v))
+}
+
+// RuleIDHasSuffix applies the HasSuffix predicate on the "rule_id" field.
+func RuleIDHasSuffix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldRuleID, v))
+}
+
+// RuleIDEqualFold applies the EqualFold predicate on the "rule_id" field.
+func RuleIDEqualFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEqualFold(FieldRuleID, v))
+}
+
+// RuleIDContainsFold applies the ContainsFold predicate on the "rule_id" field.
+func RuleIDContainsFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContainsFold(FieldRuleID, v))
+}
+
+// RuleNameEQ applies the EQ predicate on the "rule_name" field.
+func RuleNameEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldRuleName, v))
+}
+
+// RuleNameNEQ applies the NEQ predicate on the "rule_name" field.
+func RuleNameNEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldRuleName, v))
+}
+
+// RuleNameIn applies the In predicate on the "rule_name" field.
+func RuleNameIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldRuleName, vs...))
+}
+
+// RuleNameNotIn applies the NotIn predicate on the "rule_name" field.
+func RuleNameNotIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldRuleName, vs...))
+}
+
+// RuleNameGT applies the GT predicate on the "rule_name" field.
+func RuleNameGT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldRuleName, v))
+}
+
+// RuleNameGTE applies the GTE predicate on the "rule_name" field.
+func RuleNameGTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldRuleName, v))
+}
+
+// RuleNameLT applies the LT predicate on the "rule_name" field.
+func RuleNameLT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldRuleName, v))
+}
+
+// RuleNameLTE applies the LTE predicate on the "rule_name" field.
+func RuleNameLTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldRuleName, v))
+}
+
+// RuleNameContains applies the Contains predicate on the "rule_name" field.
+func RuleNameContains(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContains(FieldRuleName, v))
+}
+
+// RuleNameHasPrefix applies the HasPrefix predicate on the "rule_name" field.
+func RuleNameHasPrefix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldRuleName, v))
+}
+
+// RuleNameHasSuffix applies the HasSuffix predicate on the "rule_name" field.
+func RuleNameHasSuffix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldRuleName, v))
+}
+
+// RuleNameIsNil applies the IsNil predicate on the "rule_name" field.
+func RuleNameIsNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIsNull(FieldRuleName))
+}
+
+// RuleNameNotNil applies the NotNil predicate on the "rule_name" field.
+func RuleNameNotNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotNull(FieldRuleName))
+}
+
+// RuleNameEqualFold applies the EqualFold predicate on the "rule_name" field.
+func RuleNameEqualFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEqualFold(FieldRuleName, v))
+}
+
+// RuleNameContainsFold applies the ContainsFold predicate on the "rule_name" field.
+func RuleNameContainsFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContainsFold(FieldRuleName, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldEQ(FieldCategory, vc))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldNEQ(FieldCategory, vc))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...consts.DLPCategory) predicate.DLPHit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.DLPHit(sql.FieldIn(FieldCategory, v...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...consts.DLPCategory) predicate.DLPHit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.DLPHit(sql.FieldNotIn(FieldCategory, v...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldGT(FieldCategory, vc))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldGTE(FieldCategory, vc))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldLT(FieldCategory, vc))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldLTE(FieldCategory, vc))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldContains(FieldCategory, vc))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldCategory, vc))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldCategory, vc))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldEqualFold(FieldCategory, vc))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v consts.DLPCategory) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldContainsFold(FieldCategory, vc))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldEQ(FieldAction, vc))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldNEQ(FieldAction, vc))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...consts.DLPAction) predicate.DLPHit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.DLPHit(sql.FieldIn(FieldAction, v...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...consts.DLPAction) predicate.DLPHit {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.DLPHit(sql.FieldNotIn(FieldAction, v...))
+}
+
+// ActionGT applies the GT predicate on the "action" field.
+func ActionGT(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldGT(FieldAction, vc))
+}
+
+// ActionGTE applies the GTE predicate on the "action" field.
+func ActionGTE(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldGTE(FieldAction, vc))
+}
+
+// ActionLT applies the LT predicate on the "action" field.
+func ActionLT(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldLT(FieldAction, vc))
+}
+
+// ActionLTE applies the LTE predicate on the "action" field.
+func ActionLTE(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldLTE(FieldAction, vc))
+}
+
+// ActionContains applies the Contains predicate on the "action" field.
+func ActionContains(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldContains(FieldAction, vc))
+}
+
+// ActionHasPrefix applies the HasPrefix predicate on the "action" field.
+func ActionHasPrefix(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldAction, vc))
+}
+
+// ActionHasSuffix applies the HasSuffix predicate on the "action" field.
+func ActionHasSuffix(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldAction, vc))
+}
+
+// ActionEqualFold applies the EqualFold predicate on the "action" field.
+func ActionEqualFold(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldEqualFold(FieldAction, vc))
+}
+
+// ActionContainsFold applies the ContainsFold predicate on the "action" field.
+func ActionContainsFold(v consts.DLPAction) predicate.DLPHit {
+	vc := string(v)
+	return predicate.DLPHit(sql.FieldContainsFold(FieldAction, vc))
+}
+
+// PreviewEQ applies the EQ predicate on the "preview" field.
+func PreviewEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldPreview, v))
+}
+
+// PreviewNEQ applies the NEQ predicate on the "preview" field.
+func PreviewNEQ(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldPreview, v))
+}
+
+// PreviewIn applies the In predicate on the "preview" field.
+func PreviewIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldPreview, vs...))
+}
+
+// PreviewNotIn applies the NotIn predicate on the "preview" field.
+func PreviewNotIn(vs ...string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldPreview, vs...))
+}
+
+// PreviewGT applies the GT predicate on the "preview" field.
+func PreviewGT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldPreview, v))
+}
+
+// PreviewGTE applies the GTE predicate on the "preview" field.
+func PreviewGTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldPreview, v))
+}
+
+// PreviewLT applies the LT predicate on the "preview" field.
+func PreviewLT(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldPreview, v))
+}
+
+// PreviewLTE applies the LTE predicate on the "preview" field.
+func PreviewLTE(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldPreview, v))
+}
+
+// PreviewContains applies the Contains predicate on the "preview" field.
+func PreviewContains(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContains(FieldPreview, v))
+}
+
+// PreviewHasPrefix applies the HasPrefix predicate on the "preview" field.
+func PreviewHasPrefix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasPrefix(FieldPreview, v))
+}
+
+// PreviewHasSuffix applies the HasSuffix predicate on the "preview" field.
+func PreviewHasSuffix(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldHasSuffix(FieldPreview, v))
+}
+
+// PreviewIsNil applies the IsNil predicate on the "preview" field.
+func PreviewIsNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIsNull(FieldPreview))
+}
+
+// PreviewNotNil applies the NotNil predicate on the "preview" field.
+func PreviewNotNil() predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotNull(FieldPreview))
+}
+
+// PreviewEqualFold applies the EqualFold predicate on the "preview" field.
+func PreviewEqualFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEqualFold(FieldPreview, v))
+}
+
+// PreviewContainsFold applies the ContainsFold predicate on the "preview" field.
+func PreviewContainsFold(v string) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldContainsFold(FieldPreview, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DLPHit {
+	return predicate.DLPHit(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DLPHit) predicate.DLPHit {
+	return predicate.DLPHit(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DLPHit) predicate.DLPHit {
+	return predicate.DLPHit(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DLPHit) predicate.DLPHit {
+	return predicate.DLPHit(sql.NotPredicates(p))
+}
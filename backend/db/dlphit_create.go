@@ -0,0 +1,1131 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
+	"github.com/google/uuid"
+)
+
+// DLPHitCreate is the builder for creating a DLPHit entity.
+type DLPHitCreate struct {
+	config
+	mutation *DLPHitMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTaskID sets the "task_id" field.
+func (dhc *DLPHitCreate) SetTaskID(s string) *DLPHitCreate {
+	dhc.mutation.SetTaskID(s)
+	return dhc
+}
+
+// SetRequestID sets the "request_id" field.
+func (dhc *DLPHitCreate) SetRequestID(s string) *DLPHitCreate {
+	dhc.mutation.SetRequestID(s)
+	return dhc
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillableRequestID(s *string) *DLPHitCreate {
+	if s != nil {
+		dhc.SetRequestID(*s)
+	}
+	return dhc
+}
+
+// SetUserID sets the "user_id" field.
+func (dhc *DLPHitCreate) SetUserID(u uuid.UUID) *DLPHitCreate {
+	dhc.mutation.SetUserID(u)
+	return dhc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillableUserID(u *uuid.UUID) *DLPHitCreate {
+	if u != nil {
+		dhc.SetUserID(*u)
+	}
+	return dhc
+}
+
+// SetRuleID sets the "rule_id" field.
+func (dhc *DLPHitCreate) SetRuleID(s string) *DLPHitCreate {
+	dhc.mutation.SetRuleID(s)
+	return dhc
+}
+
+// SetRuleName sets the "rule_name" field.
+func (dhc *DLPHitCreate) SetRuleName(s string) *DLPHitCreate {
+	dhc.mutation.SetRuleName(s)
+	return dhc
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillableRuleName(s *string) *DLPHitCreate {
+	if s != nil {
+		dhc.SetRuleName(*s)
+	}
+	return dhc
+}
+
+// SetCategory sets the "category" field.
+func (dhc *DLPHitCreate) SetCategory(cc consts.DLPCategory) *DLPHitCreate {
+	dhc.mutation.SetCategory(cc)
+	return dhc
+}
+
+// SetAction sets the "action" field.
+func (dhc *DLPHitCreate) SetAction(ca consts.DLPAction) *DLPHitCreate {
+	dhc.mutation.SetAction(ca)
+	return dhc
+}
+
+// SetPreview sets the "preview" field.
+func (dhc *DLPHitCreate) SetPreview(s string) *DLPHitCreate {
+	dhc.mutation.SetPreview(s)
+	return dhc
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillablePreview(s *string) *DLPHitCreate {
+	if s != nil {
+		dhc.SetPreview(*s)
+	}
+	return dhc
+}
+
+// SetCount sets the "count" field.
+func (dhc *DLPHitCreate) SetCount(i int) *DLPHitCreate {
+	dhc.mutation.SetCount(i)
+	return dhc
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillableCount(i *int) *DLPHitCreate {
+	if i != nil {
+		dhc.SetCount(*i)
+	}
+	return dhc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dhc *DLPHitCreate) SetCreatedAt(t time.Time) *DLPHitCreate {
+	dhc.mutation.SetCreatedAt(t)
+	return dhc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillableCreatedAt(t *time.Time) *DLPHitCreate {
+	if t != nil {
+		dhc.SetCreatedAt(*t)
+	}
+	return dhc
+}
+
+// SetID sets the "id" field.
+func (dhc *DLPHitCreate) SetID(u uuid.UUID) *DLPHitCreate {
+	dhc.mutation.SetID(u)
+	return dhc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (dhc *DLPHitCreate) SetNillableID(u *uuid.UUID) *DLPHitCreate {
+	if u != nil {
+		dhc.SetID(*u)
+	}
+	return dhc
+}
+
+// Mutation returns the DLPHitMutation object of the builder.
+func (dhc *DLPHitCreate) Mutation() *DLPHitMutation {
+	return dhc.mutation
+}
+
+// Save creates the DLPHit in the database.
+func (dhc *DLPHitCreate) Save(ctx context.Context) (*DLPHit, error) {
+	dhc.defaults()
+	return withHooks(ctx, dhc.sqlSave, dhc.mutation, dhc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dhc *DLPHitCreate) SaveX(ctx context.Context) *DLPHit {
+	v, err := dhc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dhc *DLPHitCreate) Exec(ctx context.Context) error {
+	_, err := dhc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dhc *DLPHitCreate) ExecX(ctx context.Context) {
+	if err := dhc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dhc *DLPHitCreate) defaults() {
+	if _, ok := dhc.mutation.Count(); !ok {
+		v := dlphit.DefaultCount
+		dhc.mutation.SetCount(v)
+	}
+	if _, ok := dhc.mutation.CreatedAt(); !ok {
+		v := dlphit.DefaultCreatedAt()
+		dhc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dhc.mutation.ID(); !ok {
+		v := dlphit.DefaultID()
+		dhc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dhc *DLPHitCreate) check() error {
+	if _, ok := dhc.mutation.TaskID(); !ok {
+		return &ValidationError{Name: "task_id", err: errors.New(`db: missing required field "DLPHit.task_id"`)}
+	}
+	if _, ok := dhc.mutation.RuleID(); !ok {
+		return &ValidationError{Name: "rule_id", err: errors.New(`db: missing required field "DLPHit.rule_id"`)}
+	}
+	if _, ok := dhc.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`db: missing required field "DLPHit.category"`)}
+	}
+	if _, ok := dhc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`db: missing required field "DLPHit.action"`)}
+	}
+	if _, ok := dhc.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`db: missing required field "DLPHit.count"`)}
+	}
+	if _, ok := dhc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "DLPHit.created_at"`)}
+	}
+	return nil
+}
+
+func (dhc *DLPHitCreate) sqlSave(ctx context.Context) (*DLPHit, error) {
+	if err := dhc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dhc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dhc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	dhc.mutation.id = &_node.ID
+	dhc.mutation.done = true
+	return _node, nil
+}
+
+func (dhc *DLPHitCreate) createSpec() (*DLPHit, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DLPHit{config: dhc.config}
+		_spec = sqlgraph.NewCreateSpec(dlphit.Table, sqlgraph.NewFieldSpec(dlphit.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = dhc.conflict
+	if id, ok := dhc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := dhc.mutation.TaskID(); ok {
+		_spec.SetField(dlphit.FieldTaskID, field.TypeString, value)
+		_node.TaskID = value
+	}
+	if value, ok := dhc.mutation.RequestID(); ok {
+		_spec.SetField(dlphit.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := dhc.mutation.UserID(); ok {
+		_spec.SetField(dlphit.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := dhc.mutation.RuleID(); ok {
+		_spec.SetField(dlphit.FieldRuleID, field.TypeString, value)
+		_node.RuleID = value
+	}
+	if value, ok := dhc.mutation.RuleName(); ok {
+		_spec.SetField(dlphit.FieldRuleName, field.TypeString, value)
+		_node.RuleName = value
+	}
+	if value, ok := dhc.mutation.Category(); ok {
+		_spec.SetField(dlphit.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := dhc.mutation.Action(); ok {
+		_spec.SetField(dlphit.FieldAction, field.TypeString, value)
+		_node.Action = value
+	}
+	if value, ok := dhc.mutation.Preview(); ok {
+		_spec.SetField(dlphit.FieldPreview, field.TypeString, value)
+		_node.Preview = value
+	}
+	if value, ok := dhc.mutation.Count(); ok {
+		_spec.SetField(dlphit.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := dhc.mutation.CreatedAt(); ok {
+		_spec.SetField(dlphit.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DLPHit.Create().
+//		SetTaskID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DLPHitUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (dhc *DLPHitCreate) OnConflict(opts ...sql.ConflictOption) *DLPHitUpsertOne {
+	dhc.conflict = opts
+	return &DLPHitUpsertOne{
+		create: dhc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DLPHit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dhc *DLPHitCreate) OnConflictColumns(columns ...string) *DLPHitUpsertOne {
+	dhc.conflict = append(dhc.conflict, sql.ConflictColumns(columns...))
+	return &DLPHitUpsertOne{
+		create: dhc,
+	}
+}
+
+type (
+	// DLPHitUpsertOne is the builder for "upsert"-ing
+	//  one DLPHit node.
+	DLPHitUpsertOne struct {
+		create *DLPHitCreate
+	}
+
+	// DLPHitUpsert is the "OnConflict" setter.
+	DLPHitUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetTaskID sets the "task_id" field.
+func (u *DLPHitUpsert) SetTaskID(v string) *DLPHitUpsert {
+	u.Set(dlphit.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateTaskID() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldTaskID)
+	return u
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *DLPHitUpsert) SetRequestID(v string) *DLPHitUpsert {
+	u.Set(dlphit.FieldRequestID, v)
+	return u
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateRequestID() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldRequestID)
+	return u
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *DLPHitUpsert) ClearRequestID() *DLPHitUpsert {
+	u.SetNull(dlphit.FieldRequestID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DLPHitUpsert) SetUserID(v uuid.UUID) *DLPHitUpsert {
+	u.Set(dlphit.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateUserID() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldUserID)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DLPHitUpsert) ClearUserID() *DLPHitUpsert {
+	u.SetNull(dlphit.FieldUserID)
+	return u
+}
+
+// SetRuleID sets the "rule_id" field.
+func (u *DLPHitUpsert) SetRuleID(v string) *DLPHitUpsert {
+	u.Set(dlphit.FieldRuleID, v)
+	return u
+}
+
+// UpdateRuleID sets the "rule_id" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateRuleID() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldRuleID)
+	return u
+}
+
+// SetRuleName sets the "rule_name" field.
+func (u *DLPHitUpsert) SetRuleName(v string) *DLPHitUpsert {
+	u.Set(dlphit.FieldRuleName, v)
+	return u
+}
+
+// UpdateRuleName sets the "rule_name" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateRuleName() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldRuleName)
+	return u
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (u *DLPHitUpsert) ClearRuleName() *DLPHitUpsert {
+	u.SetNull(dlphit.FieldRuleName)
+	return u
+}
+
+// SetCategory sets the "category" field.
+func (u *DLPHitUpsert) SetCategory(v consts.DLPCategory) *DLPHitUpsert {
+	u.Set(dlphit.FieldCategory, v)
+	return u
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateCategory() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldCategory)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *DLPHitUpsert) SetAction(v consts.DLPAction) *DLPHitUpsert {
+	u.Set(dlphit.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateAction() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldAction)
+	return u
+}
+
+// SetPreview sets the "preview" field.
+func (u *DLPHitUpsert) SetPreview(v string) *DLPHitUpsert {
+	u.Set(dlphit.FieldPreview, v)
+	return u
+}
+
+// UpdatePreview sets the "preview" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdatePreview() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldPreview)
+	return u
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (u *DLPHitUpsert) ClearPreview() *DLPHitUpsert {
+	u.SetNull(dlphit.FieldPreview)
+	return u
+}
+
+// SetCount sets the "count" field.
+func (u *DLPHitUpsert) SetCount(v int) *DLPHitUpsert {
+	u.Set(dlphit.FieldCount, v)
+	return u
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateCount() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldCount)
+	return u
+}
+
+// AddCount adds v to the "count" field.
+func (u *DLPHitUpsert) AddCount(v int) *DLPHitUpsert {
+	u.Add(dlphit.FieldCount, v)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DLPHitUpsert) SetCreatedAt(v time.Time) *DLPHitUpsert {
+	u.Set(dlphit.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DLPHitUpsert) UpdateCreatedAt() *DLPHitUpsert {
+	u.SetExcluded(dlphit.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DLPHit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dlphit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DLPHitUpsertOne) UpdateNewValues() *DLPHitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(dlphit.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DLPHit.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DLPHitUpsertOne) Ignore() *DLPHitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DLPHitUpsertOne) DoNothing() *DLPHitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DLPHitCreate.OnConflict
+// documentation for more info.
+func (u *DLPHitUpsertOne) Update(set func(*DLPHitUpsert)) *DLPHitUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DLPHitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *DLPHitUpsertOne) SetTaskID(v string) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateTaskID() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *DLPHitUpsertOne) SetRequestID(v string) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateRequestID() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *DLPHitUpsertOne) ClearRequestID() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearRequestID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DLPHitUpsertOne) SetUserID(v uuid.UUID) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateUserID() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DLPHitUpsertOne) ClearUserID() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRuleID sets the "rule_id" field.
+func (u *DLPHitUpsertOne) SetRuleID(v string) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetRuleID(v)
+	})
+}
+
+// UpdateRuleID sets the "rule_id" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateRuleID() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateRuleID()
+	})
+}
+
+// SetRuleName sets the "rule_name" field.
+func (u *DLPHitUpsertOne) SetRuleName(v string) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetRuleName(v)
+	})
+}
+
+// UpdateRuleName sets the "rule_name" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateRuleName() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateRuleName()
+	})
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (u *DLPHitUpsertOne) ClearRuleName() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearRuleName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *DLPHitUpsertOne) SetCategory(v consts.DLPCategory) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateCategory() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DLPHitUpsertOne) SetAction(v consts.DLPAction) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateAction() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPreview sets the "preview" field.
+func (u *DLPHitUpsertOne) SetPreview(v string) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetPreview(v)
+	})
+}
+
+// UpdatePreview sets the "preview" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdatePreview() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdatePreview()
+	})
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (u *DLPHitUpsertOne) ClearPreview() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearPreview()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *DLPHitUpsertOne) SetCount(v int) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *DLPHitUpsertOne) AddCount(v int) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateCount() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DLPHitUpsertOne) SetCreatedAt(v time.Time) *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DLPHitUpsertOne) UpdateCreatedAt() *DLPHitUpsertOne {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DLPHitUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for DLPHitCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DLPHitUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DLPHitUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: DLPHitUpsertOne.ID is not supported by MySQL driver. Use DLPHitUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DLPHitUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DLPHitCreateBulk is the builder for creating many DLPHit entities in bulk.
+type DLPHitCreateBulk struct {
+	config
+	err      error
+	builders []*DLPHitCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DLPHit entities in the database.
+func (dhcb *DLPHitCreateBulk) Save(ctx context.Context) ([]*DLPHit, error) {
+	if dhcb.err != nil {
+		return nil, dhcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dhcb.builders))
+	nodes := make([]*DLPHit, len(dhcb.builders))
+	mutators := make([]Mutator, len(dhcb.builders))
+	for i := range dhcb.builders {
+		func(i int, root context.Context) {
+			builder := dhcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DLPHitMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dhcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dhcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dhcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dhcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dhcb *DLPHitCreateBulk) SaveX(ctx context.Context) []*DLPHit {
+	v, err := dhcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dhcb *DLPHitCreateBulk) Exec(ctx context.Context) error {
+	_, err := dhcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dhcb *DLPHitCreateBulk) ExecX(ctx context.Context) {
+	if err := dhcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DLPHit.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DLPHitUpsert) {
+//			SetTaskID(v+v).
+//		}).
+//		Exec(ctx)
+func (dhcb *DLPHitCreateBulk) OnConflict(opts ...sql.ConflictOption) *DLPHitUpsertBulk {
+	dhcb.conflict = opts
+	return &DLPHitUpsertBulk{
+		create: dhcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DLPHit.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dhcb *DLPHitCreateBulk) OnConflictColumns(columns ...string) *DLPHitUpsertBulk {
+	dhcb.conflict = append(dhcb.conflict, sql.ConflictColumns(columns...))
+	return &DLPHitUpsertBulk{
+		create: dhcb,
+	}
+}
+
+// DLPHitUpsertBulk is the builder for "upsert"-ing
+// a bulk of DLPHit nodes.
+type DLPHitUpsertBulk struct {
+	create *DLPHitCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DLPHit.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(dlphit.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DLPHitUpsertBulk) UpdateNewValues() *DLPHitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(dlphit.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DLPHit.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DLPHitUpsertBulk) Ignore() *DLPHitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DLPHitUpsertBulk) DoNothing() *DLPHitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DLPHitCreateBulk.OnConflict
+// documentation for more info.
+func (u *DLPHitUpsertBulk) Update(set func(*DLPHitUpsert)) *DLPHitUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DLPHitUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *DLPHitUpsertBulk) SetTaskID(v string) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateTaskID() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// SetRequestID sets the "request_id" field.
+func (u *DLPHitUpsertBulk) SetRequestID(v string) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetRequestID(v)
+	})
+}
+
+// UpdateRequestID sets the "request_id" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateRequestID() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateRequestID()
+	})
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (u *DLPHitUpsertBulk) ClearRequestID() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearRequestID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DLPHitUpsertBulk) SetUserID(v uuid.UUID) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateUserID() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *DLPHitUpsertBulk) ClearUserID() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRuleID sets the "rule_id" field.
+func (u *DLPHitUpsertBulk) SetRuleID(v string) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetRuleID(v)
+	})
+}
+
+// UpdateRuleID sets the "rule_id" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateRuleID() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateRuleID()
+	})
+}
+
+// SetRuleName sets the "rule_name" field.
+func (u *DLPHitUpsertBulk) SetRuleName(v string) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetRuleName(v)
+	})
+}
+
+// UpdateRuleName sets the "rule_name" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateRuleName() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateRuleName()
+	})
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (u *DLPHitUpsertBulk) ClearRuleName() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearRuleName()
+	})
+}
+
+// SetCategory sets the "category" field.
+func (u *DLPHitUpsertBulk) SetCategory(v consts.DLPCategory) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetCategory(v)
+	})
+}
+
+// UpdateCategory sets the "category" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateCategory() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateCategory()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *DLPHitUpsertBulk) SetAction(v consts.DLPAction) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateAction() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetPreview sets the "preview" field.
+func (u *DLPHitUpsertBulk) SetPreview(v string) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetPreview(v)
+	})
+}
+
+// UpdatePreview sets the "preview" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdatePreview() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdatePreview()
+	})
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (u *DLPHitUpsertBulk) ClearPreview() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.ClearPreview()
+	})
+}
+
+// SetCount sets the "count" field.
+func (u *DLPHitUpsertBulk) SetCount(v int) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetCount(v)
+	})
+}
+
+// AddCount adds v to the "count" field.
+func (u *DLPHitUpsertBulk) AddCount(v int) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.AddCount(v)
+	})
+}
+
+// UpdateCount sets the "count" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateCount() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateCount()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DLPHitUpsertBulk) SetCreatedAt(v time.Time) *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DLPHitUpsertBulk) UpdateCreatedAt() *DLPHitUpsertBulk {
+	return u.Update(func(s *DLPHitUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DLPHitUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the DLPHitCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for DLPHitCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DLPHitUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+)
+
+// DLPHitDelete is the builder for deleting a DLPHit entity.
+type DLPHitDelete struct {
+	config
+	hooks    []Hook
+	mutation *DLPHitMutation
+}
+
+// Where appends a list predicates to the DLPHitDelete builder.
+func (dhd *DLPHitDelete) Where(ps ...predicate.DLPHit) *DLPHitDelete {
+	dhd.mutation.Where(ps...)
+	return dhd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dhd *DLPHitDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dhd.sqlExec, dhd.mutation, dhd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dhd *DLPHitDelete) ExecX(ctx context.Context) int {
+	n, err := dhd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dhd *DLPHitDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dlphit.Table, sqlgraph.NewFieldSpec(dlphit.FieldID, field.TypeUUID))
+	if ps := dhd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dhd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dhd.mutation.done = true
+	return affected, err
+}
+
+// DLPHitDeleteOne is the builder for deleting a single DLPHit entity.
+type DLPHitDeleteOne struct {
+	dhd *DLPHitDelete
+}
+
+// Where appends a list predicates to the DLPHitDelete builder.
+func (dhdo *DLPHitDeleteOne) Where(ps ...predicate.DLPHit) *DLPHitDeleteOne {
+	dhdo.dhd.mutation.Where(ps...)
+	return dhdo
+}
+
+// Exec executes the deletion query.
+func (dhdo *DLPHitDeleteOne) Exec(ctx context.Context) error {
+	n, err := dhdo.dhd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dlphit.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dhdo *DLPHitDeleteOne) ExecX(ctx context.Context) {
+	if err := dhdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// DLPHitQuery is the builder for querying DLPHit entities.
+type DLPHitQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dlphit.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DLPHit
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DLPHitQuery builder.
+func (dhq *DLPHitQuery) Where(ps ...predicate.DLPHit) *DLPHitQuery {
+	dhq.predicates = append(dhq.predicates, ps...)
+	return dhq
+}
+
+// Limit the number of records to be returned by this query.
+func (dhq *DLPHitQuery) Limit(limit int) *DLPHitQuery {
+	dhq.ctx.Limit = &limit
+	return dhq
+}
+
+// Offset to start from.
+func (dhq *DLPHitQuery) Offset(offset int) *DLPHitQuery {
+	dhq.ctx.Offset = &offset
+	return dhq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dhq *DLPHitQuery) Unique(unique bool) *DLPHitQuery {
+	dhq.ctx.Unique = &unique
+	return dhq
+}
+
+// Order specifies how the records should be ordered.
+func (dhq *DLPHitQuery) Order(o ...dlphit.OrderOption) *DLPHitQuery {
+	dhq.order = append(dhq.order, o...)
+	return dhq
+}
+
+// First returns the first DLPHit entity from the query.
+// Returns a *NotFoundError when no DLPHit was found.
+func (dhq *DLPHitQuery) First(ctx context.Context) (*DLPHit, error) {
+	nodes, err := dhq.Limit(1).All(setContextOp(ctx, dhq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dlphit.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dhq *DLPHitQuery) FirstX(ctx context.Context) *DLPHit {
+	node, err := dhq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DLPHit ID from the query.
+// Returns a *NotFoundError when no DLPHit ID was found.
+func (dhq *DLPHitQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dhq.Limit(1).IDs(setContextOp(ctx, dhq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dlphit.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dhq *DLPHitQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := dhq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DLPHit entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DLPHit entity is found.
+// Returns a *NotFoundError when no DLPHit entities are found.
+func (dhq *DLPHitQuery) Only(ctx context.Context) (*DLPHit, error) {
+	nodes, err := dhq.Limit(2).All(setContextOp(ctx, dhq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dlphit.Label}
+	default:
+		return nil, &NotSingularError{dlphit.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dhq *DLPHitQuery) OnlyX(ctx context.Context) *DLPHit {
+	node, err := dhq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DLPHit ID in the query.
+// Returns a *NotSingularError when more than one DLPHit ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dhq *DLPHitQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = dhq.Limit(2).IDs(setContextOp(ctx, dhq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dlphit.Label}
+	default:
+		err = &NotSingularError{dlphit.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dhq *DLPHitQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := dhq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DLPHits.
+func (dhq *DLPHitQuery) All(ctx context.Context) ([]*DLPHit, error) {
+	ctx = setContextOp(ctx, dhq.ctx, ent.OpQueryAll)
+	if err := dhq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DLPHit, *DLPHitQuery]()
+	return withInterceptors[[]*DLPHit](ctx, dhq, qr, dhq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dhq *DLPHitQuery) AllX(ctx context.Context) []*DLPHit {
+	nodes, err := dhq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DLPHit IDs.
+func (dhq *DLPHitQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if dhq.ctx.Unique == nil && dhq.path != nil {
+		dhq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dhq.ctx, ent.OpQueryIDs)
+	if err = dhq.Select(dlphit.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dhq *DLPHitQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := dhq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dhq *DLPHitQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dhq.ctx, ent.OpQueryCount)
+	if err := dhq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dhq, querierCount[*DLPHitQuery](), dhq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dhq *DLPHitQuery) CountX(ctx context.Context) int {
+	count, err := dhq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dhq *DLPHitQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dhq.ctx, ent.OpQueryExist)
+	switch _, err := dhq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dhq *DLPHitQuery) ExistX(ctx context.Context) bool {
+	exist, err := dhq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DLPHitQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dhq *DLPHitQuery) Clone() *DLPHitQuery {
+	if dhq == nil {
+		return nil
+	}
+	return &DLPHitQuery{
+		config:     dhq.config,
+		ctx:        dhq.ctx.Clone(),
+		order:      append([]dlphit.OrderOption{}, dhq.order...),
+		inters:     append([]Interceptor{}, dhq.inters...),
+		predicates: append([]predicate.DLPHit{}, dhq.predicates...),
+		// clone intermediate query.
+		sql:       dhq.sql.Clone(),
+		path:      dhq.path,
+		modifiers: append([]func(*sql.Selector){}, dhq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DLPHit.Query().
+//		GroupBy(dlphit.FieldTaskID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (dhq *DLPHitQuery) GroupBy(field string, fields ...string) *DLPHitGroupBy {
+	dhq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DLPHitGroupBy{build: dhq}
+	grbuild.flds = &dhq.ctx.Fields
+	grbuild.label = dlphit.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		TaskID string `json:"task_id,omitempty"`
+//	}
+//
+//	client.DLPHit.Query().
+//		Select(dlphit.FieldTaskID).
+//		Scan(ctx, &v)
+func (dhq *DLPHitQuery) Select(fields ...string) *DLPHitSelect {
+	dhq.ctx.Fields = append(dhq.ctx.Fields, fields...)
+	sbuild := &DLPHitSelect{DLPHitQuery: dhq}
+	sbuild.label = dlphit.Label
+	sbuild.flds, sbuild.scan = &dhq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DLPHitSelect configured with the given aggregations.
+func (dhq *DLPHitQuery) Aggregate(fns ...AggregateFunc) *DLPHitSelect {
+	return dhq.Select().Aggregate(fns...)
+}
+
+func (dhq *DLPHitQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dhq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dhq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dhq.ctx.Fields {
+		if !dlphit.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if dhq.path != nil {
+		prev, err := dhq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dhq.sql = prev
+	}
+	return nil
+}
+
+func (dhq *DLPHitQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DLPHit, error) {
+	var (
+		nodes = []*DLPHit{}
+		_spec = dhq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DLPHit).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DLPHit{config: dhq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(dhq.modifiers) > 0 {
+		_spec.Modifiers = dhq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dhq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (dhq *DLPHitQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dhq.querySpec()
+	if len(dhq.modifiers) > 0 {
+		_spec.Modifiers = dhq.modifiers
+	}
+	_spec.Node.Columns = dhq.ctx.Fields
+	if len(dhq.ctx.Fields) > 0 {
+		_spec.Unique = dhq.ctx.Unique != nil && *dhq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dhq.driver, _spec)
+}
+
+func (dhq *DLPHitQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dlphit.Table, dlphit.Columns, sqlgraph.NewFieldSpec(dlphit.FieldID, field.TypeUUID))
+	_spec.From = dhq.sql
+	if unique := dhq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dhq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dhq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dlphit.FieldID)
+		for i := range fields {
+			if fields[i] != dlphit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dhq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dhq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dhq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dhq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dhq *DLPHitQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dhq.driver.Dialect())
+	t1 := builder.Table(dlphit.Table)
+	columns := dhq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dlphit.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dhq.sql != nil {
+		selector = dhq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dhq.ctx.Unique != nil && *dhq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dhq.modifiers {
+		m(selector)
+	}
+	for _, p := range dhq.predicates {
+		p(selector)
+	}
+	for _, p := range dhq.order {
+		p(selector)
+	}
+	if offset := dhq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dhq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (dhq *DLPHitQuery) ForUpdate(opts ...sql.LockOption) *DLPHitQuery {
+	if dhq.driver.Dialect() == dialect.Postgres {
+		dhq.Unique(false)
+	}
+	dhq.modifiers = append(dhq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return dhq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (dhq *DLPHitQuery) ForShare(opts ...sql.LockOption) *DLPHitQuery {
+	if dhq.driver.Dialect() == dialect.Postgres {
+		dhq.Unique(false)
+	}
+	dhq.modifiers = append(dhq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return dhq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dhq *DLPHitQuery) Modify(modifiers ...func(s *sql.Selector)) *DLPHitSelect {
+	dhq.modifiers = append(dhq.modifiers, modifiers...)
+	return dhq.Select()
+}
+
+// DLPHitGroupBy is the group-by builder for DLPHit entities.
+type DLPHitGroupBy struct {
+	selector
+	build *DLPHitQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dhgb *DLPHitGroupBy) Aggregate(fns ...AggregateFunc) *DLPHitGroupBy {
+	dhgb.fns = append(dhgb.fns, fns...)
+	return dhgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dhgb *DLPHitGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dhgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dhgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DLPHitQuery, *DLPHitGroupBy](ctx, dhgb.build, dhgb, dhgb.build.inters, v)
+}
+
+func (dhgb *DLPHitGroupBy) sqlScan(ctx context.Context, root *DLPHitQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dhgb.fns))
+	for _, fn := range dhgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dhgb.flds)+len(dhgb.fns))
+		for _, f := range *dhgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dhgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dhgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DLPHitSelect is the builder for selecting fields of DLPHit entities.
+type DLPHitSelect struct {
+	*DLPHitQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dhs *DLPHitSelect) Aggregate(fns ...AggregateFunc) *DLPHitSelect {
+	dhs.fns = append(dhs.fns, fns...)
+	return dhs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dhs *DLPHitSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dhs.ctx, ent.OpQuerySelect)
+	if err := dhs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DLPHitQuery, *DLPHitSelect](ctx, dhs.DLPHitQuery, dhs, dhs.inters, v)
+}
+
+func (dhs *DLPHitSelect) sqlScan(ctx context.Context, root *DLPHitQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dhs.fns))
+	for _, fn := range dhs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dhs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dhs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dhs *DLPHitSelect) Modify(modifiers ...func(s *sql.Selector)) *DLPHitSelect {
+	dhs.modifiers = append(dhs.modifiers, modifiers...)
+	return dhs
+}
@@ -0,0 +1,626 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// DLPHitUpdate is the builder for updating DLPHit entities.
+type DLPHitUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DLPHitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DLPHitUpdate builder.
+func (dhu *DLPHitUpdate) Where(ps ...predicate.DLPHit) *DLPHitUpdate {
+	dhu.mutation.Where(ps...)
+	return dhu
+}
+
+// SetTaskID sets the "task_id" field.
+func (dhu *DLPHitUpdate) SetTaskID(s string) *DLPHitUpdate {
+	dhu.mutation.SetTaskID(s)
+	return dhu
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableTaskID(s *string) *DLPHitUpdate {
+	if s != nil {
+		dhu.SetTaskID(*s)
+	}
+	return dhu
+}
+
+// SetRequestID sets the "request_id" field.
+func (dhu *DLPHitUpdate) SetRequestID(s string) *DLPHitUpdate {
+	dhu.mutation.SetRequestID(s)
+	return dhu
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableRequestID(s *string) *DLPHitUpdate {
+	if s != nil {
+		dhu.SetRequestID(*s)
+	}
+	return dhu
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (dhu *DLPHitUpdate) ClearRequestID() *DLPHitUpdate {
+	dhu.mutation.ClearRequestID()
+	return dhu
+}
+
+// SetUserID sets the "user_id" field.
+func (dhu *DLPHitUpdate) SetUserID(u uuid.UUID) *DLPHitUpdate {
+	dhu.mutation.SetUserID(u)
+	return dhu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableUserID(u *uuid.UUID) *DLPHitUpdate {
+	if u != nil {
+		dhu.SetUserID(*u)
+	}
+	return dhu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (dhu *DLPHitUpdate) ClearUserID() *DLPHitUpdate {
+	dhu.mutation.ClearUserID()
+	return dhu
+}
+
+// SetRuleID sets the "rule_id" field.
+func (dhu *DLPHitUpdate) SetRuleID(s string) *DLPHitUpdate {
+	dhu.mutation.SetRuleID(s)
+	return dhu
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableRuleID(s *string) *DLPHitUpdate {
+	if s != nil {
+		dhu.SetRuleID(*s)
+	}
+	return dhu
+}
+
+// SetRuleName sets the "rule_name" field.
+func (dhu *DLPHitUpdate) SetRuleName(s string) *DLPHitUpdate {
+	dhu.mutation.SetRuleName(s)
+	return dhu
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableRuleName(s *string) *DLPHitUpdate {
+	if s != nil {
+		dhu.SetRuleName(*s)
+	}
+	return dhu
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (dhu *DLPHitUpdate) ClearRuleName() *DLPHitUpdate {
+	dhu.mutation.ClearRuleName()
+	return dhu
+}
+
+// SetCategory sets the "category" field.
+func (dhu *DLPHitUpdate) SetCategory(cc consts.DLPCategory) *DLPHitUpdate {
+	dhu.mutation.SetCategory(cc)
+	return dhu
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableCategory(cc *consts.DLPCategory) *DLPHitUpdate {
+	if cc != nil {
+		dhu.SetCategory(*cc)
+	}
+	return dhu
+}
+
+// SetAction sets the "action" field.
+func (dhu *DLPHitUpdate) SetAction(ca consts.DLPAction) *DLPHitUpdate {
+	dhu.mutation.SetAction(ca)
+	return dhu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableAction(ca *consts.DLPAction) *DLPHitUpdate {
+	if ca != nil {
+		dhu.SetAction(*ca)
+	}
+	return dhu
+}
+
+// SetPreview sets the "preview" field.
+func (dhu *DLPHitUpdate) SetPreview(s string) *DLPHitUpdate {
+	dhu.mutation.SetPreview(s)
+	return dhu
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillablePreview(s *string) *DLPHitUpdate {
+	if s != nil {
+		dhu.SetPreview(*s)
+	}
+	return dhu
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (dhu *DLPHitUpdate) ClearPreview() *DLPHitUpdate {
+	dhu.mutation.ClearPreview()
+	return dhu
+}
+
+// SetCount sets the "count" field.
+func (dhu *DLPHitUpdate) SetCount(i int) *DLPHitUpdate {
+	dhu.mutation.ResetCount()
+	dhu.mutation.SetCount(i)
+	return dhu
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableCount(i *int) *DLPHitUpdate {
+	if i != nil {
+		dhu.SetCount(*i)
+	}
+	return dhu
+}
+
+// AddCount adds i to the "count" field.
+func (dhu *DLPHitUpdate) AddCount(i int) *DLPHitUpdate {
+	dhu.mutation.AddCount(i)
+	return dhu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dhu *DLPHitUpdate) SetCreatedAt(t time.Time) *DLPHitUpdate {
+	dhu.mutation.SetCreatedAt(t)
+	return dhu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dhu *DLPHitUpdate) SetNillableCreatedAt(t *time.Time) *DLPHitUpdate {
+	if t != nil {
+		dhu.SetCreatedAt(*t)
+	}
+	return dhu
+}
+
+// Mutation returns the DLPHitMutation object of the builder.
+func (dhu *DLPHitUpdate) Mutation() *DLPHitMutation {
+	return dhu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dhu *DLPHitUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dhu.sqlSave, dhu.mutation, dhu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dhu *DLPHitUpdate) SaveX(ctx context.Context) int {
+	affected, err := dhu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dhu *DLPHitUpdate) Exec(ctx context.Context) error {
+	_, err := dhu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dhu *DLPHitUpdate) ExecX(ctx context.Context) {
+	if err := dhu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dhu *DLPHitUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DLPHitUpdate {
+	dhu.modifiers = append(dhu.modifiers, modifiers...)
+	return dhu
+}
+
+func (dhu *DLPHitUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dlphit.Table, dlphit.Columns, sqlgraph.NewFieldSpec(dlphit.FieldID, field.TypeUUID))
+	if ps := dhu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dhu.mutation.TaskID(); ok {
+		_spec.SetField(dlphit.FieldTaskID, field.TypeString, value)
+	}
+	if value, ok := dhu.mutation.RequestID(); ok {
+		_spec.SetField(dlphit.FieldRequestID, field.TypeString, value)
+	}
+	if dhu.mutation.RequestIDCleared() {
+		_spec.ClearField(dlphit.FieldRequestID, field.TypeString)
+	}
+	if value, ok := dhu.mutation.UserID(); ok {
+		_spec.SetField(dlphit.FieldUserID, field.TypeUUID, value)
+	}
+	if dhu.mutation.UserIDCleared() {
+		_spec.ClearField(dlphit.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := dhu.mutation.RuleID(); ok {
+		_spec.SetField(dlphit.FieldRuleID, field.TypeString, value)
+	}
+	if value, ok := dhu.mutation.RuleName(); ok {
+		_spec.SetField(dlphit.FieldRuleName, field.TypeString, value)
+	}
+	if dhu.mutation.RuleNameCleared() {
+		_spec.ClearField(dlphit.FieldRuleName, field.TypeString)
+	}
+	if value, ok := dhu.mutation.Category(); ok {
+		_spec.SetField(dlphit.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := dhu.mutation.Action(); ok {
+		_spec.SetField(dlphit.FieldAction, field.TypeString, value)
+	}
+	if value, ok := dhu.mutation.Preview(); ok {
+		_spec.SetField(dlphit.FieldPreview, field.TypeString, value)
+	}
+	if dhu.mutation.PreviewCleared() {
+		_spec.ClearField(dlphit.FieldPreview, field.TypeString)
+	}
+	if value, ok := dhu.mutation.Count(); ok {
+		_spec.SetField(dlphit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := dhu.mutation.AddedCount(); ok {
+		_spec.AddField(dlphit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := dhu.mutation.CreatedAt(); ok {
+		_spec.SetField(dlphit.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(dhu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dhu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dlphit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dhu.mutation.done = true
+	return n, nil
+}
+
+// DLPHitUpdateOne is the builder for updating a single DLPHit entity.
+type DLPHitUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DLPHitMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetTaskID sets the "task_id" field.
+func (dhuo *DLPHitUpdateOne) SetTaskID(s string) *DLPHitUpdateOne {
+	dhuo.mutation.SetTaskID(s)
+	return dhuo
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableTaskID(s *string) *DLPHitUpdateOne {
+	if s != nil {
+		dhuo.SetTaskID(*s)
+	}
+	return dhuo
+}
+
+// SetRequestID sets the "request_id" field.
+func (dhuo *DLPHitUpdateOne) SetRequestID(s string) *DLPHitUpdateOne {
+	dhuo.mutation.SetRequestID(s)
+	return dhuo
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableRequestID(s *string) *DLPHitUpdateOne {
+	if s != nil {
+		dhuo.SetRequestID(*s)
+	}
+	return dhuo
+}
+
+// ClearRequestID clears the value of the "request_id" field.
+func (dhuo *DLPHitUpdateOne) ClearRequestID() *DLPHitUpdateOne {
+	dhuo.mutation.ClearRequestID()
+	return dhuo
+}
+
+// SetUserID sets the "user_id" field.
+func (dhuo *DLPHitUpdateOne) SetUserID(u uuid.UUID) *DLPHitUpdateOne {
+	dhuo.mutation.SetUserID(u)
+	return dhuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableUserID(u *uuid.UUID) *DLPHitUpdateOne {
+	if u != nil {
+		dhuo.SetUserID(*u)
+	}
+	return dhuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (dhuo *DLPHitUpdateOne) ClearUserID() *DLPHitUpdateOne {
+	dhuo.mutation.ClearUserID()
+	return dhuo
+}
+
+// SetRuleID sets the "rule_id" field.
+func (dhuo *DLPHitUpdateOne) SetRuleID(s string) *DLPHitUpdateOne {
+	dhuo.mutation.SetRuleID(s)
+	return dhuo
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableRuleID(s *string) *DLPHitUpdateOne {
+	if s != nil {
+		dhuo.SetRuleID(*s)
+	}
+	return dhuo
+}
+
+// SetRuleName sets the "rule_name" field.
+func (dhuo *DLPHitUpdateOne) SetRuleName(s string) *DLPHitUpdateOne {
+	dhuo.mutation.SetRuleName(s)
+	return dhuo
+}
+
+// SetNillableRuleName sets the "rule_name" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableRuleName(s *string) *DLPHitUpdateOne {
+	if s != nil {
+		dhuo.SetRuleName(*s)
+	}
+	return dhuo
+}
+
+// ClearRuleName clears the value of the "rule_name" field.
+func (dhuo *DLPHitUpdateOne) ClearRuleName() *DLPHitUpdateOne {
+	dhuo.mutation.ClearRuleName()
+	return dhuo
+}
+
+// SetCategory sets the "category" field.
+func (dhuo *DLPHitUpdateOne) SetCategory(cc consts.DLPCategory) *DLPHitUpdateOne {
+	dhuo.mutation.SetCategory(cc)
+	return dhuo
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableCategory(cc *consts.DLPCategory) *DLPHitUpdateOne {
+	if cc != nil {
+		dhuo.SetCategory(*cc)
+	}
+	return dhuo
+}
+
+// SetAction sets the "action" field.
+func (dhuo *DLPHitUpdateOne) SetAction(ca consts.DLPAction) *DLPHitUpdateOne {
+	dhuo.mutation.SetAction(ca)
+	return dhuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableAction(ca *consts.DLPAction) *DLPHitUpdateOne {
+	if ca != nil {
+		dhuo.SetAction(*ca)
+	}
+	return dhuo
+}
+
+// SetPreview sets the "preview" field.
+func (dhuo *DLPHitUpdateOne) SetPreview(s string) *DLPHitUpdateOne {
+	dhuo.mutation.SetPreview(s)
+	return dhuo
+}
+
+// SetNillablePreview sets the "preview" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillablePreview(s *string) *DLPHitUpdateOne {
+	if s != nil {
+		dhuo.SetPreview(*s)
+	}
+	return dhuo
+}
+
+// ClearPreview clears the value of the "preview" field.
+func (dhuo *DLPHitUpdateOne) ClearPreview() *DLPHitUpdateOne {
+	dhuo.mutation.ClearPreview()
+	return dhuo
+}
+
+// SetCount sets the "count" field.
+func (dhuo *DLPHitUpdateOne) SetCount(i int) *DLPHitUpdateOne {
+	dhuo.mutation.ResetCount()
+	dhuo.mutation.SetCount(i)
+	return dhuo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableCount(i *int) *DLPHitUpdateOne {
+	if i != nil {
+		dhuo.SetCount(*i)
+	}
+	return dhuo
+}
+
+// AddCount adds i to the "count" field.
+func (dhuo *DLPHitUpdateOne) AddCount(i int) *DLPHitUpdateOne {
+	dhuo.mutation.AddCount(i)
+	return dhuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dhuo *DLPHitUpdateOne) SetCreatedAt(t time.Time) *DLPHitUpdateOne {
+	dhuo.mutation.SetCreatedAt(t)
+	return dhuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dhuo *DLPHitUpdateOne) SetNillableCreatedAt(t *time.Time) *DLPHitUpdateOne {
+	if t != nil {
+		dhuo.SetCreatedAt(*t)
+	}
+	return dhuo
+}
+
+// Mutation returns the DLPHitMutation object of the builder.
+func (dhuo *DLPHitUpdateOne) Mutation() *DLPHitMutation {
+	return dhuo.mutation
+}
+
+// Where appends a list predicates to the DLPHitUpdate builder.
+func (dhuo *DLPHitUpdateOne) Where(ps ...predicate.DLPHit) *DLPHitUpdateOne {
+	dhuo.mutation.Where(ps...)
+	return dhuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dhuo *DLPHitUpdateOne) Select(field string, fields ...string) *DLPHitUpdateOne {
+	dhuo.fields = append([]string{field}, fields...)
+	return dhuo
+}
+
+// Save executes the query and returns the updated DLPHit entity.
+func (dhuo *DLPHitUpdateOne) Save(ctx context.Context) (*DLPHit, error) {
+	return withHooks(ctx, dhuo.sqlSave, dhuo.mutation, dhuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dhuo *DLPHitUpdateOne) SaveX(ctx context.Context) *DLPHit {
+	node, err := dhuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dhuo *DLPHitUpdateOne) Exec(ctx context.Context) error {
+	_, err := dhuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dhuo *DLPHitUpdateOne) ExecX(ctx context.Context) {
+	if err := dhuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dhuo *DLPHitUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DLPHitUpdateOne {
+	dhuo.modifiers = append(dhuo.modifiers, modifiers...)
+	return dhuo
+}
+
+func (dhuo *DLPHitUpdateOne) sqlSave(ctx context.Context) (_node *DLPHit, err error) {
+	_spec := sqlgraph.NewUpdateSpec(dlphit.Table, dlphit.Columns, sqlgraph.NewFieldSpec(dlphit.FieldID, field.TypeUUID))
+	id, ok := dhuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "DLPHit.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dhuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dlphit.FieldID)
+		for _, f := range fields {
+			if !dlphit.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != dlphit.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dhuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dhuo.mutation.TaskID(); ok {
+		_spec.SetField(dlphit.FieldTaskID, field.TypeString, value)
+	}
+	if value, ok := dhuo.mutation.RequestID(); ok {
+		_spec.SetField(dlphit.FieldRequestID, field.TypeString, value)
+	}
+	if dhuo.mutation.RequestIDCleared() {
+		_spec.ClearField(dlphit.FieldRequestID, field.TypeString)
+	}
+	if value, ok := dhuo.mutation.UserID(); ok {
+		_spec.SetField(dlphit.FieldUserID, field.TypeUUID, value)
+	}
+	if dhuo.mutation.UserIDCleared() {
+		_spec.ClearField(dlphit.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := dhuo.mutation.RuleID(); ok {
+		_spec.SetField(dlphit.FieldRuleID, field.TypeString, value)
+	}
+	if value, ok := dhuo.mutation.RuleName(); ok {
+		_spec.SetField(dlphit.FieldRuleName, field.TypeString, value)
+	}
+	if dhuo.mutation.RuleNameCleared() {
+		_spec.ClearField(dlphit.FieldRuleName, field.TypeString)
+	}
+	if value, ok := dhuo.mutation.Category(); ok {
+		_spec.SetField(dlphit.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := dhuo.mutation.Action(); ok {
+		_spec.SetField(dlphit.FieldAction, field.TypeString, value)
+	}
+	if value, ok := dhuo.mutation.Preview(); ok {
+		_spec.SetField(dlphit.FieldPreview, field.TypeString, value)
+	}
+	if dhuo.mutation.PreviewCleared() {
+		_spec.ClearField(dlphit.FieldPreview, field.TypeString)
+	}
+	if value, ok := dhuo.mutation.Count(); ok {
+		_spec.SetField(dlphit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := dhuo.mutation.AddedCount(); ok {
+		_spec.AddField(dlphit.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := dhuo.mutation.CreatedAt(); ok {
+		_spec.SetField(dlphit.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(dhuo.modifiers...)
+	_node = &DLPHit{config: dhuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dhuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dlphit.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dhuo.mutation.done = true
+	return _node, nil
+}
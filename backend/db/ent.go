@@ -16,23 +16,35 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/adminloginhistory"
 	"github.com/chaitin/MonkeyCode/backend/db/adminrole"
 	"github.com/chaitin/MonkeyCode/backend/db/apikey"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
 	"github.com/chaitin/MonkeyCode/backend/db/billingplan"
 	"github.com/chaitin/MonkeyCode/backend/db/billingquota"
 	"github.com/chaitin/MonkeyCode/backend/db/billingrecord"
 	"github.com/chaitin/MonkeyCode/backend/db/billingusage"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
 	"github.com/chaitin/MonkeyCode/backend/db/codesnippet"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
 	"github.com/chaitin/MonkeyCode/backend/db/extension"
 	"github.com/chaitin/MonkeyCode/backend/db/invitecode"
 	"github.com/chaitin/MonkeyCode/backend/db/license"
 	"github.com/chaitin/MonkeyCode/backend/db/model"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovider"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovidermodel"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
 	"github.com/chaitin/MonkeyCode/backend/db/role"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
 	"github.com/chaitin/MonkeyCode/backend/db/setting"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupadmin"
@@ -101,35 +113,47 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			admin.Table:                  admin.ValidColumn,
-			adminloginhistory.Table:      adminloginhistory.ValidColumn,
-			adminrole.Table:              adminrole.ValidColumn,
-			apikey.Table:                 apikey.ValidColumn,
-			billingplan.Table:            billingplan.ValidColumn,
-			billingquota.Table:           billingquota.ValidColumn,
-			billingrecord.Table:          billingrecord.ValidColumn,
-			billingusage.Table:           billingusage.ValidColumn,
-			codesnippet.Table:            codesnippet.ValidColumn,
-			extension.Table:              extension.ValidColumn,
-			invitecode.Table:             invitecode.ValidColumn,
-			license.Table:                license.ValidColumn,
-			model.Table:                  model.ValidColumn,
-			modelprovider.Table:          modelprovider.ValidColumn,
-			modelprovidermodel.Table:     modelprovidermodel.ValidColumn,
-			role.Table:                   role.ValidColumn,
-			securityscanning.Table:       securityscanning.ValidColumn,
-			securityscanningresult.Table: securityscanningresult.ValidColumn,
-			setting.Table:                setting.ValidColumn,
-			task.Table:                   task.ValidColumn,
-			taskrecord.Table:             taskrecord.ValidColumn,
-			user.Table:                   user.ValidColumn,
-			usergroup.Table:              usergroup.ValidColumn,
-			usergroupadmin.Table:         usergroupadmin.ValidColumn,
-			usergroupuser.Table:          usergroupuser.ValidColumn,
-			useridentity.Table:           useridentity.ValidColumn,
-			userloginhistory.Table:       userloginhistory.ValidColumn,
-			workspace.Table:              workspace.ValidColumn,
-			workspacefile.Table:          workspacefile.ValidColumn,
+			admin.Table:                       admin.ValidColumn,
+			adminloginhistory.Table:           adminloginhistory.ValidColumn,
+			adminrole.Table:                   adminrole.ValidColumn,
+			apikey.Table:                      apikey.ValidColumn,
+			auditlog.Table:                    auditlog.ValidColumn,
+			billingplan.Table:                 billingplan.ValidColumn,
+			billingquota.Table:                billingquota.ValidColumn,
+			billingrecord.Table:               billingrecord.ValidColumn,
+			billingusage.Table:                billingusage.ValidColumn,
+			budgetalert.Table:                 budgetalert.ValidColumn,
+			codesnippet.Table:                 codesnippet.ValidColumn,
+			dlphit.Table:                      dlphit.ValidColumn,
+			extension.Table:                   extension.ValidColumn,
+			invitecode.Table:                  invitecode.ValidColumn,
+			license.Table:                     license.ValidColumn,
+			model.Table:                       model.ValidColumn,
+			modelhealthcheck.Table:            modelhealthcheck.ValidColumn,
+			modelprovider.Table:               modelprovider.ValidColumn,
+			modelprovidermodel.Table:          modelprovidermodel.ValidColumn,
+			responsecache.Table:               responsecache.ValidColumn,
+			role.Table:                        role.ValidColumn,
+			securityrulepack.Table:            securityrulepack.ValidColumn,
+			securityrulepackversion.Table:     securityrulepackversion.ValidColumn,
+			securityscanning.Table:            securityscanning.ValidColumn,
+			securityscanningcomment.Table:     securityscanningcomment.ValidColumn,
+			securityscanningpolicy.Table:      securityscanningpolicy.ValidColumn,
+			securityscanningresult.Table:      securityscanningresult.ValidColumn,
+			securityscanningsuppression.Table: securityscanningsuppression.ValidColumn,
+			setting.Table:                     setting.ValidColumn,
+			task.Table:                        task.ValidColumn,
+			taskrecord.Table:                  taskrecord.ValidColumn,
+			transformpolicy.Table:             transformpolicy.ValidColumn,
+			transformrule.Table:               transformrule.ValidColumn,
+			user.Table:                        user.ValidColumn,
+			usergroup.Table:                   usergroup.ValidColumn,
+			usergroupadmin.Table:              usergroupadmin.ValidColumn,
+			usergroupuser.Table:               usergroupuser.ValidColumn,
+			useridentity.Table:                useridentity.ValidColumn,
+			userloginhistory.Table:            userloginhistory.ValidColumn,
+			workspace.Table:                   workspace.ValidColumn,
+			workspacefile.Table:               workspacefile.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -57,6 +57,18 @@ func (f ApiKeyFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ApiKeyMutation", m)
 }
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *db.AuditLogMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.AuditLogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.AuditLogMutation", m)
+}
+
 // The BillingPlanFunc type is an adapter to allow the use of ordinary
 // function as BillingPlan mutator.
 type BillingPlanFunc func(context.Context, *db.BillingPlanMutation) (db.Value, error)
@@ -105,6 +117,18 @@ func (f BillingUsageFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.BillingUsageMutation", m)
 }
 
+// The BudgetAlertFunc type is an adapter to allow the use of ordinary
+// function as BudgetAlert mutator.
+type BudgetAlertFunc func(context.Context, *db.BudgetAlertMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BudgetAlertFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.BudgetAlertMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.BudgetAlertMutation", m)
+}
+
 // The CodeSnippetFunc type is an adapter to allow the use of ordinary
 // function as CodeSnippet mutator.
 type CodeSnippetFunc func(context.Context, *db.CodeSnippetMutation) (db.Value, error)
@@ -117,6 +141,18 @@ func (f CodeSnippetFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.CodeSnippetMutation", m)
 }
 
+// The DLPHitFunc type is an adapter to allow the use of ordinary
+// function as DLPHit mutator.
+type DLPHitFunc func(context.Context, *db.DLPHitMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DLPHitFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.DLPHitMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.DLPHitMutation", m)
+}
+
 // The ExtensionFunc type is an adapter to allow the use of ordinary
 // function as Extension mutator.
 type ExtensionFunc func(context.Context, *db.ExtensionMutation) (db.Value, error)
@@ -165,6 +201,18 @@ func (f ModelFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ModelMutation", m)
 }
 
+// The ModelHealthCheckFunc type is an adapter to allow the use of ordinary
+// function as ModelHealthCheck mutator.
+type ModelHealthCheckFunc func(context.Context, *db.ModelHealthCheckMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModelHealthCheckFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ModelHealthCheckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ModelHealthCheckMutation", m)
+}
+
 // The ModelProviderFunc type is an adapter to allow the use of ordinary
 // function as ModelProvider mutator.
 type ModelProviderFunc func(context.Context, *db.ModelProviderMutation) (db.Value, error)
@@ -189,6 +237,18 @@ func (f ModelProviderModelFunc) Mutate(ctx context.Context, m db.Mutation) (db.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ModelProviderModelMutation", m)
 }
 
+// The ResponseCacheFunc type is an adapter to allow the use of ordinary
+// function as ResponseCache mutator.
+type ResponseCacheFunc func(context.Context, *db.ResponseCacheMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ResponseCacheFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.ResponseCacheMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.ResponseCacheMutation", m)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary
 // function as Role mutator.
 type RoleFunc func(context.Context, *db.RoleMutation) (db.Value, error)
@@ -201,6 +261,30 @@ func (f RoleFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.RoleMutation", m)
 }
 
+// The SecurityRulePackFunc type is an adapter to allow the use of ordinary
+// function as SecurityRulePack mutator.
+type SecurityRulePackFunc func(context.Context, *db.SecurityRulePackMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecurityRulePackFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SecurityRulePackMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityRulePackMutation", m)
+}
+
+// The SecurityRulePackVersionFunc type is an adapter to allow the use of ordinary
+// function as SecurityRulePackVersion mutator.
+type SecurityRulePackVersionFunc func(context.Context, *db.SecurityRulePackVersionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecurityRulePackVersionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SecurityRulePackVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityRulePackVersionMutation", m)
+}
+
 // The SecurityScanningFunc type is an adapter to allow the use of ordinary
 // function as SecurityScanning mutator.
 type SecurityScanningFunc func(context.Context, *db.SecurityScanningMutation) (db.Value, error)
@@ -213,6 +297,30 @@ func (f SecurityScanningFunc) Mutate(ctx context.Context, m db.Mutation) (db.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityScanningMutation", m)
 }
 
+// The SecurityScanningCommentFunc type is an adapter to allow the use of ordinary
+// function as SecurityScanningComment mutator.
+type SecurityScanningCommentFunc func(context.Context, *db.SecurityScanningCommentMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecurityScanningCommentFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SecurityScanningCommentMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityScanningCommentMutation", m)
+}
+
+// The SecurityScanningPolicyFunc type is an adapter to allow the use of ordinary
+// function as SecurityScanningPolicy mutator.
+type SecurityScanningPolicyFunc func(context.Context, *db.SecurityScanningPolicyMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecurityScanningPolicyFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SecurityScanningPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityScanningPolicyMutation", m)
+}
+
 // The SecurityScanningResultFunc type is an adapter to allow the use of ordinary
 // function as SecurityScanningResult mutator.
 type SecurityScanningResultFunc func(context.Context, *db.SecurityScanningResultMutation) (db.Value, error)
@@ -225,6 +333,18 @@ func (f SecurityScanningResultFunc) Mutate(ctx context.Context, m db.Mutation) (
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityScanningResultMutation", m)
 }
 
+// The SecurityScanningSuppressionFunc type is an adapter to allow the use of ordinary
+// function as SecurityScanningSuppression mutator.
+type SecurityScanningSuppressionFunc func(context.Context, *db.SecurityScanningSuppressionMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SecurityScanningSuppressionFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.SecurityScanningSuppressionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.SecurityScanningSuppressionMutation", m)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary
 // function as Setting mutator.
 type SettingFunc func(context.Context, *db.SettingMutation) (db.Value, error)
@@ -261,6 +381,30 @@ func (f TaskRecordFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.TaskRecordMutation", m)
 }
 
+// The TransformPolicyFunc type is an adapter to allow the use of ordinary
+// function as TransformPolicy mutator.
+type TransformPolicyFunc func(context.Context, *db.TransformPolicyMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransformPolicyFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.TransformPolicyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.TransformPolicyMutation", m)
+}
+
+// The TransformRuleFunc type is an adapter to allow the use of ordinary
+// function as TransformRule mutator.
+type TransformRuleFunc func(context.Context, *db.TransformRuleMutation) (db.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TransformRuleFunc) Mutate(ctx context.Context, m db.Mutation) (db.Value, error) {
+	if mv, ok := m.(*db.TransformRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *db.TransformRuleMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *db.UserMutation) (db.Value, error)
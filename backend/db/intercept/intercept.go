@@ -12,24 +12,36 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/adminloginhistory"
 	"github.com/chaitin/MonkeyCode/backend/db/adminrole"
 	"github.com/chaitin/MonkeyCode/backend/db/apikey"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
 	"github.com/chaitin/MonkeyCode/backend/db/billingplan"
 	"github.com/chaitin/MonkeyCode/backend/db/billingquota"
 	"github.com/chaitin/MonkeyCode/backend/db/billingrecord"
 	"github.com/chaitin/MonkeyCode/backend/db/billingusage"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
 	"github.com/chaitin/MonkeyCode/backend/db/codesnippet"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
 	"github.com/chaitin/MonkeyCode/backend/db/extension"
 	"github.com/chaitin/MonkeyCode/backend/db/invitecode"
 	"github.com/chaitin/MonkeyCode/backend/db/license"
 	"github.com/chaitin/MonkeyCode/backend/db/model"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovider"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovidermodel"
 	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
 	"github.com/chaitin/MonkeyCode/backend/db/role"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
 	"github.com/chaitin/MonkeyCode/backend/db/setting"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupadmin"
@@ -204,6 +216,33 @@ func (f TraverseApiKey) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.ApiKeyQuery", q)
 }
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditLogFunc func(context.Context, *db.AuditLogQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditLogFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.AuditLogQuery", q)
+}
+
+// The TraverseAuditLog type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditLog func(context.Context, *db.AuditLogQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditLog) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditLog) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.AuditLogQuery", q)
+}
+
 // The BillingPlanFunc type is an adapter to allow the use of ordinary function as a Querier.
 type BillingPlanFunc func(context.Context, *db.BillingPlanQuery) (db.Value, error)
 
@@ -312,6 +351,33 @@ func (f TraverseBillingUsage) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.BillingUsageQuery", q)
 }
 
+// The BudgetAlertFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BudgetAlertFunc func(context.Context, *db.BudgetAlertQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f BudgetAlertFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.BudgetAlertQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.BudgetAlertQuery", q)
+}
+
+// The TraverseBudgetAlert type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBudgetAlert func(context.Context, *db.BudgetAlertQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBudgetAlert) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBudgetAlert) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.BudgetAlertQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.BudgetAlertQuery", q)
+}
+
 // The CodeSnippetFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CodeSnippetFunc func(context.Context, *db.CodeSnippetQuery) (db.Value, error)
 
@@ -339,6 +405,33 @@ func (f TraverseCodeSnippet) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.CodeSnippetQuery", q)
 }
 
+// The DLPHitFunc type is an adapter to allow the use of ordinary function as a Querier.
+type DLPHitFunc func(context.Context, *db.DLPHitQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f DLPHitFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.DLPHitQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.DLPHitQuery", q)
+}
+
+// The TraverseDLPHit type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseDLPHit func(context.Context, *db.DLPHitQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseDLPHit) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseDLPHit) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.DLPHitQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.DLPHitQuery", q)
+}
+
 // The ExtensionFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ExtensionFunc func(context.Context, *db.ExtensionQuery) (db.Value, error)
 
@@ -447,6 +540,33 @@ func (f TraverseModel) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.ModelQuery", q)
 }
 
+// The ModelHealthCheckFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ModelHealthCheckFunc func(context.Context, *db.ModelHealthCheckQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f ModelHealthCheckFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.ModelHealthCheckQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.ModelHealthCheckQuery", q)
+}
+
+// The TraverseModelHealthCheck type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseModelHealthCheck func(context.Context, *db.ModelHealthCheckQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseModelHealthCheck) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseModelHealthCheck) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.ModelHealthCheckQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.ModelHealthCheckQuery", q)
+}
+
 // The ModelProviderFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ModelProviderFunc func(context.Context, *db.ModelProviderQuery) (db.Value, error)
 
@@ -501,6 +621,33 @@ func (f TraverseModelProviderModel) Traverse(ctx context.Context, q db.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *db.ModelProviderModelQuery", q)
 }
 
+// The ResponseCacheFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ResponseCacheFunc func(context.Context, *db.ResponseCacheQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f ResponseCacheFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.ResponseCacheQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.ResponseCacheQuery", q)
+}
+
+// The TraverseResponseCache type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseResponseCache func(context.Context, *db.ResponseCacheQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseResponseCache) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseResponseCache) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.ResponseCacheQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.ResponseCacheQuery", q)
+}
+
 // The RoleFunc type is an adapter to allow the use of ordinary function as a Querier.
 type RoleFunc func(context.Context, *db.RoleQuery) (db.Value, error)
 
@@ -528,6 +675,60 @@ func (f TraverseRole) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.RoleQuery", q)
 }
 
+// The SecurityRulePackFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SecurityRulePackFunc func(context.Context, *db.SecurityRulePackQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f SecurityRulePackFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.SecurityRulePackQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.SecurityRulePackQuery", q)
+}
+
+// The TraverseSecurityRulePack type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSecurityRulePack func(context.Context, *db.SecurityRulePackQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSecurityRulePack) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSecurityRulePack) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.SecurityRulePackQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.SecurityRulePackQuery", q)
+}
+
+// The SecurityRulePackVersionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SecurityRulePackVersionFunc func(context.Context, *db.SecurityRulePackVersionQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f SecurityRulePackVersionFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.SecurityRulePackVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.SecurityRulePackVersionQuery", q)
+}
+
+// The TraverseSecurityRulePackVersion type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSecurityRulePackVersion func(context.Context, *db.SecurityRulePackVersionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSecurityRulePackVersion) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSecurityRulePackVersion) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.SecurityRulePackVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.SecurityRulePackVersionQuery", q)
+}
+
 // The SecurityScanningFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SecurityScanningFunc func(context.Context, *db.SecurityScanningQuery) (db.Value, error)
 
@@ -555,6 +756,60 @@ func (f TraverseSecurityScanning) Traverse(ctx context.Context, q db.Query) erro
 	return fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningQuery", q)
 }
 
+// The SecurityScanningCommentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SecurityScanningCommentFunc func(context.Context, *db.SecurityScanningCommentQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f SecurityScanningCommentFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.SecurityScanningCommentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningCommentQuery", q)
+}
+
+// The TraverseSecurityScanningComment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSecurityScanningComment func(context.Context, *db.SecurityScanningCommentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSecurityScanningComment) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSecurityScanningComment) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.SecurityScanningCommentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningCommentQuery", q)
+}
+
+// The SecurityScanningPolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SecurityScanningPolicyFunc func(context.Context, *db.SecurityScanningPolicyQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f SecurityScanningPolicyFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.SecurityScanningPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningPolicyQuery", q)
+}
+
+// The TraverseSecurityScanningPolicy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSecurityScanningPolicy func(context.Context, *db.SecurityScanningPolicyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSecurityScanningPolicy) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSecurityScanningPolicy) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.SecurityScanningPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningPolicyQuery", q)
+}
+
 // The SecurityScanningResultFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SecurityScanningResultFunc func(context.Context, *db.SecurityScanningResultQuery) (db.Value, error)
 
@@ -582,6 +837,33 @@ func (f TraverseSecurityScanningResult) Traverse(ctx context.Context, q db.Query
 	return fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningResultQuery", q)
 }
 
+// The SecurityScanningSuppressionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SecurityScanningSuppressionFunc func(context.Context, *db.SecurityScanningSuppressionQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f SecurityScanningSuppressionFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.SecurityScanningSuppressionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningSuppressionQuery", q)
+}
+
+// The TraverseSecurityScanningSuppression type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSecurityScanningSuppression func(context.Context, *db.SecurityScanningSuppressionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSecurityScanningSuppression) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSecurityScanningSuppression) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.SecurityScanningSuppressionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.SecurityScanningSuppressionQuery", q)
+}
+
 // The SettingFunc type is an adapter to allow the use of ordinary function as a Querier.
 type SettingFunc func(context.Context, *db.SettingQuery) (db.Value, error)
 
@@ -663,6 +945,60 @@ func (f TraverseTaskRecord) Traverse(ctx context.Context, q db.Query) error {
 	return fmt.Errorf("unexpected query type %T. expect *db.TaskRecordQuery", q)
 }
 
+// The TransformPolicyFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TransformPolicyFunc func(context.Context, *db.TransformPolicyQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f TransformPolicyFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.TransformPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.TransformPolicyQuery", q)
+}
+
+// The TraverseTransformPolicy type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTransformPolicy func(context.Context, *db.TransformPolicyQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTransformPolicy) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTransformPolicy) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.TransformPolicyQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.TransformPolicyQuery", q)
+}
+
+// The TransformRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type TransformRuleFunc func(context.Context, *db.TransformRuleQuery) (db.Value, error)
+
+// Query calls f(ctx, q).
+func (f TransformRuleFunc) Query(ctx context.Context, q db.Query) (db.Value, error) {
+	if q, ok := q.(*db.TransformRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *db.TransformRuleQuery", q)
+}
+
+// The TraverseTransformRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseTransformRule func(context.Context, *db.TransformRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseTransformRule) Intercept(next db.Querier) db.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseTransformRule) Traverse(ctx context.Context, q db.Query) error {
+	if q, ok := q.(*db.TransformRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *db.TransformRuleQuery", q)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
 type UserFunc func(context.Context, *db.UserQuery) (db.Value, error)
 
@@ -890,6 +1226,8 @@ func NewQuery(q db.Query) (Query, error) {
 		return &query[*db.AdminRoleQuery, predicate.AdminRole, adminrole.OrderOption]{typ: db.TypeAdminRole, tq: q}, nil
 	case *db.ApiKeyQuery:
 		return &query[*db.ApiKeyQuery, predicate.ApiKey, apikey.OrderOption]{typ: db.TypeApiKey, tq: q}, nil
+	case *db.AuditLogQuery:
+		return &query[*db.AuditLogQuery, predicate.AuditLog, auditlog.OrderOption]{typ: db.TypeAuditLog, tq: q}, nil
 	case *db.BillingPlanQuery:
 		return &query[*db.BillingPlanQuery, predicate.BillingPlan, billingplan.OrderOption]{typ: db.TypeBillingPlan, tq: q}, nil
 	case *db.BillingQuotaQuery:
@@ -898,8 +1236,12 @@ func NewQuery(q db.Query) (Query, error) {
 		return &query[*db.BillingRecordQuery, predicate.BillingRecord, billingrecord.OrderOption]{typ: db.TypeBillingRecord, tq: q}, nil
 	case *db.BillingUsageQuery:
 		return &query[*db.BillingUsageQuery, predicate.BillingUsage, billingusage.OrderOption]{typ: db.TypeBillingUsage, tq: q}, nil
+	case *db.BudgetAlertQuery:
+		return &query[*db.BudgetAlertQuery, predicate.BudgetAlert, budgetalert.OrderOption]{typ: db.TypeBudgetAlert, tq: q}, nil
 	case *db.CodeSnippetQuery:
 		return &query[*db.CodeSnippetQuery, predicate.CodeSnippet, codesnippet.OrderOption]{typ: db.TypeCodeSnippet, tq: q}, nil
+	case *db.DLPHitQuery:
+		return &query[*db.DLPHitQuery, predicate.DLPHit, dlphit.OrderOption]{typ: db.TypeDLPHit, tq: q}, nil
 	case *db.ExtensionQuery:
 		return &query[*db.ExtensionQuery, predicate.Extension, extension.OrderOption]{typ: db.TypeExtension, tq: q}, nil
 	case *db.InviteCodeQuery:
@@ -908,22 +1250,40 @@ func NewQuery(q db.Query) (Query, error) {
 		return &query[*db.LicenseQuery, predicate.License, license.OrderOption]{typ: db.TypeLicense, tq: q}, nil
 	case *db.ModelQuery:
 		return &query[*db.ModelQuery, predicate.Model, model.OrderOption]{typ: db.TypeModel, tq: q}, nil
+	case *db.ModelHealthCheckQuery:
+		return &query[*db.ModelHealthCheckQuery, predicate.ModelHealthCheck, modelhealthcheck.OrderOption]{typ: db.TypeModelHealthCheck, tq: q}, nil
 	case *db.ModelProviderQuery:
 		return &query[*db.ModelProviderQuery, predicate.ModelProvider, modelprovider.OrderOption]{typ: db.TypeModelProvider, tq: q}, nil
 	case *db.ModelProviderModelQuery:
 		return &query[*db.ModelProviderModelQuery, predicate.ModelProviderModel, modelprovidermodel.OrderOption]{typ: db.TypeModelProviderModel, tq: q}, nil
+	case *db.ResponseCacheQuery:
+		return &query[*db.ResponseCacheQuery, predicate.ResponseCache, responsecache.OrderOption]{typ: db.TypeResponseCache, tq: q}, nil
 	case *db.RoleQuery:
 		return &query[*db.RoleQuery, predicate.Role, role.OrderOption]{typ: db.TypeRole, tq: q}, nil
+	case *db.SecurityRulePackQuery:
+		return &query[*db.SecurityRulePackQuery, predicate.SecurityRulePack, securityrulepack.OrderOption]{typ: db.TypeSecurityRulePack, tq: q}, nil
+	case *db.SecurityRulePackVersionQuery:
+		return &query[*db.SecurityRulePackVersionQuery, predicate.SecurityRulePackVersion, securityrulepackversion.OrderOption]{typ: db.TypeSecurityRulePackVersion, tq: q}, nil
 	case *db.SecurityScanningQuery:
 		return &query[*db.SecurityScanningQuery, predicate.SecurityScanning, securityscanning.OrderOption]{typ: db.TypeSecurityScanning, tq: q}, nil
+	case *db.SecurityScanningCommentQuery:
+		return &query[*db.SecurityScanningCommentQuery, predicate.SecurityScanningComment, securityscanningcomment.OrderOption]{typ: db.TypeSecurityScanningComment, tq: q}, nil
+	case *db.SecurityScanningPolicyQuery:
+		return &query[*db.SecurityScanningPolicyQuery, predicate.SecurityScanningPolicy, securityscanningpolicy.OrderOption]{typ: db.TypeSecurityScanningPolicy, tq: q}, nil
 	case *db.SecurityScanningResultQuery:
 		return &query[*db.SecurityScanningResultQuery, predicate.SecurityScanningResult, securityscanningresult.OrderOption]{typ: db.TypeSecurityScanningResult, tq: q}, nil
+	case *db.SecurityScanningSuppressionQuery:
+		return &query[*db.SecurityScanningSuppressionQuery, predicate.SecurityScanningSuppression, securityscanningsuppression.OrderOption]{typ: db.TypeSecurityScanningSuppression, tq: q}, nil
 	case *db.SettingQuery:
 		return &query[*db.SettingQuery, predicate.Setting, setting.OrderOption]{typ: db.TypeSetting, tq: q}, nil
 	case *db.TaskQuery:
 		return &query[*db.TaskQuery, predicate.Task, task.OrderOption]{typ: db.TypeTask, tq: q}, nil
 	case *db.TaskRecordQuery:
 		return &query[*db.TaskRecordQuery, predicate.TaskRecord, taskrecord.OrderOption]{typ: db.TypeTaskRecord, tq: q}, nil
+	case *db.TransformPolicyQuery:
+		return &query[*db.TransformPolicyQuery, predicate.TransformPolicy, transformpolicy.OrderOption]{typ: db.TypeTransformPolicy, tq: q}, nil
+	case *db.TransformRuleQuery:
+		return &query[*db.TransformRuleQuery, predicate.TransformRule, transformrule.OrderOption]{typ: db.TypeTransformRule, tq: q}, nil
 	case *db.UserQuery:
 		return &query[*db.UserQuery, predicate.User, user.OrderOption]{typ: db.TypeUser, tq: q}, nil
 	case *db.UserGroupQuery:
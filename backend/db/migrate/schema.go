@@ -111,12 +111,54 @@ var (
 			},
 		},
 	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "request_id", Type: field.TypeString},
+		{Name: "task_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "model_id", Type: field.TypeUUID},
+		{Name: "model_name", Type: field.TypeString, Nullable: true},
+		{Name: "model_type", Type: field.TypeString},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "completion", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "request", Type: field.TypeBytes, Nullable: true},
+		{Name: "response", Type: field.TypeBytes, Nullable: true},
+		{Name: "raw_size", Type: field.TypeInt64, Default: 0},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[14]},
+			},
+			{
+				Name:    "auditlog_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[14]},
+			},
+			{
+				Name:    "auditlog_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[2]},
+			},
+		},
+	}
 	// BillingPlansColumns holds the columns for the "billing_plans" table.
 	BillingPlansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString, Unique: true},
-		{Name: "description", Type: field.TypeString},
-		{Name: "rules", Type: field.TypeJSON},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "quota", Type: field.TypeInt64, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -131,6 +173,7 @@ var (
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "plan_id", Type: field.TypeString, Nullable: true},
 		{Name: "total", Type: field.TypeInt64},
 		{Name: "used", Type: field.TypeInt64},
 		{Name: "remain", Type: field.TypeInt64},
@@ -146,15 +189,16 @@ var (
 	// BillingRecordsColumns holds the columns for the "billing_records" table.
 	BillingRecordsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
-		{Name: "tenant_id", Type: field.TypeString, Unique: true},
-		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "tenant_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "model", Type: field.TypeString},
 		{Name: "operation", Type: field.TypeString},
 		{Name: "input_tokens", Type: field.TypeInt64},
 		{Name: "output_tokens", Type: field.TypeInt64},
 		{Name: "cost", Type: field.TypeInt64},
 		{Name: "request_time", Type: field.TypeTime},
-		{Name: "metadata", Type: field.TypeJSON},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -168,7 +212,8 @@ var (
 	BillingUsagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
-		{Name: "user_id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "model_name", Type: field.TypeString},
 		{Name: "tokens", Type: field.TypeInt64},
 		{Name: "operation", Type: field.TypeString},
@@ -181,6 +226,36 @@ var (
 		Columns:    BillingUsagesColumns,
 		PrimaryKey: []*schema.Column{BillingUsagesColumns[0]},
 	}
+	// BudgetAlertsColumns holds the columns for the "budget_alerts" table.
+	BudgetAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "group_id", Type: field.TypeUUID},
+		{Name: "group_name", Type: field.TypeString, Nullable: true},
+		{Name: "month", Type: field.TypeString},
+		{Name: "threshold", Type: field.TypeInt},
+		{Name: "spend", Type: field.TypeInt64},
+		{Name: "budget", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// BudgetAlertsTable holds the schema information for the "budget_alerts" table.
+	BudgetAlertsTable = &schema.Table{
+		Name:       "budget_alerts",
+		Columns:    BudgetAlertsColumns,
+		PrimaryKey: []*schema.Column{BudgetAlertsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgetalert_group_id_month_threshold",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetAlertsColumns[1], BudgetAlertsColumns[3], BudgetAlertsColumns[4]},
+			},
+			{
+				Name:    "budgetalert_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetAlertsColumns[8]},
+			},
+		},
+	}
 	// CodeSnippetsColumns holds the columns for the "code_snippets" table.
 	CodeSnippetsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -255,6 +330,38 @@ var (
 			},
 		},
 	}
+	// DlpHitsColumns holds the columns for the "dlp_hits" table.
+	DlpHitsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "task_id", Type: field.TypeString},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "rule_id", Type: field.TypeString},
+		{Name: "rule_name", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeString},
+		{Name: "action", Type: field.TypeString},
+		{Name: "preview", Type: field.TypeString, Nullable: true},
+		{Name: "count", Type: field.TypeInt, Default: 1},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DlpHitsTable holds the schema information for the "dlp_hits" table.
+	DlpHitsTable = &schema.Table{
+		Name:       "dlp_hits",
+		Columns:    DlpHitsColumns,
+		PrimaryKey: []*schema.Column{DlpHitsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dlphit_task_id",
+				Unique:  false,
+				Columns: []*schema.Column{DlpHitsColumns[1]},
+			},
+			{
+				Name:    "dlphit_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DlpHitsColumns[10]},
+			},
+		},
+	}
 	// ExtensionsColumns holds the columns for the "extensions" table.
 	ExtensionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -302,6 +409,7 @@ var (
 	ModelsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "model_name", Type: field.TypeString},
+		{Name: "aliases", Type: field.TypeJSON, Nullable: true},
 		{Name: "model_type", Type: field.TypeString},
 		{Name: "show_name", Type: field.TypeString, Nullable: true},
 		{Name: "api_base", Type: field.TypeString},
@@ -314,6 +422,8 @@ var (
 		{Name: "status", Type: field.TypeString, Default: "active"},
 		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
 		{Name: "context_length", Type: field.TypeInt, Nullable: true},
+		{Name: "weight", Type: field.TypeInt, Default: 1},
+		{Name: "pricing", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
@@ -326,12 +436,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "models_users_models",
-				Columns:    []*schema.Column{ModelsColumns[16]},
+				Columns:    []*schema.Column{ModelsColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ModelHealthChecksColumns holds the columns for the "model_health_checks" table.
+	ModelHealthChecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "model_id", Type: field.TypeUUID},
+		{Name: "success", Type: field.TypeBool},
+		{Name: "latency", Type: field.TypeInt64, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ModelHealthChecksTable holds the schema information for the "model_health_checks" table.
+	ModelHealthChecksTable = &schema.Table{
+		Name:       "model_health_checks",
+		Columns:    ModelHealthChecksColumns,
+		PrimaryKey: []*schema.Column{ModelHealthChecksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "modelhealthcheck_model_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModelHealthChecksColumns[1], ModelHealthChecksColumns[5]},
+			},
+			{
+				Name:    "modelhealthcheck_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModelHealthChecksColumns[5]},
+			},
+		},
+	}
 	// ModelProvidersColumns holds the columns for the "model_providers" table.
 	ModelProvidersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -369,6 +506,56 @@ var (
 			},
 		},
 	}
+	// ResponseCachesColumns holds the columns for the "response_caches" table.
+	ResponseCachesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "cache_key", Type: field.TypeString, Unique: true},
+		{Name: "context_key", Type: field.TypeString, Nullable: true},
+		{Name: "model_name", Type: field.TypeString},
+		{Name: "model_type", Type: field.TypeString},
+		{Name: "prompt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "completion", Type: field.TypeString, Size: 2147483647},
+		{Name: "finish_reason", Type: field.TypeString, Nullable: true},
+		{Name: "input_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "output_tokens", Type: field.TypeInt64, Default: 0},
+		{Name: "hits", Type: field.TypeInt64, Default: 0},
+		{Name: "embedding", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "vector(1024)"}},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ResponseCachesTable holds the schema information for the "response_caches" table.
+	ResponseCachesTable = &schema.Table{
+		Name:       "response_caches",
+		Columns:    ResponseCachesColumns,
+		PrimaryKey: []*schema.Column{ResponseCachesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "responsecache_model_name_model_type",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCachesColumns[3], ResponseCachesColumns[4]},
+			},
+			{
+				Name:    "responsecache_context_key",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCachesColumns[2]},
+			},
+			{
+				Name:    "responsecache_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCachesColumns[12]},
+			},
+			{
+				Name:    "responsecache_embedding",
+				Unique:  false,
+				Columns: []*schema.Column{ResponseCachesColumns[11]},
+				Annotation: &entsql.IndexAnnotation{
+					OpClass: "vector_cosine_ops",
+					Type:    "hnsw",
+				},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -382,6 +569,56 @@ var (
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
 	}
+	// SecurityRulePacksColumns holds the columns for the "security_rule_packs" table.
+	SecurityRulePacksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+		{Name: "latest_version", Type: field.TypeInt, Default: 0},
+		{Name: "creator", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SecurityRulePacksTable holds the schema information for the "security_rule_packs" table.
+	SecurityRulePacksTable = &schema.Table{
+		Name:       "security_rule_packs",
+		Columns:    SecurityRulePacksColumns,
+		PrimaryKey: []*schema.Column{SecurityRulePacksColumns[0]},
+	}
+	// SecurityRulePackVersionsColumns holds the columns for the "security_rule_pack_versions" table.
+	SecurityRulePackVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "checksum", Type: field.TypeString},
+		{Name: "rule_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "creator", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "pack_id", Type: field.TypeUUID},
+	}
+	// SecurityRulePackVersionsTable holds the schema information for the "security_rule_pack_versions" table.
+	SecurityRulePackVersionsTable = &schema.Table{
+		Name:       "security_rule_pack_versions",
+		Columns:    SecurityRulePackVersionsColumns,
+		PrimaryKey: []*schema.Column{SecurityRulePackVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "security_rule_pack_versions_security_rule_packs_versions",
+				Columns:    []*schema.Column{SecurityRulePackVersionsColumns[7]},
+				RefColumns: []*schema.Column{SecurityRulePacksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityrulepackversion_pack_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{SecurityRulePackVersionsColumns[7], SecurityRulePackVersionsColumns[1]},
+			},
+		},
+	}
 	// SecurityScanningsColumns holds the columns for the "security_scannings" table.
 	SecurityScanningsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -390,6 +627,12 @@ var (
 		{Name: "language", Type: field.TypeString},
 		{Name: "rule", Type: field.TypeString, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
+		{Name: "tool", Type: field.TypeString, Nullable: true},
+		{Name: "policy", Type: field.TypeString, Nullable: true},
+		{Name: "base_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "file_hashes", Type: field.TypeJSON, Nullable: true},
+		{Name: "scanned_files", Type: field.TypeInt, Default: 0},
+		{Name: "sub_scans", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeUUID},
@@ -403,18 +646,67 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "security_scannings_users_security_scannings",
-				Columns:    []*schema.Column{SecurityScanningsColumns[8]},
+				Columns:    []*schema.Column{SecurityScanningsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "security_scannings_workspaces_security_scannings",
-				Columns:    []*schema.Column{SecurityScanningsColumns[9]},
+				Columns:    []*schema.Column{SecurityScanningsColumns[15]},
 				RefColumns: []*schema.Column{WorkspacesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// SecurityScanningCommentsColumns holds the columns for the "security_scanning_comments" table.
+	SecurityScanningCommentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "author_id", Type: field.TypeUUID},
+		{Name: "author", Type: field.TypeString, Nullable: true},
+		{Name: "triage_status", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "result_id", Type: field.TypeUUID},
+	}
+	// SecurityScanningCommentsTable holds the schema information for the "security_scanning_comments" table.
+	SecurityScanningCommentsTable = &schema.Table{
+		Name:       "security_scanning_comments",
+		Columns:    SecurityScanningCommentsColumns,
+		PrimaryKey: []*schema.Column{SecurityScanningCommentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "security_scanning_comments_security_scanning_results_comments",
+				Columns:    []*schema.Column{SecurityScanningCommentsColumns[6]},
+				RefColumns: []*schema.Column{SecurityScanningResultsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityscanningcomment_result_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityScanningCommentsColumns[6], SecurityScanningCommentsColumns[5]},
+			},
+		},
+	}
+	// SecurityScanningPoliciesColumns holds the columns for the "security_scanning_policies" table.
+	SecurityScanningPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "rule_pack_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "min_confidence", Type: field.TypeString, Default: "MEDIUM"},
+		{Name: "min_impact", Type: field.TypeString, Default: "MEDIUM"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SecurityScanningPoliciesTable holds the schema information for the "security_scanning_policies" table.
+	SecurityScanningPoliciesTable = &schema.Table{
+		Name:       "security_scanning_policies",
+		Columns:    SecurityScanningPoliciesColumns,
+		PrimaryKey: []*schema.Column{SecurityScanningPoliciesColumns[0]},
+	}
 	// SecurityScanningResultsColumns holds the columns for the "security_scanning_results" table.
 	SecurityScanningResultsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -436,6 +728,12 @@ var (
 		{Name: "file_content", Type: field.TypeString, Size: 2147483647},
 		{Name: "start_position", Type: field.TypeJSON},
 		{Name: "end_position", Type: field.TypeJSON},
+		{Name: "fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "state", Type: field.TypeString, Nullable: true},
+		{Name: "triage_status", Type: field.TypeString, Default: "open"},
+		{Name: "assignee_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "risk_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "suppression_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "security_scanning_id", Type: field.TypeUUID},
 	}
@@ -447,12 +745,43 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "security_scanning_results_security_scannings_results",
-				Columns:    []*schema.Column{SecurityScanningResultsColumns[20]},
+				Columns:    []*schema.Column{SecurityScanningResultsColumns[26]},
 				RefColumns: []*schema.Column{SecurityScanningsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// SecurityScanningSuppressionsColumns holds the columns for the "security_scanning_suppressions" table.
+	SecurityScanningSuppressionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "workspace_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "fingerprint", Type: field.TypeString, Nullable: true},
+		{Name: "check_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "creator_id", Type: field.TypeUUID},
+		{Name: "creator", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SecurityScanningSuppressionsTable holds the schema information for the "security_scanning_suppressions" table.
+	SecurityScanningSuppressionsTable = &schema.Table{
+		Name:       "security_scanning_suppressions",
+		Columns:    SecurityScanningSuppressionsColumns,
+		PrimaryKey: []*schema.Column{SecurityScanningSuppressionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "securityscanningsuppression_workspace_id_fingerprint",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityScanningSuppressionsColumns[1], SecurityScanningSuppressionsColumns[2]},
+			},
+			{
+				Name:    "securityscanningsuppression_workspace_id_check_id",
+				Unique:  false,
+				Columns: []*schema.Column{SecurityScanningSuppressionsColumns[1], SecurityScanningSuppressionsColumns[3]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -463,6 +792,7 @@ var (
 		{Name: "dingtalk_oauth", Type: field.TypeJSON, Nullable: true},
 		{Name: "custom_oauth", Type: field.TypeJSON, Nullable: true},
 		{Name: "base_url", Type: field.TypeString, Nullable: true},
+		{Name: "rate_limit", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -486,10 +816,17 @@ var (
 		{Name: "code_lines", Type: field.TypeInt64, Nullable: true},
 		{Name: "input_tokens", Type: field.TypeInt64, Nullable: true},
 		{Name: "output_tokens", Type: field.TypeInt64, Nullable: true},
+		{Name: "cached_input_tokens", Type: field.TypeInt64, Nullable: true},
 		{Name: "is_suggested", Type: field.TypeBool, Default: false},
 		{Name: "source_code", Type: field.TypeString, Nullable: true},
 		{Name: "cursor_position", Type: field.TypeJSON, Nullable: true},
 		{Name: "user_input", Type: field.TypeString, Nullable: true},
+		{Name: "cache_hit", Type: field.TypeBool, Default: false},
+		{Name: "policy_version", Type: field.TypeInt64, Nullable: true},
+		{Name: "usage_source", Type: field.TypeString, Nullable: true},
+		{Name: "cost", Type: field.TypeInt64, Default: 0},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
@@ -503,13 +840,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "tasks_models_tasks",
-				Columns:    []*schema.Column{TasksColumns[18]},
+				Columns:    []*schema.Column{TasksColumns[25]},
 				RefColumns: []*schema.Column{ModelsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "tasks_users_tasks",
-				Columns:    []*schema.Column{TasksColumns[19]},
+				Columns:    []*schema.Column{TasksColumns[26]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -524,6 +861,8 @@ var (
 		{Name: "output_tokens", Type: field.TypeInt64, Default: 0},
 		{Name: "code_lines", Type: field.TypeInt64, Default: 0},
 		{Name: "code", Type: field.TypeString, Nullable: true},
+		{Name: "findings", Type: field.TypeJSON, Nullable: true},
+		{Name: "messages", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "task_id", Type: field.TypeUUID, Nullable: true},
@@ -536,12 +875,44 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "task_records_tasks_task_records",
-				Columns:    []*schema.Column{TaskRecordsColumns[9]},
+				Columns:    []*schema.Column{TaskRecordsColumns[11]},
 				RefColumns: []*schema.Column{TasksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// TransformPoliciesColumns holds the columns for the "transform_policies" table.
+	TransformPoliciesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "version", Type: field.TypeInt64, Unique: true},
+		{Name: "rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "admin_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TransformPoliciesTable holds the schema information for the "transform_policies" table.
+	TransformPoliciesTable = &schema.Table{
+		Name:       "transform_policies",
+		Columns:    TransformPoliciesColumns,
+		PrimaryKey: []*schema.Column{TransformPoliciesColumns[0]},
+	}
+	// TransformRulesColumns holds the columns for the "transform_rules" table.
+	TransformRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "model_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "group_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "priority", Type: field.TypeInt, Default: 0},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "config", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TransformRulesTable holds the schema information for the "transform_rules" table.
+	TransformRulesTable = &schema.Table{
+		Name:       "transform_rules",
+		Columns:    TransformRulesColumns,
+		PrimaryKey: []*schema.Column{TransformRulesColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -565,6 +936,10 @@ var (
 	UserGroupsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "name", Type: field.TypeString},
+		{Name: "rate_limit", Type: field.TypeJSON, Nullable: true},
+		{Name: "audit_retention", Type: field.TypeJSON, Nullable: true},
+		{Name: "budget", Type: field.TypeJSON, Nullable: true},
+		{Name: "plan_id", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "admin_id", Type: field.TypeUUID},
 	}
@@ -576,7 +951,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_groups_admins_myusergroups",
-				Columns:    []*schema.Column{UserGroupsColumns[3]},
+				Columns:    []*schema.Column{UserGroupsColumns[7]},
 				RefColumns: []*schema.Column{AdminsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -835,23 +1210,35 @@ var (
 		AdminLoginHistoriesTable,
 		AdminRolesTable,
 		APIKeysTable,
+		AuditLogsTable,
 		BillingPlansTable,
 		BillingQuotasTable,
 		BillingRecordsTable,
 		BillingUsagesTable,
+		BudgetAlertsTable,
 		CodeSnippetsTable,
+		DlpHitsTable,
 		ExtensionsTable,
 		InviteCodesTable,
 		LicenseTable,
 		ModelsTable,
+		ModelHealthChecksTable,
 		ModelProvidersTable,
 		ModelProviderModelsTable,
+		ResponseCachesTable,
 		RolesTable,
+		SecurityRulePacksTable,
+		SecurityRulePackVersionsTable,
 		SecurityScanningsTable,
+		SecurityScanningCommentsTable,
+		SecurityScanningPoliciesTable,
 		SecurityScanningResultsTable,
+		SecurityScanningSuppressionsTable,
 		SettingsTable,
 		TasksTable,
 		TaskRecordsTable,
+		TransformPoliciesTable,
+		TransformRulesTable,
 		UsersTable,
 		UserGroupsTable,
 		UserGroupAdminsTable,
@@ -880,6 +1267,9 @@ func init() {
 	APIKeysTable.Annotation = &entsql.Annotation{
 		Table: "api_keys",
 	}
+	AuditLogsTable.Annotation = &entsql.Annotation{
+		Table: "audit_logs",
+	}
 	BillingPlansTable.Annotation = &entsql.Annotation{
 		Table: "billing_plans",
 	}
@@ -892,10 +1282,16 @@ func init() {
 	BillingUsagesTable.Annotation = &entsql.Annotation{
 		Table: "billing_usages",
 	}
+	BudgetAlertsTable.Annotation = &entsql.Annotation{
+		Table: "budget_alerts",
+	}
 	CodeSnippetsTable.ForeignKeys[0].RefTable = WorkspaceFilesTable
 	CodeSnippetsTable.Annotation = &entsql.Annotation{
 		Table: "code_snippets",
 	}
+	DlpHitsTable.Annotation = &entsql.Annotation{
+		Table: "dlp_hits",
+	}
 	ExtensionsTable.Annotation = &entsql.Annotation{
 		Table: "extensions",
 	}
@@ -909,6 +1305,9 @@ func init() {
 	ModelsTable.Annotation = &entsql.Annotation{
 		Table: "models",
 	}
+	ModelHealthChecksTable.Annotation = &entsql.Annotation{
+		Table: "model_health_checks",
+	}
 	ModelProvidersTable.Annotation = &entsql.Annotation{
 		Table: "model_providers",
 	}
@@ -916,18 +1315,38 @@ func init() {
 	ModelProviderModelsTable.Annotation = &entsql.Annotation{
 		Table: "model_provider_models",
 	}
+	ResponseCachesTable.Annotation = &entsql.Annotation{
+		Table: "response_caches",
+	}
 	RolesTable.Annotation = &entsql.Annotation{
 		Table: "roles",
 	}
+	SecurityRulePacksTable.Annotation = &entsql.Annotation{
+		Table: "security_rule_packs",
+	}
+	SecurityRulePackVersionsTable.ForeignKeys[0].RefTable = SecurityRulePacksTable
+	SecurityRulePackVersionsTable.Annotation = &entsql.Annotation{
+		Table: "security_rule_pack_versions",
+	}
 	SecurityScanningsTable.ForeignKeys[0].RefTable = UsersTable
 	SecurityScanningsTable.ForeignKeys[1].RefTable = WorkspacesTable
 	SecurityScanningsTable.Annotation = &entsql.Annotation{
 		Table: "security_scannings",
 	}
+	SecurityScanningCommentsTable.ForeignKeys[0].RefTable = SecurityScanningResultsTable
+	SecurityScanningCommentsTable.Annotation = &entsql.Annotation{
+		Table: "security_scanning_comments",
+	}
+	SecurityScanningPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "security_scanning_policies",
+	}
 	SecurityScanningResultsTable.ForeignKeys[0].RefTable = SecurityScanningsTable
 	SecurityScanningResultsTable.Annotation = &entsql.Annotation{
 		Table: "security_scanning_results",
 	}
+	SecurityScanningSuppressionsTable.Annotation = &entsql.Annotation{
+		Table: "security_scanning_suppressions",
+	}
 	SettingsTable.Annotation = &entsql.Annotation{
 		Table: "settings",
 	}
@@ -940,6 +1359,12 @@ func init() {
 	TaskRecordsTable.Annotation = &entsql.Annotation{
 		Table: "task_records",
 	}
+	TransformPoliciesTable.Annotation = &entsql.Annotation{
+		Table: "transform_policies",
+	}
+	TransformRulesTable.Annotation = &entsql.Annotation{
+		Table: "transform_rules",
+	}
 	UsersTable.Annotation = &entsql.Annotation{
 		Table: "users",
 	}
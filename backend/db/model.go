@@ -26,6 +26,8 @@ type Model struct {
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// ModelName holds the value of the "model_name" field.
 	ModelName string `json:"model_name,omitempty"`
+	// Aliases holds the value of the "aliases" field.
+	Aliases []string `json:"aliases,omitempty"`
 	// ModelType holds the value of the "model_type" field.
 	ModelType consts.ModelType `json:"model_type,omitempty"`
 	// ShowName holds the value of the "show_name" field.
@@ -50,6 +52,10 @@ type Model struct {
 	Parameters *types.ModelParam `json:"parameters,omitempty"`
 	// ContextLength holds the value of the "context_length" field.
 	ContextLength int `json:"context_length,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight int `json:"weight,omitempty"`
+	// Pricing holds the value of the "pricing" field.
+	Pricing *types.ModelPricing `json:"pricing,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -96,11 +102,11 @@ func (*Model) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case model.FieldParameters:
+		case model.FieldAliases, model.FieldParameters, model.FieldPricing:
 			values[i] = new([]byte)
 		case model.FieldIsInternal:
 			values[i] = new(sql.NullBool)
-		case model.FieldContextLength:
+		case model.FieldContextLength, model.FieldWeight:
 			values[i] = new(sql.NullInt64)
 		case model.FieldModelName, model.FieldModelType, model.FieldShowName, model.FieldAPIBase, model.FieldAPIKey, model.FieldAPIVersion, model.FieldAPIHeader, model.FieldDescription, model.FieldProvider, model.FieldStatus:
 			values[i] = new(sql.NullString)
@@ -141,6 +147,14 @@ func (m *Model) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.ModelName = value.String
 			}
+		case model.FieldAliases:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field aliases", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Aliases); err != nil {
+					return fmt.Errorf("unmarshal field aliases: %w", err)
+				}
+			}
 		case model.FieldModelType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field model_type", values[i])
@@ -215,6 +229,20 @@ func (m *Model) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.ContextLength = int(value.Int64)
 			}
+		case model.FieldWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				m.Weight = int(value.Int64)
+			}
+		case model.FieldPricing:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pricing", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Pricing); err != nil {
+					return fmt.Errorf("unmarshal field pricing: %w", err)
+				}
+			}
 		case model.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -279,6 +307,9 @@ func (m *Model) String() string {
 	builder.WriteString("model_name=")
 	builder.WriteString(m.ModelName)
 	builder.WriteString(", ")
+	builder.WriteString("aliases=")
+	builder.WriteString(fmt.Sprintf("%v", m.Aliases))
+	builder.WriteString(", ")
 	builder.WriteString("model_type=")
 	builder.WriteString(fmt.Sprintf("%v", m.ModelType))
 	builder.WriteString(", ")
@@ -315,6 +346,12 @@ func (m *Model) String() string {
 	builder.WriteString("context_length=")
 	builder.WriteString(fmt.Sprintf("%v", m.ContextLength))
 	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", m.Weight))
+	builder.WriteString(", ")
+	builder.WriteString("pricing=")
+	builder.WriteString(fmt.Sprintf("%v", m.Pricing))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
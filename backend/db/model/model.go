@@ -19,6 +19,8 @@ const (
 	FieldUserID = "user_id"
 	// FieldModelName holds the string denoting the model_name field in the database.
 	FieldModelName = "model_name"
+	// FieldAliases holds the string denoting the aliases field in the database.
+	FieldAliases = "aliases"
 	// FieldModelType holds the string denoting the model_type field in the database.
 	FieldModelType = "model_type"
 	// FieldShowName holds the string denoting the show_name field in the database.
@@ -43,6 +45,10 @@ const (
 	FieldParameters = "parameters"
 	// FieldContextLength holds the string denoting the context_length field in the database.
 	FieldContextLength = "context_length"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
+	// FieldPricing holds the string denoting the pricing field in the database.
+	FieldPricing = "pricing"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -74,6 +80,7 @@ var Columns = []string{
 	FieldID,
 	FieldUserID,
 	FieldModelName,
+	FieldAliases,
 	FieldModelType,
 	FieldShowName,
 	FieldAPIBase,
@@ -86,6 +93,8 @@ var Columns = []string{
 	FieldStatus,
 	FieldParameters,
 	FieldContextLength,
+	FieldWeight,
+	FieldPricing,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -105,6 +114,8 @@ var (
 	DefaultIsInternal bool
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus consts.ModelStatus
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -186,6 +197,11 @@ func ByContextLength(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContextLength, opts...).ToFunc()
 }
 
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -125,6 +125,11 @@ func ContextLength(v int) predicate.Model {
 	return predicate.Model(sql.FieldEQ(FieldContextLength, v))
 }
 
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v int) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldWeight, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Model {
 	return predicate.Model(sql.FieldEQ(FieldCreatedAt, v))
@@ -230,6 +235,16 @@ func ModelNameContainsFold(v string) predicate.Model {
 	return predicate.Model(sql.FieldContainsFold(FieldModelName, v))
 }
 
+// AliasesIsNil applies the IsNil predicate on the "aliases" field.
+func AliasesIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldAliases))
+}
+
+// AliasesNotNil applies the NotNil predicate on the "aliases" field.
+func AliasesNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldAliases))
+}
+
 // ModelTypeEQ applies the EQ predicate on the "model_type" field.
 func ModelTypeEQ(v consts.ModelType) predicate.Model {
 	vc := string(v)
@@ -992,6 +1007,56 @@ func ContextLengthNotNil() predicate.Model {
 	return predicate.Model(sql.FieldNotNull(FieldContextLength))
 }
 
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v int) predicate.Model {
+	return predicate.Model(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v int) predicate.Model {
+	return predicate.Model(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...int) predicate.Model {
+	return predicate.Model(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...int) predicate.Model {
+	return predicate.Model(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v int) predicate.Model {
+	return predicate.Model(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v int) predicate.Model {
+	return predicate.Model(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v int) predicate.Model {
+	return predicate.Model(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v int) predicate.Model {
+	return predicate.Model(sql.FieldLTE(FieldWeight, v))
+}
+
+// PricingIsNil applies the IsNil predicate on the "pricing" field.
+func PricingIsNil() predicate.Model {
+	return predicate.Model(sql.FieldIsNull(FieldPricing))
+}
+
+// PricingNotNil applies the NotNil predicate on the "pricing" field.
+func PricingNotNil() predicate.Model {
+	return predicate.Model(sql.FieldNotNull(FieldPricing))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Model {
 	return predicate.Model(sql.FieldEQ(FieldCreatedAt, v))
@@ -48,6 +48,12 @@ func (mc *ModelCreate) SetModelName(s string) *ModelCreate {
 	return mc
 }
 
+// SetAliases sets the "aliases" field.
+func (mc *ModelCreate) SetAliases(s []string) *ModelCreate {
+	mc.mutation.SetAliases(s)
+	return mc
+}
+
 // SetModelType sets the "model_type" field.
 func (mc *ModelCreate) SetModelType(ct consts.ModelType) *ModelCreate {
 	mc.mutation.SetModelType(ct)
@@ -184,6 +190,26 @@ func (mc *ModelCreate) SetNillableContextLength(i *int) *ModelCreate {
 	return mc
 }
 
+// SetWeight sets the "weight" field.
+func (mc *ModelCreate) SetWeight(i int) *ModelCreate {
+	mc.mutation.SetWeight(i)
+	return mc
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (mc *ModelCreate) SetNillableWeight(i *int) *ModelCreate {
+	if i != nil {
+		mc.SetWeight(*i)
+	}
+	return mc
+}
+
+// SetPricing sets the "pricing" field.
+func (mc *ModelCreate) SetPricing(tp *types.ModelPricing) *ModelCreate {
+	mc.mutation.SetPricing(tp)
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *ModelCreate) SetCreatedAt(t time.Time) *ModelCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -281,6 +307,10 @@ func (mc *ModelCreate) defaults() {
 		v := model.DefaultStatus
 		mc.mutation.SetStatus(v)
 	}
+	if _, ok := mc.mutation.Weight(); !ok {
+		v := model.DefaultWeight
+		mc.mutation.SetWeight(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		v := model.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
@@ -311,6 +341,9 @@ func (mc *ModelCreate) check() error {
 	if _, ok := mc.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "Model.status"`)}
 	}
+	if _, ok := mc.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`db: missing required field "Model.weight"`)}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "Model.created_at"`)}
 	}
@@ -357,6 +390,10 @@ func (mc *ModelCreate) createSpec() (*Model, *sqlgraph.CreateSpec) {
 		_spec.SetField(model.FieldModelName, field.TypeString, value)
 		_node.ModelName = value
 	}
+	if value, ok := mc.mutation.Aliases(); ok {
+		_spec.SetField(model.FieldAliases, field.TypeJSON, value)
+		_node.Aliases = value
+	}
 	if value, ok := mc.mutation.ModelType(); ok {
 		_spec.SetField(model.FieldModelType, field.TypeString, value)
 		_node.ModelType = value
@@ -405,6 +442,14 @@ func (mc *ModelCreate) createSpec() (*Model, *sqlgraph.CreateSpec) {
 		_spec.SetField(model.FieldContextLength, field.TypeInt, value)
 		_node.ContextLength = value
 	}
+	if value, ok := mc.mutation.Weight(); ok {
+		_spec.SetField(model.FieldWeight, field.TypeInt, value)
+		_node.Weight = value
+	}
+	if value, ok := mc.mutation.Pricing(); ok {
+		_spec.SetField(model.FieldPricing, field.TypeJSON, value)
+		_node.Pricing = value
+	}
 	if value, ok := mc.mutation.CreatedAt(); ok {
 		_spec.SetField(model.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -528,6 +573,24 @@ func (u *ModelUpsert) UpdateModelName() *ModelUpsert {
 	return u
 }
 
+// SetAliases sets the "aliases" field.
+func (u *ModelUpsert) SetAliases(v []string) *ModelUpsert {
+	u.Set(model.FieldAliases, v)
+	return u
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *ModelUpsert) UpdateAliases() *ModelUpsert {
+	u.SetExcluded(model.FieldAliases)
+	return u
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *ModelUpsert) ClearAliases() *ModelUpsert {
+	u.SetNull(model.FieldAliases)
+	return u
+}
+
 // SetModelType sets the "model_type" field.
 func (u *ModelUpsert) SetModelType(v consts.ModelType) *ModelUpsert {
 	u.Set(model.FieldModelType, v)
@@ -720,6 +783,42 @@ func (u *ModelUpsert) ClearContextLength() *ModelUpsert {
 	return u
 }
 
+// SetWeight sets the "weight" field.
+func (u *ModelUpsert) SetWeight(v int) *ModelUpsert {
+	u.Set(model.FieldWeight, v)
+	return u
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ModelUpsert) UpdateWeight() *ModelUpsert {
+	u.SetExcluded(model.FieldWeight)
+	return u
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ModelUpsert) AddWeight(v int) *ModelUpsert {
+	u.Add(model.FieldWeight, v)
+	return u
+}
+
+// SetPricing sets the "pricing" field.
+func (u *ModelUpsert) SetPricing(v *types.ModelPricing) *ModelUpsert {
+	u.Set(model.FieldPricing, v)
+	return u
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *ModelUpsert) UpdatePricing() *ModelUpsert {
+	u.SetExcluded(model.FieldPricing)
+	return u
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *ModelUpsert) ClearPricing() *ModelUpsert {
+	u.SetNull(model.FieldPricing)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ModelUpsert) SetCreatedAt(v time.Time) *ModelUpsert {
 	u.Set(model.FieldCreatedAt, v)
@@ -827,6 +926,27 @@ func (u *ModelUpsertOne) UpdateModelName() *ModelUpsertOne {
 	})
 }
 
+// SetAliases sets the "aliases" field.
+func (u *ModelUpsertOne) SetAliases(v []string) *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *ModelUpsertOne) UpdateAliases() *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *ModelUpsertOne) ClearAliases() *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.ClearAliases()
+	})
+}
+
 // SetModelType sets the "model_type" field.
 func (u *ModelUpsertOne) SetModelType(v consts.ModelType) *ModelUpsertOne {
 	return u.Update(func(s *ModelUpsert) {
@@ -1051,6 +1171,48 @@ func (u *ModelUpsertOne) ClearContextLength() *ModelUpsertOne {
 	})
 }
 
+// SetWeight sets the "weight" field.
+func (u *ModelUpsertOne) SetWeight(v int) *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ModelUpsertOne) AddWeight(v int) *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ModelUpsertOne) UpdateWeight() *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// SetPricing sets the "pricing" field.
+func (u *ModelUpsertOne) SetPricing(v *types.ModelPricing) *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.SetPricing(v)
+	})
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *ModelUpsertOne) UpdatePricing() *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.UpdatePricing()
+	})
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *ModelUpsertOne) ClearPricing() *ModelUpsertOne {
+	return u.Update(func(s *ModelUpsert) {
+		s.ClearPricing()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ModelUpsertOne) SetCreatedAt(v time.Time) *ModelUpsertOne {
 	return u.Update(func(s *ModelUpsert) {
@@ -1329,6 +1491,27 @@ func (u *ModelUpsertBulk) UpdateModelName() *ModelUpsertBulk {
 	})
 }
 
+// SetAliases sets the "aliases" field.
+func (u *ModelUpsertBulk) SetAliases(v []string) *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.SetAliases(v)
+	})
+}
+
+// UpdateAliases sets the "aliases" field to the value that was provided on create.
+func (u *ModelUpsertBulk) UpdateAliases() *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.UpdateAliases()
+	})
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (u *ModelUpsertBulk) ClearAliases() *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.ClearAliases()
+	})
+}
+
 // SetModelType sets the "model_type" field.
 func (u *ModelUpsertBulk) SetModelType(v consts.ModelType) *ModelUpsertBulk {
 	return u.Update(func(s *ModelUpsert) {
@@ -1553,6 +1736,48 @@ func (u *ModelUpsertBulk) ClearContextLength() *ModelUpsertBulk {
 	})
 }
 
+// SetWeight sets the "weight" field.
+func (u *ModelUpsertBulk) SetWeight(v int) *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.SetWeight(v)
+	})
+}
+
+// AddWeight adds v to the "weight" field.
+func (u *ModelUpsertBulk) AddWeight(v int) *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.AddWeight(v)
+	})
+}
+
+// UpdateWeight sets the "weight" field to the value that was provided on create.
+func (u *ModelUpsertBulk) UpdateWeight() *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.UpdateWeight()
+	})
+}
+
+// SetPricing sets the "pricing" field.
+func (u *ModelUpsertBulk) SetPricing(v *types.ModelPricing) *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.SetPricing(v)
+	})
+}
+
+// UpdatePricing sets the "pricing" field to the value that was provided on create.
+func (u *ModelUpsertBulk) UpdatePricing() *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.UpdatePricing()
+	})
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (u *ModelUpsertBulk) ClearPricing() *ModelUpsertBulk {
+	return u.Update(func(s *ModelUpsert) {
+		s.ClearPricing()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *ModelUpsertBulk) SetCreatedAt(v time.Time) *ModelUpsertBulk {
 	return u.Update(func(s *ModelUpsert) {
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/model"
@@ -68,6 +69,24 @@ func (mu *ModelUpdate) SetNillableModelName(s *string) *ModelUpdate {
 	return mu
 }
 
+// SetAliases sets the "aliases" field.
+func (mu *ModelUpdate) SetAliases(s []string) *ModelUpdate {
+	mu.mutation.SetAliases(s)
+	return mu
+}
+
+// AppendAliases appends s to the "aliases" field.
+func (mu *ModelUpdate) AppendAliases(s []string) *ModelUpdate {
+	mu.mutation.AppendAliases(s)
+	return mu
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (mu *ModelUpdate) ClearAliases() *ModelUpdate {
+	mu.mutation.ClearAliases()
+	return mu
+}
+
 // SetModelType sets the "model_type" field.
 func (mu *ModelUpdate) SetModelType(ct consts.ModelType) *ModelUpdate {
 	mu.mutation.SetModelType(ct)
@@ -277,6 +296,39 @@ func (mu *ModelUpdate) ClearContextLength() *ModelUpdate {
 	return mu
 }
 
+// SetWeight sets the "weight" field.
+func (mu *ModelUpdate) SetWeight(i int) *ModelUpdate {
+	mu.mutation.ResetWeight()
+	mu.mutation.SetWeight(i)
+	return mu
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (mu *ModelUpdate) SetNillableWeight(i *int) *ModelUpdate {
+	if i != nil {
+		mu.SetWeight(*i)
+	}
+	return mu
+}
+
+// AddWeight adds i to the "weight" field.
+func (mu *ModelUpdate) AddWeight(i int) *ModelUpdate {
+	mu.mutation.AddWeight(i)
+	return mu
+}
+
+// SetPricing sets the "pricing" field.
+func (mu *ModelUpdate) SetPricing(tp *types.ModelPricing) *ModelUpdate {
+	mu.mutation.SetPricing(tp)
+	return mu
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (mu *ModelUpdate) ClearPricing() *ModelUpdate {
+	mu.mutation.ClearPricing()
+	return mu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mu *ModelUpdate) SetCreatedAt(t time.Time) *ModelUpdate {
 	mu.mutation.SetCreatedAt(t)
@@ -403,6 +455,17 @@ func (mu *ModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.ModelName(); ok {
 		_spec.SetField(model.FieldModelName, field.TypeString, value)
 	}
+	if value, ok := mu.mutation.Aliases(); ok {
+		_spec.SetField(model.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, model.FieldAliases, value)
+		})
+	}
+	if mu.mutation.AliasesCleared() {
+		_spec.ClearField(model.FieldAliases, field.TypeJSON)
+	}
 	if value, ok := mu.mutation.ModelType(); ok {
 		_spec.SetField(model.FieldModelType, field.TypeString, value)
 	}
@@ -463,6 +526,18 @@ func (mu *ModelUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if mu.mutation.ContextLengthCleared() {
 		_spec.ClearField(model.FieldContextLength, field.TypeInt)
 	}
+	if value, ok := mu.mutation.Weight(); ok {
+		_spec.SetField(model.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedWeight(); ok {
+		_spec.AddField(model.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Pricing(); ok {
+		_spec.SetField(model.FieldPricing, field.TypeJSON, value)
+	}
+	if mu.mutation.PricingCleared() {
+		_spec.ClearField(model.FieldPricing, field.TypeJSON)
+	}
 	if value, ok := mu.mutation.CreatedAt(); ok {
 		_spec.SetField(model.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -599,6 +674,24 @@ func (muo *ModelUpdateOne) SetNillableModelName(s *string) *ModelUpdateOne {
 	return muo
 }
 
+// SetAliases sets the "aliases" field.
+func (muo *ModelUpdateOne) SetAliases(s []string) *ModelUpdateOne {
+	muo.mutation.SetAliases(s)
+	return muo
+}
+
+// AppendAliases appends s to the "aliases" field.
+func (muo *ModelUpdateOne) AppendAliases(s []string) *ModelUpdateOne {
+	muo.mutation.AppendAliases(s)
+	return muo
+}
+
+// ClearAliases clears the value of the "aliases" field.
+func (muo *ModelUpdateOne) ClearAliases() *ModelUpdateOne {
+	muo.mutation.ClearAliases()
+	return muo
+}
+
 // SetModelType sets the "model_type" field.
 func (muo *ModelUpdateOne) SetModelType(ct consts.ModelType) *ModelUpdateOne {
 	muo.mutation.SetModelType(ct)
@@ -808,6 +901,39 @@ func (muo *ModelUpdateOne) ClearContextLength() *ModelUpdateOne {
 	return muo
 }
 
+// SetWeight sets the "weight" field.
+func (muo *ModelUpdateOne) SetWeight(i int) *ModelUpdateOne {
+	muo.mutation.ResetWeight()
+	muo.mutation.SetWeight(i)
+	return muo
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (muo *ModelUpdateOne) SetNillableWeight(i *int) *ModelUpdateOne {
+	if i != nil {
+		muo.SetWeight(*i)
+	}
+	return muo
+}
+
+// AddWeight adds i to the "weight" field.
+func (muo *ModelUpdateOne) AddWeight(i int) *ModelUpdateOne {
+	muo.mutation.AddWeight(i)
+	return muo
+}
+
+// SetPricing sets the "pricing" field.
+func (muo *ModelUpdateOne) SetPricing(tp *types.ModelPricing) *ModelUpdateOne {
+	muo.mutation.SetPricing(tp)
+	return muo
+}
+
+// ClearPricing clears the value of the "pricing" field.
+func (muo *ModelUpdateOne) ClearPricing() *ModelUpdateOne {
+	muo.mutation.ClearPricing()
+	return muo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (muo *ModelUpdateOne) SetCreatedAt(t time.Time) *ModelUpdateOne {
 	muo.mutation.SetCreatedAt(t)
@@ -964,6 +1090,17 @@ func (muo *ModelUpdateOne) sqlSave(ctx context.Context) (_node *Model, err error
 	if value, ok := muo.mutation.ModelName(); ok {
 		_spec.SetField(model.FieldModelName, field.TypeString, value)
 	}
+	if value, ok := muo.mutation.Aliases(); ok {
+		_spec.SetField(model.FieldAliases, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedAliases(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, model.FieldAliases, value)
+		})
+	}
+	if muo.mutation.AliasesCleared() {
+		_spec.ClearField(model.FieldAliases, field.TypeJSON)
+	}
 	if value, ok := muo.mutation.ModelType(); ok {
 		_spec.SetField(model.FieldModelType, field.TypeString, value)
 	}
@@ -1024,6 +1161,18 @@ func (muo *ModelUpdateOne) sqlSave(ctx context.Context) (_node *Model, err error
 	if muo.mutation.ContextLengthCleared() {
 		_spec.ClearField(model.FieldContextLength, field.TypeInt)
 	}
+	if value, ok := muo.mutation.Weight(); ok {
+		_spec.SetField(model.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedWeight(); ok {
+		_spec.AddField(model.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Pricing(); ok {
+		_spec.SetField(model.FieldPricing, field.TypeJSON, value)
+	}
+	if muo.mutation.PricingCleared() {
+		_spec.ClearField(model.FieldPricing, field.TypeJSON)
+	}
 	if value, ok := muo.mutation.CreatedAt(); ok {
 		_spec.SetField(model.FieldCreatedAt, field.TypeTime, value)
 	}
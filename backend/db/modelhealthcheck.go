@@ -0,0 +1,155 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
+	"github.com/google/uuid"
+)
+
+// ModelHealthCheck is the model entity for the ModelHealthCheck schema.
+type ModelHealthCheck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID uuid.UUID `json:"model_id,omitempty"`
+	// Success holds the value of the "success" field.
+	Success bool `json:"success,omitempty"`
+	// Latency holds the value of the "latency" field.
+	Latency int64 `json:"latency,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ModelHealthCheck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case modelhealthcheck.FieldSuccess:
+			values[i] = new(sql.NullBool)
+		case modelhealthcheck.FieldLatency:
+			values[i] = new(sql.NullInt64)
+		case modelhealthcheck.FieldError:
+			values[i] = new(sql.NullString)
+		case modelhealthcheck.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case modelhealthcheck.FieldID, modelhealthcheck.FieldModelID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ModelHealthCheck fields.
+func (mhc *ModelHealthCheck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case modelhealthcheck.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				mhc.ID = *value
+			}
+		case modelhealthcheck.FieldModelID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value != nil {
+				mhc.ModelID = *value
+			}
+		case modelhealthcheck.FieldSuccess:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field success", values[i])
+			} else if value.Valid {
+				mhc.Success = value.Bool
+			}
+		case modelhealthcheck.FieldLatency:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latency", values[i])
+			} else if value.Valid {
+				mhc.Latency = value.Int64
+			}
+		case modelhealthcheck.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				mhc.Error = value.String
+			}
+		case modelhealthcheck.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				mhc.CreatedAt = value.Time
+			}
+		default:
+			mhc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ModelHealthCheck.
+// This includes values selected through modifiers, order, etc.
+func (mhc *ModelHealthCheck) Value(name string) (ent.Value, error) {
+	return mhc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ModelHealthCheck.
+// Note that you need to call ModelHealthCheck.Unwrap() before calling this method if this ModelHealthCheck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (mhc *ModelHealthCheck) Update() *ModelHealthCheckUpdateOne {
+	return NewModelHealthCheckClient(mhc.config).UpdateOne(mhc)
+}
+
+// Unwrap unwraps the ModelHealthCheck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (mhc *ModelHealthCheck) Unwrap() *ModelHealthCheck {
+	_tx, ok := mhc.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ModelHealthCheck is not a transactional entity")
+	}
+	mhc.config.driver = _tx.drv
+	return mhc
+}
+
+// String implements the fmt.Stringer.
+func (mhc *ModelHealthCheck) String() string {
+	var builder strings.Builder
+	builder.WriteString("ModelHealthCheck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", mhc.ID))
+	builder.WriteString("model_id=")
+	builder.WriteString(fmt.Sprintf("%v", mhc.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("success=")
+	builder.WriteString(fmt.Sprintf("%v", mhc.Success))
+	builder.WriteString(", ")
+	builder.WriteString("latency=")
+	builder.WriteString(fmt.Sprintf("%v", mhc.Latency))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(mhc.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(mhc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ModelHealthChecks is a parsable slice of ModelHealthCheck.
+type ModelHealthChecks []*ModelHealthCheck
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
+	"github.com/google/uuid"
+)
+
+// ModelHealthCheckCreate is the builder for creating a ModelHealthCheck entity.
+type ModelHealthCheckCreate struct {
+	config
+	mutation *ModelHealthCheckMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetModelID sets the "model_id" field.
+func (mhcc *ModelHealthCheckCreate) SetModelID(u uuid.UUID) *ModelHealthCheckCreate {
+	mhcc.mutation.SetModelID(u)
+	return mhcc
+}
+
+// SetSuccess sets the "success" field.
+func (mhcc *ModelHealthCheckCreate) SetSuccess(b bool) *ModelHealthCheckCreate {
+	mhcc.mutation.SetSuccess(b)
+	return mhcc
+}
+
+// SetLatency sets the "latency" field.
+func (mhcc *ModelHealthCheckCreate) SetLatency(i int64) *ModelHealthCheckCreate {
+	mhcc.mutation.SetLatency(i)
+	return mhcc
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (mhcc *ModelHealthCheckCreate) SetNillableLatency(i *int64) *ModelHealthCheckCreate {
+	if i != nil {
+		mhcc.SetLatency(*i)
+	}
+	return mhcc
+}
+
+// SetError sets the "error" field.
+func (mhcc *ModelHealthCheckCreate) SetError(s string) *ModelHealthCheckCreate {
+	mhcc.mutation.SetError(s)
+	return mhcc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (mhcc *ModelHealthCheckCreate) SetNillableError(s *string) *ModelHealthCheckCreate {
+	if s != nil {
+		mhcc.SetError(*s)
+	}
+	return mhcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mhcc *ModelHealthCheckCreate) SetCreatedAt(t time.Time) *ModelHealthCheckCreate {
+	mhcc.mutation.SetCreatedAt(t)
+	return mhcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mhcc *ModelHealthCheckCreate) SetNillableCreatedAt(t *time.Time) *ModelHealthCheckCreate {
+	if t != nil {
+		mhcc.SetCreatedAt(*t)
+	}
+	return mhcc
+}
+
+// SetID sets the "id" field.
+func (mhcc *ModelHealthCheckCreate) SetID(u uuid.UUID) *ModelHealthCheckCreate {
+	mhcc.mutation.SetID(u)
+	return mhcc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (mhcc *ModelHealthCheckCreate) SetNillableID(u *uuid.UUID) *ModelHealthCheckCreate {
+	if u != nil {
+		mhcc.SetID(*u)
+	}
+	return mhcc
+}
+
+// Mutation returns the ModelHealthCheckMutation object of the builder.
+func (mhcc *ModelHealthCheckCreate) Mutation() *ModelHealthCheckMutation {
+	return mhcc.mutation
+}
+
+// Save creates the ModelHealthCheck in the database.
+func (mhcc *ModelHealthCheckCreate) Save(ctx context.Context) (*ModelHealthCheck, error) {
+	mhcc.defaults()
+	return withHooks(ctx, mhcc.sqlSave, mhcc.mutation, mhcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mhcc *ModelHealthCheckCreate) SaveX(ctx context.Context) *ModelHealthCheck {
+	v, err := mhcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mhcc *ModelHealthCheckCreate) Exec(ctx context.Context) error {
+	_, err := mhcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mhcc *ModelHealthCheckCreate) ExecX(ctx context.Context) {
+	if err := mhcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mhcc *ModelHealthCheckCreate) defaults() {
+	if _, ok := mhcc.mutation.Latency(); !ok {
+		v := modelhealthcheck.DefaultLatency
+		mhcc.mutation.SetLatency(v)
+	}
+	if _, ok := mhcc.mutation.CreatedAt(); !ok {
+		v := modelhealthcheck.DefaultCreatedAt()
+		mhcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mhcc.mutation.ID(); !ok {
+		v := modelhealthcheck.DefaultID()
+		mhcc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mhcc *ModelHealthCheckCreate) check() error {
+	if _, ok := mhcc.mutation.ModelID(); !ok {
+		return &ValidationError{Name: "model_id", err: errors.New(`db: missing required field "ModelHealthCheck.model_id"`)}
+	}
+	if _, ok := mhcc.mutation.Success(); !ok {
+		return &ValidationError{Name: "success", err: errors.New(`db: missing required field "ModelHealthCheck.success"`)}
+	}
+	if _, ok := mhcc.mutation.Latency(); !ok {
+		return &ValidationError{Name: "latency", err: errors.New(`db: missing required field "ModelHealthCheck.latency"`)}
+	}
+	if _, ok := mhcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ModelHealthCheck.created_at"`)}
+	}
+	return nil
+}
+
+func (mhcc *ModelHealthCheckCreate) sqlSave(ctx context.Context) (*ModelHealthCheck, error) {
+	if err := mhcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mhcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mhcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	mhcc.mutation.id = &_node.ID
+	mhcc.mutation.done = true
+	return _node, nil
+}
+
+func (mhcc *ModelHealthCheckCreate) createSpec() (*ModelHealthCheck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModelHealthCheck{config: mhcc.config}
+		_spec = sqlgraph.NewCreateSpec(modelhealthcheck.Table, sqlgraph.NewFieldSpec(modelhealthcheck.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = mhcc.conflict
+	if id, ok := mhcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := mhcc.mutation.ModelID(); ok {
+		_spec.SetField(modelhealthcheck.FieldModelID, field.TypeUUID, value)
+		_node.ModelID = value
+	}
+	if value, ok := mhcc.mutation.Success(); ok {
+		_spec.SetField(modelhealthcheck.FieldSuccess, field.TypeBool, value)
+		_node.Success = value
+	}
+	if value, ok := mhcc.mutation.Latency(); ok {
+		_spec.SetField(modelhealthcheck.FieldLatency, field.TypeInt64, value)
+		_node.Latency = value
+	}
+	if value, ok := mhcc.mutation.Error(); ok {
+		_spec.SetField(modelhealthcheck.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := mhcc.mutation.CreatedAt(); ok {
+		_spec.SetField(modelhealthcheck.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModelHealthCheck.Create().
+//		SetModelID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModelHealthCheckUpsert) {
+//			SetModelID(v+v).
+//		}).
+//		Exec(ctx)
+func (mhcc *ModelHealthCheckCreate) OnConflict(opts ...sql.ConflictOption) *ModelHealthCheckUpsertOne {
+	mhcc.conflict = opts
+	return &ModelHealthCheckUpsertOne{
+		create: mhcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModelHealthCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mhcc *ModelHealthCheckCreate) OnConflictColumns(columns ...string) *ModelHealthCheckUpsertOne {
+	mhcc.conflict = append(mhcc.conflict, sql.ConflictColumns(columns...))
+	return &ModelHealthCheckUpsertOne{
+		create: mhcc,
+	}
+}
+
+type (
+	// ModelHealthCheckUpsertOne is the builder for "upsert"-ing
+	//  one ModelHealthCheck node.
+	ModelHealthCheckUpsertOne struct {
+		create *ModelHealthCheckCreate
+	}
+
+	// ModelHealthCheckUpsert is the "OnConflict" setter.
+	ModelHealthCheckUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetModelID sets the "model_id" field.
+func (u *ModelHealthCheckUpsert) SetModelID(v uuid.UUID) *ModelHealthCheckUpsert {
+	u.Set(modelhealthcheck.FieldModelID, v)
+	return u
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsert) UpdateModelID() *ModelHealthCheckUpsert {
+	u.SetExcluded(modelhealthcheck.FieldModelID)
+	return u
+}
+
+// SetSuccess sets the "success" field.
+func (u *ModelHealthCheckUpsert) SetSuccess(v bool) *ModelHealthCheckUpsert {
+	u.Set(modelhealthcheck.FieldSuccess, v)
+	return u
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsert) UpdateSuccess() *ModelHealthCheckUpsert {
+	u.SetExcluded(modelhealthcheck.FieldSuccess)
+	return u
+}
+
+// SetLatency sets the "latency" field.
+func (u *ModelHealthCheckUpsert) SetLatency(v int64) *ModelHealthCheckUpsert {
+	u.Set(modelhealthcheck.FieldLatency, v)
+	return u
+}
+
+// UpdateLatency sets the "latency" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsert) UpdateLatency() *ModelHealthCheckUpsert {
+	u.SetExcluded(modelhealthcheck.FieldLatency)
+	return u
+}
+
+// AddLatency adds v to the "latency" field.
+func (u *ModelHealthCheckUpsert) AddLatency(v int64) *ModelHealthCheckUpsert {
+	u.Add(modelhealthcheck.FieldLatency, v)
+	return u
+}
+
+// SetError sets the "error" field.
+func (u *ModelHealthCheckUpsert) SetError(v string) *ModelHealthCheckUpsert {
+	u.Set(modelhealthcheck.FieldError, v)
+	return u
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsert) UpdateError() *ModelHealthCheckUpsert {
+	u.SetExcluded(modelhealthcheck.FieldError)
+	return u
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ModelHealthCheckUpsert) ClearError() *ModelHealthCheckUpsert {
+	u.SetNull(modelhealthcheck.FieldError)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ModelHealthCheckUpsert) SetCreatedAt(v time.Time) *ModelHealthCheckUpsert {
+	u.Set(modelhealthcheck.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsert) UpdateCreatedAt() *ModelHealthCheckUpsert {
+	u.SetExcluded(modelhealthcheck.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ModelHealthCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(modelhealthcheck.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModelHealthCheckUpsertOne) UpdateNewValues() *ModelHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(modelhealthcheck.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModelHealthCheck.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModelHealthCheckUpsertOne) Ignore() *ModelHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModelHealthCheckUpsertOne) DoNothing() *ModelHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModelHealthCheckCreate.OnConflict
+// documentation for more info.
+func (u *ModelHealthCheckUpsertOne) Update(set func(*ModelHealthCheckUpsert)) *ModelHealthCheckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModelHealthCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModelID sets the "model_id" field.
+func (u *ModelHealthCheckUpsertOne) SetModelID(v uuid.UUID) *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertOne) UpdateModelID() *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *ModelHealthCheckUpsertOne) SetSuccess(v bool) *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertOne) UpdateSuccess() *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetLatency sets the "latency" field.
+func (u *ModelHealthCheckUpsertOne) SetLatency(v int64) *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetLatency(v)
+	})
+}
+
+// AddLatency adds v to the "latency" field.
+func (u *ModelHealthCheckUpsertOne) AddLatency(v int64) *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.AddLatency(v)
+	})
+}
+
+// UpdateLatency sets the "latency" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertOne) UpdateLatency() *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateLatency()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ModelHealthCheckUpsertOne) SetError(v string) *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertOne) UpdateError() *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ModelHealthCheckUpsertOne) ClearError() *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ModelHealthCheckUpsertOne) SetCreatedAt(v time.Time) *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertOne) UpdateCreatedAt() *ModelHealthCheckUpsertOne {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModelHealthCheckUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ModelHealthCheckCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModelHealthCheckUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModelHealthCheckUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: ModelHealthCheckUpsertOne.ID is not supported by MySQL driver. Use ModelHealthCheckUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModelHealthCheckUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModelHealthCheckCreateBulk is the builder for creating many ModelHealthCheck entities in bulk.
+type ModelHealthCheckCreateBulk struct {
+	config
+	err      error
+	builders []*ModelHealthCheckCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModelHealthCheck entities in the database.
+func (mhccb *ModelHealthCheckCreateBulk) Save(ctx context.Context) ([]*ModelHealthCheck, error) {
+	if mhccb.err != nil {
+		return nil, mhccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mhccb.builders))
+	nodes := make([]*ModelHealthCheck, len(mhccb.builders))
+	mutators := make([]Mutator, len(mhccb.builders))
+	for i := range mhccb.builders {
+		func(i int, root context.Context) {
+			builder := mhccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModelHealthCheckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mhccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = mhccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mhccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mhccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mhccb *ModelHealthCheckCreateBulk) SaveX(ctx context.Context) []*ModelHealthCheck {
+	v, err := mhccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mhccb *ModelHealthCheckCreateBulk) Exec(ctx context.Context) error {
+	_, err := mhccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mhccb *ModelHealthCheckCreateBulk) ExecX(ctx context.Context) {
+	if err := mhccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModelHealthCheck.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModelHealthCheckUpsert) {
+//			SetModelID(v+v).
+//		}).
+//		Exec(ctx)
+func (mhccb *ModelHealthCheckCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModelHealthCheckUpsertBulk {
+	mhccb.conflict = opts
+	return &ModelHealthCheckUpsertBulk{
+		create: mhccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModelHealthCheck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (mhccb *ModelHealthCheckCreateBulk) OnConflictColumns(columns ...string) *ModelHealthCheckUpsertBulk {
+	mhccb.conflict = append(mhccb.conflict, sql.ConflictColumns(columns...))
+	return &ModelHealthCheckUpsertBulk{
+		create: mhccb,
+	}
+}
+
+// ModelHealthCheckUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModelHealthCheck nodes.
+type ModelHealthCheckUpsertBulk struct {
+	create *ModelHealthCheckCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModelHealthCheck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(modelhealthcheck.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ModelHealthCheckUpsertBulk) UpdateNewValues() *ModelHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(modelhealthcheck.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModelHealthCheck.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModelHealthCheckUpsertBulk) Ignore() *ModelHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModelHealthCheckUpsertBulk) DoNothing() *ModelHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModelHealthCheckCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModelHealthCheckUpsertBulk) Update(set func(*ModelHealthCheckUpsert)) *ModelHealthCheckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModelHealthCheckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetModelID sets the "model_id" field.
+func (u *ModelHealthCheckUpsertBulk) SetModelID(v uuid.UUID) *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertBulk) UpdateModelID() *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// SetSuccess sets the "success" field.
+func (u *ModelHealthCheckUpsertBulk) SetSuccess(v bool) *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetSuccess(v)
+	})
+}
+
+// UpdateSuccess sets the "success" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertBulk) UpdateSuccess() *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateSuccess()
+	})
+}
+
+// SetLatency sets the "latency" field.
+func (u *ModelHealthCheckUpsertBulk) SetLatency(v int64) *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetLatency(v)
+	})
+}
+
+// AddLatency adds v to the "latency" field.
+func (u *ModelHealthCheckUpsertBulk) AddLatency(v int64) *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.AddLatency(v)
+	})
+}
+
+// UpdateLatency sets the "latency" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertBulk) UpdateLatency() *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateLatency()
+	})
+}
+
+// SetError sets the "error" field.
+func (u *ModelHealthCheckUpsertBulk) SetError(v string) *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetError(v)
+	})
+}
+
+// UpdateError sets the "error" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertBulk) UpdateError() *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateError()
+	})
+}
+
+// ClearError clears the value of the "error" field.
+func (u *ModelHealthCheckUpsertBulk) ClearError() *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.ClearError()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ModelHealthCheckUpsertBulk) SetCreatedAt(v time.Time) *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ModelHealthCheckUpsertBulk) UpdateCreatedAt() *ModelHealthCheckUpsertBulk {
+	return u.Update(func(s *ModelHealthCheckUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ModelHealthCheckUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the ModelHealthCheckCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ModelHealthCheckCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModelHealthCheckUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+)
+
+// ModelHealthCheckDelete is the builder for deleting a ModelHealthCheck entity.
+type ModelHealthCheckDelete struct {
+	config
+	hooks    []Hook
+	mutation *ModelHealthCheckMutation
+}
+
+// Where appends a list predicates to the ModelHealthCheckDelete builder.
+func (mhcd *ModelHealthCheckDelete) Where(ps ...predicate.ModelHealthCheck) *ModelHealthCheckDelete {
+	mhcd.mutation.Where(ps...)
+	return mhcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (mhcd *ModelHealthCheckDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, mhcd.sqlExec, mhcd.mutation, mhcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mhcd *ModelHealthCheckDelete) ExecX(ctx context.Context) int {
+	n, err := mhcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (mhcd *ModelHealthCheckDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(modelhealthcheck.Table, sqlgraph.NewFieldSpec(modelhealthcheck.FieldID, field.TypeUUID))
+	if ps := mhcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, mhcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	mhcd.mutation.done = true
+	return affected, err
+}
+
+// ModelHealthCheckDeleteOne is the builder for deleting a single ModelHealthCheck entity.
+type ModelHealthCheckDeleteOne struct {
+	mhcd *ModelHealthCheckDelete
+}
+
+// Where appends a list predicates to the ModelHealthCheckDelete builder.
+func (mhcdo *ModelHealthCheckDeleteOne) Where(ps ...predicate.ModelHealthCheck) *ModelHealthCheckDeleteOne {
+	mhcdo.mhcd.mutation.Where(ps...)
+	return mhcdo
+}
+
+// Exec executes the deletion query.
+func (mhcdo *ModelHealthCheckDeleteOne) Exec(ctx context.Context) error {
+	n, err := mhcdo.mhcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{modelhealthcheck.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mhcdo *ModelHealthCheckDeleteOne) ExecX(ctx context.Context) {
+	if err := mhcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
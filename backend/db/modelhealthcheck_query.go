@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ModelHealthCheckQuery is the builder for querying ModelHealthCheck entities.
+type ModelHealthCheckQuery struct {
+	config
+	ctx        *QueryContext
+	order      []modelhealthcheck.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ModelHealthCheck
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ModelHealthCheckQuery builder.
+func (mhcq *ModelHealthCheckQuery) Where(ps ...predicate.ModelHealthCheck) *ModelHealthCheckQuery {
+	mhcq.predicates = append(mhcq.predicates, ps...)
+	return mhcq
+}
+
+// Limit the number of records to be returned by this query.
+func (mhcq *ModelHealthCheckQuery) Limit(limit int) *ModelHealthCheckQuery {
+	mhcq.ctx.Limit = &limit
+	return mhcq
+}
+
+// Offset to start from.
+func (mhcq *ModelHealthCheckQuery) Offset(offset int) *ModelHealthCheckQuery {
+	mhcq.ctx.Offset = &offset
+	return mhcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (mhcq *ModelHealthCheckQuery) Unique(unique bool) *ModelHealthCheckQuery {
+	mhcq.ctx.Unique = &unique
+	return mhcq
+}
+
+// Order specifies how the records should be ordered.
+func (mhcq *ModelHealthCheckQuery) Order(o ...modelhealthcheck.OrderOption) *ModelHealthCheckQuery {
+	mhcq.order = append(mhcq.order, o...)
+	return mhcq
+}
+
+// First returns the first ModelHealthCheck entity from the query.
+// Returns a *NotFoundError when no ModelHealthCheck was found.
+func (mhcq *ModelHealthCheckQuery) First(ctx context.Context) (*ModelHealthCheck, error) {
+	nodes, err := mhcq.Limit(1).All(setContextOp(ctx, mhcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{modelhealthcheck.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) FirstX(ctx context.Context) *ModelHealthCheck {
+	node, err := mhcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ModelHealthCheck ID from the query.
+// Returns a *NotFoundError when no ModelHealthCheck ID was found.
+func (mhcq *ModelHealthCheckQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mhcq.Limit(1).IDs(setContextOp(ctx, mhcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{modelhealthcheck.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := mhcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ModelHealthCheck entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ModelHealthCheck entity is found.
+// Returns a *NotFoundError when no ModelHealthCheck entities are found.
+func (mhcq *ModelHealthCheckQuery) Only(ctx context.Context) (*ModelHealthCheck, error) {
+	nodes, err := mhcq.Limit(2).All(setContextOp(ctx, mhcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{modelhealthcheck.Label}
+	default:
+		return nil, &NotSingularError{modelhealthcheck.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) OnlyX(ctx context.Context) *ModelHealthCheck {
+	node, err := mhcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ModelHealthCheck ID in the query.
+// Returns a *NotSingularError when more than one ModelHealthCheck ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (mhcq *ModelHealthCheckQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = mhcq.Limit(2).IDs(setContextOp(ctx, mhcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{modelhealthcheck.Label}
+	default:
+		err = &NotSingularError{modelhealthcheck.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := mhcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ModelHealthChecks.
+func (mhcq *ModelHealthCheckQuery) All(ctx context.Context) ([]*ModelHealthCheck, error) {
+	ctx = setContextOp(ctx, mhcq.ctx, ent.OpQueryAll)
+	if err := mhcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ModelHealthCheck, *ModelHealthCheckQuery]()
+	return withInterceptors[[]*ModelHealthCheck](ctx, mhcq, qr, mhcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) AllX(ctx context.Context) []*ModelHealthCheck {
+	nodes, err := mhcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ModelHealthCheck IDs.
+func (mhcq *ModelHealthCheckQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if mhcq.ctx.Unique == nil && mhcq.path != nil {
+		mhcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, mhcq.ctx, ent.OpQueryIDs)
+	if err = mhcq.Select(modelhealthcheck.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := mhcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (mhcq *ModelHealthCheckQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, mhcq.ctx, ent.OpQueryCount)
+	if err := mhcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, mhcq, querierCount[*ModelHealthCheckQuery](), mhcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) CountX(ctx context.Context) int {
+	count, err := mhcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (mhcq *ModelHealthCheckQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, mhcq.ctx, ent.OpQueryExist)
+	switch _, err := mhcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (mhcq *ModelHealthCheckQuery) ExistX(ctx context.Context) bool {
+	exist, err := mhcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ModelHealthCheckQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (mhcq *ModelHealthCheckQuery) Clone() *ModelHealthCheckQuery {
+	if mhcq == nil {
+		return nil
+	}
+	return &ModelHealthCheckQuery{
+		config:     mhcq.config,
+		ctx:        mhcq.ctx.Clone(),
+		order:      append([]modelhealthcheck.OrderOption{}, mhcq.order...),
+		inters:     append([]Interceptor{}, mhcq.inters...),
+		predicates: append([]predicate.ModelHealthCheck{}, mhcq.predicates...),
+		// clone intermediate query.
+		sql:       mhcq.sql.Clone(),
+		path:      mhcq.path,
+		modifiers: append([]func(*sql.Selector){}, mhcq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ModelID uuid.UUID `json:"model_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ModelHealthCheck.Query().
+//		GroupBy(modelhealthcheck.FieldModelID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (mhcq *ModelHealthCheckQuery) GroupBy(field string, fields ...string) *ModelHealthCheckGroupBy {
+	mhcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ModelHealthCheckGroupBy{build: mhcq}
+	grbuild.flds = &mhcq.ctx.Fields
+	grbuild.label = modelhealthcheck.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ModelID uuid.UUID `json:"model_id,omitempty"`
+//	}
+//
+//	client.ModelHealthCheck.Query().
+//		Select(modelhealthcheck.FieldModelID).
+//		Scan(ctx, &v)
+func (mhcq *ModelHealthCheckQuery) Select(fields ...string) *ModelHealthCheckSelect {
+	mhcq.ctx.Fields = append(mhcq.ctx.Fields, fields...)
+	sbuild := &ModelHealthCheckSelect{ModelHealthCheckQuery: mhcq}
+	sbuild.label = modelhealthcheck.Label
+	sbuild.flds, sbuild.scan = &mhcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ModelHealthCheckSelect configured with the given aggregations.
+func (mhcq *ModelHealthCheckQuery) Aggregate(fns ...AggregateFunc) *ModelHealthCheckSelect {
+	return mhcq.Select().Aggregate(fns...)
+}
+
+func (mhcq *ModelHealthCheckQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range mhcq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, mhcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range mhcq.ctx.Fields {
+		if !modelhealthcheck.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if mhcq.path != nil {
+		prev, err := mhcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		mhcq.sql = prev
+	}
+	return nil
+}
+
+func (mhcq *ModelHealthCheckQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ModelHealthCheck, error) {
+	var (
+		nodes = []*ModelHealthCheck{}
+		_spec = mhcq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ModelHealthCheck).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ModelHealthCheck{config: mhcq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(mhcq.modifiers) > 0 {
+		_spec.Modifiers = mhcq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, mhcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (mhcq *ModelHealthCheckQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := mhcq.querySpec()
+	if len(mhcq.modifiers) > 0 {
+		_spec.Modifiers = mhcq.modifiers
+	}
+	_spec.Node.Columns = mhcq.ctx.Fields
+	if len(mhcq.ctx.Fields) > 0 {
+		_spec.Unique = mhcq.ctx.Unique != nil && *mhcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, mhcq.driver, _spec)
+}
+
+func (mhcq *ModelHealthCheckQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(modelhealthcheck.Table, modelhealthcheck.Columns, sqlgraph.NewFieldSpec(modelhealthcheck.FieldID, field.TypeUUID))
+	_spec.From = mhcq.sql
+	if unique := mhcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if mhcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := mhcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelhealthcheck.FieldID)
+		for i := range fields {
+			if fields[i] != modelhealthcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := mhcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := mhcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := mhcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := mhcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (mhcq *ModelHealthCheckQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(mhcq.driver.Dialect())
+	t1 := builder.Table(modelhealthcheck.Table)
+	columns := mhcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = modelhealthcheck.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if mhcq.sql != nil {
+		selector = mhcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if mhcq.ctx.Unique != nil && *mhcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range mhcq.modifiers {
+		m(selector)
+	}
+	for _, p := range mhcq.predicates {
+		p(selector)
+	}
+	for _, p := range mhcq.order {
+		p(selector)
+	}
+	if offset := mhcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := mhcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (mhcq *ModelHealthCheckQuery) ForUpdate(opts ...sql.LockOption) *ModelHealthCheckQuery {
+	if mhcq.driver.Dialect() == dialect.Postgres {
+		mhcq.Unique(false)
+	}
+	mhcq.modifiers = append(mhcq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return mhcq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (mhcq *ModelHealthCheckQuery) ForShare(opts ...sql.LockOption) *ModelHealthCheckQuery {
+	if mhcq.driver.Dialect() == dialect.Postgres {
+		mhcq.Unique(false)
+	}
+	mhcq.modifiers = append(mhcq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return mhcq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mhcq *ModelHealthCheckQuery) Modify(modifiers ...func(s *sql.Selector)) *ModelHealthCheckSelect {
+	mhcq.modifiers = append(mhcq.modifiers, modifiers...)
+	return mhcq.Select()
+}
+
+// ModelHealthCheckGroupBy is the group-by builder for ModelHealthCheck entities.
+type ModelHealthCheckGroupBy struct {
+	selector
+	build *ModelHealthCheckQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (mhcgb *ModelHealthCheckGroupBy) Aggregate(fns ...AggregateFunc) *ModelHealthCheckGroupBy {
+	mhcgb.fns = append(mhcgb.fns, fns...)
+	return mhcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mhcgb *ModelHealthCheckGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mhcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := mhcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModelHealthCheckQuery, *ModelHealthCheckGroupBy](ctx, mhcgb.build, mhcgb, mhcgb.build.inters, v)
+}
+
+func (mhcgb *ModelHealthCheckGroupBy) sqlScan(ctx context.Context, root *ModelHealthCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(mhcgb.fns))
+	for _, fn := range mhcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*mhcgb.flds)+len(mhcgb.fns))
+		for _, f := range *mhcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*mhcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mhcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ModelHealthCheckSelect is the builder for selecting fields of ModelHealthCheck entities.
+type ModelHealthCheckSelect struct {
+	*ModelHealthCheckQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (mhcs *ModelHealthCheckSelect) Aggregate(fns ...AggregateFunc) *ModelHealthCheckSelect {
+	mhcs.fns = append(mhcs.fns, fns...)
+	return mhcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (mhcs *ModelHealthCheckSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, mhcs.ctx, ent.OpQuerySelect)
+	if err := mhcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ModelHealthCheckQuery, *ModelHealthCheckSelect](ctx, mhcs.ModelHealthCheckQuery, mhcs, mhcs.inters, v)
+}
+
+func (mhcs *ModelHealthCheckSelect) sqlScan(ctx context.Context, root *ModelHealthCheckQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(mhcs.fns))
+	for _, fn := range mhcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*mhcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := mhcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (mhcs *ModelHealthCheckSelect) Modify(modifiers ...func(s *sql.Selector)) *ModelHealthCheckSelect {
+	mhcs.modifiers = append(mhcs.modifiers, modifiers...)
+	return mhcs
+}
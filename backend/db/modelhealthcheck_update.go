@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ModelHealthCheckUpdate is the builder for updating ModelHealthCheck entities.
+type ModelHealthCheckUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ModelHealthCheckMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ModelHealthCheckUpdate builder.
+func (mhcu *ModelHealthCheckUpdate) Where(ps ...predicate.ModelHealthCheck) *ModelHealthCheckUpdate {
+	mhcu.mutation.Where(ps...)
+	return mhcu
+}
+
+// SetModelID sets the "model_id" field.
+func (mhcu *ModelHealthCheckUpdate) SetModelID(u uuid.UUID) *ModelHealthCheckUpdate {
+	mhcu.mutation.SetModelID(u)
+	return mhcu
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (mhcu *ModelHealthCheckUpdate) SetNillableModelID(u *uuid.UUID) *ModelHealthCheckUpdate {
+	if u != nil {
+		mhcu.SetModelID(*u)
+	}
+	return mhcu
+}
+
+// SetSuccess sets the "success" field.
+func (mhcu *ModelHealthCheckUpdate) SetSuccess(b bool) *ModelHealthCheckUpdate {
+	mhcu.mutation.SetSuccess(b)
+	return mhcu
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (mhcu *ModelHealthCheckUpdate) SetNillableSuccess(b *bool) *ModelHealthCheckUpdate {
+	if b != nil {
+		mhcu.SetSuccess(*b)
+	}
+	return mhcu
+}
+
+// SetLatency sets the "latency" field.
+func (mhcu *ModelHealthCheckUpdate) SetLatency(i int64) *ModelHealthCheckUpdate {
+	mhcu.mutation.ResetLatency()
+	mhcu.mutation.SetLatency(i)
+	return mhcu
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (mhcu *ModelHealthCheckUpdate) SetNillableLatency(i *int64) *ModelHealthCheckUpdate {
+	if i != nil {
+		mhcu.SetLatency(*i)
+	}
+	return mhcu
+}
+
+// AddLatency adds i to the "latency" field.
+func (mhcu *ModelHealthCheckUpdate) AddLatency(i int64) *ModelHealthCheckUpdate {
+	mhcu.mutation.AddLatency(i)
+	return mhcu
+}
+
+// SetError sets the "error" field.
+func (mhcu *ModelHealthCheckUpdate) SetError(s string) *ModelHealthCheckUpdate {
+	mhcu.mutation.SetError(s)
+	return mhcu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (mhcu *ModelHealthCheckUpdate) SetNillableError(s *string) *ModelHealthCheckUpdate {
+	if s != nil {
+		mhcu.SetError(*s)
+	}
+	return mhcu
+}
+
+// ClearError clears the value of the "error" field.
+func (mhcu *ModelHealthCheckUpdate) ClearError() *ModelHealthCheckUpdate {
+	mhcu.mutation.ClearError()
+	return mhcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mhcu *ModelHealthCheckUpdate) SetCreatedAt(t time.Time) *ModelHealthCheckUpdate {
+	mhcu.mutation.SetCreatedAt(t)
+	return mhcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mhcu *ModelHealthCheckUpdate) SetNillableCreatedAt(t *time.Time) *ModelHealthCheckUpdate {
+	if t != nil {
+		mhcu.SetCreatedAt(*t)
+	}
+	return mhcu
+}
+
+// Mutation returns the ModelHealthCheckMutation object of the builder.
+func (mhcu *ModelHealthCheckUpdate) Mutation() *ModelHealthCheckMutation {
+	return mhcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mhcu *ModelHealthCheckUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mhcu.sqlSave, mhcu.mutation, mhcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mhcu *ModelHealthCheckUpdate) SaveX(ctx context.Context) int {
+	affected, err := mhcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mhcu *ModelHealthCheckUpdate) Exec(ctx context.Context) error {
+	_, err := mhcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mhcu *ModelHealthCheckUpdate) ExecX(ctx context.Context) {
+	if err := mhcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mhcu *ModelHealthCheckUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModelHealthCheckUpdate {
+	mhcu.modifiers = append(mhcu.modifiers, modifiers...)
+	return mhcu
+}
+
+func (mhcu *ModelHealthCheckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(modelhealthcheck.Table, modelhealthcheck.Columns, sqlgraph.NewFieldSpec(modelhealthcheck.FieldID, field.TypeUUID))
+	if ps := mhcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mhcu.mutation.ModelID(); ok {
+		_spec.SetField(modelhealthcheck.FieldModelID, field.TypeUUID, value)
+	}
+	if value, ok := mhcu.mutation.Success(); ok {
+		_spec.SetField(modelhealthcheck.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := mhcu.mutation.Latency(); ok {
+		_spec.SetField(modelhealthcheck.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := mhcu.mutation.AddedLatency(); ok {
+		_spec.AddField(modelhealthcheck.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := mhcu.mutation.Error(); ok {
+		_spec.SetField(modelhealthcheck.FieldError, field.TypeString, value)
+	}
+	if mhcu.mutation.ErrorCleared() {
+		_spec.ClearField(modelhealthcheck.FieldError, field.TypeString)
+	}
+	if value, ok := mhcu.mutation.CreatedAt(); ok {
+		_spec.SetField(modelhealthcheck.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(mhcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, mhcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelhealthcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mhcu.mutation.done = true
+	return n, nil
+}
+
+// ModelHealthCheckUpdateOne is the builder for updating a single ModelHealthCheck entity.
+type ModelHealthCheckUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ModelHealthCheckMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetModelID sets the "model_id" field.
+func (mhcuo *ModelHealthCheckUpdateOne) SetModelID(u uuid.UUID) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.SetModelID(u)
+	return mhcuo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (mhcuo *ModelHealthCheckUpdateOne) SetNillableModelID(u *uuid.UUID) *ModelHealthCheckUpdateOne {
+	if u != nil {
+		mhcuo.SetModelID(*u)
+	}
+	return mhcuo
+}
+
+// SetSuccess sets the "success" field.
+func (mhcuo *ModelHealthCheckUpdateOne) SetSuccess(b bool) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.SetSuccess(b)
+	return mhcuo
+}
+
+// SetNillableSuccess sets the "success" field if the given value is not nil.
+func (mhcuo *ModelHealthCheckUpdateOne) SetNillableSuccess(b *bool) *ModelHealthCheckUpdateOne {
+	if b != nil {
+		mhcuo.SetSuccess(*b)
+	}
+	return mhcuo
+}
+
+// SetLatency sets the "latency" field.
+func (mhcuo *ModelHealthCheckUpdateOne) SetLatency(i int64) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.ResetLatency()
+	mhcuo.mutation.SetLatency(i)
+	return mhcuo
+}
+
+// SetNillableLatency sets the "latency" field if the given value is not nil.
+func (mhcuo *ModelHealthCheckUpdateOne) SetNillableLatency(i *int64) *ModelHealthCheckUpdateOne {
+	if i != nil {
+		mhcuo.SetLatency(*i)
+	}
+	return mhcuo
+}
+
+// AddLatency adds i to the "latency" field.
+func (mhcuo *ModelHealthCheckUpdateOne) AddLatency(i int64) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.AddLatency(i)
+	return mhcuo
+}
+
+// SetError sets the "error" field.
+func (mhcuo *ModelHealthCheckUpdateOne) SetError(s string) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.SetError(s)
+	return mhcuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (mhcuo *ModelHealthCheckUpdateOne) SetNillableError(s *string) *ModelHealthCheckUpdateOne {
+	if s != nil {
+		mhcuo.SetError(*s)
+	}
+	return mhcuo
+}
+
+// ClearError clears the value of the "error" field.
+func (mhcuo *ModelHealthCheckUpdateOne) ClearError() *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.ClearError()
+	return mhcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mhcuo *ModelHealthCheckUpdateOne) SetCreatedAt(t time.Time) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.SetCreatedAt(t)
+	return mhcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mhcuo *ModelHealthCheckUpdateOne) SetNillableCreatedAt(t *time.Time) *ModelHealthCheckUpdateOne {
+	if t != nil {
+		mhcuo.SetCreatedAt(*t)
+	}
+	return mhcuo
+}
+
+// Mutation returns the ModelHealthCheckMutation object of the builder.
+func (mhcuo *ModelHealthCheckUpdateOne) Mutation() *ModelHealthCheckMutation {
+	return mhcuo.mutation
+}
+
+// Where appends a list predicates to the ModelHealthCheckUpdate builder.
+func (mhcuo *ModelHealthCheckUpdateOne) Where(ps ...predicate.ModelHealthCheck) *ModelHealthCheckUpdateOne {
+	mhcuo.mutation.Where(ps...)
+	return mhcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (mhcuo *ModelHealthCheckUpdateOne) Select(field string, fields ...string) *ModelHealthCheckUpdateOne {
+	mhcuo.fields = append([]string{field}, fields...)
+	return mhcuo
+}
+
+// Save executes the query and returns the updated ModelHealthCheck entity.
+func (mhcuo *ModelHealthCheckUpdateOne) Save(ctx context.Context) (*ModelHealthCheck, error) {
+	return withHooks(ctx, mhcuo.sqlSave, mhcuo.mutation, mhcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mhcuo *ModelHealthCheckUpdateOne) SaveX(ctx context.Context) *ModelHealthCheck {
+	node, err := mhcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (mhcuo *ModelHealthCheckUpdateOne) Exec(ctx context.Context) error {
+	_, err := mhcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mhcuo *ModelHealthCheckUpdateOne) ExecX(ctx context.Context) {
+	if err := mhcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (mhcuo *ModelHealthCheckUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ModelHealthCheckUpdateOne {
+	mhcuo.modifiers = append(mhcuo.modifiers, modifiers...)
+	return mhcuo
+}
+
+func (mhcuo *ModelHealthCheckUpdateOne) sqlSave(ctx context.Context) (_node *ModelHealthCheck, err error) {
+	_spec := sqlgraph.NewUpdateSpec(modelhealthcheck.Table, modelhealthcheck.Columns, sqlgraph.NewFieldSpec(modelhealthcheck.FieldID, field.TypeUUID))
+	id, ok := mhcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ModelHealthCheck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := mhcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, modelhealthcheck.FieldID)
+		for _, f := range fields {
+			if !modelhealthcheck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != modelhealthcheck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := mhcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mhcuo.mutation.ModelID(); ok {
+		_spec.SetField(modelhealthcheck.FieldModelID, field.TypeUUID, value)
+	}
+	if value, ok := mhcuo.mutation.Success(); ok {
+		_spec.SetField(modelhealthcheck.FieldSuccess, field.TypeBool, value)
+	}
+	if value, ok := mhcuo.mutation.Latency(); ok {
+		_spec.SetField(modelhealthcheck.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := mhcuo.mutation.AddedLatency(); ok {
+		_spec.AddField(modelhealthcheck.FieldLatency, field.TypeInt64, value)
+	}
+	if value, ok := mhcuo.mutation.Error(); ok {
+		_spec.SetField(modelhealthcheck.FieldError, field.TypeString, value)
+	}
+	if mhcuo.mutation.ErrorCleared() {
+		_spec.ClearField(modelhealthcheck.FieldError, field.TypeString)
+	}
+	if value, ok := mhcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(modelhealthcheck.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(mhcuo.modifiers...)
+	_node = &ModelHealthCheck{config: mhcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, mhcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{modelhealthcheck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	mhcuo.mutation.done = true
+	return _node, nil
+}
@@ -67,6 +67,20 @@ func (ak *ApiKeyQuery) Page(ctx context.Context, page, size int) ([]*ApiKey, *Pa
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (al *AuditLogQuery) Page(ctx context.Context, page, size int) ([]*AuditLog, *PageInfo, error) {
+	cnt, err := al.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := al.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (bp *BillingPlanQuery) Page(ctx context.Context, page, size int) ([]*BillingPlan, *PageInfo, error) {
 	cnt, err := bp.Count(ctx)
 	if err != nil {
@@ -123,6 +137,20 @@ func (bu *BillingUsageQuery) Page(ctx context.Context, page, size int) ([]*Billi
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (ba *BudgetAlertQuery) Page(ctx context.Context, page, size int) ([]*BudgetAlert, *PageInfo, error) {
+	cnt, err := ba.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := ba.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (cs *CodeSnippetQuery) Page(ctx context.Context, page, size int) ([]*CodeSnippet, *PageInfo, error) {
 	cnt, err := cs.Count(ctx)
 	if err != nil {
@@ -137,6 +165,20 @@ func (cs *CodeSnippetQuery) Page(ctx context.Context, page, size int) ([]*CodeSn
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (dh *DLPHitQuery) Page(ctx context.Context, page, size int) ([]*DLPHit, *PageInfo, error) {
+	cnt, err := dh.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := dh.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (e *ExtensionQuery) Page(ctx context.Context, page, size int) ([]*Extension, *PageInfo, error) {
 	cnt, err := e.Count(ctx)
 	if err != nil {
@@ -193,6 +235,20 @@ func (m *ModelQuery) Page(ctx context.Context, page, size int) ([]*Model, *PageI
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (mhc *ModelHealthCheckQuery) Page(ctx context.Context, page, size int) ([]*ModelHealthCheck, *PageInfo, error) {
+	cnt, err := mhc.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := mhc.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (mp *ModelProviderQuery) Page(ctx context.Context, page, size int) ([]*ModelProvider, *PageInfo, error) {
 	cnt, err := mp.Count(ctx)
 	if err != nil {
@@ -221,6 +277,20 @@ func (mpm *ModelProviderModelQuery) Page(ctx context.Context, page, size int) ([
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (rc *ResponseCacheQuery) Page(ctx context.Context, page, size int) ([]*ResponseCache, *PageInfo, error) {
+	cnt, err := rc.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := rc.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (r *RoleQuery) Page(ctx context.Context, page, size int) ([]*Role, *PageInfo, error) {
 	cnt, err := r.Count(ctx)
 	if err != nil {
@@ -235,6 +305,34 @@ func (r *RoleQuery) Page(ctx context.Context, page, size int) ([]*Role, *PageInf
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (srp *SecurityRulePackQuery) Page(ctx context.Context, page, size int) ([]*SecurityRulePack, *PageInfo, error) {
+	cnt, err := srp.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := srp.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
+func (srpv *SecurityRulePackVersionQuery) Page(ctx context.Context, page, size int) ([]*SecurityRulePackVersion, *PageInfo, error) {
+	cnt, err := srpv.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := srpv.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (ss *SecurityScanningQuery) Page(ctx context.Context, page, size int) ([]*SecurityScanning, *PageInfo, error) {
 	cnt, err := ss.Count(ctx)
 	if err != nil {
@@ -249,6 +347,34 @@ func (ss *SecurityScanningQuery) Page(ctx context.Context, page, size int) ([]*S
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (ssc *SecurityScanningCommentQuery) Page(ctx context.Context, page, size int) ([]*SecurityScanningComment, *PageInfo, error) {
+	cnt, err := ssc.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := ssc.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
+func (ssp *SecurityScanningPolicyQuery) Page(ctx context.Context, page, size int) ([]*SecurityScanningPolicy, *PageInfo, error) {
+	cnt, err := ssp.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := ssp.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (ssr *SecurityScanningResultQuery) Page(ctx context.Context, page, size int) ([]*SecurityScanningResult, *PageInfo, error) {
 	cnt, err := ssr.Count(ctx)
 	if err != nil {
@@ -263,6 +389,20 @@ func (ssr *SecurityScanningResultQuery) Page(ctx context.Context, page, size int
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (sss *SecurityScanningSuppressionQuery) Page(ctx context.Context, page, size int) ([]*SecurityScanningSuppression, *PageInfo, error) {
+	cnt, err := sss.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := sss.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (s *SettingQuery) Page(ctx context.Context, page, size int) ([]*Setting, *PageInfo, error) {
 	cnt, err := s.Count(ctx)
 	if err != nil {
@@ -305,6 +445,34 @@ func (tr *TaskRecordQuery) Page(ctx context.Context, page, size int) ([]*TaskRec
 	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
 }
 
+func (tp *TransformPolicyQuery) Page(ctx context.Context, page, size int) ([]*TransformPolicy, *PageInfo, error) {
+	cnt, err := tp.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := tp.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
+func (tr *TransformRuleQuery) Page(ctx context.Context, page, size int) ([]*TransformRule, *PageInfo, error) {
+	cnt, err := tr.Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	offset := size * (page - 1)
+	rs, err := tr.Offset(offset).Limit(size).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	has := (page * size) < cnt
+	return rs, &PageInfo{HasNextPage: has, TotalCount: int64(cnt)}, nil
+}
+
 func (u *UserQuery) Page(ctx context.Context, page, size int) ([]*User, *PageInfo, error) {
 	cnt, err := u.Count(ctx)
 	if err != nil {
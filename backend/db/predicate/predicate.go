@@ -18,6 +18,9 @@ type AdminRole func(*sql.Selector)
 // ApiKey is the predicate function for apikey builders.
 type ApiKey func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
 // BillingPlan is the predicate function for billingplan builders.
 type BillingPlan func(*sql.Selector)
 
@@ -30,9 +33,15 @@ type BillingRecord func(*sql.Selector)
 // BillingUsage is the predicate function for billingusage builders.
 type BillingUsage func(*sql.Selector)
 
+// BudgetAlert is the predicate function for budgetalert builders.
+type BudgetAlert func(*sql.Selector)
+
 // CodeSnippet is the predicate function for codesnippet builders.
 type CodeSnippet func(*sql.Selector)
 
+// DLPHit is the predicate function for dlphit builders.
+type DLPHit func(*sql.Selector)
+
 // Extension is the predicate function for extension builders.
 type Extension func(*sql.Selector)
 
@@ -45,21 +54,42 @@ type License func(*sql.Selector)
 // Model is the predicate function for model builders.
 type Model func(*sql.Selector)
 
+// ModelHealthCheck is the predicate function for modelhealthcheck builders.
+type ModelHealthCheck func(*sql.Selector)
+
 // ModelProvider is the predicate function for modelprovider builders.
 type ModelProvider func(*sql.Selector)
 
 // ModelProviderModel is the predicate function for modelprovidermodel builders.
 type ModelProviderModel func(*sql.Selector)
 
+// ResponseCache is the predicate function for responsecache builders.
+type ResponseCache func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// SecurityRulePack is the predicate function for securityrulepack builders.
+type SecurityRulePack func(*sql.Selector)
+
+// SecurityRulePackVersion is the predicate function for securityrulepackversion builders.
+type SecurityRulePackVersion func(*sql.Selector)
+
 // SecurityScanning is the predicate function for securityscanning builders.
 type SecurityScanning func(*sql.Selector)
 
+// SecurityScanningComment is the predicate function for securityscanningcomment builders.
+type SecurityScanningComment func(*sql.Selector)
+
+// SecurityScanningPolicy is the predicate function for securityscanningpolicy builders.
+type SecurityScanningPolicy func(*sql.Selector)
+
 // SecurityScanningResult is the predicate function for securityscanningresult builders.
 type SecurityScanningResult func(*sql.Selector)
 
+// SecurityScanningSuppression is the predicate function for securityscanningsuppression builders.
+type SecurityScanningSuppression func(*sql.Selector)
+
 // Setting is the predicate function for setting builders.
 type Setting func(*sql.Selector)
 
@@ -69,6 +99,12 @@ type Task func(*sql.Selector)
 // TaskRecord is the predicate function for taskrecord builders.
 type TaskRecord func(*sql.Selector)
 
+// TransformPolicy is the predicate function for transformpolicy builders.
+type TransformPolicy func(*sql.Selector)
+
+// TransformRule is the predicate function for transformrule builders.
+type TransformRule func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -0,0 +1,256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ResponseCache is the model entity for the ResponseCache schema.
+type ResponseCache struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// CacheKey holds the value of the "cache_key" field.
+	CacheKey string `json:"cache_key,omitempty"`
+	// ContextKey holds the value of the "context_key" field.
+	ContextKey string `json:"context_key,omitempty"`
+	// ModelName holds the value of the "model_name" field.
+	ModelName string `json:"model_name,omitempty"`
+	// ModelType holds the value of the "model_type" field.
+	ModelType consts.ModelType `json:"model_type,omitempty"`
+	// Prompt holds the value of the "prompt" field.
+	Prompt string `json:"prompt,omitempty"`
+	// Completion holds the value of the "completion" field.
+	Completion string `json:"completion,omitempty"`
+	// FinishReason holds the value of the "finish_reason" field.
+	FinishReason string `json:"finish_reason,omitempty"`
+	// InputTokens holds the value of the "input_tokens" field.
+	InputTokens int64 `json:"input_tokens,omitempty"`
+	// OutputTokens holds the value of the "output_tokens" field.
+	OutputTokens int64 `json:"output_tokens,omitempty"`
+	// Hits holds the value of the "hits" field.
+	Hits int64 `json:"hits,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding pgvector.Vector `json:"embedding,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ResponseCache) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case responsecache.FieldEmbedding:
+			values[i] = new(pgvector.Vector)
+		case responsecache.FieldInputTokens, responsecache.FieldOutputTokens, responsecache.FieldHits:
+			values[i] = new(sql.NullInt64)
+		case responsecache.FieldCacheKey, responsecache.FieldContextKey, responsecache.FieldModelName, responsecache.FieldModelType, responsecache.FieldPrompt, responsecache.FieldCompletion, responsecache.FieldFinishReason:
+			values[i] = new(sql.NullString)
+		case responsecache.FieldExpiresAt, responsecache.FieldCreatedAt, responsecache.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case responsecache.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ResponseCache fields.
+func (rc *ResponseCache) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case responsecache.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				rc.ID = *value
+			}
+		case responsecache.FieldCacheKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_key", values[i])
+			} else if value.Valid {
+				rc.CacheKey = value.String
+			}
+		case responsecache.FieldContextKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field context_key", values[i])
+			} else if value.Valid {
+				rc.ContextKey = value.String
+			}
+		case responsecache.FieldModelName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_name", values[i])
+			} else if value.Valid {
+				rc.ModelName = value.String
+			}
+		case responsecache.FieldModelType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_type", values[i])
+			} else if value.Valid {
+				rc.ModelType = consts.ModelType(value.String)
+			}
+		case responsecache.FieldPrompt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field prompt", values[i])
+			} else if value.Valid {
+				rc.Prompt = value.String
+			}
+		case responsecache.FieldCompletion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field completion", values[i])
+			} else if value.Valid {
+				rc.Completion = value.String
+			}
+		case responsecache.FieldFinishReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field finish_reason", values[i])
+			} else if value.Valid {
+				rc.FinishReason = value.String
+			}
+		case responsecache.FieldInputTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field input_tokens", values[i])
+			} else if value.Valid {
+				rc.InputTokens = value.Int64
+			}
+		case responsecache.FieldOutputTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field output_tokens", values[i])
+			} else if value.Valid {
+				rc.OutputTokens = value.Int64
+			}
+		case responsecache.FieldHits:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field hits", values[i])
+			} else if value.Valid {
+				rc.Hits = value.Int64
+			}
+		case responsecache.FieldEmbedding:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value != nil {
+				rc.Embedding = *value
+			}
+		case responsecache.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				rc.ExpiresAt = value.Time
+			}
+		case responsecache.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rc.CreatedAt = value.Time
+			}
+		case responsecache.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rc.UpdatedAt = value.Time
+			}
+		default:
+			rc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ResponseCache.
+// This includes values selected through modifiers, order, etc.
+func (rc *ResponseCache) Value(name string) (ent.Value, error) {
+	return rc.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ResponseCache.
+// Note that you need to call ResponseCache.Unwrap() before calling this method if this ResponseCache
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rc *ResponseCache) Update() *ResponseCacheUpdateOne {
+	return NewResponseCacheClient(rc.config).UpdateOne(rc)
+}
+
+// Unwrap unwraps the ResponseCache entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rc *ResponseCache) Unwrap() *ResponseCache {
+	_tx, ok := rc.config.driver.(*txDriver)
+	if !ok {
+		panic("db: ResponseCache is not a transactional entity")
+	}
+	rc.config.driver = _tx.drv
+	return rc
+}
+
+// String implements the fmt.Stringer.
+func (rc *ResponseCache) String() string {
+	var builder strings.Builder
+	builder.WriteString("ResponseCache(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rc.ID))
+	builder.WriteString("cache_key=")
+	builder.WriteString(rc.CacheKey)
+	builder.WriteString(", ")
+	builder.WriteString("context_key=")
+	builder.WriteString(rc.ContextKey)
+	builder.WriteString(", ")
+	builder.WriteString("model_name=")
+	builder.WriteString(rc.ModelName)
+	builder.WriteString(", ")
+	builder.WriteString("model_type=")
+	builder.WriteString(fmt.Sprintf("%v", rc.ModelType))
+	builder.WriteString(", ")
+	builder.WriteString("prompt=")
+	builder.WriteString(rc.Prompt)
+	builder.WriteString(", ")
+	builder.WriteString("completion=")
+	builder.WriteString(rc.Completion)
+	builder.WriteString(", ")
+	builder.WriteString("finish_reason=")
+	builder.WriteString(rc.FinishReason)
+	builder.WriteString(", ")
+	builder.WriteString("input_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", rc.InputTokens))
+	builder.WriteString(", ")
+	builder.WriteString("output_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", rc.OutputTokens))
+	builder.WriteString(", ")
+	builder.WriteString("hits=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Hits))
+	builder.WriteString(", ")
+	builder.WriteString("embedding=")
+	builder.WriteString(fmt.Sprintf("%v", rc.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(rc.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ResponseCaches is a parsable slice of ResponseCache.
+type ResponseCaches []*ResponseCache
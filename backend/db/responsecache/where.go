@@ -0,0 +1,938 @@
+// Code generated by ent, DO NOT EDIT.
+
+package responsecache
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldID, id))
+}
+
+// CacheKey applies equality check predicate on the "cache_key" field. It's identical to CacheKeyEQ.
+func CacheKey(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// ContextKey applies equality check predicate on the "context_key" field. It's identical to ContextKeyEQ.
+func ContextKey(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldContextKey, v))
+}
+
+// ModelName applies equality check predicate on the "model_name" field. It's identical to ModelNameEQ.
+func ModelName(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldModelName, v))
+}
+
+// ModelType applies equality check predicate on the "model_type" field. It's identical to ModelTypeEQ.
+func ModelType(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldEQ(FieldModelType, vc))
+}
+
+// Prompt applies equality check predicate on the "prompt" field. It's identical to PromptEQ.
+func Prompt(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldPrompt, v))
+}
+
+// Completion applies equality check predicate on the "completion" field. It's identical to CompletionEQ.
+func Completion(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldCompletion, v))
+}
+
+// FinishReason applies equality check predicate on the "finish_reason" field. It's identical to FinishReasonEQ.
+func FinishReason(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldFinishReason, v))
+}
+
+// InputTokens applies equality check predicate on the "input_tokens" field. It's identical to InputTokensEQ.
+func InputTokens(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldInputTokens, v))
+}
+
+// OutputTokens applies equality check predicate on the "output_tokens" field. It's identical to OutputTokensEQ.
+func OutputTokens(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldOutputTokens, v))
+}
+
+// Hits applies equality check predicate on the "hits" field. It's identical to HitsEQ.
+func Hits(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldHits, v))
+}
+
+// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
+func Embedding(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// CacheKeyEQ applies the EQ predicate on the "cache_key" field.
+func CacheKeyEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldCacheKey, v))
+}
+
+// CacheKeyNEQ applies the NEQ predicate on the "cache_key" field.
+func CacheKeyNEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldCacheKey, v))
+}
+
+// CacheKeyIn applies the In predicate on the "cache_key" field.
+func CacheKeyIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyNotIn applies the NotIn predicate on the "cache_key" field.
+func CacheKeyNotIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldCacheKey, vs...))
+}
+
+// CacheKeyGT applies the GT predicate on the "cache_key" field.
+func CacheKeyGT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldCacheKey, v))
+}
+
+// CacheKeyGTE applies the GTE predicate on the "cache_key" field.
+func CacheKeyGTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldCacheKey, v))
+}
+
+// CacheKeyLT applies the LT predicate on the "cache_key" field.
+func CacheKeyLT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldCacheKey, v))
+}
+
+// CacheKeyLTE applies the LTE predicate on the "cache_key" field.
+func CacheKeyLTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldCacheKey, v))
+}
+
+// CacheKeyContains applies the Contains predicate on the "cache_key" field.
+func CacheKeyContains(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContains(FieldCacheKey, v))
+}
+
+// CacheKeyHasPrefix applies the HasPrefix predicate on the "cache_key" field.
+func CacheKeyHasPrefix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldCacheKey, v))
+}
+
+// CacheKeyHasSuffix applies the HasSuffix predicate on the "cache_key" field.
+func CacheKeyHasSuffix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldCacheKey, v))
+}
+
+// CacheKeyEqualFold applies the EqualFold predicate on the "cache_key" field.
+func CacheKeyEqualFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldCacheKey, v))
+}
+
+// CacheKeyContainsFold applies the ContainsFold predicate on the "cache_key" field.
+func CacheKeyContainsFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldCacheKey, v))
+}
+
+// ContextKeyEQ applies the EQ predicate on the "context_key" field.
+func ContextKeyEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldContextKey, v))
+}
+
+// ContextKeyNEQ applies the NEQ predicate on the "context_key" field.
+func ContextKeyNEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldContextKey, v))
+}
+
+// ContextKeyIn applies the In predicate on the "context_key" field.
+func ContextKeyIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldContextKey, vs...))
+}
+
+// ContextKeyNotIn applies the NotIn predicate on the "context_key" field.
+func ContextKeyNotIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldContextKey, vs...))
+}
+
+// ContextKeyGT applies the GT predicate on the "context_key" field.
+func ContextKeyGT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldContextKey, v))
+}
+
+// ContextKeyGTE applies the GTE predicate on the "context_key" field.
+func ContextKeyGTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldContextKey, v))
+}
+
+// ContextKeyLT applies the LT predicate on the "context_key" field.
+func ContextKeyLT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldContextKey, v))
+}
+
+// ContextKeyLTE applies the LTE predicate on the "context_key" field.
+func ContextKeyLTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldContextKey, v))
+}
+
+// ContextKeyContains applies the Contains predicate on the "context_key" field.
+func ContextKeyContains(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContains(FieldContextKey, v))
+}
+
+// ContextKeyHasPrefix applies the HasPrefix predicate on the "context_key" field.
+func ContextKeyHasPrefix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldContextKey, v))
+}
+
+// ContextKeyHasSuffix applies the HasSuffix predicate on the "context_key" field.
+func ContextKeyHasSuffix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldContextKey, v))
+}
+
+// ContextKeyIsNil applies the IsNil predicate on the "context_key" field.
+func ContextKeyIsNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIsNull(FieldContextKey))
+}
+
+// ContextKeyNotNil applies the NotNil predicate on the "context_key" field.
+func ContextKeyNotNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotNull(FieldContextKey))
+}
+
+// ContextKeyEqualFold applies the EqualFold predicate on the "context_key" field.
+func ContextKeyEqualFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldContextKey, v))
+}
+
+// ContextKeyContainsFold applies the ContainsFold predicate on the "context_key" field.
+func ContextKeyContainsFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldContextKey, v))
+}
+
+// ModelNameEQ applies the EQ predicate on the "model_name" field.
+func ModelNameEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldModelName, v))
+}
+
+// ModelNameNEQ applies the NEQ predicate on the "model_name" field.
+func ModelNameNEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldModelName, v))
+}
+
+// ModelNameIn applies the In predicate on the "model_name" field.
+func ModelNameIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldModelName, vs...))
+}
+
+// ModelNameNotIn applies the NotIn predicate on the "model_name" field.
+func ModelNameNotIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldModelName, vs...))
+}
+
+// ModelNameGT applies the GT predicate on the "model_name" field.
+func ModelNameGT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldModelName, v))
+}
+
+// ModelNameGTE applies the GTE predicate on the "model_name" field.
+func ModelNameGTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldModelName, v))
+}
+
+// ModelNameLT applies the LT predicate on the "model_name" field.
+func ModelNameLT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldModelName, v))
+}
+
+// ModelNameLTE applies the LTE predicate on the "model_name" field.
+func ModelNameLTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldModelName, v))
+}
+
+// ModelNameContains applies the Contains predicate on the "model_name" field.
+func ModelNameContains(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContains(FieldModelName, v))
+}
+
+// ModelNameHasPrefix applies the HasPrefix predicate on the "model_name" field.
+func ModelNameHasPrefix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldModelName, v))
+}
+
+// ModelNameHasSuffix applies the HasSuffix predicate on the "model_name" field.
+func ModelNameHasSuffix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldModelName, v))
+}
+
+// ModelNameEqualFold applies the EqualFold predicate on the "model_name" field.
+func ModelNameEqualFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldModelName, v))
+}
+
+// ModelNameContainsFold applies the ContainsFold predicate on the "model_name" field.
+func ModelNameContainsFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldModelName, v))
+}
+
+// ModelTypeEQ applies the EQ predicate on the "model_type" field.
+func ModelTypeEQ(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldEQ(FieldModelType, vc))
+}
+
+// ModelTypeNEQ applies the NEQ predicate on the "model_type" field.
+func ModelTypeNEQ(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldNEQ(FieldModelType, vc))
+}
+
+// ModelTypeIn applies the In predicate on the "model_type" field.
+func ModelTypeIn(vs ...consts.ModelType) predicate.ResponseCache {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ResponseCache(sql.FieldIn(FieldModelType, v...))
+}
+
+// ModelTypeNotIn applies the NotIn predicate on the "model_type" field.
+func ModelTypeNotIn(vs ...consts.ModelType) predicate.ResponseCache {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.ResponseCache(sql.FieldNotIn(FieldModelType, v...))
+}
+
+// ModelTypeGT applies the GT predicate on the "model_type" field.
+func ModelTypeGT(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldGT(FieldModelType, vc))
+}
+
+// ModelTypeGTE applies the GTE predicate on the "model_type" field.
+func ModelTypeGTE(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldGTE(FieldModelType, vc))
+}
+
+// ModelTypeLT applies the LT predicate on the "model_type" field.
+func ModelTypeLT(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldLT(FieldModelType, vc))
+}
+
+// ModelTypeLTE applies the LTE predicate on the "model_type" field.
+func ModelTypeLTE(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldLTE(FieldModelType, vc))
+}
+
+// ModelTypeContains applies the Contains predicate on the "model_type" field.
+func ModelTypeContains(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldContains(FieldModelType, vc))
+}
+
+// ModelTypeHasPrefix applies the HasPrefix predicate on the "model_type" field.
+func ModelTypeHasPrefix(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldModelType, vc))
+}
+
+// ModelTypeHasSuffix applies the HasSuffix predicate on the "model_type" field.
+func ModelTypeHasSuffix(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldModelType, vc))
+}
+
+// ModelTypeEqualFold applies the EqualFold predicate on the "model_type" field.
+func ModelTypeEqualFold(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldModelType, vc))
+}
+
+// ModelTypeContainsFold applies the ContainsFold predicate on the "model_type" field.
+func ModelTypeContainsFold(v consts.ModelType) predicate.ResponseCache {
+	vc := string(v)
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldModelType, vc))
+}
+
+// PromptEQ applies the EQ predicate on the "prompt" field.
+func PromptEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldPrompt, v))
+}
+
+// PromptNEQ applies the NEQ predicate on the "prompt" field.
+func PromptNEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldPrompt, v))
+}
+
+// PromptIn applies the In predicate on the "prompt" field.
+func PromptIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldPrompt, vs...))
+}
+
+// PromptNotIn applies the NotIn predicate on the "prompt" field.
+func PromptNotIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldPrompt, vs...))
+}
+
+// PromptGT applies the GT predicate on the "prompt" field.
+func PromptGT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldPrompt, v))
+}
+
+// PromptGTE applies the GTE predicate on the "prompt" field.
+func PromptGTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldPrompt, v))
+}
+
+// PromptLT applies the LT predicate on the "prompt" field.
+func PromptLT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldPrompt, v))
+}
+
+// PromptLTE applies the LTE predicate on the "prompt" field.
+func PromptLTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldPrompt, v))
+}
+
+// PromptContains applies the Contains predicate on the "prompt" field.
+func PromptContains(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContains(FieldPrompt, v))
+}
+
+// PromptHasPrefix applies the HasPrefix predicate on the "prompt" field.
+func PromptHasPrefix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldPrompt, v))
+}
+
+// PromptHasSuffix applies the HasSuffix predicate on the "prompt" field.
+func PromptHasSuffix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldPrompt, v))
+}
+
+// PromptIsNil applies the IsNil predicate on the "prompt" field.
+func PromptIsNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIsNull(FieldPrompt))
+}
+
+// PromptNotNil applies the NotNil predicate on the "prompt" field.
+func PromptNotNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotNull(FieldPrompt))
+}
+
+// PromptEqualFold applies the EqualFold predicate on the "prompt" field.
+func PromptEqualFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldPrompt, v))
+}
+
+// PromptContainsFold applies the ContainsFold predicate on the "prompt" field.
+func PromptContainsFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldPrompt, v))
+}
+
+// CompletionEQ applies the EQ predicate on the "completion" field.
+func CompletionEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldCompletion, v))
+}
+
+// CompletionNEQ applies the NEQ predicate on the "completion" field.
+func CompletionNEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldCompletion, v))
+}
+
+// CompletionIn applies the In predicate on the "completion" field.
+func CompletionIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldCompletion, vs...))
+}
+
+// CompletionNotIn applies the NotIn predicate on the "completion" field.
+func CompletionNotIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldCompletion, vs...))
+}
+
+// CompletionGT applies the GT predicate on the "completion" field.
+func CompletionGT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldCompletion, v))
+}
+
+// CompletionGTE applies the GTE predicate on the "completion" field.
+func CompletionGTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldCompletion, v))
+}
+
+// CompletionLT applies the LT predicate on the "completion" field.
+func CompletionLT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldCompletion, v))
+}
+
+// CompletionLTE applies the LTE predicate on the "completion" field.
+func CompletionLTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldCompletion, v))
+}
+
+// CompletionContains applies the Contains predicate on the "completion" field.
+func CompletionContains(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContains(FieldCompletion, v))
+}
+
+// CompletionHasPrefix applies the HasPrefix predicate on the "completion" field.
+func CompletionHasPrefix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldCompletion, v))
+}
+
+// CompletionHasSuffix applies the HasSuffix predicate on the "completion" field.
+func CompletionHasSuffix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldCompletion, v))
+}
+
+// CompletionEqualFold applies the EqualFold predicate on the "completion" field.
+func CompletionEqualFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldCompletion, v))
+}
+
+// CompletionContainsFold applies the ContainsFold predicate on the "completion" field.
+func CompletionContainsFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldCompletion, v))
+}
+
+// FinishReasonEQ applies the EQ predicate on the "finish_reason" field.
+func FinishReasonEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldFinishReason, v))
+}
+
+// FinishReasonNEQ applies the NEQ predicate on the "finish_reason" field.
+func FinishReasonNEQ(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldFinishReason, v))
+}
+
+// FinishReasonIn applies the In predicate on the "finish_reason" field.
+func FinishReasonIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldFinishReason, vs...))
+}
+
+// FinishReasonNotIn applies the NotIn predicate on the "finish_reason" field.
+func FinishReasonNotIn(vs ...string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldFinishReason, vs...))
+}
+
+// FinishReasonGT applies the GT predicate on the "finish_reason" field.
+func FinishReasonGT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldFinishReason, v))
+}
+
+// FinishReasonGTE applies the GTE predicate on the "finish_reason" field.
+func FinishReasonGTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldFinishReason, v))
+}
+
+// FinishReasonLT applies the LT predicate on the "finish_reason" field.
+func FinishReasonLT(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldFinishReason, v))
+}
+
+// FinishReasonLTE applies the LTE predicate on the "finish_reason" field.
+func FinishReasonLTE(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldFinishReason, v))
+}
+
+// FinishReasonContains applies the Contains predicate on the "finish_reason" field.
+func FinishReasonContains(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContains(FieldFinishReason, v))
+}
+
+// FinishReasonHasPrefix applies the HasPrefix predicate on the "finish_reason" field.
+func FinishReasonHasPrefix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasPrefix(FieldFinishReason, v))
+}
+
+// FinishReasonHasSuffix applies the HasSuffix predicate on the "finish_reason" field.
+func FinishReasonHasSuffix(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldHasSuffix(FieldFinishReason, v))
+}
+
+// FinishReasonIsNil applies the IsNil predicate on the "finish_reason" field.
+func FinishReasonIsNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIsNull(FieldFinishReason))
+}
+
+// FinishReasonNotNil applies the NotNil predicate on the "finish_reason" field.
+func FinishReasonNotNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotNull(FieldFinishReason))
+}
+
+// FinishReasonEqualFold applies the EqualFold predicate on the "finish_reason" field.
+func FinishReasonEqualFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEqualFold(FieldFinishReason, v))
+}
+
+// FinishReasonContainsFold applies the ContainsFold predicate on the "finish_reason" field.
+func FinishReasonContainsFold(v string) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldContainsFold(FieldFinishReason, v))
+}
+
+// InputTokensEQ applies the EQ predicate on the "input_tokens" field.
+func InputTokensEQ(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldInputTokens, v))
+}
+
+// InputTokensNEQ applies the NEQ predicate on the "input_tokens" field.
+func InputTokensNEQ(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldInputTokens, v))
+}
+
+// InputTokensIn applies the In predicate on the "input_tokens" field.
+func InputTokensIn(vs ...int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldInputTokens, vs...))
+}
+
+// InputTokensNotIn applies the NotIn predicate on the "input_tokens" field.
+func InputTokensNotIn(vs ...int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldInputTokens, vs...))
+}
+
+// InputTokensGT applies the GT predicate on the "input_tokens" field.
+func InputTokensGT(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldInputTokens, v))
+}
+
+// InputTokensGTE applies the GTE predicate on the "input_tokens" field.
+func InputTokensGTE(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldInputTokens, v))
+}
+
+// InputTokensLT applies the LT predicate on the "input_tokens" field.
+func InputTokensLT(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldInputTokens, v))
+}
+
+// InputTokensLTE applies the LTE predicate on the "input_tokens" field.
+func InputTokensLTE(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldInputTokens, v))
+}
+
+// OutputTokensEQ applies the EQ predicate on the "output_tokens" field.
+func OutputTokensEQ(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldOutputTokens, v))
+}
+
+// OutputTokensNEQ applies the NEQ predicate on the "output_tokens" field.
+func OutputTokensNEQ(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldOutputTokens, v))
+}
+
+// OutputTokensIn applies the In predicate on the "output_tokens" field.
+func OutputTokensIn(vs ...int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldOutputTokens, vs...))
+}
+
+// OutputTokensNotIn applies the NotIn predicate on the "output_tokens" field.
+func OutputTokensNotIn(vs ...int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldOutputTokens, vs...))
+}
+
+// OutputTokensGT applies the GT predicate on the "output_tokens" field.
+func OutputTokensGT(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldOutputTokens, v))
+}
+
+// OutputTokensGTE applies the GTE predicate on the "output_tokens" field.
+func OutputTokensGTE(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldOutputTokens, v))
+}
+
+// OutputTokensLT applies the LT predicate on the "output_tokens" field.
+func OutputTokensLT(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldOutputTokens, v))
+}
+
+// OutputTokensLTE applies the LTE predicate on the "output_tokens" field.
+func OutputTokensLTE(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldOutputTokens, v))
+}
+
+// HitsEQ applies the EQ predicate on the "hits" field.
+func HitsEQ(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldHits, v))
+}
+
+// HitsNEQ applies the NEQ predicate on the "hits" field.
+func HitsNEQ(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldHits, v))
+}
+
+// HitsIn applies the In predicate on the "hits" field.
+func HitsIn(vs ...int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldHits, vs...))
+}
+
+// HitsNotIn applies the NotIn predicate on the "hits" field.
+func HitsNotIn(vs ...int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldHits, vs...))
+}
+
+// HitsGT applies the GT predicate on the "hits" field.
+func HitsGT(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldHits, v))
+}
+
+// HitsGTE applies the GTE predicate on the "hits" field.
+func HitsGTE(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldHits, v))
+}
+
+// HitsLT applies the LT predicate on the "hits" field.
+func HitsLT(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldHits, v))
+}
+
+// HitsLTE applies the LTE predicate on the "hits" field.
+func HitsLTE(v int64) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldHits, v))
+}
+
+// EmbeddingEQ applies the EQ predicate on the "embedding" field.
+func EmbeddingEQ(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
+func EmbeddingNEQ(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldEmbedding, v))
+}
+
+// EmbeddingIn applies the In predicate on the "embedding" field.
+func EmbeddingIn(vs ...pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingGT applies the GT predicate on the "embedding" field.
+func EmbeddingGT(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldEmbedding, v))
+}
+
+// EmbeddingGTE applies the GTE predicate on the "embedding" field.
+func EmbeddingGTE(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldEmbedding, v))
+}
+
+// EmbeddingLT applies the LT predicate on the "embedding" field.
+func EmbeddingLT(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldEmbedding, v))
+}
+
+// EmbeddingLTE applies the LTE predicate on the "embedding" field.
+func EmbeddingLTE(v pgvector.Vector) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldEmbedding, v))
+}
+
+// EmbeddingIsNil applies the IsNil predicate on the "embedding" field.
+func EmbeddingIsNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIsNull(FieldEmbedding))
+}
+
+// EmbeddingNotNil applies the NotNil predicate on the "embedding" field.
+func EmbeddingNotNil() predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotNull(FieldEmbedding))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ResponseCache) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ResponseCache) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ResponseCache) predicate.ResponseCache {
+	return predicate.ResponseCache(sql.NotPredicates(p))
+}
@@ -0,0 +1,1408 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
+	"github.com/google/uuid"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ResponseCacheCreate is the builder for creating a ResponseCache entity.
+type ResponseCacheCreate struct {
+	config
+	mutation *ResponseCacheMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (rcc *ResponseCacheCreate) SetCacheKey(s string) *ResponseCacheCreate {
+	rcc.mutation.SetCacheKey(s)
+	return rcc
+}
+
+// SetContextKey sets the "context_key" field.
+func (rcc *ResponseCacheCreate) SetContextKey(s string) *ResponseCacheCreate {
+	rcc.mutation.SetContextKey(s)
+	return rcc
+}
+
+// SetNillableContextKey sets the "context_key" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableContextKey(s *string) *ResponseCacheCreate {
+	if s != nil {
+		rcc.SetContextKey(*s)
+	}
+	return rcc
+}
+
+// SetModelName sets the "model_name" field.
+func (rcc *ResponseCacheCreate) SetModelName(s string) *ResponseCacheCreate {
+	rcc.mutation.SetModelName(s)
+	return rcc
+}
+
+// SetModelType sets the "model_type" field.
+func (rcc *ResponseCacheCreate) SetModelType(ct consts.ModelType) *ResponseCacheCreate {
+	rcc.mutation.SetModelType(ct)
+	return rcc
+}
+
+// SetPrompt sets the "prompt" field.
+func (rcc *ResponseCacheCreate) SetPrompt(s string) *ResponseCacheCreate {
+	rcc.mutation.SetPrompt(s)
+	return rcc
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillablePrompt(s *string) *ResponseCacheCreate {
+	if s != nil {
+		rcc.SetPrompt(*s)
+	}
+	return rcc
+}
+
+// SetCompletion sets the "completion" field.
+func (rcc *ResponseCacheCreate) SetCompletion(s string) *ResponseCacheCreate {
+	rcc.mutation.SetCompletion(s)
+	return rcc
+}
+
+// SetFinishReason sets the "finish_reason" field.
+func (rcc *ResponseCacheCreate) SetFinishReason(s string) *ResponseCacheCreate {
+	rcc.mutation.SetFinishReason(s)
+	return rcc
+}
+
+// SetNillableFinishReason sets the "finish_reason" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableFinishReason(s *string) *ResponseCacheCreate {
+	if s != nil {
+		rcc.SetFinishReason(*s)
+	}
+	return rcc
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (rcc *ResponseCacheCreate) SetInputTokens(i int64) *ResponseCacheCreate {
+	rcc.mutation.SetInputTokens(i)
+	return rcc
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableInputTokens(i *int64) *ResponseCacheCreate {
+	if i != nil {
+		rcc.SetInputTokens(*i)
+	}
+	return rcc
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (rcc *ResponseCacheCreate) SetOutputTokens(i int64) *ResponseCacheCreate {
+	rcc.mutation.SetOutputTokens(i)
+	return rcc
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableOutputTokens(i *int64) *ResponseCacheCreate {
+	if i != nil {
+		rcc.SetOutputTokens(*i)
+	}
+	return rcc
+}
+
+// SetHits sets the "hits" field.
+func (rcc *ResponseCacheCreate) SetHits(i int64) *ResponseCacheCreate {
+	rcc.mutation.SetHits(i)
+	return rcc
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableHits(i *int64) *ResponseCacheCreate {
+	if i != nil {
+		rcc.SetHits(*i)
+	}
+	return rcc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (rcc *ResponseCacheCreate) SetEmbedding(pg pgvector.Vector) *ResponseCacheCreate {
+	rcc.mutation.SetEmbedding(pg)
+	return rcc
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableEmbedding(pg *pgvector.Vector) *ResponseCacheCreate {
+	if pg != nil {
+		rcc.SetEmbedding(*pg)
+	}
+	return rcc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcc *ResponseCacheCreate) SetExpiresAt(t time.Time) *ResponseCacheCreate {
+	rcc.mutation.SetExpiresAt(t)
+	return rcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcc *ResponseCacheCreate) SetCreatedAt(t time.Time) *ResponseCacheCreate {
+	rcc.mutation.SetCreatedAt(t)
+	return rcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableCreatedAt(t *time.Time) *ResponseCacheCreate {
+	if t != nil {
+		rcc.SetCreatedAt(*t)
+	}
+	return rcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcc *ResponseCacheCreate) SetUpdatedAt(t time.Time) *ResponseCacheCreate {
+	rcc.mutation.SetUpdatedAt(t)
+	return rcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rcc *ResponseCacheCreate) SetNillableUpdatedAt(t *time.Time) *ResponseCacheCreate {
+	if t != nil {
+		rcc.SetUpdatedAt(*t)
+	}
+	return rcc
+}
+
+// SetID sets the "id" field.
+func (rcc *ResponseCacheCreate) SetID(u uuid.UUID) *ResponseCacheCreate {
+	rcc.mutation.SetID(u)
+	return rcc
+}
+
+// Mutation returns the ResponseCacheMutation object of the builder.
+func (rcc *ResponseCacheCreate) Mutation() *ResponseCacheMutation {
+	return rcc.mutation
+}
+
+// Save creates the ResponseCache in the database.
+func (rcc *ResponseCacheCreate) Save(ctx context.Context) (*ResponseCache, error) {
+	rcc.defaults()
+	return withHooks(ctx, rcc.sqlSave, rcc.mutation, rcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rcc *ResponseCacheCreate) SaveX(ctx context.Context) *ResponseCache {
+	v, err := rcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcc *ResponseCacheCreate) Exec(ctx context.Context) error {
+	_, err := rcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcc *ResponseCacheCreate) ExecX(ctx context.Context) {
+	if err := rcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcc *ResponseCacheCreate) defaults() {
+	if _, ok := rcc.mutation.InputTokens(); !ok {
+		v := responsecache.DefaultInputTokens
+		rcc.mutation.SetInputTokens(v)
+	}
+	if _, ok := rcc.mutation.OutputTokens(); !ok {
+		v := responsecache.DefaultOutputTokens
+		rcc.mutation.SetOutputTokens(v)
+	}
+	if _, ok := rcc.mutation.Hits(); !ok {
+		v := responsecache.DefaultHits
+		rcc.mutation.SetHits(v)
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		v := responsecache.DefaultCreatedAt()
+		rcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		v := responsecache.DefaultUpdatedAt()
+		rcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rcc *ResponseCacheCreate) check() error {
+	if _, ok := rcc.mutation.CacheKey(); !ok {
+		return &ValidationError{Name: "cache_key", err: errors.New(`db: missing required field "ResponseCache.cache_key"`)}
+	}
+	if _, ok := rcc.mutation.ModelName(); !ok {
+		return &ValidationError{Name: "model_name", err: errors.New(`db: missing required field "ResponseCache.model_name"`)}
+	}
+	if _, ok := rcc.mutation.ModelType(); !ok {
+		return &ValidationError{Name: "model_type", err: errors.New(`db: missing required field "ResponseCache.model_type"`)}
+	}
+	if _, ok := rcc.mutation.Completion(); !ok {
+		return &ValidationError{Name: "completion", err: errors.New(`db: missing required field "ResponseCache.completion"`)}
+	}
+	if _, ok := rcc.mutation.InputTokens(); !ok {
+		return &ValidationError{Name: "input_tokens", err: errors.New(`db: missing required field "ResponseCache.input_tokens"`)}
+	}
+	if _, ok := rcc.mutation.OutputTokens(); !ok {
+		return &ValidationError{Name: "output_tokens", err: errors.New(`db: missing required field "ResponseCache.output_tokens"`)}
+	}
+	if _, ok := rcc.mutation.Hits(); !ok {
+		return &ValidationError{Name: "hits", err: errors.New(`db: missing required field "ResponseCache.hits"`)}
+	}
+	if _, ok := rcc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`db: missing required field "ResponseCache.expires_at"`)}
+	}
+	if _, ok := rcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "ResponseCache.created_at"`)}
+	}
+	if _, ok := rcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "ResponseCache.updated_at"`)}
+	}
+	return nil
+}
+
+func (rcc *ResponseCacheCreate) sqlSave(ctx context.Context) (*ResponseCache, error) {
+	if err := rcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	rcc.mutation.id = &_node.ID
+	rcc.mutation.done = true
+	return _node, nil
+}
+
+func (rcc *ResponseCacheCreate) createSpec() (*ResponseCache, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ResponseCache{config: rcc.config}
+		_spec = sqlgraph.NewCreateSpec(responsecache.Table, sqlgraph.NewFieldSpec(responsecache.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = rcc.conflict
+	if id, ok := rcc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := rcc.mutation.CacheKey(); ok {
+		_spec.SetField(responsecache.FieldCacheKey, field.TypeString, value)
+		_node.CacheKey = value
+	}
+	if value, ok := rcc.mutation.ContextKey(); ok {
+		_spec.SetField(responsecache.FieldContextKey, field.TypeString, value)
+		_node.ContextKey = value
+	}
+	if value, ok := rcc.mutation.ModelName(); ok {
+		_spec.SetField(responsecache.FieldModelName, field.TypeString, value)
+		_node.ModelName = value
+	}
+	if value, ok := rcc.mutation.ModelType(); ok {
+		_spec.SetField(responsecache.FieldModelType, field.TypeString, value)
+		_node.ModelType = value
+	}
+	if value, ok := rcc.mutation.Prompt(); ok {
+		_spec.SetField(responsecache.FieldPrompt, field.TypeString, value)
+		_node.Prompt = value
+	}
+	if value, ok := rcc.mutation.Completion(); ok {
+		_spec.SetField(responsecache.FieldCompletion, field.TypeString, value)
+		_node.Completion = value
+	}
+	if value, ok := rcc.mutation.FinishReason(); ok {
+		_spec.SetField(responsecache.FieldFinishReason, field.TypeString, value)
+		_node.FinishReason = value
+	}
+	if value, ok := rcc.mutation.InputTokens(); ok {
+		_spec.SetField(responsecache.FieldInputTokens, field.TypeInt64, value)
+		_node.InputTokens = value
+	}
+	if value, ok := rcc.mutation.OutputTokens(); ok {
+		_spec.SetField(responsecache.FieldOutputTokens, field.TypeInt64, value)
+		_node.OutputTokens = value
+	}
+	if value, ok := rcc.mutation.Hits(); ok {
+		_spec.SetField(responsecache.FieldHits, field.TypeInt64, value)
+		_node.Hits = value
+	}
+	if value, ok := rcc.mutation.Embedding(); ok {
+		_spec.SetField(responsecache.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = value
+	}
+	if value, ok := rcc.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecache.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := rcc.mutation.CreatedAt(); ok {
+		_spec.SetField(responsecache.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(responsecache.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseCache.Create().
+//		SetCacheKey(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseCacheUpsert) {
+//			SetCacheKey(v+v).
+//		}).
+//		Exec(ctx)
+func (rcc *ResponseCacheCreate) OnConflict(opts ...sql.ConflictOption) *ResponseCacheUpsertOne {
+	rcc.conflict = opts
+	return &ResponseCacheUpsertOne{
+		create: rcc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rcc *ResponseCacheCreate) OnConflictColumns(columns ...string) *ResponseCacheUpsertOne {
+	rcc.conflict = append(rcc.conflict, sql.ConflictColumns(columns...))
+	return &ResponseCacheUpsertOne{
+		create: rcc,
+	}
+}
+
+type (
+	// ResponseCacheUpsertOne is the builder for "upsert"-ing
+	//  one ResponseCache node.
+	ResponseCacheUpsertOne struct {
+		create *ResponseCacheCreate
+	}
+
+	// ResponseCacheUpsert is the "OnConflict" setter.
+	ResponseCacheUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetCacheKey sets the "cache_key" field.
+func (u *ResponseCacheUpsert) SetCacheKey(v string) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldCacheKey, v)
+	return u
+}
+
+// UpdateCacheKey sets the "cache_key" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateCacheKey() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldCacheKey)
+	return u
+}
+
+// SetContextKey sets the "context_key" field.
+func (u *ResponseCacheUpsert) SetContextKey(v string) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldContextKey, v)
+	return u
+}
+
+// UpdateContextKey sets the "context_key" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateContextKey() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldContextKey)
+	return u
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (u *ResponseCacheUpsert) ClearContextKey() *ResponseCacheUpsert {
+	u.SetNull(responsecache.FieldContextKey)
+	return u
+}
+
+// SetModelName sets the "model_name" field.
+func (u *ResponseCacheUpsert) SetModelName(v string) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldModelName, v)
+	return u
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateModelName() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldModelName)
+	return u
+}
+
+// SetModelType sets the "model_type" field.
+func (u *ResponseCacheUpsert) SetModelType(v consts.ModelType) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldModelType, v)
+	return u
+}
+
+// UpdateModelType sets the "model_type" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateModelType() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldModelType)
+	return u
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ResponseCacheUpsert) SetPrompt(v string) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldPrompt, v)
+	return u
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdatePrompt() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldPrompt)
+	return u
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *ResponseCacheUpsert) ClearPrompt() *ResponseCacheUpsert {
+	u.SetNull(responsecache.FieldPrompt)
+	return u
+}
+
+// SetCompletion sets the "completion" field.
+func (u *ResponseCacheUpsert) SetCompletion(v string) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldCompletion, v)
+	return u
+}
+
+// UpdateCompletion sets the "completion" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateCompletion() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldCompletion)
+	return u
+}
+
+// SetFinishReason sets the "finish_reason" field.
+func (u *ResponseCacheUpsert) SetFinishReason(v string) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldFinishReason, v)
+	return u
+}
+
+// UpdateFinishReason sets the "finish_reason" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateFinishReason() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldFinishReason)
+	return u
+}
+
+// ClearFinishReason clears the value of the "finish_reason" field.
+func (u *ResponseCacheUpsert) ClearFinishReason() *ResponseCacheUpsert {
+	u.SetNull(responsecache.FieldFinishReason)
+	return u
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (u *ResponseCacheUpsert) SetInputTokens(v int64) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldInputTokens, v)
+	return u
+}
+
+// UpdateInputTokens sets the "input_tokens" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateInputTokens() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldInputTokens)
+	return u
+}
+
+// AddInputTokens adds v to the "input_tokens" field.
+func (u *ResponseCacheUpsert) AddInputTokens(v int64) *ResponseCacheUpsert {
+	u.Add(responsecache.FieldInputTokens, v)
+	return u
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (u *ResponseCacheUpsert) SetOutputTokens(v int64) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldOutputTokens, v)
+	return u
+}
+
+// UpdateOutputTokens sets the "output_tokens" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateOutputTokens() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldOutputTokens)
+	return u
+}
+
+// AddOutputTokens adds v to the "output_tokens" field.
+func (u *ResponseCacheUpsert) AddOutputTokens(v int64) *ResponseCacheUpsert {
+	u.Add(responsecache.FieldOutputTokens, v)
+	return u
+}
+
+// SetHits sets the "hits" field.
+func (u *ResponseCacheUpsert) SetHits(v int64) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldHits, v)
+	return u
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateHits() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldHits)
+	return u
+}
+
+// AddHits adds v to the "hits" field.
+func (u *ResponseCacheUpsert) AddHits(v int64) *ResponseCacheUpsert {
+	u.Add(responsecache.FieldHits, v)
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *ResponseCacheUpsert) SetEmbedding(v pgvector.Vector) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateEmbedding() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldEmbedding)
+	return u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *ResponseCacheUpsert) ClearEmbedding() *ResponseCacheUpsert {
+	u.SetNull(responsecache.FieldEmbedding)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResponseCacheUpsert) SetExpiresAt(v time.Time) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateExpiresAt() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldExpiresAt)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResponseCacheUpsert) SetCreatedAt(v time.Time) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateCreatedAt() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResponseCacheUpsert) SetUpdatedAt(v time.Time) *ResponseCacheUpsert {
+	u.Set(responsecache.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsert) UpdateUpdatedAt() *ResponseCacheUpsert {
+	u.SetExcluded(responsecache.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(responsecache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResponseCacheUpsertOne) UpdateNewValues() *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(responsecache.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ResponseCacheUpsertOne) Ignore() *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseCacheUpsertOne) DoNothing() *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseCacheCreate.OnConflict
+// documentation for more info.
+func (u *ResponseCacheUpsertOne) Update(set func(*ResponseCacheUpsert)) *ResponseCacheUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (u *ResponseCacheUpsertOne) SetCacheKey(v string) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetCacheKey(v)
+	})
+}
+
+// UpdateCacheKey sets the "cache_key" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateCacheKey() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateCacheKey()
+	})
+}
+
+// SetContextKey sets the "context_key" field.
+func (u *ResponseCacheUpsertOne) SetContextKey(v string) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetContextKey(v)
+	})
+}
+
+// UpdateContextKey sets the "context_key" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateContextKey() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateContextKey()
+	})
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (u *ResponseCacheUpsertOne) ClearContextKey() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearContextKey()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *ResponseCacheUpsertOne) SetModelName(v string) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateModelName() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// SetModelType sets the "model_type" field.
+func (u *ResponseCacheUpsertOne) SetModelType(v consts.ModelType) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetModelType(v)
+	})
+}
+
+// UpdateModelType sets the "model_type" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateModelType() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateModelType()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ResponseCacheUpsertOne) SetPrompt(v string) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdatePrompt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *ResponseCacheUpsertOne) ClearPrompt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearPrompt()
+	})
+}
+
+// SetCompletion sets the "completion" field.
+func (u *ResponseCacheUpsertOne) SetCompletion(v string) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetCompletion(v)
+	})
+}
+
+// UpdateCompletion sets the "completion" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateCompletion() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateCompletion()
+	})
+}
+
+// SetFinishReason sets the "finish_reason" field.
+func (u *ResponseCacheUpsertOne) SetFinishReason(v string) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetFinishReason(v)
+	})
+}
+
+// UpdateFinishReason sets the "finish_reason" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateFinishReason() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateFinishReason()
+	})
+}
+
+// ClearFinishReason clears the value of the "finish_reason" field.
+func (u *ResponseCacheUpsertOne) ClearFinishReason() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearFinishReason()
+	})
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (u *ResponseCacheUpsertOne) SetInputTokens(v int64) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetInputTokens(v)
+	})
+}
+
+// AddInputTokens adds v to the "input_tokens" field.
+func (u *ResponseCacheUpsertOne) AddInputTokens(v int64) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.AddInputTokens(v)
+	})
+}
+
+// UpdateInputTokens sets the "input_tokens" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateInputTokens() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateInputTokens()
+	})
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (u *ResponseCacheUpsertOne) SetOutputTokens(v int64) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetOutputTokens(v)
+	})
+}
+
+// AddOutputTokens adds v to the "output_tokens" field.
+func (u *ResponseCacheUpsertOne) AddOutputTokens(v int64) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.AddOutputTokens(v)
+	})
+}
+
+// UpdateOutputTokens sets the "output_tokens" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateOutputTokens() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateOutputTokens()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *ResponseCacheUpsertOne) SetHits(v int64) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *ResponseCacheUpsertOne) AddHits(v int64) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateHits() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *ResponseCacheUpsertOne) SetEmbedding(v pgvector.Vector) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateEmbedding() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *ResponseCacheUpsertOne) ClearEmbedding() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResponseCacheUpsertOne) SetExpiresAt(v time.Time) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateExpiresAt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResponseCacheUpsertOne) SetCreatedAt(v time.Time) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateCreatedAt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResponseCacheUpsertOne) SetUpdatedAt(v time.Time) *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertOne) UpdateUpdatedAt() *ResponseCacheUpsertOne {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseCacheUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ResponseCacheCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseCacheUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ResponseCacheUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: ResponseCacheUpsertOne.ID is not supported by MySQL driver. Use ResponseCacheUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ResponseCacheUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ResponseCacheCreateBulk is the builder for creating many ResponseCache entities in bulk.
+type ResponseCacheCreateBulk struct {
+	config
+	err      error
+	builders []*ResponseCacheCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ResponseCache entities in the database.
+func (rccb *ResponseCacheCreateBulk) Save(ctx context.Context) ([]*ResponseCache, error) {
+	if rccb.err != nil {
+		return nil, rccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rccb.builders))
+	nodes := make([]*ResponseCache, len(rccb.builders))
+	mutators := make([]Mutator, len(rccb.builders))
+	for i := range rccb.builders {
+		func(i int, root context.Context) {
+			builder := rccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ResponseCacheMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = rccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rccb *ResponseCacheCreateBulk) SaveX(ctx context.Context) []*ResponseCache {
+	v, err := rccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rccb *ResponseCacheCreateBulk) Exec(ctx context.Context) error {
+	_, err := rccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rccb *ResponseCacheCreateBulk) ExecX(ctx context.Context) {
+	if err := rccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ResponseCache.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ResponseCacheUpsert) {
+//			SetCacheKey(v+v).
+//		}).
+//		Exec(ctx)
+func (rccb *ResponseCacheCreateBulk) OnConflict(opts ...sql.ConflictOption) *ResponseCacheUpsertBulk {
+	rccb.conflict = opts
+	return &ResponseCacheUpsertBulk{
+		create: rccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (rccb *ResponseCacheCreateBulk) OnConflictColumns(columns ...string) *ResponseCacheUpsertBulk {
+	rccb.conflict = append(rccb.conflict, sql.ConflictColumns(columns...))
+	return &ResponseCacheUpsertBulk{
+		create: rccb,
+	}
+}
+
+// ResponseCacheUpsertBulk is the builder for "upsert"-ing
+// a bulk of ResponseCache nodes.
+type ResponseCacheUpsertBulk struct {
+	create *ResponseCacheCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(responsecache.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ResponseCacheUpsertBulk) UpdateNewValues() *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(responsecache.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ResponseCache.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ResponseCacheUpsertBulk) Ignore() *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ResponseCacheUpsertBulk) DoNothing() *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ResponseCacheCreateBulk.OnConflict
+// documentation for more info.
+func (u *ResponseCacheUpsertBulk) Update(set func(*ResponseCacheUpsert)) *ResponseCacheUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ResponseCacheUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (u *ResponseCacheUpsertBulk) SetCacheKey(v string) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetCacheKey(v)
+	})
+}
+
+// UpdateCacheKey sets the "cache_key" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateCacheKey() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateCacheKey()
+	})
+}
+
+// SetContextKey sets the "context_key" field.
+func (u *ResponseCacheUpsertBulk) SetContextKey(v string) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetContextKey(v)
+	})
+}
+
+// UpdateContextKey sets the "context_key" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateContextKey() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateContextKey()
+	})
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (u *ResponseCacheUpsertBulk) ClearContextKey() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearContextKey()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *ResponseCacheUpsertBulk) SetModelName(v string) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateModelName() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// SetModelType sets the "model_type" field.
+func (u *ResponseCacheUpsertBulk) SetModelType(v consts.ModelType) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetModelType(v)
+	})
+}
+
+// UpdateModelType sets the "model_type" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateModelType() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateModelType()
+	})
+}
+
+// SetPrompt sets the "prompt" field.
+func (u *ResponseCacheUpsertBulk) SetPrompt(v string) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetPrompt(v)
+	})
+}
+
+// UpdatePrompt sets the "prompt" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdatePrompt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdatePrompt()
+	})
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (u *ResponseCacheUpsertBulk) ClearPrompt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearPrompt()
+	})
+}
+
+// SetCompletion sets the "completion" field.
+func (u *ResponseCacheUpsertBulk) SetCompletion(v string) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetCompletion(v)
+	})
+}
+
+// UpdateCompletion sets the "completion" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateCompletion() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateCompletion()
+	})
+}
+
+// SetFinishReason sets the "finish_reason" field.
+func (u *ResponseCacheUpsertBulk) SetFinishReason(v string) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetFinishReason(v)
+	})
+}
+
+// UpdateFinishReason sets the "finish_reason" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateFinishReason() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateFinishReason()
+	})
+}
+
+// ClearFinishReason clears the value of the "finish_reason" field.
+func (u *ResponseCacheUpsertBulk) ClearFinishReason() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearFinishReason()
+	})
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (u *ResponseCacheUpsertBulk) SetInputTokens(v int64) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetInputTokens(v)
+	})
+}
+
+// AddInputTokens adds v to the "input_tokens" field.
+func (u *ResponseCacheUpsertBulk) AddInputTokens(v int64) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.AddInputTokens(v)
+	})
+}
+
+// UpdateInputTokens sets the "input_tokens" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateInputTokens() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateInputTokens()
+	})
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (u *ResponseCacheUpsertBulk) SetOutputTokens(v int64) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetOutputTokens(v)
+	})
+}
+
+// AddOutputTokens adds v to the "output_tokens" field.
+func (u *ResponseCacheUpsertBulk) AddOutputTokens(v int64) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.AddOutputTokens(v)
+	})
+}
+
+// UpdateOutputTokens sets the "output_tokens" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateOutputTokens() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateOutputTokens()
+	})
+}
+
+// SetHits sets the "hits" field.
+func (u *ResponseCacheUpsertBulk) SetHits(v int64) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetHits(v)
+	})
+}
+
+// AddHits adds v to the "hits" field.
+func (u *ResponseCacheUpsertBulk) AddHits(v int64) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.AddHits(v)
+	})
+}
+
+// UpdateHits sets the "hits" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateHits() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateHits()
+	})
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *ResponseCacheUpsertBulk) SetEmbedding(v pgvector.Vector) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateEmbedding() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (u *ResponseCacheUpsertBulk) ClearEmbedding() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.ClearEmbedding()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *ResponseCacheUpsertBulk) SetExpiresAt(v time.Time) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateExpiresAt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *ResponseCacheUpsertBulk) SetCreatedAt(v time.Time) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateCreatedAt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ResponseCacheUpsertBulk) SetUpdatedAt(v time.Time) *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ResponseCacheUpsertBulk) UpdateUpdatedAt() *ResponseCacheUpsertBulk {
+	return u.Update(func(s *ResponseCacheUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ResponseCacheUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the ResponseCacheCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for ResponseCacheCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ResponseCacheUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
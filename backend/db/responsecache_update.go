@@ -0,0 +1,804 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ResponseCacheUpdate is the builder for updating ResponseCache entities.
+type ResponseCacheUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ResponseCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ResponseCacheUpdate builder.
+func (rcu *ResponseCacheUpdate) Where(ps ...predicate.ResponseCache) *ResponseCacheUpdate {
+	rcu.mutation.Where(ps...)
+	return rcu
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (rcu *ResponseCacheUpdate) SetCacheKey(s string) *ResponseCacheUpdate {
+	rcu.mutation.SetCacheKey(s)
+	return rcu
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableCacheKey(s *string) *ResponseCacheUpdate {
+	if s != nil {
+		rcu.SetCacheKey(*s)
+	}
+	return rcu
+}
+
+// SetContextKey sets the "context_key" field.
+func (rcu *ResponseCacheUpdate) SetContextKey(s string) *ResponseCacheUpdate {
+	rcu.mutation.SetContextKey(s)
+	return rcu
+}
+
+// SetNillableContextKey sets the "context_key" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableContextKey(s *string) *ResponseCacheUpdate {
+	if s != nil {
+		rcu.SetContextKey(*s)
+	}
+	return rcu
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (rcu *ResponseCacheUpdate) ClearContextKey() *ResponseCacheUpdate {
+	rcu.mutation.ClearContextKey()
+	return rcu
+}
+
+// SetModelName sets the "model_name" field.
+func (rcu *ResponseCacheUpdate) SetModelName(s string) *ResponseCacheUpdate {
+	rcu.mutation.SetModelName(s)
+	return rcu
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableModelName(s *string) *ResponseCacheUpdate {
+	if s != nil {
+		rcu.SetModelName(*s)
+	}
+	return rcu
+}
+
+// SetModelType sets the "model_type" field.
+func (rcu *ResponseCacheUpdate) SetModelType(ct consts.ModelType) *ResponseCacheUpdate {
+	rcu.mutation.SetModelType(ct)
+	return rcu
+}
+
+// SetNillableModelType sets the "model_type" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableModelType(ct *consts.ModelType) *ResponseCacheUpdate {
+	if ct != nil {
+		rcu.SetModelType(*ct)
+	}
+	return rcu
+}
+
+// SetPrompt sets the "prompt" field.
+func (rcu *ResponseCacheUpdate) SetPrompt(s string) *ResponseCacheUpdate {
+	rcu.mutation.SetPrompt(s)
+	return rcu
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillablePrompt(s *string) *ResponseCacheUpdate {
+	if s != nil {
+		rcu.SetPrompt(*s)
+	}
+	return rcu
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (rcu *ResponseCacheUpdate) ClearPrompt() *ResponseCacheUpdate {
+	rcu.mutation.ClearPrompt()
+	return rcu
+}
+
+// SetCompletion sets the "completion" field.
+func (rcu *ResponseCacheUpdate) SetCompletion(s string) *ResponseCacheUpdate {
+	rcu.mutation.SetCompletion(s)
+	return rcu
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableCompletion(s *string) *ResponseCacheUpdate {
+	if s != nil {
+		rcu.SetCompletion(*s)
+	}
+	return rcu
+}
+
+// SetFinishReason sets the "finish_reason" field.
+func (rcu *ResponseCacheUpdate) SetFinishReason(s string) *ResponseCacheUpdate {
+	rcu.mutation.SetFinishReason(s)
+	return rcu
+}
+
+// SetNillableFinishReason sets the "finish_reason" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableFinishReason(s *string) *ResponseCacheUpdate {
+	if s != nil {
+		rcu.SetFinishReason(*s)
+	}
+	return rcu
+}
+
+// ClearFinishReason clears the value of the "finish_reason" field.
+func (rcu *ResponseCacheUpdate) ClearFinishReason() *ResponseCacheUpdate {
+	rcu.mutation.ClearFinishReason()
+	return rcu
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (rcu *ResponseCacheUpdate) SetInputTokens(i int64) *ResponseCacheUpdate {
+	rcu.mutation.ResetInputTokens()
+	rcu.mutation.SetInputTokens(i)
+	return rcu
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableInputTokens(i *int64) *ResponseCacheUpdate {
+	if i != nil {
+		rcu.SetInputTokens(*i)
+	}
+	return rcu
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (rcu *ResponseCacheUpdate) AddInputTokens(i int64) *ResponseCacheUpdate {
+	rcu.mutation.AddInputTokens(i)
+	return rcu
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (rcu *ResponseCacheUpdate) SetOutputTokens(i int64) *ResponseCacheUpdate {
+	rcu.mutation.ResetOutputTokens()
+	rcu.mutation.SetOutputTokens(i)
+	return rcu
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableOutputTokens(i *int64) *ResponseCacheUpdate {
+	if i != nil {
+		rcu.SetOutputTokens(*i)
+	}
+	return rcu
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (rcu *ResponseCacheUpdate) AddOutputTokens(i int64) *ResponseCacheUpdate {
+	rcu.mutation.AddOutputTokens(i)
+	return rcu
+}
+
+// SetHits sets the "hits" field.
+func (rcu *ResponseCacheUpdate) SetHits(i int64) *ResponseCacheUpdate {
+	rcu.mutation.ResetHits()
+	rcu.mutation.SetHits(i)
+	return rcu
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableHits(i *int64) *ResponseCacheUpdate {
+	if i != nil {
+		rcu.SetHits(*i)
+	}
+	return rcu
+}
+
+// AddHits adds i to the "hits" field.
+func (rcu *ResponseCacheUpdate) AddHits(i int64) *ResponseCacheUpdate {
+	rcu.mutation.AddHits(i)
+	return rcu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (rcu *ResponseCacheUpdate) SetEmbedding(pg pgvector.Vector) *ResponseCacheUpdate {
+	rcu.mutation.SetEmbedding(pg)
+	return rcu
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableEmbedding(pg *pgvector.Vector) *ResponseCacheUpdate {
+	if pg != nil {
+		rcu.SetEmbedding(*pg)
+	}
+	return rcu
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (rcu *ResponseCacheUpdate) ClearEmbedding() *ResponseCacheUpdate {
+	rcu.mutation.ClearEmbedding()
+	return rcu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcu *ResponseCacheUpdate) SetExpiresAt(t time.Time) *ResponseCacheUpdate {
+	rcu.mutation.SetExpiresAt(t)
+	return rcu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableExpiresAt(t *time.Time) *ResponseCacheUpdate {
+	if t != nil {
+		rcu.SetExpiresAt(*t)
+	}
+	return rcu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcu *ResponseCacheUpdate) SetCreatedAt(t time.Time) *ResponseCacheUpdate {
+	rcu.mutation.SetCreatedAt(t)
+	return rcu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcu *ResponseCacheUpdate) SetNillableCreatedAt(t *time.Time) *ResponseCacheUpdate {
+	if t != nil {
+		rcu.SetCreatedAt(*t)
+	}
+	return rcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcu *ResponseCacheUpdate) SetUpdatedAt(t time.Time) *ResponseCacheUpdate {
+	rcu.mutation.SetUpdatedAt(t)
+	return rcu
+}
+
+// Mutation returns the ResponseCacheMutation object of the builder.
+func (rcu *ResponseCacheUpdate) Mutation() *ResponseCacheMutation {
+	return rcu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rcu *ResponseCacheUpdate) Save(ctx context.Context) (int, error) {
+	rcu.defaults()
+	return withHooks(ctx, rcu.sqlSave, rcu.mutation, rcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcu *ResponseCacheUpdate) SaveX(ctx context.Context) int {
+	affected, err := rcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rcu *ResponseCacheUpdate) Exec(ctx context.Context) error {
+	_, err := rcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcu *ResponseCacheUpdate) ExecX(ctx context.Context) {
+	if err := rcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcu *ResponseCacheUpdate) defaults() {
+	if _, ok := rcu.mutation.UpdatedAt(); !ok {
+		v := responsecache.UpdateDefaultUpdatedAt()
+		rcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcu *ResponseCacheUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResponseCacheUpdate {
+	rcu.modifiers = append(rcu.modifiers, modifiers...)
+	return rcu
+}
+
+func (rcu *ResponseCacheUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(responsecache.Table, responsecache.Columns, sqlgraph.NewFieldSpec(responsecache.FieldID, field.TypeUUID))
+	if ps := rcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcu.mutation.CacheKey(); ok {
+		_spec.SetField(responsecache.FieldCacheKey, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.ContextKey(); ok {
+		_spec.SetField(responsecache.FieldContextKey, field.TypeString, value)
+	}
+	if rcu.mutation.ContextKeyCleared() {
+		_spec.ClearField(responsecache.FieldContextKey, field.TypeString)
+	}
+	if value, ok := rcu.mutation.ModelName(); ok {
+		_spec.SetField(responsecache.FieldModelName, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.ModelType(); ok {
+		_spec.SetField(responsecache.FieldModelType, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.Prompt(); ok {
+		_spec.SetField(responsecache.FieldPrompt, field.TypeString, value)
+	}
+	if rcu.mutation.PromptCleared() {
+		_spec.ClearField(responsecache.FieldPrompt, field.TypeString)
+	}
+	if value, ok := rcu.mutation.Completion(); ok {
+		_spec.SetField(responsecache.FieldCompletion, field.TypeString, value)
+	}
+	if value, ok := rcu.mutation.FinishReason(); ok {
+		_spec.SetField(responsecache.FieldFinishReason, field.TypeString, value)
+	}
+	if rcu.mutation.FinishReasonCleared() {
+		_spec.ClearField(responsecache.FieldFinishReason, field.TypeString)
+	}
+	if value, ok := rcu.mutation.InputTokens(); ok {
+		_spec.SetField(responsecache.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcu.mutation.AddedInputTokens(); ok {
+		_spec.AddField(responsecache.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcu.mutation.OutputTokens(); ok {
+		_spec.SetField(responsecache.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcu.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(responsecache.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcu.mutation.Hits(); ok {
+		_spec.SetField(responsecache.FieldHits, field.TypeInt64, value)
+	}
+	if value, ok := rcu.mutation.AddedHits(); ok {
+		_spec.AddField(responsecache.FieldHits, field.TypeInt64, value)
+	}
+	if value, ok := rcu.mutation.Embedding(); ok {
+		_spec.SetField(responsecache.FieldEmbedding, field.TypeOther, value)
+	}
+	if rcu.mutation.EmbeddingCleared() {
+		_spec.ClearField(responsecache.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := rcu.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rcu.mutation.CreatedAt(); ok {
+		_spec.SetField(responsecache.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(responsecache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(rcu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, rcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rcu.mutation.done = true
+	return n, nil
+}
+
+// ResponseCacheUpdateOne is the builder for updating a single ResponseCache entity.
+type ResponseCacheUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ResponseCacheMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetCacheKey sets the "cache_key" field.
+func (rcuo *ResponseCacheUpdateOne) SetCacheKey(s string) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetCacheKey(s)
+	return rcuo
+}
+
+// SetNillableCacheKey sets the "cache_key" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableCacheKey(s *string) *ResponseCacheUpdateOne {
+	if s != nil {
+		rcuo.SetCacheKey(*s)
+	}
+	return rcuo
+}
+
+// SetContextKey sets the "context_key" field.
+func (rcuo *ResponseCacheUpdateOne) SetContextKey(s string) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetContextKey(s)
+	return rcuo
+}
+
+// SetNillableContextKey sets the "context_key" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableContextKey(s *string) *ResponseCacheUpdateOne {
+	if s != nil {
+		rcuo.SetContextKey(*s)
+	}
+	return rcuo
+}
+
+// ClearContextKey clears the value of the "context_key" field.
+func (rcuo *ResponseCacheUpdateOne) ClearContextKey() *ResponseCacheUpdateOne {
+	rcuo.mutation.ClearContextKey()
+	return rcuo
+}
+
+// SetModelName sets the "model_name" field.
+func (rcuo *ResponseCacheUpdateOne) SetModelName(s string) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetModelName(s)
+	return rcuo
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableModelName(s *string) *ResponseCacheUpdateOne {
+	if s != nil {
+		rcuo.SetModelName(*s)
+	}
+	return rcuo
+}
+
+// SetModelType sets the "model_type" field.
+func (rcuo *ResponseCacheUpdateOne) SetModelType(ct consts.ModelType) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetModelType(ct)
+	return rcuo
+}
+
+// SetNillableModelType sets the "model_type" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableModelType(ct *consts.ModelType) *ResponseCacheUpdateOne {
+	if ct != nil {
+		rcuo.SetModelType(*ct)
+	}
+	return rcuo
+}
+
+// SetPrompt sets the "prompt" field.
+func (rcuo *ResponseCacheUpdateOne) SetPrompt(s string) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetPrompt(s)
+	return rcuo
+}
+
+// SetNillablePrompt sets the "prompt" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillablePrompt(s *string) *ResponseCacheUpdateOne {
+	if s != nil {
+		rcuo.SetPrompt(*s)
+	}
+	return rcuo
+}
+
+// ClearPrompt clears the value of the "prompt" field.
+func (rcuo *ResponseCacheUpdateOne) ClearPrompt() *ResponseCacheUpdateOne {
+	rcuo.mutation.ClearPrompt()
+	return rcuo
+}
+
+// SetCompletion sets the "completion" field.
+func (rcuo *ResponseCacheUpdateOne) SetCompletion(s string) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetCompletion(s)
+	return rcuo
+}
+
+// SetNillableCompletion sets the "completion" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableCompletion(s *string) *ResponseCacheUpdateOne {
+	if s != nil {
+		rcuo.SetCompletion(*s)
+	}
+	return rcuo
+}
+
+// SetFinishReason sets the "finish_reason" field.
+func (rcuo *ResponseCacheUpdateOne) SetFinishReason(s string) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetFinishReason(s)
+	return rcuo
+}
+
+// SetNillableFinishReason sets the "finish_reason" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableFinishReason(s *string) *ResponseCacheUpdateOne {
+	if s != nil {
+		rcuo.SetFinishReason(*s)
+	}
+	return rcuo
+}
+
+// ClearFinishReason clears the value of the "finish_reason" field.
+func (rcuo *ResponseCacheUpdateOne) ClearFinishReason() *ResponseCacheUpdateOne {
+	rcuo.mutation.ClearFinishReason()
+	return rcuo
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (rcuo *ResponseCacheUpdateOne) SetInputTokens(i int64) *ResponseCacheUpdateOne {
+	rcuo.mutation.ResetInputTokens()
+	rcuo.mutation.SetInputTokens(i)
+	return rcuo
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableInputTokens(i *int64) *ResponseCacheUpdateOne {
+	if i != nil {
+		rcuo.SetInputTokens(*i)
+	}
+	return rcuo
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (rcuo *ResponseCacheUpdateOne) AddInputTokens(i int64) *ResponseCacheUpdateOne {
+	rcuo.mutation.AddInputTokens(i)
+	return rcuo
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (rcuo *ResponseCacheUpdateOne) SetOutputTokens(i int64) *ResponseCacheUpdateOne {
+	rcuo.mutation.ResetOutputTokens()
+	rcuo.mutation.SetOutputTokens(i)
+	return rcuo
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableOutputTokens(i *int64) *ResponseCacheUpdateOne {
+	if i != nil {
+		rcuo.SetOutputTokens(*i)
+	}
+	return rcuo
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (rcuo *ResponseCacheUpdateOne) AddOutputTokens(i int64) *ResponseCacheUpdateOne {
+	rcuo.mutation.AddOutputTokens(i)
+	return rcuo
+}
+
+// SetHits sets the "hits" field.
+func (rcuo *ResponseCacheUpdateOne) SetHits(i int64) *ResponseCacheUpdateOne {
+	rcuo.mutation.ResetHits()
+	rcuo.mutation.SetHits(i)
+	return rcuo
+}
+
+// SetNillableHits sets the "hits" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableHits(i *int64) *ResponseCacheUpdateOne {
+	if i != nil {
+		rcuo.SetHits(*i)
+	}
+	return rcuo
+}
+
+// AddHits adds i to the "hits" field.
+func (rcuo *ResponseCacheUpdateOne) AddHits(i int64) *ResponseCacheUpdateOne {
+	rcuo.mutation.AddHits(i)
+	return rcuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (rcuo *ResponseCacheUpdateOne) SetEmbedding(pg pgvector.Vector) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetEmbedding(pg)
+	return rcuo
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableEmbedding(pg *pgvector.Vector) *ResponseCacheUpdateOne {
+	if pg != nil {
+		rcuo.SetEmbedding(*pg)
+	}
+	return rcuo
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (rcuo *ResponseCacheUpdateOne) ClearEmbedding() *ResponseCacheUpdateOne {
+	rcuo.mutation.ClearEmbedding()
+	return rcuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (rcuo *ResponseCacheUpdateOne) SetExpiresAt(t time.Time) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetExpiresAt(t)
+	return rcuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableExpiresAt(t *time.Time) *ResponseCacheUpdateOne {
+	if t != nil {
+		rcuo.SetExpiresAt(*t)
+	}
+	return rcuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rcuo *ResponseCacheUpdateOne) SetCreatedAt(t time.Time) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetCreatedAt(t)
+	return rcuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rcuo *ResponseCacheUpdateOne) SetNillableCreatedAt(t *time.Time) *ResponseCacheUpdateOne {
+	if t != nil {
+		rcuo.SetCreatedAt(*t)
+	}
+	return rcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rcuo *ResponseCacheUpdateOne) SetUpdatedAt(t time.Time) *ResponseCacheUpdateOne {
+	rcuo.mutation.SetUpdatedAt(t)
+	return rcuo
+}
+
+// Mutation returns the ResponseCacheMutation object of the builder.
+func (rcuo *ResponseCacheUpdateOne) Mutation() *ResponseCacheMutation {
+	return rcuo.mutation
+}
+
+// Where appends a list predicates to the ResponseCacheUpdate builder.
+func (rcuo *ResponseCacheUpdateOne) Where(ps ...predicate.ResponseCache) *ResponseCacheUpdateOne {
+	rcuo.mutation.Where(ps...)
+	return rcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rcuo *ResponseCacheUpdateOne) Select(field string, fields ...string) *ResponseCacheUpdateOne {
+	rcuo.fields = append([]string{field}, fields...)
+	return rcuo
+}
+
+// Save executes the query and returns the updated ResponseCache entity.
+func (rcuo *ResponseCacheUpdateOne) Save(ctx context.Context) (*ResponseCache, error) {
+	rcuo.defaults()
+	return withHooks(ctx, rcuo.sqlSave, rcuo.mutation, rcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcuo *ResponseCacheUpdateOne) SaveX(ctx context.Context) *ResponseCache {
+	node, err := rcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rcuo *ResponseCacheUpdateOne) Exec(ctx context.Context) error {
+	_, err := rcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcuo *ResponseCacheUpdateOne) ExecX(ctx context.Context) {
+	if err := rcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rcuo *ResponseCacheUpdateOne) defaults() {
+	if _, ok := rcuo.mutation.UpdatedAt(); !ok {
+		v := responsecache.UpdateDefaultUpdatedAt()
+		rcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (rcuo *ResponseCacheUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ResponseCacheUpdateOne {
+	rcuo.modifiers = append(rcuo.modifiers, modifiers...)
+	return rcuo
+}
+
+func (rcuo *ResponseCacheUpdateOne) sqlSave(ctx context.Context) (_node *ResponseCache, err error) {
+	_spec := sqlgraph.NewUpdateSpec(responsecache.Table, responsecache.Columns, sqlgraph.NewFieldSpec(responsecache.FieldID, field.TypeUUID))
+	id, ok := rcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "ResponseCache.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, responsecache.FieldID)
+		for _, f := range fields {
+			if !responsecache.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != responsecache.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rcuo.mutation.CacheKey(); ok {
+		_spec.SetField(responsecache.FieldCacheKey, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.ContextKey(); ok {
+		_spec.SetField(responsecache.FieldContextKey, field.TypeString, value)
+	}
+	if rcuo.mutation.ContextKeyCleared() {
+		_spec.ClearField(responsecache.FieldContextKey, field.TypeString)
+	}
+	if value, ok := rcuo.mutation.ModelName(); ok {
+		_spec.SetField(responsecache.FieldModelName, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.ModelType(); ok {
+		_spec.SetField(responsecache.FieldModelType, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.Prompt(); ok {
+		_spec.SetField(responsecache.FieldPrompt, field.TypeString, value)
+	}
+	if rcuo.mutation.PromptCleared() {
+		_spec.ClearField(responsecache.FieldPrompt, field.TypeString)
+	}
+	if value, ok := rcuo.mutation.Completion(); ok {
+		_spec.SetField(responsecache.FieldCompletion, field.TypeString, value)
+	}
+	if value, ok := rcuo.mutation.FinishReason(); ok {
+		_spec.SetField(responsecache.FieldFinishReason, field.TypeString, value)
+	}
+	if rcuo.mutation.FinishReasonCleared() {
+		_spec.ClearField(responsecache.FieldFinishReason, field.TypeString)
+	}
+	if value, ok := rcuo.mutation.InputTokens(); ok {
+		_spec.SetField(responsecache.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcuo.mutation.AddedInputTokens(); ok {
+		_spec.AddField(responsecache.FieldInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcuo.mutation.OutputTokens(); ok {
+		_spec.SetField(responsecache.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcuo.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(responsecache.FieldOutputTokens, field.TypeInt64, value)
+	}
+	if value, ok := rcuo.mutation.Hits(); ok {
+		_spec.SetField(responsecache.FieldHits, field.TypeInt64, value)
+	}
+	if value, ok := rcuo.mutation.AddedHits(); ok {
+		_spec.AddField(responsecache.FieldHits, field.TypeInt64, value)
+	}
+	if value, ok := rcuo.mutation.Embedding(); ok {
+		_spec.SetField(responsecache.FieldEmbedding, field.TypeOther, value)
+	}
+	if rcuo.mutation.EmbeddingCleared() {
+		_spec.ClearField(responsecache.FieldEmbedding, field.TypeOther)
+	}
+	if value, ok := rcuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(responsecache.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := rcuo.mutation.CreatedAt(); ok {
+		_spec.SetField(responsecache.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(responsecache.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(rcuo.modifiers...)
+	_node = &ResponseCache{config: rcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{responsecache.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rcuo.mutation.done = true
+	return _node, nil
+}
@@ -9,22 +9,34 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/admin"
 	"github.com/chaitin/MonkeyCode/backend/db/adminloginhistory"
 	"github.com/chaitin/MonkeyCode/backend/db/apikey"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
 	"github.com/chaitin/MonkeyCode/backend/db/billingplan"
 	"github.com/chaitin/MonkeyCode/backend/db/billingquota"
 	"github.com/chaitin/MonkeyCode/backend/db/billingrecord"
 	"github.com/chaitin/MonkeyCode/backend/db/billingusage"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
 	"github.com/chaitin/MonkeyCode/backend/db/extension"
 	"github.com/chaitin/MonkeyCode/backend/db/invitecode"
 	"github.com/chaitin/MonkeyCode/backend/db/license"
 	"github.com/chaitin/MonkeyCode/backend/db/model"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovider"
 	"github.com/chaitin/MonkeyCode/backend/db/modelprovidermodel"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
 	"github.com/chaitin/MonkeyCode/backend/db/role"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
 	"github.com/chaitin/MonkeyCode/backend/db/setting"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/db/useridentity"
@@ -77,14 +89,36 @@ func init() {
 	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
 	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescRawSize is the schema descriptor for raw_size field.
+	auditlogDescRawSize := auditlogFields[12].Descriptor()
+	// auditlog.DefaultRawSize holds the default value on creation for the raw_size field.
+	auditlog.DefaultRawSize = auditlogDescRawSize.Default.(int64)
+	// auditlogDescSize is the schema descriptor for size field.
+	auditlogDescSize := auditlogFields[13].Descriptor()
+	// auditlog.DefaultSize holds the default value on creation for the size field.
+	auditlog.DefaultSize = auditlogDescSize.Default.(int64)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[14].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
 	billingplanFields := schema.BillingPlan{}.Fields()
 	_ = billingplanFields
+	// billingplanDescQuota is the schema descriptor for quota field.
+	billingplanDescQuota := billingplanFields[4].Descriptor()
+	// billingplan.DefaultQuota holds the default value on creation for the quota field.
+	billingplan.DefaultQuota = billingplanDescQuota.Default.(int64)
 	// billingplanDescCreatedAt is the schema descriptor for created_at field.
-	billingplanDescCreatedAt := billingplanFields[4].Descriptor()
+	billingplanDescCreatedAt := billingplanFields[5].Descriptor()
 	// billingplan.DefaultCreatedAt holds the default value on creation for the created_at field.
 	billingplan.DefaultCreatedAt = billingplanDescCreatedAt.Default.(func() time.Time)
 	// billingplanDescUpdatedAt is the schema descriptor for updated_at field.
-	billingplanDescUpdatedAt := billingplanFields[5].Descriptor()
+	billingplanDescUpdatedAt := billingplanFields[6].Descriptor()
 	// billingplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	billingplan.DefaultUpdatedAt = billingplanDescUpdatedAt.Default.(func() time.Time)
 	// billingplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -97,11 +131,11 @@ func init() {
 	billingquotaFields := schema.BillingQuota{}.Fields()
 	_ = billingquotaFields
 	// billingquotaDescCreatedAt is the schema descriptor for created_at field.
-	billingquotaDescCreatedAt := billingquotaFields[5].Descriptor()
+	billingquotaDescCreatedAt := billingquotaFields[6].Descriptor()
 	// billingquota.DefaultCreatedAt holds the default value on creation for the created_at field.
 	billingquota.DefaultCreatedAt = billingquotaDescCreatedAt.Default.(func() time.Time)
 	// billingquotaDescUpdatedAt is the schema descriptor for updated_at field.
-	billingquotaDescUpdatedAt := billingquotaFields[6].Descriptor()
+	billingquotaDescUpdatedAt := billingquotaFields[7].Descriptor()
 	// billingquota.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	billingquota.DefaultUpdatedAt = billingquotaDescUpdatedAt.Default.(func() time.Time)
 	// billingquota.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -109,15 +143,15 @@ func init() {
 	billingrecordFields := schema.BillingRecord{}.Fields()
 	_ = billingrecordFields
 	// billingrecordDescRequestTime is the schema descriptor for request_time field.
-	billingrecordDescRequestTime := billingrecordFields[8].Descriptor()
+	billingrecordDescRequestTime := billingrecordFields[9].Descriptor()
 	// billingrecord.DefaultRequestTime holds the default value on creation for the request_time field.
 	billingrecord.DefaultRequestTime = billingrecordDescRequestTime.Default.(func() time.Time)
 	// billingrecordDescCreatedAt is the schema descriptor for created_at field.
-	billingrecordDescCreatedAt := billingrecordFields[10].Descriptor()
+	billingrecordDescCreatedAt := billingrecordFields[11].Descriptor()
 	// billingrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
 	billingrecord.DefaultCreatedAt = billingrecordDescCreatedAt.Default.(func() time.Time)
 	// billingrecordDescUpdatedAt is the schema descriptor for updated_at field.
-	billingrecordDescUpdatedAt := billingrecordFields[11].Descriptor()
+	billingrecordDescUpdatedAt := billingrecordFields[12].Descriptor()
 	// billingrecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	billingrecord.DefaultUpdatedAt = billingrecordDescUpdatedAt.Default.(func() time.Time)
 	// billingrecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -130,15 +164,39 @@ func init() {
 	billingusageFields := schema.BillingUsage{}.Fields()
 	_ = billingusageFields
 	// billingusageDescCreatedAt is the schema descriptor for created_at field.
-	billingusageDescCreatedAt := billingusageFields[5].Descriptor()
+	billingusageDescCreatedAt := billingusageFields[6].Descriptor()
 	// billingusage.DefaultCreatedAt holds the default value on creation for the created_at field.
 	billingusage.DefaultCreatedAt = billingusageDescCreatedAt.Default.(func() time.Time)
 	// billingusageDescUpdatedAt is the schema descriptor for updated_at field.
-	billingusageDescUpdatedAt := billingusageFields[6].Descriptor()
+	billingusageDescUpdatedAt := billingusageFields[7].Descriptor()
 	// billingusage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	billingusage.DefaultUpdatedAt = billingusageDescUpdatedAt.Default.(func() time.Time)
 	// billingusage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	billingusage.UpdateDefaultUpdatedAt = billingusageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	budgetalertFields := schema.BudgetAlert{}.Fields()
+	_ = budgetalertFields
+	// budgetalertDescCreatedAt is the schema descriptor for created_at field.
+	budgetalertDescCreatedAt := budgetalertFields[8].Descriptor()
+	// budgetalert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budgetalert.DefaultCreatedAt = budgetalertDescCreatedAt.Default.(func() time.Time)
+	// budgetalertDescID is the schema descriptor for id field.
+	budgetalertDescID := budgetalertFields[0].Descriptor()
+	// budgetalert.DefaultID holds the default value on creation for the id field.
+	budgetalert.DefaultID = budgetalertDescID.Default.(func() uuid.UUID)
+	dlphitFields := schema.DLPHit{}.Fields()
+	_ = dlphitFields
+	// dlphitDescCount is the schema descriptor for count field.
+	dlphitDescCount := dlphitFields[9].Descriptor()
+	// dlphit.DefaultCount holds the default value on creation for the count field.
+	dlphit.DefaultCount = dlphitDescCount.Default.(int)
+	// dlphitDescCreatedAt is the schema descriptor for created_at field.
+	dlphitDescCreatedAt := dlphitFields[10].Descriptor()
+	// dlphit.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dlphit.DefaultCreatedAt = dlphitDescCreatedAt.Default.(func() time.Time)
+	// dlphitDescID is the schema descriptor for id field.
+	dlphitDescID := dlphitFields[0].Descriptor()
+	// dlphit.DefaultID holds the default value on creation for the id field.
+	dlphit.DefaultID = dlphitDescID.Default.(func() uuid.UUID)
 	extensionFields := schema.Extension{}.Fields()
 	_ = extensionFields
 	// extensionDescCreatedAt is the schema descriptor for created_at field.
@@ -174,23 +232,41 @@ func init() {
 	modelFields := schema.Model{}.Fields()
 	_ = modelFields
 	// modelDescIsInternal is the schema descriptor for is_internal field.
-	modelDescIsInternal := modelFields[10].Descriptor()
+	modelDescIsInternal := modelFields[11].Descriptor()
 	// model.DefaultIsInternal holds the default value on creation for the is_internal field.
 	model.DefaultIsInternal = modelDescIsInternal.Default.(bool)
 	// modelDescStatus is the schema descriptor for status field.
-	modelDescStatus := modelFields[12].Descriptor()
+	modelDescStatus := modelFields[13].Descriptor()
 	// model.DefaultStatus holds the default value on creation for the status field.
 	model.DefaultStatus = consts.ModelStatus(modelDescStatus.Default.(string))
+	// modelDescWeight is the schema descriptor for weight field.
+	modelDescWeight := modelFields[16].Descriptor()
+	// model.DefaultWeight holds the default value on creation for the weight field.
+	model.DefaultWeight = modelDescWeight.Default.(int)
 	// modelDescCreatedAt is the schema descriptor for created_at field.
-	modelDescCreatedAt := modelFields[15].Descriptor()
+	modelDescCreatedAt := modelFields[18].Descriptor()
 	// model.DefaultCreatedAt holds the default value on creation for the created_at field.
 	model.DefaultCreatedAt = modelDescCreatedAt.Default.(func() time.Time)
 	// modelDescUpdatedAt is the schema descriptor for updated_at field.
-	modelDescUpdatedAt := modelFields[16].Descriptor()
+	modelDescUpdatedAt := modelFields[19].Descriptor()
 	// model.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	model.DefaultUpdatedAt = modelDescUpdatedAt.Default.(func() time.Time)
 	// model.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	model.UpdateDefaultUpdatedAt = modelDescUpdatedAt.UpdateDefault.(func() time.Time)
+	modelhealthcheckFields := schema.ModelHealthCheck{}.Fields()
+	_ = modelhealthcheckFields
+	// modelhealthcheckDescLatency is the schema descriptor for latency field.
+	modelhealthcheckDescLatency := modelhealthcheckFields[3].Descriptor()
+	// modelhealthcheck.DefaultLatency holds the default value on creation for the latency field.
+	modelhealthcheck.DefaultLatency = modelhealthcheckDescLatency.Default.(int64)
+	// modelhealthcheckDescCreatedAt is the schema descriptor for created_at field.
+	modelhealthcheckDescCreatedAt := modelhealthcheckFields[5].Descriptor()
+	// modelhealthcheck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	modelhealthcheck.DefaultCreatedAt = modelhealthcheckDescCreatedAt.Default.(func() time.Time)
+	// modelhealthcheckDescID is the schema descriptor for id field.
+	modelhealthcheckDescID := modelhealthcheckFields[0].Descriptor()
+	// modelhealthcheck.DefaultID holds the default value on creation for the id field.
+	modelhealthcheck.DefaultID = modelhealthcheckDescID.Default.(func() uuid.UUID)
 	modelproviderFields := schema.ModelProvider{}.Fields()
 	_ = modelproviderFields
 	// modelproviderDescName is the schema descriptor for name field.
@@ -239,28 +315,142 @@ func init() {
 	modelprovidermodelDescID := modelprovidermodelFields[0].Descriptor()
 	// modelprovidermodel.DefaultID holds the default value on creation for the id field.
 	modelprovidermodel.DefaultID = modelprovidermodelDescID.Default.(func() uuid.UUID)
+	responsecacheFields := schema.ResponseCache{}.Fields()
+	_ = responsecacheFields
+	// responsecacheDescInputTokens is the schema descriptor for input_tokens field.
+	responsecacheDescInputTokens := responsecacheFields[8].Descriptor()
+	// responsecache.DefaultInputTokens holds the default value on creation for the input_tokens field.
+	responsecache.DefaultInputTokens = responsecacheDescInputTokens.Default.(int64)
+	// responsecacheDescOutputTokens is the schema descriptor for output_tokens field.
+	responsecacheDescOutputTokens := responsecacheFields[9].Descriptor()
+	// responsecache.DefaultOutputTokens holds the default value on creation for the output_tokens field.
+	responsecache.DefaultOutputTokens = responsecacheDescOutputTokens.Default.(int64)
+	// responsecacheDescHits is the schema descriptor for hits field.
+	responsecacheDescHits := responsecacheFields[10].Descriptor()
+	// responsecache.DefaultHits holds the default value on creation for the hits field.
+	responsecache.DefaultHits = responsecacheDescHits.Default.(int64)
+	// responsecacheDescCreatedAt is the schema descriptor for created_at field.
+	responsecacheDescCreatedAt := responsecacheFields[13].Descriptor()
+	// responsecache.DefaultCreatedAt holds the default value on creation for the created_at field.
+	responsecache.DefaultCreatedAt = responsecacheDescCreatedAt.Default.(func() time.Time)
+	// responsecacheDescUpdatedAt is the schema descriptor for updated_at field.
+	responsecacheDescUpdatedAt := responsecacheFields[14].Descriptor()
+	// responsecache.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	responsecache.DefaultUpdatedAt = responsecacheDescUpdatedAt.Default.(func() time.Time)
+	// responsecache.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	responsecache.UpdateDefaultUpdatedAt = responsecacheDescUpdatedAt.UpdateDefault.(func() time.Time)
 	roleFields := schema.Role{}.Fields()
 	_ = roleFields
 	// roleDescCreatedAt is the schema descriptor for created_at field.
 	roleDescCreatedAt := roleFields[3].Descriptor()
 	// role.DefaultCreatedAt holds the default value on creation for the created_at field.
 	role.DefaultCreatedAt = roleDescCreatedAt.Default.(func() time.Time)
+	securityrulepackFields := schema.SecurityRulePack{}.Fields()
+	_ = securityrulepackFields
+	// securityrulepackDescEnabled is the schema descriptor for enabled field.
+	securityrulepackDescEnabled := securityrulepackFields[3].Descriptor()
+	// securityrulepack.DefaultEnabled holds the default value on creation for the enabled field.
+	securityrulepack.DefaultEnabled = securityrulepackDescEnabled.Default.(bool)
+	// securityrulepackDescVersion is the schema descriptor for version field.
+	securityrulepackDescVersion := securityrulepackFields[4].Descriptor()
+	// securityrulepack.DefaultVersion holds the default value on creation for the version field.
+	securityrulepack.DefaultVersion = securityrulepackDescVersion.Default.(int)
+	// securityrulepackDescLatestVersion is the schema descriptor for latest_version field.
+	securityrulepackDescLatestVersion := securityrulepackFields[5].Descriptor()
+	// securityrulepack.DefaultLatestVersion holds the default value on creation for the latest_version field.
+	securityrulepack.DefaultLatestVersion = securityrulepackDescLatestVersion.Default.(int)
+	// securityrulepackDescCreatedAt is the schema descriptor for created_at field.
+	securityrulepackDescCreatedAt := securityrulepackFields[7].Descriptor()
+	// securityrulepack.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityrulepack.DefaultCreatedAt = securityrulepackDescCreatedAt.Default.(func() time.Time)
+	// securityrulepackDescUpdatedAt is the schema descriptor for updated_at field.
+	securityrulepackDescUpdatedAt := securityrulepackFields[8].Descriptor()
+	// securityrulepack.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	securityrulepack.DefaultUpdatedAt = securityrulepackDescUpdatedAt.Default.(func() time.Time)
+	// securityrulepack.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	securityrulepack.UpdateDefaultUpdatedAt = securityrulepackDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// securityrulepackDescID is the schema descriptor for id field.
+	securityrulepackDescID := securityrulepackFields[0].Descriptor()
+	// securityrulepack.DefaultID holds the default value on creation for the id field.
+	securityrulepack.DefaultID = securityrulepackDescID.Default.(func() uuid.UUID)
+	securityrulepackversionFields := schema.SecurityRulePackVersion{}.Fields()
+	_ = securityrulepackversionFields
+	// securityrulepackversionDescCreatedAt is the schema descriptor for created_at field.
+	securityrulepackversionDescCreatedAt := securityrulepackversionFields[7].Descriptor()
+	// securityrulepackversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityrulepackversion.DefaultCreatedAt = securityrulepackversionDescCreatedAt.Default.(func() time.Time)
+	// securityrulepackversionDescID is the schema descriptor for id field.
+	securityrulepackversionDescID := securityrulepackversionFields[0].Descriptor()
+	// securityrulepackversion.DefaultID holds the default value on creation for the id field.
+	securityrulepackversion.DefaultID = securityrulepackversionDescID.Default.(func() uuid.UUID)
 	securityscanningFields := schema.SecurityScanning{}.Fields()
 	_ = securityscanningFields
+	// securityscanningDescScannedFiles is the schema descriptor for scanned_files field.
+	securityscanningDescScannedFiles := securityscanningFields[12].Descriptor()
+	// securityscanning.DefaultScannedFiles holds the default value on creation for the scanned_files field.
+	securityscanning.DefaultScannedFiles = securityscanningDescScannedFiles.Default.(int)
 	// securityscanningDescCreatedAt is the schema descriptor for created_at field.
-	securityscanningDescCreatedAt := securityscanningFields[8].Descriptor()
+	securityscanningDescCreatedAt := securityscanningFields[14].Descriptor()
 	// securityscanning.DefaultCreatedAt holds the default value on creation for the created_at field.
 	securityscanning.DefaultCreatedAt = securityscanningDescCreatedAt.Default.(func() time.Time)
 	// securityscanningDescUpdatedAt is the schema descriptor for updated_at field.
-	securityscanningDescUpdatedAt := securityscanningFields[9].Descriptor()
+	securityscanningDescUpdatedAt := securityscanningFields[15].Descriptor()
 	// securityscanning.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	securityscanning.DefaultUpdatedAt = securityscanningDescUpdatedAt.Default.(func() time.Time)
+	securityscanningcommentFields := schema.SecurityScanningComment{}.Fields()
+	_ = securityscanningcommentFields
+	// securityscanningcommentDescCreatedAt is the schema descriptor for created_at field.
+	securityscanningcommentDescCreatedAt := securityscanningcommentFields[6].Descriptor()
+	// securityscanningcomment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityscanningcomment.DefaultCreatedAt = securityscanningcommentDescCreatedAt.Default.(func() time.Time)
+	// securityscanningcommentDescID is the schema descriptor for id field.
+	securityscanningcommentDescID := securityscanningcommentFields[0].Descriptor()
+	// securityscanningcomment.DefaultID holds the default value on creation for the id field.
+	securityscanningcomment.DefaultID = securityscanningcommentDescID.Default.(func() uuid.UUID)
+	securityscanningpolicyFields := schema.SecurityScanningPolicy{}.Fields()
+	_ = securityscanningpolicyFields
+	// securityscanningpolicyDescMinConfidence is the schema descriptor for min_confidence field.
+	securityscanningpolicyDescMinConfidence := securityscanningpolicyFields[5].Descriptor()
+	// securityscanningpolicy.DefaultMinConfidence holds the default value on creation for the min_confidence field.
+	securityscanningpolicy.DefaultMinConfidence = consts.SecurityRuleLevel(securityscanningpolicyDescMinConfidence.Default.(string))
+	// securityscanningpolicyDescMinImpact is the schema descriptor for min_impact field.
+	securityscanningpolicyDescMinImpact := securityscanningpolicyFields[6].Descriptor()
+	// securityscanningpolicy.DefaultMinImpact holds the default value on creation for the min_impact field.
+	securityscanningpolicy.DefaultMinImpact = consts.SecurityRuleLevel(securityscanningpolicyDescMinImpact.Default.(string))
+	// securityscanningpolicyDescCreatedAt is the schema descriptor for created_at field.
+	securityscanningpolicyDescCreatedAt := securityscanningpolicyFields[7].Descriptor()
+	// securityscanningpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityscanningpolicy.DefaultCreatedAt = securityscanningpolicyDescCreatedAt.Default.(func() time.Time)
+	// securityscanningpolicyDescUpdatedAt is the schema descriptor for updated_at field.
+	securityscanningpolicyDescUpdatedAt := securityscanningpolicyFields[8].Descriptor()
+	// securityscanningpolicy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	securityscanningpolicy.DefaultUpdatedAt = securityscanningpolicyDescUpdatedAt.Default.(func() time.Time)
+	// securityscanningpolicy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	securityscanningpolicy.UpdateDefaultUpdatedAt = securityscanningpolicyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// securityscanningpolicyDescID is the schema descriptor for id field.
+	securityscanningpolicyDescID := securityscanningpolicyFields[0].Descriptor()
+	// securityscanningpolicy.DefaultID holds the default value on creation for the id field.
+	securityscanningpolicy.DefaultID = securityscanningpolicyDescID.Default.(func() uuid.UUID)
 	securityscanningresultFields := schema.SecurityScanningResult{}.Fields()
 	_ = securityscanningresultFields
+	// securityscanningresultDescTriageStatus is the schema descriptor for triage_status field.
+	securityscanningresultDescTriageStatus := securityscanningresultFields[22].Descriptor()
+	// securityscanningresult.DefaultTriageStatus holds the default value on creation for the triage_status field.
+	securityscanningresult.DefaultTriageStatus = consts.SecurityScanningTriageStatus(securityscanningresultDescTriageStatus.Default.(string))
 	// securityscanningresultDescCreatedAt is the schema descriptor for created_at field.
-	securityscanningresultDescCreatedAt := securityscanningresultFields[20].Descriptor()
+	securityscanningresultDescCreatedAt := securityscanningresultFields[26].Descriptor()
 	// securityscanningresult.DefaultCreatedAt holds the default value on creation for the created_at field.
 	securityscanningresult.DefaultCreatedAt = securityscanningresultDescCreatedAt.Default.(func() time.Time)
+	securityscanningsuppressionFields := schema.SecurityScanningSuppression{}.Fields()
+	_ = securityscanningsuppressionFields
+	// securityscanningsuppressionDescCreatedAt is the schema descriptor for created_at field.
+	securityscanningsuppressionDescCreatedAt := securityscanningsuppressionFields[9].Descriptor()
+	// securityscanningsuppression.DefaultCreatedAt holds the default value on creation for the created_at field.
+	securityscanningsuppression.DefaultCreatedAt = securityscanningsuppressionDescCreatedAt.Default.(func() time.Time)
+	// securityscanningsuppressionDescID is the schema descriptor for id field.
+	securityscanningsuppressionDescID := securityscanningsuppressionFields[0].Descriptor()
+	// securityscanningsuppression.DefaultID holds the default value on creation for the id field.
+	securityscanningsuppression.DefaultID = securityscanningsuppressionDescID.Default.(func() uuid.UUID)
 	settingFields := schema.Setting{}.Fields()
 	_ = settingFields
 	// settingDescEnableSSO is the schema descriptor for enable_sso field.
@@ -280,11 +470,11 @@ func init() {
 	// setting.DefaultEnableAutoLogin holds the default value on creation for the enable_auto_login field.
 	setting.DefaultEnableAutoLogin = settingDescEnableAutoLogin.Default.(bool)
 	// settingDescCreatedAt is the schema descriptor for created_at field.
-	settingDescCreatedAt := settingFields[8].Descriptor()
+	settingDescCreatedAt := settingFields[9].Descriptor()
 	// setting.DefaultCreatedAt holds the default value on creation for the created_at field.
 	setting.DefaultCreatedAt = settingDescCreatedAt.Default.(func() time.Time)
 	// settingDescUpdatedAt is the schema descriptor for updated_at field.
-	settingDescUpdatedAt := settingFields[9].Descriptor()
+	settingDescUpdatedAt := settingFields[10].Descriptor()
 	// setting.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	setting.DefaultUpdatedAt = settingDescUpdatedAt.Default.(func() time.Time)
 	// setting.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -296,15 +486,23 @@ func init() {
 	// task.DefaultIsAccept holds the default value on creation for the is_accept field.
 	task.DefaultIsAccept = taskDescIsAccept.Default.(bool)
 	// taskDescIsSuggested is the schema descriptor for is_suggested field.
-	taskDescIsSuggested := taskFields[14].Descriptor()
+	taskDescIsSuggested := taskFields[15].Descriptor()
 	// task.DefaultIsSuggested holds the default value on creation for the is_suggested field.
 	task.DefaultIsSuggested = taskDescIsSuggested.Default.(bool)
+	// taskDescCacheHit is the schema descriptor for cache_hit field.
+	taskDescCacheHit := taskFields[19].Descriptor()
+	// task.DefaultCacheHit holds the default value on creation for the cache_hit field.
+	task.DefaultCacheHit = taskDescCacheHit.Default.(bool)
+	// taskDescCost is the schema descriptor for cost field.
+	taskDescCost := taskFields[22].Descriptor()
+	// task.DefaultCost holds the default value on creation for the cost field.
+	task.DefaultCost = taskDescCost.Default.(int64)
 	// taskDescCreatedAt is the schema descriptor for created_at field.
-	taskDescCreatedAt := taskFields[18].Descriptor()
+	taskDescCreatedAt := taskFields[25].Descriptor()
 	// task.DefaultCreatedAt holds the default value on creation for the created_at field.
 	task.DefaultCreatedAt = taskDescCreatedAt.Default.(func() time.Time)
 	// taskDescUpdatedAt is the schema descriptor for updated_at field.
-	taskDescUpdatedAt := taskFields[19].Descriptor()
+	taskDescUpdatedAt := taskFields[26].Descriptor()
 	// task.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	task.DefaultUpdatedAt = taskDescUpdatedAt.Default.(func() time.Time)
 	// task.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -320,15 +518,53 @@ func init() {
 	// taskrecord.DefaultCodeLines holds the default value on creation for the code_lines field.
 	taskrecord.DefaultCodeLines = taskrecordDescCodeLines.Default.(int64)
 	// taskrecordDescCreatedAt is the schema descriptor for created_at field.
-	taskrecordDescCreatedAt := taskrecordFields[8].Descriptor()
+	taskrecordDescCreatedAt := taskrecordFields[10].Descriptor()
 	// taskrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
 	taskrecord.DefaultCreatedAt = taskrecordDescCreatedAt.Default.(func() time.Time)
 	// taskrecordDescUpdatedAt is the schema descriptor for updated_at field.
-	taskrecordDescUpdatedAt := taskrecordFields[9].Descriptor()
+	taskrecordDescUpdatedAt := taskrecordFields[11].Descriptor()
 	// taskrecord.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	taskrecord.DefaultUpdatedAt = taskrecordDescUpdatedAt.Default.(func() time.Time)
 	// taskrecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	taskrecord.UpdateDefaultUpdatedAt = taskrecordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	transformpolicyFields := schema.TransformPolicy{}.Fields()
+	_ = transformpolicyFields
+	// transformpolicyDescCreatedAt is the schema descriptor for created_at field.
+	transformpolicyDescCreatedAt := transformpolicyFields[4].Descriptor()
+	// transformpolicy.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transformpolicy.DefaultCreatedAt = transformpolicyDescCreatedAt.Default.(func() time.Time)
+	// transformpolicyDescID is the schema descriptor for id field.
+	transformpolicyDescID := transformpolicyFields[0].Descriptor()
+	// transformpolicy.DefaultID holds the default value on creation for the id field.
+	transformpolicy.DefaultID = transformpolicyDescID.Default.(func() uuid.UUID)
+	transformruleFields := schema.TransformRule{}.Fields()
+	_ = transformruleFields
+	// transformruleDescName is the schema descriptor for name field.
+	transformruleDescName := transformruleFields[1].Descriptor()
+	// transformrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	transformrule.NameValidator = transformruleDescName.Validators[0].(func(string) error)
+	// transformruleDescPriority is the schema descriptor for priority field.
+	transformruleDescPriority := transformruleFields[4].Descriptor()
+	// transformrule.DefaultPriority holds the default value on creation for the priority field.
+	transformrule.DefaultPriority = transformruleDescPriority.Default.(int)
+	// transformruleDescEnabled is the schema descriptor for enabled field.
+	transformruleDescEnabled := transformruleFields[5].Descriptor()
+	// transformrule.DefaultEnabled holds the default value on creation for the enabled field.
+	transformrule.DefaultEnabled = transformruleDescEnabled.Default.(bool)
+	// transformruleDescCreatedAt is the schema descriptor for created_at field.
+	transformruleDescCreatedAt := transformruleFields[7].Descriptor()
+	// transformrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transformrule.DefaultCreatedAt = transformruleDescCreatedAt.Default.(func() time.Time)
+	// transformruleDescUpdatedAt is the schema descriptor for updated_at field.
+	transformruleDescUpdatedAt := transformruleFields[8].Descriptor()
+	// transformrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transformrule.DefaultUpdatedAt = transformruleDescUpdatedAt.Default.(func() time.Time)
+	// transformrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transformrule.UpdateDefaultUpdatedAt = transformruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// transformruleDescID is the schema descriptor for id field.
+	transformruleDescID := transformruleFields[0].Descriptor()
+	// transformrule.DefaultID holds the default value on creation for the id field.
+	transformrule.DefaultID = transformruleDescID.Default.(func() uuid.UUID)
 	userMixin := schema.User{}.Mixin()
 	userMixinHooks0 := userMixin[0].Hooks()
 	user.Hooks[0] = userMixinHooks0[0]
@@ -359,7 +595,7 @@ func init() {
 	// usergroup.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	usergroup.NameValidator = usergroupDescName.Validators[0].(func(string) error)
 	// usergroupDescCreatedAt is the schema descriptor for created_at field.
-	usergroupDescCreatedAt := usergroupFields[3].Descriptor()
+	usergroupDescCreatedAt := usergroupFields[7].Descriptor()
 	// usergroup.DefaultCreatedAt holds the default value on creation for the created_at field.
 	usergroup.DefaultCreatedAt = usergroupDescCreatedAt.Default.(func() time.Time)
 	useridentityMixin := schema.UserIdentity{}.Mixin()
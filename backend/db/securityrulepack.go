@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePack is the model entity for the SecurityRulePack schema.
+type SecurityRulePack struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// 扫描使用的版本
+	Version int `json:"version,omitempty"`
+	// 最新上传的版本
+	LatestVersion int `json:"latest_version,omitempty"`
+	// Creator holds the value of the "creator" field.
+	Creator string `json:"creator,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SecurityRulePackQuery when eager-loading is set.
+	Edges        SecurityRulePackEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SecurityRulePackEdges holds the relations/edges for other nodes in the graph.
+type SecurityRulePackEdges struct {
+	// Versions holds the value of the versions edge.
+	Versions []*SecurityRulePackVersion `json:"versions,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e SecurityRulePackEdges) VersionsOrErr() ([]*SecurityRulePackVersion, error) {
+	if e.loadedTypes[0] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityRulePack) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityrulepack.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case securityrulepack.FieldVersion, securityrulepack.FieldLatestVersion:
+			values[i] = new(sql.NullInt64)
+		case securityrulepack.FieldName, securityrulepack.FieldDescription, securityrulepack.FieldCreator:
+			values[i] = new(sql.NullString)
+		case securityrulepack.FieldCreatedAt, securityrulepack.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case securityrulepack.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityRulePack fields.
+func (srp *SecurityRulePack) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityrulepack.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				srp.ID = *value
+			}
+		case securityrulepack.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				srp.Name = value.String
+			}
+		case securityrulepack.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				srp.Description = value.String
+			}
+		case securityrulepack.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				srp.Enabled = value.Bool
+			}
+		case securityrulepack.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				srp.Version = int(value.Int64)
+			}
+		case securityrulepack.FieldLatestVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field latest_version", values[i])
+			} else if value.Valid {
+				srp.LatestVersion = int(value.Int64)
+			}
+		case securityrulepack.FieldCreator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field creator", values[i])
+			} else if value.Valid {
+				srp.Creator = value.String
+			}
+		case securityrulepack.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				srp.CreatedAt = value.Time
+			}
+		case securityrulepack.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				srp.UpdatedAt = value.Time
+			}
+		default:
+			srp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityRulePack.
+// This includes values selected through modifiers, order, etc.
+func (srp *SecurityRulePack) Value(name string) (ent.Value, error) {
+	return srp.selectValues.Get(name)
+}
+
+// QueryVersions queries the "versions" edge of the SecurityRulePack entity.
+func (srp *SecurityRulePack) QueryVersions() *SecurityRulePackVersionQuery {
+	return NewSecurityRulePackClient(srp.config).QueryVersions(srp)
+}
+
+// Update returns a builder for updating this SecurityRulePack.
+// Note that you need to call SecurityRulePack.Unwrap() before calling this method if this SecurityRulePack
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (srp *SecurityRulePack) Update() *SecurityRulePackUpdateOne {
+	return NewSecurityRulePackClient(srp.config).UpdateOne(srp)
+}
+
+// Unwrap unwraps the SecurityRulePack entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (srp *SecurityRulePack) Unwrap() *SecurityRulePack {
+	_tx, ok := srp.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SecurityRulePack is not a transactional entity")
+	}
+	srp.config.driver = _tx.drv
+	return srp
+}
+
+// String implements the fmt.Stringer.
+func (srp *SecurityRulePack) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityRulePack(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", srp.ID))
+	builder.WriteString("name=")
+	builder.WriteString(srp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(srp.Description)
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", srp.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", srp.Version))
+	builder.WriteString(", ")
+	builder.WriteString("latest_version=")
+	builder.WriteString(fmt.Sprintf("%v", srp.LatestVersion))
+	builder.WriteString(", ")
+	builder.WriteString("creator=")
+	builder.WriteString(srp.Creator)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(srp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(srp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityRulePacks is a parsable slice of SecurityRulePack.
+type SecurityRulePacks []*SecurityRulePack
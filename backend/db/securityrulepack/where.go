@@ -0,0 +1,520 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityrulepack
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldDescription, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldVersion, v))
+}
+
+// LatestVersion applies equality check predicate on the "latest_version" field. It's identical to LatestVersionEQ.
+func LatestVersion(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldLatestVersion, v))
+}
+
+// Creator applies equality check predicate on the "creator" field. It's identical to CreatorEQ.
+func Creator(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldVersion, v))
+}
+
+// LatestVersionEQ applies the EQ predicate on the "latest_version" field.
+func LatestVersionEQ(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldLatestVersion, v))
+}
+
+// LatestVersionNEQ applies the NEQ predicate on the "latest_version" field.
+func LatestVersionNEQ(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldLatestVersion, v))
+}
+
+// LatestVersionIn applies the In predicate on the "latest_version" field.
+func LatestVersionIn(vs ...int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldLatestVersion, vs...))
+}
+
+// LatestVersionNotIn applies the NotIn predicate on the "latest_version" field.
+func LatestVersionNotIn(vs ...int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldLatestVersion, vs...))
+}
+
+// LatestVersionGT applies the GT predicate on the "latest_version" field.
+func LatestVersionGT(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldLatestVersion, v))
+}
+
+// LatestVersionGTE applies the GTE predicate on the "latest_version" field.
+func LatestVersionGTE(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldLatestVersion, v))
+}
+
+// LatestVersionLT applies the LT predicate on the "latest_version" field.
+func LatestVersionLT(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldLatestVersion, v))
+}
+
+// LatestVersionLTE applies the LTE predicate on the "latest_version" field.
+func LatestVersionLTE(v int) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldLatestVersion, v))
+}
+
+// CreatorEQ applies the EQ predicate on the "creator" field.
+func CreatorEQ(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatorNEQ applies the NEQ predicate on the "creator" field.
+func CreatorNEQ(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldCreator, v))
+}
+
+// CreatorIn applies the In predicate on the "creator" field.
+func CreatorIn(vs ...string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldCreator, vs...))
+}
+
+// CreatorNotIn applies the NotIn predicate on the "creator" field.
+func CreatorNotIn(vs ...string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldCreator, vs...))
+}
+
+// CreatorGT applies the GT predicate on the "creator" field.
+func CreatorGT(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldCreator, v))
+}
+
+// CreatorGTE applies the GTE predicate on the "creator" field.
+func CreatorGTE(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldCreator, v))
+}
+
+// CreatorLT applies the LT predicate on the "creator" field.
+func CreatorLT(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldCreator, v))
+}
+
+// CreatorLTE applies the LTE predicate on the "creator" field.
+func CreatorLTE(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldCreator, v))
+}
+
+// CreatorContains applies the Contains predicate on the "creator" field.
+func CreatorContains(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldContains(FieldCreator, v))
+}
+
+// CreatorHasPrefix applies the HasPrefix predicate on the "creator" field.
+func CreatorHasPrefix(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldHasPrefix(FieldCreator, v))
+}
+
+// CreatorHasSuffix applies the HasSuffix predicate on the "creator" field.
+func CreatorHasSuffix(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldHasSuffix(FieldCreator, v))
+}
+
+// CreatorIsNil applies the IsNil predicate on the "creator" field.
+func CreatorIsNil() predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIsNull(FieldCreator))
+}
+
+// CreatorNotNil applies the NotNil predicate on the "creator" field.
+func CreatorNotNil() predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotNull(FieldCreator))
+}
+
+// CreatorEqualFold applies the EqualFold predicate on the "creator" field.
+func CreatorEqualFold(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEqualFold(FieldCreator, v))
+}
+
+// CreatorContainsFold applies the ContainsFold predicate on the "creator" field.
+func CreatorContainsFold(v string) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldContainsFold(FieldCreator, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasVersions applies the HasEdge predicate on the "versions" edge.
+func HasVersions() predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionsWith applies the HasEdge predicate on the "versions" edge with a given conditions (other predicates).
+func HasVersionsWith(preds ...predicate.SecurityRulePackVersion) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(func(s *sql.Selector) {
+		step := newVersionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SecurityRulePack) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SecurityRulePack) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SecurityRulePack) predicate.SecurityRulePack {
+	return predicate.SecurityRulePack(sql.NotPredicates(p))
+}
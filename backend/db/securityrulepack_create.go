@@ -0,0 +1,1062 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackCreate is the builder for creating a SecurityRulePack entity.
+type SecurityRulePackCreate struct {
+	config
+	mutation *SecurityRulePackMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (srpc *SecurityRulePackCreate) SetName(s string) *SecurityRulePackCreate {
+	srpc.mutation.SetName(s)
+	return srpc
+}
+
+// SetDescription sets the "description" field.
+func (srpc *SecurityRulePackCreate) SetDescription(s string) *SecurityRulePackCreate {
+	srpc.mutation.SetDescription(s)
+	return srpc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableDescription(s *string) *SecurityRulePackCreate {
+	if s != nil {
+		srpc.SetDescription(*s)
+	}
+	return srpc
+}
+
+// SetEnabled sets the "enabled" field.
+func (srpc *SecurityRulePackCreate) SetEnabled(b bool) *SecurityRulePackCreate {
+	srpc.mutation.SetEnabled(b)
+	return srpc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableEnabled(b *bool) *SecurityRulePackCreate {
+	if b != nil {
+		srpc.SetEnabled(*b)
+	}
+	return srpc
+}
+
+// SetVersion sets the "version" field.
+func (srpc *SecurityRulePackCreate) SetVersion(i int) *SecurityRulePackCreate {
+	srpc.mutation.SetVersion(i)
+	return srpc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableVersion(i *int) *SecurityRulePackCreate {
+	if i != nil {
+		srpc.SetVersion(*i)
+	}
+	return srpc
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (srpc *SecurityRulePackCreate) SetLatestVersion(i int) *SecurityRulePackCreate {
+	srpc.mutation.SetLatestVersion(i)
+	return srpc
+}
+
+// SetNillableLatestVersion sets the "latest_version" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableLatestVersion(i *int) *SecurityRulePackCreate {
+	if i != nil {
+		srpc.SetLatestVersion(*i)
+	}
+	return srpc
+}
+
+// SetCreator sets the "creator" field.
+func (srpc *SecurityRulePackCreate) SetCreator(s string) *SecurityRulePackCreate {
+	srpc.mutation.SetCreator(s)
+	return srpc
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableCreator(s *string) *SecurityRulePackCreate {
+	if s != nil {
+		srpc.SetCreator(*s)
+	}
+	return srpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (srpc *SecurityRulePackCreate) SetCreatedAt(t time.Time) *SecurityRulePackCreate {
+	srpc.mutation.SetCreatedAt(t)
+	return srpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableCreatedAt(t *time.Time) *SecurityRulePackCreate {
+	if t != nil {
+		srpc.SetCreatedAt(*t)
+	}
+	return srpc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (srpc *SecurityRulePackCreate) SetUpdatedAt(t time.Time) *SecurityRulePackCreate {
+	srpc.mutation.SetUpdatedAt(t)
+	return srpc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableUpdatedAt(t *time.Time) *SecurityRulePackCreate {
+	if t != nil {
+		srpc.SetUpdatedAt(*t)
+	}
+	return srpc
+}
+
+// SetID sets the "id" field.
+func (srpc *SecurityRulePackCreate) SetID(u uuid.UUID) *SecurityRulePackCreate {
+	srpc.mutation.SetID(u)
+	return srpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (srpc *SecurityRulePackCreate) SetNillableID(u *uuid.UUID) *SecurityRulePackCreate {
+	if u != nil {
+		srpc.SetID(*u)
+	}
+	return srpc
+}
+
+// AddVersionIDs adds the "versions" edge to the SecurityRulePackVersion entity by IDs.
+func (srpc *SecurityRulePackCreate) AddVersionIDs(ids ...uuid.UUID) *SecurityRulePackCreate {
+	srpc.mutation.AddVersionIDs(ids...)
+	return srpc
+}
+
+// AddVersions adds the "versions" edges to the SecurityRulePackVersion entity.
+func (srpc *SecurityRulePackCreate) AddVersions(s ...*SecurityRulePackVersion) *SecurityRulePackCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return srpc.AddVersionIDs(ids...)
+}
+
+// Mutation returns the SecurityRulePackMutation object of the builder.
+func (srpc *SecurityRulePackCreate) Mutation() *SecurityRulePackMutation {
+	return srpc.mutation
+}
+
+// Save creates the SecurityRulePack in the database.
+func (srpc *SecurityRulePackCreate) Save(ctx context.Context) (*SecurityRulePack, error) {
+	srpc.defaults()
+	return withHooks(ctx, srpc.sqlSave, srpc.mutation, srpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (srpc *SecurityRulePackCreate) SaveX(ctx context.Context) *SecurityRulePack {
+	v, err := srpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srpc *SecurityRulePackCreate) Exec(ctx context.Context) error {
+	_, err := srpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpc *SecurityRulePackCreate) ExecX(ctx context.Context) {
+	if err := srpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (srpc *SecurityRulePackCreate) defaults() {
+	if _, ok := srpc.mutation.Enabled(); !ok {
+		v := securityrulepack.DefaultEnabled
+		srpc.mutation.SetEnabled(v)
+	}
+	if _, ok := srpc.mutation.Version(); !ok {
+		v := securityrulepack.DefaultVersion
+		srpc.mutation.SetVersion(v)
+	}
+	if _, ok := srpc.mutation.LatestVersion(); !ok {
+		v := securityrulepack.DefaultLatestVersion
+		srpc.mutation.SetLatestVersion(v)
+	}
+	if _, ok := srpc.mutation.CreatedAt(); !ok {
+		v := securityrulepack.DefaultCreatedAt()
+		srpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := srpc.mutation.UpdatedAt(); !ok {
+		v := securityrulepack.DefaultUpdatedAt()
+		srpc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := srpc.mutation.ID(); !ok {
+		v := securityrulepack.DefaultID()
+		srpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srpc *SecurityRulePackCreate) check() error {
+	if _, ok := srpc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "SecurityRulePack.name"`)}
+	}
+	if _, ok := srpc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`db: missing required field "SecurityRulePack.enabled"`)}
+	}
+	if _, ok := srpc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`db: missing required field "SecurityRulePack.version"`)}
+	}
+	if _, ok := srpc.mutation.LatestVersion(); !ok {
+		return &ValidationError{Name: "latest_version", err: errors.New(`db: missing required field "SecurityRulePack.latest_version"`)}
+	}
+	if _, ok := srpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityRulePack.created_at"`)}
+	}
+	if _, ok := srpc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "SecurityRulePack.updated_at"`)}
+	}
+	return nil
+}
+
+func (srpc *SecurityRulePackCreate) sqlSave(ctx context.Context) (*SecurityRulePack, error) {
+	if err := srpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := srpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, srpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	srpc.mutation.id = &_node.ID
+	srpc.mutation.done = true
+	return _node, nil
+}
+
+func (srpc *SecurityRulePackCreate) createSpec() (*SecurityRulePack, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityRulePack{config: srpc.config}
+		_spec = sqlgraph.NewCreateSpec(securityrulepack.Table, sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = srpc.conflict
+	if id, ok := srpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := srpc.mutation.Name(); ok {
+		_spec.SetField(securityrulepack.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := srpc.mutation.Description(); ok {
+		_spec.SetField(securityrulepack.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := srpc.mutation.Enabled(); ok {
+		_spec.SetField(securityrulepack.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := srpc.mutation.Version(); ok {
+		_spec.SetField(securityrulepack.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := srpc.mutation.LatestVersion(); ok {
+		_spec.SetField(securityrulepack.FieldLatestVersion, field.TypeInt, value)
+		_node.LatestVersion = value
+	}
+	if value, ok := srpc.mutation.Creator(); ok {
+		_spec.SetField(securityrulepack.FieldCreator, field.TypeString, value)
+		_node.Creator = value
+	}
+	if value, ok := srpc.mutation.CreatedAt(); ok {
+		_spec.SetField(securityrulepack.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := srpc.mutation.UpdatedAt(); ok {
+		_spec.SetField(securityrulepack.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := srpc.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityRulePack.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityRulePackUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (srpc *SecurityRulePackCreate) OnConflict(opts ...sql.ConflictOption) *SecurityRulePackUpsertOne {
+	srpc.conflict = opts
+	return &SecurityRulePackUpsertOne{
+		create: srpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityRulePack.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srpc *SecurityRulePackCreate) OnConflictColumns(columns ...string) *SecurityRulePackUpsertOne {
+	srpc.conflict = append(srpc.conflict, sql.ConflictColumns(columns...))
+	return &SecurityRulePackUpsertOne{
+		create: srpc,
+	}
+}
+
+type (
+	// SecurityRulePackUpsertOne is the builder for "upsert"-ing
+	//  one SecurityRulePack node.
+	SecurityRulePackUpsertOne struct {
+		create *SecurityRulePackCreate
+	}
+
+	// SecurityRulePackUpsert is the "OnConflict" setter.
+	SecurityRulePackUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *SecurityRulePackUpsert) SetName(v string) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateName() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SecurityRulePackUpsert) SetDescription(v string) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateDescription() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecurityRulePackUpsert) ClearDescription() *SecurityRulePackUpsert {
+	u.SetNull(securityrulepack.FieldDescription)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SecurityRulePackUpsert) SetEnabled(v bool) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateEnabled() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldEnabled)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *SecurityRulePackUpsert) SetVersion(v int) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateVersion() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SecurityRulePackUpsert) AddVersion(v int) *SecurityRulePackUpsert {
+	u.Add(securityrulepack.FieldVersion, v)
+	return u
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (u *SecurityRulePackUpsert) SetLatestVersion(v int) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldLatestVersion, v)
+	return u
+}
+
+// UpdateLatestVersion sets the "latest_version" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateLatestVersion() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldLatestVersion)
+	return u
+}
+
+// AddLatestVersion adds v to the "latest_version" field.
+func (u *SecurityRulePackUpsert) AddLatestVersion(v int) *SecurityRulePackUpsert {
+	u.Add(securityrulepack.FieldLatestVersion, v)
+	return u
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityRulePackUpsert) SetCreator(v string) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldCreator, v)
+	return u
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateCreator() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldCreator)
+	return u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityRulePackUpsert) ClearCreator() *SecurityRulePackUpsert {
+	u.SetNull(securityrulepack.FieldCreator)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityRulePackUpsert) SetCreatedAt(v time.Time) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateCreatedAt() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecurityRulePackUpsert) SetUpdatedAt(v time.Time) *SecurityRulePackUpsert {
+	u.Set(securityrulepack.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecurityRulePackUpsert) UpdateUpdatedAt() *SecurityRulePackUpsert {
+	u.SetExcluded(securityrulepack.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SecurityRulePack.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityrulepack.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityRulePackUpsertOne) UpdateNewValues() *SecurityRulePackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(securityrulepack.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityRulePack.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecurityRulePackUpsertOne) Ignore() *SecurityRulePackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityRulePackUpsertOne) DoNothing() *SecurityRulePackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityRulePackCreate.OnConflict
+// documentation for more info.
+func (u *SecurityRulePackUpsertOne) Update(set func(*SecurityRulePackUpsert)) *SecurityRulePackUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityRulePackUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SecurityRulePackUpsertOne) SetName(v string) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateName() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecurityRulePackUpsertOne) SetDescription(v string) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateDescription() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecurityRulePackUpsertOne) ClearDescription() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SecurityRulePackUpsertOne) SetEnabled(v bool) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateEnabled() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *SecurityRulePackUpsertOne) SetVersion(v int) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SecurityRulePackUpsertOne) AddVersion(v int) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateVersion() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (u *SecurityRulePackUpsertOne) SetLatestVersion(v int) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetLatestVersion(v)
+	})
+}
+
+// AddLatestVersion adds v to the "latest_version" field.
+func (u *SecurityRulePackUpsertOne) AddLatestVersion(v int) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.AddLatestVersion(v)
+	})
+}
+
+// UpdateLatestVersion sets the "latest_version" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateLatestVersion() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateLatestVersion()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityRulePackUpsertOne) SetCreator(v string) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateCreator() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityRulePackUpsertOne) ClearCreator() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityRulePackUpsertOne) SetCreatedAt(v time.Time) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateCreatedAt() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecurityRulePackUpsertOne) SetUpdatedAt(v time.Time) *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertOne) UpdateUpdatedAt() *SecurityRulePackUpsertOne {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityRulePackUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityRulePackCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityRulePackUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecurityRulePackUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: SecurityRulePackUpsertOne.ID is not supported by MySQL driver. Use SecurityRulePackUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecurityRulePackUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecurityRulePackCreateBulk is the builder for creating many SecurityRulePack entities in bulk.
+type SecurityRulePackCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityRulePackCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SecurityRulePack entities in the database.
+func (srpcb *SecurityRulePackCreateBulk) Save(ctx context.Context) ([]*SecurityRulePack, error) {
+	if srpcb.err != nil {
+		return nil, srpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srpcb.builders))
+	nodes := make([]*SecurityRulePack, len(srpcb.builders))
+	mutators := make([]Mutator, len(srpcb.builders))
+	for i := range srpcb.builders {
+		func(i int, root context.Context) {
+			builder := srpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityRulePackMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = srpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srpcb *SecurityRulePackCreateBulk) SaveX(ctx context.Context) []*SecurityRulePack {
+	v, err := srpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srpcb *SecurityRulePackCreateBulk) Exec(ctx context.Context) error {
+	_, err := srpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpcb *SecurityRulePackCreateBulk) ExecX(ctx context.Context) {
+	if err := srpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityRulePack.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityRulePackUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (srpcb *SecurityRulePackCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecurityRulePackUpsertBulk {
+	srpcb.conflict = opts
+	return &SecurityRulePackUpsertBulk{
+		create: srpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityRulePack.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srpcb *SecurityRulePackCreateBulk) OnConflictColumns(columns ...string) *SecurityRulePackUpsertBulk {
+	srpcb.conflict = append(srpcb.conflict, sql.ConflictColumns(columns...))
+	return &SecurityRulePackUpsertBulk{
+		create: srpcb,
+	}
+}
+
+// SecurityRulePackUpsertBulk is the builder for "upsert"-ing
+// a bulk of SecurityRulePack nodes.
+type SecurityRulePackUpsertBulk struct {
+	create *SecurityRulePackCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SecurityRulePack.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityrulepack.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityRulePackUpsertBulk) UpdateNewValues() *SecurityRulePackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(securityrulepack.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityRulePack.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecurityRulePackUpsertBulk) Ignore() *SecurityRulePackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityRulePackUpsertBulk) DoNothing() *SecurityRulePackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityRulePackCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecurityRulePackUpsertBulk) Update(set func(*SecurityRulePackUpsert)) *SecurityRulePackUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityRulePackUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SecurityRulePackUpsertBulk) SetName(v string) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateName() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecurityRulePackUpsertBulk) SetDescription(v string) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateDescription() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecurityRulePackUpsertBulk) ClearDescription() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *SecurityRulePackUpsertBulk) SetEnabled(v bool) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateEnabled() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *SecurityRulePackUpsertBulk) SetVersion(v int) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SecurityRulePackUpsertBulk) AddVersion(v int) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateVersion() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (u *SecurityRulePackUpsertBulk) SetLatestVersion(v int) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetLatestVersion(v)
+	})
+}
+
+// AddLatestVersion adds v to the "latest_version" field.
+func (u *SecurityRulePackUpsertBulk) AddLatestVersion(v int) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.AddLatestVersion(v)
+	})
+}
+
+// UpdateLatestVersion sets the "latest_version" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateLatestVersion() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateLatestVersion()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityRulePackUpsertBulk) SetCreator(v string) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateCreator() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityRulePackUpsertBulk) ClearCreator() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityRulePackUpsertBulk) SetCreatedAt(v time.Time) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateCreatedAt() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecurityRulePackUpsertBulk) SetUpdatedAt(v time.Time) *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecurityRulePackUpsertBulk) UpdateUpdatedAt() *SecurityRulePackUpsertBulk {
+	return u.Update(func(s *SecurityRulePackUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityRulePackUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the SecurityRulePackCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityRulePackCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityRulePackUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
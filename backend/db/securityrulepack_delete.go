@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+)
+
+// SecurityRulePackDelete is the builder for deleting a SecurityRulePack entity.
+type SecurityRulePackDelete struct {
+	config
+	hooks    []Hook
+	mutation *SecurityRulePackMutation
+}
+
+// Where appends a list predicates to the SecurityRulePackDelete builder.
+func (srpd *SecurityRulePackDelete) Where(ps ...predicate.SecurityRulePack) *SecurityRulePackDelete {
+	srpd.mutation.Where(ps...)
+	return srpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srpd *SecurityRulePackDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srpd.sqlExec, srpd.mutation, srpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpd *SecurityRulePackDelete) ExecX(ctx context.Context) int {
+	n, err := srpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srpd *SecurityRulePackDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(securityrulepack.Table, sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID))
+	if ps := srpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srpd.mutation.done = true
+	return affected, err
+}
+
+// SecurityRulePackDeleteOne is the builder for deleting a single SecurityRulePack entity.
+type SecurityRulePackDeleteOne struct {
+	srpd *SecurityRulePackDelete
+}
+
+// Where appends a list predicates to the SecurityRulePackDelete builder.
+func (srpdo *SecurityRulePackDeleteOne) Where(ps ...predicate.SecurityRulePack) *SecurityRulePackDeleteOne {
+	srpdo.srpd.mutation.Where(ps...)
+	return srpdo
+}
+
+// Exec executes the deletion query.
+func (srpdo *SecurityRulePackDeleteOne) Exec(ctx context.Context) error {
+	n, err := srpdo.srpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{securityrulepack.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpdo *SecurityRulePackDeleteOne) ExecX(ctx context.Context) {
+	if err := srpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
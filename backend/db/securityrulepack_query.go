@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackQuery is the builder for querying SecurityRulePack entities.
+type SecurityRulePackQuery struct {
+	config
+	ctx          *QueryContext
+	order        []securityrulepack.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.SecurityRulePack
+	withVersions *SecurityRulePackVersionQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SecurityRulePackQuery builder.
+func (srpq *SecurityRulePackQuery) Where(ps ...predicate.SecurityRulePack) *SecurityRulePackQuery {
+	srpq.predicates = append(srpq.predicates, ps...)
+	return srpq
+}
+
+// Limit the number of records to be returned by this query.
+func (srpq *SecurityRulePackQuery) Limit(limit int) *SecurityRulePackQuery {
+	srpq.ctx.Limit = &limit
+	return srpq
+}
+
+// Offset to start from.
+func (srpq *SecurityRulePackQuery) Offset(offset int) *SecurityRulePackQuery {
+	srpq.ctx.Offset = &offset
+	return srpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srpq *SecurityRulePackQuery) Unique(unique bool) *SecurityRulePackQuery {
+	srpq.ctx.Unique = &unique
+	return srpq
+}
+
+// Order specifies how the records should be ordered.
+func (srpq *SecurityRulePackQuery) Order(o ...securityrulepack.OrderOption) *SecurityRulePackQuery {
+	srpq.order = append(srpq.order, o...)
+	return srpq
+}
+
+// QueryVersions chains the current query on the "versions" edge.
+func (srpq *SecurityRulePackQuery) QueryVersions() *SecurityRulePackVersionQuery {
+	query := (&SecurityRulePackVersionClient{config: srpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityrulepack.Table, securityrulepack.FieldID, selector),
+			sqlgraph.To(securityrulepackversion.Table, securityrulepackversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, securityrulepack.VersionsTable, securityrulepack.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SecurityRulePack entity from the query.
+// Returns a *NotFoundError when no SecurityRulePack was found.
+func (srpq *SecurityRulePackQuery) First(ctx context.Context) (*SecurityRulePack, error) {
+	nodes, err := srpq.Limit(1).All(setContextOp(ctx, srpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{securityrulepack.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) FirstX(ctx context.Context) *SecurityRulePack {
+	node, err := srpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SecurityRulePack ID from the query.
+// Returns a *NotFoundError when no SecurityRulePack ID was found.
+func (srpq *SecurityRulePackQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = srpq.Limit(1).IDs(setContextOp(ctx, srpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{securityrulepack.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := srpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SecurityRulePack entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SecurityRulePack entity is found.
+// Returns a *NotFoundError when no SecurityRulePack entities are found.
+func (srpq *SecurityRulePackQuery) Only(ctx context.Context) (*SecurityRulePack, error) {
+	nodes, err := srpq.Limit(2).All(setContextOp(ctx, srpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{securityrulepack.Label}
+	default:
+		return nil, &NotSingularError{securityrulepack.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) OnlyX(ctx context.Context) *SecurityRulePack {
+	node, err := srpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SecurityRulePack ID in the query.
+// Returns a *NotSingularError when more than one SecurityRulePack ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srpq *SecurityRulePackQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = srpq.Limit(2).IDs(setContextOp(ctx, srpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{securityrulepack.Label}
+	default:
+		err = &NotSingularError{securityrulepack.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := srpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SecurityRulePacks.
+func (srpq *SecurityRulePackQuery) All(ctx context.Context) ([]*SecurityRulePack, error) {
+	ctx = setContextOp(ctx, srpq.ctx, ent.OpQueryAll)
+	if err := srpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SecurityRulePack, *SecurityRulePackQuery]()
+	return withInterceptors[[]*SecurityRulePack](ctx, srpq, qr, srpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) AllX(ctx context.Context) []*SecurityRulePack {
+	nodes, err := srpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SecurityRulePack IDs.
+func (srpq *SecurityRulePackQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if srpq.ctx.Unique == nil && srpq.path != nil {
+		srpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srpq.ctx, ent.OpQueryIDs)
+	if err = srpq.Select(securityrulepack.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := srpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srpq *SecurityRulePackQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srpq.ctx, ent.OpQueryCount)
+	if err := srpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srpq, querierCount[*SecurityRulePackQuery](), srpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) CountX(ctx context.Context) int {
+	count, err := srpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srpq *SecurityRulePackQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srpq.ctx, ent.OpQueryExist)
+	switch _, err := srpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srpq *SecurityRulePackQuery) ExistX(ctx context.Context) bool {
+	exist, err := srpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SecurityRulePackQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srpq *SecurityRulePackQuery) Clone() *SecurityRulePackQuery {
+	if srpq == nil {
+		return nil
+	}
+	return &SecurityRulePackQuery{
+		config:       srpq.config,
+		ctx:          srpq.ctx.Clone(),
+		order:        append([]securityrulepack.OrderOption{}, srpq.order...),
+		inters:       append([]Interceptor{}, srpq.inters...),
+		predicates:   append([]predicate.SecurityRulePack{}, srpq.predicates...),
+		withVersions: srpq.withVersions.Clone(),
+		// clone intermediate query.
+		sql:       srpq.sql.Clone(),
+		path:      srpq.path,
+		modifiers: append([]func(*sql.Selector){}, srpq.modifiers...),
+	}
+}
+
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (srpq *SecurityRulePackQuery) WithVersions(opts ...func(*SecurityRulePackVersionQuery)) *SecurityRulePackQuery {
+	query := (&SecurityRulePackVersionClient{config: srpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srpq.withVersions = query
+	return srpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SecurityRulePack.Query().
+//		GroupBy(securityrulepack.FieldName).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (srpq *SecurityRulePackQuery) GroupBy(field string, fields ...string) *SecurityRulePackGroupBy {
+	srpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SecurityRulePackGroupBy{build: srpq}
+	grbuild.flds = &srpq.ctx.Fields
+	grbuild.label = securityrulepack.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SecurityRulePack.Query().
+//		Select(securityrulepack.FieldName).
+//		Scan(ctx, &v)
+func (srpq *SecurityRulePackQuery) Select(fields ...string) *SecurityRulePackSelect {
+	srpq.ctx.Fields = append(srpq.ctx.Fields, fields...)
+	sbuild := &SecurityRulePackSelect{SecurityRulePackQuery: srpq}
+	sbuild.label = securityrulepack.Label
+	sbuild.flds, sbuild.scan = &srpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SecurityRulePackSelect configured with the given aggregations.
+func (srpq *SecurityRulePackQuery) Aggregate(fns ...AggregateFunc) *SecurityRulePackSelect {
+	return srpq.Select().Aggregate(fns...)
+}
+
+func (srpq *SecurityRulePackQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srpq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srpq.ctx.Fields {
+		if !securityrulepack.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if srpq.path != nil {
+		prev, err := srpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srpq.sql = prev
+	}
+	return nil
+}
+
+func (srpq *SecurityRulePackQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SecurityRulePack, error) {
+	var (
+		nodes       = []*SecurityRulePack{}
+		_spec       = srpq.querySpec()
+		loadedTypes = [1]bool{
+			srpq.withVersions != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SecurityRulePack).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SecurityRulePack{config: srpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(srpq.modifiers) > 0 {
+		_spec.Modifiers = srpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := srpq.withVersions; query != nil {
+		if err := srpq.loadVersions(ctx, query, nodes,
+			func(n *SecurityRulePack) { n.Edges.Versions = []*SecurityRulePackVersion{} },
+			func(n *SecurityRulePack, e *SecurityRulePackVersion) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (srpq *SecurityRulePackQuery) loadVersions(ctx context.Context, query *SecurityRulePackVersionQuery, nodes []*SecurityRulePack, init func(*SecurityRulePack), assign func(*SecurityRulePack, *SecurityRulePackVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SecurityRulePack)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(securityrulepackversion.FieldPackID)
+	}
+	query.Where(predicate.SecurityRulePackVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(securityrulepack.VersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PackID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "pack_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (srpq *SecurityRulePackQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srpq.querySpec()
+	if len(srpq.modifiers) > 0 {
+		_spec.Modifiers = srpq.modifiers
+	}
+	_spec.Node.Columns = srpq.ctx.Fields
+	if len(srpq.ctx.Fields) > 0 {
+		_spec.Unique = srpq.ctx.Unique != nil && *srpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srpq.driver, _spec)
+}
+
+func (srpq *SecurityRulePackQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(securityrulepack.Table, securityrulepack.Columns, sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID))
+	_spec.From = srpq.sql
+	if unique := srpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityrulepack.FieldID)
+		for i := range fields {
+			if fields[i] != securityrulepack.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := srpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srpq *SecurityRulePackQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srpq.driver.Dialect())
+	t1 := builder.Table(securityrulepack.Table)
+	columns := srpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = securityrulepack.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srpq.sql != nil {
+		selector = srpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srpq.ctx.Unique != nil && *srpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range srpq.modifiers {
+		m(selector)
+	}
+	for _, p := range srpq.predicates {
+		p(selector)
+	}
+	for _, p := range srpq.order {
+		p(selector)
+	}
+	if offset := srpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (srpq *SecurityRulePackQuery) ForUpdate(opts ...sql.LockOption) *SecurityRulePackQuery {
+	if srpq.driver.Dialect() == dialect.Postgres {
+		srpq.Unique(false)
+	}
+	srpq.modifiers = append(srpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return srpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (srpq *SecurityRulePackQuery) ForShare(opts ...sql.LockOption) *SecurityRulePackQuery {
+	if srpq.driver.Dialect() == dialect.Postgres {
+		srpq.Unique(false)
+	}
+	srpq.modifiers = append(srpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return srpq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (srpq *SecurityRulePackQuery) Modify(modifiers ...func(s *sql.Selector)) *SecurityRulePackSelect {
+	srpq.modifiers = append(srpq.modifiers, modifiers...)
+	return srpq.Select()
+}
+
+// SecurityRulePackGroupBy is the group-by builder for SecurityRulePack entities.
+type SecurityRulePackGroupBy struct {
+	selector
+	build *SecurityRulePackQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srpgb *SecurityRulePackGroupBy) Aggregate(fns ...AggregateFunc) *SecurityRulePackGroupBy {
+	srpgb.fns = append(srpgb.fns, fns...)
+	return srpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srpgb *SecurityRulePackGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityRulePackQuery, *SecurityRulePackGroupBy](ctx, srpgb.build, srpgb, srpgb.build.inters, v)
+}
+
+func (srpgb *SecurityRulePackGroupBy) sqlScan(ctx context.Context, root *SecurityRulePackQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srpgb.fns))
+	for _, fn := range srpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srpgb.flds)+len(srpgb.fns))
+		for _, f := range *srpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SecurityRulePackSelect is the builder for selecting fields of SecurityRulePack entities.
+type SecurityRulePackSelect struct {
+	*SecurityRulePackQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srps *SecurityRulePackSelect) Aggregate(fns ...AggregateFunc) *SecurityRulePackSelect {
+	srps.fns = append(srps.fns, fns...)
+	return srps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srps *SecurityRulePackSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srps.ctx, ent.OpQuerySelect)
+	if err := srps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityRulePackQuery, *SecurityRulePackSelect](ctx, srps.SecurityRulePackQuery, srps, srps.inters, v)
+}
+
+func (srps *SecurityRulePackSelect) sqlScan(ctx context.Context, root *SecurityRulePackQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srps.fns))
+	for _, fn := range srps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (srps *SecurityRulePackSelect) Modify(modifiers ...func(s *sql.Selector)) *SecurityRulePackSelect {
+	srps.modifiers = append(srps.modifiers, modifiers...)
+	return srps
+}
@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackUpdate is the builder for updating SecurityRulePack entities.
+type SecurityRulePackUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SecurityRulePackMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SecurityRulePackUpdate builder.
+func (srpu *SecurityRulePackUpdate) Where(ps ...predicate.SecurityRulePack) *SecurityRulePackUpdate {
+	srpu.mutation.Where(ps...)
+	return srpu
+}
+
+// SetName sets the "name" field.
+func (srpu *SecurityRulePackUpdate) SetName(s string) *SecurityRulePackUpdate {
+	srpu.mutation.SetName(s)
+	return srpu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableName(s *string) *SecurityRulePackUpdate {
+	if s != nil {
+		srpu.SetName(*s)
+	}
+	return srpu
+}
+
+// SetDescription sets the "description" field.
+func (srpu *SecurityRulePackUpdate) SetDescription(s string) *SecurityRulePackUpdate {
+	srpu.mutation.SetDescription(s)
+	return srpu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableDescription(s *string) *SecurityRulePackUpdate {
+	if s != nil {
+		srpu.SetDescription(*s)
+	}
+	return srpu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (srpu *SecurityRulePackUpdate) ClearDescription() *SecurityRulePackUpdate {
+	srpu.mutation.ClearDescription()
+	return srpu
+}
+
+// SetEnabled sets the "enabled" field.
+func (srpu *SecurityRulePackUpdate) SetEnabled(b bool) *SecurityRulePackUpdate {
+	srpu.mutation.SetEnabled(b)
+	return srpu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableEnabled(b *bool) *SecurityRulePackUpdate {
+	if b != nil {
+		srpu.SetEnabled(*b)
+	}
+	return srpu
+}
+
+// SetVersion sets the "version" field.
+func (srpu *SecurityRulePackUpdate) SetVersion(i int) *SecurityRulePackUpdate {
+	srpu.mutation.ResetVersion()
+	srpu.mutation.SetVersion(i)
+	return srpu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableVersion(i *int) *SecurityRulePackUpdate {
+	if i != nil {
+		srpu.SetVersion(*i)
+	}
+	return srpu
+}
+
+// AddVersion adds i to the "version" field.
+func (srpu *SecurityRulePackUpdate) AddVersion(i int) *SecurityRulePackUpdate {
+	srpu.mutation.AddVersion(i)
+	return srpu
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (srpu *SecurityRulePackUpdate) SetLatestVersion(i int) *SecurityRulePackUpdate {
+	srpu.mutation.ResetLatestVersion()
+	srpu.mutation.SetLatestVersion(i)
+	return srpu
+}
+
+// SetNillableLatestVersion sets the "latest_version" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableLatestVersion(i *int) *SecurityRulePackUpdate {
+	if i != nil {
+		srpu.SetLatestVersion(*i)
+	}
+	return srpu
+}
+
+// AddLatestVersion adds i to the "latest_version" field.
+func (srpu *SecurityRulePackUpdate) AddLatestVersion(i int) *SecurityRulePackUpdate {
+	srpu.mutation.AddLatestVersion(i)
+	return srpu
+}
+
+// SetCreator sets the "creator" field.
+func (srpu *SecurityRulePackUpdate) SetCreator(s string) *SecurityRulePackUpdate {
+	srpu.mutation.SetCreator(s)
+	return srpu
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableCreator(s *string) *SecurityRulePackUpdate {
+	if s != nil {
+		srpu.SetCreator(*s)
+	}
+	return srpu
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (srpu *SecurityRulePackUpdate) ClearCreator() *SecurityRulePackUpdate {
+	srpu.mutation.ClearCreator()
+	return srpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (srpu *SecurityRulePackUpdate) SetCreatedAt(t time.Time) *SecurityRulePackUpdate {
+	srpu.mutation.SetCreatedAt(t)
+	return srpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (srpu *SecurityRulePackUpdate) SetNillableCreatedAt(t *time.Time) *SecurityRulePackUpdate {
+	if t != nil {
+		srpu.SetCreatedAt(*t)
+	}
+	return srpu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (srpu *SecurityRulePackUpdate) SetUpdatedAt(t time.Time) *SecurityRulePackUpdate {
+	srpu.mutation.SetUpdatedAt(t)
+	return srpu
+}
+
+// AddVersionIDs adds the "versions" edge to the SecurityRulePackVersion entity by IDs.
+func (srpu *SecurityRulePackUpdate) AddVersionIDs(ids ...uuid.UUID) *SecurityRulePackUpdate {
+	srpu.mutation.AddVersionIDs(ids...)
+	return srpu
+}
+
+// AddVersions adds the "versions" edges to the SecurityRulePackVersion entity.
+func (srpu *SecurityRulePackUpdate) AddVersions(s ...*SecurityRulePackVersion) *SecurityRulePackUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return srpu.AddVersionIDs(ids...)
+}
+
+// Mutation returns the SecurityRulePackMutation object of the builder.
+func (srpu *SecurityRulePackUpdate) Mutation() *SecurityRulePackMutation {
+	return srpu.mutation
+}
+
+// ClearVersions clears all "versions" edges to the SecurityRulePackVersion entity.
+func (srpu *SecurityRulePackUpdate) ClearVersions() *SecurityRulePackUpdate {
+	srpu.mutation.ClearVersions()
+	return srpu
+}
+
+// RemoveVersionIDs removes the "versions" edge to SecurityRulePackVersion entities by IDs.
+func (srpu *SecurityRulePackUpdate) RemoveVersionIDs(ids ...uuid.UUID) *SecurityRulePackUpdate {
+	srpu.mutation.RemoveVersionIDs(ids...)
+	return srpu
+}
+
+// RemoveVersions removes "versions" edges to SecurityRulePackVersion entities.
+func (srpu *SecurityRulePackUpdate) RemoveVersions(s ...*SecurityRulePackVersion) *SecurityRulePackUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return srpu.RemoveVersionIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (srpu *SecurityRulePackUpdate) Save(ctx context.Context) (int, error) {
+	srpu.defaults()
+	return withHooks(ctx, srpu.sqlSave, srpu.mutation, srpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srpu *SecurityRulePackUpdate) SaveX(ctx context.Context) int {
+	affected, err := srpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (srpu *SecurityRulePackUpdate) Exec(ctx context.Context) error {
+	_, err := srpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpu *SecurityRulePackUpdate) ExecX(ctx context.Context) {
+	if err := srpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (srpu *SecurityRulePackUpdate) defaults() {
+	if _, ok := srpu.mutation.UpdatedAt(); !ok {
+		v := securityrulepack.UpdateDefaultUpdatedAt()
+		srpu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (srpu *SecurityRulePackUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityRulePackUpdate {
+	srpu.modifiers = append(srpu.modifiers, modifiers...)
+	return srpu
+}
+
+func (srpu *SecurityRulePackUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(securityrulepack.Table, securityrulepack.Columns, sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID))
+	if ps := srpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := srpu.mutation.Name(); ok {
+		_spec.SetField(securityrulepack.FieldName, field.TypeString, value)
+	}
+	if value, ok := srpu.mutation.Description(); ok {
+		_spec.SetField(securityrulepack.FieldDescription, field.TypeString, value)
+	}
+	if srpu.mutation.DescriptionCleared() {
+		_spec.ClearField(securityrulepack.FieldDescription, field.TypeString)
+	}
+	if value, ok := srpu.mutation.Enabled(); ok {
+		_spec.SetField(securityrulepack.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := srpu.mutation.Version(); ok {
+		_spec.SetField(securityrulepack.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpu.mutation.AddedVersion(); ok {
+		_spec.AddField(securityrulepack.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpu.mutation.LatestVersion(); ok {
+		_spec.SetField(securityrulepack.FieldLatestVersion, field.TypeInt, value)
+	}
+	if value, ok := srpu.mutation.AddedLatestVersion(); ok {
+		_spec.AddField(securityrulepack.FieldLatestVersion, field.TypeInt, value)
+	}
+	if value, ok := srpu.mutation.Creator(); ok {
+		_spec.SetField(securityrulepack.FieldCreator, field.TypeString, value)
+	}
+	if srpu.mutation.CreatorCleared() {
+		_spec.ClearField(securityrulepack.FieldCreator, field.TypeString)
+	}
+	if value, ok := srpu.mutation.CreatedAt(); ok {
+		_spec.SetField(securityrulepack.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := srpu.mutation.UpdatedAt(); ok {
+		_spec.SetField(securityrulepack.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if srpu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := srpu.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !srpu.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := srpu.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(srpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, srpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityrulepack.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	srpu.mutation.done = true
+	return n, nil
+}
+
+// SecurityRulePackUpdateOne is the builder for updating a single SecurityRulePack entity.
+type SecurityRulePackUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SecurityRulePackMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (srpuo *SecurityRulePackUpdateOne) SetName(s string) *SecurityRulePackUpdateOne {
+	srpuo.mutation.SetName(s)
+	return srpuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableName(s *string) *SecurityRulePackUpdateOne {
+	if s != nil {
+		srpuo.SetName(*s)
+	}
+	return srpuo
+}
+
+// SetDescription sets the "description" field.
+func (srpuo *SecurityRulePackUpdateOne) SetDescription(s string) *SecurityRulePackUpdateOne {
+	srpuo.mutation.SetDescription(s)
+	return srpuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableDescription(s *string) *SecurityRulePackUpdateOne {
+	if s != nil {
+		srpuo.SetDescription(*s)
+	}
+	return srpuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (srpuo *SecurityRulePackUpdateOne) ClearDescription() *SecurityRulePackUpdateOne {
+	srpuo.mutation.ClearDescription()
+	return srpuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (srpuo *SecurityRulePackUpdateOne) SetEnabled(b bool) *SecurityRulePackUpdateOne {
+	srpuo.mutation.SetEnabled(b)
+	return srpuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableEnabled(b *bool) *SecurityRulePackUpdateOne {
+	if b != nil {
+		srpuo.SetEnabled(*b)
+	}
+	return srpuo
+}
+
+// SetVersion sets the "version" field.
+func (srpuo *SecurityRulePackUpdateOne) SetVersion(i int) *SecurityRulePackUpdateOne {
+	srpuo.mutation.ResetVersion()
+	srpuo.mutation.SetVersion(i)
+	return srpuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableVersion(i *int) *SecurityRulePackUpdateOne {
+	if i != nil {
+		srpuo.SetVersion(*i)
+	}
+	return srpuo
+}
+
+// AddVersion adds i to the "version" field.
+func (srpuo *SecurityRulePackUpdateOne) AddVersion(i int) *SecurityRulePackUpdateOne {
+	srpuo.mutation.AddVersion(i)
+	return srpuo
+}
+
+// SetLatestVersion sets the "latest_version" field.
+func (srpuo *SecurityRulePackUpdateOne) SetLatestVersion(i int) *SecurityRulePackUpdateOne {
+	srpuo.mutation.ResetLatestVersion()
+	srpuo.mutation.SetLatestVersion(i)
+	return srpuo
+}
+
+// SetNillableLatestVersion sets the "latest_version" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableLatestVersion(i *int) *SecurityRulePackUpdateOne {
+	if i != nil {
+		srpuo.SetLatestVersion(*i)
+	}
+	return srpuo
+}
+
+// AddLatestVersion adds i to the "latest_version" field.
+func (srpuo *SecurityRulePackUpdateOne) AddLatestVersion(i int) *SecurityRulePackUpdateOne {
+	srpuo.mutation.AddLatestVersion(i)
+	return srpuo
+}
+
+// SetCreator sets the "creator" field.
+func (srpuo *SecurityRulePackUpdateOne) SetCreator(s string) *SecurityRulePackUpdateOne {
+	srpuo.mutation.SetCreator(s)
+	return srpuo
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableCreator(s *string) *SecurityRulePackUpdateOne {
+	if s != nil {
+		srpuo.SetCreator(*s)
+	}
+	return srpuo
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (srpuo *SecurityRulePackUpdateOne) ClearCreator() *SecurityRulePackUpdateOne {
+	srpuo.mutation.ClearCreator()
+	return srpuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (srpuo *SecurityRulePackUpdateOne) SetCreatedAt(t time.Time) *SecurityRulePackUpdateOne {
+	srpuo.mutation.SetCreatedAt(t)
+	return srpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (srpuo *SecurityRulePackUpdateOne) SetNillableCreatedAt(t *time.Time) *SecurityRulePackUpdateOne {
+	if t != nil {
+		srpuo.SetCreatedAt(*t)
+	}
+	return srpuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (srpuo *SecurityRulePackUpdateOne) SetUpdatedAt(t time.Time) *SecurityRulePackUpdateOne {
+	srpuo.mutation.SetUpdatedAt(t)
+	return srpuo
+}
+
+// AddVersionIDs adds the "versions" edge to the SecurityRulePackVersion entity by IDs.
+func (srpuo *SecurityRulePackUpdateOne) AddVersionIDs(ids ...uuid.UUID) *SecurityRulePackUpdateOne {
+	srpuo.mutation.AddVersionIDs(ids...)
+	return srpuo
+}
+
+// AddVersions adds the "versions" edges to the SecurityRulePackVersion entity.
+func (srpuo *SecurityRulePackUpdateOne) AddVersions(s ...*SecurityRulePackVersion) *SecurityRulePackUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return srpuo.AddVersionIDs(ids...)
+}
+
+// Mutation returns the SecurityRulePackMutation object of the builder.
+func (srpuo *SecurityRulePackUpdateOne) Mutation() *SecurityRulePackMutation {
+	return srpuo.mutation
+}
+
+// ClearVersions clears all "versions" edges to the SecurityRulePackVersion entity.
+func (srpuo *SecurityRulePackUpdateOne) ClearVersions() *SecurityRulePackUpdateOne {
+	srpuo.mutation.ClearVersions()
+	return srpuo
+}
+
+// RemoveVersionIDs removes the "versions" edge to SecurityRulePackVersion entities by IDs.
+func (srpuo *SecurityRulePackUpdateOne) RemoveVersionIDs(ids ...uuid.UUID) *SecurityRulePackUpdateOne {
+	srpuo.mutation.RemoveVersionIDs(ids...)
+	return srpuo
+}
+
+// RemoveVersions removes "versions" edges to SecurityRulePackVersion entities.
+func (srpuo *SecurityRulePackUpdateOne) RemoveVersions(s ...*SecurityRulePackVersion) *SecurityRulePackUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return srpuo.RemoveVersionIDs(ids...)
+}
+
+// Where appends a list predicates to the SecurityRulePackUpdate builder.
+func (srpuo *SecurityRulePackUpdateOne) Where(ps ...predicate.SecurityRulePack) *SecurityRulePackUpdateOne {
+	srpuo.mutation.Where(ps...)
+	return srpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (srpuo *SecurityRulePackUpdateOne) Select(field string, fields ...string) *SecurityRulePackUpdateOne {
+	srpuo.fields = append([]string{field}, fields...)
+	return srpuo
+}
+
+// Save executes the query and returns the updated SecurityRulePack entity.
+func (srpuo *SecurityRulePackUpdateOne) Save(ctx context.Context) (*SecurityRulePack, error) {
+	srpuo.defaults()
+	return withHooks(ctx, srpuo.sqlSave, srpuo.mutation, srpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srpuo *SecurityRulePackUpdateOne) SaveX(ctx context.Context) *SecurityRulePack {
+	node, err := srpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (srpuo *SecurityRulePackUpdateOne) Exec(ctx context.Context) error {
+	_, err := srpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpuo *SecurityRulePackUpdateOne) ExecX(ctx context.Context) {
+	if err := srpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (srpuo *SecurityRulePackUpdateOne) defaults() {
+	if _, ok := srpuo.mutation.UpdatedAt(); !ok {
+		v := securityrulepack.UpdateDefaultUpdatedAt()
+		srpuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (srpuo *SecurityRulePackUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityRulePackUpdateOne {
+	srpuo.modifiers = append(srpuo.modifiers, modifiers...)
+	return srpuo
+}
+
+func (srpuo *SecurityRulePackUpdateOne) sqlSave(ctx context.Context) (_node *SecurityRulePack, err error) {
+	_spec := sqlgraph.NewUpdateSpec(securityrulepack.Table, securityrulepack.Columns, sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID))
+	id, ok := srpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SecurityRulePack.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := srpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityrulepack.FieldID)
+		for _, f := range fields {
+			if !securityrulepack.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != securityrulepack.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := srpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := srpuo.mutation.Name(); ok {
+		_spec.SetField(securityrulepack.FieldName, field.TypeString, value)
+	}
+	if value, ok := srpuo.mutation.Description(); ok {
+		_spec.SetField(securityrulepack.FieldDescription, field.TypeString, value)
+	}
+	if srpuo.mutation.DescriptionCleared() {
+		_spec.ClearField(securityrulepack.FieldDescription, field.TypeString)
+	}
+	if value, ok := srpuo.mutation.Enabled(); ok {
+		_spec.SetField(securityrulepack.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := srpuo.mutation.Version(); ok {
+		_spec.SetField(securityrulepack.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpuo.mutation.AddedVersion(); ok {
+		_spec.AddField(securityrulepack.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpuo.mutation.LatestVersion(); ok {
+		_spec.SetField(securityrulepack.FieldLatestVersion, field.TypeInt, value)
+	}
+	if value, ok := srpuo.mutation.AddedLatestVersion(); ok {
+		_spec.AddField(securityrulepack.FieldLatestVersion, field.TypeInt, value)
+	}
+	if value, ok := srpuo.mutation.Creator(); ok {
+		_spec.SetField(securityrulepack.FieldCreator, field.TypeString, value)
+	}
+	if srpuo.mutation.CreatorCleared() {
+		_spec.ClearField(securityrulepack.FieldCreator, field.TypeString)
+	}
+	if value, ok := srpuo.mutation.CreatedAt(); ok {
+		_spec.SetField(securityrulepack.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := srpuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(securityrulepack.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if srpuo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := srpuo.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !srpuo.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := srpuo.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityrulepack.VersionsTable,
+			Columns: []string{securityrulepack.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(srpuo.modifiers...)
+	_node = &SecurityRulePack{config: srpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, srpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityrulepack.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	srpuo.mutation.done = true
+	return _node, nil
+}
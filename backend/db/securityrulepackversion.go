@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackVersion is the model entity for the SecurityRulePackVersion schema.
+type SecurityRulePackVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// PackID holds the value of the "pack_id" field.
+	PackID uuid.UUID `json:"pack_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Checksum holds the value of the "checksum" field.
+	Checksum string `json:"checksum,omitempty"`
+	// RuleIds holds the value of the "rule_ids" field.
+	RuleIds []string `json:"rule_ids,omitempty"`
+	// Creator holds the value of the "creator" field.
+	Creator string `json:"creator,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SecurityRulePackVersionQuery when eager-loading is set.
+	Edges        SecurityRulePackVersionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SecurityRulePackVersionEdges holds the relations/edges for other nodes in the graph.
+type SecurityRulePackVersionEdges struct {
+	// Pack holds the value of the pack edge.
+	Pack *SecurityRulePack `json:"pack,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PackOrErr returns the Pack value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SecurityRulePackVersionEdges) PackOrErr() (*SecurityRulePack, error) {
+	if e.Pack != nil {
+		return e.Pack, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: securityrulepack.Label}
+	}
+	return nil, &NotLoadedError{edge: "pack"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityRulePackVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityrulepackversion.FieldRuleIds:
+			values[i] = new([]byte)
+		case securityrulepackversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case securityrulepackversion.FieldContent, securityrulepackversion.FieldChecksum, securityrulepackversion.FieldCreator:
+			values[i] = new(sql.NullString)
+		case securityrulepackversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case securityrulepackversion.FieldID, securityrulepackversion.FieldPackID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityRulePackVersion fields.
+func (srpv *SecurityRulePackVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityrulepackversion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				srpv.ID = *value
+			}
+		case securityrulepackversion.FieldPackID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field pack_id", values[i])
+			} else if value != nil {
+				srpv.PackID = *value
+			}
+		case securityrulepackversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				srpv.Version = int(value.Int64)
+			}
+		case securityrulepackversion.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				srpv.Content = value.String
+			}
+		case securityrulepackversion.FieldChecksum:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field checksum", values[i])
+			} else if value.Valid {
+				srpv.Checksum = value.String
+			}
+		case securityrulepackversion.FieldRuleIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &srpv.RuleIds); err != nil {
+					return fmt.Errorf("unmarshal field rule_ids: %w", err)
+				}
+			}
+		case securityrulepackversion.FieldCreator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field creator", values[i])
+			} else if value.Valid {
+				srpv.Creator = value.String
+			}
+		case securityrulepackversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				srpv.CreatedAt = value.Time
+			}
+		default:
+			srpv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityRulePackVersion.
+// This includes values selected through modifiers, order, etc.
+func (srpv *SecurityRulePackVersion) Value(name string) (ent.Value, error) {
+	return srpv.selectValues.Get(name)
+}
+
+// QueryPack queries the "pack" edge of the SecurityRulePackVersion entity.
+func (srpv *SecurityRulePackVersion) QueryPack() *SecurityRulePackQuery {
+	return NewSecurityRulePackVersionClient(srpv.config).QueryPack(srpv)
+}
+
+// Update returns a builder for updating this SecurityRulePackVersion.
+// Note that you need to call SecurityRulePackVersion.Unwrap() before calling this method if this SecurityRulePackVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (srpv *SecurityRulePackVersion) Update() *SecurityRulePackVersionUpdateOne {
+	return NewSecurityRulePackVersionClient(srpv.config).UpdateOne(srpv)
+}
+
+// Unwrap unwraps the SecurityRulePackVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (srpv *SecurityRulePackVersion) Unwrap() *SecurityRulePackVersion {
+	_tx, ok := srpv.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SecurityRulePackVersion is not a transactional entity")
+	}
+	srpv.config.driver = _tx.drv
+	return srpv
+}
+
+// String implements the fmt.Stringer.
+func (srpv *SecurityRulePackVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityRulePackVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", srpv.ID))
+	builder.WriteString("pack_id=")
+	builder.WriteString(fmt.Sprintf("%v", srpv.PackID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", srpv.Version))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(srpv.Content)
+	builder.WriteString(", ")
+	builder.WriteString("checksum=")
+	builder.WriteString(srpv.Checksum)
+	builder.WriteString(", ")
+	builder.WriteString("rule_ids=")
+	builder.WriteString(fmt.Sprintf("%v", srpv.RuleIds))
+	builder.WriteString(", ")
+	builder.WriteString("creator=")
+	builder.WriteString(srpv.Creator)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(srpv.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityRulePackVersions is a parsable slice of SecurityRulePackVersion.
+type SecurityRulePackVersions []*SecurityRulePackVersion
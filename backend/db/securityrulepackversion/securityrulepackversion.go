@@ -0,0 +1,124 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityrulepackversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the securityrulepackversion type in the database.
+	Label = "security_rule_pack_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPackID holds the string denoting the pack_id field in the database.
+	FieldPackID = "pack_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldChecksum holds the string denoting the checksum field in the database.
+	FieldChecksum = "checksum"
+	// FieldRuleIds holds the string denoting the rule_ids field in the database.
+	FieldRuleIds = "rule_ids"
+	// FieldCreator holds the string denoting the creator field in the database.
+	FieldCreator = "creator"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePack holds the string denoting the pack edge name in mutations.
+	EdgePack = "pack"
+	// Table holds the table name of the securityrulepackversion in the database.
+	Table = "security_rule_pack_versions"
+	// PackTable is the table that holds the pack relation/edge.
+	PackTable = "security_rule_pack_versions"
+	// PackInverseTable is the table name for the SecurityRulePack entity.
+	// It exists in this package in order to avoid circular dependency with the "securityrulepack" package.
+	PackInverseTable = "security_rule_packs"
+	// PackColumn is the table column denoting the pack relation/edge.
+	PackColumn = "pack_id"
+)
+
+// Columns holds all SQL columns for securityrulepackversion fields.
+var Columns = []string{
+	FieldID,
+	FieldPackID,
+	FieldVersion,
+	FieldContent,
+	FieldChecksum,
+	FieldRuleIds,
+	FieldCreator,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SecurityRulePackVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPackID orders the results by the pack_id field.
+func ByPackID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPackID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByChecksum orders the results by the checksum field.
+func ByChecksum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChecksum, opts...).ToFunc()
+}
+
+// ByCreator orders the results by the creator field.
+func ByCreator(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreator, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPackField orders the results by pack field.
+func ByPackField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPackStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPackStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PackInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PackTable, PackColumn),
+	)
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityrulepackversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLTE(FieldID, id))
+}
+
+// PackID applies equality check predicate on the "pack_id" field. It's identical to PackIDEQ.
+func PackID(v uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldPackID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldContent, v))
+}
+
+// Checksum applies equality check predicate on the "checksum" field. It's identical to ChecksumEQ.
+func Checksum(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldChecksum, v))
+}
+
+// Creator applies equality check predicate on the "creator" field. It's identical to CreatorEQ.
+func Creator(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PackIDEQ applies the EQ predicate on the "pack_id" field.
+func PackIDEQ(v uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldPackID, v))
+}
+
+// PackIDNEQ applies the NEQ predicate on the "pack_id" field.
+func PackIDNEQ(v uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldPackID, v))
+}
+
+// PackIDIn applies the In predicate on the "pack_id" field.
+func PackIDIn(vs ...uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldPackID, vs...))
+}
+
+// PackIDNotIn applies the NotIn predicate on the "pack_id" field.
+func PackIDNotIn(vs ...uuid.UUID) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldPackID, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ChecksumEQ applies the EQ predicate on the "checksum" field.
+func ChecksumEQ(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldChecksum, v))
+}
+
+// ChecksumNEQ applies the NEQ predicate on the "checksum" field.
+func ChecksumNEQ(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldChecksum, v))
+}
+
+// ChecksumIn applies the In predicate on the "checksum" field.
+func ChecksumIn(vs ...string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldChecksum, vs...))
+}
+
+// ChecksumNotIn applies the NotIn predicate on the "checksum" field.
+func ChecksumNotIn(vs ...string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldChecksum, vs...))
+}
+
+// ChecksumGT applies the GT predicate on the "checksum" field.
+func ChecksumGT(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGT(FieldChecksum, v))
+}
+
+// ChecksumGTE applies the GTE predicate on the "checksum" field.
+func ChecksumGTE(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGTE(FieldChecksum, v))
+}
+
+// ChecksumLT applies the LT predicate on the "checksum" field.
+func ChecksumLT(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLT(FieldChecksum, v))
+}
+
+// ChecksumLTE applies the LTE predicate on the "checksum" field.
+func ChecksumLTE(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLTE(FieldChecksum, v))
+}
+
+// ChecksumContains applies the Contains predicate on the "checksum" field.
+func ChecksumContains(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldContains(FieldChecksum, v))
+}
+
+// ChecksumHasPrefix applies the HasPrefix predicate on the "checksum" field.
+func ChecksumHasPrefix(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldHasPrefix(FieldChecksum, v))
+}
+
+// ChecksumHasSuffix applies the HasSuffix predicate on the "checksum" field.
+func ChecksumHasSuffix(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldHasSuffix(FieldChecksum, v))
+}
+
+// ChecksumEqualFold applies the EqualFold predicate on the "checksum" field.
+func ChecksumEqualFold(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEqualFold(FieldChecksum, v))
+}
+
+// ChecksumContainsFold applies the ContainsFold predicate on the "checksum" field.
+func ChecksumContainsFold(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldContainsFold(FieldChecksum, v))
+}
+
+// RuleIdsIsNil applies the IsNil predicate on the "rule_ids" field.
+func RuleIdsIsNil() predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIsNull(FieldRuleIds))
+}
+
+// RuleIdsNotNil applies the NotNil predicate on the "rule_ids" field.
+func RuleIdsNotNil() predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotNull(FieldRuleIds))
+}
+
+// CreatorEQ applies the EQ predicate on the "creator" field.
+func CreatorEQ(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatorNEQ applies the NEQ predicate on the "creator" field.
+func CreatorNEQ(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldCreator, v))
+}
+
+// CreatorIn applies the In predicate on the "creator" field.
+func CreatorIn(vs ...string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldCreator, vs...))
+}
+
+// CreatorNotIn applies the NotIn predicate on the "creator" field.
+func CreatorNotIn(vs ...string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldCreator, vs...))
+}
+
+// CreatorGT applies the GT predicate on the "creator" field.
+func CreatorGT(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGT(FieldCreator, v))
+}
+
+// CreatorGTE applies the GTE predicate on the "creator" field.
+func CreatorGTE(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGTE(FieldCreator, v))
+}
+
+// CreatorLT applies the LT predicate on the "creator" field.
+func CreatorLT(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLT(FieldCreator, v))
+}
+
+// CreatorLTE applies the LTE predicate on the "creator" field.
+func CreatorLTE(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLTE(FieldCreator, v))
+}
+
+// CreatorContains applies the Contains predicate on the "creator" field.
+func CreatorContains(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldContains(FieldCreator, v))
+}
+
+// CreatorHasPrefix applies the HasPrefix predicate on the "creator" field.
+func CreatorHasPrefix(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldHasPrefix(FieldCreator, v))
+}
+
+// CreatorHasSuffix applies the HasSuffix predicate on the "creator" field.
+func CreatorHasSuffix(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldHasSuffix(FieldCreator, v))
+}
+
+// CreatorIsNil applies the IsNil predicate on the "creator" field.
+func CreatorIsNil() predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIsNull(FieldCreator))
+}
+
+// CreatorNotNil applies the NotNil predicate on the "creator" field.
+func CreatorNotNil() predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotNull(FieldCreator))
+}
+
+// CreatorEqualFold applies the EqualFold predicate on the "creator" field.
+func CreatorEqualFold(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEqualFold(FieldCreator, v))
+}
+
+// CreatorContainsFold applies the ContainsFold predicate on the "creator" field.
+func CreatorContainsFold(v string) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldContainsFold(FieldCreator, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPack applies the HasEdge predicate on the "pack" edge.
+func HasPack() predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PackTable, PackColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPackWith applies the HasEdge predicate on the "pack" edge with a given conditions (other predicates).
+func HasPackWith(preds ...predicate.SecurityRulePack) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(func(s *sql.Selector) {
+		step := newPackStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SecurityRulePackVersion) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SecurityRulePackVersion) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SecurityRulePackVersion) predicate.SecurityRulePackVersion {
+	return predicate.SecurityRulePackVersion(sql.NotPredicates(p))
+}
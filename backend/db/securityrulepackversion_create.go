@@ -0,0 +1,923 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackVersionCreate is the builder for creating a SecurityRulePackVersion entity.
+type SecurityRulePackVersionCreate struct {
+	config
+	mutation *SecurityRulePackVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetPackID sets the "pack_id" field.
+func (srpvc *SecurityRulePackVersionCreate) SetPackID(u uuid.UUID) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetPackID(u)
+	return srpvc
+}
+
+// SetVersion sets the "version" field.
+func (srpvc *SecurityRulePackVersionCreate) SetVersion(i int) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetVersion(i)
+	return srpvc
+}
+
+// SetContent sets the "content" field.
+func (srpvc *SecurityRulePackVersionCreate) SetContent(s string) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetContent(s)
+	return srpvc
+}
+
+// SetChecksum sets the "checksum" field.
+func (srpvc *SecurityRulePackVersionCreate) SetChecksum(s string) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetChecksum(s)
+	return srpvc
+}
+
+// SetRuleIds sets the "rule_ids" field.
+func (srpvc *SecurityRulePackVersionCreate) SetRuleIds(s []string) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetRuleIds(s)
+	return srpvc
+}
+
+// SetCreator sets the "creator" field.
+func (srpvc *SecurityRulePackVersionCreate) SetCreator(s string) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetCreator(s)
+	return srpvc
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (srpvc *SecurityRulePackVersionCreate) SetNillableCreator(s *string) *SecurityRulePackVersionCreate {
+	if s != nil {
+		srpvc.SetCreator(*s)
+	}
+	return srpvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (srpvc *SecurityRulePackVersionCreate) SetCreatedAt(t time.Time) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetCreatedAt(t)
+	return srpvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (srpvc *SecurityRulePackVersionCreate) SetNillableCreatedAt(t *time.Time) *SecurityRulePackVersionCreate {
+	if t != nil {
+		srpvc.SetCreatedAt(*t)
+	}
+	return srpvc
+}
+
+// SetID sets the "id" field.
+func (srpvc *SecurityRulePackVersionCreate) SetID(u uuid.UUID) *SecurityRulePackVersionCreate {
+	srpvc.mutation.SetID(u)
+	return srpvc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (srpvc *SecurityRulePackVersionCreate) SetNillableID(u *uuid.UUID) *SecurityRulePackVersionCreate {
+	if u != nil {
+		srpvc.SetID(*u)
+	}
+	return srpvc
+}
+
+// SetPack sets the "pack" edge to the SecurityRulePack entity.
+func (srpvc *SecurityRulePackVersionCreate) SetPack(s *SecurityRulePack) *SecurityRulePackVersionCreate {
+	return srpvc.SetPackID(s.ID)
+}
+
+// Mutation returns the SecurityRulePackVersionMutation object of the builder.
+func (srpvc *SecurityRulePackVersionCreate) Mutation() *SecurityRulePackVersionMutation {
+	return srpvc.mutation
+}
+
+// Save creates the SecurityRulePackVersion in the database.
+func (srpvc *SecurityRulePackVersionCreate) Save(ctx context.Context) (*SecurityRulePackVersion, error) {
+	srpvc.defaults()
+	return withHooks(ctx, srpvc.sqlSave, srpvc.mutation, srpvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (srpvc *SecurityRulePackVersionCreate) SaveX(ctx context.Context) *SecurityRulePackVersion {
+	v, err := srpvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srpvc *SecurityRulePackVersionCreate) Exec(ctx context.Context) error {
+	_, err := srpvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpvc *SecurityRulePackVersionCreate) ExecX(ctx context.Context) {
+	if err := srpvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (srpvc *SecurityRulePackVersionCreate) defaults() {
+	if _, ok := srpvc.mutation.CreatedAt(); !ok {
+		v := securityrulepackversion.DefaultCreatedAt()
+		srpvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := srpvc.mutation.ID(); !ok {
+		v := securityrulepackversion.DefaultID()
+		srpvc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srpvc *SecurityRulePackVersionCreate) check() error {
+	if _, ok := srpvc.mutation.PackID(); !ok {
+		return &ValidationError{Name: "pack_id", err: errors.New(`db: missing required field "SecurityRulePackVersion.pack_id"`)}
+	}
+	if _, ok := srpvc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`db: missing required field "SecurityRulePackVersion.version"`)}
+	}
+	if _, ok := srpvc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`db: missing required field "SecurityRulePackVersion.content"`)}
+	}
+	if _, ok := srpvc.mutation.Checksum(); !ok {
+		return &ValidationError{Name: "checksum", err: errors.New(`db: missing required field "SecurityRulePackVersion.checksum"`)}
+	}
+	if _, ok := srpvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityRulePackVersion.created_at"`)}
+	}
+	if len(srpvc.mutation.PackIDs()) == 0 {
+		return &ValidationError{Name: "pack", err: errors.New(`db: missing required edge "SecurityRulePackVersion.pack"`)}
+	}
+	return nil
+}
+
+func (srpvc *SecurityRulePackVersionCreate) sqlSave(ctx context.Context) (*SecurityRulePackVersion, error) {
+	if err := srpvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := srpvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, srpvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	srpvc.mutation.id = &_node.ID
+	srpvc.mutation.done = true
+	return _node, nil
+}
+
+func (srpvc *SecurityRulePackVersionCreate) createSpec() (*SecurityRulePackVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityRulePackVersion{config: srpvc.config}
+		_spec = sqlgraph.NewCreateSpec(securityrulepackversion.Table, sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = srpvc.conflict
+	if id, ok := srpvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := srpvc.mutation.Version(); ok {
+		_spec.SetField(securityrulepackversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := srpvc.mutation.Content(); ok {
+		_spec.SetField(securityrulepackversion.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := srpvc.mutation.Checksum(); ok {
+		_spec.SetField(securityrulepackversion.FieldChecksum, field.TypeString, value)
+		_node.Checksum = value
+	}
+	if value, ok := srpvc.mutation.RuleIds(); ok {
+		_spec.SetField(securityrulepackversion.FieldRuleIds, field.TypeJSON, value)
+		_node.RuleIds = value
+	}
+	if value, ok := srpvc.mutation.Creator(); ok {
+		_spec.SetField(securityrulepackversion.FieldCreator, field.TypeString, value)
+		_node.Creator = value
+	}
+	if value, ok := srpvc.mutation.CreatedAt(); ok {
+		_spec.SetField(securityrulepackversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := srpvc.mutation.PackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityrulepackversion.PackTable,
+			Columns: []string{securityrulepackversion.PackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PackID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityRulePackVersion.Create().
+//		SetPackID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityRulePackVersionUpsert) {
+//			SetPackID(v+v).
+//		}).
+//		Exec(ctx)
+func (srpvc *SecurityRulePackVersionCreate) OnConflict(opts ...sql.ConflictOption) *SecurityRulePackVersionUpsertOne {
+	srpvc.conflict = opts
+	return &SecurityRulePackVersionUpsertOne{
+		create: srpvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityRulePackVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srpvc *SecurityRulePackVersionCreate) OnConflictColumns(columns ...string) *SecurityRulePackVersionUpsertOne {
+	srpvc.conflict = append(srpvc.conflict, sql.ConflictColumns(columns...))
+	return &SecurityRulePackVersionUpsertOne{
+		create: srpvc,
+	}
+}
+
+type (
+	// SecurityRulePackVersionUpsertOne is the builder for "upsert"-ing
+	//  one SecurityRulePackVersion node.
+	SecurityRulePackVersionUpsertOne struct {
+		create *SecurityRulePackVersionCreate
+	}
+
+	// SecurityRulePackVersionUpsert is the "OnConflict" setter.
+	SecurityRulePackVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetPackID sets the "pack_id" field.
+func (u *SecurityRulePackVersionUpsert) SetPackID(v uuid.UUID) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldPackID, v)
+	return u
+}
+
+// UpdatePackID sets the "pack_id" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdatePackID() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldPackID)
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *SecurityRulePackVersionUpsert) SetVersion(v int) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdateVersion() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SecurityRulePackVersionUpsert) AddVersion(v int) *SecurityRulePackVersionUpsert {
+	u.Add(securityrulepackversion.FieldVersion, v)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *SecurityRulePackVersionUpsert) SetContent(v string) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdateContent() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldContent)
+	return u
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *SecurityRulePackVersionUpsert) SetChecksum(v string) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldChecksum, v)
+	return u
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdateChecksum() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldChecksum)
+	return u
+}
+
+// SetRuleIds sets the "rule_ids" field.
+func (u *SecurityRulePackVersionUpsert) SetRuleIds(v []string) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldRuleIds, v)
+	return u
+}
+
+// UpdateRuleIds sets the "rule_ids" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdateRuleIds() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldRuleIds)
+	return u
+}
+
+// ClearRuleIds clears the value of the "rule_ids" field.
+func (u *SecurityRulePackVersionUpsert) ClearRuleIds() *SecurityRulePackVersionUpsert {
+	u.SetNull(securityrulepackversion.FieldRuleIds)
+	return u
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityRulePackVersionUpsert) SetCreator(v string) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldCreator, v)
+	return u
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdateCreator() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldCreator)
+	return u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityRulePackVersionUpsert) ClearCreator() *SecurityRulePackVersionUpsert {
+	u.SetNull(securityrulepackversion.FieldCreator)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityRulePackVersionUpsert) SetCreatedAt(v time.Time) *SecurityRulePackVersionUpsert {
+	u.Set(securityrulepackversion.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsert) UpdateCreatedAt() *SecurityRulePackVersionUpsert {
+	u.SetExcluded(securityrulepackversion.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SecurityRulePackVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityrulepackversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityRulePackVersionUpsertOne) UpdateNewValues() *SecurityRulePackVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(securityrulepackversion.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityRulePackVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecurityRulePackVersionUpsertOne) Ignore() *SecurityRulePackVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityRulePackVersionUpsertOne) DoNothing() *SecurityRulePackVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityRulePackVersionCreate.OnConflict
+// documentation for more info.
+func (u *SecurityRulePackVersionUpsertOne) Update(set func(*SecurityRulePackVersionUpsert)) *SecurityRulePackVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityRulePackVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackID sets the "pack_id" field.
+func (u *SecurityRulePackVersionUpsertOne) SetPackID(v uuid.UUID) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetPackID(v)
+	})
+}
+
+// UpdatePackID sets the "pack_id" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdatePackID() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdatePackID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *SecurityRulePackVersionUpsertOne) SetVersion(v int) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SecurityRulePackVersionUpsertOne) AddVersion(v int) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdateVersion() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *SecurityRulePackVersionUpsertOne) SetContent(v string) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdateContent() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *SecurityRulePackVersionUpsertOne) SetChecksum(v string) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdateChecksum() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// SetRuleIds sets the "rule_ids" field.
+func (u *SecurityRulePackVersionUpsertOne) SetRuleIds(v []string) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetRuleIds(v)
+	})
+}
+
+// UpdateRuleIds sets the "rule_ids" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdateRuleIds() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateRuleIds()
+	})
+}
+
+// ClearRuleIds clears the value of the "rule_ids" field.
+func (u *SecurityRulePackVersionUpsertOne) ClearRuleIds() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.ClearRuleIds()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityRulePackVersionUpsertOne) SetCreator(v string) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdateCreator() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityRulePackVersionUpsertOne) ClearCreator() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityRulePackVersionUpsertOne) SetCreatedAt(v time.Time) *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertOne) UpdateCreatedAt() *SecurityRulePackVersionUpsertOne {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityRulePackVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityRulePackVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityRulePackVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecurityRulePackVersionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: SecurityRulePackVersionUpsertOne.ID is not supported by MySQL driver. Use SecurityRulePackVersionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecurityRulePackVersionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecurityRulePackVersionCreateBulk is the builder for creating many SecurityRulePackVersion entities in bulk.
+type SecurityRulePackVersionCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityRulePackVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SecurityRulePackVersion entities in the database.
+func (srpvcb *SecurityRulePackVersionCreateBulk) Save(ctx context.Context) ([]*SecurityRulePackVersion, error) {
+	if srpvcb.err != nil {
+		return nil, srpvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srpvcb.builders))
+	nodes := make([]*SecurityRulePackVersion, len(srpvcb.builders))
+	mutators := make([]Mutator, len(srpvcb.builders))
+	for i := range srpvcb.builders {
+		func(i int, root context.Context) {
+			builder := srpvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityRulePackVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srpvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = srpvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srpvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srpvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srpvcb *SecurityRulePackVersionCreateBulk) SaveX(ctx context.Context) []*SecurityRulePackVersion {
+	v, err := srpvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srpvcb *SecurityRulePackVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := srpvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpvcb *SecurityRulePackVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := srpvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityRulePackVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityRulePackVersionUpsert) {
+//			SetPackID(v+v).
+//		}).
+//		Exec(ctx)
+func (srpvcb *SecurityRulePackVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecurityRulePackVersionUpsertBulk {
+	srpvcb.conflict = opts
+	return &SecurityRulePackVersionUpsertBulk{
+		create: srpvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityRulePackVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (srpvcb *SecurityRulePackVersionCreateBulk) OnConflictColumns(columns ...string) *SecurityRulePackVersionUpsertBulk {
+	srpvcb.conflict = append(srpvcb.conflict, sql.ConflictColumns(columns...))
+	return &SecurityRulePackVersionUpsertBulk{
+		create: srpvcb,
+	}
+}
+
+// SecurityRulePackVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of SecurityRulePackVersion nodes.
+type SecurityRulePackVersionUpsertBulk struct {
+	create *SecurityRulePackVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SecurityRulePackVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityrulepackversion.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityRulePackVersionUpsertBulk) UpdateNewValues() *SecurityRulePackVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(securityrulepackversion.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityRulePackVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecurityRulePackVersionUpsertBulk) Ignore() *SecurityRulePackVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityRulePackVersionUpsertBulk) DoNothing() *SecurityRulePackVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityRulePackVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecurityRulePackVersionUpsertBulk) Update(set func(*SecurityRulePackVersionUpsert)) *SecurityRulePackVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityRulePackVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetPackID sets the "pack_id" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetPackID(v uuid.UUID) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetPackID(v)
+	})
+}
+
+// UpdatePackID sets the "pack_id" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdatePackID() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdatePackID()
+	})
+}
+
+// SetVersion sets the "version" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetVersion(v int) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *SecurityRulePackVersionUpsertBulk) AddVersion(v int) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdateVersion() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetContent(v string) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdateContent() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// SetChecksum sets the "checksum" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetChecksum(v string) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetChecksum(v)
+	})
+}
+
+// UpdateChecksum sets the "checksum" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdateChecksum() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateChecksum()
+	})
+}
+
+// SetRuleIds sets the "rule_ids" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetRuleIds(v []string) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetRuleIds(v)
+	})
+}
+
+// UpdateRuleIds sets the "rule_ids" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdateRuleIds() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateRuleIds()
+	})
+}
+
+// ClearRuleIds clears the value of the "rule_ids" field.
+func (u *SecurityRulePackVersionUpsertBulk) ClearRuleIds() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.ClearRuleIds()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetCreator(v string) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdateCreator() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityRulePackVersionUpsertBulk) ClearCreator() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityRulePackVersionUpsertBulk) SetCreatedAt(v time.Time) *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityRulePackVersionUpsertBulk) UpdateCreatedAt() *SecurityRulePackVersionUpsertBulk {
+	return u.Update(func(s *SecurityRulePackVersionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityRulePackVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the SecurityRulePackVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityRulePackVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityRulePackVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
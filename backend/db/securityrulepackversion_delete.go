@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+)
+
+// SecurityRulePackVersionDelete is the builder for deleting a SecurityRulePackVersion entity.
+type SecurityRulePackVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SecurityRulePackVersionMutation
+}
+
+// Where appends a list predicates to the SecurityRulePackVersionDelete builder.
+func (srpvd *SecurityRulePackVersionDelete) Where(ps ...predicate.SecurityRulePackVersion) *SecurityRulePackVersionDelete {
+	srpvd.mutation.Where(ps...)
+	return srpvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srpvd *SecurityRulePackVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srpvd.sqlExec, srpvd.mutation, srpvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpvd *SecurityRulePackVersionDelete) ExecX(ctx context.Context) int {
+	n, err := srpvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srpvd *SecurityRulePackVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(securityrulepackversion.Table, sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID))
+	if ps := srpvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srpvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srpvd.mutation.done = true
+	return affected, err
+}
+
+// SecurityRulePackVersionDeleteOne is the builder for deleting a single SecurityRulePackVersion entity.
+type SecurityRulePackVersionDeleteOne struct {
+	srpvd *SecurityRulePackVersionDelete
+}
+
+// Where appends a list predicates to the SecurityRulePackVersionDelete builder.
+func (srpvdo *SecurityRulePackVersionDeleteOne) Where(ps ...predicate.SecurityRulePackVersion) *SecurityRulePackVersionDeleteOne {
+	srpvdo.srpvd.mutation.Where(ps...)
+	return srpvdo
+}
+
+// Exec executes the deletion query.
+func (srpvdo *SecurityRulePackVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := srpvdo.srpvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{securityrulepackversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpvdo *SecurityRulePackVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := srpvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
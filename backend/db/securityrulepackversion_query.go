@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackVersionQuery is the builder for querying SecurityRulePackVersion entities.
+type SecurityRulePackVersionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []securityrulepackversion.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SecurityRulePackVersion
+	withPack   *SecurityRulePackQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SecurityRulePackVersionQuery builder.
+func (srpvq *SecurityRulePackVersionQuery) Where(ps ...predicate.SecurityRulePackVersion) *SecurityRulePackVersionQuery {
+	srpvq.predicates = append(srpvq.predicates, ps...)
+	return srpvq
+}
+
+// Limit the number of records to be returned by this query.
+func (srpvq *SecurityRulePackVersionQuery) Limit(limit int) *SecurityRulePackVersionQuery {
+	srpvq.ctx.Limit = &limit
+	return srpvq
+}
+
+// Offset to start from.
+func (srpvq *SecurityRulePackVersionQuery) Offset(offset int) *SecurityRulePackVersionQuery {
+	srpvq.ctx.Offset = &offset
+	return srpvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srpvq *SecurityRulePackVersionQuery) Unique(unique bool) *SecurityRulePackVersionQuery {
+	srpvq.ctx.Unique = &unique
+	return srpvq
+}
+
+// Order specifies how the records should be ordered.
+func (srpvq *SecurityRulePackVersionQuery) Order(o ...securityrulepackversion.OrderOption) *SecurityRulePackVersionQuery {
+	srpvq.order = append(srpvq.order, o...)
+	return srpvq
+}
+
+// QueryPack chains the current query on the "pack" edge.
+func (srpvq *SecurityRulePackVersionQuery) QueryPack() *SecurityRulePackQuery {
+	query := (&SecurityRulePackClient{config: srpvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := srpvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := srpvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityrulepackversion.Table, securityrulepackversion.FieldID, selector),
+			sqlgraph.To(securityrulepack.Table, securityrulepack.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, securityrulepackversion.PackTable, securityrulepackversion.PackColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(srpvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SecurityRulePackVersion entity from the query.
+// Returns a *NotFoundError when no SecurityRulePackVersion was found.
+func (srpvq *SecurityRulePackVersionQuery) First(ctx context.Context) (*SecurityRulePackVersion, error) {
+	nodes, err := srpvq.Limit(1).All(setContextOp(ctx, srpvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{securityrulepackversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) FirstX(ctx context.Context) *SecurityRulePackVersion {
+	node, err := srpvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SecurityRulePackVersion ID from the query.
+// Returns a *NotFoundError when no SecurityRulePackVersion ID was found.
+func (srpvq *SecurityRulePackVersionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = srpvq.Limit(1).IDs(setContextOp(ctx, srpvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{securityrulepackversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := srpvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SecurityRulePackVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SecurityRulePackVersion entity is found.
+// Returns a *NotFoundError when no SecurityRulePackVersion entities are found.
+func (srpvq *SecurityRulePackVersionQuery) Only(ctx context.Context) (*SecurityRulePackVersion, error) {
+	nodes, err := srpvq.Limit(2).All(setContextOp(ctx, srpvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{securityrulepackversion.Label}
+	default:
+		return nil, &NotSingularError{securityrulepackversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) OnlyX(ctx context.Context) *SecurityRulePackVersion {
+	node, err := srpvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SecurityRulePackVersion ID in the query.
+// Returns a *NotSingularError when more than one SecurityRulePackVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srpvq *SecurityRulePackVersionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = srpvq.Limit(2).IDs(setContextOp(ctx, srpvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{securityrulepackversion.Label}
+	default:
+		err = &NotSingularError{securityrulepackversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := srpvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SecurityRulePackVersions.
+func (srpvq *SecurityRulePackVersionQuery) All(ctx context.Context) ([]*SecurityRulePackVersion, error) {
+	ctx = setContextOp(ctx, srpvq.ctx, ent.OpQueryAll)
+	if err := srpvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SecurityRulePackVersion, *SecurityRulePackVersionQuery]()
+	return withInterceptors[[]*SecurityRulePackVersion](ctx, srpvq, qr, srpvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) AllX(ctx context.Context) []*SecurityRulePackVersion {
+	nodes, err := srpvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SecurityRulePackVersion IDs.
+func (srpvq *SecurityRulePackVersionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if srpvq.ctx.Unique == nil && srpvq.path != nil {
+		srpvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srpvq.ctx, ent.OpQueryIDs)
+	if err = srpvq.Select(securityrulepackversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := srpvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srpvq *SecurityRulePackVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srpvq.ctx, ent.OpQueryCount)
+	if err := srpvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srpvq, querierCount[*SecurityRulePackVersionQuery](), srpvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) CountX(ctx context.Context) int {
+	count, err := srpvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srpvq *SecurityRulePackVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srpvq.ctx, ent.OpQueryExist)
+	switch _, err := srpvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srpvq *SecurityRulePackVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := srpvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SecurityRulePackVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srpvq *SecurityRulePackVersionQuery) Clone() *SecurityRulePackVersionQuery {
+	if srpvq == nil {
+		return nil
+	}
+	return &SecurityRulePackVersionQuery{
+		config:     srpvq.config,
+		ctx:        srpvq.ctx.Clone(),
+		order:      append([]securityrulepackversion.OrderOption{}, srpvq.order...),
+		inters:     append([]Interceptor{}, srpvq.inters...),
+		predicates: append([]predicate.SecurityRulePackVersion{}, srpvq.predicates...),
+		withPack:   srpvq.withPack.Clone(),
+		// clone intermediate query.
+		sql:       srpvq.sql.Clone(),
+		path:      srpvq.path,
+		modifiers: append([]func(*sql.Selector){}, srpvq.modifiers...),
+	}
+}
+
+// WithPack tells the query-builder to eager-load the nodes that are connected to
+// the "pack" edge. The optional arguments are used to configure the query builder of the edge.
+func (srpvq *SecurityRulePackVersionQuery) WithPack(opts ...func(*SecurityRulePackQuery)) *SecurityRulePackVersionQuery {
+	query := (&SecurityRulePackClient{config: srpvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	srpvq.withPack = query
+	return srpvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		PackID uuid.UUID `json:"pack_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SecurityRulePackVersion.Query().
+//		GroupBy(securityrulepackversion.FieldPackID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (srpvq *SecurityRulePackVersionQuery) GroupBy(field string, fields ...string) *SecurityRulePackVersionGroupBy {
+	srpvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SecurityRulePackVersionGroupBy{build: srpvq}
+	grbuild.flds = &srpvq.ctx.Fields
+	grbuild.label = securityrulepackversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		PackID uuid.UUID `json:"pack_id,omitempty"`
+//	}
+//
+//	client.SecurityRulePackVersion.Query().
+//		Select(securityrulepackversion.FieldPackID).
+//		Scan(ctx, &v)
+func (srpvq *SecurityRulePackVersionQuery) Select(fields ...string) *SecurityRulePackVersionSelect {
+	srpvq.ctx.Fields = append(srpvq.ctx.Fields, fields...)
+	sbuild := &SecurityRulePackVersionSelect{SecurityRulePackVersionQuery: srpvq}
+	sbuild.label = securityrulepackversion.Label
+	sbuild.flds, sbuild.scan = &srpvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SecurityRulePackVersionSelect configured with the given aggregations.
+func (srpvq *SecurityRulePackVersionQuery) Aggregate(fns ...AggregateFunc) *SecurityRulePackVersionSelect {
+	return srpvq.Select().Aggregate(fns...)
+}
+
+func (srpvq *SecurityRulePackVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srpvq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srpvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srpvq.ctx.Fields {
+		if !securityrulepackversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if srpvq.path != nil {
+		prev, err := srpvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srpvq.sql = prev
+	}
+	return nil
+}
+
+func (srpvq *SecurityRulePackVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SecurityRulePackVersion, error) {
+	var (
+		nodes       = []*SecurityRulePackVersion{}
+		_spec       = srpvq.querySpec()
+		loadedTypes = [1]bool{
+			srpvq.withPack != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SecurityRulePackVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SecurityRulePackVersion{config: srpvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(srpvq.modifiers) > 0 {
+		_spec.Modifiers = srpvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srpvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := srpvq.withPack; query != nil {
+		if err := srpvq.loadPack(ctx, query, nodes, nil,
+			func(n *SecurityRulePackVersion, e *SecurityRulePack) { n.Edges.Pack = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (srpvq *SecurityRulePackVersionQuery) loadPack(ctx context.Context, query *SecurityRulePackQuery, nodes []*SecurityRulePackVersion, init func(*SecurityRulePackVersion), assign func(*SecurityRulePackVersion, *SecurityRulePack)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SecurityRulePackVersion)
+	for i := range nodes {
+		fk := nodes[i].PackID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(securityrulepack.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "pack_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (srpvq *SecurityRulePackVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srpvq.querySpec()
+	if len(srpvq.modifiers) > 0 {
+		_spec.Modifiers = srpvq.modifiers
+	}
+	_spec.Node.Columns = srpvq.ctx.Fields
+	if len(srpvq.ctx.Fields) > 0 {
+		_spec.Unique = srpvq.ctx.Unique != nil && *srpvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srpvq.driver, _spec)
+}
+
+func (srpvq *SecurityRulePackVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(securityrulepackversion.Table, securityrulepackversion.Columns, sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID))
+	_spec.From = srpvq.sql
+	if unique := srpvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srpvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srpvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityrulepackversion.FieldID)
+		for i := range fields {
+			if fields[i] != securityrulepackversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if srpvq.withPack != nil {
+			_spec.Node.AddColumnOnce(securityrulepackversion.FieldPackID)
+		}
+	}
+	if ps := srpvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srpvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srpvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srpvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srpvq *SecurityRulePackVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srpvq.driver.Dialect())
+	t1 := builder.Table(securityrulepackversion.Table)
+	columns := srpvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = securityrulepackversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srpvq.sql != nil {
+		selector = srpvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srpvq.ctx.Unique != nil && *srpvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range srpvq.modifiers {
+		m(selector)
+	}
+	for _, p := range srpvq.predicates {
+		p(selector)
+	}
+	for _, p := range srpvq.order {
+		p(selector)
+	}
+	if offset := srpvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srpvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (srpvq *SecurityRulePackVersionQuery) ForUpdate(opts ...sql.LockOption) *SecurityRulePackVersionQuery {
+	if srpvq.driver.Dialect() == dialect.Postgres {
+		srpvq.Unique(false)
+	}
+	srpvq.modifiers = append(srpvq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return srpvq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (srpvq *SecurityRulePackVersionQuery) ForShare(opts ...sql.LockOption) *SecurityRulePackVersionQuery {
+	if srpvq.driver.Dialect() == dialect.Postgres {
+		srpvq.Unique(false)
+	}
+	srpvq.modifiers = append(srpvq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return srpvq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (srpvq *SecurityRulePackVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *SecurityRulePackVersionSelect {
+	srpvq.modifiers = append(srpvq.modifiers, modifiers...)
+	return srpvq.Select()
+}
+
+// SecurityRulePackVersionGroupBy is the group-by builder for SecurityRulePackVersion entities.
+type SecurityRulePackVersionGroupBy struct {
+	selector
+	build *SecurityRulePackVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srpvgb *SecurityRulePackVersionGroupBy) Aggregate(fns ...AggregateFunc) *SecurityRulePackVersionGroupBy {
+	srpvgb.fns = append(srpvgb.fns, fns...)
+	return srpvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srpvgb *SecurityRulePackVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srpvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srpvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityRulePackVersionQuery, *SecurityRulePackVersionGroupBy](ctx, srpvgb.build, srpvgb, srpvgb.build.inters, v)
+}
+
+func (srpvgb *SecurityRulePackVersionGroupBy) sqlScan(ctx context.Context, root *SecurityRulePackVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srpvgb.fns))
+	for _, fn := range srpvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srpvgb.flds)+len(srpvgb.fns))
+		for _, f := range *srpvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srpvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srpvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SecurityRulePackVersionSelect is the builder for selecting fields of SecurityRulePackVersion entities.
+type SecurityRulePackVersionSelect struct {
+	*SecurityRulePackVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srpvs *SecurityRulePackVersionSelect) Aggregate(fns ...AggregateFunc) *SecurityRulePackVersionSelect {
+	srpvs.fns = append(srpvs.fns, fns...)
+	return srpvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srpvs *SecurityRulePackVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srpvs.ctx, ent.OpQuerySelect)
+	if err := srpvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityRulePackVersionQuery, *SecurityRulePackVersionSelect](ctx, srpvs.SecurityRulePackVersionQuery, srpvs, srpvs.inters, v)
+}
+
+func (srpvs *SecurityRulePackVersionSelect) sqlScan(ctx context.Context, root *SecurityRulePackVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srpvs.fns))
+	for _, fn := range srpvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srpvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srpvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (srpvs *SecurityRulePackVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *SecurityRulePackVersionSelect {
+	srpvs.modifiers = append(srpvs.modifiers, modifiers...)
+	return srpvs
+}
@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackVersionUpdate is the builder for updating SecurityRulePackVersion entities.
+type SecurityRulePackVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SecurityRulePackVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SecurityRulePackVersionUpdate builder.
+func (srpvu *SecurityRulePackVersionUpdate) Where(ps ...predicate.SecurityRulePackVersion) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.Where(ps...)
+	return srpvu
+}
+
+// SetPackID sets the "pack_id" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetPackID(u uuid.UUID) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.SetPackID(u)
+	return srpvu
+}
+
+// SetNillablePackID sets the "pack_id" field if the given value is not nil.
+func (srpvu *SecurityRulePackVersionUpdate) SetNillablePackID(u *uuid.UUID) *SecurityRulePackVersionUpdate {
+	if u != nil {
+		srpvu.SetPackID(*u)
+	}
+	return srpvu
+}
+
+// SetVersion sets the "version" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetVersion(i int) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.ResetVersion()
+	srpvu.mutation.SetVersion(i)
+	return srpvu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (srpvu *SecurityRulePackVersionUpdate) SetNillableVersion(i *int) *SecurityRulePackVersionUpdate {
+	if i != nil {
+		srpvu.SetVersion(*i)
+	}
+	return srpvu
+}
+
+// AddVersion adds i to the "version" field.
+func (srpvu *SecurityRulePackVersionUpdate) AddVersion(i int) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.AddVersion(i)
+	return srpvu
+}
+
+// SetContent sets the "content" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetContent(s string) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.SetContent(s)
+	return srpvu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (srpvu *SecurityRulePackVersionUpdate) SetNillableContent(s *string) *SecurityRulePackVersionUpdate {
+	if s != nil {
+		srpvu.SetContent(*s)
+	}
+	return srpvu
+}
+
+// SetChecksum sets the "checksum" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetChecksum(s string) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.SetChecksum(s)
+	return srpvu
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (srpvu *SecurityRulePackVersionUpdate) SetNillableChecksum(s *string) *SecurityRulePackVersionUpdate {
+	if s != nil {
+		srpvu.SetChecksum(*s)
+	}
+	return srpvu
+}
+
+// SetRuleIds sets the "rule_ids" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetRuleIds(s []string) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.SetRuleIds(s)
+	return srpvu
+}
+
+// AppendRuleIds appends s to the "rule_ids" field.
+func (srpvu *SecurityRulePackVersionUpdate) AppendRuleIds(s []string) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.AppendRuleIds(s)
+	return srpvu
+}
+
+// ClearRuleIds clears the value of the "rule_ids" field.
+func (srpvu *SecurityRulePackVersionUpdate) ClearRuleIds() *SecurityRulePackVersionUpdate {
+	srpvu.mutation.ClearRuleIds()
+	return srpvu
+}
+
+// SetCreator sets the "creator" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetCreator(s string) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.SetCreator(s)
+	return srpvu
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (srpvu *SecurityRulePackVersionUpdate) SetNillableCreator(s *string) *SecurityRulePackVersionUpdate {
+	if s != nil {
+		srpvu.SetCreator(*s)
+	}
+	return srpvu
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (srpvu *SecurityRulePackVersionUpdate) ClearCreator() *SecurityRulePackVersionUpdate {
+	srpvu.mutation.ClearCreator()
+	return srpvu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (srpvu *SecurityRulePackVersionUpdate) SetCreatedAt(t time.Time) *SecurityRulePackVersionUpdate {
+	srpvu.mutation.SetCreatedAt(t)
+	return srpvu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (srpvu *SecurityRulePackVersionUpdate) SetNillableCreatedAt(t *time.Time) *SecurityRulePackVersionUpdate {
+	if t != nil {
+		srpvu.SetCreatedAt(*t)
+	}
+	return srpvu
+}
+
+// SetPack sets the "pack" edge to the SecurityRulePack entity.
+func (srpvu *SecurityRulePackVersionUpdate) SetPack(s *SecurityRulePack) *SecurityRulePackVersionUpdate {
+	return srpvu.SetPackID(s.ID)
+}
+
+// Mutation returns the SecurityRulePackVersionMutation object of the builder.
+func (srpvu *SecurityRulePackVersionUpdate) Mutation() *SecurityRulePackVersionMutation {
+	return srpvu.mutation
+}
+
+// ClearPack clears the "pack" edge to the SecurityRulePack entity.
+func (srpvu *SecurityRulePackVersionUpdate) ClearPack() *SecurityRulePackVersionUpdate {
+	srpvu.mutation.ClearPack()
+	return srpvu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (srpvu *SecurityRulePackVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, srpvu.sqlSave, srpvu.mutation, srpvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srpvu *SecurityRulePackVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := srpvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (srpvu *SecurityRulePackVersionUpdate) Exec(ctx context.Context) error {
+	_, err := srpvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpvu *SecurityRulePackVersionUpdate) ExecX(ctx context.Context) {
+	if err := srpvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srpvu *SecurityRulePackVersionUpdate) check() error {
+	if srpvu.mutation.PackCleared() && len(srpvu.mutation.PackIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "SecurityRulePackVersion.pack"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (srpvu *SecurityRulePackVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityRulePackVersionUpdate {
+	srpvu.modifiers = append(srpvu.modifiers, modifiers...)
+	return srpvu
+}
+
+func (srpvu *SecurityRulePackVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := srpvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityrulepackversion.Table, securityrulepackversion.Columns, sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID))
+	if ps := srpvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := srpvu.mutation.Version(); ok {
+		_spec.SetField(securityrulepackversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpvu.mutation.AddedVersion(); ok {
+		_spec.AddField(securityrulepackversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpvu.mutation.Content(); ok {
+		_spec.SetField(securityrulepackversion.FieldContent, field.TypeString, value)
+	}
+	if value, ok := srpvu.mutation.Checksum(); ok {
+		_spec.SetField(securityrulepackversion.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := srpvu.mutation.RuleIds(); ok {
+		_spec.SetField(securityrulepackversion.FieldRuleIds, field.TypeJSON, value)
+	}
+	if value, ok := srpvu.mutation.AppendedRuleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, securityrulepackversion.FieldRuleIds, value)
+		})
+	}
+	if srpvu.mutation.RuleIdsCleared() {
+		_spec.ClearField(securityrulepackversion.FieldRuleIds, field.TypeJSON)
+	}
+	if value, ok := srpvu.mutation.Creator(); ok {
+		_spec.SetField(securityrulepackversion.FieldCreator, field.TypeString, value)
+	}
+	if srpvu.mutation.CreatorCleared() {
+		_spec.ClearField(securityrulepackversion.FieldCreator, field.TypeString)
+	}
+	if value, ok := srpvu.mutation.CreatedAt(); ok {
+		_spec.SetField(securityrulepackversion.FieldCreatedAt, field.TypeTime, value)
+	}
+	if srpvu.mutation.PackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityrulepackversion.PackTable,
+			Columns: []string{securityrulepackversion.PackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := srpvu.mutation.PackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityrulepackversion.PackTable,
+			Columns: []string{securityrulepackversion.PackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(srpvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, srpvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityrulepackversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	srpvu.mutation.done = true
+	return n, nil
+}
+
+// SecurityRulePackVersionUpdateOne is the builder for updating a single SecurityRulePackVersion entity.
+type SecurityRulePackVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SecurityRulePackVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetPackID sets the "pack_id" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetPackID(u uuid.UUID) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.SetPackID(u)
+	return srpvuo
+}
+
+// SetNillablePackID sets the "pack_id" field if the given value is not nil.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetNillablePackID(u *uuid.UUID) *SecurityRulePackVersionUpdateOne {
+	if u != nil {
+		srpvuo.SetPackID(*u)
+	}
+	return srpvuo
+}
+
+// SetVersion sets the "version" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetVersion(i int) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.ResetVersion()
+	srpvuo.mutation.SetVersion(i)
+	return srpvuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetNillableVersion(i *int) *SecurityRulePackVersionUpdateOne {
+	if i != nil {
+		srpvuo.SetVersion(*i)
+	}
+	return srpvuo
+}
+
+// AddVersion adds i to the "version" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) AddVersion(i int) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.AddVersion(i)
+	return srpvuo
+}
+
+// SetContent sets the "content" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetContent(s string) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.SetContent(s)
+	return srpvuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetNillableContent(s *string) *SecurityRulePackVersionUpdateOne {
+	if s != nil {
+		srpvuo.SetContent(*s)
+	}
+	return srpvuo
+}
+
+// SetChecksum sets the "checksum" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetChecksum(s string) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.SetChecksum(s)
+	return srpvuo
+}
+
+// SetNillableChecksum sets the "checksum" field if the given value is not nil.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetNillableChecksum(s *string) *SecurityRulePackVersionUpdateOne {
+	if s != nil {
+		srpvuo.SetChecksum(*s)
+	}
+	return srpvuo
+}
+
+// SetRuleIds sets the "rule_ids" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetRuleIds(s []string) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.SetRuleIds(s)
+	return srpvuo
+}
+
+// AppendRuleIds appends s to the "rule_ids" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) AppendRuleIds(s []string) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.AppendRuleIds(s)
+	return srpvuo
+}
+
+// ClearRuleIds clears the value of the "rule_ids" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) ClearRuleIds() *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.ClearRuleIds()
+	return srpvuo
+}
+
+// SetCreator sets the "creator" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetCreator(s string) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.SetCreator(s)
+	return srpvuo
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetNillableCreator(s *string) *SecurityRulePackVersionUpdateOne {
+	if s != nil {
+		srpvuo.SetCreator(*s)
+	}
+	return srpvuo
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) ClearCreator() *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.ClearCreator()
+	return srpvuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetCreatedAt(t time.Time) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.SetCreatedAt(t)
+	return srpvuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetNillableCreatedAt(t *time.Time) *SecurityRulePackVersionUpdateOne {
+	if t != nil {
+		srpvuo.SetCreatedAt(*t)
+	}
+	return srpvuo
+}
+
+// SetPack sets the "pack" edge to the SecurityRulePack entity.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SetPack(s *SecurityRulePack) *SecurityRulePackVersionUpdateOne {
+	return srpvuo.SetPackID(s.ID)
+}
+
+// Mutation returns the SecurityRulePackVersionMutation object of the builder.
+func (srpvuo *SecurityRulePackVersionUpdateOne) Mutation() *SecurityRulePackVersionMutation {
+	return srpvuo.mutation
+}
+
+// ClearPack clears the "pack" edge to the SecurityRulePack entity.
+func (srpvuo *SecurityRulePackVersionUpdateOne) ClearPack() *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.ClearPack()
+	return srpvuo
+}
+
+// Where appends a list predicates to the SecurityRulePackVersionUpdate builder.
+func (srpvuo *SecurityRulePackVersionUpdateOne) Where(ps ...predicate.SecurityRulePackVersion) *SecurityRulePackVersionUpdateOne {
+	srpvuo.mutation.Where(ps...)
+	return srpvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (srpvuo *SecurityRulePackVersionUpdateOne) Select(field string, fields ...string) *SecurityRulePackVersionUpdateOne {
+	srpvuo.fields = append([]string{field}, fields...)
+	return srpvuo
+}
+
+// Save executes the query and returns the updated SecurityRulePackVersion entity.
+func (srpvuo *SecurityRulePackVersionUpdateOne) Save(ctx context.Context) (*SecurityRulePackVersion, error) {
+	return withHooks(ctx, srpvuo.sqlSave, srpvuo.mutation, srpvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srpvuo *SecurityRulePackVersionUpdateOne) SaveX(ctx context.Context) *SecurityRulePackVersion {
+	node, err := srpvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (srpvuo *SecurityRulePackVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := srpvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srpvuo *SecurityRulePackVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := srpvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (srpvuo *SecurityRulePackVersionUpdateOne) check() error {
+	if srpvuo.mutation.PackCleared() && len(srpvuo.mutation.PackIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "SecurityRulePackVersion.pack"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (srpvuo *SecurityRulePackVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityRulePackVersionUpdateOne {
+	srpvuo.modifiers = append(srpvuo.modifiers, modifiers...)
+	return srpvuo
+}
+
+func (srpvuo *SecurityRulePackVersionUpdateOne) sqlSave(ctx context.Context) (_node *SecurityRulePackVersion, err error) {
+	if err := srpvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityrulepackversion.Table, securityrulepackversion.Columns, sqlgraph.NewFieldSpec(securityrulepackversion.FieldID, field.TypeUUID))
+	id, ok := srpvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SecurityRulePackVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := srpvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityrulepackversion.FieldID)
+		for _, f := range fields {
+			if !securityrulepackversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != securityrulepackversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := srpvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := srpvuo.mutation.Version(); ok {
+		_spec.SetField(securityrulepackversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpvuo.mutation.AddedVersion(); ok {
+		_spec.AddField(securityrulepackversion.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := srpvuo.mutation.Content(); ok {
+		_spec.SetField(securityrulepackversion.FieldContent, field.TypeString, value)
+	}
+	if value, ok := srpvuo.mutation.Checksum(); ok {
+		_spec.SetField(securityrulepackversion.FieldChecksum, field.TypeString, value)
+	}
+	if value, ok := srpvuo.mutation.RuleIds(); ok {
+		_spec.SetField(securityrulepackversion.FieldRuleIds, field.TypeJSON, value)
+	}
+	if value, ok := srpvuo.mutation.AppendedRuleIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, securityrulepackversion.FieldRuleIds, value)
+		})
+	}
+	if srpvuo.mutation.RuleIdsCleared() {
+		_spec.ClearField(securityrulepackversion.FieldRuleIds, field.TypeJSON)
+	}
+	if value, ok := srpvuo.mutation.Creator(); ok {
+		_spec.SetField(securityrulepackversion.FieldCreator, field.TypeString, value)
+	}
+	if srpvuo.mutation.CreatorCleared() {
+		_spec.ClearField(securityrulepackversion.FieldCreator, field.TypeString)
+	}
+	if value, ok := srpvuo.mutation.CreatedAt(); ok {
+		_spec.SetField(securityrulepackversion.FieldCreatedAt, field.TypeTime, value)
+	}
+	if srpvuo.mutation.PackCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityrulepackversion.PackTable,
+			Columns: []string{securityrulepackversion.PackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := srpvuo.mutation.PackIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityrulepackversion.PackTable,
+			Columns: []string{securityrulepackversion.PackColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityrulepack.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(srpvuo.modifiers...)
+	_node = &SecurityRulePackVersion{config: srpvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, srpvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityrulepackversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	srpvuo.mutation.done = true
+	return _node, nil
+}
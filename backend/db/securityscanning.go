@@ -3,6 +3,7 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/workspace"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -35,6 +37,18 @@ type SecurityScanning struct {
 	Rule string `json:"rule,omitempty"`
 	// ErrorMessage holds the value of the "error_message" field.
 	ErrorMessage string `json:"error_message,omitempty"`
+	// 导入的外部扫描工具名称，为空表示内置扫描
+	Tool string `json:"tool,omitempty"`
+	// 扫描策略名称，为空表示只使用内置语言规则
+	Policy string `json:"policy,omitempty"`
+	// 增量扫描对比的上一次扫描，为空表示全量扫描
+	BaseID *uuid.UUID `json:"base_id,omitempty"`
+	// 扫描时工作区文件的哈希，key 为相对路径
+	FileHashes map[string]string `json:"file_hashes,omitempty"`
+	// 本次实际扫描的文件数
+	ScannedFiles int `json:"scanned_files,omitempty"`
+	// 自动识别语言时每个规则集的子扫描进度
+	SubScans []*types.SecurityScanningSubScan `json:"sub_scans,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -94,7 +108,13 @@ func (*SecurityScanning) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case securityscanning.FieldStatus, securityscanning.FieldWorkspace, securityscanning.FieldLanguage, securityscanning.FieldRule, securityscanning.FieldErrorMessage:
+		case securityscanning.FieldBaseID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case securityscanning.FieldFileHashes, securityscanning.FieldSubScans:
+			values[i] = new([]byte)
+		case securityscanning.FieldScannedFiles:
+			values[i] = new(sql.NullInt64)
+		case securityscanning.FieldStatus, securityscanning.FieldWorkspace, securityscanning.FieldLanguage, securityscanning.FieldRule, securityscanning.FieldErrorMessage, securityscanning.FieldTool, securityscanning.FieldPolicy:
 			values[i] = new(sql.NullString)
 		case securityscanning.FieldCreatedAt, securityscanning.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -163,6 +183,47 @@ func (ss *SecurityScanning) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ss.ErrorMessage = value.String
 			}
+		case securityscanning.FieldTool:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tool", values[i])
+			} else if value.Valid {
+				ss.Tool = value.String
+			}
+		case securityscanning.FieldPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field policy", values[i])
+			} else if value.Valid {
+				ss.Policy = value.String
+			}
+		case securityscanning.FieldBaseID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field base_id", values[i])
+			} else if value.Valid {
+				ss.BaseID = new(uuid.UUID)
+				*ss.BaseID = *value.S.(*uuid.UUID)
+			}
+		case securityscanning.FieldFileHashes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field file_hashes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.FileHashes); err != nil {
+					return fmt.Errorf("unmarshal field file_hashes: %w", err)
+				}
+			}
+		case securityscanning.FieldScannedFiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field scanned_files", values[i])
+			} else if value.Valid {
+				ss.ScannedFiles = int(value.Int64)
+			}
+		case securityscanning.FieldSubScans:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field sub_scans", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ss.SubScans); err != nil {
+					return fmt.Errorf("unmarshal field sub_scans: %w", err)
+				}
+			}
 		case securityscanning.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -247,6 +308,26 @@ func (ss *SecurityScanning) String() string {
 	builder.WriteString("error_message=")
 	builder.WriteString(ss.ErrorMessage)
 	builder.WriteString(", ")
+	builder.WriteString("tool=")
+	builder.WriteString(ss.Tool)
+	builder.WriteString(", ")
+	builder.WriteString("policy=")
+	builder.WriteString(ss.Policy)
+	builder.WriteString(", ")
+	if v := ss.BaseID; v != nil {
+		builder.WriteString("base_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("file_hashes=")
+	builder.WriteString(fmt.Sprintf("%v", ss.FileHashes))
+	builder.WriteString(", ")
+	builder.WriteString("scanned_files=")
+	builder.WriteString(fmt.Sprintf("%v", ss.ScannedFiles))
+	builder.WriteString(", ")
+	builder.WriteString("sub_scans=")
+	builder.WriteString(fmt.Sprintf("%v", ss.SubScans))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -28,6 +28,18 @@ const (
 	FieldRule = "rule"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
+	// FieldTool holds the string denoting the tool field in the database.
+	FieldTool = "tool"
+	// FieldPolicy holds the string denoting the policy field in the database.
+	FieldPolicy = "policy"
+	// FieldBaseID holds the string denoting the base_id field in the database.
+	FieldBaseID = "base_id"
+	// FieldFileHashes holds the string denoting the file_hashes field in the database.
+	FieldFileHashes = "file_hashes"
+	// FieldScannedFiles holds the string denoting the scanned_files field in the database.
+	FieldScannedFiles = "scanned_files"
+	// FieldSubScans holds the string denoting the sub_scans field in the database.
+	FieldSubScans = "sub_scans"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -73,6 +85,12 @@ var Columns = []string{
 	FieldLanguage,
 	FieldRule,
 	FieldErrorMessage,
+	FieldTool,
+	FieldPolicy,
+	FieldBaseID,
+	FieldFileHashes,
+	FieldScannedFiles,
+	FieldSubScans,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -88,6 +106,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultScannedFiles holds the default value on creation for the "scanned_files" field.
+	DefaultScannedFiles int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -137,6 +157,26 @@ func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
 }
 
+// ByTool orders the results by the tool field.
+func ByTool(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTool, opts...).ToFunc()
+}
+
+// ByPolicy orders the results by the policy field.
+func ByPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicy, opts...).ToFunc()
+}
+
+// ByBaseID orders the results by the base_id field.
+func ByBaseID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBaseID, opts...).ToFunc()
+}
+
+// ByScannedFiles orders the results by the scanned_files field.
+func ByScannedFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScannedFiles, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -94,6 +94,21 @@ func ErrorMessage(v string) predicate.SecurityScanning {
 	return predicate.SecurityScanning(sql.FieldEQ(FieldErrorMessage, v))
 }
 
+// Tool applies equality check predicate on the "tool" field. It's identical to ToolEQ.
+func Tool(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldTool, v))
+}
+
+// BaseID applies equality check predicate on the "base_id" field. It's identical to BaseIDEQ.
+func BaseID(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldBaseID, v))
+}
+
+// ScannedFiles applies equality check predicate on the "scanned_files" field. It's identical to ScannedFilesEQ.
+func ScannedFiles(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldScannedFiles, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.SecurityScanning {
 	return predicate.SecurityScanning(sql.FieldEQ(FieldCreatedAt, v))
@@ -527,6 +542,266 @@ func ErrorMessageContainsFold(v string) predicate.SecurityScanning {
 	return predicate.SecurityScanning(sql.FieldContainsFold(FieldErrorMessage, v))
 }
 
+// ToolEQ applies the EQ predicate on the "tool" field.
+func ToolEQ(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldTool, v))
+}
+
+// ToolNEQ applies the NEQ predicate on the "tool" field.
+func ToolNEQ(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNEQ(FieldTool, v))
+}
+
+// ToolIn applies the In predicate on the "tool" field.
+func ToolIn(vs ...string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIn(FieldTool, vs...))
+}
+
+// ToolNotIn applies the NotIn predicate on the "tool" field.
+func ToolNotIn(vs ...string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotIn(FieldTool, vs...))
+}
+
+// ToolGT applies the GT predicate on the "tool" field.
+func ToolGT(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGT(FieldTool, v))
+}
+
+// ToolGTE applies the GTE predicate on the "tool" field.
+func ToolGTE(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGTE(FieldTool, v))
+}
+
+// ToolLT applies the LT predicate on the "tool" field.
+func ToolLT(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLT(FieldTool, v))
+}
+
+// ToolLTE applies the LTE predicate on the "tool" field.
+func ToolLTE(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLTE(FieldTool, v))
+}
+
+// ToolContains applies the Contains predicate on the "tool" field.
+func ToolContains(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldContains(FieldTool, v))
+}
+
+// ToolHasPrefix applies the HasPrefix predicate on the "tool" field.
+func ToolHasPrefix(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldHasPrefix(FieldTool, v))
+}
+
+// ToolHasSuffix applies the HasSuffix predicate on the "tool" field.
+func ToolHasSuffix(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldHasSuffix(FieldTool, v))
+}
+
+// ToolIsNil applies the IsNil predicate on the "tool" field.
+func ToolIsNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIsNull(FieldTool))
+}
+
+// ToolNotNil applies the NotNil predicate on the "tool" field.
+func ToolNotNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotNull(FieldTool))
+}
+
+// ToolEqualFold applies the EqualFold predicate on the "tool" field.
+func ToolEqualFold(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEqualFold(FieldTool, v))
+}
+
+// ToolContainsFold applies the ContainsFold predicate on the "tool" field.
+func ToolContainsFold(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldContainsFold(FieldTool, v))
+}
+
+// PolicyEQ applies the EQ predicate on the "policy" field.
+func PolicyEQ(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldPolicy, v))
+}
+
+// PolicyNEQ applies the NEQ predicate on the "policy" field.
+func PolicyNEQ(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNEQ(FieldPolicy, v))
+}
+
+// PolicyIn applies the In predicate on the "policy" field.
+func PolicyIn(vs ...string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIn(FieldPolicy, vs...))
+}
+
+// PolicyNotIn applies the NotIn predicate on the "policy" field.
+func PolicyNotIn(vs ...string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotIn(FieldPolicy, vs...))
+}
+
+// PolicyGT applies the GT predicate on the "policy" field.
+func PolicyGT(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGT(FieldPolicy, v))
+}
+
+// PolicyGTE applies the GTE predicate on the "policy" field.
+func PolicyGTE(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGTE(FieldPolicy, v))
+}
+
+// PolicyLT applies the LT predicate on the "policy" field.
+func PolicyLT(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLT(FieldPolicy, v))
+}
+
+// PolicyLTE applies the LTE predicate on the "policy" field.
+func PolicyLTE(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLTE(FieldPolicy, v))
+}
+
+// PolicyContains applies the Contains predicate on the "policy" field.
+func PolicyContains(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldContains(FieldPolicy, v))
+}
+
+// PolicyHasPrefix applies the HasPrefix predicate on the "policy" field.
+func PolicyHasPrefix(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldHasPrefix(FieldPolicy, v))
+}
+
+// PolicyHasSuffix applies the HasSuffix predicate on the "policy" field.
+func PolicyHasSuffix(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldHasSuffix(FieldPolicy, v))
+}
+
+// PolicyIsNil applies the IsNil predicate on the "policy" field.
+func PolicyIsNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIsNull(FieldPolicy))
+}
+
+// PolicyNotNil applies the NotNil predicate on the "policy" field.
+func PolicyNotNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotNull(FieldPolicy))
+}
+
+// PolicyEqualFold applies the EqualFold predicate on the "policy" field.
+func PolicyEqualFold(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEqualFold(FieldPolicy, v))
+}
+
+// PolicyContainsFold applies the ContainsFold predicate on the "policy" field.
+func PolicyContainsFold(v string) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldContainsFold(FieldPolicy, v))
+}
+
+// BaseIDEQ applies the EQ predicate on the "base_id" field.
+func BaseIDEQ(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldBaseID, v))
+}
+
+// BaseIDNEQ applies the NEQ predicate on the "base_id" field.
+func BaseIDNEQ(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNEQ(FieldBaseID, v))
+}
+
+// BaseIDIn applies the In predicate on the "base_id" field.
+func BaseIDIn(vs ...uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIn(FieldBaseID, vs...))
+}
+
+// BaseIDNotIn applies the NotIn predicate on the "base_id" field.
+func BaseIDNotIn(vs ...uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotIn(FieldBaseID, vs...))
+}
+
+// BaseIDGT applies the GT predicate on the "base_id" field.
+func BaseIDGT(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGT(FieldBaseID, v))
+}
+
+// BaseIDGTE applies the GTE predicate on the "base_id" field.
+func BaseIDGTE(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGTE(FieldBaseID, v))
+}
+
+// BaseIDLT applies the LT predicate on the "base_id" field.
+func BaseIDLT(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLT(FieldBaseID, v))
+}
+
+// BaseIDLTE applies the LTE predicate on the "base_id" field.
+func BaseIDLTE(v uuid.UUID) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLTE(FieldBaseID, v))
+}
+
+// BaseIDIsNil applies the IsNil predicate on the "base_id" field.
+func BaseIDIsNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIsNull(FieldBaseID))
+}
+
+// BaseIDNotNil applies the NotNil predicate on the "base_id" field.
+func BaseIDNotNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotNull(FieldBaseID))
+}
+
+// FileHashesIsNil applies the IsNil predicate on the "file_hashes" field.
+func FileHashesIsNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIsNull(FieldFileHashes))
+}
+
+// FileHashesNotNil applies the NotNil predicate on the "file_hashes" field.
+func FileHashesNotNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotNull(FieldFileHashes))
+}
+
+// ScannedFilesEQ applies the EQ predicate on the "scanned_files" field.
+func ScannedFilesEQ(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldEQ(FieldScannedFiles, v))
+}
+
+// ScannedFilesNEQ applies the NEQ predicate on the "scanned_files" field.
+func ScannedFilesNEQ(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNEQ(FieldScannedFiles, v))
+}
+
+// ScannedFilesIn applies the In predicate on the "scanned_files" field.
+func ScannedFilesIn(vs ...int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIn(FieldScannedFiles, vs...))
+}
+
+// ScannedFilesNotIn applies the NotIn predicate on the "scanned_files" field.
+func ScannedFilesNotIn(vs ...int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotIn(FieldScannedFiles, vs...))
+}
+
+// ScannedFilesGT applies the GT predicate on the "scanned_files" field.
+func ScannedFilesGT(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGT(FieldScannedFiles, v))
+}
+
+// ScannedFilesGTE applies the GTE predicate on the "scanned_files" field.
+func ScannedFilesGTE(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldGTE(FieldScannedFiles, v))
+}
+
+// ScannedFilesLT applies the LT predicate on the "scanned_files" field.
+func ScannedFilesLT(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLT(FieldScannedFiles, v))
+}
+
+// ScannedFilesLTE applies the LTE predicate on the "scanned_files" field.
+func ScannedFilesLTE(v int) predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldLTE(FieldScannedFiles, v))
+}
+
+// SubScansIsNil applies the IsNil predicate on the "sub_scans" field.
+func SubScansIsNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldIsNull(FieldSubScans))
+}
+
+// SubScansNotNil applies the NotNil predicate on the "sub_scans" field.
+func SubScansNotNil() predicate.SecurityScanning {
+	return predicate.SecurityScanning(sql.FieldNotNull(FieldSubScans))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.SecurityScanning {
 	return predicate.SecurityScanning(sql.FieldEQ(FieldCreatedAt, v))
@@ -17,6 +17,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/workspace"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -86,6 +87,74 @@ func (ssc *SecurityScanningCreate) SetNillableErrorMessage(s *string) *SecurityS
 	return ssc
 }
 
+// SetTool sets the "tool" field.
+func (ssc *SecurityScanningCreate) SetTool(s string) *SecurityScanningCreate {
+	ssc.mutation.SetTool(s)
+	return ssc
+}
+
+// SetNillableTool sets the "tool" field if the given value is not nil.
+func (ssc *SecurityScanningCreate) SetNillableTool(s *string) *SecurityScanningCreate {
+	if s != nil {
+		ssc.SetTool(*s)
+	}
+	return ssc
+}
+
+// SetPolicy sets the "policy" field.
+func (ssc *SecurityScanningCreate) SetPolicy(s string) *SecurityScanningCreate {
+	ssc.mutation.SetPolicy(s)
+	return ssc
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (ssc *SecurityScanningCreate) SetNillablePolicy(s *string) *SecurityScanningCreate {
+	if s != nil {
+		ssc.SetPolicy(*s)
+	}
+	return ssc
+}
+
+// SetBaseID sets the "base_id" field.
+func (ssc *SecurityScanningCreate) SetBaseID(u uuid.UUID) *SecurityScanningCreate {
+	ssc.mutation.SetBaseID(u)
+	return ssc
+}
+
+// SetNillableBaseID sets the "base_id" field if the given value is not nil.
+func (ssc *SecurityScanningCreate) SetNillableBaseID(u *uuid.UUID) *SecurityScanningCreate {
+	if u != nil {
+		ssc.SetBaseID(*u)
+	}
+	return ssc
+}
+
+// SetFileHashes sets the "file_hashes" field.
+func (ssc *SecurityScanningCreate) SetFileHashes(m map[string]string) *SecurityScanningCreate {
+	ssc.mutation.SetFileHashes(m)
+	return ssc
+}
+
+// SetScannedFiles sets the "scanned_files" field.
+func (ssc *SecurityScanningCreate) SetScannedFiles(i int) *SecurityScanningCreate {
+	ssc.mutation.SetScannedFiles(i)
+	return ssc
+}
+
+// SetNillableScannedFiles sets the "scanned_files" field if the given value is not nil.
+func (ssc *SecurityScanningCreate) SetNillableScannedFiles(i *int) *SecurityScanningCreate {
+	if i != nil {
+		ssc.SetScannedFiles(*i)
+	}
+	return ssc
+}
+
+// SetSubScans sets the "sub_scans" field.
+func (ssc *SecurityScanningCreate) SetSubScans(tsss []*types.SecurityScanningSubScan) *SecurityScanningCreate {
+	ssc.mutation.SetSubScans(tsss)
+	return ssc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ssc *SecurityScanningCreate) SetCreatedAt(t time.Time) *SecurityScanningCreate {
 	ssc.mutation.SetCreatedAt(t)
@@ -186,6 +255,10 @@ func (ssc *SecurityScanningCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ssc *SecurityScanningCreate) defaults() {
+	if _, ok := ssc.mutation.ScannedFiles(); !ok {
+		v := securityscanning.DefaultScannedFiles
+		ssc.mutation.SetScannedFiles(v)
+	}
 	if _, ok := ssc.mutation.CreatedAt(); !ok {
 		v := securityscanning.DefaultCreatedAt()
 		ssc.mutation.SetCreatedAt(v)
@@ -213,6 +286,9 @@ func (ssc *SecurityScanningCreate) check() error {
 	if _, ok := ssc.mutation.Language(); !ok {
 		return &ValidationError{Name: "language", err: errors.New(`db: missing required field "SecurityScanning.language"`)}
 	}
+	if _, ok := ssc.mutation.ScannedFiles(); !ok {
+		return &ValidationError{Name: "scanned_files", err: errors.New(`db: missing required field "SecurityScanning.scanned_files"`)}
+	}
 	if _, ok := ssc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityScanning.created_at"`)}
 	}
@@ -281,6 +357,30 @@ func (ssc *SecurityScanningCreate) createSpec() (*SecurityScanning, *sqlgraph.Cr
 		_spec.SetField(securityscanning.FieldErrorMessage, field.TypeString, value)
 		_node.ErrorMessage = value
 	}
+	if value, ok := ssc.mutation.Tool(); ok {
+		_spec.SetField(securityscanning.FieldTool, field.TypeString, value)
+		_node.Tool = value
+	}
+	if value, ok := ssc.mutation.Policy(); ok {
+		_spec.SetField(securityscanning.FieldPolicy, field.TypeString, value)
+		_node.Policy = value
+	}
+	if value, ok := ssc.mutation.BaseID(); ok {
+		_spec.SetField(securityscanning.FieldBaseID, field.TypeUUID, value)
+		_node.BaseID = &value
+	}
+	if value, ok := ssc.mutation.FileHashes(); ok {
+		_spec.SetField(securityscanning.FieldFileHashes, field.TypeJSON, value)
+		_node.FileHashes = value
+	}
+	if value, ok := ssc.mutation.ScannedFiles(); ok {
+		_spec.SetField(securityscanning.FieldScannedFiles, field.TypeInt, value)
+		_node.ScannedFiles = value
+	}
+	if value, ok := ssc.mutation.SubScans(); ok {
+		_spec.SetField(securityscanning.FieldSubScans, field.TypeJSON, value)
+		_node.SubScans = value
+	}
 	if value, ok := ssc.mutation.CreatedAt(); ok {
 		_spec.SetField(securityscanning.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -487,6 +587,114 @@ func (u *SecurityScanningUpsert) ClearErrorMessage() *SecurityScanningUpsert {
 	return u
 }
 
+// SetTool sets the "tool" field.
+func (u *SecurityScanningUpsert) SetTool(v string) *SecurityScanningUpsert {
+	u.Set(securityscanning.FieldTool, v)
+	return u
+}
+
+// UpdateTool sets the "tool" field to the value that was provided on create.
+func (u *SecurityScanningUpsert) UpdateTool() *SecurityScanningUpsert {
+	u.SetExcluded(securityscanning.FieldTool)
+	return u
+}
+
+// ClearTool clears the value of the "tool" field.
+func (u *SecurityScanningUpsert) ClearTool() *SecurityScanningUpsert {
+	u.SetNull(securityscanning.FieldTool)
+	return u
+}
+
+// SetPolicy sets the "policy" field.
+func (u *SecurityScanningUpsert) SetPolicy(v string) *SecurityScanningUpsert {
+	u.Set(securityscanning.FieldPolicy, v)
+	return u
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *SecurityScanningUpsert) UpdatePolicy() *SecurityScanningUpsert {
+	u.SetExcluded(securityscanning.FieldPolicy)
+	return u
+}
+
+// ClearPolicy clears the value of the "policy" field.
+func (u *SecurityScanningUpsert) ClearPolicy() *SecurityScanningUpsert {
+	u.SetNull(securityscanning.FieldPolicy)
+	return u
+}
+
+// SetBaseID sets the "base_id" field.
+func (u *SecurityScanningUpsert) SetBaseID(v uuid.UUID) *SecurityScanningUpsert {
+	u.Set(securityscanning.FieldBaseID, v)
+	return u
+}
+
+// UpdateBaseID sets the "base_id" field to the value that was provided on create.
+func (u *SecurityScanningUpsert) UpdateBaseID() *SecurityScanningUpsert {
+	u.SetExcluded(securityscanning.FieldBaseID)
+	return u
+}
+
+// ClearBaseID clears the value of the "base_id" field.
+func (u *SecurityScanningUpsert) ClearBaseID() *SecurityScanningUpsert {
+	u.SetNull(securityscanning.FieldBaseID)
+	return u
+}
+
+// SetFileHashes sets the "file_hashes" field.
+func (u *SecurityScanningUpsert) SetFileHashes(v map[string]string) *SecurityScanningUpsert {
+	u.Set(securityscanning.FieldFileHashes, v)
+	return u
+}
+
+// UpdateFileHashes sets the "file_hashes" field to the value that was provided on create.
+func (u *SecurityScanningUpsert) UpdateFileHashes() *SecurityScanningUpsert {
+	u.SetExcluded(securityscanning.FieldFileHashes)
+	return u
+}
+
+// ClearFileHashes clears the value of the "file_hashes" field.
+func (u *SecurityScanningUpsert) ClearFileHashes() *SecurityScanningUpsert {
+	u.SetNull(securityscanning.FieldFileHashes)
+	return u
+}
+
+// SetScannedFiles sets the "scanned_files" field.
+func (u *SecurityScanningUpsert) SetScannedFiles(v int) *SecurityScanningUpsert {
+	u.Set(securityscanning.FieldScannedFiles, v)
+	return u
+}
+
+// UpdateScannedFiles sets the "scanned_files" field to the value that was provided on create.
+func (u *SecurityScanningUpsert) UpdateScannedFiles() *SecurityScanningUpsert {
+	u.SetExcluded(securityscanning.FieldScannedFiles)
+	return u
+}
+
+// AddScannedFiles adds v to the "scanned_files" field.
+func (u *SecurityScanningUpsert) AddScannedFiles(v int) *SecurityScanningUpsert {
+	u.Add(securityscanning.FieldScannedFiles, v)
+	return u
+}
+
+// SetSubScans sets the "sub_scans" field.
+func (u *SecurityScanningUpsert) SetSubScans(v []*types.SecurityScanningSubScan) *SecurityScanningUpsert {
+	u.Set(securityscanning.FieldSubScans, v)
+	return u
+}
+
+// UpdateSubScans sets the "sub_scans" field to the value that was provided on create.
+func (u *SecurityScanningUpsert) UpdateSubScans() *SecurityScanningUpsert {
+	u.SetExcluded(securityscanning.FieldSubScans)
+	return u
+}
+
+// ClearSubScans clears the value of the "sub_scans" field.
+func (u *SecurityScanningUpsert) ClearSubScans() *SecurityScanningUpsert {
+	u.SetNull(securityscanning.FieldSubScans)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SecurityScanningUpsert) SetCreatedAt(v time.Time) *SecurityScanningUpsert {
 	u.Set(securityscanning.FieldCreatedAt, v)
@@ -671,6 +879,132 @@ func (u *SecurityScanningUpsertOne) ClearErrorMessage() *SecurityScanningUpsertO
 	})
 }
 
+// SetTool sets the "tool" field.
+func (u *SecurityScanningUpsertOne) SetTool(v string) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetTool(v)
+	})
+}
+
+// UpdateTool sets the "tool" field to the value that was provided on create.
+func (u *SecurityScanningUpsertOne) UpdateTool() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateTool()
+	})
+}
+
+// ClearTool clears the value of the "tool" field.
+func (u *SecurityScanningUpsertOne) ClearTool() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearTool()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *SecurityScanningUpsertOne) SetPolicy(v string) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *SecurityScanningUpsertOne) UpdatePolicy() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// ClearPolicy clears the value of the "policy" field.
+func (u *SecurityScanningUpsertOne) ClearPolicy() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearPolicy()
+	})
+}
+
+// SetBaseID sets the "base_id" field.
+func (u *SecurityScanningUpsertOne) SetBaseID(v uuid.UUID) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetBaseID(v)
+	})
+}
+
+// UpdateBaseID sets the "base_id" field to the value that was provided on create.
+func (u *SecurityScanningUpsertOne) UpdateBaseID() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateBaseID()
+	})
+}
+
+// ClearBaseID clears the value of the "base_id" field.
+func (u *SecurityScanningUpsertOne) ClearBaseID() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearBaseID()
+	})
+}
+
+// SetFileHashes sets the "file_hashes" field.
+func (u *SecurityScanningUpsertOne) SetFileHashes(v map[string]string) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetFileHashes(v)
+	})
+}
+
+// UpdateFileHashes sets the "file_hashes" field to the value that was provided on create.
+func (u *SecurityScanningUpsertOne) UpdateFileHashes() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateFileHashes()
+	})
+}
+
+// ClearFileHashes clears the value of the "file_hashes" field.
+func (u *SecurityScanningUpsertOne) ClearFileHashes() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearFileHashes()
+	})
+}
+
+// SetScannedFiles sets the "scanned_files" field.
+func (u *SecurityScanningUpsertOne) SetScannedFiles(v int) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetScannedFiles(v)
+	})
+}
+
+// AddScannedFiles adds v to the "scanned_files" field.
+func (u *SecurityScanningUpsertOne) AddScannedFiles(v int) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.AddScannedFiles(v)
+	})
+}
+
+// UpdateScannedFiles sets the "scanned_files" field to the value that was provided on create.
+func (u *SecurityScanningUpsertOne) UpdateScannedFiles() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateScannedFiles()
+	})
+}
+
+// SetSubScans sets the "sub_scans" field.
+func (u *SecurityScanningUpsertOne) SetSubScans(v []*types.SecurityScanningSubScan) *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetSubScans(v)
+	})
+}
+
+// UpdateSubScans sets the "sub_scans" field to the value that was provided on create.
+func (u *SecurityScanningUpsertOne) UpdateSubScans() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateSubScans()
+	})
+}
+
+// ClearSubScans clears the value of the "sub_scans" field.
+func (u *SecurityScanningUpsertOne) ClearSubScans() *SecurityScanningUpsertOne {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearSubScans()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SecurityScanningUpsertOne) SetCreatedAt(v time.Time) *SecurityScanningUpsertOne {
 	return u.Update(func(s *SecurityScanningUpsert) {
@@ -1026,6 +1360,132 @@ func (u *SecurityScanningUpsertBulk) ClearErrorMessage() *SecurityScanningUpsert
 	})
 }
 
+// SetTool sets the "tool" field.
+func (u *SecurityScanningUpsertBulk) SetTool(v string) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetTool(v)
+	})
+}
+
+// UpdateTool sets the "tool" field to the value that was provided on create.
+func (u *SecurityScanningUpsertBulk) UpdateTool() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateTool()
+	})
+}
+
+// ClearTool clears the value of the "tool" field.
+func (u *SecurityScanningUpsertBulk) ClearTool() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearTool()
+	})
+}
+
+// SetPolicy sets the "policy" field.
+func (u *SecurityScanningUpsertBulk) SetPolicy(v string) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetPolicy(v)
+	})
+}
+
+// UpdatePolicy sets the "policy" field to the value that was provided on create.
+func (u *SecurityScanningUpsertBulk) UpdatePolicy() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdatePolicy()
+	})
+}
+
+// ClearPolicy clears the value of the "policy" field.
+func (u *SecurityScanningUpsertBulk) ClearPolicy() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearPolicy()
+	})
+}
+
+// SetBaseID sets the "base_id" field.
+func (u *SecurityScanningUpsertBulk) SetBaseID(v uuid.UUID) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetBaseID(v)
+	})
+}
+
+// UpdateBaseID sets the "base_id" field to the value that was provided on create.
+func (u *SecurityScanningUpsertBulk) UpdateBaseID() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateBaseID()
+	})
+}
+
+// ClearBaseID clears the value of the "base_id" field.
+func (u *SecurityScanningUpsertBulk) ClearBaseID() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearBaseID()
+	})
+}
+
+// SetFileHashes sets the "file_hashes" field.
+func (u *SecurityScanningUpsertBulk) SetFileHashes(v map[string]string) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetFileHashes(v)
+	})
+}
+
+// UpdateFileHashes sets the "file_hashes" field to the value that was provided on create.
+func (u *SecurityScanningUpsertBulk) UpdateFileHashes() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateFileHashes()
+	})
+}
+
+// ClearFileHashes clears the value of the "file_hashes" field.
+func (u *SecurityScanningUpsertBulk) ClearFileHashes() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearFileHashes()
+	})
+}
+
+// SetScannedFiles sets the "scanned_files" field.
+func (u *SecurityScanningUpsertBulk) SetScannedFiles(v int) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetScannedFiles(v)
+	})
+}
+
+// AddScannedFiles adds v to the "scanned_files" field.
+func (u *SecurityScanningUpsertBulk) AddScannedFiles(v int) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.AddScannedFiles(v)
+	})
+}
+
+// UpdateScannedFiles sets the "scanned_files" field to the value that was provided on create.
+func (u *SecurityScanningUpsertBulk) UpdateScannedFiles() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateScannedFiles()
+	})
+}
+
+// SetSubScans sets the "sub_scans" field.
+func (u *SecurityScanningUpsertBulk) SetSubScans(v []*types.SecurityScanningSubScan) *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.SetSubScans(v)
+	})
+}
+
+// UpdateSubScans sets the "sub_scans" field to the value that was provided on create.
+func (u *SecurityScanningUpsertBulk) UpdateSubScans() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.UpdateSubScans()
+	})
+}
+
+// ClearSubScans clears the value of the "sub_scans" field.
+func (u *SecurityScanningUpsertBulk) ClearSubScans() *SecurityScanningUpsertBulk {
+	return u.Update(func(s *SecurityScanningUpsert) {
+		s.ClearSubScans()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SecurityScanningUpsertBulk) SetCreatedAt(v time.Time) *SecurityScanningUpsertBulk {
 	return u.Update(func(s *SecurityScanningUpsert) {
@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/predicate"
@@ -17,6 +18,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/db/workspace"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -144,6 +146,117 @@ func (ssu *SecurityScanningUpdate) ClearErrorMessage() *SecurityScanningUpdate {
 	return ssu
 }
 
+// SetTool sets the "tool" field.
+func (ssu *SecurityScanningUpdate) SetTool(s string) *SecurityScanningUpdate {
+	ssu.mutation.SetTool(s)
+	return ssu
+}
+
+// SetNillableTool sets the "tool" field if the given value is not nil.
+func (ssu *SecurityScanningUpdate) SetNillableTool(s *string) *SecurityScanningUpdate {
+	if s != nil {
+		ssu.SetTool(*s)
+	}
+	return ssu
+}
+
+// ClearTool clears the value of the "tool" field.
+func (ssu *SecurityScanningUpdate) ClearTool() *SecurityScanningUpdate {
+	ssu.mutation.ClearTool()
+	return ssu
+}
+
+// SetPolicy sets the "policy" field.
+func (ssu *SecurityScanningUpdate) SetPolicy(s string) *SecurityScanningUpdate {
+	ssu.mutation.SetPolicy(s)
+	return ssu
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (ssu *SecurityScanningUpdate) SetNillablePolicy(s *string) *SecurityScanningUpdate {
+	if s != nil {
+		ssu.SetPolicy(*s)
+	}
+	return ssu
+}
+
+// ClearPolicy clears the value of the "policy" field.
+func (ssu *SecurityScanningUpdate) ClearPolicy() *SecurityScanningUpdate {
+	ssu.mutation.ClearPolicy()
+	return ssu
+}
+
+// SetBaseID sets the "base_id" field.
+func (ssu *SecurityScanningUpdate) SetBaseID(u uuid.UUID) *SecurityScanningUpdate {
+	ssu.mutation.SetBaseID(u)
+	return ssu
+}
+
+// SetNillableBaseID sets the "base_id" field if the given value is not nil.
+func (ssu *SecurityScanningUpdate) SetNillableBaseID(u *uuid.UUID) *SecurityScanningUpdate {
+	if u != nil {
+		ssu.SetBaseID(*u)
+	}
+	return ssu
+}
+
+// ClearBaseID clears the value of the "base_id" field.
+func (ssu *SecurityScanningUpdate) ClearBaseID() *SecurityScanningUpdate {
+	ssu.mutation.ClearBaseID()
+	return ssu
+}
+
+// SetFileHashes sets the "file_hashes" field.
+func (ssu *SecurityScanningUpdate) SetFileHashes(m map[string]string) *SecurityScanningUpdate {
+	ssu.mutation.SetFileHashes(m)
+	return ssu
+}
+
+// ClearFileHashes clears the value of the "file_hashes" field.
+func (ssu *SecurityScanningUpdate) ClearFileHashes() *SecurityScanningUpdate {
+	ssu.mutation.ClearFileHashes()
+	return ssu
+}
+
+// SetScannedFiles sets the "scanned_files" field.
+func (ssu *SecurityScanningUpdate) SetScannedFiles(i int) *SecurityScanningUpdate {
+	ssu.mutation.ResetScannedFiles()
+	ssu.mutation.SetScannedFiles(i)
+	return ssu
+}
+
+// SetNillableScannedFiles sets the "scanned_files" field if the given value is not nil.
+func (ssu *SecurityScanningUpdate) SetNillableScannedFiles(i *int) *SecurityScanningUpdate {
+	if i != nil {
+		ssu.SetScannedFiles(*i)
+	}
+	return ssu
+}
+
+// AddScannedFiles adds i to the "scanned_files" field.
+func (ssu *SecurityScanningUpdate) AddScannedFiles(i int) *SecurityScanningUpdate {
+	ssu.mutation.AddScannedFiles(i)
+	return ssu
+}
+
+// SetSubScans sets the "sub_scans" field.
+func (ssu *SecurityScanningUpdate) SetSubScans(tsss []*types.SecurityScanningSubScan) *SecurityScanningUpdate {
+	ssu.mutation.SetSubScans(tsss)
+	return ssu
+}
+
+// AppendSubScans appends tsss to the "sub_scans" field.
+func (ssu *SecurityScanningUpdate) AppendSubScans(tsss []*types.SecurityScanningSubScan) *SecurityScanningUpdate {
+	ssu.mutation.AppendSubScans(tsss)
+	return ssu
+}
+
+// ClearSubScans clears the value of the "sub_scans" field.
+func (ssu *SecurityScanningUpdate) ClearSubScans() *SecurityScanningUpdate {
+	ssu.mutation.ClearSubScans()
+	return ssu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ssu *SecurityScanningUpdate) SetCreatedAt(t time.Time) *SecurityScanningUpdate {
 	ssu.mutation.SetCreatedAt(t)
@@ -318,6 +431,47 @@ func (ssu *SecurityScanningUpdate) sqlSave(ctx context.Context) (n int, err erro
 	if ssu.mutation.ErrorMessageCleared() {
 		_spec.ClearField(securityscanning.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := ssu.mutation.Tool(); ok {
+		_spec.SetField(securityscanning.FieldTool, field.TypeString, value)
+	}
+	if ssu.mutation.ToolCleared() {
+		_spec.ClearField(securityscanning.FieldTool, field.TypeString)
+	}
+	if value, ok := ssu.mutation.Policy(); ok {
+		_spec.SetField(securityscanning.FieldPolicy, field.TypeString, value)
+	}
+	if ssu.mutation.PolicyCleared() {
+		_spec.ClearField(securityscanning.FieldPolicy, field.TypeString)
+	}
+	if value, ok := ssu.mutation.BaseID(); ok {
+		_spec.SetField(securityscanning.FieldBaseID, field.TypeUUID, value)
+	}
+	if ssu.mutation.BaseIDCleared() {
+		_spec.ClearField(securityscanning.FieldBaseID, field.TypeUUID)
+	}
+	if value, ok := ssu.mutation.FileHashes(); ok {
+		_spec.SetField(securityscanning.FieldFileHashes, field.TypeJSON, value)
+	}
+	if ssu.mutation.FileHashesCleared() {
+		_spec.ClearField(securityscanning.FieldFileHashes, field.TypeJSON)
+	}
+	if value, ok := ssu.mutation.ScannedFiles(); ok {
+		_spec.SetField(securityscanning.FieldScannedFiles, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.AddedScannedFiles(); ok {
+		_spec.AddField(securityscanning.FieldScannedFiles, field.TypeInt, value)
+	}
+	if value, ok := ssu.mutation.SubScans(); ok {
+		_spec.SetField(securityscanning.FieldSubScans, field.TypeJSON, value)
+	}
+	if value, ok := ssu.mutation.AppendedSubScans(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, securityscanning.FieldSubScans, value)
+		})
+	}
+	if ssu.mutation.SubScansCleared() {
+		_spec.ClearField(securityscanning.FieldSubScans, field.TypeJSON)
+	}
 	if value, ok := ssu.mutation.CreatedAt(); ok {
 		_spec.SetField(securityscanning.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -559,6 +713,117 @@ func (ssuo *SecurityScanningUpdateOne) ClearErrorMessage() *SecurityScanningUpda
 	return ssuo
 }
 
+// SetTool sets the "tool" field.
+func (ssuo *SecurityScanningUpdateOne) SetTool(s string) *SecurityScanningUpdateOne {
+	ssuo.mutation.SetTool(s)
+	return ssuo
+}
+
+// SetNillableTool sets the "tool" field if the given value is not nil.
+func (ssuo *SecurityScanningUpdateOne) SetNillableTool(s *string) *SecurityScanningUpdateOne {
+	if s != nil {
+		ssuo.SetTool(*s)
+	}
+	return ssuo
+}
+
+// ClearTool clears the value of the "tool" field.
+func (ssuo *SecurityScanningUpdateOne) ClearTool() *SecurityScanningUpdateOne {
+	ssuo.mutation.ClearTool()
+	return ssuo
+}
+
+// SetPolicy sets the "policy" field.
+func (ssuo *SecurityScanningUpdateOne) SetPolicy(s string) *SecurityScanningUpdateOne {
+	ssuo.mutation.SetPolicy(s)
+	return ssuo
+}
+
+// SetNillablePolicy sets the "policy" field if the given value is not nil.
+func (ssuo *SecurityScanningUpdateOne) SetNillablePolicy(s *string) *SecurityScanningUpdateOne {
+	if s != nil {
+		ssuo.SetPolicy(*s)
+	}
+	return ssuo
+}
+
+// ClearPolicy clears the value of the "policy" field.
+func (ssuo *SecurityScanningUpdateOne) ClearPolicy() *SecurityScanningUpdateOne {
+	ssuo.mutation.ClearPolicy()
+	return ssuo
+}
+
+// SetBaseID sets the "base_id" field.
+func (ssuo *SecurityScanningUpdateOne) SetBaseID(u uuid.UUID) *SecurityScanningUpdateOne {
+	ssuo.mutation.SetBaseID(u)
+	return ssuo
+}
+
+// SetNillableBaseID sets the "base_id" field if the given value is not nil.
+func (ssuo *SecurityScanningUpdateOne) SetNillableBaseID(u *uuid.UUID) *SecurityScanningUpdateOne {
+	if u != nil {
+		ssuo.SetBaseID(*u)
+	}
+	return ssuo
+}
+
+// ClearBaseID clears the value of the "base_id" field.
+func (ssuo *SecurityScanningUpdateOne) ClearBaseID() *SecurityScanningUpdateOne {
+	ssuo.mutation.ClearBaseID()
+	return ssuo
+}
+
+// SetFileHashes sets the "file_hashes" field.
+func (ssuo *SecurityScanningUpdateOne) SetFileHashes(m map[string]string) *SecurityScanningUpdateOne {
+	ssuo.mutation.SetFileHashes(m)
+	return ssuo
+}
+
+// ClearFileHashes clears the value of the "file_hashes" field.
+func (ssuo *SecurityScanningUpdateOne) ClearFileHashes() *SecurityScanningUpdateOne {
+	ssuo.mutation.ClearFileHashes()
+	return ssuo
+}
+
+// SetScannedFiles sets the "scanned_files" field.
+func (ssuo *SecurityScanningUpdateOne) SetScannedFiles(i int) *SecurityScanningUpdateOne {
+	ssuo.mutation.ResetScannedFiles()
+	ssuo.mutation.SetScannedFiles(i)
+	return ssuo
+}
+
+// SetNillableScannedFiles sets the "scanned_files" field if the given value is not nil.
+func (ssuo *SecurityScanningUpdateOne) SetNillableScannedFiles(i *int) *SecurityScanningUpdateOne {
+	if i != nil {
+		ssuo.SetScannedFiles(*i)
+	}
+	return ssuo
+}
+
+// AddScannedFiles adds i to the "scanned_files" field.
+func (ssuo *SecurityScanningUpdateOne) AddScannedFiles(i int) *SecurityScanningUpdateOne {
+	ssuo.mutation.AddScannedFiles(i)
+	return ssuo
+}
+
+// SetSubScans sets the "sub_scans" field.
+func (ssuo *SecurityScanningUpdateOne) SetSubScans(tsss []*types.SecurityScanningSubScan) *SecurityScanningUpdateOne {
+	ssuo.mutation.SetSubScans(tsss)
+	return ssuo
+}
+
+// AppendSubScans appends tsss to the "sub_scans" field.
+func (ssuo *SecurityScanningUpdateOne) AppendSubScans(tsss []*types.SecurityScanningSubScan) *SecurityScanningUpdateOne {
+	ssuo.mutation.AppendSubScans(tsss)
+	return ssuo
+}
+
+// ClearSubScans clears the value of the "sub_scans" field.
+func (ssuo *SecurityScanningUpdateOne) ClearSubScans() *SecurityScanningUpdateOne {
+	ssuo.mutation.ClearSubScans()
+	return ssuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ssuo *SecurityScanningUpdateOne) SetCreatedAt(t time.Time) *SecurityScanningUpdateOne {
 	ssuo.mutation.SetCreatedAt(t)
@@ -763,6 +1028,47 @@ func (ssuo *SecurityScanningUpdateOne) sqlSave(ctx context.Context) (_node *Secu
 	if ssuo.mutation.ErrorMessageCleared() {
 		_spec.ClearField(securityscanning.FieldErrorMessage, field.TypeString)
 	}
+	if value, ok := ssuo.mutation.Tool(); ok {
+		_spec.SetField(securityscanning.FieldTool, field.TypeString, value)
+	}
+	if ssuo.mutation.ToolCleared() {
+		_spec.ClearField(securityscanning.FieldTool, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.Policy(); ok {
+		_spec.SetField(securityscanning.FieldPolicy, field.TypeString, value)
+	}
+	if ssuo.mutation.PolicyCleared() {
+		_spec.ClearField(securityscanning.FieldPolicy, field.TypeString)
+	}
+	if value, ok := ssuo.mutation.BaseID(); ok {
+		_spec.SetField(securityscanning.FieldBaseID, field.TypeUUID, value)
+	}
+	if ssuo.mutation.BaseIDCleared() {
+		_spec.ClearField(securityscanning.FieldBaseID, field.TypeUUID)
+	}
+	if value, ok := ssuo.mutation.FileHashes(); ok {
+		_spec.SetField(securityscanning.FieldFileHashes, field.TypeJSON, value)
+	}
+	if ssuo.mutation.FileHashesCleared() {
+		_spec.ClearField(securityscanning.FieldFileHashes, field.TypeJSON)
+	}
+	if value, ok := ssuo.mutation.ScannedFiles(); ok {
+		_spec.SetField(securityscanning.FieldScannedFiles, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.AddedScannedFiles(); ok {
+		_spec.AddField(securityscanning.FieldScannedFiles, field.TypeInt, value)
+	}
+	if value, ok := ssuo.mutation.SubScans(); ok {
+		_spec.SetField(securityscanning.FieldSubScans, field.TypeJSON, value)
+	}
+	if value, ok := ssuo.mutation.AppendedSubScans(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, securityscanning.FieldSubScans, value)
+		})
+	}
+	if ssuo.mutation.SubScansCleared() {
+		_spec.ClearField(securityscanning.FieldSubScans, field.TypeJSON)
+	}
 	if value, ok := ssuo.mutation.CreatedAt(); ok {
 		_spec.SetField(securityscanning.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningComment is the model entity for the SecurityScanningComment schema.
+type SecurityScanningComment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ResultID holds the value of the "result_id" field.
+	ResultID uuid.UUID `json:"result_id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID uuid.UUID `json:"author_id,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// 评论时修改后的处置状态
+	TriageStatus consts.SecurityScanningTriageStatus `json:"triage_status,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SecurityScanningCommentQuery when eager-loading is set.
+	Edges        SecurityScanningCommentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SecurityScanningCommentEdges holds the relations/edges for other nodes in the graph.
+type SecurityScanningCommentEdges struct {
+	// Result holds the value of the result edge.
+	Result *SecurityScanningResult `json:"result,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ResultOrErr returns the Result value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SecurityScanningCommentEdges) ResultOrErr() (*SecurityScanningResult, error) {
+	if e.Result != nil {
+		return e.Result, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: securityscanningresult.Label}
+	}
+	return nil, &NotLoadedError{edge: "result"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityScanningComment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityscanningcomment.FieldAuthor, securityscanningcomment.FieldTriageStatus, securityscanningcomment.FieldContent:
+			values[i] = new(sql.NullString)
+		case securityscanningcomment.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case securityscanningcomment.FieldID, securityscanningcomment.FieldResultID, securityscanningcomment.FieldAuthorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityScanningComment fields.
+func (ssc *SecurityScanningComment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityscanningcomment.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ssc.ID = *value
+			}
+		case securityscanningcomment.FieldResultID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field result_id", values[i])
+			} else if value != nil {
+				ssc.ResultID = *value
+			}
+		case securityscanningcomment.FieldAuthorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value != nil {
+				ssc.AuthorID = *value
+			}
+		case securityscanningcomment.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				ssc.Author = value.String
+			}
+		case securityscanningcomment.FieldTriageStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field triage_status", values[i])
+			} else if value.Valid {
+				ssc.TriageStatus = consts.SecurityScanningTriageStatus(value.String)
+			}
+		case securityscanningcomment.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				ssc.Content = value.String
+			}
+		case securityscanningcomment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ssc.CreatedAt = value.Time
+			}
+		default:
+			ssc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityScanningComment.
+// This includes values selected through modifiers, order, etc.
+func (ssc *SecurityScanningComment) Value(name string) (ent.Value, error) {
+	return ssc.selectValues.Get(name)
+}
+
+// QueryResult queries the "result" edge of the SecurityScanningComment entity.
+func (ssc *SecurityScanningComment) QueryResult() *SecurityScanningResultQuery {
+	return NewSecurityScanningCommentClient(ssc.config).QueryResult(ssc)
+}
+
+// Update returns a builder for updating this SecurityScanningComment.
+// Note that you need to call SecurityScanningComment.Unwrap() before calling this method if this SecurityScanningComment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ssc *SecurityScanningComment) Update() *SecurityScanningCommentUpdateOne {
+	return NewSecurityScanningCommentClient(ssc.config).UpdateOne(ssc)
+}
+
+// Unwrap unwraps the SecurityScanningComment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ssc *SecurityScanningComment) Unwrap() *SecurityScanningComment {
+	_tx, ok := ssc.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SecurityScanningComment is not a transactional entity")
+	}
+	ssc.config.driver = _tx.drv
+	return ssc
+}
+
+// String implements the fmt.Stringer.
+func (ssc *SecurityScanningComment) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityScanningComment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ssc.ID))
+	builder.WriteString("result_id=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.ResultID))
+	builder.WriteString(", ")
+	builder.WriteString("author_id=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.AuthorID))
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(ssc.Author)
+	builder.WriteString(", ")
+	builder.WriteString("triage_status=")
+	builder.WriteString(fmt.Sprintf("%v", ssc.TriageStatus))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(ssc.Content)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ssc.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityScanningComments is a parsable slice of SecurityScanningComment.
+type SecurityScanningComments []*SecurityScanningComment
@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityscanningcomment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the securityscanningcomment type in the database.
+	Label = "security_scanning_comment"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldResultID holds the string denoting the result_id field in the database.
+	FieldResultID = "result_id"
+	// FieldAuthorID holds the string denoting the author_id field in the database.
+	FieldAuthorID = "author_id"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldTriageStatus holds the string denoting the triage_status field in the database.
+	FieldTriageStatus = "triage_status"
+	// FieldContent holds the string denoting the content field in the database.
+	FieldContent = "content"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeResult holds the string denoting the result edge name in mutations.
+	EdgeResult = "result"
+	// Table holds the table name of the securityscanningcomment in the database.
+	Table = "security_scanning_comments"
+	// ResultTable is the table that holds the result relation/edge.
+	ResultTable = "security_scanning_comments"
+	// ResultInverseTable is the table name for the SecurityScanningResult entity.
+	// It exists in this package in order to avoid circular dependency with the "securityscanningresult" package.
+	ResultInverseTable = "security_scanning_results"
+	// ResultColumn is the table column denoting the result relation/edge.
+	ResultColumn = "result_id"
+)
+
+// Columns holds all SQL columns for securityscanningcomment fields.
+var Columns = []string{
+	FieldID,
+	FieldResultID,
+	FieldAuthorID,
+	FieldAuthor,
+	FieldTriageStatus,
+	FieldContent,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SecurityScanningComment queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByResultID orders the results by the result_id field.
+func ByResultID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResultID, opts...).ToFunc()
+}
+
+// ByAuthorID orders the results by the author_id field.
+func ByAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorID, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// ByTriageStatus orders the results by the triage_status field.
+func ByTriageStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriageStatus, opts...).ToFunc()
+}
+
+// ByContent orders the results by the content field.
+func ByContent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContent, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByResultField orders the results by result field.
+func ByResultField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newResultStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newResultStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ResultInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ResultTable, ResultColumn),
+	)
+}
@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityscanningcomment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLTE(FieldID, id))
+}
+
+// ResultID applies equality check predicate on the "result_id" field. It's identical to ResultIDEQ.
+func ResultID(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldResultID, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldAuthor, v))
+}
+
+// TriageStatus applies equality check predicate on the "triage_status" field. It's identical to TriageStatusEQ.
+func TriageStatus(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldTriageStatus, vc))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldContent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ResultIDEQ applies the EQ predicate on the "result_id" field.
+func ResultIDEQ(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldResultID, v))
+}
+
+// ResultIDNEQ applies the NEQ predicate on the "result_id" field.
+func ResultIDNEQ(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldResultID, v))
+}
+
+// ResultIDIn applies the In predicate on the "result_id" field.
+func ResultIDIn(vs ...uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldResultID, vs...))
+}
+
+// ResultIDNotIn applies the NotIn predicate on the "result_id" field.
+func ResultIDNotIn(vs ...uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldResultID, vs...))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDGT applies the GT predicate on the "author_id" field.
+func AuthorIDGT(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGT(FieldAuthorID, v))
+}
+
+// AuthorIDGTE applies the GTE predicate on the "author_id" field.
+func AuthorIDGTE(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGTE(FieldAuthorID, v))
+}
+
+// AuthorIDLT applies the LT predicate on the "author_id" field.
+func AuthorIDLT(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLT(FieldAuthorID, v))
+}
+
+// AuthorIDLTE applies the LTE predicate on the "author_id" field.
+func AuthorIDLTE(v uuid.UUID) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLTE(FieldAuthorID, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorIsNil applies the IsNil predicate on the "author" field.
+func AuthorIsNil() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIsNull(FieldAuthor))
+}
+
+// AuthorNotNil applies the NotNil predicate on the "author" field.
+func AuthorNotNil() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotNull(FieldAuthor))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// TriageStatusEQ applies the EQ predicate on the "triage_status" field.
+func TriageStatusEQ(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldTriageStatus, vc))
+}
+
+// TriageStatusNEQ applies the NEQ predicate on the "triage_status" field.
+func TriageStatusNEQ(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldTriageStatus, vc))
+}
+
+// TriageStatusIn applies the In predicate on the "triage_status" field.
+func TriageStatusIn(vs ...consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldTriageStatus, v...))
+}
+
+// TriageStatusNotIn applies the NotIn predicate on the "triage_status" field.
+func TriageStatusNotIn(vs ...consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldTriageStatus, v...))
+}
+
+// TriageStatusGT applies the GT predicate on the "triage_status" field.
+func TriageStatusGT(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldGT(FieldTriageStatus, vc))
+}
+
+// TriageStatusGTE applies the GTE predicate on the "triage_status" field.
+func TriageStatusGTE(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldGTE(FieldTriageStatus, vc))
+}
+
+// TriageStatusLT applies the LT predicate on the "triage_status" field.
+func TriageStatusLT(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldLT(FieldTriageStatus, vc))
+}
+
+// TriageStatusLTE applies the LTE predicate on the "triage_status" field.
+func TriageStatusLTE(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldLTE(FieldTriageStatus, vc))
+}
+
+// TriageStatusContains applies the Contains predicate on the "triage_status" field.
+func TriageStatusContains(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldContains(FieldTriageStatus, vc))
+}
+
+// TriageStatusHasPrefix applies the HasPrefix predicate on the "triage_status" field.
+func TriageStatusHasPrefix(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldHasPrefix(FieldTriageStatus, vc))
+}
+
+// TriageStatusHasSuffix applies the HasSuffix predicate on the "triage_status" field.
+func TriageStatusHasSuffix(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldHasSuffix(FieldTriageStatus, vc))
+}
+
+// TriageStatusIsNil applies the IsNil predicate on the "triage_status" field.
+func TriageStatusIsNil() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIsNull(FieldTriageStatus))
+}
+
+// TriageStatusNotNil applies the NotNil predicate on the "triage_status" field.
+func TriageStatusNotNil() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotNull(FieldTriageStatus))
+}
+
+// TriageStatusEqualFold applies the EqualFold predicate on the "triage_status" field.
+func TriageStatusEqualFold(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldEqualFold(FieldTriageStatus, vc))
+}
+
+// TriageStatusContainsFold applies the ContainsFold predicate on the "triage_status" field.
+func TriageStatusContainsFold(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningComment {
+	vc := string(v)
+	return predicate.SecurityScanningComment(sql.FieldContainsFold(FieldTriageStatus, vc))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldContainsFold(FieldContent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasResult applies the HasEdge predicate on the "result" edge.
+func HasResult() predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ResultTable, ResultColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasResultWith applies the HasEdge predicate on the "result" edge with a given conditions (other predicates).
+func HasResultWith(preds ...predicate.SecurityScanningResult) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(func(s *sql.Selector) {
+		step := newResultStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SecurityScanningComment) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SecurityScanningComment) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SecurityScanningComment) predicate.SecurityScanningComment {
+	return predicate.SecurityScanningComment(sql.NotPredicates(p))
+}
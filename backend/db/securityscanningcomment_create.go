@@ -0,0 +1,884 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningCommentCreate is the builder for creating a SecurityScanningComment entity.
+type SecurityScanningCommentCreate struct {
+	config
+	mutation *SecurityScanningCommentMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetResultID sets the "result_id" field.
+func (sscc *SecurityScanningCommentCreate) SetResultID(u uuid.UUID) *SecurityScanningCommentCreate {
+	sscc.mutation.SetResultID(u)
+	return sscc
+}
+
+// SetAuthorID sets the "author_id" field.
+func (sscc *SecurityScanningCommentCreate) SetAuthorID(u uuid.UUID) *SecurityScanningCommentCreate {
+	sscc.mutation.SetAuthorID(u)
+	return sscc
+}
+
+// SetAuthor sets the "author" field.
+func (sscc *SecurityScanningCommentCreate) SetAuthor(s string) *SecurityScanningCommentCreate {
+	sscc.mutation.SetAuthor(s)
+	return sscc
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (sscc *SecurityScanningCommentCreate) SetNillableAuthor(s *string) *SecurityScanningCommentCreate {
+	if s != nil {
+		sscc.SetAuthor(*s)
+	}
+	return sscc
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (sscc *SecurityScanningCommentCreate) SetTriageStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningCommentCreate {
+	sscc.mutation.SetTriageStatus(csts)
+	return sscc
+}
+
+// SetNillableTriageStatus sets the "triage_status" field if the given value is not nil.
+func (sscc *SecurityScanningCommentCreate) SetNillableTriageStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningCommentCreate {
+	if csts != nil {
+		sscc.SetTriageStatus(*csts)
+	}
+	return sscc
+}
+
+// SetContent sets the "content" field.
+func (sscc *SecurityScanningCommentCreate) SetContent(s string) *SecurityScanningCommentCreate {
+	sscc.mutation.SetContent(s)
+	return sscc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sscc *SecurityScanningCommentCreate) SetNillableContent(s *string) *SecurityScanningCommentCreate {
+	if s != nil {
+		sscc.SetContent(*s)
+	}
+	return sscc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sscc *SecurityScanningCommentCreate) SetCreatedAt(t time.Time) *SecurityScanningCommentCreate {
+	sscc.mutation.SetCreatedAt(t)
+	return sscc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sscc *SecurityScanningCommentCreate) SetNillableCreatedAt(t *time.Time) *SecurityScanningCommentCreate {
+	if t != nil {
+		sscc.SetCreatedAt(*t)
+	}
+	return sscc
+}
+
+// SetID sets the "id" field.
+func (sscc *SecurityScanningCommentCreate) SetID(u uuid.UUID) *SecurityScanningCommentCreate {
+	sscc.mutation.SetID(u)
+	return sscc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sscc *SecurityScanningCommentCreate) SetNillableID(u *uuid.UUID) *SecurityScanningCommentCreate {
+	if u != nil {
+		sscc.SetID(*u)
+	}
+	return sscc
+}
+
+// SetResult sets the "result" edge to the SecurityScanningResult entity.
+func (sscc *SecurityScanningCommentCreate) SetResult(s *SecurityScanningResult) *SecurityScanningCommentCreate {
+	return sscc.SetResultID(s.ID)
+}
+
+// Mutation returns the SecurityScanningCommentMutation object of the builder.
+func (sscc *SecurityScanningCommentCreate) Mutation() *SecurityScanningCommentMutation {
+	return sscc.mutation
+}
+
+// Save creates the SecurityScanningComment in the database.
+func (sscc *SecurityScanningCommentCreate) Save(ctx context.Context) (*SecurityScanningComment, error) {
+	sscc.defaults()
+	return withHooks(ctx, sscc.sqlSave, sscc.mutation, sscc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sscc *SecurityScanningCommentCreate) SaveX(ctx context.Context) *SecurityScanningComment {
+	v, err := sscc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscc *SecurityScanningCommentCreate) Exec(ctx context.Context) error {
+	_, err := sscc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscc *SecurityScanningCommentCreate) ExecX(ctx context.Context) {
+	if err := sscc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sscc *SecurityScanningCommentCreate) defaults() {
+	if _, ok := sscc.mutation.CreatedAt(); !ok {
+		v := securityscanningcomment.DefaultCreatedAt()
+		sscc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sscc.mutation.ID(); !ok {
+		v := securityscanningcomment.DefaultID()
+		sscc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sscc *SecurityScanningCommentCreate) check() error {
+	if _, ok := sscc.mutation.ResultID(); !ok {
+		return &ValidationError{Name: "result_id", err: errors.New(`db: missing required field "SecurityScanningComment.result_id"`)}
+	}
+	if _, ok := sscc.mutation.AuthorID(); !ok {
+		return &ValidationError{Name: "author_id", err: errors.New(`db: missing required field "SecurityScanningComment.author_id"`)}
+	}
+	if _, ok := sscc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityScanningComment.created_at"`)}
+	}
+	if len(sscc.mutation.ResultIDs()) == 0 {
+		return &ValidationError{Name: "result", err: errors.New(`db: missing required edge "SecurityScanningComment.result"`)}
+	}
+	return nil
+}
+
+func (sscc *SecurityScanningCommentCreate) sqlSave(ctx context.Context) (*SecurityScanningComment, error) {
+	if err := sscc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sscc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sscc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sscc.mutation.id = &_node.ID
+	sscc.mutation.done = true
+	return _node, nil
+}
+
+func (sscc *SecurityScanningCommentCreate) createSpec() (*SecurityScanningComment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityScanningComment{config: sscc.config}
+		_spec = sqlgraph.NewCreateSpec(securityscanningcomment.Table, sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sscc.conflict
+	if id, ok := sscc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sscc.mutation.AuthorID(); ok {
+		_spec.SetField(securityscanningcomment.FieldAuthorID, field.TypeUUID, value)
+		_node.AuthorID = value
+	}
+	if value, ok := sscc.mutation.Author(); ok {
+		_spec.SetField(securityscanningcomment.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := sscc.mutation.TriageStatus(); ok {
+		_spec.SetField(securityscanningcomment.FieldTriageStatus, field.TypeString, value)
+		_node.TriageStatus = value
+	}
+	if value, ok := sscc.mutation.Content(); ok {
+		_spec.SetField(securityscanningcomment.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := sscc.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningcomment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sscc.mutation.ResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityscanningcomment.ResultTable,
+			Columns: []string{securityscanningcomment.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ResultID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityScanningComment.Create().
+//		SetResultID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityScanningCommentUpsert) {
+//			SetResultID(v+v).
+//		}).
+//		Exec(ctx)
+func (sscc *SecurityScanningCommentCreate) OnConflict(opts ...sql.ConflictOption) *SecurityScanningCommentUpsertOne {
+	sscc.conflict = opts
+	return &SecurityScanningCommentUpsertOne{
+		create: sscc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityScanningComment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sscc *SecurityScanningCommentCreate) OnConflictColumns(columns ...string) *SecurityScanningCommentUpsertOne {
+	sscc.conflict = append(sscc.conflict, sql.ConflictColumns(columns...))
+	return &SecurityScanningCommentUpsertOne{
+		create: sscc,
+	}
+}
+
+type (
+	// SecurityScanningCommentUpsertOne is the builder for "upsert"-ing
+	//  one SecurityScanningComment node.
+	SecurityScanningCommentUpsertOne struct {
+		create *SecurityScanningCommentCreate
+	}
+
+	// SecurityScanningCommentUpsert is the "OnConflict" setter.
+	SecurityScanningCommentUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetResultID sets the "result_id" field.
+func (u *SecurityScanningCommentUpsert) SetResultID(v uuid.UUID) *SecurityScanningCommentUpsert {
+	u.Set(securityscanningcomment.FieldResultID, v)
+	return u
+}
+
+// UpdateResultID sets the "result_id" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsert) UpdateResultID() *SecurityScanningCommentUpsert {
+	u.SetExcluded(securityscanningcomment.FieldResultID)
+	return u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *SecurityScanningCommentUpsert) SetAuthorID(v uuid.UUID) *SecurityScanningCommentUpsert {
+	u.Set(securityscanningcomment.FieldAuthorID, v)
+	return u
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsert) UpdateAuthorID() *SecurityScanningCommentUpsert {
+	u.SetExcluded(securityscanningcomment.FieldAuthorID)
+	return u
+}
+
+// SetAuthor sets the "author" field.
+func (u *SecurityScanningCommentUpsert) SetAuthor(v string) *SecurityScanningCommentUpsert {
+	u.Set(securityscanningcomment.FieldAuthor, v)
+	return u
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsert) UpdateAuthor() *SecurityScanningCommentUpsert {
+	u.SetExcluded(securityscanningcomment.FieldAuthor)
+	return u
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *SecurityScanningCommentUpsert) ClearAuthor() *SecurityScanningCommentUpsert {
+	u.SetNull(securityscanningcomment.FieldAuthor)
+	return u
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (u *SecurityScanningCommentUpsert) SetTriageStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpsert {
+	u.Set(securityscanningcomment.FieldTriageStatus, v)
+	return u
+}
+
+// UpdateTriageStatus sets the "triage_status" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsert) UpdateTriageStatus() *SecurityScanningCommentUpsert {
+	u.SetExcluded(securityscanningcomment.FieldTriageStatus)
+	return u
+}
+
+// ClearTriageStatus clears the value of the "triage_status" field.
+func (u *SecurityScanningCommentUpsert) ClearTriageStatus() *SecurityScanningCommentUpsert {
+	u.SetNull(securityscanningcomment.FieldTriageStatus)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *SecurityScanningCommentUpsert) SetContent(v string) *SecurityScanningCommentUpsert {
+	u.Set(securityscanningcomment.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsert) UpdateContent() *SecurityScanningCommentUpsert {
+	u.SetExcluded(securityscanningcomment.FieldContent)
+	return u
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *SecurityScanningCommentUpsert) ClearContent() *SecurityScanningCommentUpsert {
+	u.SetNull(securityscanningcomment.FieldContent)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningCommentUpsert) SetCreatedAt(v time.Time) *SecurityScanningCommentUpsert {
+	u.Set(securityscanningcomment.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsert) UpdateCreatedAt() *SecurityScanningCommentUpsert {
+	u.SetExcluded(securityscanningcomment.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningComment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityscanningcomment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityScanningCommentUpsertOne) UpdateNewValues() *SecurityScanningCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(securityscanningcomment.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningComment.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecurityScanningCommentUpsertOne) Ignore() *SecurityScanningCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityScanningCommentUpsertOne) DoNothing() *SecurityScanningCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityScanningCommentCreate.OnConflict
+// documentation for more info.
+func (u *SecurityScanningCommentUpsertOne) Update(set func(*SecurityScanningCommentUpsert)) *SecurityScanningCommentUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityScanningCommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetResultID sets the "result_id" field.
+func (u *SecurityScanningCommentUpsertOne) SetResultID(v uuid.UUID) *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetResultID(v)
+	})
+}
+
+// UpdateResultID sets the "result_id" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertOne) UpdateResultID() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateResultID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *SecurityScanningCommentUpsertOne) SetAuthorID(v uuid.UUID) *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertOne) UpdateAuthorID() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *SecurityScanningCommentUpsertOne) SetAuthor(v string) *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertOne) UpdateAuthor() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *SecurityScanningCommentUpsertOne) ClearAuthor() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (u *SecurityScanningCommentUpsertOne) SetTriageStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetTriageStatus(v)
+	})
+}
+
+// UpdateTriageStatus sets the "triage_status" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertOne) UpdateTriageStatus() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateTriageStatus()
+	})
+}
+
+// ClearTriageStatus clears the value of the "triage_status" field.
+func (u *SecurityScanningCommentUpsertOne) ClearTriageStatus() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.ClearTriageStatus()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *SecurityScanningCommentUpsertOne) SetContent(v string) *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertOne) UpdateContent() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *SecurityScanningCommentUpsertOne) ClearContent() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningCommentUpsertOne) SetCreatedAt(v time.Time) *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertOne) UpdateCreatedAt() *SecurityScanningCommentUpsertOne {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityScanningCommentUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityScanningCommentCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityScanningCommentUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecurityScanningCommentUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: SecurityScanningCommentUpsertOne.ID is not supported by MySQL driver. Use SecurityScanningCommentUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecurityScanningCommentUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecurityScanningCommentCreateBulk is the builder for creating many SecurityScanningComment entities in bulk.
+type SecurityScanningCommentCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityScanningCommentCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SecurityScanningComment entities in the database.
+func (ssccb *SecurityScanningCommentCreateBulk) Save(ctx context.Context) ([]*SecurityScanningComment, error) {
+	if ssccb.err != nil {
+		return nil, ssccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ssccb.builders))
+	nodes := make([]*SecurityScanningComment, len(ssccb.builders))
+	mutators := make([]Mutator, len(ssccb.builders))
+	for i := range ssccb.builders {
+		func(i int, root context.Context) {
+			builder := ssccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityScanningCommentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ssccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ssccb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ssccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ssccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssccb *SecurityScanningCommentCreateBulk) SaveX(ctx context.Context) []*SecurityScanningComment {
+	v, err := ssccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssccb *SecurityScanningCommentCreateBulk) Exec(ctx context.Context) error {
+	_, err := ssccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssccb *SecurityScanningCommentCreateBulk) ExecX(ctx context.Context) {
+	if err := ssccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityScanningComment.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityScanningCommentUpsert) {
+//			SetResultID(v+v).
+//		}).
+//		Exec(ctx)
+func (ssccb *SecurityScanningCommentCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecurityScanningCommentUpsertBulk {
+	ssccb.conflict = opts
+	return &SecurityScanningCommentUpsertBulk{
+		create: ssccb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityScanningComment.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssccb *SecurityScanningCommentCreateBulk) OnConflictColumns(columns ...string) *SecurityScanningCommentUpsertBulk {
+	ssccb.conflict = append(ssccb.conflict, sql.ConflictColumns(columns...))
+	return &SecurityScanningCommentUpsertBulk{
+		create: ssccb,
+	}
+}
+
+// SecurityScanningCommentUpsertBulk is the builder for "upsert"-ing
+// a bulk of SecurityScanningComment nodes.
+type SecurityScanningCommentUpsertBulk struct {
+	create *SecurityScanningCommentCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SecurityScanningComment.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityscanningcomment.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityScanningCommentUpsertBulk) UpdateNewValues() *SecurityScanningCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(securityscanningcomment.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningComment.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecurityScanningCommentUpsertBulk) Ignore() *SecurityScanningCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityScanningCommentUpsertBulk) DoNothing() *SecurityScanningCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityScanningCommentCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecurityScanningCommentUpsertBulk) Update(set func(*SecurityScanningCommentUpsert)) *SecurityScanningCommentUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityScanningCommentUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetResultID sets the "result_id" field.
+func (u *SecurityScanningCommentUpsertBulk) SetResultID(v uuid.UUID) *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetResultID(v)
+	})
+}
+
+// UpdateResultID sets the "result_id" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertBulk) UpdateResultID() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateResultID()
+	})
+}
+
+// SetAuthorID sets the "author_id" field.
+func (u *SecurityScanningCommentUpsertBulk) SetAuthorID(v uuid.UUID) *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetAuthorID(v)
+	})
+}
+
+// UpdateAuthorID sets the "author_id" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertBulk) UpdateAuthorID() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateAuthorID()
+	})
+}
+
+// SetAuthor sets the "author" field.
+func (u *SecurityScanningCommentUpsertBulk) SetAuthor(v string) *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetAuthor(v)
+	})
+}
+
+// UpdateAuthor sets the "author" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertBulk) UpdateAuthor() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateAuthor()
+	})
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (u *SecurityScanningCommentUpsertBulk) ClearAuthor() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.ClearAuthor()
+	})
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (u *SecurityScanningCommentUpsertBulk) SetTriageStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetTriageStatus(v)
+	})
+}
+
+// UpdateTriageStatus sets the "triage_status" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertBulk) UpdateTriageStatus() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateTriageStatus()
+	})
+}
+
+// ClearTriageStatus clears the value of the "triage_status" field.
+func (u *SecurityScanningCommentUpsertBulk) ClearTriageStatus() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.ClearTriageStatus()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *SecurityScanningCommentUpsertBulk) SetContent(v string) *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertBulk) UpdateContent() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *SecurityScanningCommentUpsertBulk) ClearContent() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningCommentUpsertBulk) SetCreatedAt(v time.Time) *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningCommentUpsertBulk) UpdateCreatedAt() *SecurityScanningCommentUpsertBulk {
+	return u.Update(func(s *SecurityScanningCommentUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityScanningCommentUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the SecurityScanningCommentCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityScanningCommentCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityScanningCommentUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+)
+
+// SecurityScanningCommentDelete is the builder for deleting a SecurityScanningComment entity.
+type SecurityScanningCommentDelete struct {
+	config
+	hooks    []Hook
+	mutation *SecurityScanningCommentMutation
+}
+
+// Where appends a list predicates to the SecurityScanningCommentDelete builder.
+func (sscd *SecurityScanningCommentDelete) Where(ps ...predicate.SecurityScanningComment) *SecurityScanningCommentDelete {
+	sscd.mutation.Where(ps...)
+	return sscd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sscd *SecurityScanningCommentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sscd.sqlExec, sscd.mutation, sscd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscd *SecurityScanningCommentDelete) ExecX(ctx context.Context) int {
+	n, err := sscd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sscd *SecurityScanningCommentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(securityscanningcomment.Table, sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID))
+	if ps := sscd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sscd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sscd.mutation.done = true
+	return affected, err
+}
+
+// SecurityScanningCommentDeleteOne is the builder for deleting a single SecurityScanningComment entity.
+type SecurityScanningCommentDeleteOne struct {
+	sscd *SecurityScanningCommentDelete
+}
+
+// Where appends a list predicates to the SecurityScanningCommentDelete builder.
+func (sscdo *SecurityScanningCommentDeleteOne) Where(ps ...predicate.SecurityScanningComment) *SecurityScanningCommentDeleteOne {
+	sscdo.sscd.mutation.Where(ps...)
+	return sscdo
+}
+
+// Exec executes the deletion query.
+func (sscdo *SecurityScanningCommentDeleteOne) Exec(ctx context.Context) error {
+	n, err := sscdo.sscd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{securityscanningcomment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscdo *SecurityScanningCommentDeleteOne) ExecX(ctx context.Context) {
+	if err := sscdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,657 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningCommentQuery is the builder for querying SecurityScanningComment entities.
+type SecurityScanningCommentQuery struct {
+	config
+	ctx        *QueryContext
+	order      []securityscanningcomment.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SecurityScanningComment
+	withResult *SecurityScanningResultQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SecurityScanningCommentQuery builder.
+func (sscq *SecurityScanningCommentQuery) Where(ps ...predicate.SecurityScanningComment) *SecurityScanningCommentQuery {
+	sscq.predicates = append(sscq.predicates, ps...)
+	return sscq
+}
+
+// Limit the number of records to be returned by this query.
+func (sscq *SecurityScanningCommentQuery) Limit(limit int) *SecurityScanningCommentQuery {
+	sscq.ctx.Limit = &limit
+	return sscq
+}
+
+// Offset to start from.
+func (sscq *SecurityScanningCommentQuery) Offset(offset int) *SecurityScanningCommentQuery {
+	sscq.ctx.Offset = &offset
+	return sscq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sscq *SecurityScanningCommentQuery) Unique(unique bool) *SecurityScanningCommentQuery {
+	sscq.ctx.Unique = &unique
+	return sscq
+}
+
+// Order specifies how the records should be ordered.
+func (sscq *SecurityScanningCommentQuery) Order(o ...securityscanningcomment.OrderOption) *SecurityScanningCommentQuery {
+	sscq.order = append(sscq.order, o...)
+	return sscq
+}
+
+// QueryResult chains the current query on the "result" edge.
+func (sscq *SecurityScanningCommentQuery) QueryResult() *SecurityScanningResultQuery {
+	query := (&SecurityScanningResultClient{config: sscq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sscq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sscq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityscanningcomment.Table, securityscanningcomment.FieldID, selector),
+			sqlgraph.To(securityscanningresult.Table, securityscanningresult.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, securityscanningcomment.ResultTable, securityscanningcomment.ResultColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sscq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first SecurityScanningComment entity from the query.
+// Returns a *NotFoundError when no SecurityScanningComment was found.
+func (sscq *SecurityScanningCommentQuery) First(ctx context.Context) (*SecurityScanningComment, error) {
+	nodes, err := sscq.Limit(1).All(setContextOp(ctx, sscq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{securityscanningcomment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) FirstX(ctx context.Context) *SecurityScanningComment {
+	node, err := sscq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SecurityScanningComment ID from the query.
+// Returns a *NotFoundError when no SecurityScanningComment ID was found.
+func (sscq *SecurityScanningCommentQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sscq.Limit(1).IDs(setContextOp(ctx, sscq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{securityscanningcomment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := sscq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SecurityScanningComment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SecurityScanningComment entity is found.
+// Returns a *NotFoundError when no SecurityScanningComment entities are found.
+func (sscq *SecurityScanningCommentQuery) Only(ctx context.Context) (*SecurityScanningComment, error) {
+	nodes, err := sscq.Limit(2).All(setContextOp(ctx, sscq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{securityscanningcomment.Label}
+	default:
+		return nil, &NotSingularError{securityscanningcomment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) OnlyX(ctx context.Context) *SecurityScanningComment {
+	node, err := sscq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SecurityScanningComment ID in the query.
+// Returns a *NotSingularError when more than one SecurityScanningComment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sscq *SecurityScanningCommentQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sscq.Limit(2).IDs(setContextOp(ctx, sscq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{securityscanningcomment.Label}
+	default:
+		err = &NotSingularError{securityscanningcomment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := sscq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SecurityScanningComments.
+func (sscq *SecurityScanningCommentQuery) All(ctx context.Context) ([]*SecurityScanningComment, error) {
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryAll)
+	if err := sscq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SecurityScanningComment, *SecurityScanningCommentQuery]()
+	return withInterceptors[[]*SecurityScanningComment](ctx, sscq, qr, sscq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) AllX(ctx context.Context) []*SecurityScanningComment {
+	nodes, err := sscq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SecurityScanningComment IDs.
+func (sscq *SecurityScanningCommentQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if sscq.ctx.Unique == nil && sscq.path != nil {
+		sscq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryIDs)
+	if err = sscq.Select(securityscanningcomment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := sscq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sscq *SecurityScanningCommentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryCount)
+	if err := sscq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sscq, querierCount[*SecurityScanningCommentQuery](), sscq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) CountX(ctx context.Context) int {
+	count, err := sscq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sscq *SecurityScanningCommentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sscq.ctx, ent.OpQueryExist)
+	switch _, err := sscq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sscq *SecurityScanningCommentQuery) ExistX(ctx context.Context) bool {
+	exist, err := sscq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SecurityScanningCommentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sscq *SecurityScanningCommentQuery) Clone() *SecurityScanningCommentQuery {
+	if sscq == nil {
+		return nil
+	}
+	return &SecurityScanningCommentQuery{
+		config:     sscq.config,
+		ctx:        sscq.ctx.Clone(),
+		order:      append([]securityscanningcomment.OrderOption{}, sscq.order...),
+		inters:     append([]Interceptor{}, sscq.inters...),
+		predicates: append([]predicate.SecurityScanningComment{}, sscq.predicates...),
+		withResult: sscq.withResult.Clone(),
+		// clone intermediate query.
+		sql:       sscq.sql.Clone(),
+		path:      sscq.path,
+		modifiers: append([]func(*sql.Selector){}, sscq.modifiers...),
+	}
+}
+
+// WithResult tells the query-builder to eager-load the nodes that are connected to
+// the "result" edge. The optional arguments are used to configure the query builder of the edge.
+func (sscq *SecurityScanningCommentQuery) WithResult(opts ...func(*SecurityScanningResultQuery)) *SecurityScanningCommentQuery {
+	query := (&SecurityScanningResultClient{config: sscq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sscq.withResult = query
+	return sscq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ResultID uuid.UUID `json:"result_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SecurityScanningComment.Query().
+//		GroupBy(securityscanningcomment.FieldResultID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (sscq *SecurityScanningCommentQuery) GroupBy(field string, fields ...string) *SecurityScanningCommentGroupBy {
+	sscq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SecurityScanningCommentGroupBy{build: sscq}
+	grbuild.flds = &sscq.ctx.Fields
+	grbuild.label = securityscanningcomment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ResultID uuid.UUID `json:"result_id,omitempty"`
+//	}
+//
+//	client.SecurityScanningComment.Query().
+//		Select(securityscanningcomment.FieldResultID).
+//		Scan(ctx, &v)
+func (sscq *SecurityScanningCommentQuery) Select(fields ...string) *SecurityScanningCommentSelect {
+	sscq.ctx.Fields = append(sscq.ctx.Fields, fields...)
+	sbuild := &SecurityScanningCommentSelect{SecurityScanningCommentQuery: sscq}
+	sbuild.label = securityscanningcomment.Label
+	sbuild.flds, sbuild.scan = &sscq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SecurityScanningCommentSelect configured with the given aggregations.
+func (sscq *SecurityScanningCommentQuery) Aggregate(fns ...AggregateFunc) *SecurityScanningCommentSelect {
+	return sscq.Select().Aggregate(fns...)
+}
+
+func (sscq *SecurityScanningCommentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sscq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sscq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sscq.ctx.Fields {
+		if !securityscanningcomment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if sscq.path != nil {
+		prev, err := sscq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sscq.sql = prev
+	}
+	return nil
+}
+
+func (sscq *SecurityScanningCommentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SecurityScanningComment, error) {
+	var (
+		nodes       = []*SecurityScanningComment{}
+		_spec       = sscq.querySpec()
+		loadedTypes = [1]bool{
+			sscq.withResult != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SecurityScanningComment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SecurityScanningComment{config: sscq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(sscq.modifiers) > 0 {
+		_spec.Modifiers = sscq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sscq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := sscq.withResult; query != nil {
+		if err := sscq.loadResult(ctx, query, nodes, nil,
+			func(n *SecurityScanningComment, e *SecurityScanningResult) { n.Edges.Result = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (sscq *SecurityScanningCommentQuery) loadResult(ctx context.Context, query *SecurityScanningResultQuery, nodes []*SecurityScanningComment, init func(*SecurityScanningComment), assign func(*SecurityScanningComment, *SecurityScanningResult)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SecurityScanningComment)
+	for i := range nodes {
+		fk := nodes[i].ResultID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(securityscanningresult.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "result_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (sscq *SecurityScanningCommentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sscq.querySpec()
+	if len(sscq.modifiers) > 0 {
+		_spec.Modifiers = sscq.modifiers
+	}
+	_spec.Node.Columns = sscq.ctx.Fields
+	if len(sscq.ctx.Fields) > 0 {
+		_spec.Unique = sscq.ctx.Unique != nil && *sscq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sscq.driver, _spec)
+}
+
+func (sscq *SecurityScanningCommentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(securityscanningcomment.Table, securityscanningcomment.Columns, sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID))
+	_spec.From = sscq.sql
+	if unique := sscq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sscq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sscq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityscanningcomment.FieldID)
+		for i := range fields {
+			if fields[i] != securityscanningcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if sscq.withResult != nil {
+			_spec.Node.AddColumnOnce(securityscanningcomment.FieldResultID)
+		}
+	}
+	if ps := sscq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sscq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sscq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sscq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sscq *SecurityScanningCommentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sscq.driver.Dialect())
+	t1 := builder.Table(securityscanningcomment.Table)
+	columns := sscq.ctx.Fields
+	if len(columns) == 0 {
+		columns = securityscanningcomment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sscq.sql != nil {
+		selector = sscq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sscq.ctx.Unique != nil && *sscq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sscq.modifiers {
+		m(selector)
+	}
+	for _, p := range sscq.predicates {
+		p(selector)
+	}
+	for _, p := range sscq.order {
+		p(selector)
+	}
+	if offset := sscq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sscq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sscq *SecurityScanningCommentQuery) ForUpdate(opts ...sql.LockOption) *SecurityScanningCommentQuery {
+	if sscq.driver.Dialect() == dialect.Postgres {
+		sscq.Unique(false)
+	}
+	sscq.modifiers = append(sscq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sscq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sscq *SecurityScanningCommentQuery) ForShare(opts ...sql.LockOption) *SecurityScanningCommentQuery {
+	if sscq.driver.Dialect() == dialect.Postgres {
+		sscq.Unique(false)
+	}
+	sscq.modifiers = append(sscq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sscq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sscq *SecurityScanningCommentQuery) Modify(modifiers ...func(s *sql.Selector)) *SecurityScanningCommentSelect {
+	sscq.modifiers = append(sscq.modifiers, modifiers...)
+	return sscq.Select()
+}
+
+// SecurityScanningCommentGroupBy is the group-by builder for SecurityScanningComment entities.
+type SecurityScanningCommentGroupBy struct {
+	selector
+	build *SecurityScanningCommentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sscgb *SecurityScanningCommentGroupBy) Aggregate(fns ...AggregateFunc) *SecurityScanningCommentGroupBy {
+	sscgb.fns = append(sscgb.fns, fns...)
+	return sscgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sscgb *SecurityScanningCommentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sscgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sscgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityScanningCommentQuery, *SecurityScanningCommentGroupBy](ctx, sscgb.build, sscgb, sscgb.build.inters, v)
+}
+
+func (sscgb *SecurityScanningCommentGroupBy) sqlScan(ctx context.Context, root *SecurityScanningCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sscgb.fns))
+	for _, fn := range sscgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sscgb.flds)+len(sscgb.fns))
+		for _, f := range *sscgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sscgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sscgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SecurityScanningCommentSelect is the builder for selecting fields of SecurityScanningComment entities.
+type SecurityScanningCommentSelect struct {
+	*SecurityScanningCommentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (sscs *SecurityScanningCommentSelect) Aggregate(fns ...AggregateFunc) *SecurityScanningCommentSelect {
+	sscs.fns = append(sscs.fns, fns...)
+	return sscs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sscs *SecurityScanningCommentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sscs.ctx, ent.OpQuerySelect)
+	if err := sscs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityScanningCommentQuery, *SecurityScanningCommentSelect](ctx, sscs.SecurityScanningCommentQuery, sscs, sscs.inters, v)
+}
+
+func (sscs *SecurityScanningCommentSelect) sqlScan(ctx context.Context, root *SecurityScanningCommentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(sscs.fns))
+	for _, fn := range sscs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*sscs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sscs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sscs *SecurityScanningCommentSelect) Modify(modifiers ...func(s *sql.Selector)) *SecurityScanningCommentSelect {
+	sscs.modifiers = append(sscs.modifiers, modifiers...)
+	return sscs
+}
@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningCommentUpdate is the builder for updating SecurityScanningComment entities.
+type SecurityScanningCommentUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SecurityScanningCommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SecurityScanningCommentUpdate builder.
+func (sscu *SecurityScanningCommentUpdate) Where(ps ...predicate.SecurityScanningComment) *SecurityScanningCommentUpdate {
+	sscu.mutation.Where(ps...)
+	return sscu
+}
+
+// SetResultID sets the "result_id" field.
+func (sscu *SecurityScanningCommentUpdate) SetResultID(u uuid.UUID) *SecurityScanningCommentUpdate {
+	sscu.mutation.SetResultID(u)
+	return sscu
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (sscu *SecurityScanningCommentUpdate) SetNillableResultID(u *uuid.UUID) *SecurityScanningCommentUpdate {
+	if u != nil {
+		sscu.SetResultID(*u)
+	}
+	return sscu
+}
+
+// SetAuthorID sets the "author_id" field.
+func (sscu *SecurityScanningCommentUpdate) SetAuthorID(u uuid.UUID) *SecurityScanningCommentUpdate {
+	sscu.mutation.SetAuthorID(u)
+	return sscu
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (sscu *SecurityScanningCommentUpdate) SetNillableAuthorID(u *uuid.UUID) *SecurityScanningCommentUpdate {
+	if u != nil {
+		sscu.SetAuthorID(*u)
+	}
+	return sscu
+}
+
+// SetAuthor sets the "author" field.
+func (sscu *SecurityScanningCommentUpdate) SetAuthor(s string) *SecurityScanningCommentUpdate {
+	sscu.mutation.SetAuthor(s)
+	return sscu
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (sscu *SecurityScanningCommentUpdate) SetNillableAuthor(s *string) *SecurityScanningCommentUpdate {
+	if s != nil {
+		sscu.SetAuthor(*s)
+	}
+	return sscu
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (sscu *SecurityScanningCommentUpdate) ClearAuthor() *SecurityScanningCommentUpdate {
+	sscu.mutation.ClearAuthor()
+	return sscu
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (sscu *SecurityScanningCommentUpdate) SetTriageStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpdate {
+	sscu.mutation.SetTriageStatus(csts)
+	return sscu
+}
+
+// SetNillableTriageStatus sets the "triage_status" field if the given value is not nil.
+func (sscu *SecurityScanningCommentUpdate) SetNillableTriageStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpdate {
+	if csts != nil {
+		sscu.SetTriageStatus(*csts)
+	}
+	return sscu
+}
+
+// ClearTriageStatus clears the value of the "triage_status" field.
+func (sscu *SecurityScanningCommentUpdate) ClearTriageStatus() *SecurityScanningCommentUpdate {
+	sscu.mutation.ClearTriageStatus()
+	return sscu
+}
+
+// SetContent sets the "content" field.
+func (sscu *SecurityScanningCommentUpdate) SetContent(s string) *SecurityScanningCommentUpdate {
+	sscu.mutation.SetContent(s)
+	return sscu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sscu *SecurityScanningCommentUpdate) SetNillableContent(s *string) *SecurityScanningCommentUpdate {
+	if s != nil {
+		sscu.SetContent(*s)
+	}
+	return sscu
+}
+
+// ClearContent clears the value of the "content" field.
+func (sscu *SecurityScanningCommentUpdate) ClearContent() *SecurityScanningCommentUpdate {
+	sscu.mutation.ClearContent()
+	return sscu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sscu *SecurityScanningCommentUpdate) SetCreatedAt(t time.Time) *SecurityScanningCommentUpdate {
+	sscu.mutation.SetCreatedAt(t)
+	return sscu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sscu *SecurityScanningCommentUpdate) SetNillableCreatedAt(t *time.Time) *SecurityScanningCommentUpdate {
+	if t != nil {
+		sscu.SetCreatedAt(*t)
+	}
+	return sscu
+}
+
+// SetResult sets the "result" edge to the SecurityScanningResult entity.
+func (sscu *SecurityScanningCommentUpdate) SetResult(s *SecurityScanningResult) *SecurityScanningCommentUpdate {
+	return sscu.SetResultID(s.ID)
+}
+
+// Mutation returns the SecurityScanningCommentMutation object of the builder.
+func (sscu *SecurityScanningCommentUpdate) Mutation() *SecurityScanningCommentMutation {
+	return sscu.mutation
+}
+
+// ClearResult clears the "result" edge to the SecurityScanningResult entity.
+func (sscu *SecurityScanningCommentUpdate) ClearResult() *SecurityScanningCommentUpdate {
+	sscu.mutation.ClearResult()
+	return sscu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sscu *SecurityScanningCommentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sscu.sqlSave, sscu.mutation, sscu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscu *SecurityScanningCommentUpdate) SaveX(ctx context.Context) int {
+	affected, err := sscu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sscu *SecurityScanningCommentUpdate) Exec(ctx context.Context) error {
+	_, err := sscu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscu *SecurityScanningCommentUpdate) ExecX(ctx context.Context) {
+	if err := sscu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sscu *SecurityScanningCommentUpdate) check() error {
+	if sscu.mutation.ResultCleared() && len(sscu.mutation.ResultIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "SecurityScanningComment.result"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sscu *SecurityScanningCommentUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityScanningCommentUpdate {
+	sscu.modifiers = append(sscu.modifiers, modifiers...)
+	return sscu
+}
+
+func (sscu *SecurityScanningCommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := sscu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityscanningcomment.Table, securityscanningcomment.Columns, sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID))
+	if ps := sscu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sscu.mutation.AuthorID(); ok {
+		_spec.SetField(securityscanningcomment.FieldAuthorID, field.TypeUUID, value)
+	}
+	if value, ok := sscu.mutation.Author(); ok {
+		_spec.SetField(securityscanningcomment.FieldAuthor, field.TypeString, value)
+	}
+	if sscu.mutation.AuthorCleared() {
+		_spec.ClearField(securityscanningcomment.FieldAuthor, field.TypeString)
+	}
+	if value, ok := sscu.mutation.TriageStatus(); ok {
+		_spec.SetField(securityscanningcomment.FieldTriageStatus, field.TypeString, value)
+	}
+	if sscu.mutation.TriageStatusCleared() {
+		_spec.ClearField(securityscanningcomment.FieldTriageStatus, field.TypeString)
+	}
+	if value, ok := sscu.mutation.Content(); ok {
+		_spec.SetField(securityscanningcomment.FieldContent, field.TypeString, value)
+	}
+	if sscu.mutation.ContentCleared() {
+		_spec.ClearField(securityscanningcomment.FieldContent, field.TypeString)
+	}
+	if value, ok := sscu.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningcomment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sscu.mutation.ResultCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityscanningcomment.ResultTable,
+			Columns: []string{securityscanningcomment.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningresult.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sscu.mutation.ResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityscanningcomment.ResultTable,
+			Columns: []string{securityscanningcomment.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sscu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sscu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityscanningcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sscu.mutation.done = true
+	return n, nil
+}
+
+// SecurityScanningCommentUpdateOne is the builder for updating a single SecurityScanningComment entity.
+type SecurityScanningCommentUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SecurityScanningCommentMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetResultID sets the "result_id" field.
+func (sscuo *SecurityScanningCommentUpdateOne) SetResultID(u uuid.UUID) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.SetResultID(u)
+	return sscuo
+}
+
+// SetNillableResultID sets the "result_id" field if the given value is not nil.
+func (sscuo *SecurityScanningCommentUpdateOne) SetNillableResultID(u *uuid.UUID) *SecurityScanningCommentUpdateOne {
+	if u != nil {
+		sscuo.SetResultID(*u)
+	}
+	return sscuo
+}
+
+// SetAuthorID sets the "author_id" field.
+func (sscuo *SecurityScanningCommentUpdateOne) SetAuthorID(u uuid.UUID) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.SetAuthorID(u)
+	return sscuo
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (sscuo *SecurityScanningCommentUpdateOne) SetNillableAuthorID(u *uuid.UUID) *SecurityScanningCommentUpdateOne {
+	if u != nil {
+		sscuo.SetAuthorID(*u)
+	}
+	return sscuo
+}
+
+// SetAuthor sets the "author" field.
+func (sscuo *SecurityScanningCommentUpdateOne) SetAuthor(s string) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.SetAuthor(s)
+	return sscuo
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (sscuo *SecurityScanningCommentUpdateOne) SetNillableAuthor(s *string) *SecurityScanningCommentUpdateOne {
+	if s != nil {
+		sscuo.SetAuthor(*s)
+	}
+	return sscuo
+}
+
+// ClearAuthor clears the value of the "author" field.
+func (sscuo *SecurityScanningCommentUpdateOne) ClearAuthor() *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.ClearAuthor()
+	return sscuo
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (sscuo *SecurityScanningCommentUpdateOne) SetTriageStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.SetTriageStatus(csts)
+	return sscuo
+}
+
+// SetNillableTriageStatus sets the "triage_status" field if the given value is not nil.
+func (sscuo *SecurityScanningCommentUpdateOne) SetNillableTriageStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningCommentUpdateOne {
+	if csts != nil {
+		sscuo.SetTriageStatus(*csts)
+	}
+	return sscuo
+}
+
+// ClearTriageStatus clears the value of the "triage_status" field.
+func (sscuo *SecurityScanningCommentUpdateOne) ClearTriageStatus() *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.ClearTriageStatus()
+	return sscuo
+}
+
+// SetContent sets the "content" field.
+func (sscuo *SecurityScanningCommentUpdateOne) SetContent(s string) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.SetContent(s)
+	return sscuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (sscuo *SecurityScanningCommentUpdateOne) SetNillableContent(s *string) *SecurityScanningCommentUpdateOne {
+	if s != nil {
+		sscuo.SetContent(*s)
+	}
+	return sscuo
+}
+
+// ClearContent clears the value of the "content" field.
+func (sscuo *SecurityScanningCommentUpdateOne) ClearContent() *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.ClearContent()
+	return sscuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sscuo *SecurityScanningCommentUpdateOne) SetCreatedAt(t time.Time) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.SetCreatedAt(t)
+	return sscuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sscuo *SecurityScanningCommentUpdateOne) SetNillableCreatedAt(t *time.Time) *SecurityScanningCommentUpdateOne {
+	if t != nil {
+		sscuo.SetCreatedAt(*t)
+	}
+	return sscuo
+}
+
+// SetResult sets the "result" edge to the SecurityScanningResult entity.
+func (sscuo *SecurityScanningCommentUpdateOne) SetResult(s *SecurityScanningResult) *SecurityScanningCommentUpdateOne {
+	return sscuo.SetResultID(s.ID)
+}
+
+// Mutation returns the SecurityScanningCommentMutation object of the builder.
+func (sscuo *SecurityScanningCommentUpdateOne) Mutation() *SecurityScanningCommentMutation {
+	return sscuo.mutation
+}
+
+// ClearResult clears the "result" edge to the SecurityScanningResult entity.
+func (sscuo *SecurityScanningCommentUpdateOne) ClearResult() *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.ClearResult()
+	return sscuo
+}
+
+// Where appends a list predicates to the SecurityScanningCommentUpdate builder.
+func (sscuo *SecurityScanningCommentUpdateOne) Where(ps ...predicate.SecurityScanningComment) *SecurityScanningCommentUpdateOne {
+	sscuo.mutation.Where(ps...)
+	return sscuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sscuo *SecurityScanningCommentUpdateOne) Select(field string, fields ...string) *SecurityScanningCommentUpdateOne {
+	sscuo.fields = append([]string{field}, fields...)
+	return sscuo
+}
+
+// Save executes the query and returns the updated SecurityScanningComment entity.
+func (sscuo *SecurityScanningCommentUpdateOne) Save(ctx context.Context) (*SecurityScanningComment, error) {
+	return withHooks(ctx, sscuo.sqlSave, sscuo.mutation, sscuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscuo *SecurityScanningCommentUpdateOne) SaveX(ctx context.Context) *SecurityScanningComment {
+	node, err := sscuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sscuo *SecurityScanningCommentUpdateOne) Exec(ctx context.Context) error {
+	_, err := sscuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscuo *SecurityScanningCommentUpdateOne) ExecX(ctx context.Context) {
+	if err := sscuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sscuo *SecurityScanningCommentUpdateOne) check() error {
+	if sscuo.mutation.ResultCleared() && len(sscuo.mutation.ResultIDs()) > 0 {
+		return errors.New(`db: clearing a required unique edge "SecurityScanningComment.result"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sscuo *SecurityScanningCommentUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityScanningCommentUpdateOne {
+	sscuo.modifiers = append(sscuo.modifiers, modifiers...)
+	return sscuo
+}
+
+func (sscuo *SecurityScanningCommentUpdateOne) sqlSave(ctx context.Context) (_node *SecurityScanningComment, err error) {
+	if err := sscuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(securityscanningcomment.Table, securityscanningcomment.Columns, sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID))
+	id, ok := sscuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SecurityScanningComment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sscuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityscanningcomment.FieldID)
+		for _, f := range fields {
+			if !securityscanningcomment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != securityscanningcomment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sscuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sscuo.mutation.AuthorID(); ok {
+		_spec.SetField(securityscanningcomment.FieldAuthorID, field.TypeUUID, value)
+	}
+	if value, ok := sscuo.mutation.Author(); ok {
+		_spec.SetField(securityscanningcomment.FieldAuthor, field.TypeString, value)
+	}
+	if sscuo.mutation.AuthorCleared() {
+		_spec.ClearField(securityscanningcomment.FieldAuthor, field.TypeString)
+	}
+	if value, ok := sscuo.mutation.TriageStatus(); ok {
+		_spec.SetField(securityscanningcomment.FieldTriageStatus, field.TypeString, value)
+	}
+	if sscuo.mutation.TriageStatusCleared() {
+		_spec.ClearField(securityscanningcomment.FieldTriageStatus, field.TypeString)
+	}
+	if value, ok := sscuo.mutation.Content(); ok {
+		_spec.SetField(securityscanningcomment.FieldContent, field.TypeString, value)
+	}
+	if sscuo.mutation.ContentCleared() {
+		_spec.ClearField(securityscanningcomment.FieldContent, field.TypeString)
+	}
+	if value, ok := sscuo.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningcomment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if sscuo.mutation.ResultCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityscanningcomment.ResultTable,
+			Columns: []string{securityscanningcomment.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningresult.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := sscuo.mutation.ResultIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   securityscanningcomment.ResultTable,
+			Columns: []string{securityscanningcomment.ResultColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(sscuo.modifiers...)
+	_node = &SecurityScanningComment{config: sscuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sscuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityscanningcomment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sscuo.mutation.done = true
+	return _node, nil
+}
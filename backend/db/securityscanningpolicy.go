@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningPolicy is the model entity for the SecurityScanningPolicy schema.
+type SecurityScanningPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// 内置语言规则，扫描请求未指定语言时使用
+	Language consts.SecurityScanningLanguage `json:"language,omitempty"`
+	// RulePackIds holds the value of the "rule_pack_ids" field.
+	RulePackIds []uuid.UUID `json:"rule_pack_ids,omitempty"`
+	// MinConfidence holds the value of the "min_confidence" field.
+	MinConfidence consts.SecurityRuleLevel `json:"min_confidence,omitempty"`
+	// MinImpact holds the value of the "min_impact" field.
+	MinImpact consts.SecurityRuleLevel `json:"min_impact,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityScanningPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityscanningpolicy.FieldRulePackIds:
+			values[i] = new([]byte)
+		case securityscanningpolicy.FieldName, securityscanningpolicy.FieldDescription, securityscanningpolicy.FieldLanguage, securityscanningpolicy.FieldMinConfidence, securityscanningpolicy.FieldMinImpact:
+			values[i] = new(sql.NullString)
+		case securityscanningpolicy.FieldCreatedAt, securityscanningpolicy.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case securityscanningpolicy.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityScanningPolicy fields.
+func (ssp *SecurityScanningPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityscanningpolicy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ssp.ID = *value
+			}
+		case securityscanningpolicy.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ssp.Name = value.String
+			}
+		case securityscanningpolicy.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				ssp.Description = value.String
+			}
+		case securityscanningpolicy.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				ssp.Language = consts.SecurityScanningLanguage(value.String)
+			}
+		case securityscanningpolicy.FieldRulePackIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_pack_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ssp.RulePackIds); err != nil {
+					return fmt.Errorf("unmarshal field rule_pack_ids: %w", err)
+				}
+			}
+		case securityscanningpolicy.FieldMinConfidence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min_confidence", values[i])
+			} else if value.Valid {
+				ssp.MinConfidence = consts.SecurityRuleLevel(value.String)
+			}
+		case securityscanningpolicy.FieldMinImpact:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field min_impact", values[i])
+			} else if value.Valid {
+				ssp.MinImpact = consts.SecurityRuleLevel(value.String)
+			}
+		case securityscanningpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ssp.CreatedAt = value.Time
+			}
+		case securityscanningpolicy.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ssp.UpdatedAt = value.Time
+			}
+		default:
+			ssp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityScanningPolicy.
+// This includes values selected through modifiers, order, etc.
+func (ssp *SecurityScanningPolicy) Value(name string) (ent.Value, error) {
+	return ssp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SecurityScanningPolicy.
+// Note that you need to call SecurityScanningPolicy.Unwrap() before calling this method if this SecurityScanningPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ssp *SecurityScanningPolicy) Update() *SecurityScanningPolicyUpdateOne {
+	return NewSecurityScanningPolicyClient(ssp.config).UpdateOne(ssp)
+}
+
+// Unwrap unwraps the SecurityScanningPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ssp *SecurityScanningPolicy) Unwrap() *SecurityScanningPolicy {
+	_tx, ok := ssp.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SecurityScanningPolicy is not a transactional entity")
+	}
+	ssp.config.driver = _tx.drv
+	return ssp
+}
+
+// String implements the fmt.Stringer.
+func (ssp *SecurityScanningPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityScanningPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ssp.ID))
+	builder.WriteString("name=")
+	builder.WriteString(ssp.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(ssp.Description)
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(fmt.Sprintf("%v", ssp.Language))
+	builder.WriteString(", ")
+	builder.WriteString("rule_pack_ids=")
+	builder.WriteString(fmt.Sprintf("%v", ssp.RulePackIds))
+	builder.WriteString(", ")
+	builder.WriteString("min_confidence=")
+	builder.WriteString(fmt.Sprintf("%v", ssp.MinConfidence))
+	builder.WriteString(", ")
+	builder.WriteString("min_impact=")
+	builder.WriteString(fmt.Sprintf("%v", ssp.MinImpact))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ssp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ssp.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityScanningPolicies is a parsable slice of SecurityScanningPolicy.
+type SecurityScanningPolicies []*SecurityScanningPolicy
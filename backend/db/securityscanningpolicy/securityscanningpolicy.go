@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityscanningpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the securityscanningpolicy type in the database.
+	Label = "security_scanning_policy"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldRulePackIds holds the string denoting the rule_pack_ids field in the database.
+	FieldRulePackIds = "rule_pack_ids"
+	// FieldMinConfidence holds the string denoting the min_confidence field in the database.
+	FieldMinConfidence = "min_confidence"
+	// FieldMinImpact holds the string denoting the min_impact field in the database.
+	FieldMinImpact = "min_impact"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the securityscanningpolicy in the database.
+	Table = "security_scanning_policies"
+)
+
+// Columns holds all SQL columns for securityscanningpolicy fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldDescription,
+	FieldLanguage,
+	FieldRulePackIds,
+	FieldMinConfidence,
+	FieldMinImpact,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultMinConfidence holds the default value on creation for the "min_confidence" field.
+	DefaultMinConfidence consts.SecurityRuleLevel
+	// DefaultMinImpact holds the default value on creation for the "min_impact" field.
+	DefaultMinImpact consts.SecurityRuleLevel
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the SecurityScanningPolicy queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByMinConfidence orders the results by the min_confidence field.
+func ByMinConfidence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinConfidence, opts...).ToFunc()
+}
+
+// ByMinImpact orders the results by the min_impact field.
+func ByMinImpact(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinImpact, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityscanningpolicy
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldLanguage, vc))
+}
+
+// MinConfidence applies equality check predicate on the "min_confidence" field. It's identical to MinConfidenceEQ.
+func MinConfidence(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldMinConfidence, vc))
+}
+
+// MinImpact applies equality check predicate on the "min_impact" field. It's identical to MinImpactEQ.
+func MinImpact(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldMinImpact, vc))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldLanguage, vc))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldLanguage, vc))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldLanguage, v...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldLanguage, v...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldLanguage, vc))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldLanguage, vc))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldLanguage, vc))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldLanguage, vc))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldContains(FieldLanguage, vc))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldHasPrefix(FieldLanguage, vc))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldHasSuffix(FieldLanguage, vc))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEqualFold(FieldLanguage, vc))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v consts.SecurityScanningLanguage) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldContainsFold(FieldLanguage, vc))
+}
+
+// RulePackIdsIsNil applies the IsNil predicate on the "rule_pack_ids" field.
+func RulePackIdsIsNil() predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIsNull(FieldRulePackIds))
+}
+
+// RulePackIdsNotNil applies the NotNil predicate on the "rule_pack_ids" field.
+func RulePackIdsNotNil() predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotNull(FieldRulePackIds))
+}
+
+// MinConfidenceEQ applies the EQ predicate on the "min_confidence" field.
+func MinConfidenceEQ(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldMinConfidence, vc))
+}
+
+// MinConfidenceNEQ applies the NEQ predicate on the "min_confidence" field.
+func MinConfidenceNEQ(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldMinConfidence, vc))
+}
+
+// MinConfidenceIn applies the In predicate on the "min_confidence" field.
+func MinConfidenceIn(vs ...consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldMinConfidence, v...))
+}
+
+// MinConfidenceNotIn applies the NotIn predicate on the "min_confidence" field.
+func MinConfidenceNotIn(vs ...consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldMinConfidence, v...))
+}
+
+// MinConfidenceGT applies the GT predicate on the "min_confidence" field.
+func MinConfidenceGT(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldMinConfidence, vc))
+}
+
+// MinConfidenceGTE applies the GTE predicate on the "min_confidence" field.
+func MinConfidenceGTE(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldMinConfidence, vc))
+}
+
+// MinConfidenceLT applies the LT predicate on the "min_confidence" field.
+func MinConfidenceLT(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldMinConfidence, vc))
+}
+
+// MinConfidenceLTE applies the LTE predicate on the "min_confidence" field.
+func MinConfidenceLTE(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldMinConfidence, vc))
+}
+
+// MinConfidenceContains applies the Contains predicate on the "min_confidence" field.
+func MinConfidenceContains(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldContains(FieldMinConfidence, vc))
+}
+
+// MinConfidenceHasPrefix applies the HasPrefix predicate on the "min_confidence" field.
+func MinConfidenceHasPrefix(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldHasPrefix(FieldMinConfidence, vc))
+}
+
+// MinConfidenceHasSuffix applies the HasSuffix predicate on the "min_confidence" field.
+func MinConfidenceHasSuffix(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldHasSuffix(FieldMinConfidence, vc))
+}
+
+// MinConfidenceEqualFold applies the EqualFold predicate on the "min_confidence" field.
+func MinConfidenceEqualFold(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEqualFold(FieldMinConfidence, vc))
+}
+
+// MinConfidenceContainsFold applies the ContainsFold predicate on the "min_confidence" field.
+func MinConfidenceContainsFold(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldContainsFold(FieldMinConfidence, vc))
+}
+
+// MinImpactEQ applies the EQ predicate on the "min_impact" field.
+func MinImpactEQ(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldMinImpact, vc))
+}
+
+// MinImpactNEQ applies the NEQ predicate on the "min_impact" field.
+func MinImpactNEQ(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldMinImpact, vc))
+}
+
+// MinImpactIn applies the In predicate on the "min_impact" field.
+func MinImpactIn(vs ...consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldMinImpact, v...))
+}
+
+// MinImpactNotIn applies the NotIn predicate on the "min_impact" field.
+func MinImpactNotIn(vs ...consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldMinImpact, v...))
+}
+
+// MinImpactGT applies the GT predicate on the "min_impact" field.
+func MinImpactGT(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldMinImpact, vc))
+}
+
+// MinImpactGTE applies the GTE predicate on the "min_impact" field.
+func MinImpactGTE(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldMinImpact, vc))
+}
+
+// MinImpactLT applies the LT predicate on the "min_impact" field.
+func MinImpactLT(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldMinImpact, vc))
+}
+
+// MinImpactLTE applies the LTE predicate on the "min_impact" field.
+func MinImpactLTE(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldMinImpact, vc))
+}
+
+// MinImpactContains applies the Contains predicate on the "min_impact" field.
+func MinImpactContains(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldContains(FieldMinImpact, vc))
+}
+
+// MinImpactHasPrefix applies the HasPrefix predicate on the "min_impact" field.
+func MinImpactHasPrefix(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldHasPrefix(FieldMinImpact, vc))
+}
+
+// MinImpactHasSuffix applies the HasSuffix predicate on the "min_impact" field.
+func MinImpactHasSuffix(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldHasSuffix(FieldMinImpact, vc))
+}
+
+// MinImpactEqualFold applies the EqualFold predicate on the "min_impact" field.
+func MinImpactEqualFold(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldEqualFold(FieldMinImpact, vc))
+}
+
+// MinImpactContainsFold applies the ContainsFold predicate on the "min_impact" field.
+func MinImpactContainsFold(v consts.SecurityRuleLevel) predicate.SecurityScanningPolicy {
+	vc := string(v)
+	return predicate.SecurityScanningPolicy(sql.FieldContainsFold(FieldMinImpact, vc))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SecurityScanningPolicy) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SecurityScanningPolicy) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SecurityScanningPolicy) predicate.SecurityScanningPolicy {
+	return predicate.SecurityScanningPolicy(sql.NotPredicates(p))
+}
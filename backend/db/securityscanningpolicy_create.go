@@ -0,0 +1,996 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningPolicyCreate is the builder for creating a SecurityScanningPolicy entity.
+type SecurityScanningPolicyCreate struct {
+	config
+	mutation *SecurityScanningPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (sspc *SecurityScanningPolicyCreate) SetName(s string) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetName(s)
+	return sspc
+}
+
+// SetDescription sets the "description" field.
+func (sspc *SecurityScanningPolicyCreate) SetDescription(s string) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetDescription(s)
+	return sspc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableDescription(s *string) *SecurityScanningPolicyCreate {
+	if s != nil {
+		sspc.SetDescription(*s)
+	}
+	return sspc
+}
+
+// SetLanguage sets the "language" field.
+func (sspc *SecurityScanningPolicyCreate) SetLanguage(csl consts.SecurityScanningLanguage) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetLanguage(csl)
+	return sspc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableLanguage(csl *consts.SecurityScanningLanguage) *SecurityScanningPolicyCreate {
+	if csl != nil {
+		sspc.SetLanguage(*csl)
+	}
+	return sspc
+}
+
+// SetRulePackIds sets the "rule_pack_ids" field.
+func (sspc *SecurityScanningPolicyCreate) SetRulePackIds(u []uuid.UUID) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetRulePackIds(u)
+	return sspc
+}
+
+// SetMinConfidence sets the "min_confidence" field.
+func (sspc *SecurityScanningPolicyCreate) SetMinConfidence(crl consts.SecurityRuleLevel) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetMinConfidence(crl)
+	return sspc
+}
+
+// SetNillableMinConfidence sets the "min_confidence" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableMinConfidence(crl *consts.SecurityRuleLevel) *SecurityScanningPolicyCreate {
+	if crl != nil {
+		sspc.SetMinConfidence(*crl)
+	}
+	return sspc
+}
+
+// SetMinImpact sets the "min_impact" field.
+func (sspc *SecurityScanningPolicyCreate) SetMinImpact(crl consts.SecurityRuleLevel) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetMinImpact(crl)
+	return sspc
+}
+
+// SetNillableMinImpact sets the "min_impact" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableMinImpact(crl *consts.SecurityRuleLevel) *SecurityScanningPolicyCreate {
+	if crl != nil {
+		sspc.SetMinImpact(*crl)
+	}
+	return sspc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sspc *SecurityScanningPolicyCreate) SetCreatedAt(t time.Time) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetCreatedAt(t)
+	return sspc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableCreatedAt(t *time.Time) *SecurityScanningPolicyCreate {
+	if t != nil {
+		sspc.SetCreatedAt(*t)
+	}
+	return sspc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sspc *SecurityScanningPolicyCreate) SetUpdatedAt(t time.Time) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetUpdatedAt(t)
+	return sspc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableUpdatedAt(t *time.Time) *SecurityScanningPolicyCreate {
+	if t != nil {
+		sspc.SetUpdatedAt(*t)
+	}
+	return sspc
+}
+
+// SetID sets the "id" field.
+func (sspc *SecurityScanningPolicyCreate) SetID(u uuid.UUID) *SecurityScanningPolicyCreate {
+	sspc.mutation.SetID(u)
+	return sspc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sspc *SecurityScanningPolicyCreate) SetNillableID(u *uuid.UUID) *SecurityScanningPolicyCreate {
+	if u != nil {
+		sspc.SetID(*u)
+	}
+	return sspc
+}
+
+// Mutation returns the SecurityScanningPolicyMutation object of the builder.
+func (sspc *SecurityScanningPolicyCreate) Mutation() *SecurityScanningPolicyMutation {
+	return sspc.mutation
+}
+
+// Save creates the SecurityScanningPolicy in the database.
+func (sspc *SecurityScanningPolicyCreate) Save(ctx context.Context) (*SecurityScanningPolicy, error) {
+	sspc.defaults()
+	return withHooks(ctx, sspc.sqlSave, sspc.mutation, sspc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sspc *SecurityScanningPolicyCreate) SaveX(ctx context.Context) *SecurityScanningPolicy {
+	v, err := sspc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sspc *SecurityScanningPolicyCreate) Exec(ctx context.Context) error {
+	_, err := sspc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspc *SecurityScanningPolicyCreate) ExecX(ctx context.Context) {
+	if err := sspc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sspc *SecurityScanningPolicyCreate) defaults() {
+	if _, ok := sspc.mutation.MinConfidence(); !ok {
+		v := securityscanningpolicy.DefaultMinConfidence
+		sspc.mutation.SetMinConfidence(v)
+	}
+	if _, ok := sspc.mutation.MinImpact(); !ok {
+		v := securityscanningpolicy.DefaultMinImpact
+		sspc.mutation.SetMinImpact(v)
+	}
+	if _, ok := sspc.mutation.CreatedAt(); !ok {
+		v := securityscanningpolicy.DefaultCreatedAt()
+		sspc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sspc.mutation.UpdatedAt(); !ok {
+		v := securityscanningpolicy.DefaultUpdatedAt()
+		sspc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := sspc.mutation.ID(); !ok {
+		v := securityscanningpolicy.DefaultID()
+		sspc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sspc *SecurityScanningPolicyCreate) check() error {
+	if _, ok := sspc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "SecurityScanningPolicy.name"`)}
+	}
+	if _, ok := sspc.mutation.MinConfidence(); !ok {
+		return &ValidationError{Name: "min_confidence", err: errors.New(`db: missing required field "SecurityScanningPolicy.min_confidence"`)}
+	}
+	if _, ok := sspc.mutation.MinImpact(); !ok {
+		return &ValidationError{Name: "min_impact", err: errors.New(`db: missing required field "SecurityScanningPolicy.min_impact"`)}
+	}
+	if _, ok := sspc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityScanningPolicy.created_at"`)}
+	}
+	if _, ok := sspc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "SecurityScanningPolicy.updated_at"`)}
+	}
+	return nil
+}
+
+func (sspc *SecurityScanningPolicyCreate) sqlSave(ctx context.Context) (*SecurityScanningPolicy, error) {
+	if err := sspc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sspc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sspc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sspc.mutation.id = &_node.ID
+	sspc.mutation.done = true
+	return _node, nil
+}
+
+func (sspc *SecurityScanningPolicyCreate) createSpec() (*SecurityScanningPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityScanningPolicy{config: sspc.config}
+		_spec = sqlgraph.NewCreateSpec(securityscanningpolicy.Table, sqlgraph.NewFieldSpec(securityscanningpolicy.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sspc.conflict
+	if id, ok := sspc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sspc.mutation.Name(); ok {
+		_spec.SetField(securityscanningpolicy.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := sspc.mutation.Description(); ok {
+		_spec.SetField(securityscanningpolicy.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := sspc.mutation.Language(); ok {
+		_spec.SetField(securityscanningpolicy.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := sspc.mutation.RulePackIds(); ok {
+		_spec.SetField(securityscanningpolicy.FieldRulePackIds, field.TypeJSON, value)
+		_node.RulePackIds = value
+	}
+	if value, ok := sspc.mutation.MinConfidence(); ok {
+		_spec.SetField(securityscanningpolicy.FieldMinConfidence, field.TypeString, value)
+		_node.MinConfidence = value
+	}
+	if value, ok := sspc.mutation.MinImpact(); ok {
+		_spec.SetField(securityscanningpolicy.FieldMinImpact, field.TypeString, value)
+		_node.MinImpact = value
+	}
+	if value, ok := sspc.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sspc.mutation.UpdatedAt(); ok {
+		_spec.SetField(securityscanningpolicy.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityScanningPolicy.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityScanningPolicyUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (sspc *SecurityScanningPolicyCreate) OnConflict(opts ...sql.ConflictOption) *SecurityScanningPolicyUpsertOne {
+	sspc.conflict = opts
+	return &SecurityScanningPolicyUpsertOne{
+		create: sspc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityScanningPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sspc *SecurityScanningPolicyCreate) OnConflictColumns(columns ...string) *SecurityScanningPolicyUpsertOne {
+	sspc.conflict = append(sspc.conflict, sql.ConflictColumns(columns...))
+	return &SecurityScanningPolicyUpsertOne{
+		create: sspc,
+	}
+}
+
+type (
+	// SecurityScanningPolicyUpsertOne is the builder for "upsert"-ing
+	//  one SecurityScanningPolicy node.
+	SecurityScanningPolicyUpsertOne struct {
+		create *SecurityScanningPolicyCreate
+	}
+
+	// SecurityScanningPolicyUpsert is the "OnConflict" setter.
+	SecurityScanningPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *SecurityScanningPolicyUpsert) SetName(v string) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateName() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *SecurityScanningPolicyUpsert) SetDescription(v string) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateDescription() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecurityScanningPolicyUpsert) ClearDescription() *SecurityScanningPolicyUpsert {
+	u.SetNull(securityscanningpolicy.FieldDescription)
+	return u
+}
+
+// SetLanguage sets the "language" field.
+func (u *SecurityScanningPolicyUpsert) SetLanguage(v consts.SecurityScanningLanguage) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldLanguage, v)
+	return u
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateLanguage() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldLanguage)
+	return u
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (u *SecurityScanningPolicyUpsert) ClearLanguage() *SecurityScanningPolicyUpsert {
+	u.SetNull(securityscanningpolicy.FieldLanguage)
+	return u
+}
+
+// SetRulePackIds sets the "rule_pack_ids" field.
+func (u *SecurityScanningPolicyUpsert) SetRulePackIds(v []uuid.UUID) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldRulePackIds, v)
+	return u
+}
+
+// UpdateRulePackIds sets the "rule_pack_ids" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateRulePackIds() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldRulePackIds)
+	return u
+}
+
+// ClearRulePackIds clears the value of the "rule_pack_ids" field.
+func (u *SecurityScanningPolicyUpsert) ClearRulePackIds() *SecurityScanningPolicyUpsert {
+	u.SetNull(securityscanningpolicy.FieldRulePackIds)
+	return u
+}
+
+// SetMinConfidence sets the "min_confidence" field.
+func (u *SecurityScanningPolicyUpsert) SetMinConfidence(v consts.SecurityRuleLevel) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldMinConfidence, v)
+	return u
+}
+
+// UpdateMinConfidence sets the "min_confidence" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateMinConfidence() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldMinConfidence)
+	return u
+}
+
+// SetMinImpact sets the "min_impact" field.
+func (u *SecurityScanningPolicyUpsert) SetMinImpact(v consts.SecurityRuleLevel) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldMinImpact, v)
+	return u
+}
+
+// UpdateMinImpact sets the "min_impact" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateMinImpact() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldMinImpact)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningPolicyUpsert) SetCreatedAt(v time.Time) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateCreatedAt() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecurityScanningPolicyUpsert) SetUpdatedAt(v time.Time) *SecurityScanningPolicyUpsert {
+	u.Set(securityscanningpolicy.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsert) UpdateUpdatedAt() *SecurityScanningPolicyUpsert {
+	u.SetExcluded(securityscanningpolicy.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityscanningpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityScanningPolicyUpsertOne) UpdateNewValues() *SecurityScanningPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(securityscanningpolicy.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecurityScanningPolicyUpsertOne) Ignore() *SecurityScanningPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityScanningPolicyUpsertOne) DoNothing() *SecurityScanningPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityScanningPolicyCreate.OnConflict
+// documentation for more info.
+func (u *SecurityScanningPolicyUpsertOne) Update(set func(*SecurityScanningPolicyUpsert)) *SecurityScanningPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityScanningPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SecurityScanningPolicyUpsertOne) SetName(v string) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateName() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecurityScanningPolicyUpsertOne) SetDescription(v string) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateDescription() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecurityScanningPolicyUpsertOne) ClearDescription() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *SecurityScanningPolicyUpsertOne) SetLanguage(v consts.SecurityScanningLanguage) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateLanguage() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (u *SecurityScanningPolicyUpsertOne) ClearLanguage() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.ClearLanguage()
+	})
+}
+
+// SetRulePackIds sets the "rule_pack_ids" field.
+func (u *SecurityScanningPolicyUpsertOne) SetRulePackIds(v []uuid.UUID) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetRulePackIds(v)
+	})
+}
+
+// UpdateRulePackIds sets the "rule_pack_ids" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateRulePackIds() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateRulePackIds()
+	})
+}
+
+// ClearRulePackIds clears the value of the "rule_pack_ids" field.
+func (u *SecurityScanningPolicyUpsertOne) ClearRulePackIds() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.ClearRulePackIds()
+	})
+}
+
+// SetMinConfidence sets the "min_confidence" field.
+func (u *SecurityScanningPolicyUpsertOne) SetMinConfidence(v consts.SecurityRuleLevel) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetMinConfidence(v)
+	})
+}
+
+// UpdateMinConfidence sets the "min_confidence" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateMinConfidence() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateMinConfidence()
+	})
+}
+
+// SetMinImpact sets the "min_impact" field.
+func (u *SecurityScanningPolicyUpsertOne) SetMinImpact(v consts.SecurityRuleLevel) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetMinImpact(v)
+	})
+}
+
+// UpdateMinImpact sets the "min_impact" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateMinImpact() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateMinImpact()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningPolicyUpsertOne) SetCreatedAt(v time.Time) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateCreatedAt() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecurityScanningPolicyUpsertOne) SetUpdatedAt(v time.Time) *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertOne) UpdateUpdatedAt() *SecurityScanningPolicyUpsertOne {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityScanningPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityScanningPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityScanningPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecurityScanningPolicyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: SecurityScanningPolicyUpsertOne.ID is not supported by MySQL driver. Use SecurityScanningPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecurityScanningPolicyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecurityScanningPolicyCreateBulk is the builder for creating many SecurityScanningPolicy entities in bulk.
+type SecurityScanningPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityScanningPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SecurityScanningPolicy entities in the database.
+func (sspcb *SecurityScanningPolicyCreateBulk) Save(ctx context.Context) ([]*SecurityScanningPolicy, error) {
+	if sspcb.err != nil {
+		return nil, sspcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sspcb.builders))
+	nodes := make([]*SecurityScanningPolicy, len(sspcb.builders))
+	mutators := make([]Mutator, len(sspcb.builders))
+	for i := range sspcb.builders {
+		func(i int, root context.Context) {
+			builder := sspcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityScanningPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sspcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = sspcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sspcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sspcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sspcb *SecurityScanningPolicyCreateBulk) SaveX(ctx context.Context) []*SecurityScanningPolicy {
+	v, err := sspcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sspcb *SecurityScanningPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := sspcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspcb *SecurityScanningPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := sspcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityScanningPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityScanningPolicyUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (sspcb *SecurityScanningPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecurityScanningPolicyUpsertBulk {
+	sspcb.conflict = opts
+	return &SecurityScanningPolicyUpsertBulk{
+		create: sspcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityScanningPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sspcb *SecurityScanningPolicyCreateBulk) OnConflictColumns(columns ...string) *SecurityScanningPolicyUpsertBulk {
+	sspcb.conflict = append(sspcb.conflict, sql.ConflictColumns(columns...))
+	return &SecurityScanningPolicyUpsertBulk{
+		create: sspcb,
+	}
+}
+
+// SecurityScanningPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of SecurityScanningPolicy nodes.
+type SecurityScanningPolicyUpsertBulk struct {
+	create *SecurityScanningPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SecurityScanningPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityscanningpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityScanningPolicyUpsertBulk) UpdateNewValues() *SecurityScanningPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(securityscanningpolicy.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecurityScanningPolicyUpsertBulk) Ignore() *SecurityScanningPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityScanningPolicyUpsertBulk) DoNothing() *SecurityScanningPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityScanningPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecurityScanningPolicyUpsertBulk) Update(set func(*SecurityScanningPolicyUpsert)) *SecurityScanningPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityScanningPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetName(v string) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateName() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetDescription(v string) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateDescription() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *SecurityScanningPolicyUpsertBulk) ClearDescription() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetLanguage sets the "language" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetLanguage(v consts.SecurityScanningLanguage) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetLanguage(v)
+	})
+}
+
+// UpdateLanguage sets the "language" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateLanguage() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateLanguage()
+	})
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (u *SecurityScanningPolicyUpsertBulk) ClearLanguage() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.ClearLanguage()
+	})
+}
+
+// SetRulePackIds sets the "rule_pack_ids" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetRulePackIds(v []uuid.UUID) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetRulePackIds(v)
+	})
+}
+
+// UpdateRulePackIds sets the "rule_pack_ids" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateRulePackIds() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateRulePackIds()
+	})
+}
+
+// ClearRulePackIds clears the value of the "rule_pack_ids" field.
+func (u *SecurityScanningPolicyUpsertBulk) ClearRulePackIds() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.ClearRulePackIds()
+	})
+}
+
+// SetMinConfidence sets the "min_confidence" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetMinConfidence(v consts.SecurityRuleLevel) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetMinConfidence(v)
+	})
+}
+
+// UpdateMinConfidence sets the "min_confidence" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateMinConfidence() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateMinConfidence()
+	})
+}
+
+// SetMinImpact sets the "min_impact" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetMinImpact(v consts.SecurityRuleLevel) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetMinImpact(v)
+	})
+}
+
+// UpdateMinImpact sets the "min_impact" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateMinImpact() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateMinImpact()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetCreatedAt(v time.Time) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateCreatedAt() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SecurityScanningPolicyUpsertBulk) SetUpdatedAt(v time.Time) *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SecurityScanningPolicyUpsertBulk) UpdateUpdatedAt() *SecurityScanningPolicyUpsertBulk {
+	return u.Update(func(s *SecurityScanningPolicyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityScanningPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the SecurityScanningPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityScanningPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityScanningPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
+)
+
+// SecurityScanningPolicyDelete is the builder for deleting a SecurityScanningPolicy entity.
+type SecurityScanningPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *SecurityScanningPolicyMutation
+}
+
+// Where appends a list predicates to the SecurityScanningPolicyDelete builder.
+func (sspd *SecurityScanningPolicyDelete) Where(ps ...predicate.SecurityScanningPolicy) *SecurityScanningPolicyDelete {
+	sspd.mutation.Where(ps...)
+	return sspd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sspd *SecurityScanningPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sspd.sqlExec, sspd.mutation, sspd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspd *SecurityScanningPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := sspd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sspd *SecurityScanningPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(securityscanningpolicy.Table, sqlgraph.NewFieldSpec(securityscanningpolicy.FieldID, field.TypeUUID))
+	if ps := sspd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sspd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sspd.mutation.done = true
+	return affected, err
+}
+
+// SecurityScanningPolicyDeleteOne is the builder for deleting a single SecurityScanningPolicy entity.
+type SecurityScanningPolicyDeleteOne struct {
+	sspd *SecurityScanningPolicyDelete
+}
+
+// Where appends a list predicates to the SecurityScanningPolicyDelete builder.
+func (sspdo *SecurityScanningPolicyDeleteOne) Where(ps ...predicate.SecurityScanningPolicy) *SecurityScanningPolicyDeleteOne {
+	sspdo.sspd.mutation.Where(ps...)
+	return sspdo
+}
+
+// Exec executes the deletion query.
+func (sspdo *SecurityScanningPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := sspdo.sspd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{securityscanningpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspdo *SecurityScanningPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := sspdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningPolicyQuery is the builder for querying SecurityScanningPolicy entities.
+type SecurityScanningPolicyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []securityscanningpolicy.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SecurityScanningPolicy
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SecurityScanningPolicyQuery builder.
+func (sspq *SecurityScanningPolicyQuery) Where(ps ...predicate.SecurityScanningPolicy) *SecurityScanningPolicyQuery {
+	sspq.predicates = append(sspq.predicates, ps...)
+	return sspq
+}
+
+// Limit the number of records to be returned by this query.
+func (sspq *SecurityScanningPolicyQuery) Limit(limit int) *SecurityScanningPolicyQuery {
+	sspq.ctx.Limit = &limit
+	return sspq
+}
+
+// Offset to start from.
+func (sspq *SecurityScanningPolicyQuery) Offset(offset int) *SecurityScanningPolicyQuery {
+	sspq.ctx.Offset = &offset
+	return sspq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sspq *SecurityScanningPolicyQuery) Unique(unique bool) *SecurityScanningPolicyQuery {
+	sspq.ctx.Unique = &unique
+	return sspq
+}
+
+// Order specifies how the records should be ordered.
+func (sspq *SecurityScanningPolicyQuery) Order(o ...securityscanningpolicy.OrderOption) *SecurityScanningPolicyQuery {
+	sspq.order = append(sspq.order, o...)
+	return sspq
+}
+
+// First returns the first SecurityScanningPolicy entity from the query.
+// Returns a *NotFoundError when no SecurityScanningPolicy was found.
+func (sspq *SecurityScanningPolicyQuery) First(ctx context.Context) (*SecurityScanningPolicy, error) {
+	nodes, err := sspq.Limit(1).All(setContextOp(ctx, sspq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{securityscanningpolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) FirstX(ctx context.Context) *SecurityScanningPolicy {
+	node, err := sspq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SecurityScanningPolicy ID from the query.
+// Returns a *NotFoundError when no SecurityScanningPolicy ID was found.
+func (sspq *SecurityScanningPolicyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sspq.Limit(1).IDs(setContextOp(ctx, sspq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{securityscanningpolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := sspq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SecurityScanningPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SecurityScanningPolicy entity is found.
+// Returns a *NotFoundError when no SecurityScanningPolicy entities are found.
+func (sspq *SecurityScanningPolicyQuery) Only(ctx context.Context) (*SecurityScanningPolicy, error) {
+	nodes, err := sspq.Limit(2).All(setContextOp(ctx, sspq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{securityscanningpolicy.Label}
+	default:
+		return nil, &NotSingularError{securityscanningpolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) OnlyX(ctx context.Context) *SecurityScanningPolicy {
+	node, err := sspq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SecurityScanningPolicy ID in the query.
+// Returns a *NotSingularError when more than one SecurityScanningPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sspq *SecurityScanningPolicyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sspq.Limit(2).IDs(setContextOp(ctx, sspq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{securityscanningpolicy.Label}
+	default:
+		err = &NotSingularError{securityscanningpolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := sspq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SecurityScanningPolicies.
+func (sspq *SecurityScanningPolicyQuery) All(ctx context.Context) ([]*SecurityScanningPolicy, error) {
+	ctx = setContextOp(ctx, sspq.ctx, ent.OpQueryAll)
+	if err := sspq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SecurityScanningPolicy, *SecurityScanningPolicyQuery]()
+	return withInterceptors[[]*SecurityScanningPolicy](ctx, sspq, qr, sspq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) AllX(ctx context.Context) []*SecurityScanningPolicy {
+	nodes, err := sspq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SecurityScanningPolicy IDs.
+func (sspq *SecurityScanningPolicyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if sspq.ctx.Unique == nil && sspq.path != nil {
+		sspq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sspq.ctx, ent.OpQueryIDs)
+	if err = sspq.Select(securityscanningpolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := sspq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sspq *SecurityScanningPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sspq.ctx, ent.OpQueryCount)
+	if err := sspq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sspq, querierCount[*SecurityScanningPolicyQuery](), sspq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) CountX(ctx context.Context) int {
+	count, err := sspq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sspq *SecurityScanningPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sspq.ctx, ent.OpQueryExist)
+	switch _, err := sspq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sspq *SecurityScanningPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := sspq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SecurityScanningPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sspq *SecurityScanningPolicyQuery) Clone() *SecurityScanningPolicyQuery {
+	if sspq == nil {
+		return nil
+	}
+	return &SecurityScanningPolicyQuery{
+		config:     sspq.config,
+		ctx:        sspq.ctx.Clone(),
+		order:      append([]securityscanningpolicy.OrderOption{}, sspq.order...),
+		inters:     append([]Interceptor{}, sspq.inters...),
+		predicates: append([]predicate.SecurityScanningPolicy{}, sspq.predicates...),
+		// clone intermediate query.
+		sql:       sspq.sql.Clone(),
+		path:      sspq.path,
+		modifiers: append([]func(*sql.Selector){}, sspq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SecurityScanningPolicy.Query().
+//		GroupBy(securityscanningpolicy.FieldName).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (sspq *SecurityScanningPolicyQuery) GroupBy(field string, fields ...string) *SecurityScanningPolicyGroupBy {
+	sspq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SecurityScanningPolicyGroupBy{build: sspq}
+	grbuild.flds = &sspq.ctx.Fields
+	grbuild.label = securityscanningpolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.SecurityScanningPolicy.Query().
+//		Select(securityscanningpolicy.FieldName).
+//		Scan(ctx, &v)
+func (sspq *SecurityScanningPolicyQuery) Select(fields ...string) *SecurityScanningPolicySelect {
+	sspq.ctx.Fields = append(sspq.ctx.Fields, fields...)
+	sbuild := &SecurityScanningPolicySelect{SecurityScanningPolicyQuery: sspq}
+	sbuild.label = securityscanningpolicy.Label
+	sbuild.flds, sbuild.scan = &sspq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SecurityScanningPolicySelect configured with the given aggregations.
+func (sspq *SecurityScanningPolicyQuery) Aggregate(fns ...AggregateFunc) *SecurityScanningPolicySelect {
+	return sspq.Select().Aggregate(fns...)
+}
+
+func (sspq *SecurityScanningPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sspq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sspq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sspq.ctx.Fields {
+		if !securityscanningpolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if sspq.path != nil {
+		prev, err := sspq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sspq.sql = prev
+	}
+	return nil
+}
+
+func (sspq *SecurityScanningPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SecurityScanningPolicy, error) {
+	var (
+		nodes = []*SecurityScanningPolicy{}
+		_spec = sspq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SecurityScanningPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SecurityScanningPolicy{config: sspq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(sspq.modifiers) > 0 {
+		_spec.Modifiers = sspq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sspq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sspq *SecurityScanningPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sspq.querySpec()
+	if len(sspq.modifiers) > 0 {
+		_spec.Modifiers = sspq.modifiers
+	}
+	_spec.Node.Columns = sspq.ctx.Fields
+	if len(sspq.ctx.Fields) > 0 {
+		_spec.Unique = sspq.ctx.Unique != nil && *sspq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sspq.driver, _spec)
+}
+
+func (sspq *SecurityScanningPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(securityscanningpolicy.Table, securityscanningpolicy.Columns, sqlgraph.NewFieldSpec(securityscanningpolicy.FieldID, field.TypeUUID))
+	_spec.From = sspq.sql
+	if unique := sspq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sspq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sspq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityscanningpolicy.FieldID)
+		for i := range fields {
+			if fields[i] != securityscanningpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sspq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sspq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sspq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sspq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sspq *SecurityScanningPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sspq.driver.Dialect())
+	t1 := builder.Table(securityscanningpolicy.Table)
+	columns := sspq.ctx.Fields
+	if len(columns) == 0 {
+		columns = securityscanningpolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sspq.sql != nil {
+		selector = sspq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sspq.ctx.Unique != nil && *sspq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sspq.modifiers {
+		m(selector)
+	}
+	for _, p := range sspq.predicates {
+		p(selector)
+	}
+	for _, p := range sspq.order {
+		p(selector)
+	}
+	if offset := sspq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sspq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sspq *SecurityScanningPolicyQuery) ForUpdate(opts ...sql.LockOption) *SecurityScanningPolicyQuery {
+	if sspq.driver.Dialect() == dialect.Postgres {
+		sspq.Unique(false)
+	}
+	sspq.modifiers = append(sspq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sspq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sspq *SecurityScanningPolicyQuery) ForShare(opts ...sql.LockOption) *SecurityScanningPolicyQuery {
+	if sspq.driver.Dialect() == dialect.Postgres {
+		sspq.Unique(false)
+	}
+	sspq.modifiers = append(sspq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sspq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sspq *SecurityScanningPolicyQuery) Modify(modifiers ...func(s *sql.Selector)) *SecurityScanningPolicySelect {
+	sspq.modifiers = append(sspq.modifiers, modifiers...)
+	return sspq.Select()
+}
+
+// SecurityScanningPolicyGroupBy is the group-by builder for SecurityScanningPolicy entities.
+type SecurityScanningPolicyGroupBy struct {
+	selector
+	build *SecurityScanningPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sspgb *SecurityScanningPolicyGroupBy) Aggregate(fns ...AggregateFunc) *SecurityScanningPolicyGroupBy {
+	sspgb.fns = append(sspgb.fns, fns...)
+	return sspgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sspgb *SecurityScanningPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sspgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sspgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityScanningPolicyQuery, *SecurityScanningPolicyGroupBy](ctx, sspgb.build, sspgb, sspgb.build.inters, v)
+}
+
+func (sspgb *SecurityScanningPolicyGroupBy) sqlScan(ctx context.Context, root *SecurityScanningPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sspgb.fns))
+	for _, fn := range sspgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sspgb.flds)+len(sspgb.fns))
+		for _, f := range *sspgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sspgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sspgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SecurityScanningPolicySelect is the builder for selecting fields of SecurityScanningPolicy entities.
+type SecurityScanningPolicySelect struct {
+	*SecurityScanningPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ssps *SecurityScanningPolicySelect) Aggregate(fns ...AggregateFunc) *SecurityScanningPolicySelect {
+	ssps.fns = append(ssps.fns, fns...)
+	return ssps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssps *SecurityScanningPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssps.ctx, ent.OpQuerySelect)
+	if err := ssps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityScanningPolicyQuery, *SecurityScanningPolicySelect](ctx, ssps.SecurityScanningPolicyQuery, ssps, ssps.inters, v)
+}
+
+func (ssps *SecurityScanningPolicySelect) sqlScan(ctx context.Context, root *SecurityScanningPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ssps.fns))
+	for _, fn := range ssps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ssps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ssps *SecurityScanningPolicySelect) Modify(modifiers ...func(s *sql.Selector)) *SecurityScanningPolicySelect {
+	ssps.modifiers = append(ssps.modifiers, modifiers...)
+	return ssps
+}
@@ -0,0 +1,529 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningPolicyUpdate is the builder for updating SecurityScanningPolicy entities.
+type SecurityScanningPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SecurityScanningPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SecurityScanningPolicyUpdate builder.
+func (sspu *SecurityScanningPolicyUpdate) Where(ps ...predicate.SecurityScanningPolicy) *SecurityScanningPolicyUpdate {
+	sspu.mutation.Where(ps...)
+	return sspu
+}
+
+// SetName sets the "name" field.
+func (sspu *SecurityScanningPolicyUpdate) SetName(s string) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetName(s)
+	return sspu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sspu *SecurityScanningPolicyUpdate) SetNillableName(s *string) *SecurityScanningPolicyUpdate {
+	if s != nil {
+		sspu.SetName(*s)
+	}
+	return sspu
+}
+
+// SetDescription sets the "description" field.
+func (sspu *SecurityScanningPolicyUpdate) SetDescription(s string) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetDescription(s)
+	return sspu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sspu *SecurityScanningPolicyUpdate) SetNillableDescription(s *string) *SecurityScanningPolicyUpdate {
+	if s != nil {
+		sspu.SetDescription(*s)
+	}
+	return sspu
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sspu *SecurityScanningPolicyUpdate) ClearDescription() *SecurityScanningPolicyUpdate {
+	sspu.mutation.ClearDescription()
+	return sspu
+}
+
+// SetLanguage sets the "language" field.
+func (sspu *SecurityScanningPolicyUpdate) SetLanguage(csl consts.SecurityScanningLanguage) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetLanguage(csl)
+	return sspu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (sspu *SecurityScanningPolicyUpdate) SetNillableLanguage(csl *consts.SecurityScanningLanguage) *SecurityScanningPolicyUpdate {
+	if csl != nil {
+		sspu.SetLanguage(*csl)
+	}
+	return sspu
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (sspu *SecurityScanningPolicyUpdate) ClearLanguage() *SecurityScanningPolicyUpdate {
+	sspu.mutation.ClearLanguage()
+	return sspu
+}
+
+// SetRulePackIds sets the "rule_pack_ids" field.
+func (sspu *SecurityScanningPolicyUpdate) SetRulePackIds(u []uuid.UUID) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetRulePackIds(u)
+	return sspu
+}
+
+// AppendRulePackIds appends u to the "rule_pack_ids" field.
+func (sspu *SecurityScanningPolicyUpdate) AppendRulePackIds(u []uuid.UUID) *SecurityScanningPolicyUpdate {
+	sspu.mutation.AppendRulePackIds(u)
+	return sspu
+}
+
+// ClearRulePackIds clears the value of the "rule_pack_ids" field.
+func (sspu *SecurityScanningPolicyUpdate) ClearRulePackIds() *SecurityScanningPolicyUpdate {
+	sspu.mutation.ClearRulePackIds()
+	return sspu
+}
+
+// SetMinConfidence sets the "min_confidence" field.
+func (sspu *SecurityScanningPolicyUpdate) SetMinConfidence(crl consts.SecurityRuleLevel) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetMinConfidence(crl)
+	return sspu
+}
+
+// SetNillableMinConfidence sets the "min_confidence" field if the given value is not nil.
+func (sspu *SecurityScanningPolicyUpdate) SetNillableMinConfidence(crl *consts.SecurityRuleLevel) *SecurityScanningPolicyUpdate {
+	if crl != nil {
+		sspu.SetMinConfidence(*crl)
+	}
+	return sspu
+}
+
+// SetMinImpact sets the "min_impact" field.
+func (sspu *SecurityScanningPolicyUpdate) SetMinImpact(crl consts.SecurityRuleLevel) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetMinImpact(crl)
+	return sspu
+}
+
+// SetNillableMinImpact sets the "min_impact" field if the given value is not nil.
+func (sspu *SecurityScanningPolicyUpdate) SetNillableMinImpact(crl *consts.SecurityRuleLevel) *SecurityScanningPolicyUpdate {
+	if crl != nil {
+		sspu.SetMinImpact(*crl)
+	}
+	return sspu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sspu *SecurityScanningPolicyUpdate) SetCreatedAt(t time.Time) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetCreatedAt(t)
+	return sspu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sspu *SecurityScanningPolicyUpdate) SetNillableCreatedAt(t *time.Time) *SecurityScanningPolicyUpdate {
+	if t != nil {
+		sspu.SetCreatedAt(*t)
+	}
+	return sspu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sspu *SecurityScanningPolicyUpdate) SetUpdatedAt(t time.Time) *SecurityScanningPolicyUpdate {
+	sspu.mutation.SetUpdatedAt(t)
+	return sspu
+}
+
+// Mutation returns the SecurityScanningPolicyMutation object of the builder.
+func (sspu *SecurityScanningPolicyUpdate) Mutation() *SecurityScanningPolicyMutation {
+	return sspu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sspu *SecurityScanningPolicyUpdate) Save(ctx context.Context) (int, error) {
+	sspu.defaults()
+	return withHooks(ctx, sspu.sqlSave, sspu.mutation, sspu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sspu *SecurityScanningPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sspu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sspu *SecurityScanningPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := sspu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspu *SecurityScanningPolicyUpdate) ExecX(ctx context.Context) {
+	if err := sspu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sspu *SecurityScanningPolicyUpdate) defaults() {
+	if _, ok := sspu.mutation.UpdatedAt(); !ok {
+		v := securityscanningpolicy.UpdateDefaultUpdatedAt()
+		sspu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sspu *SecurityScanningPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityScanningPolicyUpdate {
+	sspu.modifiers = append(sspu.modifiers, modifiers...)
+	return sspu
+}
+
+func (sspu *SecurityScanningPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(securityscanningpolicy.Table, securityscanningpolicy.Columns, sqlgraph.NewFieldSpec(securityscanningpolicy.FieldID, field.TypeUUID))
+	if ps := sspu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sspu.mutation.Name(); ok {
+		_spec.SetField(securityscanningpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := sspu.mutation.Description(); ok {
+		_spec.SetField(securityscanningpolicy.FieldDescription, field.TypeString, value)
+	}
+	if sspu.mutation.DescriptionCleared() {
+		_spec.ClearField(securityscanningpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := sspu.mutation.Language(); ok {
+		_spec.SetField(securityscanningpolicy.FieldLanguage, field.TypeString, value)
+	}
+	if sspu.mutation.LanguageCleared() {
+		_spec.ClearField(securityscanningpolicy.FieldLanguage, field.TypeString)
+	}
+	if value, ok := sspu.mutation.RulePackIds(); ok {
+		_spec.SetField(securityscanningpolicy.FieldRulePackIds, field.TypeJSON, value)
+	}
+	if value, ok := sspu.mutation.AppendedRulePackIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, securityscanningpolicy.FieldRulePackIds, value)
+		})
+	}
+	if sspu.mutation.RulePackIdsCleared() {
+		_spec.ClearField(securityscanningpolicy.FieldRulePackIds, field.TypeJSON)
+	}
+	if value, ok := sspu.mutation.MinConfidence(); ok {
+		_spec.SetField(securityscanningpolicy.FieldMinConfidence, field.TypeString, value)
+	}
+	if value, ok := sspu.mutation.MinImpact(); ok {
+		_spec.SetField(securityscanningpolicy.FieldMinImpact, field.TypeString, value)
+	}
+	if value, ok := sspu.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningpolicy.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := sspu.mutation.UpdatedAt(); ok {
+		_spec.SetField(securityscanningpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(sspu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sspu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityscanningpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sspu.mutation.done = true
+	return n, nil
+}
+
+// SecurityScanningPolicyUpdateOne is the builder for updating a single SecurityScanningPolicy entity.
+type SecurityScanningPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SecurityScanningPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetName(s string) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetName(s)
+	return sspuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetNillableName(s *string) *SecurityScanningPolicyUpdateOne {
+	if s != nil {
+		sspuo.SetName(*s)
+	}
+	return sspuo
+}
+
+// SetDescription sets the "description" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetDescription(s string) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetDescription(s)
+	return sspuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetNillableDescription(s *string) *SecurityScanningPolicyUpdateOne {
+	if s != nil {
+		sspuo.SetDescription(*s)
+	}
+	return sspuo
+}
+
+// ClearDescription clears the value of the "description" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) ClearDescription() *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.ClearDescription()
+	return sspuo
+}
+
+// SetLanguage sets the "language" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetLanguage(csl consts.SecurityScanningLanguage) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetLanguage(csl)
+	return sspuo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetNillableLanguage(csl *consts.SecurityScanningLanguage) *SecurityScanningPolicyUpdateOne {
+	if csl != nil {
+		sspuo.SetLanguage(*csl)
+	}
+	return sspuo
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) ClearLanguage() *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.ClearLanguage()
+	return sspuo
+}
+
+// SetRulePackIds sets the "rule_pack_ids" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetRulePackIds(u []uuid.UUID) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetRulePackIds(u)
+	return sspuo
+}
+
+// AppendRulePackIds appends u to the "rule_pack_ids" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) AppendRulePackIds(u []uuid.UUID) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.AppendRulePackIds(u)
+	return sspuo
+}
+
+// ClearRulePackIds clears the value of the "rule_pack_ids" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) ClearRulePackIds() *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.ClearRulePackIds()
+	return sspuo
+}
+
+// SetMinConfidence sets the "min_confidence" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetMinConfidence(crl consts.SecurityRuleLevel) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetMinConfidence(crl)
+	return sspuo
+}
+
+// SetNillableMinConfidence sets the "min_confidence" field if the given value is not nil.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetNillableMinConfidence(crl *consts.SecurityRuleLevel) *SecurityScanningPolicyUpdateOne {
+	if crl != nil {
+		sspuo.SetMinConfidence(*crl)
+	}
+	return sspuo
+}
+
+// SetMinImpact sets the "min_impact" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetMinImpact(crl consts.SecurityRuleLevel) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetMinImpact(crl)
+	return sspuo
+}
+
+// SetNillableMinImpact sets the "min_impact" field if the given value is not nil.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetNillableMinImpact(crl *consts.SecurityRuleLevel) *SecurityScanningPolicyUpdateOne {
+	if crl != nil {
+		sspuo.SetMinImpact(*crl)
+	}
+	return sspuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetCreatedAt(t time.Time) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetCreatedAt(t)
+	return sspuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetNillableCreatedAt(t *time.Time) *SecurityScanningPolicyUpdateOne {
+	if t != nil {
+		sspuo.SetCreatedAt(*t)
+	}
+	return sspuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sspuo *SecurityScanningPolicyUpdateOne) SetUpdatedAt(t time.Time) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.SetUpdatedAt(t)
+	return sspuo
+}
+
+// Mutation returns the SecurityScanningPolicyMutation object of the builder.
+func (sspuo *SecurityScanningPolicyUpdateOne) Mutation() *SecurityScanningPolicyMutation {
+	return sspuo.mutation
+}
+
+// Where appends a list predicates to the SecurityScanningPolicyUpdate builder.
+func (sspuo *SecurityScanningPolicyUpdateOne) Where(ps ...predicate.SecurityScanningPolicy) *SecurityScanningPolicyUpdateOne {
+	sspuo.mutation.Where(ps...)
+	return sspuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sspuo *SecurityScanningPolicyUpdateOne) Select(field string, fields ...string) *SecurityScanningPolicyUpdateOne {
+	sspuo.fields = append([]string{field}, fields...)
+	return sspuo
+}
+
+// Save executes the query and returns the updated SecurityScanningPolicy entity.
+func (sspuo *SecurityScanningPolicyUpdateOne) Save(ctx context.Context) (*SecurityScanningPolicy, error) {
+	sspuo.defaults()
+	return withHooks(ctx, sspuo.sqlSave, sspuo.mutation, sspuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sspuo *SecurityScanningPolicyUpdateOne) SaveX(ctx context.Context) *SecurityScanningPolicy {
+	node, err := sspuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sspuo *SecurityScanningPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := sspuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sspuo *SecurityScanningPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := sspuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sspuo *SecurityScanningPolicyUpdateOne) defaults() {
+	if _, ok := sspuo.mutation.UpdatedAt(); !ok {
+		v := securityscanningpolicy.UpdateDefaultUpdatedAt()
+		sspuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sspuo *SecurityScanningPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityScanningPolicyUpdateOne {
+	sspuo.modifiers = append(sspuo.modifiers, modifiers...)
+	return sspuo
+}
+
+func (sspuo *SecurityScanningPolicyUpdateOne) sqlSave(ctx context.Context) (_node *SecurityScanningPolicy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(securityscanningpolicy.Table, securityscanningpolicy.Columns, sqlgraph.NewFieldSpec(securityscanningpolicy.FieldID, field.TypeUUID))
+	id, ok := sspuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SecurityScanningPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sspuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityscanningpolicy.FieldID)
+		for _, f := range fields {
+			if !securityscanningpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != securityscanningpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sspuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sspuo.mutation.Name(); ok {
+		_spec.SetField(securityscanningpolicy.FieldName, field.TypeString, value)
+	}
+	if value, ok := sspuo.mutation.Description(); ok {
+		_spec.SetField(securityscanningpolicy.FieldDescription, field.TypeString, value)
+	}
+	if sspuo.mutation.DescriptionCleared() {
+		_spec.ClearField(securityscanningpolicy.FieldDescription, field.TypeString)
+	}
+	if value, ok := sspuo.mutation.Language(); ok {
+		_spec.SetField(securityscanningpolicy.FieldLanguage, field.TypeString, value)
+	}
+	if sspuo.mutation.LanguageCleared() {
+		_spec.ClearField(securityscanningpolicy.FieldLanguage, field.TypeString)
+	}
+	if value, ok := sspuo.mutation.RulePackIds(); ok {
+		_spec.SetField(securityscanningpolicy.FieldRulePackIds, field.TypeJSON, value)
+	}
+	if value, ok := sspuo.mutation.AppendedRulePackIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, securityscanningpolicy.FieldRulePackIds, value)
+		})
+	}
+	if sspuo.mutation.RulePackIdsCleared() {
+		_spec.ClearField(securityscanningpolicy.FieldRulePackIds, field.TypeJSON)
+	}
+	if value, ok := sspuo.mutation.MinConfidence(); ok {
+		_spec.SetField(securityscanningpolicy.FieldMinConfidence, field.TypeString, value)
+	}
+	if value, ok := sspuo.mutation.MinImpact(); ok {
+		_spec.SetField(securityscanningpolicy.FieldMinImpact, field.TypeString, value)
+	}
+	if value, ok := sspuo.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningpolicy.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := sspuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(securityscanningpolicy.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(sspuo.modifiers...)
+	_node = &SecurityScanningPolicy{config: sspuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sspuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityscanningpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sspuo.mutation.done = true
+	return _node, nil
+}
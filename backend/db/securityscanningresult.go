@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/chaitin/MonkeyCode/backend/ent/types"
@@ -59,6 +60,18 @@ type SecurityScanningResult struct {
 	StartPosition *types.Position `json:"start_position,omitempty"`
 	// EndPosition holds the value of the "end_position" field.
 	EndPosition *types.Position `json:"end_position,omitempty"`
+	// 发现的指纹，用于对比前后两次扫描
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// 相对上一次扫描的状态 new persisting fixed
+	State consts.SecurityScanningFindingState `json:"state,omitempty"`
+	// 处置状态
+	TriageStatus consts.SecurityScanningTriageStatus `json:"triage_status,omitempty"`
+	// 处理人
+	AssigneeID *uuid.UUID `json:"assignee_id,omitempty"`
+	// 接受风险的到期时间，到期后重新计入风险
+	RiskExpiresAt *time.Time `json:"risk_expires_at,omitempty"`
+	// 命中的屏蔽规则
+	SuppressionID *uuid.UUID `json:"suppression_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -71,9 +84,11 @@ type SecurityScanningResult struct {
 type SecurityScanningResultEdges struct {
 	// SecurityScanning holds the value of the security_scanning edge.
 	SecurityScanning *SecurityScanning `json:"security_scanning,omitempty"`
+	// Comments holds the value of the comments edge.
+	Comments []*SecurityScanningComment `json:"comments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // SecurityScanningOrErr returns the SecurityScanning value or an error if the edge
@@ -87,16 +102,27 @@ func (e SecurityScanningResultEdges) SecurityScanningOrErr() (*SecurityScanning,
 	return nil, &NotLoadedError{edge: "security_scanning"}
 }
 
+// CommentsOrErr returns the Comments value or an error if the edge
+// was not loaded in eager-loading.
+func (e SecurityScanningResultEdges) CommentsOrErr() ([]*SecurityScanningComment, error) {
+	if e.loadedTypes[1] {
+		return e.Comments, nil
+	}
+	return nil, &NotLoadedError{edge: "comments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SecurityScanningResult) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case securityscanningresult.FieldAssigneeID, securityscanningresult.FieldSuppressionID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case securityscanningresult.FieldCwe, securityscanningresult.FieldOwasp, securityscanningresult.FieldStartPosition, securityscanningresult.FieldEndPosition:
 			values[i] = new([]byte)
-		case securityscanningresult.FieldCheckID, securityscanningresult.FieldEngineKind, securityscanningresult.FieldLines, securityscanningresult.FieldPath, securityscanningresult.FieldMessage, securityscanningresult.FieldMessageZh, securityscanningresult.FieldSeverity, securityscanningresult.FieldAbstractEn, securityscanningresult.FieldAbstractZh, securityscanningresult.FieldCategoryEn, securityscanningresult.FieldCategoryZh, securityscanningresult.FieldConfidence, securityscanningresult.FieldImpact, securityscanningresult.FieldFileContent:
+		case securityscanningresult.FieldCheckID, securityscanningresult.FieldEngineKind, securityscanningresult.FieldLines, securityscanningresult.FieldPath, securityscanningresult.FieldMessage, securityscanningresult.FieldMessageZh, securityscanningresult.FieldSeverity, securityscanningresult.FieldAbstractEn, securityscanningresult.FieldAbstractZh, securityscanningresult.FieldCategoryEn, securityscanningresult.FieldCategoryZh, securityscanningresult.FieldConfidence, securityscanningresult.FieldImpact, securityscanningresult.FieldFileContent, securityscanningresult.FieldFingerprint, securityscanningresult.FieldState, securityscanningresult.FieldTriageStatus:
 			values[i] = new(sql.NullString)
-		case securityscanningresult.FieldCreatedAt:
+		case securityscanningresult.FieldRiskExpiresAt, securityscanningresult.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case securityscanningresult.FieldID, securityscanningresult.FieldSecurityScanningID:
 			values[i] = new(uuid.UUID)
@@ -243,6 +269,45 @@ func (ssr *SecurityScanningResult) assignValues(columns []string, values []any)
 					return fmt.Errorf("unmarshal field end_position: %w", err)
 				}
 			}
+		case securityscanningresult.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				ssr.Fingerprint = value.String
+			}
+		case securityscanningresult.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				ssr.State = consts.SecurityScanningFindingState(value.String)
+			}
+		case securityscanningresult.FieldTriageStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field triage_status", values[i])
+			} else if value.Valid {
+				ssr.TriageStatus = consts.SecurityScanningTriageStatus(value.String)
+			}
+		case securityscanningresult.FieldAssigneeID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field assignee_id", values[i])
+			} else if value.Valid {
+				ssr.AssigneeID = new(uuid.UUID)
+				*ssr.AssigneeID = *value.S.(*uuid.UUID)
+			}
+		case securityscanningresult.FieldRiskExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_expires_at", values[i])
+			} else if value.Valid {
+				ssr.RiskExpiresAt = new(time.Time)
+				*ssr.RiskExpiresAt = value.Time
+			}
+		case securityscanningresult.FieldSuppressionID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field suppression_id", values[i])
+			} else if value.Valid {
+				ssr.SuppressionID = new(uuid.UUID)
+				*ssr.SuppressionID = *value.S.(*uuid.UUID)
+			}
 		case securityscanningresult.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -267,6 +332,11 @@ func (ssr *SecurityScanningResult) QuerySecurityScanning() *SecurityScanningQuer
 	return NewSecurityScanningResultClient(ssr.config).QuerySecurityScanning(ssr)
 }
 
+// QueryComments queries the "comments" edge of the SecurityScanningResult entity.
+func (ssr *SecurityScanningResult) QueryComments() *SecurityScanningCommentQuery {
+	return NewSecurityScanningResultClient(ssr.config).QueryComments(ssr)
+}
+
 // Update returns a builder for updating this SecurityScanningResult.
 // Note that you need to call SecurityScanningResult.Unwrap() before calling this method if this SecurityScanningResult
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -347,6 +417,30 @@ func (ssr *SecurityScanningResult) String() string {
 	builder.WriteString("end_position=")
 	builder.WriteString(fmt.Sprintf("%v", ssr.EndPosition))
 	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(ssr.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.State))
+	builder.WriteString(", ")
+	builder.WriteString("triage_status=")
+	builder.WriteString(fmt.Sprintf("%v", ssr.TriageStatus))
+	builder.WriteString(", ")
+	if v := ssr.AssigneeID; v != nil {
+		builder.WriteString("assignee_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ssr.RiskExpiresAt; v != nil {
+		builder.WriteString("risk_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := ssr.SuppressionID; v != nil {
+		builder.WriteString("suppression_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(ssr.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/MonkeyCode/backend/consts"
 )
 
 const (
@@ -52,10 +53,24 @@ const (
 	FieldStartPosition = "start_position"
 	// FieldEndPosition holds the string denoting the end_position field in the database.
 	FieldEndPosition = "end_position"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// FieldTriageStatus holds the string denoting the triage_status field in the database.
+	FieldTriageStatus = "triage_status"
+	// FieldAssigneeID holds the string denoting the assignee_id field in the database.
+	FieldAssigneeID = "assignee_id"
+	// FieldRiskExpiresAt holds the string denoting the risk_expires_at field in the database.
+	FieldRiskExpiresAt = "risk_expires_at"
+	// FieldSuppressionID holds the string denoting the suppression_id field in the database.
+	FieldSuppressionID = "suppression_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeSecurityScanning holds the string denoting the security_scanning edge name in mutations.
 	EdgeSecurityScanning = "security_scanning"
+	// EdgeComments holds the string denoting the comments edge name in mutations.
+	EdgeComments = "comments"
 	// Table holds the table name of the securityscanningresult in the database.
 	Table = "security_scanning_results"
 	// SecurityScanningTable is the table that holds the security_scanning relation/edge.
@@ -65,6 +80,13 @@ const (
 	SecurityScanningInverseTable = "security_scannings"
 	// SecurityScanningColumn is the table column denoting the security_scanning relation/edge.
 	SecurityScanningColumn = "security_scanning_id"
+	// CommentsTable is the table that holds the comments relation/edge.
+	CommentsTable = "security_scanning_comments"
+	// CommentsInverseTable is the table name for the SecurityScanningComment entity.
+	// It exists in this package in order to avoid circular dependency with the "securityscanningcomment" package.
+	CommentsInverseTable = "security_scanning_comments"
+	// CommentsColumn is the table column denoting the comments relation/edge.
+	CommentsColumn = "result_id"
 )
 
 // Columns holds all SQL columns for securityscanningresult fields.
@@ -89,6 +111,12 @@ var Columns = []string{
 	FieldFileContent,
 	FieldStartPosition,
 	FieldEndPosition,
+	FieldFingerprint,
+	FieldState,
+	FieldTriageStatus,
+	FieldAssigneeID,
+	FieldRiskExpiresAt,
+	FieldSuppressionID,
 	FieldCreatedAt,
 }
 
@@ -103,6 +131,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTriageStatus holds the default value on creation for the "triage_status" field.
+	DefaultTriageStatus consts.SecurityScanningTriageStatus
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -190,6 +220,36 @@ func ByFileContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldFileContent, opts...).ToFunc()
 }
 
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
+
+// ByTriageStatus orders the results by the triage_status field.
+func ByTriageStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriageStatus, opts...).ToFunc()
+}
+
+// ByAssigneeID orders the results by the assignee_id field.
+func ByAssigneeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAssigneeID, opts...).ToFunc()
+}
+
+// ByRiskExpiresAt orders the results by the risk_expires_at field.
+func ByRiskExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskExpiresAt, opts...).ToFunc()
+}
+
+// BySuppressionID orders the results by the suppression_id field.
+func BySuppressionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuppressionID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -201,6 +261,20 @@ func BySecurityScanningField(field string, opts ...sql.OrderTermOption) OrderOpt
 		sqlgraph.OrderByNeighborTerms(s, newSecurityScanningStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByCommentsCount orders the results by comments count.
+func ByCommentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCommentsStep(), opts...)
+	}
+}
+
+// ByComments orders the results by comments terms.
+func ByComments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCommentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSecurityScanningStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -208,3 +282,10 @@ func newSecurityScanningStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, SecurityScanningTable, SecurityScanningColumn),
 	)
 }
+func newCommentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CommentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+	)
+}
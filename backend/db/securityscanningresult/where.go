@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/predicate"
 	"github.com/google/uuid"
 )
@@ -131,6 +132,38 @@ func FileContent(v string) predicate.SecurityScanningResult {
 	return predicate.SecurityScanningResult(sql.FieldEQ(FieldFileContent, v))
 }
 
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldState, vc))
+}
+
+// TriageStatus applies equality check predicate on the "triage_status" field. It's identical to TriageStatusEQ.
+func TriageStatus(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldTriageStatus, vc))
+}
+
+// AssigneeID applies equality check predicate on the "assignee_id" field. It's identical to AssigneeIDEQ.
+func AssigneeID(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// RiskExpiresAt applies equality check predicate on the "risk_expires_at" field. It's identical to RiskExpiresAtEQ.
+func RiskExpiresAt(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldRiskExpiresAt, v))
+}
+
+// SuppressionID applies equality check predicate on the "suppression_id" field. It's identical to SuppressionIDEQ.
+func SuppressionID(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldSuppressionID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.SecurityScanningResult {
 	return predicate.SecurityScanningResult(sql.FieldEQ(FieldCreatedAt, v))
@@ -1066,6 +1099,409 @@ func FileContentContainsFold(v string) predicate.SecurityScanningResult {
 	return predicate.SecurityScanningResult(sql.FieldContainsFold(FieldFileContent, v))
 }
 
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotNull(FieldFingerprint))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldState, vc))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldNEQ(FieldState, vc))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningResult(sql.FieldIn(FieldState, v...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningResult(sql.FieldNotIn(FieldState, v...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldGT(FieldState, vc))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldGTE(FieldState, vc))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldLT(FieldState, vc))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldLTE(FieldState, vc))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldContains(FieldState, vc))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldHasPrefix(FieldState, vc))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldHasSuffix(FieldState, vc))
+}
+
+// StateIsNil applies the IsNil predicate on the "state" field.
+func StateIsNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIsNull(FieldState))
+}
+
+// StateNotNil applies the NotNil predicate on the "state" field.
+func StateNotNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotNull(FieldState))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldEqualFold(FieldState, vc))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v consts.SecurityScanningFindingState) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldContainsFold(FieldState, vc))
+}
+
+// TriageStatusEQ applies the EQ predicate on the "triage_status" field.
+func TriageStatusEQ(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldTriageStatus, vc))
+}
+
+// TriageStatusNEQ applies the NEQ predicate on the "triage_status" field.
+func TriageStatusNEQ(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldNEQ(FieldTriageStatus, vc))
+}
+
+// TriageStatusIn applies the In predicate on the "triage_status" field.
+func TriageStatusIn(vs ...consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningResult(sql.FieldIn(FieldTriageStatus, v...))
+}
+
+// TriageStatusNotIn applies the NotIn predicate on the "triage_status" field.
+func TriageStatusNotIn(vs ...consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningResult(sql.FieldNotIn(FieldTriageStatus, v...))
+}
+
+// TriageStatusGT applies the GT predicate on the "triage_status" field.
+func TriageStatusGT(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldGT(FieldTriageStatus, vc))
+}
+
+// TriageStatusGTE applies the GTE predicate on the "triage_status" field.
+func TriageStatusGTE(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldGTE(FieldTriageStatus, vc))
+}
+
+// TriageStatusLT applies the LT predicate on the "triage_status" field.
+func TriageStatusLT(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldLT(FieldTriageStatus, vc))
+}
+
+// TriageStatusLTE applies the LTE predicate on the "triage_status" field.
+func TriageStatusLTE(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldLTE(FieldTriageStatus, vc))
+}
+
+// TriageStatusContains applies the Contains predicate on the "triage_status" field.
+func TriageStatusContains(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldContains(FieldTriageStatus, vc))
+}
+
+// TriageStatusHasPrefix applies the HasPrefix predicate on the "triage_status" field.
+func TriageStatusHasPrefix(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldHasPrefix(FieldTriageStatus, vc))
+}
+
+// TriageStatusHasSuffix applies the HasSuffix predicate on the "triage_status" field.
+func TriageStatusHasSuffix(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldHasSuffix(FieldTriageStatus, vc))
+}
+
+// TriageStatusEqualFold applies the EqualFold predicate on the "triage_status" field.
+func TriageStatusEqualFold(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldEqualFold(FieldTriageStatus, vc))
+}
+
+// TriageStatusContainsFold applies the ContainsFold predicate on the "triage_status" field.
+func TriageStatusContainsFold(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningResult {
+	vc := string(v)
+	return predicate.SecurityScanningResult(sql.FieldContainsFold(FieldTriageStatus, vc))
+}
+
+// AssigneeIDEQ applies the EQ predicate on the "assignee_id" field.
+func AssigneeIDEQ(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDNEQ applies the NEQ predicate on the "assignee_id" field.
+func AssigneeIDNEQ(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNEQ(FieldAssigneeID, v))
+}
+
+// AssigneeIDIn applies the In predicate on the "assignee_id" field.
+func AssigneeIDIn(vs ...uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDNotIn applies the NotIn predicate on the "assignee_id" field.
+func AssigneeIDNotIn(vs ...uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotIn(FieldAssigneeID, vs...))
+}
+
+// AssigneeIDGT applies the GT predicate on the "assignee_id" field.
+func AssigneeIDGT(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGT(FieldAssigneeID, v))
+}
+
+// AssigneeIDGTE applies the GTE predicate on the "assignee_id" field.
+func AssigneeIDGTE(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDLT applies the LT predicate on the "assignee_id" field.
+func AssigneeIDLT(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLT(FieldAssigneeID, v))
+}
+
+// AssigneeIDLTE applies the LTE predicate on the "assignee_id" field.
+func AssigneeIDLTE(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLTE(FieldAssigneeID, v))
+}
+
+// AssigneeIDIsNil applies the IsNil predicate on the "assignee_id" field.
+func AssigneeIDIsNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIsNull(FieldAssigneeID))
+}
+
+// AssigneeIDNotNil applies the NotNil predicate on the "assignee_id" field.
+func AssigneeIDNotNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotNull(FieldAssigneeID))
+}
+
+// RiskExpiresAtEQ applies the EQ predicate on the "risk_expires_at" field.
+func RiskExpiresAtEQ(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldRiskExpiresAt, v))
+}
+
+// RiskExpiresAtNEQ applies the NEQ predicate on the "risk_expires_at" field.
+func RiskExpiresAtNEQ(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNEQ(FieldRiskExpiresAt, v))
+}
+
+// RiskExpiresAtIn applies the In predicate on the "risk_expires_at" field.
+func RiskExpiresAtIn(vs ...time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIn(FieldRiskExpiresAt, vs...))
+}
+
+// RiskExpiresAtNotIn applies the NotIn predicate on the "risk_expires_at" field.
+func RiskExpiresAtNotIn(vs ...time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotIn(FieldRiskExpiresAt, vs...))
+}
+
+// RiskExpiresAtGT applies the GT predicate on the "risk_expires_at" field.
+func RiskExpiresAtGT(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGT(FieldRiskExpiresAt, v))
+}
+
+// RiskExpiresAtGTE applies the GTE predicate on the "risk_expires_at" field.
+func RiskExpiresAtGTE(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGTE(FieldRiskExpiresAt, v))
+}
+
+// RiskExpiresAtLT applies the LT predicate on the "risk_expires_at" field.
+func RiskExpiresAtLT(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLT(FieldRiskExpiresAt, v))
+}
+
+// RiskExpiresAtLTE applies the LTE predicate on the "risk_expires_at" field.
+func RiskExpiresAtLTE(v time.Time) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLTE(FieldRiskExpiresAt, v))
+}
+
+// RiskExpiresAtIsNil applies the IsNil predicate on the "risk_expires_at" field.
+func RiskExpiresAtIsNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIsNull(FieldRiskExpiresAt))
+}
+
+// RiskExpiresAtNotNil applies the NotNil predicate on the "risk_expires_at" field.
+func RiskExpiresAtNotNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotNull(FieldRiskExpiresAt))
+}
+
+// SuppressionIDEQ applies the EQ predicate on the "suppression_id" field.
+func SuppressionIDEQ(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldEQ(FieldSuppressionID, v))
+}
+
+// SuppressionIDNEQ applies the NEQ predicate on the "suppression_id" field.
+func SuppressionIDNEQ(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNEQ(FieldSuppressionID, v))
+}
+
+// SuppressionIDIn applies the In predicate on the "suppression_id" field.
+func SuppressionIDIn(vs ...uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIn(FieldSuppressionID, vs...))
+}
+
+// SuppressionIDNotIn applies the NotIn predicate on the "suppression_id" field.
+func SuppressionIDNotIn(vs ...uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotIn(FieldSuppressionID, vs...))
+}
+
+// SuppressionIDGT applies the GT predicate on the "suppression_id" field.
+func SuppressionIDGT(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGT(FieldSuppressionID, v))
+}
+
+// SuppressionIDGTE applies the GTE predicate on the "suppression_id" field.
+func SuppressionIDGTE(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldGTE(FieldSuppressionID, v))
+}
+
+// SuppressionIDLT applies the LT predicate on the "suppression_id" field.
+func SuppressionIDLT(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLT(FieldSuppressionID, v))
+}
+
+// SuppressionIDLTE applies the LTE predicate on the "suppression_id" field.
+func SuppressionIDLTE(v uuid.UUID) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldLTE(FieldSuppressionID, v))
+}
+
+// SuppressionIDIsNil applies the IsNil predicate on the "suppression_id" field.
+func SuppressionIDIsNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldIsNull(FieldSuppressionID))
+}
+
+// SuppressionIDNotNil applies the NotNil predicate on the "suppression_id" field.
+func SuppressionIDNotNil() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(sql.FieldNotNull(FieldSuppressionID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.SecurityScanningResult {
 	return predicate.SecurityScanningResult(sql.FieldEQ(FieldCreatedAt, v))
@@ -1129,6 +1565,29 @@ func HasSecurityScanningWith(preds ...predicate.SecurityScanning) predicate.Secu
 	})
 }
 
+// HasComments applies the HasEdge predicate on the "comments" edge.
+func HasComments() predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CommentsTable, CommentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCommentsWith applies the HasEdge predicate on the "comments" edge with a given conditions (other predicates).
+func HasCommentsWith(preds ...predicate.SecurityScanningComment) predicate.SecurityScanningResult {
+	return predicate.SecurityScanningResult(func(s *sql.Selector) {
+		step := newCommentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SecurityScanningResult) predicate.SecurityScanningResult {
 	return predicate.SecurityScanningResult(sql.AndPredicates(predicates...))
@@ -12,7 +12,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
@@ -140,6 +142,90 @@ func (ssrc *SecurityScanningResultCreate) SetEndPosition(t *types.Position) *Sec
 	return ssrc
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (ssrc *SecurityScanningResultCreate) SetFingerprint(s string) *SecurityScanningResultCreate {
+	ssrc.mutation.SetFingerprint(s)
+	return ssrc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ssrc *SecurityScanningResultCreate) SetNillableFingerprint(s *string) *SecurityScanningResultCreate {
+	if s != nil {
+		ssrc.SetFingerprint(*s)
+	}
+	return ssrc
+}
+
+// SetState sets the "state" field.
+func (ssrc *SecurityScanningResultCreate) SetState(csfs consts.SecurityScanningFindingState) *SecurityScanningResultCreate {
+	ssrc.mutation.SetState(csfs)
+	return ssrc
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ssrc *SecurityScanningResultCreate) SetNillableState(csfs *consts.SecurityScanningFindingState) *SecurityScanningResultCreate {
+	if csfs != nil {
+		ssrc.SetState(*csfs)
+	}
+	return ssrc
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (ssrc *SecurityScanningResultCreate) SetTriageStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningResultCreate {
+	ssrc.mutation.SetTriageStatus(csts)
+	return ssrc
+}
+
+// SetNillableTriageStatus sets the "triage_status" field if the given value is not nil.
+func (ssrc *SecurityScanningResultCreate) SetNillableTriageStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningResultCreate {
+	if csts != nil {
+		ssrc.SetTriageStatus(*csts)
+	}
+	return ssrc
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (ssrc *SecurityScanningResultCreate) SetAssigneeID(u uuid.UUID) *SecurityScanningResultCreate {
+	ssrc.mutation.SetAssigneeID(u)
+	return ssrc
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (ssrc *SecurityScanningResultCreate) SetNillableAssigneeID(u *uuid.UUID) *SecurityScanningResultCreate {
+	if u != nil {
+		ssrc.SetAssigneeID(*u)
+	}
+	return ssrc
+}
+
+// SetRiskExpiresAt sets the "risk_expires_at" field.
+func (ssrc *SecurityScanningResultCreate) SetRiskExpiresAt(t time.Time) *SecurityScanningResultCreate {
+	ssrc.mutation.SetRiskExpiresAt(t)
+	return ssrc
+}
+
+// SetNillableRiskExpiresAt sets the "risk_expires_at" field if the given value is not nil.
+func (ssrc *SecurityScanningResultCreate) SetNillableRiskExpiresAt(t *time.Time) *SecurityScanningResultCreate {
+	if t != nil {
+		ssrc.SetRiskExpiresAt(*t)
+	}
+	return ssrc
+}
+
+// SetSuppressionID sets the "suppression_id" field.
+func (ssrc *SecurityScanningResultCreate) SetSuppressionID(u uuid.UUID) *SecurityScanningResultCreate {
+	ssrc.mutation.SetSuppressionID(u)
+	return ssrc
+}
+
+// SetNillableSuppressionID sets the "suppression_id" field if the given value is not nil.
+func (ssrc *SecurityScanningResultCreate) SetNillableSuppressionID(u *uuid.UUID) *SecurityScanningResultCreate {
+	if u != nil {
+		ssrc.SetSuppressionID(*u)
+	}
+	return ssrc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ssrc *SecurityScanningResultCreate) SetCreatedAt(t time.Time) *SecurityScanningResultCreate {
 	ssrc.mutation.SetCreatedAt(t)
@@ -165,6 +251,21 @@ func (ssrc *SecurityScanningResultCreate) SetSecurityScanning(s *SecurityScannin
 	return ssrc.SetSecurityScanningID(s.ID)
 }
 
+// AddCommentIDs adds the "comments" edge to the SecurityScanningComment entity by IDs.
+func (ssrc *SecurityScanningResultCreate) AddCommentIDs(ids ...uuid.UUID) *SecurityScanningResultCreate {
+	ssrc.mutation.AddCommentIDs(ids...)
+	return ssrc
+}
+
+// AddComments adds the "comments" edges to the SecurityScanningComment entity.
+func (ssrc *SecurityScanningResultCreate) AddComments(s ...*SecurityScanningComment) *SecurityScanningResultCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ssrc.AddCommentIDs(ids...)
+}
+
 // Mutation returns the SecurityScanningResultMutation object of the builder.
 func (ssrc *SecurityScanningResultCreate) Mutation() *SecurityScanningResultMutation {
 	return ssrc.mutation
@@ -200,6 +301,10 @@ func (ssrc *SecurityScanningResultCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (ssrc *SecurityScanningResultCreate) defaults() {
+	if _, ok := ssrc.mutation.TriageStatus(); !ok {
+		v := securityscanningresult.DefaultTriageStatus
+		ssrc.mutation.SetTriageStatus(v)
+	}
 	if _, ok := ssrc.mutation.CreatedAt(); !ok {
 		v := securityscanningresult.DefaultCreatedAt()
 		ssrc.mutation.SetCreatedAt(v)
@@ -265,6 +370,9 @@ func (ssrc *SecurityScanningResultCreate) check() error {
 	if _, ok := ssrc.mutation.EndPosition(); !ok {
 		return &ValidationError{Name: "end_position", err: errors.New(`db: missing required field "SecurityScanningResult.end_position"`)}
 	}
+	if _, ok := ssrc.mutation.TriageStatus(); !ok {
+		return &ValidationError{Name: "triage_status", err: errors.New(`db: missing required field "SecurityScanningResult.triage_status"`)}
+	}
 	if _, ok := ssrc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityScanningResult.created_at"`)}
 	}
@@ -379,6 +487,30 @@ func (ssrc *SecurityScanningResultCreate) createSpec() (*SecurityScanningResult,
 		_spec.SetField(securityscanningresult.FieldEndPosition, field.TypeJSON, value)
 		_node.EndPosition = value
 	}
+	if value, ok := ssrc.mutation.Fingerprint(); ok {
+		_spec.SetField(securityscanningresult.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := ssrc.mutation.State(); ok {
+		_spec.SetField(securityscanningresult.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	if value, ok := ssrc.mutation.TriageStatus(); ok {
+		_spec.SetField(securityscanningresult.FieldTriageStatus, field.TypeString, value)
+		_node.TriageStatus = value
+	}
+	if value, ok := ssrc.mutation.AssigneeID(); ok {
+		_spec.SetField(securityscanningresult.FieldAssigneeID, field.TypeUUID, value)
+		_node.AssigneeID = &value
+	}
+	if value, ok := ssrc.mutation.RiskExpiresAt(); ok {
+		_spec.SetField(securityscanningresult.FieldRiskExpiresAt, field.TypeTime, value)
+		_node.RiskExpiresAt = &value
+	}
+	if value, ok := ssrc.mutation.SuppressionID(); ok {
+		_spec.SetField(securityscanningresult.FieldSuppressionID, field.TypeUUID, value)
+		_node.SuppressionID = &value
+	}
 	if value, ok := ssrc.mutation.CreatedAt(); ok {
 		_spec.SetField(securityscanningresult.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -400,6 +532,22 @@ func (ssrc *SecurityScanningResultCreate) createSpec() (*SecurityScanningResult,
 		_node.SecurityScanningID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ssrc.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -680,6 +828,108 @@ func (u *SecurityScanningResultUpsert) UpdateEndPosition() *SecurityScanningResu
 	return u
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (u *SecurityScanningResultUpsert) SetFingerprint(v string) *SecurityScanningResultUpsert {
+	u.Set(securityscanningresult.FieldFingerprint, v)
+	return u
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsert) UpdateFingerprint() *SecurityScanningResultUpsert {
+	u.SetExcluded(securityscanningresult.FieldFingerprint)
+	return u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *SecurityScanningResultUpsert) ClearFingerprint() *SecurityScanningResultUpsert {
+	u.SetNull(securityscanningresult.FieldFingerprint)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *SecurityScanningResultUpsert) SetState(v consts.SecurityScanningFindingState) *SecurityScanningResultUpsert {
+	u.Set(securityscanningresult.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsert) UpdateState() *SecurityScanningResultUpsert {
+	u.SetExcluded(securityscanningresult.FieldState)
+	return u
+}
+
+// ClearState clears the value of the "state" field.
+func (u *SecurityScanningResultUpsert) ClearState() *SecurityScanningResultUpsert {
+	u.SetNull(securityscanningresult.FieldState)
+	return u
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (u *SecurityScanningResultUpsert) SetTriageStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningResultUpsert {
+	u.Set(securityscanningresult.FieldTriageStatus, v)
+	return u
+}
+
+// UpdateTriageStatus sets the "triage_status" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsert) UpdateTriageStatus() *SecurityScanningResultUpsert {
+	u.SetExcluded(securityscanningresult.FieldTriageStatus)
+	return u
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *SecurityScanningResultUpsert) SetAssigneeID(v uuid.UUID) *SecurityScanningResultUpsert {
+	u.Set(securityscanningresult.FieldAssigneeID, v)
+	return u
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsert) UpdateAssigneeID() *SecurityScanningResultUpsert {
+	u.SetExcluded(securityscanningresult.FieldAssigneeID)
+	return u
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *SecurityScanningResultUpsert) ClearAssigneeID() *SecurityScanningResultUpsert {
+	u.SetNull(securityscanningresult.FieldAssigneeID)
+	return u
+}
+
+// SetRiskExpiresAt sets the "risk_expires_at" field.
+func (u *SecurityScanningResultUpsert) SetRiskExpiresAt(v time.Time) *SecurityScanningResultUpsert {
+	u.Set(securityscanningresult.FieldRiskExpiresAt, v)
+	return u
+}
+
+// UpdateRiskExpiresAt sets the "risk_expires_at" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsert) UpdateRiskExpiresAt() *SecurityScanningResultUpsert {
+	u.SetExcluded(securityscanningresult.FieldRiskExpiresAt)
+	return u
+}
+
+// ClearRiskExpiresAt clears the value of the "risk_expires_at" field.
+func (u *SecurityScanningResultUpsert) ClearRiskExpiresAt() *SecurityScanningResultUpsert {
+	u.SetNull(securityscanningresult.FieldRiskExpiresAt)
+	return u
+}
+
+// SetSuppressionID sets the "suppression_id" field.
+func (u *SecurityScanningResultUpsert) SetSuppressionID(v uuid.UUID) *SecurityScanningResultUpsert {
+	u.Set(securityscanningresult.FieldSuppressionID, v)
+	return u
+}
+
+// UpdateSuppressionID sets the "suppression_id" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsert) UpdateSuppressionID() *SecurityScanningResultUpsert {
+	u.SetExcluded(securityscanningresult.FieldSuppressionID)
+	return u
+}
+
+// ClearSuppressionID clears the value of the "suppression_id" field.
+func (u *SecurityScanningResultUpsert) ClearSuppressionID() *SecurityScanningResultUpsert {
+	u.SetNull(securityscanningresult.FieldSuppressionID)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SecurityScanningResultUpsert) SetCreatedAt(v time.Time) *SecurityScanningResultUpsert {
 	u.Set(securityscanningresult.FieldCreatedAt, v)
@@ -1006,6 +1256,125 @@ func (u *SecurityScanningResultUpsertOne) UpdateEndPosition() *SecurityScanningR
 	})
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (u *SecurityScanningResultUpsertOne) SetFingerprint(v string) *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertOne) UpdateFingerprint() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *SecurityScanningResultUpsertOne) ClearFingerprint() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearFingerprint()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *SecurityScanningResultUpsertOne) SetState(v consts.SecurityScanningFindingState) *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertOne) UpdateState() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateState()
+	})
+}
+
+// ClearState clears the value of the "state" field.
+func (u *SecurityScanningResultUpsertOne) ClearState() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearState()
+	})
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (u *SecurityScanningResultUpsertOne) SetTriageStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetTriageStatus(v)
+	})
+}
+
+// UpdateTriageStatus sets the "triage_status" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertOne) UpdateTriageStatus() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateTriageStatus()
+	})
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *SecurityScanningResultUpsertOne) SetAssigneeID(v uuid.UUID) *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertOne) UpdateAssigneeID() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *SecurityScanningResultUpsertOne) ClearAssigneeID() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetRiskExpiresAt sets the "risk_expires_at" field.
+func (u *SecurityScanningResultUpsertOne) SetRiskExpiresAt(v time.Time) *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetRiskExpiresAt(v)
+	})
+}
+
+// UpdateRiskExpiresAt sets the "risk_expires_at" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertOne) UpdateRiskExpiresAt() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateRiskExpiresAt()
+	})
+}
+
+// ClearRiskExpiresAt clears the value of the "risk_expires_at" field.
+func (u *SecurityScanningResultUpsertOne) ClearRiskExpiresAt() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearRiskExpiresAt()
+	})
+}
+
+// SetSuppressionID sets the "suppression_id" field.
+func (u *SecurityScanningResultUpsertOne) SetSuppressionID(v uuid.UUID) *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetSuppressionID(v)
+	})
+}
+
+// UpdateSuppressionID sets the "suppression_id" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertOne) UpdateSuppressionID() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateSuppressionID()
+	})
+}
+
+// ClearSuppressionID clears the value of the "suppression_id" field.
+func (u *SecurityScanningResultUpsertOne) ClearSuppressionID() *SecurityScanningResultUpsertOne {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearSuppressionID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SecurityScanningResultUpsertOne) SetCreatedAt(v time.Time) *SecurityScanningResultUpsertOne {
 	return u.Update(func(s *SecurityScanningResultUpsert) {
@@ -1501,6 +1870,125 @@ func (u *SecurityScanningResultUpsertBulk) UpdateEndPosition() *SecurityScanning
 	})
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (u *SecurityScanningResultUpsertBulk) SetFingerprint(v string) *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertBulk) UpdateFingerprint() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *SecurityScanningResultUpsertBulk) ClearFingerprint() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearFingerprint()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *SecurityScanningResultUpsertBulk) SetState(v consts.SecurityScanningFindingState) *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertBulk) UpdateState() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateState()
+	})
+}
+
+// ClearState clears the value of the "state" field.
+func (u *SecurityScanningResultUpsertBulk) ClearState() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearState()
+	})
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (u *SecurityScanningResultUpsertBulk) SetTriageStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetTriageStatus(v)
+	})
+}
+
+// UpdateTriageStatus sets the "triage_status" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertBulk) UpdateTriageStatus() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateTriageStatus()
+	})
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (u *SecurityScanningResultUpsertBulk) SetAssigneeID(v uuid.UUID) *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetAssigneeID(v)
+	})
+}
+
+// UpdateAssigneeID sets the "assignee_id" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertBulk) UpdateAssigneeID() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateAssigneeID()
+	})
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (u *SecurityScanningResultUpsertBulk) ClearAssigneeID() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearAssigneeID()
+	})
+}
+
+// SetRiskExpiresAt sets the "risk_expires_at" field.
+func (u *SecurityScanningResultUpsertBulk) SetRiskExpiresAt(v time.Time) *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetRiskExpiresAt(v)
+	})
+}
+
+// UpdateRiskExpiresAt sets the "risk_expires_at" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertBulk) UpdateRiskExpiresAt() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateRiskExpiresAt()
+	})
+}
+
+// ClearRiskExpiresAt clears the value of the "risk_expires_at" field.
+func (u *SecurityScanningResultUpsertBulk) ClearRiskExpiresAt() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearRiskExpiresAt()
+	})
+}
+
+// SetSuppressionID sets the "suppression_id" field.
+func (u *SecurityScanningResultUpsertBulk) SetSuppressionID(v uuid.UUID) *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.SetSuppressionID(v)
+	})
+}
+
+// UpdateSuppressionID sets the "suppression_id" field to the value that was provided on create.
+func (u *SecurityScanningResultUpsertBulk) UpdateSuppressionID() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.UpdateSuppressionID()
+	})
+}
+
+// ClearSuppressionID clears the value of the "suppression_id" field.
+func (u *SecurityScanningResultUpsertBulk) ClearSuppressionID() *SecurityScanningResultUpsertBulk {
+	return u.Update(func(s *SecurityScanningResultUpsert) {
+		s.ClearSuppressionID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SecurityScanningResultUpsertBulk) SetCreatedAt(v time.Time) *SecurityScanningResultUpsertBulk {
 	return u.Update(func(s *SecurityScanningResultUpsert) {
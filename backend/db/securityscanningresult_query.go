@@ -4,6 +4,7 @@ package db
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,6 +15,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/MonkeyCode/backend/db/predicate"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/google/uuid"
 )
@@ -26,6 +28,7 @@ type SecurityScanningResultQuery struct {
 	inters               []Interceptor
 	predicates           []predicate.SecurityScanningResult
 	withSecurityScanning *SecurityScanningQuery
+	withComments         *SecurityScanningCommentQuery
 	modifiers            []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -85,6 +88,28 @@ func (ssrq *SecurityScanningResultQuery) QuerySecurityScanning() *SecurityScanni
 	return query
 }
 
+// QueryComments chains the current query on the "comments" edge.
+func (ssrq *SecurityScanningResultQuery) QueryComments() *SecurityScanningCommentQuery {
+	query := (&SecurityScanningCommentClient{config: ssrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ssrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ssrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(securityscanningresult.Table, securityscanningresult.FieldID, selector),
+			sqlgraph.To(securityscanningcomment.Table, securityscanningcomment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, securityscanningresult.CommentsTable, securityscanningresult.CommentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ssrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SecurityScanningResult entity from the query.
 // Returns a *NotFoundError when no SecurityScanningResult was found.
 func (ssrq *SecurityScanningResultQuery) First(ctx context.Context) (*SecurityScanningResult, error) {
@@ -278,6 +303,7 @@ func (ssrq *SecurityScanningResultQuery) Clone() *SecurityScanningResultQuery {
 		inters:               append([]Interceptor{}, ssrq.inters...),
 		predicates:           append([]predicate.SecurityScanningResult{}, ssrq.predicates...),
 		withSecurityScanning: ssrq.withSecurityScanning.Clone(),
+		withComments:         ssrq.withComments.Clone(),
 		// clone intermediate query.
 		sql:       ssrq.sql.Clone(),
 		path:      ssrq.path,
@@ -296,6 +322,17 @@ func (ssrq *SecurityScanningResultQuery) WithSecurityScanning(opts ...func(*Secu
 	return ssrq
 }
 
+// WithComments tells the query-builder to eager-load the nodes that are connected to
+// the "comments" edge. The optional arguments are used to configure the query builder of the edge.
+func (ssrq *SecurityScanningResultQuery) WithComments(opts ...func(*SecurityScanningCommentQuery)) *SecurityScanningResultQuery {
+	query := (&SecurityScanningCommentClient{config: ssrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ssrq.withComments = query
+	return ssrq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -374,8 +411,9 @@ func (ssrq *SecurityScanningResultQuery) sqlAll(ctx context.Context, hooks ...qu
 	var (
 		nodes       = []*SecurityScanningResult{}
 		_spec       = ssrq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			ssrq.withSecurityScanning != nil,
+			ssrq.withComments != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -405,6 +443,15 @@ func (ssrq *SecurityScanningResultQuery) sqlAll(ctx context.Context, hooks ...qu
 			return nil, err
 		}
 	}
+	if query := ssrq.withComments; query != nil {
+		if err := ssrq.loadComments(ctx, query, nodes,
+			func(n *SecurityScanningResult) { n.Edges.Comments = []*SecurityScanningComment{} },
+			func(n *SecurityScanningResult, e *SecurityScanningComment) {
+				n.Edges.Comments = append(n.Edges.Comments, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -437,6 +484,36 @@ func (ssrq *SecurityScanningResultQuery) loadSecurityScanning(ctx context.Contex
 	}
 	return nil
 }
+func (ssrq *SecurityScanningResultQuery) loadComments(ctx context.Context, query *SecurityScanningCommentQuery, nodes []*SecurityScanningResult, init func(*SecurityScanningResult), assign func(*SecurityScanningResult, *SecurityScanningComment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*SecurityScanningResult)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(securityscanningcomment.FieldResultID)
+	}
+	query.Where(predicate.SecurityScanningComment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(securityscanningresult.CommentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ResultID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "result_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (ssrq *SecurityScanningResultQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ssrq.querySpec()
@@ -12,8 +12,10 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/predicate"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
@@ -279,6 +281,120 @@ func (ssru *SecurityScanningResultUpdate) SetEndPosition(t *types.Position) *Sec
 	return ssru
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (ssru *SecurityScanningResultUpdate) SetFingerprint(s string) *SecurityScanningResultUpdate {
+	ssru.mutation.SetFingerprint(s)
+	return ssru
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ssru *SecurityScanningResultUpdate) SetNillableFingerprint(s *string) *SecurityScanningResultUpdate {
+	if s != nil {
+		ssru.SetFingerprint(*s)
+	}
+	return ssru
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (ssru *SecurityScanningResultUpdate) ClearFingerprint() *SecurityScanningResultUpdate {
+	ssru.mutation.ClearFingerprint()
+	return ssru
+}
+
+// SetState sets the "state" field.
+func (ssru *SecurityScanningResultUpdate) SetState(csfs consts.SecurityScanningFindingState) *SecurityScanningResultUpdate {
+	ssru.mutation.SetState(csfs)
+	return ssru
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ssru *SecurityScanningResultUpdate) SetNillableState(csfs *consts.SecurityScanningFindingState) *SecurityScanningResultUpdate {
+	if csfs != nil {
+		ssru.SetState(*csfs)
+	}
+	return ssru
+}
+
+// ClearState clears the value of the "state" field.
+func (ssru *SecurityScanningResultUpdate) ClearState() *SecurityScanningResultUpdate {
+	ssru.mutation.ClearState()
+	return ssru
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (ssru *SecurityScanningResultUpdate) SetTriageStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningResultUpdate {
+	ssru.mutation.SetTriageStatus(csts)
+	return ssru
+}
+
+// SetNillableTriageStatus sets the "triage_status" field if the given value is not nil.
+func (ssru *SecurityScanningResultUpdate) SetNillableTriageStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningResultUpdate {
+	if csts != nil {
+		ssru.SetTriageStatus(*csts)
+	}
+	return ssru
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (ssru *SecurityScanningResultUpdate) SetAssigneeID(u uuid.UUID) *SecurityScanningResultUpdate {
+	ssru.mutation.SetAssigneeID(u)
+	return ssru
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (ssru *SecurityScanningResultUpdate) SetNillableAssigneeID(u *uuid.UUID) *SecurityScanningResultUpdate {
+	if u != nil {
+		ssru.SetAssigneeID(*u)
+	}
+	return ssru
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (ssru *SecurityScanningResultUpdate) ClearAssigneeID() *SecurityScanningResultUpdate {
+	ssru.mutation.ClearAssigneeID()
+	return ssru
+}
+
+// SetRiskExpiresAt sets the "risk_expires_at" field.
+func (ssru *SecurityScanningResultUpdate) SetRiskExpiresAt(t time.Time) *SecurityScanningResultUpdate {
+	ssru.mutation.SetRiskExpiresAt(t)
+	return ssru
+}
+
+// SetNillableRiskExpiresAt sets the "risk_expires_at" field if the given value is not nil.
+func (ssru *SecurityScanningResultUpdate) SetNillableRiskExpiresAt(t *time.Time) *SecurityScanningResultUpdate {
+	if t != nil {
+		ssru.SetRiskExpiresAt(*t)
+	}
+	return ssru
+}
+
+// ClearRiskExpiresAt clears the value of the "risk_expires_at" field.
+func (ssru *SecurityScanningResultUpdate) ClearRiskExpiresAt() *SecurityScanningResultUpdate {
+	ssru.mutation.ClearRiskExpiresAt()
+	return ssru
+}
+
+// SetSuppressionID sets the "suppression_id" field.
+func (ssru *SecurityScanningResultUpdate) SetSuppressionID(u uuid.UUID) *SecurityScanningResultUpdate {
+	ssru.mutation.SetSuppressionID(u)
+	return ssru
+}
+
+// SetNillableSuppressionID sets the "suppression_id" field if the given value is not nil.
+func (ssru *SecurityScanningResultUpdate) SetNillableSuppressionID(u *uuid.UUID) *SecurityScanningResultUpdate {
+	if u != nil {
+		ssru.SetSuppressionID(*u)
+	}
+	return ssru
+}
+
+// ClearSuppressionID clears the value of the "suppression_id" field.
+func (ssru *SecurityScanningResultUpdate) ClearSuppressionID() *SecurityScanningResultUpdate {
+	ssru.mutation.ClearSuppressionID()
+	return ssru
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ssru *SecurityScanningResultUpdate) SetCreatedAt(t time.Time) *SecurityScanningResultUpdate {
 	ssru.mutation.SetCreatedAt(t)
@@ -298,6 +414,21 @@ func (ssru *SecurityScanningResultUpdate) SetSecurityScanning(s *SecurityScannin
 	return ssru.SetSecurityScanningID(s.ID)
 }
 
+// AddCommentIDs adds the "comments" edge to the SecurityScanningComment entity by IDs.
+func (ssru *SecurityScanningResultUpdate) AddCommentIDs(ids ...uuid.UUID) *SecurityScanningResultUpdate {
+	ssru.mutation.AddCommentIDs(ids...)
+	return ssru
+}
+
+// AddComments adds the "comments" edges to the SecurityScanningComment entity.
+func (ssru *SecurityScanningResultUpdate) AddComments(s ...*SecurityScanningComment) *SecurityScanningResultUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ssru.AddCommentIDs(ids...)
+}
+
 // Mutation returns the SecurityScanningResultMutation object of the builder.
 func (ssru *SecurityScanningResultUpdate) Mutation() *SecurityScanningResultMutation {
 	return ssru.mutation
@@ -309,6 +440,27 @@ func (ssru *SecurityScanningResultUpdate) ClearSecurityScanning() *SecurityScann
 	return ssru
 }
 
+// ClearComments clears all "comments" edges to the SecurityScanningComment entity.
+func (ssru *SecurityScanningResultUpdate) ClearComments() *SecurityScanningResultUpdate {
+	ssru.mutation.ClearComments()
+	return ssru
+}
+
+// RemoveCommentIDs removes the "comments" edge to SecurityScanningComment entities by IDs.
+func (ssru *SecurityScanningResultUpdate) RemoveCommentIDs(ids ...uuid.UUID) *SecurityScanningResultUpdate {
+	ssru.mutation.RemoveCommentIDs(ids...)
+	return ssru
+}
+
+// RemoveComments removes "comments" edges to SecurityScanningComment entities.
+func (ssru *SecurityScanningResultUpdate) RemoveComments(s ...*SecurityScanningComment) *SecurityScanningResultUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ssru.RemoveCommentIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ssru *SecurityScanningResultUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, ssru.sqlSave, ssru.mutation, ssru.hooks)
@@ -426,6 +578,39 @@ func (ssru *SecurityScanningResultUpdate) sqlSave(ctx context.Context) (n int, e
 	if value, ok := ssru.mutation.EndPosition(); ok {
 		_spec.SetField(securityscanningresult.FieldEndPosition, field.TypeJSON, value)
 	}
+	if value, ok := ssru.mutation.Fingerprint(); ok {
+		_spec.SetField(securityscanningresult.FieldFingerprint, field.TypeString, value)
+	}
+	if ssru.mutation.FingerprintCleared() {
+		_spec.ClearField(securityscanningresult.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := ssru.mutation.State(); ok {
+		_spec.SetField(securityscanningresult.FieldState, field.TypeString, value)
+	}
+	if ssru.mutation.StateCleared() {
+		_spec.ClearField(securityscanningresult.FieldState, field.TypeString)
+	}
+	if value, ok := ssru.mutation.TriageStatus(); ok {
+		_spec.SetField(securityscanningresult.FieldTriageStatus, field.TypeString, value)
+	}
+	if value, ok := ssru.mutation.AssigneeID(); ok {
+		_spec.SetField(securityscanningresult.FieldAssigneeID, field.TypeUUID, value)
+	}
+	if ssru.mutation.AssigneeIDCleared() {
+		_spec.ClearField(securityscanningresult.FieldAssigneeID, field.TypeUUID)
+	}
+	if value, ok := ssru.mutation.RiskExpiresAt(); ok {
+		_spec.SetField(securityscanningresult.FieldRiskExpiresAt, field.TypeTime, value)
+	}
+	if ssru.mutation.RiskExpiresAtCleared() {
+		_spec.ClearField(securityscanningresult.FieldRiskExpiresAt, field.TypeTime)
+	}
+	if value, ok := ssru.mutation.SuppressionID(); ok {
+		_spec.SetField(securityscanningresult.FieldSuppressionID, field.TypeUUID, value)
+	}
+	if ssru.mutation.SuppressionIDCleared() {
+		_spec.ClearField(securityscanningresult.FieldSuppressionID, field.TypeUUID)
+	}
 	if value, ok := ssru.mutation.CreatedAt(); ok {
 		_spec.SetField(securityscanningresult.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -458,6 +643,51 @@ func (ssru *SecurityScanningResultUpdate) sqlSave(ctx context.Context) (n int, e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ssru.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssru.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !ssru.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssru.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(ssru.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, ssru.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -726,6 +956,120 @@ func (ssruo *SecurityScanningResultUpdateOne) SetEndPosition(t *types.Position)
 	return ssruo
 }
 
+// SetFingerprint sets the "fingerprint" field.
+func (ssruo *SecurityScanningResultUpdateOne) SetFingerprint(s string) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.SetFingerprint(s)
+	return ssruo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ssruo *SecurityScanningResultUpdateOne) SetNillableFingerprint(s *string) *SecurityScanningResultUpdateOne {
+	if s != nil {
+		ssruo.SetFingerprint(*s)
+	}
+	return ssruo
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (ssruo *SecurityScanningResultUpdateOne) ClearFingerprint() *SecurityScanningResultUpdateOne {
+	ssruo.mutation.ClearFingerprint()
+	return ssruo
+}
+
+// SetState sets the "state" field.
+func (ssruo *SecurityScanningResultUpdateOne) SetState(csfs consts.SecurityScanningFindingState) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.SetState(csfs)
+	return ssruo
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (ssruo *SecurityScanningResultUpdateOne) SetNillableState(csfs *consts.SecurityScanningFindingState) *SecurityScanningResultUpdateOne {
+	if csfs != nil {
+		ssruo.SetState(*csfs)
+	}
+	return ssruo
+}
+
+// ClearState clears the value of the "state" field.
+func (ssruo *SecurityScanningResultUpdateOne) ClearState() *SecurityScanningResultUpdateOne {
+	ssruo.mutation.ClearState()
+	return ssruo
+}
+
+// SetTriageStatus sets the "triage_status" field.
+func (ssruo *SecurityScanningResultUpdateOne) SetTriageStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.SetTriageStatus(csts)
+	return ssruo
+}
+
+// SetNillableTriageStatus sets the "triage_status" field if the given value is not nil.
+func (ssruo *SecurityScanningResultUpdateOne) SetNillableTriageStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningResultUpdateOne {
+	if csts != nil {
+		ssruo.SetTriageStatus(*csts)
+	}
+	return ssruo
+}
+
+// SetAssigneeID sets the "assignee_id" field.
+func (ssruo *SecurityScanningResultUpdateOne) SetAssigneeID(u uuid.UUID) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.SetAssigneeID(u)
+	return ssruo
+}
+
+// SetNillableAssigneeID sets the "assignee_id" field if the given value is not nil.
+func (ssruo *SecurityScanningResultUpdateOne) SetNillableAssigneeID(u *uuid.UUID) *SecurityScanningResultUpdateOne {
+	if u != nil {
+		ssruo.SetAssigneeID(*u)
+	}
+	return ssruo
+}
+
+// ClearAssigneeID clears the value of the "assignee_id" field.
+func (ssruo *SecurityScanningResultUpdateOne) ClearAssigneeID() *SecurityScanningResultUpdateOne {
+	ssruo.mutation.ClearAssigneeID()
+	return ssruo
+}
+
+// SetRiskExpiresAt sets the "risk_expires_at" field.
+func (ssruo *SecurityScanningResultUpdateOne) SetRiskExpiresAt(t time.Time) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.SetRiskExpiresAt(t)
+	return ssruo
+}
+
+// SetNillableRiskExpiresAt sets the "risk_expires_at" field if the given value is not nil.
+func (ssruo *SecurityScanningResultUpdateOne) SetNillableRiskExpiresAt(t *time.Time) *SecurityScanningResultUpdateOne {
+	if t != nil {
+		ssruo.SetRiskExpiresAt(*t)
+	}
+	return ssruo
+}
+
+// ClearRiskExpiresAt clears the value of the "risk_expires_at" field.
+func (ssruo *SecurityScanningResultUpdateOne) ClearRiskExpiresAt() *SecurityScanningResultUpdateOne {
+	ssruo.mutation.ClearRiskExpiresAt()
+	return ssruo
+}
+
+// SetSuppressionID sets the "suppression_id" field.
+func (ssruo *SecurityScanningResultUpdateOne) SetSuppressionID(u uuid.UUID) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.SetSuppressionID(u)
+	return ssruo
+}
+
+// SetNillableSuppressionID sets the "suppression_id" field if the given value is not nil.
+func (ssruo *SecurityScanningResultUpdateOne) SetNillableSuppressionID(u *uuid.UUID) *SecurityScanningResultUpdateOne {
+	if u != nil {
+		ssruo.SetSuppressionID(*u)
+	}
+	return ssruo
+}
+
+// ClearSuppressionID clears the value of the "suppression_id" field.
+func (ssruo *SecurityScanningResultUpdateOne) ClearSuppressionID() *SecurityScanningResultUpdateOne {
+	ssruo.mutation.ClearSuppressionID()
+	return ssruo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ssruo *SecurityScanningResultUpdateOne) SetCreatedAt(t time.Time) *SecurityScanningResultUpdateOne {
 	ssruo.mutation.SetCreatedAt(t)
@@ -745,6 +1089,21 @@ func (ssruo *SecurityScanningResultUpdateOne) SetSecurityScanning(s *SecuritySca
 	return ssruo.SetSecurityScanningID(s.ID)
 }
 
+// AddCommentIDs adds the "comments" edge to the SecurityScanningComment entity by IDs.
+func (ssruo *SecurityScanningResultUpdateOne) AddCommentIDs(ids ...uuid.UUID) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.AddCommentIDs(ids...)
+	return ssruo
+}
+
+// AddComments adds the "comments" edges to the SecurityScanningComment entity.
+func (ssruo *SecurityScanningResultUpdateOne) AddComments(s ...*SecurityScanningComment) *SecurityScanningResultUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ssruo.AddCommentIDs(ids...)
+}
+
 // Mutation returns the SecurityScanningResultMutation object of the builder.
 func (ssruo *SecurityScanningResultUpdateOne) Mutation() *SecurityScanningResultMutation {
 	return ssruo.mutation
@@ -756,6 +1115,27 @@ func (ssruo *SecurityScanningResultUpdateOne) ClearSecurityScanning() *SecurityS
 	return ssruo
 }
 
+// ClearComments clears all "comments" edges to the SecurityScanningComment entity.
+func (ssruo *SecurityScanningResultUpdateOne) ClearComments() *SecurityScanningResultUpdateOne {
+	ssruo.mutation.ClearComments()
+	return ssruo
+}
+
+// RemoveCommentIDs removes the "comments" edge to SecurityScanningComment entities by IDs.
+func (ssruo *SecurityScanningResultUpdateOne) RemoveCommentIDs(ids ...uuid.UUID) *SecurityScanningResultUpdateOne {
+	ssruo.mutation.RemoveCommentIDs(ids...)
+	return ssruo
+}
+
+// RemoveComments removes "comments" edges to SecurityScanningComment entities.
+func (ssruo *SecurityScanningResultUpdateOne) RemoveComments(s ...*SecurityScanningComment) *SecurityScanningResultUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ssruo.RemoveCommentIDs(ids...)
+}
+
 // Where appends a list predicates to the SecurityScanningResultUpdate builder.
 func (ssruo *SecurityScanningResultUpdateOne) Where(ps ...predicate.SecurityScanningResult) *SecurityScanningResultUpdateOne {
 	ssruo.mutation.Where(ps...)
@@ -903,6 +1283,39 @@ func (ssruo *SecurityScanningResultUpdateOne) sqlSave(ctx context.Context) (_nod
 	if value, ok := ssruo.mutation.EndPosition(); ok {
 		_spec.SetField(securityscanningresult.FieldEndPosition, field.TypeJSON, value)
 	}
+	if value, ok := ssruo.mutation.Fingerprint(); ok {
+		_spec.SetField(securityscanningresult.FieldFingerprint, field.TypeString, value)
+	}
+	if ssruo.mutation.FingerprintCleared() {
+		_spec.ClearField(securityscanningresult.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := ssruo.mutation.State(); ok {
+		_spec.SetField(securityscanningresult.FieldState, field.TypeString, value)
+	}
+	if ssruo.mutation.StateCleared() {
+		_spec.ClearField(securityscanningresult.FieldState, field.TypeString)
+	}
+	if value, ok := ssruo.mutation.TriageStatus(); ok {
+		_spec.SetField(securityscanningresult.FieldTriageStatus, field.TypeString, value)
+	}
+	if value, ok := ssruo.mutation.AssigneeID(); ok {
+		_spec.SetField(securityscanningresult.FieldAssigneeID, field.TypeUUID, value)
+	}
+	if ssruo.mutation.AssigneeIDCleared() {
+		_spec.ClearField(securityscanningresult.FieldAssigneeID, field.TypeUUID)
+	}
+	if value, ok := ssruo.mutation.RiskExpiresAt(); ok {
+		_spec.SetField(securityscanningresult.FieldRiskExpiresAt, field.TypeTime, value)
+	}
+	if ssruo.mutation.RiskExpiresAtCleared() {
+		_spec.ClearField(securityscanningresult.FieldRiskExpiresAt, field.TypeTime)
+	}
+	if value, ok := ssruo.mutation.SuppressionID(); ok {
+		_spec.SetField(securityscanningresult.FieldSuppressionID, field.TypeUUID, value)
+	}
+	if ssruo.mutation.SuppressionIDCleared() {
+		_spec.ClearField(securityscanningresult.FieldSuppressionID, field.TypeUUID)
+	}
 	if value, ok := ssruo.mutation.CreatedAt(); ok {
 		_spec.SetField(securityscanningresult.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -935,6 +1348,51 @@ func (ssruo *SecurityScanningResultUpdateOne) sqlSave(ctx context.Context) (_nod
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ssruo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssruo.mutation.RemovedCommentsIDs(); len(nodes) > 0 && !ssruo.mutation.CommentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ssruo.mutation.CommentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   securityscanningresult.CommentsTable,
+			Columns: []string{securityscanningresult.CommentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(securityscanningcomment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(ssruo.modifiers...)
 	_node = &SecurityScanningResult{config: ssruo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningSuppression is the model entity for the SecurityScanningSuppression schema.
+type SecurityScanningSuppression struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 生效的工作区，为空表示所有工作区
+	WorkspaceID *uuid.UUID `json:"workspace_id,omitempty"`
+	// 屏蔽的发现指纹，为空时按规则屏蔽
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// 屏蔽的规则
+	CheckID string `json:"check_id,omitempty"`
+	// 误报或接受风险
+	Status consts.SecurityScanningTriageStatus `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// 到期时间，为空表示永久生效
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatorID holds the value of the "creator_id" field.
+	CreatorID uuid.UUID `json:"creator_id,omitempty"`
+	// Creator holds the value of the "creator" field.
+	Creator string `json:"creator,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SecurityScanningSuppression) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case securityscanningsuppression.FieldWorkspaceID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case securityscanningsuppression.FieldFingerprint, securityscanningsuppression.FieldCheckID, securityscanningsuppression.FieldStatus, securityscanningsuppression.FieldReason, securityscanningsuppression.FieldCreator:
+			values[i] = new(sql.NullString)
+		case securityscanningsuppression.FieldExpiresAt, securityscanningsuppression.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case securityscanningsuppression.FieldID, securityscanningsuppression.FieldCreatorID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SecurityScanningSuppression fields.
+func (sss *SecurityScanningSuppression) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case securityscanningsuppression.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sss.ID = *value
+			}
+		case securityscanningsuppression.FieldWorkspaceID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field workspace_id", values[i])
+			} else if value.Valid {
+				sss.WorkspaceID = new(uuid.UUID)
+				*sss.WorkspaceID = *value.S.(*uuid.UUID)
+			}
+		case securityscanningsuppression.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				sss.Fingerprint = value.String
+			}
+		case securityscanningsuppression.FieldCheckID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field check_id", values[i])
+			} else if value.Valid {
+				sss.CheckID = value.String
+			}
+		case securityscanningsuppression.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				sss.Status = consts.SecurityScanningTriageStatus(value.String)
+			}
+		case securityscanningsuppression.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				sss.Reason = value.String
+			}
+		case securityscanningsuppression.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sss.ExpiresAt = new(time.Time)
+				*sss.ExpiresAt = value.Time
+			}
+		case securityscanningsuppression.FieldCreatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field creator_id", values[i])
+			} else if value != nil {
+				sss.CreatorID = *value
+			}
+		case securityscanningsuppression.FieldCreator:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field creator", values[i])
+			} else if value.Valid {
+				sss.Creator = value.String
+			}
+		case securityscanningsuppression.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sss.CreatedAt = value.Time
+			}
+		default:
+			sss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SecurityScanningSuppression.
+// This includes values selected through modifiers, order, etc.
+func (sss *SecurityScanningSuppression) Value(name string) (ent.Value, error) {
+	return sss.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SecurityScanningSuppression.
+// Note that you need to call SecurityScanningSuppression.Unwrap() before calling this method if this SecurityScanningSuppression
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sss *SecurityScanningSuppression) Update() *SecurityScanningSuppressionUpdateOne {
+	return NewSecurityScanningSuppressionClient(sss.config).UpdateOne(sss)
+}
+
+// Unwrap unwraps the SecurityScanningSuppression entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sss *SecurityScanningSuppression) Unwrap() *SecurityScanningSuppression {
+	_tx, ok := sss.config.driver.(*txDriver)
+	if !ok {
+		panic("db: SecurityScanningSuppression is not a transactional entity")
+	}
+	sss.config.driver = _tx.drv
+	return sss
+}
+
+// String implements the fmt.Stringer.
+func (sss *SecurityScanningSuppression) String() string {
+	var builder strings.Builder
+	builder.WriteString("SecurityScanningSuppression(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sss.ID))
+	if v := sss.WorkspaceID; v != nil {
+		builder.WriteString("workspace_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(sss.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("check_id=")
+	builder.WriteString(sss.CheckID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", sss.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(sss.Reason)
+	builder.WriteString(", ")
+	if v := sss.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("creator_id=")
+	builder.WriteString(fmt.Sprintf("%v", sss.CreatorID))
+	builder.WriteString(", ")
+	builder.WriteString("creator=")
+	builder.WriteString(sss.Creator)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sss.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SecurityScanningSuppressions is a parsable slice of SecurityScanningSuppression.
+type SecurityScanningSuppressions []*SecurityScanningSuppression
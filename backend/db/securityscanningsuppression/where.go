@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package securityscanningsuppression
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldID, id))
+}
+
+// WorkspaceID applies equality check predicate on the "workspace_id" field. It's identical to WorkspaceIDEQ.
+func WorkspaceID(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// CheckID applies equality check predicate on the "check_id" field. It's identical to CheckIDEQ.
+func CheckID(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCheckID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldStatus, vc))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldReason, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatorID applies equality check predicate on the "creator_id" field. It's identical to CreatorIDEQ.
+func CreatorID(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// Creator applies equality check predicate on the "creator" field. It's identical to CreatorEQ.
+func Creator(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WorkspaceIDEQ applies the EQ predicate on the "workspace_id" field.
+func WorkspaceIDEQ(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDNEQ applies the NEQ predicate on the "workspace_id" field.
+func WorkspaceIDNEQ(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIn applies the In predicate on the "workspace_id" field.
+func WorkspaceIDIn(vs ...uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDNotIn applies the NotIn predicate on the "workspace_id" field.
+func WorkspaceIDNotIn(vs ...uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldWorkspaceID, vs...))
+}
+
+// WorkspaceIDGT applies the GT predicate on the "workspace_id" field.
+func WorkspaceIDGT(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDGTE applies the GTE predicate on the "workspace_id" field.
+func WorkspaceIDGTE(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLT applies the LT predicate on the "workspace_id" field.
+func WorkspaceIDLT(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDLTE applies the LTE predicate on the "workspace_id" field.
+func WorkspaceIDLTE(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldWorkspaceID, v))
+}
+
+// WorkspaceIDIsNil applies the IsNil predicate on the "workspace_id" field.
+func WorkspaceIDIsNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIsNull(FieldWorkspaceID))
+}
+
+// WorkspaceIDNotNil applies the NotNil predicate on the "workspace_id" field.
+func WorkspaceIDNotNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotNull(FieldWorkspaceID))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotNull(FieldFingerprint))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// CheckIDEQ applies the EQ predicate on the "check_id" field.
+func CheckIDEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCheckID, v))
+}
+
+// CheckIDNEQ applies the NEQ predicate on the "check_id" field.
+func CheckIDNEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldCheckID, v))
+}
+
+// CheckIDIn applies the In predicate on the "check_id" field.
+func CheckIDIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldCheckID, vs...))
+}
+
+// CheckIDNotIn applies the NotIn predicate on the "check_id" field.
+func CheckIDNotIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldCheckID, vs...))
+}
+
+// CheckIDGT applies the GT predicate on the "check_id" field.
+func CheckIDGT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldCheckID, v))
+}
+
+// CheckIDGTE applies the GTE predicate on the "check_id" field.
+func CheckIDGTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldCheckID, v))
+}
+
+// CheckIDLT applies the LT predicate on the "check_id" field.
+func CheckIDLT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldCheckID, v))
+}
+
+// CheckIDLTE applies the LTE predicate on the "check_id" field.
+func CheckIDLTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldCheckID, v))
+}
+
+// CheckIDContains applies the Contains predicate on the "check_id" field.
+func CheckIDContains(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContains(FieldCheckID, v))
+}
+
+// CheckIDHasPrefix applies the HasPrefix predicate on the "check_id" field.
+func CheckIDHasPrefix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasPrefix(FieldCheckID, v))
+}
+
+// CheckIDHasSuffix applies the HasSuffix predicate on the "check_id" field.
+func CheckIDHasSuffix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasSuffix(FieldCheckID, v))
+}
+
+// CheckIDIsNil applies the IsNil predicate on the "check_id" field.
+func CheckIDIsNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIsNull(FieldCheckID))
+}
+
+// CheckIDNotNil applies the NotNil predicate on the "check_id" field.
+func CheckIDNotNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotNull(FieldCheckID))
+}
+
+// CheckIDEqualFold applies the EqualFold predicate on the "check_id" field.
+func CheckIDEqualFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEqualFold(FieldCheckID, v))
+}
+
+// CheckIDContainsFold applies the ContainsFold predicate on the "check_id" field.
+func CheckIDContainsFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContainsFold(FieldCheckID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v consts.SecurityScanningTriageStatus) predicate.SecurityScanningSuppression {
+	vc := string(v)
+	return predicate.SecurityScanningSuppression(sql.FieldContainsFold(FieldStatus, vc))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// CreatorIDEQ applies the EQ predicate on the "creator_id" field.
+func CreatorIDEQ(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCreatorID, v))
+}
+
+// CreatorIDNEQ applies the NEQ predicate on the "creator_id" field.
+func CreatorIDNEQ(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldCreatorID, v))
+}
+
+// CreatorIDIn applies the In predicate on the "creator_id" field.
+func CreatorIDIn(vs ...uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDNotIn applies the NotIn predicate on the "creator_id" field.
+func CreatorIDNotIn(vs ...uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldCreatorID, vs...))
+}
+
+// CreatorIDGT applies the GT predicate on the "creator_id" field.
+func CreatorIDGT(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldCreatorID, v))
+}
+
+// CreatorIDGTE applies the GTE predicate on the "creator_id" field.
+func CreatorIDGTE(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldCreatorID, v))
+}
+
+// CreatorIDLT applies the LT predicate on the "creator_id" field.
+func CreatorIDLT(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldCreatorID, v))
+}
+
+// CreatorIDLTE applies the LTE predicate on the "creator_id" field.
+func CreatorIDLTE(v uuid.UUID) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldCreatorID, v))
+}
+
+// CreatorEQ applies the EQ predicate on the "creator" field.
+func CreatorEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCreator, v))
+}
+
+// CreatorNEQ applies the NEQ predicate on the "creator" field.
+func CreatorNEQ(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldCreator, v))
+}
+
+// CreatorIn applies the In predicate on the "creator" field.
+func CreatorIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldCreator, vs...))
+}
+
+// CreatorNotIn applies the NotIn predicate on the "creator" field.
+func CreatorNotIn(vs ...string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldCreator, vs...))
+}
+
+// CreatorGT applies the GT predicate on the "creator" field.
+func CreatorGT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldCreator, v))
+}
+
+// CreatorGTE applies the GTE predicate on the "creator" field.
+func CreatorGTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldCreator, v))
+}
+
+// CreatorLT applies the LT predicate on the "creator" field.
+func CreatorLT(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldCreator, v))
+}
+
+// CreatorLTE applies the LTE predicate on the "creator" field.
+func CreatorLTE(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldCreator, v))
+}
+
+// CreatorContains applies the Contains predicate on the "creator" field.
+func CreatorContains(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContains(FieldCreator, v))
+}
+
+// CreatorHasPrefix applies the HasPrefix predicate on the "creator" field.
+func CreatorHasPrefix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasPrefix(FieldCreator, v))
+}
+
+// CreatorHasSuffix applies the HasSuffix predicate on the "creator" field.
+func CreatorHasSuffix(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldHasSuffix(FieldCreator, v))
+}
+
+// CreatorIsNil applies the IsNil predicate on the "creator" field.
+func CreatorIsNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIsNull(FieldCreator))
+}
+
+// CreatorNotNil applies the NotNil predicate on the "creator" field.
+func CreatorNotNil() predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotNull(FieldCreator))
+}
+
+// CreatorEqualFold applies the EqualFold predicate on the "creator" field.
+func CreatorEqualFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEqualFold(FieldCreator, v))
+}
+
+// CreatorContainsFold applies the ContainsFold predicate on the "creator" field.
+func CreatorContainsFold(v string) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldContainsFold(FieldCreator, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SecurityScanningSuppression) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SecurityScanningSuppression) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SecurityScanningSuppression) predicate.SecurityScanningSuppression {
+	return predicate.SecurityScanningSuppression(sql.NotPredicates(p))
+}
@@ -0,0 +1,1096 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningSuppressionCreate is the builder for creating a SecurityScanningSuppression entity.
+type SecurityScanningSuppressionCreate struct {
+	config
+	mutation *SecurityScanningSuppressionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (sssc *SecurityScanningSuppressionCreate) SetWorkspaceID(u uuid.UUID) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetWorkspaceID(u)
+	return sssc
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableWorkspaceID(u *uuid.UUID) *SecurityScanningSuppressionCreate {
+	if u != nil {
+		sssc.SetWorkspaceID(*u)
+	}
+	return sssc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sssc *SecurityScanningSuppressionCreate) SetFingerprint(s string) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetFingerprint(s)
+	return sssc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableFingerprint(s *string) *SecurityScanningSuppressionCreate {
+	if s != nil {
+		sssc.SetFingerprint(*s)
+	}
+	return sssc
+}
+
+// SetCheckID sets the "check_id" field.
+func (sssc *SecurityScanningSuppressionCreate) SetCheckID(s string) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetCheckID(s)
+	return sssc
+}
+
+// SetNillableCheckID sets the "check_id" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableCheckID(s *string) *SecurityScanningSuppressionCreate {
+	if s != nil {
+		sssc.SetCheckID(*s)
+	}
+	return sssc
+}
+
+// SetStatus sets the "status" field.
+func (sssc *SecurityScanningSuppressionCreate) SetStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetStatus(csts)
+	return sssc
+}
+
+// SetReason sets the "reason" field.
+func (sssc *SecurityScanningSuppressionCreate) SetReason(s string) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetReason(s)
+	return sssc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableReason(s *string) *SecurityScanningSuppressionCreate {
+	if s != nil {
+		sssc.SetReason(*s)
+	}
+	return sssc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sssc *SecurityScanningSuppressionCreate) SetExpiresAt(t time.Time) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetExpiresAt(t)
+	return sssc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableExpiresAt(t *time.Time) *SecurityScanningSuppressionCreate {
+	if t != nil {
+		sssc.SetExpiresAt(*t)
+	}
+	return sssc
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (sssc *SecurityScanningSuppressionCreate) SetCreatorID(u uuid.UUID) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetCreatorID(u)
+	return sssc
+}
+
+// SetCreator sets the "creator" field.
+func (sssc *SecurityScanningSuppressionCreate) SetCreator(s string) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetCreator(s)
+	return sssc
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableCreator(s *string) *SecurityScanningSuppressionCreate {
+	if s != nil {
+		sssc.SetCreator(*s)
+	}
+	return sssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sssc *SecurityScanningSuppressionCreate) SetCreatedAt(t time.Time) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetCreatedAt(t)
+	return sssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableCreatedAt(t *time.Time) *SecurityScanningSuppressionCreate {
+	if t != nil {
+		sssc.SetCreatedAt(*t)
+	}
+	return sssc
+}
+
+// SetID sets the "id" field.
+func (sssc *SecurityScanningSuppressionCreate) SetID(u uuid.UUID) *SecurityScanningSuppressionCreate {
+	sssc.mutation.SetID(u)
+	return sssc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (sssc *SecurityScanningSuppressionCreate) SetNillableID(u *uuid.UUID) *SecurityScanningSuppressionCreate {
+	if u != nil {
+		sssc.SetID(*u)
+	}
+	return sssc
+}
+
+// Mutation returns the SecurityScanningSuppressionMutation object of the builder.
+func (sssc *SecurityScanningSuppressionCreate) Mutation() *SecurityScanningSuppressionMutation {
+	return sssc.mutation
+}
+
+// Save creates the SecurityScanningSuppression in the database.
+func (sssc *SecurityScanningSuppressionCreate) Save(ctx context.Context) (*SecurityScanningSuppression, error) {
+	sssc.defaults()
+	return withHooks(ctx, sssc.sqlSave, sssc.mutation, sssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sssc *SecurityScanningSuppressionCreate) SaveX(ctx context.Context) *SecurityScanningSuppression {
+	v, err := sssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sssc *SecurityScanningSuppressionCreate) Exec(ctx context.Context) error {
+	_, err := sssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sssc *SecurityScanningSuppressionCreate) ExecX(ctx context.Context) {
+	if err := sssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sssc *SecurityScanningSuppressionCreate) defaults() {
+	if _, ok := sssc.mutation.CreatedAt(); !ok {
+		v := securityscanningsuppression.DefaultCreatedAt()
+		sssc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sssc.mutation.ID(); !ok {
+		v := securityscanningsuppression.DefaultID()
+		sssc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sssc *SecurityScanningSuppressionCreate) check() error {
+	if _, ok := sssc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`db: missing required field "SecurityScanningSuppression.status"`)}
+	}
+	if _, ok := sssc.mutation.CreatorID(); !ok {
+		return &ValidationError{Name: "creator_id", err: errors.New(`db: missing required field "SecurityScanningSuppression.creator_id"`)}
+	}
+	if _, ok := sssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "SecurityScanningSuppression.created_at"`)}
+	}
+	return nil
+}
+
+func (sssc *SecurityScanningSuppressionCreate) sqlSave(ctx context.Context) (*SecurityScanningSuppression, error) {
+	if err := sssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	sssc.mutation.id = &_node.ID
+	sssc.mutation.done = true
+	return _node, nil
+}
+
+func (sssc *SecurityScanningSuppressionCreate) createSpec() (*SecurityScanningSuppression, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SecurityScanningSuppression{config: sssc.config}
+		_spec = sqlgraph.NewCreateSpec(securityscanningsuppression.Table, sqlgraph.NewFieldSpec(securityscanningsuppression.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = sssc.conflict
+	if id, ok := sssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := sssc.mutation.WorkspaceID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldWorkspaceID, field.TypeUUID, value)
+		_node.WorkspaceID = &value
+	}
+	if value, ok := sssc.mutation.Fingerprint(); ok {
+		_spec.SetField(securityscanningsuppression.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := sssc.mutation.CheckID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCheckID, field.TypeString, value)
+		_node.CheckID = value
+	}
+	if value, ok := sssc.mutation.Status(); ok {
+		_spec.SetField(securityscanningsuppression.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := sssc.mutation.Reason(); ok {
+		_spec.SetField(securityscanningsuppression.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := sssc.mutation.ExpiresAt(); ok {
+		_spec.SetField(securityscanningsuppression.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := sssc.mutation.CreatorID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreatorID, field.TypeUUID, value)
+		_node.CreatorID = value
+	}
+	if value, ok := sssc.mutation.Creator(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreator, field.TypeString, value)
+		_node.Creator = value
+	}
+	if value, ok := sssc.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityScanningSuppression.Create().
+//		SetWorkspaceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityScanningSuppressionUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (sssc *SecurityScanningSuppressionCreate) OnConflict(opts ...sql.ConflictOption) *SecurityScanningSuppressionUpsertOne {
+	sssc.conflict = opts
+	return &SecurityScanningSuppressionUpsertOne{
+		create: sssc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityScanningSuppression.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (sssc *SecurityScanningSuppressionCreate) OnConflictColumns(columns ...string) *SecurityScanningSuppressionUpsertOne {
+	sssc.conflict = append(sssc.conflict, sql.ConflictColumns(columns...))
+	return &SecurityScanningSuppressionUpsertOne{
+		create: sssc,
+	}
+}
+
+type (
+	// SecurityScanningSuppressionUpsertOne is the builder for "upsert"-ing
+	//  one SecurityScanningSuppression node.
+	SecurityScanningSuppressionUpsertOne struct {
+		create *SecurityScanningSuppressionCreate
+	}
+
+	// SecurityScanningSuppressionUpsert is the "OnConflict" setter.
+	SecurityScanningSuppressionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *SecurityScanningSuppressionUpsert) SetWorkspaceID(v uuid.UUID) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldWorkspaceID, v)
+	return u
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateWorkspaceID() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldWorkspaceID)
+	return u
+}
+
+// ClearWorkspaceID clears the value of the "workspace_id" field.
+func (u *SecurityScanningSuppressionUpsert) ClearWorkspaceID() *SecurityScanningSuppressionUpsert {
+	u.SetNull(securityscanningsuppression.FieldWorkspaceID)
+	return u
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *SecurityScanningSuppressionUpsert) SetFingerprint(v string) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldFingerprint, v)
+	return u
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateFingerprint() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldFingerprint)
+	return u
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *SecurityScanningSuppressionUpsert) ClearFingerprint() *SecurityScanningSuppressionUpsert {
+	u.SetNull(securityscanningsuppression.FieldFingerprint)
+	return u
+}
+
+// SetCheckID sets the "check_id" field.
+func (u *SecurityScanningSuppressionUpsert) SetCheckID(v string) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldCheckID, v)
+	return u
+}
+
+// UpdateCheckID sets the "check_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateCheckID() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldCheckID)
+	return u
+}
+
+// ClearCheckID clears the value of the "check_id" field.
+func (u *SecurityScanningSuppressionUpsert) ClearCheckID() *SecurityScanningSuppressionUpsert {
+	u.SetNull(securityscanningsuppression.FieldCheckID)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *SecurityScanningSuppressionUpsert) SetStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateStatus() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldStatus)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *SecurityScanningSuppressionUpsert) SetReason(v string) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateReason() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldReason)
+	return u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *SecurityScanningSuppressionUpsert) ClearReason() *SecurityScanningSuppressionUpsert {
+	u.SetNull(securityscanningsuppression.FieldReason)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SecurityScanningSuppressionUpsert) SetExpiresAt(v time.Time) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateExpiresAt() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SecurityScanningSuppressionUpsert) ClearExpiresAt() *SecurityScanningSuppressionUpsert {
+	u.SetNull(securityscanningsuppression.FieldExpiresAt)
+	return u
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *SecurityScanningSuppressionUpsert) SetCreatorID(v uuid.UUID) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldCreatorID, v)
+	return u
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateCreatorID() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldCreatorID)
+	return u
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityScanningSuppressionUpsert) SetCreator(v string) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldCreator, v)
+	return u
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateCreator() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldCreator)
+	return u
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityScanningSuppressionUpsert) ClearCreator() *SecurityScanningSuppressionUpsert {
+	u.SetNull(securityscanningsuppression.FieldCreator)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningSuppressionUpsert) SetCreatedAt(v time.Time) *SecurityScanningSuppressionUpsert {
+	u.Set(securityscanningsuppression.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsert) UpdateCreatedAt() *SecurityScanningSuppressionUpsert {
+	u.SetExcluded(securityscanningsuppression.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningSuppression.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityscanningsuppression.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityScanningSuppressionUpsertOne) UpdateNewValues() *SecurityScanningSuppressionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(securityscanningsuppression.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningSuppression.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SecurityScanningSuppressionUpsertOne) Ignore() *SecurityScanningSuppressionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityScanningSuppressionUpsertOne) DoNothing() *SecurityScanningSuppressionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityScanningSuppressionCreate.OnConflict
+// documentation for more info.
+func (u *SecurityScanningSuppressionUpsertOne) Update(set func(*SecurityScanningSuppressionUpsert)) *SecurityScanningSuppressionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityScanningSuppressionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetWorkspaceID(v uuid.UUID) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateWorkspaceID() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// ClearWorkspaceID clears the value of the "workspace_id" field.
+func (u *SecurityScanningSuppressionUpsertOne) ClearWorkspaceID() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearWorkspaceID()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetFingerprint(v string) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateFingerprint() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *SecurityScanningSuppressionUpsertOne) ClearFingerprint() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearFingerprint()
+	})
+}
+
+// SetCheckID sets the "check_id" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetCheckID(v string) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCheckID(v)
+	})
+}
+
+// UpdateCheckID sets the "check_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateCheckID() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCheckID()
+	})
+}
+
+// ClearCheckID clears the value of the "check_id" field.
+func (u *SecurityScanningSuppressionUpsertOne) ClearCheckID() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearCheckID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateStatus() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetReason(v string) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateReason() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *SecurityScanningSuppressionUpsertOne) ClearReason() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetExpiresAt(v time.Time) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateExpiresAt() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SecurityScanningSuppressionUpsertOne) ClearExpiresAt() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetCreatorID(v uuid.UUID) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateCreatorID() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetCreator(v string) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateCreator() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityScanningSuppressionUpsertOne) ClearCreator() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningSuppressionUpsertOne) SetCreatedAt(v time.Time) *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertOne) UpdateCreatedAt() *SecurityScanningSuppressionUpsertOne {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityScanningSuppressionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityScanningSuppressionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityScanningSuppressionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SecurityScanningSuppressionUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: SecurityScanningSuppressionUpsertOne.ID is not supported by MySQL driver. Use SecurityScanningSuppressionUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SecurityScanningSuppressionUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SecurityScanningSuppressionCreateBulk is the builder for creating many SecurityScanningSuppression entities in bulk.
+type SecurityScanningSuppressionCreateBulk struct {
+	config
+	err      error
+	builders []*SecurityScanningSuppressionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SecurityScanningSuppression entities in the database.
+func (ssscb *SecurityScanningSuppressionCreateBulk) Save(ctx context.Context) ([]*SecurityScanningSuppression, error) {
+	if ssscb.err != nil {
+		return nil, ssscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ssscb.builders))
+	nodes := make([]*SecurityScanningSuppression, len(ssscb.builders))
+	mutators := make([]Mutator, len(ssscb.builders))
+	for i := range ssscb.builders {
+		func(i int, root context.Context) {
+			builder := ssscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SecurityScanningSuppressionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ssscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = ssscb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ssscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ssscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssscb *SecurityScanningSuppressionCreateBulk) SaveX(ctx context.Context) []*SecurityScanningSuppression {
+	v, err := ssscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssscb *SecurityScanningSuppressionCreateBulk) Exec(ctx context.Context) error {
+	_, err := ssscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssscb *SecurityScanningSuppressionCreateBulk) ExecX(ctx context.Context) {
+	if err := ssscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SecurityScanningSuppression.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SecurityScanningSuppressionUpsert) {
+//			SetWorkspaceID(v+v).
+//		}).
+//		Exec(ctx)
+func (ssscb *SecurityScanningSuppressionCreateBulk) OnConflict(opts ...sql.ConflictOption) *SecurityScanningSuppressionUpsertBulk {
+	ssscb.conflict = opts
+	return &SecurityScanningSuppressionUpsertBulk{
+		create: ssscb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SecurityScanningSuppression.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (ssscb *SecurityScanningSuppressionCreateBulk) OnConflictColumns(columns ...string) *SecurityScanningSuppressionUpsertBulk {
+	ssscb.conflict = append(ssscb.conflict, sql.ConflictColumns(columns...))
+	return &SecurityScanningSuppressionUpsertBulk{
+		create: ssscb,
+	}
+}
+
+// SecurityScanningSuppressionUpsertBulk is the builder for "upsert"-ing
+// a bulk of SecurityScanningSuppression nodes.
+type SecurityScanningSuppressionUpsertBulk struct {
+	create *SecurityScanningSuppressionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SecurityScanningSuppression.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(securityscanningsuppression.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateNewValues() *SecurityScanningSuppressionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(securityscanningsuppression.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SecurityScanningSuppression.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SecurityScanningSuppressionUpsertBulk) Ignore() *SecurityScanningSuppressionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SecurityScanningSuppressionUpsertBulk) DoNothing() *SecurityScanningSuppressionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SecurityScanningSuppressionCreateBulk.OnConflict
+// documentation for more info.
+func (u *SecurityScanningSuppressionUpsertBulk) Update(set func(*SecurityScanningSuppressionUpsert)) *SecurityScanningSuppressionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SecurityScanningSuppressionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetWorkspaceID(v uuid.UUID) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetWorkspaceID(v)
+	})
+}
+
+// UpdateWorkspaceID sets the "workspace_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateWorkspaceID() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateWorkspaceID()
+	})
+}
+
+// ClearWorkspaceID clears the value of the "workspace_id" field.
+func (u *SecurityScanningSuppressionUpsertBulk) ClearWorkspaceID() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearWorkspaceID()
+	})
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetFingerprint(v string) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetFingerprint(v)
+	})
+}
+
+// UpdateFingerprint sets the "fingerprint" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateFingerprint() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateFingerprint()
+	})
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (u *SecurityScanningSuppressionUpsertBulk) ClearFingerprint() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearFingerprint()
+	})
+}
+
+// SetCheckID sets the "check_id" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetCheckID(v string) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCheckID(v)
+	})
+}
+
+// UpdateCheckID sets the "check_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateCheckID() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCheckID()
+	})
+}
+
+// ClearCheckID clears the value of the "check_id" field.
+func (u *SecurityScanningSuppressionUpsertBulk) ClearCheckID() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearCheckID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetStatus(v consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateStatus() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetReason(v string) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateReason() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// ClearReason clears the value of the "reason" field.
+func (u *SecurityScanningSuppressionUpsertBulk) ClearReason() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearReason()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetExpiresAt(v time.Time) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateExpiresAt() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *SecurityScanningSuppressionUpsertBulk) ClearExpiresAt() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetCreatorID(v uuid.UUID) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCreatorID(v)
+	})
+}
+
+// UpdateCreatorID sets the "creator_id" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateCreatorID() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCreatorID()
+	})
+}
+
+// SetCreator sets the "creator" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetCreator(v string) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCreator(v)
+	})
+}
+
+// UpdateCreator sets the "creator" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateCreator() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCreator()
+	})
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (u *SecurityScanningSuppressionUpsertBulk) ClearCreator() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.ClearCreator()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *SecurityScanningSuppressionUpsertBulk) SetCreatedAt(v time.Time) *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *SecurityScanningSuppressionUpsertBulk) UpdateCreatedAt() *SecurityScanningSuppressionUpsertBulk {
+	return u.Update(func(s *SecurityScanningSuppressionUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SecurityScanningSuppressionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the SecurityScanningSuppressionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for SecurityScanningSuppressionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SecurityScanningSuppressionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
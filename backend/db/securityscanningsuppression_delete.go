@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
+)
+
+// SecurityScanningSuppressionDelete is the builder for deleting a SecurityScanningSuppression entity.
+type SecurityScanningSuppressionDelete struct {
+	config
+	hooks    []Hook
+	mutation *SecurityScanningSuppressionMutation
+}
+
+// Where appends a list predicates to the SecurityScanningSuppressionDelete builder.
+func (sssd *SecurityScanningSuppressionDelete) Where(ps ...predicate.SecurityScanningSuppression) *SecurityScanningSuppressionDelete {
+	sssd.mutation.Where(ps...)
+	return sssd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (sssd *SecurityScanningSuppressionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, sssd.sqlExec, sssd.mutation, sssd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sssd *SecurityScanningSuppressionDelete) ExecX(ctx context.Context) int {
+	n, err := sssd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (sssd *SecurityScanningSuppressionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(securityscanningsuppression.Table, sqlgraph.NewFieldSpec(securityscanningsuppression.FieldID, field.TypeUUID))
+	if ps := sssd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, sssd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	sssd.mutation.done = true
+	return affected, err
+}
+
+// SecurityScanningSuppressionDeleteOne is the builder for deleting a single SecurityScanningSuppression entity.
+type SecurityScanningSuppressionDeleteOne struct {
+	sssd *SecurityScanningSuppressionDelete
+}
+
+// Where appends a list predicates to the SecurityScanningSuppressionDelete builder.
+func (sssdo *SecurityScanningSuppressionDeleteOne) Where(ps ...predicate.SecurityScanningSuppression) *SecurityScanningSuppressionDeleteOne {
+	sssdo.sssd.mutation.Where(ps...)
+	return sssdo
+}
+
+// Exec executes the deletion query.
+func (sssdo *SecurityScanningSuppressionDeleteOne) Exec(ctx context.Context) error {
+	n, err := sssdo.sssd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{securityscanningsuppression.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sssdo *SecurityScanningSuppressionDeleteOne) ExecX(ctx context.Context) {
+	if err := sssdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningSuppressionQuery is the builder for querying SecurityScanningSuppression entities.
+type SecurityScanningSuppressionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []securityscanningsuppression.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SecurityScanningSuppression
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SecurityScanningSuppressionQuery builder.
+func (sssq *SecurityScanningSuppressionQuery) Where(ps ...predicate.SecurityScanningSuppression) *SecurityScanningSuppressionQuery {
+	sssq.predicates = append(sssq.predicates, ps...)
+	return sssq
+}
+
+// Limit the number of records to be returned by this query.
+func (sssq *SecurityScanningSuppressionQuery) Limit(limit int) *SecurityScanningSuppressionQuery {
+	sssq.ctx.Limit = &limit
+	return sssq
+}
+
+// Offset to start from.
+func (sssq *SecurityScanningSuppressionQuery) Offset(offset int) *SecurityScanningSuppressionQuery {
+	sssq.ctx.Offset = &offset
+	return sssq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (sssq *SecurityScanningSuppressionQuery) Unique(unique bool) *SecurityScanningSuppressionQuery {
+	sssq.ctx.Unique = &unique
+	return sssq
+}
+
+// Order specifies how the records should be ordered.
+func (sssq *SecurityScanningSuppressionQuery) Order(o ...securityscanningsuppression.OrderOption) *SecurityScanningSuppressionQuery {
+	sssq.order = append(sssq.order, o...)
+	return sssq
+}
+
+// First returns the first SecurityScanningSuppression entity from the query.
+// Returns a *NotFoundError when no SecurityScanningSuppression was found.
+func (sssq *SecurityScanningSuppressionQuery) First(ctx context.Context) (*SecurityScanningSuppression, error) {
+	nodes, err := sssq.Limit(1).All(setContextOp(ctx, sssq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{securityscanningsuppression.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) FirstX(ctx context.Context) *SecurityScanningSuppression {
+	node, err := sssq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SecurityScanningSuppression ID from the query.
+// Returns a *NotFoundError when no SecurityScanningSuppression ID was found.
+func (sssq *SecurityScanningSuppressionQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sssq.Limit(1).IDs(setContextOp(ctx, sssq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{securityscanningsuppression.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := sssq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SecurityScanningSuppression entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SecurityScanningSuppression entity is found.
+// Returns a *NotFoundError when no SecurityScanningSuppression entities are found.
+func (sssq *SecurityScanningSuppressionQuery) Only(ctx context.Context) (*SecurityScanningSuppression, error) {
+	nodes, err := sssq.Limit(2).All(setContextOp(ctx, sssq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{securityscanningsuppression.Label}
+	default:
+		return nil, &NotSingularError{securityscanningsuppression.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) OnlyX(ctx context.Context) *SecurityScanningSuppression {
+	node, err := sssq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SecurityScanningSuppression ID in the query.
+// Returns a *NotSingularError when more than one SecurityScanningSuppression ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (sssq *SecurityScanningSuppressionQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = sssq.Limit(2).IDs(setContextOp(ctx, sssq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{securityscanningsuppression.Label}
+	default:
+		err = &NotSingularError{securityscanningsuppression.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := sssq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SecurityScanningSuppressions.
+func (sssq *SecurityScanningSuppressionQuery) All(ctx context.Context) ([]*SecurityScanningSuppression, error) {
+	ctx = setContextOp(ctx, sssq.ctx, ent.OpQueryAll)
+	if err := sssq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SecurityScanningSuppression, *SecurityScanningSuppressionQuery]()
+	return withInterceptors[[]*SecurityScanningSuppression](ctx, sssq, qr, sssq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) AllX(ctx context.Context) []*SecurityScanningSuppression {
+	nodes, err := sssq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SecurityScanningSuppression IDs.
+func (sssq *SecurityScanningSuppressionQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if sssq.ctx.Unique == nil && sssq.path != nil {
+		sssq.Unique(true)
+	}
+	ctx = setContextOp(ctx, sssq.ctx, ent.OpQueryIDs)
+	if err = sssq.Select(securityscanningsuppression.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := sssq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (sssq *SecurityScanningSuppressionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, sssq.ctx, ent.OpQueryCount)
+	if err := sssq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, sssq, querierCount[*SecurityScanningSuppressionQuery](), sssq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) CountX(ctx context.Context) int {
+	count, err := sssq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (sssq *SecurityScanningSuppressionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, sssq.ctx, ent.OpQueryExist)
+	switch _, err := sssq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (sssq *SecurityScanningSuppressionQuery) ExistX(ctx context.Context) bool {
+	exist, err := sssq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SecurityScanningSuppressionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (sssq *SecurityScanningSuppressionQuery) Clone() *SecurityScanningSuppressionQuery {
+	if sssq == nil {
+		return nil
+	}
+	return &SecurityScanningSuppressionQuery{
+		config:     sssq.config,
+		ctx:        sssq.ctx.Clone(),
+		order:      append([]securityscanningsuppression.OrderOption{}, sssq.order...),
+		inters:     append([]Interceptor{}, sssq.inters...),
+		predicates: append([]predicate.SecurityScanningSuppression{}, sssq.predicates...),
+		// clone intermediate query.
+		sql:       sssq.sql.Clone(),
+		path:      sssq.path,
+		modifiers: append([]func(*sql.Selector){}, sssq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SecurityScanningSuppression.Query().
+//		GroupBy(securityscanningsuppression.FieldWorkspaceID).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (sssq *SecurityScanningSuppressionQuery) GroupBy(field string, fields ...string) *SecurityScanningSuppressionGroupBy {
+	sssq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SecurityScanningSuppressionGroupBy{build: sssq}
+	grbuild.flds = &sssq.ctx.Fields
+	grbuild.label = securityscanningsuppression.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		WorkspaceID uuid.UUID `json:"workspace_id,omitempty"`
+//	}
+//
+//	client.SecurityScanningSuppression.Query().
+//		Select(securityscanningsuppression.FieldWorkspaceID).
+//		Scan(ctx, &v)
+func (sssq *SecurityScanningSuppressionQuery) Select(fields ...string) *SecurityScanningSuppressionSelect {
+	sssq.ctx.Fields = append(sssq.ctx.Fields, fields...)
+	sbuild := &SecurityScanningSuppressionSelect{SecurityScanningSuppressionQuery: sssq}
+	sbuild.label = securityscanningsuppression.Label
+	sbuild.flds, sbuild.scan = &sssq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SecurityScanningSuppressionSelect configured with the given aggregations.
+func (sssq *SecurityScanningSuppressionQuery) Aggregate(fns ...AggregateFunc) *SecurityScanningSuppressionSelect {
+	return sssq.Select().Aggregate(fns...)
+}
+
+func (sssq *SecurityScanningSuppressionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range sssq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, sssq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range sssq.ctx.Fields {
+		if !securityscanningsuppression.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if sssq.path != nil {
+		prev, err := sssq.path(ctx)
+		if err != nil {
+			return err
+		}
+		sssq.sql = prev
+	}
+	return nil
+}
+
+func (sssq *SecurityScanningSuppressionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SecurityScanningSuppression, error) {
+	var (
+		nodes = []*SecurityScanningSuppression{}
+		_spec = sssq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SecurityScanningSuppression).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SecurityScanningSuppression{config: sssq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(sssq.modifiers) > 0 {
+		_spec.Modifiers = sssq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, sssq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (sssq *SecurityScanningSuppressionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := sssq.querySpec()
+	if len(sssq.modifiers) > 0 {
+		_spec.Modifiers = sssq.modifiers
+	}
+	_spec.Node.Columns = sssq.ctx.Fields
+	if len(sssq.ctx.Fields) > 0 {
+		_spec.Unique = sssq.ctx.Unique != nil && *sssq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, sssq.driver, _spec)
+}
+
+func (sssq *SecurityScanningSuppressionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(securityscanningsuppression.Table, securityscanningsuppression.Columns, sqlgraph.NewFieldSpec(securityscanningsuppression.FieldID, field.TypeUUID))
+	_spec.From = sssq.sql
+	if unique := sssq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if sssq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := sssq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityscanningsuppression.FieldID)
+		for i := range fields {
+			if fields[i] != securityscanningsuppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := sssq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := sssq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := sssq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := sssq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (sssq *SecurityScanningSuppressionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(sssq.driver.Dialect())
+	t1 := builder.Table(securityscanningsuppression.Table)
+	columns := sssq.ctx.Fields
+	if len(columns) == 0 {
+		columns = securityscanningsuppression.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if sssq.sql != nil {
+		selector = sssq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if sssq.ctx.Unique != nil && *sssq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range sssq.modifiers {
+		m(selector)
+	}
+	for _, p := range sssq.predicates {
+		p(selector)
+	}
+	for _, p := range sssq.order {
+		p(selector)
+	}
+	if offset := sssq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := sssq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (sssq *SecurityScanningSuppressionQuery) ForUpdate(opts ...sql.LockOption) *SecurityScanningSuppressionQuery {
+	if sssq.driver.Dialect() == dialect.Postgres {
+		sssq.Unique(false)
+	}
+	sssq.modifiers = append(sssq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return sssq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (sssq *SecurityScanningSuppressionQuery) ForShare(opts ...sql.LockOption) *SecurityScanningSuppressionQuery {
+	if sssq.driver.Dialect() == dialect.Postgres {
+		sssq.Unique(false)
+	}
+	sssq.modifiers = append(sssq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return sssq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (sssq *SecurityScanningSuppressionQuery) Modify(modifiers ...func(s *sql.Selector)) *SecurityScanningSuppressionSelect {
+	sssq.modifiers = append(sssq.modifiers, modifiers...)
+	return sssq.Select()
+}
+
+// SecurityScanningSuppressionGroupBy is the group-by builder for SecurityScanningSuppression entities.
+type SecurityScanningSuppressionGroupBy struct {
+	selector
+	build *SecurityScanningSuppressionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (sssgb *SecurityScanningSuppressionGroupBy) Aggregate(fns ...AggregateFunc) *SecurityScanningSuppressionGroupBy {
+	sssgb.fns = append(sssgb.fns, fns...)
+	return sssgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (sssgb *SecurityScanningSuppressionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, sssgb.build.ctx, ent.OpQueryGroupBy)
+	if err := sssgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityScanningSuppressionQuery, *SecurityScanningSuppressionGroupBy](ctx, sssgb.build, sssgb, sssgb.build.inters, v)
+}
+
+func (sssgb *SecurityScanningSuppressionGroupBy) sqlScan(ctx context.Context, root *SecurityScanningSuppressionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(sssgb.fns))
+	for _, fn := range sssgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*sssgb.flds)+len(sssgb.fns))
+		for _, f := range *sssgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*sssgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := sssgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SecurityScanningSuppressionSelect is the builder for selecting fields of SecurityScanningSuppression entities.
+type SecurityScanningSuppressionSelect struct {
+	*SecurityScanningSuppressionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ssss *SecurityScanningSuppressionSelect) Aggregate(fns ...AggregateFunc) *SecurityScanningSuppressionSelect {
+	ssss.fns = append(ssss.fns, fns...)
+	return ssss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ssss *SecurityScanningSuppressionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ssss.ctx, ent.OpQuerySelect)
+	if err := ssss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SecurityScanningSuppressionQuery, *SecurityScanningSuppressionSelect](ctx, ssss.SecurityScanningSuppressionQuery, ssss, ssss.inters, v)
+}
+
+func (ssss *SecurityScanningSuppressionSelect) sqlScan(ctx context.Context, root *SecurityScanningSuppressionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ssss.fns))
+	for _, fn := range ssss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ssss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ssss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (ssss *SecurityScanningSuppressionSelect) Modify(modifiers ...func(s *sql.Selector)) *SecurityScanningSuppressionSelect {
+	ssss.modifiers = append(ssss.modifiers, modifiers...)
+	return ssss
+}
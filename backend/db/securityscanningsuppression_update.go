@@ -0,0 +1,608 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
+	"github.com/google/uuid"
+)
+
+// SecurityScanningSuppressionUpdate is the builder for updating SecurityScanningSuppression entities.
+type SecurityScanningSuppressionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *SecurityScanningSuppressionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the SecurityScanningSuppressionUpdate builder.
+func (sssu *SecurityScanningSuppressionUpdate) Where(ps ...predicate.SecurityScanningSuppression) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.Where(ps...)
+	return sssu
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetWorkspaceID(u uuid.UUID) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetWorkspaceID(u)
+	return sssu
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableWorkspaceID(u *uuid.UUID) *SecurityScanningSuppressionUpdate {
+	if u != nil {
+		sssu.SetWorkspaceID(*u)
+	}
+	return sssu
+}
+
+// ClearWorkspaceID clears the value of the "workspace_id" field.
+func (sssu *SecurityScanningSuppressionUpdate) ClearWorkspaceID() *SecurityScanningSuppressionUpdate {
+	sssu.mutation.ClearWorkspaceID()
+	return sssu
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetFingerprint(s string) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetFingerprint(s)
+	return sssu
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableFingerprint(s *string) *SecurityScanningSuppressionUpdate {
+	if s != nil {
+		sssu.SetFingerprint(*s)
+	}
+	return sssu
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (sssu *SecurityScanningSuppressionUpdate) ClearFingerprint() *SecurityScanningSuppressionUpdate {
+	sssu.mutation.ClearFingerprint()
+	return sssu
+}
+
+// SetCheckID sets the "check_id" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetCheckID(s string) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetCheckID(s)
+	return sssu
+}
+
+// SetNillableCheckID sets the "check_id" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableCheckID(s *string) *SecurityScanningSuppressionUpdate {
+	if s != nil {
+		sssu.SetCheckID(*s)
+	}
+	return sssu
+}
+
+// ClearCheckID clears the value of the "check_id" field.
+func (sssu *SecurityScanningSuppressionUpdate) ClearCheckID() *SecurityScanningSuppressionUpdate {
+	sssu.mutation.ClearCheckID()
+	return sssu
+}
+
+// SetStatus sets the "status" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetStatus(csts)
+	return sssu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpdate {
+	if csts != nil {
+		sssu.SetStatus(*csts)
+	}
+	return sssu
+}
+
+// SetReason sets the "reason" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetReason(s string) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetReason(s)
+	return sssu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableReason(s *string) *SecurityScanningSuppressionUpdate {
+	if s != nil {
+		sssu.SetReason(*s)
+	}
+	return sssu
+}
+
+// ClearReason clears the value of the "reason" field.
+func (sssu *SecurityScanningSuppressionUpdate) ClearReason() *SecurityScanningSuppressionUpdate {
+	sssu.mutation.ClearReason()
+	return sssu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetExpiresAt(t time.Time) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetExpiresAt(t)
+	return sssu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableExpiresAt(t *time.Time) *SecurityScanningSuppressionUpdate {
+	if t != nil {
+		sssu.SetExpiresAt(*t)
+	}
+	return sssu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sssu *SecurityScanningSuppressionUpdate) ClearExpiresAt() *SecurityScanningSuppressionUpdate {
+	sssu.mutation.ClearExpiresAt()
+	return sssu
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetCreatorID(u uuid.UUID) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetCreatorID(u)
+	return sssu
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableCreatorID(u *uuid.UUID) *SecurityScanningSuppressionUpdate {
+	if u != nil {
+		sssu.SetCreatorID(*u)
+	}
+	return sssu
+}
+
+// SetCreator sets the "creator" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetCreator(s string) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetCreator(s)
+	return sssu
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableCreator(s *string) *SecurityScanningSuppressionUpdate {
+	if s != nil {
+		sssu.SetCreator(*s)
+	}
+	return sssu
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (sssu *SecurityScanningSuppressionUpdate) ClearCreator() *SecurityScanningSuppressionUpdate {
+	sssu.mutation.ClearCreator()
+	return sssu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sssu *SecurityScanningSuppressionUpdate) SetCreatedAt(t time.Time) *SecurityScanningSuppressionUpdate {
+	sssu.mutation.SetCreatedAt(t)
+	return sssu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sssu *SecurityScanningSuppressionUpdate) SetNillableCreatedAt(t *time.Time) *SecurityScanningSuppressionUpdate {
+	if t != nil {
+		sssu.SetCreatedAt(*t)
+	}
+	return sssu
+}
+
+// Mutation returns the SecurityScanningSuppressionMutation object of the builder.
+func (sssu *SecurityScanningSuppressionUpdate) Mutation() *SecurityScanningSuppressionMutation {
+	return sssu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sssu *SecurityScanningSuppressionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sssu.sqlSave, sssu.mutation, sssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sssu *SecurityScanningSuppressionUpdate) SaveX(ctx context.Context) int {
+	affected, err := sssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sssu *SecurityScanningSuppressionUpdate) Exec(ctx context.Context) error {
+	_, err := sssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sssu *SecurityScanningSuppressionUpdate) ExecX(ctx context.Context) {
+	if err := sssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sssu *SecurityScanningSuppressionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityScanningSuppressionUpdate {
+	sssu.modifiers = append(sssu.modifiers, modifiers...)
+	return sssu
+}
+
+func (sssu *SecurityScanningSuppressionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(securityscanningsuppression.Table, securityscanningsuppression.Columns, sqlgraph.NewFieldSpec(securityscanningsuppression.FieldID, field.TypeUUID))
+	if ps := sssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sssu.mutation.WorkspaceID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if sssu.mutation.WorkspaceIDCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldWorkspaceID, field.TypeUUID)
+	}
+	if value, ok := sssu.mutation.Fingerprint(); ok {
+		_spec.SetField(securityscanningsuppression.FieldFingerprint, field.TypeString, value)
+	}
+	if sssu.mutation.FingerprintCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := sssu.mutation.CheckID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCheckID, field.TypeString, value)
+	}
+	if sssu.mutation.CheckIDCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldCheckID, field.TypeString)
+	}
+	if value, ok := sssu.mutation.Status(); ok {
+		_spec.SetField(securityscanningsuppression.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sssu.mutation.Reason(); ok {
+		_spec.SetField(securityscanningsuppression.FieldReason, field.TypeString, value)
+	}
+	if sssu.mutation.ReasonCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldReason, field.TypeString)
+	}
+	if value, ok := sssu.mutation.ExpiresAt(); ok {
+		_spec.SetField(securityscanningsuppression.FieldExpiresAt, field.TypeTime, value)
+	}
+	if sssu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := sssu.mutation.CreatorID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreatorID, field.TypeUUID, value)
+	}
+	if value, ok := sssu.mutation.Creator(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreator, field.TypeString, value)
+	}
+	if sssu.mutation.CreatorCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldCreator, field.TypeString)
+	}
+	if value, ok := sssu.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(sssu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, sssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityscanningsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sssu.mutation.done = true
+	return n, nil
+}
+
+// SecurityScanningSuppressionUpdateOne is the builder for updating a single SecurityScanningSuppression entity.
+type SecurityScanningSuppressionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *SecurityScanningSuppressionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetWorkspaceID sets the "workspace_id" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetWorkspaceID(u uuid.UUID) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetWorkspaceID(u)
+	return sssuo
+}
+
+// SetNillableWorkspaceID sets the "workspace_id" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableWorkspaceID(u *uuid.UUID) *SecurityScanningSuppressionUpdateOne {
+	if u != nil {
+		sssuo.SetWorkspaceID(*u)
+	}
+	return sssuo
+}
+
+// ClearWorkspaceID clears the value of the "workspace_id" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ClearWorkspaceID() *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.ClearWorkspaceID()
+	return sssuo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetFingerprint(s string) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetFingerprint(s)
+	return sssuo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableFingerprint(s *string) *SecurityScanningSuppressionUpdateOne {
+	if s != nil {
+		sssuo.SetFingerprint(*s)
+	}
+	return sssuo
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ClearFingerprint() *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.ClearFingerprint()
+	return sssuo
+}
+
+// SetCheckID sets the "check_id" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetCheckID(s string) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetCheckID(s)
+	return sssuo
+}
+
+// SetNillableCheckID sets the "check_id" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableCheckID(s *string) *SecurityScanningSuppressionUpdateOne {
+	if s != nil {
+		sssuo.SetCheckID(*s)
+	}
+	return sssuo
+}
+
+// ClearCheckID clears the value of the "check_id" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ClearCheckID() *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.ClearCheckID()
+	return sssuo
+}
+
+// SetStatus sets the "status" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetStatus(csts consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetStatus(csts)
+	return sssuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableStatus(csts *consts.SecurityScanningTriageStatus) *SecurityScanningSuppressionUpdateOne {
+	if csts != nil {
+		sssuo.SetStatus(*csts)
+	}
+	return sssuo
+}
+
+// SetReason sets the "reason" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetReason(s string) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetReason(s)
+	return sssuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableReason(s *string) *SecurityScanningSuppressionUpdateOne {
+	if s != nil {
+		sssuo.SetReason(*s)
+	}
+	return sssuo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ClearReason() *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.ClearReason()
+	return sssuo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetExpiresAt(t time.Time) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetExpiresAt(t)
+	return sssuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableExpiresAt(t *time.Time) *SecurityScanningSuppressionUpdateOne {
+	if t != nil {
+		sssuo.SetExpiresAt(*t)
+	}
+	return sssuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ClearExpiresAt() *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.ClearExpiresAt()
+	return sssuo
+}
+
+// SetCreatorID sets the "creator_id" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetCreatorID(u uuid.UUID) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetCreatorID(u)
+	return sssuo
+}
+
+// SetNillableCreatorID sets the "creator_id" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableCreatorID(u *uuid.UUID) *SecurityScanningSuppressionUpdateOne {
+	if u != nil {
+		sssuo.SetCreatorID(*u)
+	}
+	return sssuo
+}
+
+// SetCreator sets the "creator" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetCreator(s string) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetCreator(s)
+	return sssuo
+}
+
+// SetNillableCreator sets the "creator" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableCreator(s *string) *SecurityScanningSuppressionUpdateOne {
+	if s != nil {
+		sssuo.SetCreator(*s)
+	}
+	return sssuo
+}
+
+// ClearCreator clears the value of the "creator" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ClearCreator() *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.ClearCreator()
+	return sssuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetCreatedAt(t time.Time) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.SetCreatedAt(t)
+	return sssuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SetNillableCreatedAt(t *time.Time) *SecurityScanningSuppressionUpdateOne {
+	if t != nil {
+		sssuo.SetCreatedAt(*t)
+	}
+	return sssuo
+}
+
+// Mutation returns the SecurityScanningSuppressionMutation object of the builder.
+func (sssuo *SecurityScanningSuppressionUpdateOne) Mutation() *SecurityScanningSuppressionMutation {
+	return sssuo.mutation
+}
+
+// Where appends a list predicates to the SecurityScanningSuppressionUpdate builder.
+func (sssuo *SecurityScanningSuppressionUpdateOne) Where(ps ...predicate.SecurityScanningSuppression) *SecurityScanningSuppressionUpdateOne {
+	sssuo.mutation.Where(ps...)
+	return sssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sssuo *SecurityScanningSuppressionUpdateOne) Select(field string, fields ...string) *SecurityScanningSuppressionUpdateOne {
+	sssuo.fields = append([]string{field}, fields...)
+	return sssuo
+}
+
+// Save executes the query and returns the updated SecurityScanningSuppression entity.
+func (sssuo *SecurityScanningSuppressionUpdateOne) Save(ctx context.Context) (*SecurityScanningSuppression, error) {
+	return withHooks(ctx, sssuo.sqlSave, sssuo.mutation, sssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sssuo *SecurityScanningSuppressionUpdateOne) SaveX(ctx context.Context) *SecurityScanningSuppression {
+	node, err := sssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sssuo *SecurityScanningSuppressionUpdateOne) Exec(ctx context.Context) error {
+	_, err := sssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sssuo *SecurityScanningSuppressionUpdateOne) ExecX(ctx context.Context) {
+	if err := sssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (sssuo *SecurityScanningSuppressionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *SecurityScanningSuppressionUpdateOne {
+	sssuo.modifiers = append(sssuo.modifiers, modifiers...)
+	return sssuo
+}
+
+func (sssuo *SecurityScanningSuppressionUpdateOne) sqlSave(ctx context.Context) (_node *SecurityScanningSuppression, err error) {
+	_spec := sqlgraph.NewUpdateSpec(securityscanningsuppression.Table, securityscanningsuppression.Columns, sqlgraph.NewFieldSpec(securityscanningsuppression.FieldID, field.TypeUUID))
+	id, ok := sssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "SecurityScanningSuppression.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, securityscanningsuppression.FieldID)
+		for _, f := range fields {
+			if !securityscanningsuppression.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != securityscanningsuppression.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sssuo.mutation.WorkspaceID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldWorkspaceID, field.TypeUUID, value)
+	}
+	if sssuo.mutation.WorkspaceIDCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldWorkspaceID, field.TypeUUID)
+	}
+	if value, ok := sssuo.mutation.Fingerprint(); ok {
+		_spec.SetField(securityscanningsuppression.FieldFingerprint, field.TypeString, value)
+	}
+	if sssuo.mutation.FingerprintCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldFingerprint, field.TypeString)
+	}
+	if value, ok := sssuo.mutation.CheckID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCheckID, field.TypeString, value)
+	}
+	if sssuo.mutation.CheckIDCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldCheckID, field.TypeString)
+	}
+	if value, ok := sssuo.mutation.Status(); ok {
+		_spec.SetField(securityscanningsuppression.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := sssuo.mutation.Reason(); ok {
+		_spec.SetField(securityscanningsuppression.FieldReason, field.TypeString, value)
+	}
+	if sssuo.mutation.ReasonCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldReason, field.TypeString)
+	}
+	if value, ok := sssuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(securityscanningsuppression.FieldExpiresAt, field.TypeTime, value)
+	}
+	if sssuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := sssuo.mutation.CreatorID(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreatorID, field.TypeUUID, value)
+	}
+	if value, ok := sssuo.mutation.Creator(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreator, field.TypeString, value)
+	}
+	if sssuo.mutation.CreatorCleared() {
+		_spec.ClearField(securityscanningsuppression.FieldCreator, field.TypeString)
+	}
+	if value, ok := sssuo.mutation.CreatedAt(); ok {
+		_spec.SetField(securityscanningsuppression.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(sssuo.modifiers...)
+	_node = &SecurityScanningSuppression{config: sssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{securityscanningsuppression.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sssuo.mutation.done = true
+	return _node, nil
+}
@@ -34,6 +34,8 @@ type Setting struct {
 	CustomOauth *types.CustomOAuth `json:"custom_oauth,omitempty"`
 	// BaseURL holds the value of the "base_url" field.
 	BaseURL string `json:"base_url,omitempty"`
+	// RateLimit holds the value of the "rate_limit" field.
+	RateLimit *types.RateLimitSetting `json:"rate_limit,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -46,7 +48,7 @@ func (*Setting) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case setting.FieldDingtalkOauth, setting.FieldCustomOauth:
+		case setting.FieldDingtalkOauth, setting.FieldCustomOauth, setting.FieldRateLimit:
 			values[i] = new([]byte)
 		case setting.FieldEnableSSO, setting.FieldForceTwoFactorAuth, setting.FieldDisablePasswordLogin, setting.FieldEnableAutoLogin:
 			values[i] = new(sql.NullBool)
@@ -123,6 +125,14 @@ func (s *Setting) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.BaseURL = value.String
 			}
+		case setting.FieldRateLimit:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &s.RateLimit); err != nil {
+					return fmt.Errorf("unmarshal field rate_limit: %w", err)
+				}
+			}
 		case setting.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -192,6 +202,9 @@ func (s *Setting) String() string {
 	builder.WriteString("base_url=")
 	builder.WriteString(s.BaseURL)
 	builder.WriteString(", ")
+	builder.WriteString("rate_limit=")
+	builder.WriteString(fmt.Sprintf("%v", s.RateLimit))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
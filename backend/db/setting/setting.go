@@ -27,6 +27,8 @@ const (
 	FieldCustomOauth = "custom_oauth"
 	// FieldBaseURL holds the string denoting the base_url field in the database.
 	FieldBaseURL = "base_url"
+	// FieldRateLimit holds the string denoting the rate_limit field in the database.
+	FieldRateLimit = "rate_limit"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -45,6 +47,7 @@ var Columns = []string{
 	FieldDingtalkOauth,
 	FieldCustomOauth,
 	FieldBaseURL,
+	FieldRateLimit,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -225,6 +225,16 @@ func BaseURLContainsFold(v string) predicate.Setting {
 	return predicate.Setting(sql.FieldContainsFold(FieldBaseURL, v))
 }
 
+// RateLimitIsNil applies the IsNil predicate on the "rate_limit" field.
+func RateLimitIsNil() predicate.Setting {
+	return predicate.Setting(sql.FieldIsNull(FieldRateLimit))
+}
+
+// RateLimitNotNil applies the NotNil predicate on the "rate_limit" field.
+func RateLimitNotNil() predicate.Setting {
+	return predicate.Setting(sql.FieldNotNull(FieldRateLimit))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Setting {
 	return predicate.Setting(sql.FieldEQ(FieldCreatedAt, v))
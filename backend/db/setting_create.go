@@ -107,6 +107,12 @@ func (sc *SettingCreate) SetNillableBaseURL(s *string) *SettingCreate {
 	return sc
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (sc *SettingCreate) SetRateLimit(tls *types.RateLimitSetting) *SettingCreate {
+	sc.mutation.SetRateLimit(tls)
+	return sc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (sc *SettingCreate) SetCreatedAt(t time.Time) *SettingCreate {
 	sc.mutation.SetCreatedAt(t)
@@ -286,6 +292,10 @@ func (sc *SettingCreate) createSpec() (*Setting, *sqlgraph.CreateSpec) {
 		_spec.SetField(setting.FieldBaseURL, field.TypeString, value)
 		_node.BaseURL = value
 	}
+	if value, ok := sc.mutation.RateLimit(); ok {
+		_spec.SetField(setting.FieldRateLimit, field.TypeJSON, value)
+		_node.RateLimit = value
+	}
 	if value, ok := sc.mutation.CreatedAt(); ok {
 		_spec.SetField(setting.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -448,6 +458,24 @@ func (u *SettingUpsert) ClearBaseURL() *SettingUpsert {
 	return u
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (u *SettingUpsert) SetRateLimit(v *types.RateLimitSetting) *SettingUpsert {
+	u.Set(setting.FieldRateLimit, v)
+	return u
+}
+
+// UpdateRateLimit sets the "rate_limit" field to the value that was provided on create.
+func (u *SettingUpsert) UpdateRateLimit() *SettingUpsert {
+	u.SetExcluded(setting.FieldRateLimit)
+	return u
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (u *SettingUpsert) ClearRateLimit() *SettingUpsert {
+	u.SetNull(setting.FieldRateLimit)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SettingUpsert) SetCreatedAt(v time.Time) *SettingUpsert {
 	u.Set(setting.FieldCreatedAt, v)
@@ -639,6 +667,27 @@ func (u *SettingUpsertOne) ClearBaseURL() *SettingUpsertOne {
 	})
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (u *SettingUpsertOne) SetRateLimit(v *types.RateLimitSetting) *SettingUpsertOne {
+	return u.Update(func(s *SettingUpsert) {
+		s.SetRateLimit(v)
+	})
+}
+
+// UpdateRateLimit sets the "rate_limit" field to the value that was provided on create.
+func (u *SettingUpsertOne) UpdateRateLimit() *SettingUpsertOne {
+	return u.Update(func(s *SettingUpsert) {
+		s.UpdateRateLimit()
+	})
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (u *SettingUpsertOne) ClearRateLimit() *SettingUpsertOne {
+	return u.Update(func(s *SettingUpsert) {
+		s.ClearRateLimit()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SettingUpsertOne) SetCreatedAt(v time.Time) *SettingUpsertOne {
 	return u.Update(func(s *SettingUpsert) {
@@ -1001,6 +1050,27 @@ func (u *SettingUpsertBulk) ClearBaseURL() *SettingUpsertBulk {
 	})
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (u *SettingUpsertBulk) SetRateLimit(v *types.RateLimitSetting) *SettingUpsertBulk {
+	return u.Update(func(s *SettingUpsert) {
+		s.SetRateLimit(v)
+	})
+}
+
+// UpdateRateLimit sets the "rate_limit" field to the value that was provided on create.
+func (u *SettingUpsertBulk) UpdateRateLimit() *SettingUpsertBulk {
+	return u.Update(func(s *SettingUpsert) {
+		s.UpdateRateLimit()
+	})
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (u *SettingUpsertBulk) ClearRateLimit() *SettingUpsertBulk {
+	return u.Update(func(s *SettingUpsert) {
+		s.ClearRateLimit()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *SettingUpsertBulk) SetCreatedAt(v time.Time) *SettingUpsertBulk {
 	return u.Update(func(s *SettingUpsert) {
@@ -130,6 +130,18 @@ func (su *SettingUpdate) ClearBaseURL() *SettingUpdate {
 	return su
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (su *SettingUpdate) SetRateLimit(tls *types.RateLimitSetting) *SettingUpdate {
+	su.mutation.SetRateLimit(tls)
+	return su
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (su *SettingUpdate) ClearRateLimit() *SettingUpdate {
+	su.mutation.ClearRateLimit()
+	return su
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (su *SettingUpdate) SetCreatedAt(t time.Time) *SettingUpdate {
 	su.mutation.SetCreatedAt(t)
@@ -236,6 +248,12 @@ func (su *SettingUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if su.mutation.BaseURLCleared() {
 		_spec.ClearField(setting.FieldBaseURL, field.TypeString)
 	}
+	if value, ok := su.mutation.RateLimit(); ok {
+		_spec.SetField(setting.FieldRateLimit, field.TypeJSON, value)
+	}
+	if su.mutation.RateLimitCleared() {
+		_spec.ClearField(setting.FieldRateLimit, field.TypeJSON)
+	}
 	if value, ok := su.mutation.CreatedAt(); ok {
 		_spec.SetField(setting.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -364,6 +382,18 @@ func (suo *SettingUpdateOne) ClearBaseURL() *SettingUpdateOne {
 	return suo
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (suo *SettingUpdateOne) SetRateLimit(tls *types.RateLimitSetting) *SettingUpdateOne {
+	suo.mutation.SetRateLimit(tls)
+	return suo
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (suo *SettingUpdateOne) ClearRateLimit() *SettingUpdateOne {
+	suo.mutation.ClearRateLimit()
+	return suo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (suo *SettingUpdateOne) SetCreatedAt(t time.Time) *SettingUpdateOne {
 	suo.mutation.SetCreatedAt(t)
@@ -500,6 +530,12 @@ func (suo *SettingUpdateOne) sqlSave(ctx context.Context) (_node *Setting, err e
 	if suo.mutation.BaseURLCleared() {
 		_spec.ClearField(setting.FieldBaseURL, field.TypeString)
 	}
+	if value, ok := suo.mutation.RateLimit(); ok {
+		_spec.SetField(setting.FieldRateLimit, field.TypeJSON, value)
+	}
+	if suo.mutation.RateLimitCleared() {
+		_spec.ClearField(setting.FieldRateLimit, field.TypeJSON)
+	}
 	if value, ok := suo.mutation.CreatedAt(); ok {
 		_spec.SetField(setting.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -48,6 +48,8 @@ type Task struct {
 	InputTokens int64 `json:"input_tokens,omitempty"`
 	// OutputTokens holds the value of the "output_tokens" field.
 	OutputTokens int64 `json:"output_tokens,omitempty"`
+	// CachedInputTokens holds the value of the "cached_input_tokens" field.
+	CachedInputTokens int64 `json:"cached_input_tokens,omitempty"`
 	// IsSuggested holds the value of the "is_suggested" field.
 	IsSuggested bool `json:"is_suggested,omitempty"`
 	// SourceCode holds the value of the "source_code" field.
@@ -56,6 +58,18 @@ type Task struct {
 	CursorPosition map[string]interface{} `json:"cursor_position,omitempty"`
 	// UserInput holds the value of the "user_input" field.
 	UserInput string `json:"user_input,omitempty"`
+	// CacheHit holds the value of the "cache_hit" field.
+	CacheHit bool `json:"cache_hit,omitempty"`
+	// PolicyVersion holds the value of the "policy_version" field.
+	PolicyVersion int64 `json:"policy_version,omitempty"`
+	// UsageSource holds the value of the "usage_source" field.
+	UsageSource consts.UsageSource `json:"usage_source,omitempty"`
+	// Cost holds the value of the "cost" field.
+	Cost int64 `json:"cost,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Status holds the value of the "status" field.
+	Status consts.TaskStatus `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -117,11 +131,11 @@ func (*Task) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case task.FieldCursorPosition:
 			values[i] = new([]byte)
-		case task.FieldIsAccept, task.FieldIsSuggested:
+		case task.FieldIsAccept, task.FieldIsSuggested, task.FieldCacheHit:
 			values[i] = new(sql.NullBool)
-		case task.FieldCodeLines, task.FieldInputTokens, task.FieldOutputTokens:
+		case task.FieldCodeLines, task.FieldInputTokens, task.FieldOutputTokens, task.FieldCachedInputTokens, task.FieldPolicyVersion, task.FieldCost:
 			values[i] = new(sql.NullInt64)
-		case task.FieldTaskID, task.FieldRequestID, task.FieldModelType, task.FieldProgramLanguage, task.FieldWorkMode, task.FieldPrompt, task.FieldCompletion, task.FieldSourceCode, task.FieldUserInput:
+		case task.FieldTaskID, task.FieldRequestID, task.FieldModelType, task.FieldProgramLanguage, task.FieldWorkMode, task.FieldPrompt, task.FieldCompletion, task.FieldSourceCode, task.FieldUserInput, task.FieldUsageSource, task.FieldCurrency, task.FieldStatus:
 			values[i] = new(sql.NullString)
 		case task.FieldCreatedAt, task.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -226,6 +240,12 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.OutputTokens = value.Int64
 			}
+		case task.FieldCachedInputTokens:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cached_input_tokens", values[i])
+			} else if value.Valid {
+				t.CachedInputTokens = value.Int64
+			}
 		case task.FieldIsSuggested:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_suggested", values[i])
@@ -252,6 +272,42 @@ func (t *Task) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				t.UserInput = value.String
 			}
+		case task.FieldCacheHit:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cache_hit", values[i])
+			} else if value.Valid {
+				t.CacheHit = value.Bool
+			}
+		case task.FieldPolicyVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field policy_version", values[i])
+			} else if value.Valid {
+				t.PolicyVersion = value.Int64
+			}
+		case task.FieldUsageSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field usage_source", values[i])
+			} else if value.Valid {
+				t.UsageSource = consts.UsageSource(value.String)
+			}
+		case task.FieldCost:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cost", values[i])
+			} else if value.Valid {
+				t.Cost = value.Int64
+			}
+		case task.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				t.Currency = value.String
+			}
+		case task.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				t.Status = consts.TaskStatus(value.String)
+			}
 		case task.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -354,6 +410,9 @@ func (t *Task) String() string {
 	builder.WriteString("output_tokens=")
 	builder.WriteString(fmt.Sprintf("%v", t.OutputTokens))
 	builder.WriteString(", ")
+	builder.WriteString("cached_input_tokens=")
+	builder.WriteString(fmt.Sprintf("%v", t.CachedInputTokens))
+	builder.WriteString(", ")
 	builder.WriteString("is_suggested=")
 	builder.WriteString(fmt.Sprintf("%v", t.IsSuggested))
 	builder.WriteString(", ")
@@ -366,6 +425,24 @@ func (t *Task) String() string {
 	builder.WriteString("user_input=")
 	builder.WriteString(t.UserInput)
 	builder.WriteString(", ")
+	builder.WriteString("cache_hit=")
+	builder.WriteString(fmt.Sprintf("%v", t.CacheHit))
+	builder.WriteString(", ")
+	builder.WriteString("policy_version=")
+	builder.WriteString(fmt.Sprintf("%v", t.PolicyVersion))
+	builder.WriteString(", ")
+	builder.WriteString("usage_source=")
+	builder.WriteString(fmt.Sprintf("%v", t.UsageSource))
+	builder.WriteString(", ")
+	builder.WriteString("cost=")
+	builder.WriteString(fmt.Sprintf("%v", t.Cost))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(t.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -40,6 +40,8 @@ const (
 	FieldInputTokens = "input_tokens"
 	// FieldOutputTokens holds the string denoting the output_tokens field in the database.
 	FieldOutputTokens = "output_tokens"
+	// FieldCachedInputTokens holds the string denoting the cached_input_tokens field in the database.
+	FieldCachedInputTokens = "cached_input_tokens"
 	// FieldIsSuggested holds the string denoting the is_suggested field in the database.
 	FieldIsSuggested = "is_suggested"
 	// FieldSourceCode holds the string denoting the source_code field in the database.
@@ -48,6 +50,18 @@ const (
 	FieldCursorPosition = "cursor_position"
 	// FieldUserInput holds the string denoting the user_input field in the database.
 	FieldUserInput = "user_input"
+	// FieldCacheHit holds the string denoting the cache_hit field in the database.
+	FieldCacheHit = "cache_hit"
+	// FieldPolicyVersion holds the string denoting the policy_version field in the database.
+	FieldPolicyVersion = "policy_version"
+	// FieldUsageSource holds the string denoting the usage_source field in the database.
+	FieldUsageSource = "usage_source"
+	// FieldCost holds the string denoting the cost field in the database.
+	FieldCost = "cost"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -99,10 +113,17 @@ var Columns = []string{
 	FieldCodeLines,
 	FieldInputTokens,
 	FieldOutputTokens,
+	FieldCachedInputTokens,
 	FieldIsSuggested,
 	FieldSourceCode,
 	FieldCursorPosition,
 	FieldUserInput,
+	FieldCacheHit,
+	FieldPolicyVersion,
+	FieldUsageSource,
+	FieldCost,
+	FieldCurrency,
+	FieldStatus,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -122,6 +143,10 @@ var (
 	DefaultIsAccept bool
 	// DefaultIsSuggested holds the default value on creation for the "is_suggested" field.
 	DefaultIsSuggested bool
+	// DefaultCacheHit holds the default value on creation for the "cache_hit" field.
+	DefaultCacheHit bool
+	// DefaultCost holds the default value on creation for the "cost" field.
+	DefaultCost int64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -203,6 +228,11 @@ func ByOutputTokens(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOutputTokens, opts...).ToFunc()
 }
 
+// ByCachedInputTokens orders the results by the cached_input_tokens field.
+func ByCachedInputTokens(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCachedInputTokens, opts...).ToFunc()
+}
+
 // ByIsSuggested orders the results by the is_suggested field.
 func ByIsSuggested(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsSuggested, opts...).ToFunc()
@@ -218,6 +248,36 @@ func ByUserInput(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserInput, opts...).ToFunc()
 }
 
+// ByCacheHit orders the results by the cache_hit field.
+func ByCacheHit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCacheHit, opts...).ToFunc()
+}
+
+// ByPolicyVersion orders the results by the policy_version field.
+func ByPolicyVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPolicyVersion, opts...).ToFunc()
+}
+
+// ByUsageSource orders the results by the usage_source field.
+func ByUsageSource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUsageSource, opts...).ToFunc()
+}
+
+// ByCost orders the results by the cost field.
+func ByCost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCost, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -123,6 +123,11 @@ func OutputTokens(v int64) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldOutputTokens, v))
 }
 
+// CachedInputTokens applies equality check predicate on the "cached_input_tokens" field. It's identical to CachedInputTokensEQ.
+func CachedInputTokens(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCachedInputTokens, v))
+}
+
 // IsSuggested applies equality check predicate on the "is_suggested" field. It's identical to IsSuggestedEQ.
 func IsSuggested(v bool) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldIsSuggested, v))
@@ -138,6 +143,38 @@ func UserInput(v string) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldUserInput, v))
 }
 
+// CacheHit applies equality check predicate on the "cache_hit" field. It's identical to CacheHitEQ.
+func CacheHit(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCacheHit, v))
+}
+
+// PolicyVersion applies equality check predicate on the "policy_version" field. It's identical to PolicyVersionEQ.
+func PolicyVersion(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPolicyVersion, v))
+}
+
+// UsageSource applies equality check predicate on the "usage_source" field. It's identical to UsageSourceEQ.
+func UsageSource(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldEQ(FieldUsageSource, vc))
+}
+
+// Cost applies equality check predicate on the "cost" field. It's identical to CostEQ.
+func Cost(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCost, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldEQ(FieldStatus, vc))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -892,6 +929,56 @@ func OutputTokensNotNil() predicate.Task {
 	return predicate.Task(sql.FieldNotNull(FieldOutputTokens))
 }
 
+// CachedInputTokensEQ applies the EQ predicate on the "cached_input_tokens" field.
+func CachedInputTokensEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensNEQ applies the NEQ predicate on the "cached_input_tokens" field.
+func CachedInputTokensNEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensIn applies the In predicate on the "cached_input_tokens" field.
+func CachedInputTokensIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldCachedInputTokens, vs...))
+}
+
+// CachedInputTokensNotIn applies the NotIn predicate on the "cached_input_tokens" field.
+func CachedInputTokensNotIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldCachedInputTokens, vs...))
+}
+
+// CachedInputTokensGT applies the GT predicate on the "cached_input_tokens" field.
+func CachedInputTokensGT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensGTE applies the GTE predicate on the "cached_input_tokens" field.
+func CachedInputTokensGTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensLT applies the LT predicate on the "cached_input_tokens" field.
+func CachedInputTokensLT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensLTE applies the LTE predicate on the "cached_input_tokens" field.
+func CachedInputTokensLTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldCachedInputTokens, v))
+}
+
+// CachedInputTokensIsNil applies the IsNil predicate on the "cached_input_tokens" field.
+func CachedInputTokensIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldCachedInputTokens))
+}
+
+// CachedInputTokensNotNil applies the NotNil predicate on the "cached_input_tokens" field.
+func CachedInputTokensNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldCachedInputTokens))
+}
+
 // IsSuggestedEQ applies the EQ predicate on the "is_suggested" field.
 func IsSuggestedEQ(v bool) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldIsSuggested, v))
@@ -1062,6 +1149,369 @@ func UserInputContainsFold(v string) predicate.Task {
 	return predicate.Task(sql.FieldContainsFold(FieldUserInput, v))
 }
 
+// CacheHitEQ applies the EQ predicate on the "cache_hit" field.
+func CacheHitEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCacheHit, v))
+}
+
+// CacheHitNEQ applies the NEQ predicate on the "cache_hit" field.
+func CacheHitNEQ(v bool) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldCacheHit, v))
+}
+
+// PolicyVersionEQ applies the EQ predicate on the "policy_version" field.
+func PolicyVersionEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldPolicyVersion, v))
+}
+
+// PolicyVersionNEQ applies the NEQ predicate on the "policy_version" field.
+func PolicyVersionNEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldPolicyVersion, v))
+}
+
+// PolicyVersionIn applies the In predicate on the "policy_version" field.
+func PolicyVersionIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldPolicyVersion, vs...))
+}
+
+// PolicyVersionNotIn applies the NotIn predicate on the "policy_version" field.
+func PolicyVersionNotIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldPolicyVersion, vs...))
+}
+
+// PolicyVersionGT applies the GT predicate on the "policy_version" field.
+func PolicyVersionGT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldPolicyVersion, v))
+}
+
+// PolicyVersionGTE applies the GTE predicate on the "policy_version" field.
+func PolicyVersionGTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldPolicyVersion, v))
+}
+
+// PolicyVersionLT applies the LT predicate on the "policy_version" field.
+func PolicyVersionLT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldPolicyVersion, v))
+}
+
+// PolicyVersionLTE applies the LTE predicate on the "policy_version" field.
+func PolicyVersionLTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldPolicyVersion, v))
+}
+
+// PolicyVersionIsNil applies the IsNil predicate on the "policy_version" field.
+func PolicyVersionIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldPolicyVersion))
+}
+
+// PolicyVersionNotNil applies the NotNil predicate on the "policy_version" field.
+func PolicyVersionNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldPolicyVersion))
+}
+
+// UsageSourceEQ applies the EQ predicate on the "usage_source" field.
+func UsageSourceEQ(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldEQ(FieldUsageSource, vc))
+}
+
+// UsageSourceNEQ applies the NEQ predicate on the "usage_source" field.
+func UsageSourceNEQ(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldNEQ(FieldUsageSource, vc))
+}
+
+// UsageSourceIn applies the In predicate on the "usage_source" field.
+func UsageSourceIn(vs ...consts.UsageSource) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Task(sql.FieldIn(FieldUsageSource, v...))
+}
+
+// UsageSourceNotIn applies the NotIn predicate on the "usage_source" field.
+func UsageSourceNotIn(vs ...consts.UsageSource) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Task(sql.FieldNotIn(FieldUsageSource, v...))
+}
+
+// UsageSourceGT applies the GT predicate on the "usage_source" field.
+func UsageSourceGT(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldGT(FieldUsageSource, vc))
+}
+
+// UsageSourceGTE applies the GTE predicate on the "usage_source" field.
+func UsageSourceGTE(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldGTE(FieldUsageSource, vc))
+}
+
+// UsageSourceLT applies the LT predicate on the "usage_source" field.
+func UsageSourceLT(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldLT(FieldUsageSource, vc))
+}
+
+// UsageSourceLTE applies the LTE predicate on the "usage_source" field.
+func UsageSourceLTE(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldLTE(FieldUsageSource, vc))
+}
+
+// UsageSourceContains applies the Contains predicate on the "usage_source" field.
+func UsageSourceContains(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldContains(FieldUsageSource, vc))
+}
+
+// UsageSourceHasPrefix applies the HasPrefix predicate on the "usage_source" field.
+func UsageSourceHasPrefix(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldHasPrefix(FieldUsageSource, vc))
+}
+
+// UsageSourceHasSuffix applies the HasSuffix predicate on the "usage_source" field.
+func UsageSourceHasSuffix(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldHasSuffix(FieldUsageSource, vc))
+}
+
+// UsageSourceIsNil applies the IsNil predicate on the "usage_source" field.
+func UsageSourceIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldUsageSource))
+}
+
+// UsageSourceNotNil applies the NotNil predicate on the "usage_source" field.
+func UsageSourceNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldUsageSource))
+}
+
+// UsageSourceEqualFold applies the EqualFold predicate on the "usage_source" field.
+func UsageSourceEqualFold(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldEqualFold(FieldUsageSource, vc))
+}
+
+// UsageSourceContainsFold applies the ContainsFold predicate on the "usage_source" field.
+func UsageSourceContainsFold(v consts.UsageSource) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldContainsFold(FieldUsageSource, vc))
+}
+
+// CostEQ applies the EQ predicate on the "cost" field.
+func CostEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCost, v))
+}
+
+// CostNEQ applies the NEQ predicate on the "cost" field.
+func CostNEQ(v int64) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldCost, v))
+}
+
+// CostIn applies the In predicate on the "cost" field.
+func CostIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldCost, vs...))
+}
+
+// CostNotIn applies the NotIn predicate on the "cost" field.
+func CostNotIn(vs ...int64) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldCost, vs...))
+}
+
+// CostGT applies the GT predicate on the "cost" field.
+func CostGT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldCost, v))
+}
+
+// CostGTE applies the GTE predicate on the "cost" field.
+func CostGTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldCost, v))
+}
+
+// CostLT applies the LT predicate on the "cost" field.
+func CostLT(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldCost, v))
+}
+
+// CostLTE applies the LTE predicate on the "cost" field.
+func CostLTE(v int64) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldCost, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Task {
+	return predicate.Task(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Task {
+	return predicate.Task(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Task {
+	return predicate.Task(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Task {
+	return predicate.Task(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Task {
+	return predicate.Task(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Task {
+	return predicate.Task(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Task {
+	return predicate.Task(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyIsNil applies the IsNil predicate on the "currency" field.
+func CurrencyIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldCurrency))
+}
+
+// CurrencyNotNil applies the NotNil predicate on the "currency" field.
+func CurrencyNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldCurrency))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Task {
+	return predicate.Task(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldEQ(FieldStatus, vc))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldNEQ(FieldStatus, vc))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...consts.TaskStatus) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Task(sql.FieldIn(FieldStatus, v...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...consts.TaskStatus) predicate.Task {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = string(vs[i])
+	}
+	return predicate.Task(sql.FieldNotIn(FieldStatus, v...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldGT(FieldStatus, vc))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldGTE(FieldStatus, vc))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldLT(FieldStatus, vc))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldLTE(FieldStatus, vc))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldContains(FieldStatus, vc))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldHasPrefix(FieldStatus, vc))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldHasSuffix(FieldStatus, vc))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.Task {
+	return predicate.Task(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.Task {
+	return predicate.Task(sql.FieldNotNull(FieldStatus))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldEqualFold(FieldStatus, vc))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v consts.TaskStatus) predicate.Task {
+	vc := string(v)
+	return predicate.Task(sql.FieldContainsFold(FieldStatus, vc))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Task {
 	return predicate.Task(sql.FieldEQ(FieldCreatedAt, v))
@@ -194,6 +194,20 @@ func (tc *TaskCreate) SetNillableOutputTokens(i *int64) *TaskCreate {
 	return tc
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (tc *TaskCreate) SetCachedInputTokens(i int64) *TaskCreate {
+	tc.mutation.SetCachedInputTokens(i)
+	return tc
+}
+
+// SetNillableCachedInputTokens sets the "cached_input_tokens" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCachedInputTokens(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetCachedInputTokens(*i)
+	}
+	return tc
+}
+
 // SetIsSuggested sets the "is_suggested" field.
 func (tc *TaskCreate) SetIsSuggested(b bool) *TaskCreate {
 	tc.mutation.SetIsSuggested(b)
@@ -242,6 +256,90 @@ func (tc *TaskCreate) SetNillableUserInput(s *string) *TaskCreate {
 	return tc
 }
 
+// SetCacheHit sets the "cache_hit" field.
+func (tc *TaskCreate) SetCacheHit(b bool) *TaskCreate {
+	tc.mutation.SetCacheHit(b)
+	return tc
+}
+
+// SetNillableCacheHit sets the "cache_hit" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCacheHit(b *bool) *TaskCreate {
+	if b != nil {
+		tc.SetCacheHit(*b)
+	}
+	return tc
+}
+
+// SetPolicyVersion sets the "policy_version" field.
+func (tc *TaskCreate) SetPolicyVersion(i int64) *TaskCreate {
+	tc.mutation.SetPolicyVersion(i)
+	return tc
+}
+
+// SetNillablePolicyVersion sets the "policy_version" field if the given value is not nil.
+func (tc *TaskCreate) SetNillablePolicyVersion(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetPolicyVersion(*i)
+	}
+	return tc
+}
+
+// SetUsageSource sets the "usage_source" field.
+func (tc *TaskCreate) SetUsageSource(cs consts.UsageSource) *TaskCreate {
+	tc.mutation.SetUsageSource(cs)
+	return tc
+}
+
+// SetNillableUsageSource sets the "usage_source" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableUsageSource(cs *consts.UsageSource) *TaskCreate {
+	if cs != nil {
+		tc.SetUsageSource(*cs)
+	}
+	return tc
+}
+
+// SetCost sets the "cost" field.
+func (tc *TaskCreate) SetCost(i int64) *TaskCreate {
+	tc.mutation.SetCost(i)
+	return tc
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCost(i *int64) *TaskCreate {
+	if i != nil {
+		tc.SetCost(*i)
+	}
+	return tc
+}
+
+// SetCurrency sets the "currency" field.
+func (tc *TaskCreate) SetCurrency(s string) *TaskCreate {
+	tc.mutation.SetCurrency(s)
+	return tc
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableCurrency(s *string) *TaskCreate {
+	if s != nil {
+		tc.SetCurrency(*s)
+	}
+	return tc
+}
+
+// SetStatus sets the "status" field.
+func (tc *TaskCreate) SetStatus(cs consts.TaskStatus) *TaskCreate {
+	tc.mutation.SetStatus(cs)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TaskCreate) SetNillableStatus(cs *consts.TaskStatus) *TaskCreate {
+	if cs != nil {
+		tc.SetStatus(*cs)
+	}
+	return tc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tc *TaskCreate) SetCreatedAt(t time.Time) *TaskCreate {
 	tc.mutation.SetCreatedAt(t)
@@ -344,6 +442,14 @@ func (tc *TaskCreate) defaults() {
 		v := task.DefaultIsSuggested
 		tc.mutation.SetIsSuggested(v)
 	}
+	if _, ok := tc.mutation.CacheHit(); !ok {
+		v := task.DefaultCacheHit
+		tc.mutation.SetCacheHit(v)
+	}
+	if _, ok := tc.mutation.Cost(); !ok {
+		v := task.DefaultCost
+		tc.mutation.SetCost(v)
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		v := task.DefaultCreatedAt()
 		tc.mutation.SetCreatedAt(v)
@@ -368,6 +474,12 @@ func (tc *TaskCreate) check() error {
 	if _, ok := tc.mutation.IsSuggested(); !ok {
 		return &ValidationError{Name: "is_suggested", err: errors.New(`db: missing required field "Task.is_suggested"`)}
 	}
+	if _, ok := tc.mutation.CacheHit(); !ok {
+		return &ValidationError{Name: "cache_hit", err: errors.New(`db: missing required field "Task.cache_hit"`)}
+	}
+	if _, ok := tc.mutation.Cost(); !ok {
+		return &ValidationError{Name: "cost", err: errors.New(`db: missing required field "Task.cost"`)}
+	}
 	if _, ok := tc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "Task.created_at"`)}
 	}
@@ -454,6 +566,10 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldOutputTokens, field.TypeInt64, value)
 		_node.OutputTokens = value
 	}
+	if value, ok := tc.mutation.CachedInputTokens(); ok {
+		_spec.SetField(task.FieldCachedInputTokens, field.TypeInt64, value)
+		_node.CachedInputTokens = value
+	}
 	if value, ok := tc.mutation.IsSuggested(); ok {
 		_spec.SetField(task.FieldIsSuggested, field.TypeBool, value)
 		_node.IsSuggested = value
@@ -470,6 +586,30 @@ func (tc *TaskCreate) createSpec() (*Task, *sqlgraph.CreateSpec) {
 		_spec.SetField(task.FieldUserInput, field.TypeString, value)
 		_node.UserInput = value
 	}
+	if value, ok := tc.mutation.CacheHit(); ok {
+		_spec.SetField(task.FieldCacheHit, field.TypeBool, value)
+		_node.CacheHit = value
+	}
+	if value, ok := tc.mutation.PolicyVersion(); ok {
+		_spec.SetField(task.FieldPolicyVersion, field.TypeInt64, value)
+		_node.PolicyVersion = value
+	}
+	if value, ok := tc.mutation.UsageSource(); ok {
+		_spec.SetField(task.FieldUsageSource, field.TypeString, value)
+		_node.UsageSource = value
+	}
+	if value, ok := tc.mutation.Cost(); ok {
+		_spec.SetField(task.FieldCost, field.TypeInt64, value)
+		_node.Cost = value
+	}
+	if value, ok := tc.mutation.Currency(); ok {
+		_spec.SetField(task.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
 	if value, ok := tc.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -814,6 +954,30 @@ func (u *TaskUpsert) ClearOutputTokens() *TaskUpsert {
 	return u
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (u *TaskUpsert) SetCachedInputTokens(v int64) *TaskUpsert {
+	u.Set(task.FieldCachedInputTokens, v)
+	return u
+}
+
+// UpdateCachedInputTokens sets the "cached_input_tokens" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateCachedInputTokens() *TaskUpsert {
+	u.SetExcluded(task.FieldCachedInputTokens)
+	return u
+}
+
+// AddCachedInputTokens adds v to the "cached_input_tokens" field.
+func (u *TaskUpsert) AddCachedInputTokens(v int64) *TaskUpsert {
+	u.Add(task.FieldCachedInputTokens, v)
+	return u
+}
+
+// ClearCachedInputTokens clears the value of the "cached_input_tokens" field.
+func (u *TaskUpsert) ClearCachedInputTokens() *TaskUpsert {
+	u.SetNull(task.FieldCachedInputTokens)
+	return u
+}
+
 // SetIsSuggested sets the "is_suggested" field.
 func (u *TaskUpsert) SetIsSuggested(v bool) *TaskUpsert {
 	u.Set(task.FieldIsSuggested, v)
@@ -880,6 +1044,114 @@ func (u *TaskUpsert) ClearUserInput() *TaskUpsert {
 	return u
 }
 
+// SetCacheHit sets the "cache_hit" field.
+func (u *TaskUpsert) SetCacheHit(v bool) *TaskUpsert {
+	u.Set(task.FieldCacheHit, v)
+	return u
+}
+
+// UpdateCacheHit sets the "cache_hit" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateCacheHit() *TaskUpsert {
+	u.SetExcluded(task.FieldCacheHit)
+	return u
+}
+
+// SetPolicyVersion sets the "policy_version" field.
+func (u *TaskUpsert) SetPolicyVersion(v int64) *TaskUpsert {
+	u.Set(task.FieldPolicyVersion, v)
+	return u
+}
+
+// UpdatePolicyVersion sets the "policy_version" field to the value that was provided on create.
+func (u *TaskUpsert) UpdatePolicyVersion() *TaskUpsert {
+	u.SetExcluded(task.FieldPolicyVersion)
+	return u
+}
+
+// AddPolicyVersion adds v to the "policy_version" field.
+func (u *TaskUpsert) AddPolicyVersion(v int64) *TaskUpsert {
+	u.Add(task.FieldPolicyVersion, v)
+	return u
+}
+
+// ClearPolicyVersion clears the value of the "policy_version" field.
+func (u *TaskUpsert) ClearPolicyVersion() *TaskUpsert {
+	u.SetNull(task.FieldPolicyVersion)
+	return u
+}
+
+// SetUsageSource sets the "usage_source" field.
+func (u *TaskUpsert) SetUsageSource(v consts.UsageSource) *TaskUpsert {
+	u.Set(task.FieldUsageSource, v)
+	return u
+}
+
+// UpdateUsageSource sets the "usage_source" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateUsageSource() *TaskUpsert {
+	u.SetExcluded(task.FieldUsageSource)
+	return u
+}
+
+// ClearUsageSource clears the value of the "usage_source" field.
+func (u *TaskUpsert) ClearUsageSource() *TaskUpsert {
+	u.SetNull(task.FieldUsageSource)
+	return u
+}
+
+// SetCost sets the "cost" field.
+func (u *TaskUpsert) SetCost(v int64) *TaskUpsert {
+	u.Set(task.FieldCost, v)
+	return u
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateCost() *TaskUpsert {
+	u.SetExcluded(task.FieldCost)
+	return u
+}
+
+// AddCost adds v to the "cost" field.
+func (u *TaskUpsert) AddCost(v int64) *TaskUpsert {
+	u.Add(task.FieldCost, v)
+	return u
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TaskUpsert) SetCurrency(v string) *TaskUpsert {
+	u.Set(task.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateCurrency() *TaskUpsert {
+	u.SetExcluded(task.FieldCurrency)
+	return u
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *TaskUpsert) ClearCurrency() *TaskUpsert {
+	u.SetNull(task.FieldCurrency)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskUpsert) SetStatus(v consts.TaskStatus) *TaskUpsert {
+	u.Set(task.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskUpsert) UpdateStatus() *TaskUpsert {
+	u.SetExcluded(task.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskUpsert) ClearStatus() *TaskUpsert {
+	u.SetNull(task.FieldStatus)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *TaskUpsert) SetCreatedAt(v time.Time) *TaskUpsert {
 	u.Set(task.FieldCreatedAt, v)
@@ -1225,6 +1497,34 @@ func (u *TaskUpsertOne) ClearOutputTokens() *TaskUpsertOne {
 	})
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (u *TaskUpsertOne) SetCachedInputTokens(v int64) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCachedInputTokens(v)
+	})
+}
+
+// AddCachedInputTokens adds v to the "cached_input_tokens" field.
+func (u *TaskUpsertOne) AddCachedInputTokens(v int64) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddCachedInputTokens(v)
+	})
+}
+
+// UpdateCachedInputTokens sets the "cached_input_tokens" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateCachedInputTokens() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCachedInputTokens()
+	})
+}
+
+// ClearCachedInputTokens clears the value of the "cached_input_tokens" field.
+func (u *TaskUpsertOne) ClearCachedInputTokens() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearCachedInputTokens()
+	})
+}
+
 // SetIsSuggested sets the "is_suggested" field.
 func (u *TaskUpsertOne) SetIsSuggested(v bool) *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -1302,6 +1602,132 @@ func (u *TaskUpsertOne) ClearUserInput() *TaskUpsertOne {
 	})
 }
 
+// SetCacheHit sets the "cache_hit" field.
+func (u *TaskUpsertOne) SetCacheHit(v bool) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCacheHit(v)
+	})
+}
+
+// UpdateCacheHit sets the "cache_hit" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateCacheHit() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCacheHit()
+	})
+}
+
+// SetPolicyVersion sets the "policy_version" field.
+func (u *TaskUpsertOne) SetPolicyVersion(v int64) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPolicyVersion(v)
+	})
+}
+
+// AddPolicyVersion adds v to the "policy_version" field.
+func (u *TaskUpsertOne) AddPolicyVersion(v int64) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddPolicyVersion(v)
+	})
+}
+
+// UpdatePolicyVersion sets the "policy_version" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdatePolicyVersion() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePolicyVersion()
+	})
+}
+
+// ClearPolicyVersion clears the value of the "policy_version" field.
+func (u *TaskUpsertOne) ClearPolicyVersion() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearPolicyVersion()
+	})
+}
+
+// SetUsageSource sets the "usage_source" field.
+func (u *TaskUpsertOne) SetUsageSource(v consts.UsageSource) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetUsageSource(v)
+	})
+}
+
+// UpdateUsageSource sets the "usage_source" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateUsageSource() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateUsageSource()
+	})
+}
+
+// ClearUsageSource clears the value of the "usage_source" field.
+func (u *TaskUpsertOne) ClearUsageSource() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearUsageSource()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *TaskUpsertOne) SetCost(v int64) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *TaskUpsertOne) AddCost(v int64) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateCost() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TaskUpsertOne) SetCurrency(v string) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateCurrency() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *TaskUpsertOne) ClearCurrency() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskUpsertOne) SetStatus(v consts.TaskStatus) *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskUpsertOne) UpdateStatus() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskUpsertOne) ClearStatus() *TaskUpsertOne {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearStatus()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *TaskUpsertOne) SetCreatedAt(v time.Time) *TaskUpsertOne {
 	return u.Update(func(s *TaskUpsert) {
@@ -1818,6 +2244,34 @@ func (u *TaskUpsertBulk) ClearOutputTokens() *TaskUpsertBulk {
 	})
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (u *TaskUpsertBulk) SetCachedInputTokens(v int64) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCachedInputTokens(v)
+	})
+}
+
+// AddCachedInputTokens adds v to the "cached_input_tokens" field.
+func (u *TaskUpsertBulk) AddCachedInputTokens(v int64) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddCachedInputTokens(v)
+	})
+}
+
+// UpdateCachedInputTokens sets the "cached_input_tokens" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateCachedInputTokens() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCachedInputTokens()
+	})
+}
+
+// ClearCachedInputTokens clears the value of the "cached_input_tokens" field.
+func (u *TaskUpsertBulk) ClearCachedInputTokens() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearCachedInputTokens()
+	})
+}
+
 // SetIsSuggested sets the "is_suggested" field.
 func (u *TaskUpsertBulk) SetIsSuggested(v bool) *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -1895,6 +2349,132 @@ func (u *TaskUpsertBulk) ClearUserInput() *TaskUpsertBulk {
 	})
 }
 
+// SetCacheHit sets the "cache_hit" field.
+func (u *TaskUpsertBulk) SetCacheHit(v bool) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCacheHit(v)
+	})
+}
+
+// UpdateCacheHit sets the "cache_hit" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateCacheHit() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCacheHit()
+	})
+}
+
+// SetPolicyVersion sets the "policy_version" field.
+func (u *TaskUpsertBulk) SetPolicyVersion(v int64) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetPolicyVersion(v)
+	})
+}
+
+// AddPolicyVersion adds v to the "policy_version" field.
+func (u *TaskUpsertBulk) AddPolicyVersion(v int64) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddPolicyVersion(v)
+	})
+}
+
+// UpdatePolicyVersion sets the "policy_version" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdatePolicyVersion() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdatePolicyVersion()
+	})
+}
+
+// ClearPolicyVersion clears the value of the "policy_version" field.
+func (u *TaskUpsertBulk) ClearPolicyVersion() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearPolicyVersion()
+	})
+}
+
+// SetUsageSource sets the "usage_source" field.
+func (u *TaskUpsertBulk) SetUsageSource(v consts.UsageSource) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetUsageSource(v)
+	})
+}
+
+// UpdateUsageSource sets the "usage_source" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateUsageSource() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateUsageSource()
+	})
+}
+
+// ClearUsageSource clears the value of the "usage_source" field.
+func (u *TaskUpsertBulk) ClearUsageSource() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearUsageSource()
+	})
+}
+
+// SetCost sets the "cost" field.
+func (u *TaskUpsertBulk) SetCost(v int64) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCost(v)
+	})
+}
+
+// AddCost adds v to the "cost" field.
+func (u *TaskUpsertBulk) AddCost(v int64) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.AddCost(v)
+	})
+}
+
+// UpdateCost sets the "cost" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateCost() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCost()
+	})
+}
+
+// SetCurrency sets the "currency" field.
+func (u *TaskUpsertBulk) SetCurrency(v string) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateCurrency() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (u *TaskUpsertBulk) ClearCurrency() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearCurrency()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskUpsertBulk) SetStatus(v consts.TaskStatus) *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskUpsertBulk) UpdateStatus() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskUpsertBulk) ClearStatus() *TaskUpsertBulk {
+	return u.Update(func(s *TaskUpsert) {
+		s.ClearStatus()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *TaskUpsertBulk) SetCreatedAt(v time.Time) *TaskUpsertBulk {
 	return u.Update(func(s *TaskUpsert) {
@@ -297,6 +297,33 @@ func (tu *TaskUpdate) ClearOutputTokens() *TaskUpdate {
 	return tu
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (tu *TaskUpdate) SetCachedInputTokens(i int64) *TaskUpdate {
+	tu.mutation.ResetCachedInputTokens()
+	tu.mutation.SetCachedInputTokens(i)
+	return tu
+}
+
+// SetNillableCachedInputTokens sets the "cached_input_tokens" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCachedInputTokens(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetCachedInputTokens(*i)
+	}
+	return tu
+}
+
+// AddCachedInputTokens adds i to the "cached_input_tokens" field.
+func (tu *TaskUpdate) AddCachedInputTokens(i int64) *TaskUpdate {
+	tu.mutation.AddCachedInputTokens(i)
+	return tu
+}
+
+// ClearCachedInputTokens clears the value of the "cached_input_tokens" field.
+func (tu *TaskUpdate) ClearCachedInputTokens() *TaskUpdate {
+	tu.mutation.ClearCachedInputTokens()
+	return tu
+}
+
 // SetIsSuggested sets the "is_suggested" field.
 func (tu *TaskUpdate) SetIsSuggested(b bool) *TaskUpdate {
 	tu.mutation.SetIsSuggested(b)
@@ -363,6 +390,128 @@ func (tu *TaskUpdate) ClearUserInput() *TaskUpdate {
 	return tu
 }
 
+// SetCacheHit sets the "cache_hit" field.
+func (tu *TaskUpdate) SetCacheHit(b bool) *TaskUpdate {
+	tu.mutation.SetCacheHit(b)
+	return tu
+}
+
+// SetNillableCacheHit sets the "cache_hit" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCacheHit(b *bool) *TaskUpdate {
+	if b != nil {
+		tu.SetCacheHit(*b)
+	}
+	return tu
+}
+
+// SetPolicyVersion sets the "policy_version" field.
+func (tu *TaskUpdate) SetPolicyVersion(i int64) *TaskUpdate {
+	tu.mutation.ResetPolicyVersion()
+	tu.mutation.SetPolicyVersion(i)
+	return tu
+}
+
+// SetNillablePolicyVersion sets the "policy_version" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillablePolicyVersion(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetPolicyVersion(*i)
+	}
+	return tu
+}
+
+// AddPolicyVersion adds i to the "policy_version" field.
+func (tu *TaskUpdate) AddPolicyVersion(i int64) *TaskUpdate {
+	tu.mutation.AddPolicyVersion(i)
+	return tu
+}
+
+// ClearPolicyVersion clears the value of the "policy_version" field.
+func (tu *TaskUpdate) ClearPolicyVersion() *TaskUpdate {
+	tu.mutation.ClearPolicyVersion()
+	return tu
+}
+
+// SetUsageSource sets the "usage_source" field.
+func (tu *TaskUpdate) SetUsageSource(cs consts.UsageSource) *TaskUpdate {
+	tu.mutation.SetUsageSource(cs)
+	return tu
+}
+
+// SetNillableUsageSource sets the "usage_source" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableUsageSource(cs *consts.UsageSource) *TaskUpdate {
+	if cs != nil {
+		tu.SetUsageSource(*cs)
+	}
+	return tu
+}
+
+// ClearUsageSource clears the value of the "usage_source" field.
+func (tu *TaskUpdate) ClearUsageSource() *TaskUpdate {
+	tu.mutation.ClearUsageSource()
+	return tu
+}
+
+// SetCost sets the "cost" field.
+func (tu *TaskUpdate) SetCost(i int64) *TaskUpdate {
+	tu.mutation.ResetCost()
+	tu.mutation.SetCost(i)
+	return tu
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCost(i *int64) *TaskUpdate {
+	if i != nil {
+		tu.SetCost(*i)
+	}
+	return tu
+}
+
+// AddCost adds i to the "cost" field.
+func (tu *TaskUpdate) AddCost(i int64) *TaskUpdate {
+	tu.mutation.AddCost(i)
+	return tu
+}
+
+// SetCurrency sets the "currency" field.
+func (tu *TaskUpdate) SetCurrency(s string) *TaskUpdate {
+	tu.mutation.SetCurrency(s)
+	return tu
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableCurrency(s *string) *TaskUpdate {
+	if s != nil {
+		tu.SetCurrency(*s)
+	}
+	return tu
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (tu *TaskUpdate) ClearCurrency() *TaskUpdate {
+	tu.mutation.ClearCurrency()
+	return tu
+}
+
+// SetStatus sets the "status" field.
+func (tu *TaskUpdate) SetStatus(cs consts.TaskStatus) *TaskUpdate {
+	tu.mutation.SetStatus(cs)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TaskUpdate) SetNillableStatus(cs *consts.TaskStatus) *TaskUpdate {
+	if cs != nil {
+		tu.SetStatus(*cs)
+	}
+	return tu
+}
+
+// ClearStatus clears the value of the "status" field.
+func (tu *TaskUpdate) ClearStatus() *TaskUpdate {
+	tu.mutation.ClearStatus()
+	return tu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tu *TaskUpdate) SetCreatedAt(t time.Time) *TaskUpdate {
 	tu.mutation.SetCreatedAt(t)
@@ -563,6 +712,15 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.OutputTokensCleared() {
 		_spec.ClearField(task.FieldOutputTokens, field.TypeInt64)
 	}
+	if value, ok := tu.mutation.CachedInputTokens(); ok {
+		_spec.SetField(task.FieldCachedInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedCachedInputTokens(); ok {
+		_spec.AddField(task.FieldCachedInputTokens, field.TypeInt64, value)
+	}
+	if tu.mutation.CachedInputTokensCleared() {
+		_spec.ClearField(task.FieldCachedInputTokens, field.TypeInt64)
+	}
 	if value, ok := tu.mutation.IsSuggested(); ok {
 		_spec.SetField(task.FieldIsSuggested, field.TypeBool, value)
 	}
@@ -584,6 +742,42 @@ func (tu *TaskUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tu.mutation.UserInputCleared() {
 		_spec.ClearField(task.FieldUserInput, field.TypeString)
 	}
+	if value, ok := tu.mutation.CacheHit(); ok {
+		_spec.SetField(task.FieldCacheHit, field.TypeBool, value)
+	}
+	if value, ok := tu.mutation.PolicyVersion(); ok {
+		_spec.SetField(task.FieldPolicyVersion, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedPolicyVersion(); ok {
+		_spec.AddField(task.FieldPolicyVersion, field.TypeInt64, value)
+	}
+	if tu.mutation.PolicyVersionCleared() {
+		_spec.ClearField(task.FieldPolicyVersion, field.TypeInt64)
+	}
+	if value, ok := tu.mutation.UsageSource(); ok {
+		_spec.SetField(task.FieldUsageSource, field.TypeString, value)
+	}
+	if tu.mutation.UsageSourceCleared() {
+		_spec.ClearField(task.FieldUsageSource, field.TypeString)
+	}
+	if value, ok := tu.mutation.Cost(); ok {
+		_spec.SetField(task.FieldCost, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.AddedCost(); ok {
+		_spec.AddField(task.FieldCost, field.TypeInt64, value)
+	}
+	if value, ok := tu.mutation.Currency(); ok {
+		_spec.SetField(task.FieldCurrency, field.TypeString, value)
+	}
+	if tu.mutation.CurrencyCleared() {
+		_spec.ClearField(task.FieldCurrency, field.TypeString)
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeString, value)
+	}
+	if tu.mutation.StatusCleared() {
+		_spec.ClearField(task.FieldStatus, field.TypeString)
+	}
 	if value, ok := tu.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -978,6 +1172,33 @@ func (tuo *TaskUpdateOne) ClearOutputTokens() *TaskUpdateOne {
 	return tuo
 }
 
+// SetCachedInputTokens sets the "cached_input_tokens" field.
+func (tuo *TaskUpdateOne) SetCachedInputTokens(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetCachedInputTokens()
+	tuo.mutation.SetCachedInputTokens(i)
+	return tuo
+}
+
+// SetNillableCachedInputTokens sets the "cached_input_tokens" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCachedInputTokens(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetCachedInputTokens(*i)
+	}
+	return tuo
+}
+
+// AddCachedInputTokens adds i to the "cached_input_tokens" field.
+func (tuo *TaskUpdateOne) AddCachedInputTokens(i int64) *TaskUpdateOne {
+	tuo.mutation.AddCachedInputTokens(i)
+	return tuo
+}
+
+// ClearCachedInputTokens clears the value of the "cached_input_tokens" field.
+func (tuo *TaskUpdateOne) ClearCachedInputTokens() *TaskUpdateOne {
+	tuo.mutation.ClearCachedInputTokens()
+	return tuo
+}
+
 // SetIsSuggested sets the "is_suggested" field.
 func (tuo *TaskUpdateOne) SetIsSuggested(b bool) *TaskUpdateOne {
 	tuo.mutation.SetIsSuggested(b)
@@ -1044,6 +1265,128 @@ func (tuo *TaskUpdateOne) ClearUserInput() *TaskUpdateOne {
 	return tuo
 }
 
+// SetCacheHit sets the "cache_hit" field.
+func (tuo *TaskUpdateOne) SetCacheHit(b bool) *TaskUpdateOne {
+	tuo.mutation.SetCacheHit(b)
+	return tuo
+}
+
+// SetNillableCacheHit sets the "cache_hit" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCacheHit(b *bool) *TaskUpdateOne {
+	if b != nil {
+		tuo.SetCacheHit(*b)
+	}
+	return tuo
+}
+
+// SetPolicyVersion sets the "policy_version" field.
+func (tuo *TaskUpdateOne) SetPolicyVersion(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetPolicyVersion()
+	tuo.mutation.SetPolicyVersion(i)
+	return tuo
+}
+
+// SetNillablePolicyVersion sets the "policy_version" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillablePolicyVersion(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetPolicyVersion(*i)
+	}
+	return tuo
+}
+
+// AddPolicyVersion adds i to the "policy_version" field.
+func (tuo *TaskUpdateOne) AddPolicyVersion(i int64) *TaskUpdateOne {
+	tuo.mutation.AddPolicyVersion(i)
+	return tuo
+}
+
+// ClearPolicyVersion clears the value of the "policy_version" field.
+func (tuo *TaskUpdateOne) ClearPolicyVersion() *TaskUpdateOne {
+	tuo.mutation.ClearPolicyVersion()
+	return tuo
+}
+
+// SetUsageSource sets the "usage_source" field.
+func (tuo *TaskUpdateOne) SetUsageSource(cs consts.UsageSource) *TaskUpdateOne {
+	tuo.mutation.SetUsageSource(cs)
+	return tuo
+}
+
+// SetNillableUsageSource sets the "usage_source" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableUsageSource(cs *consts.UsageSource) *TaskUpdateOne {
+	if cs != nil {
+		tuo.SetUsageSource(*cs)
+	}
+	return tuo
+}
+
+// ClearUsageSource clears the value of the "usage_source" field.
+func (tuo *TaskUpdateOne) ClearUsageSource() *TaskUpdateOne {
+	tuo.mutation.ClearUsageSource()
+	return tuo
+}
+
+// SetCost sets the "cost" field.
+func (tuo *TaskUpdateOne) SetCost(i int64) *TaskUpdateOne {
+	tuo.mutation.ResetCost()
+	tuo.mutation.SetCost(i)
+	return tuo
+}
+
+// SetNillableCost sets the "cost" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCost(i *int64) *TaskUpdateOne {
+	if i != nil {
+		tuo.SetCost(*i)
+	}
+	return tuo
+}
+
+// AddCost adds i to the "cost" field.
+func (tuo *TaskUpdateOne) AddCost(i int64) *TaskUpdateOne {
+	tuo.mutation.AddCost(i)
+	return tuo
+}
+
+// SetCurrency sets the "currency" field.
+func (tuo *TaskUpdateOne) SetCurrency(s string) *TaskUpdateOne {
+	tuo.mutation.SetCurrency(s)
+	return tuo
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableCurrency(s *string) *TaskUpdateOne {
+	if s != nil {
+		tuo.SetCurrency(*s)
+	}
+	return tuo
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (tuo *TaskUpdateOne) ClearCurrency() *TaskUpdateOne {
+	tuo.mutation.ClearCurrency()
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TaskUpdateOne) SetStatus(cs consts.TaskStatus) *TaskUpdateOne {
+	tuo.mutation.SetStatus(cs)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TaskUpdateOne) SetNillableStatus(cs *consts.TaskStatus) *TaskUpdateOne {
+	if cs != nil {
+		tuo.SetStatus(*cs)
+	}
+	return tuo
+}
+
+// ClearStatus clears the value of the "status" field.
+func (tuo *TaskUpdateOne) ClearStatus() *TaskUpdateOne {
+	tuo.mutation.ClearStatus()
+	return tuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tuo *TaskUpdateOne) SetCreatedAt(t time.Time) *TaskUpdateOne {
 	tuo.mutation.SetCreatedAt(t)
@@ -1274,6 +1617,15 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.OutputTokensCleared() {
 		_spec.ClearField(task.FieldOutputTokens, field.TypeInt64)
 	}
+	if value, ok := tuo.mutation.CachedInputTokens(); ok {
+		_spec.SetField(task.FieldCachedInputTokens, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedCachedInputTokens(); ok {
+		_spec.AddField(task.FieldCachedInputTokens, field.TypeInt64, value)
+	}
+	if tuo.mutation.CachedInputTokensCleared() {
+		_spec.ClearField(task.FieldCachedInputTokens, field.TypeInt64)
+	}
 	if value, ok := tuo.mutation.IsSuggested(); ok {
 		_spec.SetField(task.FieldIsSuggested, field.TypeBool, value)
 	}
@@ -1295,6 +1647,42 @@ func (tuo *TaskUpdateOne) sqlSave(ctx context.Context) (_node *Task, err error)
 	if tuo.mutation.UserInputCleared() {
 		_spec.ClearField(task.FieldUserInput, field.TypeString)
 	}
+	if value, ok := tuo.mutation.CacheHit(); ok {
+		_spec.SetField(task.FieldCacheHit, field.TypeBool, value)
+	}
+	if value, ok := tuo.mutation.PolicyVersion(); ok {
+		_spec.SetField(task.FieldPolicyVersion, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedPolicyVersion(); ok {
+		_spec.AddField(task.FieldPolicyVersion, field.TypeInt64, value)
+	}
+	if tuo.mutation.PolicyVersionCleared() {
+		_spec.ClearField(task.FieldPolicyVersion, field.TypeInt64)
+	}
+	if value, ok := tuo.mutation.UsageSource(); ok {
+		_spec.SetField(task.FieldUsageSource, field.TypeString, value)
+	}
+	if tuo.mutation.UsageSourceCleared() {
+		_spec.ClearField(task.FieldUsageSource, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Cost(); ok {
+		_spec.SetField(task.FieldCost, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.AddedCost(); ok {
+		_spec.AddField(task.FieldCost, field.TypeInt64, value)
+	}
+	if value, ok := tuo.mutation.Currency(); ok {
+		_spec.SetField(task.FieldCurrency, field.TypeString, value)
+	}
+	if tuo.mutation.CurrencyCleared() {
+		_spec.ClearField(task.FieldCurrency, field.TypeString)
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(task.FieldStatus, field.TypeString, value)
+	}
+	if tuo.mutation.StatusCleared() {
+		_spec.ClearField(task.FieldStatus, field.TypeString)
+	}
 	if value, ok := tuo.mutation.CreatedAt(); ok {
 		_spec.SetField(task.FieldCreatedAt, field.TypeTime, value)
 	}
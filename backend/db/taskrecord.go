@@ -3,6 +3,7 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +36,10 @@ type TaskRecord struct {
 	CodeLines int64 `json:"code_lines,omitempty"`
 	// Code holds the value of the "code" field.
 	Code string `json:"code,omitempty"`
+	// Findings holds the value of the "findings" field.
+	Findings []*types.CodeFinding `json:"findings,omitempty"`
+	// Messages holds the value of the "messages" field.
+	Messages []*types.ChoiceMessage `json:"messages,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -69,6 +75,8 @@ func (*TaskRecord) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case taskrecord.FieldFindings, taskrecord.FieldMessages:
+			values[i] = new([]byte)
 		case taskrecord.FieldOutputTokens, taskrecord.FieldCodeLines:
 			values[i] = new(sql.NullInt64)
 		case taskrecord.FieldPrompt, taskrecord.FieldRole, taskrecord.FieldCompletion, taskrecord.FieldCode:
@@ -140,6 +148,22 @@ func (tr *TaskRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				tr.Code = value.String
 			}
+		case taskrecord.FieldFindings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field findings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tr.Findings); err != nil {
+					return fmt.Errorf("unmarshal field findings: %w", err)
+				}
+			}
+		case taskrecord.FieldMessages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field messages", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tr.Messages); err != nil {
+					return fmt.Errorf("unmarshal field messages: %w", err)
+				}
+			}
 		case taskrecord.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -214,6 +238,12 @@ func (tr *TaskRecord) String() string {
 	builder.WriteString("code=")
 	builder.WriteString(tr.Code)
 	builder.WriteString(", ")
+	builder.WriteString("findings=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Findings))
+	builder.WriteString(", ")
+	builder.WriteString("messages=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Messages))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -28,6 +28,10 @@ const (
 	FieldCodeLines = "code_lines"
 	// FieldCode holds the string denoting the code field in the database.
 	FieldCode = "code"
+	// FieldFindings holds the string denoting the findings field in the database.
+	FieldFindings = "findings"
+	// FieldMessages holds the string denoting the messages field in the database.
+	FieldMessages = "messages"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -55,6 +59,8 @@ var Columns = []string{
 	FieldOutputTokens,
 	FieldCodeLines,
 	FieldCode,
+	FieldFindings,
+	FieldMessages,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
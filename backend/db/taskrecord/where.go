@@ -522,6 +522,26 @@ func CodeContainsFold(v string) predicate.TaskRecord {
 	return predicate.TaskRecord(sql.FieldContainsFold(FieldCode, v))
 }
 
+// FindingsIsNil applies the IsNil predicate on the "findings" field.
+func FindingsIsNil() predicate.TaskRecord {
+	return predicate.TaskRecord(sql.FieldIsNull(FieldFindings))
+}
+
+// FindingsNotNil applies the NotNil predicate on the "findings" field.
+func FindingsNotNil() predicate.TaskRecord {
+	return predicate.TaskRecord(sql.FieldNotNull(FieldFindings))
+}
+
+// MessagesIsNil applies the IsNil predicate on the "messages" field.
+func MessagesIsNil() predicate.TaskRecord {
+	return predicate.TaskRecord(sql.FieldIsNull(FieldMessages))
+}
+
+// MessagesNotNil applies the NotNil predicate on the "messages" field.
+func MessagesNotNil() predicate.TaskRecord {
+	return predicate.TaskRecord(sql.FieldNotNull(FieldMessages))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.TaskRecord {
 	return predicate.TaskRecord(sql.FieldEQ(FieldCreatedAt, v))
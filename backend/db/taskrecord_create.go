@@ -15,6 +15,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -116,6 +117,18 @@ func (trc *TaskRecordCreate) SetNillableCode(s *string) *TaskRecordCreate {
 	return trc
 }
 
+// SetFindings sets the "findings" field.
+func (trc *TaskRecordCreate) SetFindings(tf []*types.CodeFinding) *TaskRecordCreate {
+	trc.mutation.SetFindings(tf)
+	return trc
+}
+
+// SetMessages sets the "messages" field.
+func (trc *TaskRecordCreate) SetMessages(tm []*types.ChoiceMessage) *TaskRecordCreate {
+	trc.mutation.SetMessages(tm)
+	return trc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (trc *TaskRecordCreate) SetCreatedAt(t time.Time) *TaskRecordCreate {
 	trc.mutation.SetCreatedAt(t)
@@ -285,6 +298,14 @@ func (trc *TaskRecordCreate) createSpec() (*TaskRecord, *sqlgraph.CreateSpec) {
 		_spec.SetField(taskrecord.FieldCode, field.TypeString, value)
 		_node.Code = value
 	}
+	if value, ok := trc.mutation.Findings(); ok {
+		_spec.SetField(taskrecord.FieldFindings, field.TypeJSON, value)
+		_node.Findings = value
+	}
+	if value, ok := trc.mutation.Messages(); ok {
+		_spec.SetField(taskrecord.FieldMessages, field.TypeJSON, value)
+		_node.Messages = value
+	}
 	if value, ok := trc.mutation.CreatedAt(); ok {
 		_spec.SetField(taskrecord.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -482,6 +503,42 @@ func (u *TaskRecordUpsert) ClearCode() *TaskRecordUpsert {
 	return u
 }
 
+// SetFindings sets the "findings" field.
+func (u *TaskRecordUpsert) SetFindings(v []*types.CodeFinding) *TaskRecordUpsert {
+	u.Set(taskrecord.FieldFindings, v)
+	return u
+}
+
+// UpdateFindings sets the "findings" field to the value that was provided on create.
+func (u *TaskRecordUpsert) UpdateFindings() *TaskRecordUpsert {
+	u.SetExcluded(taskrecord.FieldFindings)
+	return u
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (u *TaskRecordUpsert) ClearFindings() *TaskRecordUpsert {
+	u.SetNull(taskrecord.FieldFindings)
+	return u
+}
+
+// SetMessages sets the "messages" field.
+func (u *TaskRecordUpsert) SetMessages(v []*types.ChoiceMessage) *TaskRecordUpsert {
+	u.Set(taskrecord.FieldMessages, v)
+	return u
+}
+
+// UpdateMessages sets the "messages" field to the value that was provided on create.
+func (u *TaskRecordUpsert) UpdateMessages() *TaskRecordUpsert {
+	u.SetExcluded(taskrecord.FieldMessages)
+	return u
+}
+
+// ClearMessages clears the value of the "messages" field.
+func (u *TaskRecordUpsert) ClearMessages() *TaskRecordUpsert {
+	u.SetNull(taskrecord.FieldMessages)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *TaskRecordUpsert) SetCreatedAt(v time.Time) *TaskRecordUpsert {
 	u.Set(taskrecord.FieldCreatedAt, v)
@@ -694,6 +751,48 @@ func (u *TaskRecordUpsertOne) ClearCode() *TaskRecordUpsertOne {
 	})
 }
 
+// SetFindings sets the "findings" field.
+func (u *TaskRecordUpsertOne) SetFindings(v []*types.CodeFinding) *TaskRecordUpsertOne {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.SetFindings(v)
+	})
+}
+
+// UpdateFindings sets the "findings" field to the value that was provided on create.
+func (u *TaskRecordUpsertOne) UpdateFindings() *TaskRecordUpsertOne {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.UpdateFindings()
+	})
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (u *TaskRecordUpsertOne) ClearFindings() *TaskRecordUpsertOne {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.ClearFindings()
+	})
+}
+
+// SetMessages sets the "messages" field.
+func (u *TaskRecordUpsertOne) SetMessages(v []*types.ChoiceMessage) *TaskRecordUpsertOne {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.SetMessages(v)
+	})
+}
+
+// UpdateMessages sets the "messages" field to the value that was provided on create.
+func (u *TaskRecordUpsertOne) UpdateMessages() *TaskRecordUpsertOne {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.UpdateMessages()
+	})
+}
+
+// ClearMessages clears the value of the "messages" field.
+func (u *TaskRecordUpsertOne) ClearMessages() *TaskRecordUpsertOne {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.ClearMessages()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *TaskRecordUpsertOne) SetCreatedAt(v time.Time) *TaskRecordUpsertOne {
 	return u.Update(func(s *TaskRecordUpsert) {
@@ -1077,6 +1176,48 @@ func (u *TaskRecordUpsertBulk) ClearCode() *TaskRecordUpsertBulk {
 	})
 }
 
+// SetFindings sets the "findings" field.
+func (u *TaskRecordUpsertBulk) SetFindings(v []*types.CodeFinding) *TaskRecordUpsertBulk {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.SetFindings(v)
+	})
+}
+
+// UpdateFindings sets the "findings" field to the value that was provided on create.
+func (u *TaskRecordUpsertBulk) UpdateFindings() *TaskRecordUpsertBulk {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.UpdateFindings()
+	})
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (u *TaskRecordUpsertBulk) ClearFindings() *TaskRecordUpsertBulk {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.ClearFindings()
+	})
+}
+
+// SetMessages sets the "messages" field.
+func (u *TaskRecordUpsertBulk) SetMessages(v []*types.ChoiceMessage) *TaskRecordUpsertBulk {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.SetMessages(v)
+	})
+}
+
+// UpdateMessages sets the "messages" field to the value that was provided on create.
+func (u *TaskRecordUpsertBulk) UpdateMessages() *TaskRecordUpsertBulk {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.UpdateMessages()
+	})
+}
+
+// ClearMessages clears the value of the "messages" field.
+func (u *TaskRecordUpsertBulk) ClearMessages() *TaskRecordUpsertBulk {
+	return u.Update(func(s *TaskRecordUpsert) {
+		s.ClearMessages()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *TaskRecordUpsertBulk) SetCreatedAt(v time.Time) *TaskRecordUpsertBulk {
 	return u.Update(func(s *TaskRecordUpsert) {
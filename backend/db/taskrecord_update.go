@@ -10,11 +10,13 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db/predicate"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -168,6 +170,42 @@ func (tru *TaskRecordUpdate) ClearCode() *TaskRecordUpdate {
 	return tru
 }
 
+// SetFindings sets the "findings" field.
+func (tru *TaskRecordUpdate) SetFindings(tf []*types.CodeFinding) *TaskRecordUpdate {
+	tru.mutation.SetFindings(tf)
+	return tru
+}
+
+// AppendFindings appends tf to the "findings" field.
+func (tru *TaskRecordUpdate) AppendFindings(tf []*types.CodeFinding) *TaskRecordUpdate {
+	tru.mutation.AppendFindings(tf)
+	return tru
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (tru *TaskRecordUpdate) ClearFindings() *TaskRecordUpdate {
+	tru.mutation.ClearFindings()
+	return tru
+}
+
+// SetMessages sets the "messages" field.
+func (tru *TaskRecordUpdate) SetMessages(tm []*types.ChoiceMessage) *TaskRecordUpdate {
+	tru.mutation.SetMessages(tm)
+	return tru
+}
+
+// AppendMessages appends tm to the "messages" field.
+func (tru *TaskRecordUpdate) AppendMessages(tm []*types.ChoiceMessage) *TaskRecordUpdate {
+	tru.mutation.AppendMessages(tm)
+	return tru
+}
+
+// ClearMessages clears the value of the "messages" field.
+func (tru *TaskRecordUpdate) ClearMessages() *TaskRecordUpdate {
+	tru.mutation.ClearMessages()
+	return tru
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (tru *TaskRecordUpdate) SetCreatedAt(t time.Time) *TaskRecordUpdate {
 	tru.mutation.SetCreatedAt(t)
@@ -288,6 +326,28 @@ func (tru *TaskRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if tru.mutation.CodeCleared() {
 		_spec.ClearField(taskrecord.FieldCode, field.TypeString)
 	}
+	if value, ok := tru.mutation.Findings(); ok {
+		_spec.SetField(taskrecord.FieldFindings, field.TypeJSON, value)
+	}
+	if value, ok := tru.mutation.AppendedFindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskrecord.FieldFindings, value)
+		})
+	}
+	if tru.mutation.FindingsCleared() {
+		_spec.ClearField(taskrecord.FieldFindings, field.TypeJSON)
+	}
+	if value, ok := tru.mutation.Messages(); ok {
+		_spec.SetField(taskrecord.FieldMessages, field.TypeJSON, value)
+	}
+	if value, ok := tru.mutation.AppendedMessages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskrecord.FieldMessages, value)
+		})
+	}
+	if tru.mutation.MessagesCleared() {
+		_spec.ClearField(taskrecord.FieldMessages, field.TypeJSON)
+	}
 	if value, ok := tru.mutation.CreatedAt(); ok {
 		_spec.SetField(taskrecord.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -481,6 +541,42 @@ func (truo *TaskRecordUpdateOne) ClearCode() *TaskRecordUpdateOne {
 	return truo
 }
 
+// SetFindings sets the "findings" field.
+func (truo *TaskRecordUpdateOne) SetFindings(tf []*types.CodeFinding) *TaskRecordUpdateOne {
+	truo.mutation.SetFindings(tf)
+	return truo
+}
+
+// AppendFindings appends tf to the "findings" field.
+func (truo *TaskRecordUpdateOne) AppendFindings(tf []*types.CodeFinding) *TaskRecordUpdateOne {
+	truo.mutation.AppendFindings(tf)
+	return truo
+}
+
+// ClearFindings clears the value of the "findings" field.
+func (truo *TaskRecordUpdateOne) ClearFindings() *TaskRecordUpdateOne {
+	truo.mutation.ClearFindings()
+	return truo
+}
+
+// SetMessages sets the "messages" field.
+func (truo *TaskRecordUpdateOne) SetMessages(tm []*types.ChoiceMessage) *TaskRecordUpdateOne {
+	truo.mutation.SetMessages(tm)
+	return truo
+}
+
+// AppendMessages appends tm to the "messages" field.
+func (truo *TaskRecordUpdateOne) AppendMessages(tm []*types.ChoiceMessage) *TaskRecordUpdateOne {
+	truo.mutation.AppendMessages(tm)
+	return truo
+}
+
+// ClearMessages clears the value of the "messages" field.
+func (truo *TaskRecordUpdateOne) ClearMessages() *TaskRecordUpdateOne {
+	truo.mutation.ClearMessages()
+	return truo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (truo *TaskRecordUpdateOne) SetCreatedAt(t time.Time) *TaskRecordUpdateOne {
 	truo.mutation.SetCreatedAt(t)
@@ -631,6 +727,28 @@ func (truo *TaskRecordUpdateOne) sqlSave(ctx context.Context) (_node *TaskRecord
 	if truo.mutation.CodeCleared() {
 		_spec.ClearField(taskrecord.FieldCode, field.TypeString)
 	}
+	if value, ok := truo.mutation.Findings(); ok {
+		_spec.SetField(taskrecord.FieldFindings, field.TypeJSON, value)
+	}
+	if value, ok := truo.mutation.AppendedFindings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskrecord.FieldFindings, value)
+		})
+	}
+	if truo.mutation.FindingsCleared() {
+		_spec.ClearField(taskrecord.FieldFindings, field.TypeJSON)
+	}
+	if value, ok := truo.mutation.Messages(); ok {
+		_spec.SetField(taskrecord.FieldMessages, field.TypeJSON, value)
+	}
+	if value, ok := truo.mutation.AppendedMessages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, taskrecord.FieldMessages, value)
+		})
+	}
+	if truo.mutation.MessagesCleared() {
+		_spec.ClearField(taskrecord.FieldMessages, field.TypeJSON)
+	}
 	if value, ok := truo.mutation.CreatedAt(); ok {
 		_spec.SetField(taskrecord.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/google/uuid"
+)
+
+// TransformPolicy is the model entity for the TransformPolicy schema.
+type TransformPolicy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int64 `json:"version,omitempty"`
+	// Rules holds the value of the "rules" field.
+	Rules []*types.TransformRule `json:"rules,omitempty"`
+	// AdminID holds the value of the "admin_id" field.
+	AdminID uuid.UUID `json:"admin_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TransformPolicy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transformpolicy.FieldRules:
+			values[i] = new([]byte)
+		case transformpolicy.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case transformpolicy.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case transformpolicy.FieldID, transformpolicy.FieldAdminID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TransformPolicy fields.
+func (tp *TransformPolicy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transformpolicy.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tp.ID = *value
+			}
+		case transformpolicy.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				tp.Version = value.Int64
+			}
+		case transformpolicy.FieldRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tp.Rules); err != nil {
+					return fmt.Errorf("unmarshal field rules: %w", err)
+				}
+			}
+		case transformpolicy.FieldAdminID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field admin_id", values[i])
+			} else if value != nil {
+				tp.AdminID = *value
+			}
+		case transformpolicy.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tp.CreatedAt = value.Time
+			}
+		default:
+			tp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TransformPolicy.
+// This includes values selected through modifiers, order, etc.
+func (tp *TransformPolicy) Value(name string) (ent.Value, error) {
+	return tp.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TransformPolicy.
+// Note that you need to call TransformPolicy.Unwrap() before calling this method if this TransformPolicy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tp *TransformPolicy) Update() *TransformPolicyUpdateOne {
+	return NewTransformPolicyClient(tp.config).UpdateOne(tp)
+}
+
+// Unwrap unwraps the TransformPolicy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tp *TransformPolicy) Unwrap() *TransformPolicy {
+	_tx, ok := tp.config.driver.(*txDriver)
+	if !ok {
+		panic("db: TransformPolicy is not a transactional entity")
+	}
+	tp.config.driver = _tx.drv
+	return tp
+}
+
+// String implements the fmt.Stringer.
+func (tp *TransformPolicy) String() string {
+	var builder strings.Builder
+	builder.WriteString("TransformPolicy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tp.ID))
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", tp.Version))
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fmt.Sprintf("%v", tp.Rules))
+	builder.WriteString(", ")
+	builder.WriteString("admin_id=")
+	builder.WriteString(fmt.Sprintf("%v", tp.AdminID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tp.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TransformPolicies is a parsable slice of TransformPolicy.
+type TransformPolicies []*TransformPolicy
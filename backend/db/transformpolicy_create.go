@@ -0,0 +1,743 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/google/uuid"
+)
+
+// TransformPolicyCreate is the builder for creating a TransformPolicy entity.
+type TransformPolicyCreate struct {
+	config
+	mutation *TransformPolicyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVersion sets the "version" field.
+func (tpc *TransformPolicyCreate) SetVersion(i int64) *TransformPolicyCreate {
+	tpc.mutation.SetVersion(i)
+	return tpc
+}
+
+// SetRules sets the "rules" field.
+func (tpc *TransformPolicyCreate) SetRules(tr []*types.TransformRule) *TransformPolicyCreate {
+	tpc.mutation.SetRules(tr)
+	return tpc
+}
+
+// SetAdminID sets the "admin_id" field.
+func (tpc *TransformPolicyCreate) SetAdminID(u uuid.UUID) *TransformPolicyCreate {
+	tpc.mutation.SetAdminID(u)
+	return tpc
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (tpc *TransformPolicyCreate) SetNillableAdminID(u *uuid.UUID) *TransformPolicyCreate {
+	if u != nil {
+		tpc.SetAdminID(*u)
+	}
+	return tpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tpc *TransformPolicyCreate) SetCreatedAt(t time.Time) *TransformPolicyCreate {
+	tpc.mutation.SetCreatedAt(t)
+	return tpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tpc *TransformPolicyCreate) SetNillableCreatedAt(t *time.Time) *TransformPolicyCreate {
+	if t != nil {
+		tpc.SetCreatedAt(*t)
+	}
+	return tpc
+}
+
+// SetID sets the "id" field.
+func (tpc *TransformPolicyCreate) SetID(u uuid.UUID) *TransformPolicyCreate {
+	tpc.mutation.SetID(u)
+	return tpc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tpc *TransformPolicyCreate) SetNillableID(u *uuid.UUID) *TransformPolicyCreate {
+	if u != nil {
+		tpc.SetID(*u)
+	}
+	return tpc
+}
+
+// Mutation returns the TransformPolicyMutation object of the builder.
+func (tpc *TransformPolicyCreate) Mutation() *TransformPolicyMutation {
+	return tpc.mutation
+}
+
+// Save creates the TransformPolicy in the database.
+func (tpc *TransformPolicyCreate) Save(ctx context.Context) (*TransformPolicy, error) {
+	tpc.defaults()
+	return withHooks(ctx, tpc.sqlSave, tpc.mutation, tpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tpc *TransformPolicyCreate) SaveX(ctx context.Context) *TransformPolicy {
+	v, err := tpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tpc *TransformPolicyCreate) Exec(ctx context.Context) error {
+	_, err := tpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpc *TransformPolicyCreate) ExecX(ctx context.Context) {
+	if err := tpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tpc *TransformPolicyCreate) defaults() {
+	if _, ok := tpc.mutation.CreatedAt(); !ok {
+		v := transformpolicy.DefaultCreatedAt()
+		tpc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tpc.mutation.ID(); !ok {
+		v := transformpolicy.DefaultID()
+		tpc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tpc *TransformPolicyCreate) check() error {
+	if _, ok := tpc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`db: missing required field "TransformPolicy.version"`)}
+	}
+	if _, ok := tpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "TransformPolicy.created_at"`)}
+	}
+	return nil
+}
+
+func (tpc *TransformPolicyCreate) sqlSave(ctx context.Context) (*TransformPolicy, error) {
+	if err := tpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tpc.mutation.id = &_node.ID
+	tpc.mutation.done = true
+	return _node, nil
+}
+
+func (tpc *TransformPolicyCreate) createSpec() (*TransformPolicy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransformPolicy{config: tpc.config}
+		_spec = sqlgraph.NewCreateSpec(transformpolicy.Table, sqlgraph.NewFieldSpec(transformpolicy.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tpc.conflict
+	if id, ok := tpc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tpc.mutation.Version(); ok {
+		_spec.SetField(transformpolicy.FieldVersion, field.TypeInt64, value)
+		_node.Version = value
+	}
+	if value, ok := tpc.mutation.Rules(); ok {
+		_spec.SetField(transformpolicy.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
+	if value, ok := tpc.mutation.AdminID(); ok {
+		_spec.SetField(transformpolicy.FieldAdminID, field.TypeUUID, value)
+		_node.AdminID = value
+	}
+	if value, ok := tpc.mutation.CreatedAt(); ok {
+		_spec.SetField(transformpolicy.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransformPolicy.Create().
+//		SetVersion(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransformPolicyUpsert) {
+//			SetVersion(v+v).
+//		}).
+//		Exec(ctx)
+func (tpc *TransformPolicyCreate) OnConflict(opts ...sql.ConflictOption) *TransformPolicyUpsertOne {
+	tpc.conflict = opts
+	return &TransformPolicyUpsertOne{
+		create: tpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransformPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tpc *TransformPolicyCreate) OnConflictColumns(columns ...string) *TransformPolicyUpsertOne {
+	tpc.conflict = append(tpc.conflict, sql.ConflictColumns(columns...))
+	return &TransformPolicyUpsertOne{
+		create: tpc,
+	}
+}
+
+type (
+	// TransformPolicyUpsertOne is the builder for "upsert"-ing
+	//  one TransformPolicy node.
+	TransformPolicyUpsertOne struct {
+		create *TransformPolicyCreate
+	}
+
+	// TransformPolicyUpsert is the "OnConflict" setter.
+	TransformPolicyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVersion sets the "version" field.
+func (u *TransformPolicyUpsert) SetVersion(v int64) *TransformPolicyUpsert {
+	u.Set(transformpolicy.FieldVersion, v)
+	return u
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TransformPolicyUpsert) UpdateVersion() *TransformPolicyUpsert {
+	u.SetExcluded(transformpolicy.FieldVersion)
+	return u
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TransformPolicyUpsert) AddVersion(v int64) *TransformPolicyUpsert {
+	u.Add(transformpolicy.FieldVersion, v)
+	return u
+}
+
+// SetRules sets the "rules" field.
+func (u *TransformPolicyUpsert) SetRules(v []*types.TransformRule) *TransformPolicyUpsert {
+	u.Set(transformpolicy.FieldRules, v)
+	return u
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *TransformPolicyUpsert) UpdateRules() *TransformPolicyUpsert {
+	u.SetExcluded(transformpolicy.FieldRules)
+	return u
+}
+
+// ClearRules clears the value of the "rules" field.
+func (u *TransformPolicyUpsert) ClearRules() *TransformPolicyUpsert {
+	u.SetNull(transformpolicy.FieldRules)
+	return u
+}
+
+// SetAdminID sets the "admin_id" field.
+func (u *TransformPolicyUpsert) SetAdminID(v uuid.UUID) *TransformPolicyUpsert {
+	u.Set(transformpolicy.FieldAdminID, v)
+	return u
+}
+
+// UpdateAdminID sets the "admin_id" field to the value that was provided on create.
+func (u *TransformPolicyUpsert) UpdateAdminID() *TransformPolicyUpsert {
+	u.SetExcluded(transformpolicy.FieldAdminID)
+	return u
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (u *TransformPolicyUpsert) ClearAdminID() *TransformPolicyUpsert {
+	u.SetNull(transformpolicy.FieldAdminID)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TransformPolicyUpsert) SetCreatedAt(v time.Time) *TransformPolicyUpsert {
+	u.Set(transformpolicy.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TransformPolicyUpsert) UpdateCreatedAt() *TransformPolicyUpsert {
+	u.SetExcluded(transformpolicy.FieldCreatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TransformPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transformpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransformPolicyUpsertOne) UpdateNewValues() *TransformPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transformpolicy.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransformPolicy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransformPolicyUpsertOne) Ignore() *TransformPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransformPolicyUpsertOne) DoNothing() *TransformPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransformPolicyCreate.OnConflict
+// documentation for more info.
+func (u *TransformPolicyUpsertOne) Update(set func(*TransformPolicyUpsert)) *TransformPolicyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransformPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *TransformPolicyUpsertOne) SetVersion(v int64) *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TransformPolicyUpsertOne) AddVersion(v int64) *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TransformPolicyUpsertOne) UpdateVersion() *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *TransformPolicyUpsertOne) SetRules(v []*types.TransformRule) *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *TransformPolicyUpsertOne) UpdateRules() *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// ClearRules clears the value of the "rules" field.
+func (u *TransformPolicyUpsertOne) ClearRules() *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.ClearRules()
+	})
+}
+
+// SetAdminID sets the "admin_id" field.
+func (u *TransformPolicyUpsertOne) SetAdminID(v uuid.UUID) *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetAdminID(v)
+	})
+}
+
+// UpdateAdminID sets the "admin_id" field to the value that was provided on create.
+func (u *TransformPolicyUpsertOne) UpdateAdminID() *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateAdminID()
+	})
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (u *TransformPolicyUpsertOne) ClearAdminID() *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.ClearAdminID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TransformPolicyUpsertOne) SetCreatedAt(v time.Time) *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TransformPolicyUpsertOne) UpdateCreatedAt() *TransformPolicyUpsertOne {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransformPolicyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for TransformPolicyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransformPolicyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransformPolicyUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: TransformPolicyUpsertOne.ID is not supported by MySQL driver. Use TransformPolicyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransformPolicyUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransformPolicyCreateBulk is the builder for creating many TransformPolicy entities in bulk.
+type TransformPolicyCreateBulk struct {
+	config
+	err      error
+	builders []*TransformPolicyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TransformPolicy entities in the database.
+func (tpcb *TransformPolicyCreateBulk) Save(ctx context.Context) ([]*TransformPolicy, error) {
+	if tpcb.err != nil {
+		return nil, tpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tpcb.builders))
+	nodes := make([]*TransformPolicy, len(tpcb.builders))
+	mutators := make([]Mutator, len(tpcb.builders))
+	for i := range tpcb.builders {
+		func(i int, root context.Context) {
+			builder := tpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransformPolicyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpcb *TransformPolicyCreateBulk) SaveX(ctx context.Context) []*TransformPolicy {
+	v, err := tpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tpcb *TransformPolicyCreateBulk) Exec(ctx context.Context) error {
+	_, err := tpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpcb *TransformPolicyCreateBulk) ExecX(ctx context.Context) {
+	if err := tpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransformPolicy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransformPolicyUpsert) {
+//			SetVersion(v+v).
+//		}).
+//		Exec(ctx)
+func (tpcb *TransformPolicyCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransformPolicyUpsertBulk {
+	tpcb.conflict = opts
+	return &TransformPolicyUpsertBulk{
+		create: tpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransformPolicy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tpcb *TransformPolicyCreateBulk) OnConflictColumns(columns ...string) *TransformPolicyUpsertBulk {
+	tpcb.conflict = append(tpcb.conflict, sql.ConflictColumns(columns...))
+	return &TransformPolicyUpsertBulk{
+		create: tpcb,
+	}
+}
+
+// TransformPolicyUpsertBulk is the builder for "upsert"-ing
+// a bulk of TransformPolicy nodes.
+type TransformPolicyUpsertBulk struct {
+	create *TransformPolicyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TransformPolicy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transformpolicy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransformPolicyUpsertBulk) UpdateNewValues() *TransformPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transformpolicy.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransformPolicy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransformPolicyUpsertBulk) Ignore() *TransformPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransformPolicyUpsertBulk) DoNothing() *TransformPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransformPolicyCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransformPolicyUpsertBulk) Update(set func(*TransformPolicyUpsert)) *TransformPolicyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransformPolicyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVersion sets the "version" field.
+func (u *TransformPolicyUpsertBulk) SetVersion(v int64) *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetVersion(v)
+	})
+}
+
+// AddVersion adds v to the "version" field.
+func (u *TransformPolicyUpsertBulk) AddVersion(v int64) *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.AddVersion(v)
+	})
+}
+
+// UpdateVersion sets the "version" field to the value that was provided on create.
+func (u *TransformPolicyUpsertBulk) UpdateVersion() *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateVersion()
+	})
+}
+
+// SetRules sets the "rules" field.
+func (u *TransformPolicyUpsertBulk) SetRules(v []*types.TransformRule) *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetRules(v)
+	})
+}
+
+// UpdateRules sets the "rules" field to the value that was provided on create.
+func (u *TransformPolicyUpsertBulk) UpdateRules() *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateRules()
+	})
+}
+
+// ClearRules clears the value of the "rules" field.
+func (u *TransformPolicyUpsertBulk) ClearRules() *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.ClearRules()
+	})
+}
+
+// SetAdminID sets the "admin_id" field.
+func (u *TransformPolicyUpsertBulk) SetAdminID(v uuid.UUID) *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetAdminID(v)
+	})
+}
+
+// UpdateAdminID sets the "admin_id" field to the value that was provided on create.
+func (u *TransformPolicyUpsertBulk) UpdateAdminID() *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateAdminID()
+	})
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (u *TransformPolicyUpsertBulk) ClearAdminID() *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.ClearAdminID()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TransformPolicyUpsertBulk) SetCreatedAt(v time.Time) *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TransformPolicyUpsertBulk) UpdateCreatedAt() *TransformPolicyUpsertBulk {
+	return u.Update(func(s *TransformPolicyUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransformPolicyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the TransformPolicyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for TransformPolicyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransformPolicyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+)
+
+// TransformPolicyDelete is the builder for deleting a TransformPolicy entity.
+type TransformPolicyDelete struct {
+	config
+	hooks    []Hook
+	mutation *TransformPolicyMutation
+}
+
+// Where appends a list predicates to the TransformPolicyDelete builder.
+func (tpd *TransformPolicyDelete) Where(ps ...predicate.TransformPolicy) *TransformPolicyDelete {
+	tpd.mutation.Where(ps...)
+	return tpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tpd *TransformPolicyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tpd.sqlExec, tpd.mutation, tpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpd *TransformPolicyDelete) ExecX(ctx context.Context) int {
+	n, err := tpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tpd *TransformPolicyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(transformpolicy.Table, sqlgraph.NewFieldSpec(transformpolicy.FieldID, field.TypeUUID))
+	if ps := tpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tpd.mutation.done = true
+	return affected, err
+}
+
+// TransformPolicyDeleteOne is the builder for deleting a single TransformPolicy entity.
+type TransformPolicyDeleteOne struct {
+	tpd *TransformPolicyDelete
+}
+
+// Where appends a list predicates to the TransformPolicyDelete builder.
+func (tpdo *TransformPolicyDeleteOne) Where(ps ...predicate.TransformPolicy) *TransformPolicyDeleteOne {
+	tpdo.tpd.mutation.Where(ps...)
+	return tpdo
+}
+
+// Exec executes the deletion query.
+func (tpdo *TransformPolicyDeleteOne) Exec(ctx context.Context) error {
+	n, err := tpdo.tpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{transformpolicy.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpdo *TransformPolicyDeleteOne) ExecX(ctx context.Context) {
+	if err := tpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
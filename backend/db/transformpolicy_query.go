@@ -0,0 +1,578 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/google/uuid"
+)
+
+// TransformPolicyQuery is the builder for querying TransformPolicy entities.
+type TransformPolicyQuery struct {
+	config
+	ctx        *QueryContext
+	order      []transformpolicy.OrderOption
+	inters     []Interceptor
+	predicates []predicate.TransformPolicy
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TransformPolicyQuery builder.
+func (tpq *TransformPolicyQuery) Where(ps ...predicate.TransformPolicy) *TransformPolicyQuery {
+	tpq.predicates = append(tpq.predicates, ps...)
+	return tpq
+}
+
+// Limit the number of records to be returned by this query.
+func (tpq *TransformPolicyQuery) Limit(limit int) *TransformPolicyQuery {
+	tpq.ctx.Limit = &limit
+	return tpq
+}
+
+// Offset to start from.
+func (tpq *TransformPolicyQuery) Offset(offset int) *TransformPolicyQuery {
+	tpq.ctx.Offset = &offset
+	return tpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tpq *TransformPolicyQuery) Unique(unique bool) *TransformPolicyQuery {
+	tpq.ctx.Unique = &unique
+	return tpq
+}
+
+// Order specifies how the records should be ordered.
+func (tpq *TransformPolicyQuery) Order(o ...transformpolicy.OrderOption) *TransformPolicyQuery {
+	tpq.order = append(tpq.order, o...)
+	return tpq
+}
+
+// First returns the first TransformPolicy entity from the query.
+// Returns a *NotFoundError when no TransformPolicy was found.
+func (tpq *TransformPolicyQuery) First(ctx context.Context) (*TransformPolicy, error) {
+	nodes, err := tpq.Limit(1).All(setContextOp(ctx, tpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{transformpolicy.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) FirstX(ctx context.Context) *TransformPolicy {
+	node, err := tpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TransformPolicy ID from the query.
+// Returns a *NotFoundError when no TransformPolicy ID was found.
+func (tpq *TransformPolicyQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tpq.Limit(1).IDs(setContextOp(ctx, tpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{transformpolicy.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := tpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TransformPolicy entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TransformPolicy entity is found.
+// Returns a *NotFoundError when no TransformPolicy entities are found.
+func (tpq *TransformPolicyQuery) Only(ctx context.Context) (*TransformPolicy, error) {
+	nodes, err := tpq.Limit(2).All(setContextOp(ctx, tpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{transformpolicy.Label}
+	default:
+		return nil, &NotSingularError{transformpolicy.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) OnlyX(ctx context.Context) *TransformPolicy {
+	node, err := tpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TransformPolicy ID in the query.
+// Returns a *NotSingularError when more than one TransformPolicy ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tpq *TransformPolicyQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = tpq.Limit(2).IDs(setContextOp(ctx, tpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{transformpolicy.Label}
+	default:
+		err = &NotSingularError{transformpolicy.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := tpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TransformPolicies.
+func (tpq *TransformPolicyQuery) All(ctx context.Context) ([]*TransformPolicy, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryAll)
+	if err := tpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TransformPolicy, *TransformPolicyQuery]()
+	return withInterceptors[[]*TransformPolicy](ctx, tpq, qr, tpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) AllX(ctx context.Context) []*TransformPolicy {
+	nodes, err := tpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TransformPolicy IDs.
+func (tpq *TransformPolicyQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if tpq.ctx.Unique == nil && tpq.path != nil {
+		tpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryIDs)
+	if err = tpq.Select(transformpolicy.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := tpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tpq *TransformPolicyQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryCount)
+	if err := tpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tpq, querierCount[*TransformPolicyQuery](), tpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) CountX(ctx context.Context) int {
+	count, err := tpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tpq *TransformPolicyQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tpq.ctx, ent.OpQueryExist)
+	switch _, err := tpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("db: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tpq *TransformPolicyQuery) ExistX(ctx context.Context) bool {
+	exist, err := tpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TransformPolicyQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tpq *TransformPolicyQuery) Clone() *TransformPolicyQuery {
+	if tpq == nil {
+		return nil
+	}
+	return &TransformPolicyQuery{
+		config:     tpq.config,
+		ctx:        tpq.ctx.Clone(),
+		order:      append([]transformpolicy.OrderOption{}, tpq.order...),
+		inters:     append([]Interceptor{}, tpq.inters...),
+		predicates: append([]predicate.TransformPolicy{}, tpq.predicates...),
+		// clone intermediate query.
+		sql:       tpq.sql.Clone(),
+		path:      tpq.path,
+		modifiers: append([]func(*sql.Selector){}, tpq.modifiers...),
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Version int64 `json:"version,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TransformPolicy.Query().
+//		GroupBy(transformpolicy.FieldVersion).
+//		Aggregate(db.Count()).
+//		Scan(ctx, &v)
+func (tpq *TransformPolicyQuery) GroupBy(field string, fields ...string) *TransformPolicyGroupBy {
+	tpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TransformPolicyGroupBy{build: tpq}
+	grbuild.flds = &tpq.ctx.Fields
+	grbuild.label = transformpolicy.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Version int64 `json:"version,omitempty"`
+//	}
+//
+//	client.TransformPolicy.Query().
+//		Select(transformpolicy.FieldVersion).
+//		Scan(ctx, &v)
+func (tpq *TransformPolicyQuery) Select(fields ...string) *TransformPolicySelect {
+	tpq.ctx.Fields = append(tpq.ctx.Fields, fields...)
+	sbuild := &TransformPolicySelect{TransformPolicyQuery: tpq}
+	sbuild.label = transformpolicy.Label
+	sbuild.flds, sbuild.scan = &tpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TransformPolicySelect configured with the given aggregations.
+func (tpq *TransformPolicyQuery) Aggregate(fns ...AggregateFunc) *TransformPolicySelect {
+	return tpq.Select().Aggregate(fns...)
+}
+
+func (tpq *TransformPolicyQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tpq.inters {
+		if inter == nil {
+			return fmt.Errorf("db: uninitialized interceptor (forgotten import db/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tpq.ctx.Fields {
+		if !transformpolicy.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+		}
+	}
+	if tpq.path != nil {
+		prev, err := tpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tpq.sql = prev
+	}
+	return nil
+}
+
+func (tpq *TransformPolicyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TransformPolicy, error) {
+	var (
+		nodes = []*TransformPolicy{}
+		_spec = tpq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TransformPolicy).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TransformPolicy{config: tpq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	if len(tpq.modifiers) > 0 {
+		_spec.Modifiers = tpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (tpq *TransformPolicyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tpq.querySpec()
+	if len(tpq.modifiers) > 0 {
+		_spec.Modifiers = tpq.modifiers
+	}
+	_spec.Node.Columns = tpq.ctx.Fields
+	if len(tpq.ctx.Fields) > 0 {
+		_spec.Unique = tpq.ctx.Unique != nil && *tpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tpq.driver, _spec)
+}
+
+func (tpq *TransformPolicyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(transformpolicy.Table, transformpolicy.Columns, sqlgraph.NewFieldSpec(transformpolicy.FieldID, field.TypeUUID))
+	_spec.From = tpq.sql
+	if unique := tpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transformpolicy.FieldID)
+		for i := range fields {
+			if fields[i] != transformpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := tpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tpq *TransformPolicyQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tpq.driver.Dialect())
+	t1 := builder.Table(transformpolicy.Table)
+	columns := tpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = transformpolicy.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tpq.sql != nil {
+		selector = tpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tpq.ctx.Unique != nil && *tpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range tpq.modifiers {
+		m(selector)
+	}
+	for _, p := range tpq.predicates {
+		p(selector)
+	}
+	for _, p := range tpq.order {
+		p(selector)
+	}
+	if offset := tpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (tpq *TransformPolicyQuery) ForUpdate(opts ...sql.LockOption) *TransformPolicyQuery {
+	if tpq.driver.Dialect() == dialect.Postgres {
+		tpq.Unique(false)
+	}
+	tpq.modifiers = append(tpq.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return tpq
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (tpq *TransformPolicyQuery) ForShare(opts ...sql.LockOption) *TransformPolicyQuery {
+	if tpq.driver.Dialect() == dialect.Postgres {
+		tpq.Unique(false)
+	}
+	tpq.modifiers = append(tpq.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return tpq
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tpq *TransformPolicyQuery) Modify(modifiers ...func(s *sql.Selector)) *TransformPolicySelect {
+	tpq.modifiers = append(tpq.modifiers, modifiers...)
+	return tpq.Select()
+}
+
+// TransformPolicyGroupBy is the group-by builder for TransformPolicy entities.
+type TransformPolicyGroupBy struct {
+	selector
+	build *TransformPolicyQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tpgb *TransformPolicyGroupBy) Aggregate(fns ...AggregateFunc) *TransformPolicyGroupBy {
+	tpgb.fns = append(tpgb.fns, fns...)
+	return tpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tpgb *TransformPolicyGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TransformPolicyQuery, *TransformPolicyGroupBy](ctx, tpgb.build, tpgb, tpgb.build.inters, v)
+}
+
+func (tpgb *TransformPolicyGroupBy) sqlScan(ctx context.Context, root *TransformPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tpgb.fns))
+	for _, fn := range tpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tpgb.flds)+len(tpgb.fns))
+		for _, f := range *tpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TransformPolicySelect is the builder for selecting fields of TransformPolicy entities.
+type TransformPolicySelect struct {
+	*TransformPolicyQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tps *TransformPolicySelect) Aggregate(fns ...AggregateFunc) *TransformPolicySelect {
+	tps.fns = append(tps.fns, fns...)
+	return tps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tps *TransformPolicySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tps.ctx, ent.OpQuerySelect)
+	if err := tps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TransformPolicyQuery, *TransformPolicySelect](ctx, tps.TransformPolicyQuery, tps, tps.inters, v)
+}
+
+func (tps *TransformPolicySelect) sqlScan(ctx context.Context, root *TransformPolicyQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tps.fns))
+	for _, fn := range tps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (tps *TransformPolicySelect) Modify(modifiers ...func(s *sql.Selector)) *TransformPolicySelect {
+	tps.modifiers = append(tps.modifiers, modifiers...)
+	return tps
+}
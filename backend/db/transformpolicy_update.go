@@ -0,0 +1,393 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/google/uuid"
+)
+
+// TransformPolicyUpdate is the builder for updating TransformPolicy entities.
+type TransformPolicyUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransformPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransformPolicyUpdate builder.
+func (tpu *TransformPolicyUpdate) Where(ps ...predicate.TransformPolicy) *TransformPolicyUpdate {
+	tpu.mutation.Where(ps...)
+	return tpu
+}
+
+// SetVersion sets the "version" field.
+func (tpu *TransformPolicyUpdate) SetVersion(i int64) *TransformPolicyUpdate {
+	tpu.mutation.ResetVersion()
+	tpu.mutation.SetVersion(i)
+	return tpu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tpu *TransformPolicyUpdate) SetNillableVersion(i *int64) *TransformPolicyUpdate {
+	if i != nil {
+		tpu.SetVersion(*i)
+	}
+	return tpu
+}
+
+// AddVersion adds i to the "version" field.
+func (tpu *TransformPolicyUpdate) AddVersion(i int64) *TransformPolicyUpdate {
+	tpu.mutation.AddVersion(i)
+	return tpu
+}
+
+// SetRules sets the "rules" field.
+func (tpu *TransformPolicyUpdate) SetRules(tr []*types.TransformRule) *TransformPolicyUpdate {
+	tpu.mutation.SetRules(tr)
+	return tpu
+}
+
+// AppendRules appends tr to the "rules" field.
+func (tpu *TransformPolicyUpdate) AppendRules(tr []*types.TransformRule) *TransformPolicyUpdate {
+	tpu.mutation.AppendRules(tr)
+	return tpu
+}
+
+// ClearRules clears the value of the "rules" field.
+func (tpu *TransformPolicyUpdate) ClearRules() *TransformPolicyUpdate {
+	tpu.mutation.ClearRules()
+	return tpu
+}
+
+// SetAdminID sets the "admin_id" field.
+func (tpu *TransformPolicyUpdate) SetAdminID(u uuid.UUID) *TransformPolicyUpdate {
+	tpu.mutation.SetAdminID(u)
+	return tpu
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (tpu *TransformPolicyUpdate) SetNillableAdminID(u *uuid.UUID) *TransformPolicyUpdate {
+	if u != nil {
+		tpu.SetAdminID(*u)
+	}
+	return tpu
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (tpu *TransformPolicyUpdate) ClearAdminID() *TransformPolicyUpdate {
+	tpu.mutation.ClearAdminID()
+	return tpu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tpu *TransformPolicyUpdate) SetCreatedAt(t time.Time) *TransformPolicyUpdate {
+	tpu.mutation.SetCreatedAt(t)
+	return tpu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tpu *TransformPolicyUpdate) SetNillableCreatedAt(t *time.Time) *TransformPolicyUpdate {
+	if t != nil {
+		tpu.SetCreatedAt(*t)
+	}
+	return tpu
+}
+
+// Mutation returns the TransformPolicyMutation object of the builder.
+func (tpu *TransformPolicyUpdate) Mutation() *TransformPolicyMutation {
+	return tpu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tpu *TransformPolicyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tpu.sqlSave, tpu.mutation, tpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpu *TransformPolicyUpdate) SaveX(ctx context.Context) int {
+	affected, err := tpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tpu *TransformPolicyUpdate) Exec(ctx context.Context) error {
+	_, err := tpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpu *TransformPolicyUpdate) ExecX(ctx context.Context) {
+	if err := tpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tpu *TransformPolicyUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransformPolicyUpdate {
+	tpu.modifiers = append(tpu.modifiers, modifiers...)
+	return tpu
+}
+
+func (tpu *TransformPolicyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(transformpolicy.Table, transformpolicy.Columns, sqlgraph.NewFieldSpec(transformpolicy.FieldID, field.TypeUUID))
+	if ps := tpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tpu.mutation.Version(); ok {
+		_spec.SetField(transformpolicy.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := tpu.mutation.AddedVersion(); ok {
+		_spec.AddField(transformpolicy.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := tpu.mutation.Rules(); ok {
+		_spec.SetField(transformpolicy.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := tpu.mutation.AppendedRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transformpolicy.FieldRules, value)
+		})
+	}
+	if tpu.mutation.RulesCleared() {
+		_spec.ClearField(transformpolicy.FieldRules, field.TypeJSON)
+	}
+	if value, ok := tpu.mutation.AdminID(); ok {
+		_spec.SetField(transformpolicy.FieldAdminID, field.TypeUUID, value)
+	}
+	if tpu.mutation.AdminIDCleared() {
+		_spec.ClearField(transformpolicy.FieldAdminID, field.TypeUUID)
+	}
+	if value, ok := tpu.mutation.CreatedAt(); ok {
+		_spec.SetField(transformpolicy.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(tpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transformpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tpu.mutation.done = true
+	return n, nil
+}
+
+// TransformPolicyUpdateOne is the builder for updating a single TransformPolicy entity.
+type TransformPolicyUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransformPolicyMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetVersion sets the "version" field.
+func (tpuo *TransformPolicyUpdateOne) SetVersion(i int64) *TransformPolicyUpdateOne {
+	tpuo.mutation.ResetVersion()
+	tpuo.mutation.SetVersion(i)
+	return tpuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (tpuo *TransformPolicyUpdateOne) SetNillableVersion(i *int64) *TransformPolicyUpdateOne {
+	if i != nil {
+		tpuo.SetVersion(*i)
+	}
+	return tpuo
+}
+
+// AddVersion adds i to the "version" field.
+func (tpuo *TransformPolicyUpdateOne) AddVersion(i int64) *TransformPolicyUpdateOne {
+	tpuo.mutation.AddVersion(i)
+	return tpuo
+}
+
+// SetRules sets the "rules" field.
+func (tpuo *TransformPolicyUpdateOne) SetRules(tr []*types.TransformRule) *TransformPolicyUpdateOne {
+	tpuo.mutation.SetRules(tr)
+	return tpuo
+}
+
+// AppendRules appends tr to the "rules" field.
+func (tpuo *TransformPolicyUpdateOne) AppendRules(tr []*types.TransformRule) *TransformPolicyUpdateOne {
+	tpuo.mutation.AppendRules(tr)
+	return tpuo
+}
+
+// ClearRules clears the value of the "rules" field.
+func (tpuo *TransformPolicyUpdateOne) ClearRules() *TransformPolicyUpdateOne {
+	tpuo.mutation.ClearRules()
+	return tpuo
+}
+
+// SetAdminID sets the "admin_id" field.
+func (tpuo *TransformPolicyUpdateOne) SetAdminID(u uuid.UUID) *TransformPolicyUpdateOne {
+	tpuo.mutation.SetAdminID(u)
+	return tpuo
+}
+
+// SetNillableAdminID sets the "admin_id" field if the given value is not nil.
+func (tpuo *TransformPolicyUpdateOne) SetNillableAdminID(u *uuid.UUID) *TransformPolicyUpdateOne {
+	if u != nil {
+		tpuo.SetAdminID(*u)
+	}
+	return tpuo
+}
+
+// ClearAdminID clears the value of the "admin_id" field.
+func (tpuo *TransformPolicyUpdateOne) ClearAdminID() *TransformPolicyUpdateOne {
+	tpuo.mutation.ClearAdminID()
+	return tpuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tpuo *TransformPolicyUpdateOne) SetCreatedAt(t time.Time) *TransformPolicyUpdateOne {
+	tpuo.mutation.SetCreatedAt(t)
+	return tpuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tpuo *TransformPolicyUpdateOne) SetNillableCreatedAt(t *time.Time) *TransformPolicyUpdateOne {
+	if t != nil {
+		tpuo.SetCreatedAt(*t)
+	}
+	return tpuo
+}
+
+// Mutation returns the TransformPolicyMutation object of the builder.
+func (tpuo *TransformPolicyUpdateOne) Mutation() *TransformPolicyMutation {
+	return tpuo.mutation
+}
+
+// Where appends a list predicates to the TransformPolicyUpdate builder.
+func (tpuo *TransformPolicyUpdateOne) Where(ps ...predicate.TransformPolicy) *TransformPolicyUpdateOne {
+	tpuo.mutation.Where(ps...)
+	return tpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tpuo *TransformPolicyUpdateOne) Select(field string, fields ...string) *TransformPolicyUpdateOne {
+	tpuo.fields = append([]string{field}, fields...)
+	return tpuo
+}
+
+// Save executes the query and returns the updated TransformPolicy entity.
+func (tpuo *TransformPolicyUpdateOne) Save(ctx context.Context) (*TransformPolicy, error) {
+	return withHooks(ctx, tpuo.sqlSave, tpuo.mutation, tpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tpuo *TransformPolicyUpdateOne) SaveX(ctx context.Context) *TransformPolicy {
+	node, err := tpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tpuo *TransformPolicyUpdateOne) Exec(ctx context.Context) error {
+	_, err := tpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tpuo *TransformPolicyUpdateOne) ExecX(ctx context.Context) {
+	if err := tpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tpuo *TransformPolicyUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransformPolicyUpdateOne {
+	tpuo.modifiers = append(tpuo.modifiers, modifiers...)
+	return tpuo
+}
+
+func (tpuo *TransformPolicyUpdateOne) sqlSave(ctx context.Context) (_node *TransformPolicy, err error) {
+	_spec := sqlgraph.NewUpdateSpec(transformpolicy.Table, transformpolicy.Columns, sqlgraph.NewFieldSpec(transformpolicy.FieldID, field.TypeUUID))
+	id, ok := tpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "TransformPolicy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transformpolicy.FieldID)
+		for _, f := range fields {
+			if !transformpolicy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != transformpolicy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tpuo.mutation.Version(); ok {
+		_spec.SetField(transformpolicy.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := tpuo.mutation.AddedVersion(); ok {
+		_spec.AddField(transformpolicy.FieldVersion, field.TypeInt64, value)
+	}
+	if value, ok := tpuo.mutation.Rules(); ok {
+		_spec.SetField(transformpolicy.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := tpuo.mutation.AppendedRules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, transformpolicy.FieldRules, value)
+		})
+	}
+	if tpuo.mutation.RulesCleared() {
+		_spec.ClearField(transformpolicy.FieldRules, field.TypeJSON)
+	}
+	if value, ok := tpuo.mutation.AdminID(); ok {
+		_spec.SetField(transformpolicy.FieldAdminID, field.TypeUUID, value)
+	}
+	if tpuo.mutation.AdminIDCleared() {
+		_spec.ClearField(transformpolicy.FieldAdminID, field.TypeUUID)
+	}
+	if value, ok := tpuo.mutation.CreatedAt(); ok {
+		_spec.SetField(transformpolicy.FieldCreatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(tpuo.modifiers...)
+	_node = &TransformPolicy{config: tpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transformpolicy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tpuo.mutation.done = true
+	return _node, nil
+}
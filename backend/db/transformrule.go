@@ -0,0 +1,194 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/google/uuid"
+)
+
+// TransformRule is the model entity for the TransformRule schema.
+type TransformRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// ModelID holds the value of the "model_id" field.
+	ModelID uuid.UUID `json:"model_id,omitempty"`
+	// GroupID holds the value of the "group_id" field.
+	GroupID uuid.UUID `json:"group_id,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority int `json:"priority,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Config holds the value of the "config" field.
+	Config *types.TransformConfig `json:"config,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TransformRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case transformrule.FieldConfig:
+			values[i] = new([]byte)
+		case transformrule.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case transformrule.FieldPriority:
+			values[i] = new(sql.NullInt64)
+		case transformrule.FieldName:
+			values[i] = new(sql.NullString)
+		case transformrule.FieldCreatedAt, transformrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case transformrule.FieldID, transformrule.FieldModelID, transformrule.FieldGroupID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TransformRule fields.
+func (tr *TransformRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case transformrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				tr.ID = *value
+			}
+		case transformrule.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tr.Name = value.String
+			}
+		case transformrule.FieldModelID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field model_id", values[i])
+			} else if value != nil {
+				tr.ModelID = *value
+			}
+		case transformrule.FieldGroupID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field group_id", values[i])
+			} else if value != nil {
+				tr.GroupID = *value
+			}
+		case transformrule.FieldPriority:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				tr.Priority = int(value.Int64)
+			}
+		case transformrule.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				tr.Enabled = value.Bool
+			}
+		case transformrule.FieldConfig:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field config", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tr.Config); err != nil {
+					return fmt.Errorf("unmarshal field config: %w", err)
+				}
+			}
+		case transformrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		case transformrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tr.UpdatedAt = value.Time
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TransformRule.
+// This includes values selected through modifiers, order, etc.
+func (tr *TransformRule) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TransformRule.
+// Note that you need to call TransformRule.Unwrap() before calling this method if this TransformRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TransformRule) Update() *TransformRuleUpdateOne {
+	return NewTransformRuleClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TransformRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TransformRule) Unwrap() *TransformRule {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("db: TransformRule is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TransformRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("TransformRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("name=")
+	builder.WriteString(tr.Name)
+	builder.WriteString(", ")
+	builder.WriteString("model_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.ModelID))
+	builder.WriteString(", ")
+	builder.WriteString("group_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.GroupID))
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("config=")
+	builder.WriteString(fmt.Sprintf("%v", tr.Config))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TransformRules is a parsable slice of TransformRule.
+type TransformRules []*TransformRule
@@ -0,0 +1,1004 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/google/uuid"
+)
+
+// TransformRuleCreate is the builder for creating a TransformRule entity.
+type TransformRuleCreate struct {
+	config
+	mutation *TransformRuleMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetName sets the "name" field.
+func (trc *TransformRuleCreate) SetName(s string) *TransformRuleCreate {
+	trc.mutation.SetName(s)
+	return trc
+}
+
+// SetModelID sets the "model_id" field.
+func (trc *TransformRuleCreate) SetModelID(u uuid.UUID) *TransformRuleCreate {
+	trc.mutation.SetModelID(u)
+	return trc
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillableModelID(u *uuid.UUID) *TransformRuleCreate {
+	if u != nil {
+		trc.SetModelID(*u)
+	}
+	return trc
+}
+
+// SetGroupID sets the "group_id" field.
+func (trc *TransformRuleCreate) SetGroupID(u uuid.UUID) *TransformRuleCreate {
+	trc.mutation.SetGroupID(u)
+	return trc
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillableGroupID(u *uuid.UUID) *TransformRuleCreate {
+	if u != nil {
+		trc.SetGroupID(*u)
+	}
+	return trc
+}
+
+// SetPriority sets the "priority" field.
+func (trc *TransformRuleCreate) SetPriority(i int) *TransformRuleCreate {
+	trc.mutation.SetPriority(i)
+	return trc
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillablePriority(i *int) *TransformRuleCreate {
+	if i != nil {
+		trc.SetPriority(*i)
+	}
+	return trc
+}
+
+// SetEnabled sets the "enabled" field.
+func (trc *TransformRuleCreate) SetEnabled(b bool) *TransformRuleCreate {
+	trc.mutation.SetEnabled(b)
+	return trc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillableEnabled(b *bool) *TransformRuleCreate {
+	if b != nil {
+		trc.SetEnabled(*b)
+	}
+	return trc
+}
+
+// SetConfig sets the "config" field.
+func (trc *TransformRuleCreate) SetConfig(tc *types.TransformConfig) *TransformRuleCreate {
+	trc.mutation.SetConfig(tc)
+	return trc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trc *TransformRuleCreate) SetCreatedAt(t time.Time) *TransformRuleCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillableCreatedAt(t *time.Time) *TransformRuleCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trc *TransformRuleCreate) SetUpdatedAt(t time.Time) *TransformRuleCreate {
+	trc.mutation.SetUpdatedAt(t)
+	return trc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillableUpdatedAt(t *time.Time) *TransformRuleCreate {
+	if t != nil {
+		trc.SetUpdatedAt(*t)
+	}
+	return trc
+}
+
+// SetID sets the "id" field.
+func (trc *TransformRuleCreate) SetID(u uuid.UUID) *TransformRuleCreate {
+	trc.mutation.SetID(u)
+	return trc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (trc *TransformRuleCreate) SetNillableID(u *uuid.UUID) *TransformRuleCreate {
+	if u != nil {
+		trc.SetID(*u)
+	}
+	return trc
+}
+
+// Mutation returns the TransformRuleMutation object of the builder.
+func (trc *TransformRuleCreate) Mutation() *TransformRuleMutation {
+	return trc.mutation
+}
+
+// Save creates the TransformRule in the database.
+func (trc *TransformRuleCreate) Save(ctx context.Context) (*TransformRule, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TransformRuleCreate) SaveX(ctx context.Context) *TransformRule {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TransformRuleCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TransformRuleCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TransformRuleCreate) defaults() {
+	if _, ok := trc.mutation.Priority(); !ok {
+		v := transformrule.DefaultPriority
+		trc.mutation.SetPriority(v)
+	}
+	if _, ok := trc.mutation.Enabled(); !ok {
+		v := transformrule.DefaultEnabled
+		trc.mutation.SetEnabled(v)
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		v := transformrule.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		v := transformrule.DefaultUpdatedAt()
+		trc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := trc.mutation.ID(); !ok {
+		v := transformrule.DefaultID()
+		trc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TransformRuleCreate) check() error {
+	if _, ok := trc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`db: missing required field "TransformRule.name"`)}
+	}
+	if v, ok := trc.mutation.Name(); ok {
+		if err := transformrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`db: validator failed for field "TransformRule.name": %w`, err)}
+		}
+	}
+	if _, ok := trc.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`db: missing required field "TransformRule.priority"`)}
+	}
+	if _, ok := trc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`db: missing required field "TransformRule.enabled"`)}
+	}
+	if _, ok := trc.mutation.Config(); !ok {
+		return &ValidationError{Name: "config", err: errors.New(`db: missing required field "TransformRule.config"`)}
+	}
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`db: missing required field "TransformRule.created_at"`)}
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`db: missing required field "TransformRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (trc *TransformRuleCreate) sqlSave(ctx context.Context) (*TransformRule, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TransformRuleCreate) createSpec() (*TransformRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TransformRule{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(transformrule.Table, sqlgraph.NewFieldSpec(transformrule.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = trc.conflict
+	if id, ok := trc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := trc.mutation.Name(); ok {
+		_spec.SetField(transformrule.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := trc.mutation.ModelID(); ok {
+		_spec.SetField(transformrule.FieldModelID, field.TypeUUID, value)
+		_node.ModelID = value
+	}
+	if value, ok := trc.mutation.GroupID(); ok {
+		_spec.SetField(transformrule.FieldGroupID, field.TypeUUID, value)
+		_node.GroupID = value
+	}
+	if value, ok := trc.mutation.Priority(); ok {
+		_spec.SetField(transformrule.FieldPriority, field.TypeInt, value)
+		_node.Priority = value
+	}
+	if value, ok := trc.mutation.Enabled(); ok {
+		_spec.SetField(transformrule.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := trc.mutation.Config(); ok {
+		_spec.SetField(transformrule.FieldConfig, field.TypeJSON, value)
+		_node.Config = value
+	}
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.SetField(transformrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := trc.mutation.UpdatedAt(); ok {
+		_spec.SetField(transformrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransformRule.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransformRuleUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (trc *TransformRuleCreate) OnConflict(opts ...sql.ConflictOption) *TransformRuleUpsertOne {
+	trc.conflict = opts
+	return &TransformRuleUpsertOne{
+		create: trc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransformRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trc *TransformRuleCreate) OnConflictColumns(columns ...string) *TransformRuleUpsertOne {
+	trc.conflict = append(trc.conflict, sql.ConflictColumns(columns...))
+	return &TransformRuleUpsertOne{
+		create: trc,
+	}
+}
+
+type (
+	// TransformRuleUpsertOne is the builder for "upsert"-ing
+	//  one TransformRule node.
+	TransformRuleUpsertOne struct {
+		create *TransformRuleCreate
+	}
+
+	// TransformRuleUpsert is the "OnConflict" setter.
+	TransformRuleUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *TransformRuleUpsert) SetName(v string) *TransformRuleUpsert {
+	u.Set(transformrule.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateName() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldName)
+	return u
+}
+
+// SetModelID sets the "model_id" field.
+func (u *TransformRuleUpsert) SetModelID(v uuid.UUID) *TransformRuleUpsert {
+	u.Set(transformrule.FieldModelID, v)
+	return u
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateModelID() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldModelID)
+	return u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *TransformRuleUpsert) ClearModelID() *TransformRuleUpsert {
+	u.SetNull(transformrule.FieldModelID)
+	return u
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *TransformRuleUpsert) SetGroupID(v uuid.UUID) *TransformRuleUpsert {
+	u.Set(transformrule.FieldGroupID, v)
+	return u
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateGroupID() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldGroupID)
+	return u
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *TransformRuleUpsert) ClearGroupID() *TransformRuleUpsert {
+	u.SetNull(transformrule.FieldGroupID)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *TransformRuleUpsert) SetPriority(v int) *TransformRuleUpsert {
+	u.Set(transformrule.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdatePriority() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TransformRuleUpsert) AddPriority(v int) *TransformRuleUpsert {
+	u.Add(transformrule.FieldPriority, v)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TransformRuleUpsert) SetEnabled(v bool) *TransformRuleUpsert {
+	u.Set(transformrule.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateEnabled() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldEnabled)
+	return u
+}
+
+// SetConfig sets the "config" field.
+func (u *TransformRuleUpsert) SetConfig(v *types.TransformConfig) *TransformRuleUpsert {
+	u.Set(transformrule.FieldConfig, v)
+	return u
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateConfig() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldConfig)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TransformRuleUpsert) SetCreatedAt(v time.Time) *TransformRuleUpsert {
+	u.Set(transformrule.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateCreatedAt() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransformRuleUpsert) SetUpdatedAt(v time.Time) *TransformRuleUpsert {
+	u.Set(transformrule.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransformRuleUpsert) UpdateUpdatedAt() *TransformRuleUpsert {
+	u.SetExcluded(transformrule.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TransformRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transformrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransformRuleUpsertOne) UpdateNewValues() *TransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(transformrule.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransformRule.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TransformRuleUpsertOne) Ignore() *TransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransformRuleUpsertOne) DoNothing() *TransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransformRuleCreate.OnConflict
+// documentation for more info.
+func (u *TransformRuleUpsertOne) Update(set func(*TransformRuleUpsert)) *TransformRuleUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransformRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TransformRuleUpsertOne) SetName(v string) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateName() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *TransformRuleUpsertOne) SetModelID(v uuid.UUID) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateModelID() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *TransformRuleUpsertOne) ClearModelID() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.ClearModelID()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *TransformRuleUpsertOne) SetGroupID(v uuid.UUID) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateGroupID() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *TransformRuleUpsertOne) ClearGroupID() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TransformRuleUpsertOne) SetPriority(v int) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TransformRuleUpsertOne) AddPriority(v int) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdatePriority() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TransformRuleUpsertOne) SetEnabled(v bool) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateEnabled() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetConfig sets the "config" field.
+func (u *TransformRuleUpsertOne) SetConfig(v *types.TransformConfig) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetConfig(v)
+	})
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateConfig() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateConfig()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TransformRuleUpsertOne) SetCreatedAt(v time.Time) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateCreatedAt() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransformRuleUpsertOne) SetUpdatedAt(v time.Time) *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransformRuleUpsertOne) UpdateUpdatedAt() *TransformRuleUpsertOne {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransformRuleUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for TransformRuleCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransformRuleUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TransformRuleUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("db: TransformRuleUpsertOne.ID is not supported by MySQL driver. Use TransformRuleUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TransformRuleUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TransformRuleCreateBulk is the builder for creating many TransformRule entities in bulk.
+type TransformRuleCreateBulk struct {
+	config
+	err      error
+	builders []*TransformRuleCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TransformRule entities in the database.
+func (trcb *TransformRuleCreateBulk) Save(ctx context.Context) ([]*TransformRule, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TransformRule, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TransformRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TransformRuleCreateBulk) SaveX(ctx context.Context) []*TransformRule {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TransformRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TransformRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TransformRule.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TransformRuleUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (trcb *TransformRuleCreateBulk) OnConflict(opts ...sql.ConflictOption) *TransformRuleUpsertBulk {
+	trcb.conflict = opts
+	return &TransformRuleUpsertBulk{
+		create: trcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TransformRule.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcb *TransformRuleCreateBulk) OnConflictColumns(columns ...string) *TransformRuleUpsertBulk {
+	trcb.conflict = append(trcb.conflict, sql.ConflictColumns(columns...))
+	return &TransformRuleUpsertBulk{
+		create: trcb,
+	}
+}
+
+// TransformRuleUpsertBulk is the builder for "upsert"-ing
+// a bulk of TransformRule nodes.
+type TransformRuleUpsertBulk struct {
+	create *TransformRuleCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TransformRule.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(transformrule.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TransformRuleUpsertBulk) UpdateNewValues() *TransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(transformrule.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TransformRule.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TransformRuleUpsertBulk) Ignore() *TransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TransformRuleUpsertBulk) DoNothing() *TransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TransformRuleCreateBulk.OnConflict
+// documentation for more info.
+func (u *TransformRuleUpsertBulk) Update(set func(*TransformRuleUpsert)) *TransformRuleUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TransformRuleUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TransformRuleUpsertBulk) SetName(v string) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateName() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *TransformRuleUpsertBulk) SetModelID(v uuid.UUID) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateModelID() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *TransformRuleUpsertBulk) ClearModelID() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.ClearModelID()
+	})
+}
+
+// SetGroupID sets the "group_id" field.
+func (u *TransformRuleUpsertBulk) SetGroupID(v uuid.UUID) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetGroupID(v)
+	})
+}
+
+// UpdateGroupID sets the "group_id" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateGroupID() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateGroupID()
+	})
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (u *TransformRuleUpsertBulk) ClearGroupID() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.ClearGroupID()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TransformRuleUpsertBulk) SetPriority(v int) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TransformRuleUpsertBulk) AddPriority(v int) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdatePriority() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *TransformRuleUpsertBulk) SetEnabled(v bool) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateEnabled() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetConfig sets the "config" field.
+func (u *TransformRuleUpsertBulk) SetConfig(v *types.TransformConfig) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetConfig(v)
+	})
+}
+
+// UpdateConfig sets the "config" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateConfig() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateConfig()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *TransformRuleUpsertBulk) SetCreatedAt(v time.Time) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateCreatedAt() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TransformRuleUpsertBulk) SetUpdatedAt(v time.Time) *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TransformRuleUpsertBulk) UpdateUpdatedAt() *TransformRuleUpsertBulk {
+	return u.Update(func(s *TransformRuleUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *TransformRuleUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("db: OnConflict was set for builder %d. Set it on the TransformRuleCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("db: missing options for TransformRuleCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TransformRuleUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
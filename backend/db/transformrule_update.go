@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/google/uuid"
+)
+
+// TransformRuleUpdate is the builder for updating TransformRule entities.
+type TransformRuleUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TransformRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TransformRuleUpdate builder.
+func (tru *TransformRuleUpdate) Where(ps ...predicate.TransformRule) *TransformRuleUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetName sets the "name" field.
+func (tru *TransformRuleUpdate) SetName(s string) *TransformRuleUpdate {
+	tru.mutation.SetName(s)
+	return tru
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tru *TransformRuleUpdate) SetNillableName(s *string) *TransformRuleUpdate {
+	if s != nil {
+		tru.SetName(*s)
+	}
+	return tru
+}
+
+// SetModelID sets the "model_id" field.
+func (tru *TransformRuleUpdate) SetModelID(u uuid.UUID) *TransformRuleUpdate {
+	tru.mutation.SetModelID(u)
+	return tru
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (tru *TransformRuleUpdate) SetNillableModelID(u *uuid.UUID) *TransformRuleUpdate {
+	if u != nil {
+		tru.SetModelID(*u)
+	}
+	return tru
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (tru *TransformRuleUpdate) ClearModelID() *TransformRuleUpdate {
+	tru.mutation.ClearModelID()
+	return tru
+}
+
+// SetGroupID sets the "group_id" field.
+func (tru *TransformRuleUpdate) SetGroupID(u uuid.UUID) *TransformRuleUpdate {
+	tru.mutation.SetGroupID(u)
+	return tru
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (tru *TransformRuleUpdate) SetNillableGroupID(u *uuid.UUID) *TransformRuleUpdate {
+	if u != nil {
+		tru.SetGroupID(*u)
+	}
+	return tru
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (tru *TransformRuleUpdate) ClearGroupID() *TransformRuleUpdate {
+	tru.mutation.ClearGroupID()
+	return tru
+}
+
+// SetPriority sets the "priority" field.
+func (tru *TransformRuleUpdate) SetPriority(i int) *TransformRuleUpdate {
+	tru.mutation.ResetPriority()
+	tru.mutation.SetPriority(i)
+	return tru
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (tru *TransformRuleUpdate) SetNillablePriority(i *int) *TransformRuleUpdate {
+	if i != nil {
+		tru.SetPriority(*i)
+	}
+	return tru
+}
+
+// AddPriority adds i to the "priority" field.
+func (tru *TransformRuleUpdate) AddPriority(i int) *TransformRuleUpdate {
+	tru.mutation.AddPriority(i)
+	return tru
+}
+
+// SetEnabled sets the "enabled" field.
+func (tru *TransformRuleUpdate) SetEnabled(b bool) *TransformRuleUpdate {
+	tru.mutation.SetEnabled(b)
+	return tru
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (tru *TransformRuleUpdate) SetNillableEnabled(b *bool) *TransformRuleUpdate {
+	if b != nil {
+		tru.SetEnabled(*b)
+	}
+	return tru
+}
+
+// SetConfig sets the "config" field.
+func (tru *TransformRuleUpdate) SetConfig(tc *types.TransformConfig) *TransformRuleUpdate {
+	tru.mutation.SetConfig(tc)
+	return tru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tru *TransformRuleUpdate) SetCreatedAt(t time.Time) *TransformRuleUpdate {
+	tru.mutation.SetCreatedAt(t)
+	return tru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tru *TransformRuleUpdate) SetNillableCreatedAt(t *time.Time) *TransformRuleUpdate {
+	if t != nil {
+		tru.SetCreatedAt(*t)
+	}
+	return tru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tru *TransformRuleUpdate) SetUpdatedAt(t time.Time) *TransformRuleUpdate {
+	tru.mutation.SetUpdatedAt(t)
+	return tru
+}
+
+// Mutation returns the TransformRuleMutation object of the builder.
+func (tru *TransformRuleUpdate) Mutation() *TransformRuleMutation {
+	return tru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TransformRuleUpdate) Save(ctx context.Context) (int, error) {
+	tru.defaults()
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TransformRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TransformRuleUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TransformRuleUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tru *TransformRuleUpdate) defaults() {
+	if _, ok := tru.mutation.UpdatedAt(); !ok {
+		v := transformrule.UpdateDefaultUpdatedAt()
+		tru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tru *TransformRuleUpdate) check() error {
+	if v, ok := tru.mutation.Name(); ok {
+		if err := transformrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`db: validator failed for field "TransformRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (tru *TransformRuleUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransformRuleUpdate {
+	tru.modifiers = append(tru.modifiers, modifiers...)
+	return tru
+}
+
+func (tru *TransformRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transformrule.Table, transformrule.Columns, sqlgraph.NewFieldSpec(transformrule.FieldID, field.TypeUUID))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.Name(); ok {
+		_spec.SetField(transformrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := tru.mutation.ModelID(); ok {
+		_spec.SetField(transformrule.FieldModelID, field.TypeUUID, value)
+	}
+	if tru.mutation.ModelIDCleared() {
+		_spec.ClearField(transformrule.FieldModelID, field.TypeUUID)
+	}
+	if value, ok := tru.mutation.GroupID(); ok {
+		_spec.SetField(transformrule.FieldGroupID, field.TypeUUID, value)
+	}
+	if tru.mutation.GroupIDCleared() {
+		_spec.ClearField(transformrule.FieldGroupID, field.TypeUUID)
+	}
+	if value, ok := tru.mutation.Priority(); ok {
+		_spec.SetField(transformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedPriority(); ok {
+		_spec.AddField(transformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.Enabled(); ok {
+		_spec.SetField(transformrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := tru.mutation.Config(); ok {
+		_spec.SetField(transformrule.FieldConfig, field.TypeJSON, value)
+	}
+	if value, ok := tru.mutation.CreatedAt(); ok {
+		_spec.SetField(transformrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.UpdatedAt(); ok {
+		_spec.SetField(transformrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(tru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transformrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TransformRuleUpdateOne is the builder for updating a single TransformRule entity.
+type TransformRuleUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TransformRuleMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetName sets the "name" field.
+func (truo *TransformRuleUpdateOne) SetName(s string) *TransformRuleUpdateOne {
+	truo.mutation.SetName(s)
+	return truo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (truo *TransformRuleUpdateOne) SetNillableName(s *string) *TransformRuleUpdateOne {
+	if s != nil {
+		truo.SetName(*s)
+	}
+	return truo
+}
+
+// SetModelID sets the "model_id" field.
+func (truo *TransformRuleUpdateOne) SetModelID(u uuid.UUID) *TransformRuleUpdateOne {
+	truo.mutation.SetModelID(u)
+	return truo
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (truo *TransformRuleUpdateOne) SetNillableModelID(u *uuid.UUID) *TransformRuleUpdateOne {
+	if u != nil {
+		truo.SetModelID(*u)
+	}
+	return truo
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (truo *TransformRuleUpdateOne) ClearModelID() *TransformRuleUpdateOne {
+	truo.mutation.ClearModelID()
+	return truo
+}
+
+// SetGroupID sets the "group_id" field.
+func (truo *TransformRuleUpdateOne) SetGroupID(u uuid.UUID) *TransformRuleUpdateOne {
+	truo.mutation.SetGroupID(u)
+	return truo
+}
+
+// SetNillableGroupID sets the "group_id" field if the given value is not nil.
+func (truo *TransformRuleUpdateOne) SetNillableGroupID(u *uuid.UUID) *TransformRuleUpdateOne {
+	if u != nil {
+		truo.SetGroupID(*u)
+	}
+	return truo
+}
+
+// ClearGroupID clears the value of the "group_id" field.
+func (truo *TransformRuleUpdateOne) ClearGroupID() *TransformRuleUpdateOne {
+	truo.mutation.ClearGroupID()
+	return truo
+}
+
+// SetPriority sets the "priority" field.
+func (truo *TransformRuleUpdateOne) SetPriority(i int) *TransformRuleUpdateOne {
+	truo.mutation.ResetPriority()
+	truo.mutation.SetPriority(i)
+	return truo
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (truo *TransformRuleUpdateOne) SetNillablePriority(i *int) *TransformRuleUpdateOne {
+	if i != nil {
+		truo.SetPriority(*i)
+	}
+	return truo
+}
+
+// AddPriority adds i to the "priority" field.
+func (truo *TransformRuleUpdateOne) AddPriority(i int) *TransformRuleUpdateOne {
+	truo.mutation.AddPriority(i)
+	return truo
+}
+
+// SetEnabled sets the "enabled" field.
+func (truo *TransformRuleUpdateOne) SetEnabled(b bool) *TransformRuleUpdateOne {
+	truo.mutation.SetEnabled(b)
+	return truo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (truo *TransformRuleUpdateOne) SetNillableEnabled(b *bool) *TransformRuleUpdateOne {
+	if b != nil {
+		truo.SetEnabled(*b)
+	}
+	return truo
+}
+
+// SetConfig sets the "config" field.
+func (truo *TransformRuleUpdateOne) SetConfig(tc *types.TransformConfig) *TransformRuleUpdateOne {
+	truo.mutation.SetConfig(tc)
+	return truo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (truo *TransformRuleUpdateOne) SetCreatedAt(t time.Time) *TransformRuleUpdateOne {
+	truo.mutation.SetCreatedAt(t)
+	return truo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (truo *TransformRuleUpdateOne) SetNillableCreatedAt(t *time.Time) *TransformRuleUpdateOne {
+	if t != nil {
+		truo.SetCreatedAt(*t)
+	}
+	return truo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (truo *TransformRuleUpdateOne) SetUpdatedAt(t time.Time) *TransformRuleUpdateOne {
+	truo.mutation.SetUpdatedAt(t)
+	return truo
+}
+
+// Mutation returns the TransformRuleMutation object of the builder.
+func (truo *TransformRuleUpdateOne) Mutation() *TransformRuleMutation {
+	return truo.mutation
+}
+
+// Where appends a list predicates to the TransformRuleUpdate builder.
+func (truo *TransformRuleUpdateOne) Where(ps ...predicate.TransformRule) *TransformRuleUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TransformRuleUpdateOne) Select(field string, fields ...string) *TransformRuleUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TransformRule entity.
+func (truo *TransformRuleUpdateOne) Save(ctx context.Context) (*TransformRule, error) {
+	truo.defaults()
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TransformRuleUpdateOne) SaveX(ctx context.Context) *TransformRule {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TransformRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TransformRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (truo *TransformRuleUpdateOne) defaults() {
+	if _, ok := truo.mutation.UpdatedAt(); !ok {
+		v := transformrule.UpdateDefaultUpdatedAt()
+		truo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (truo *TransformRuleUpdateOne) check() error {
+	if v, ok := truo.mutation.Name(); ok {
+		if err := transformrule.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`db: validator failed for field "TransformRule.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (truo *TransformRuleUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TransformRuleUpdateOne {
+	truo.modifiers = append(truo.modifiers, modifiers...)
+	return truo
+}
+
+func (truo *TransformRuleUpdateOne) sqlSave(ctx context.Context) (_node *TransformRule, err error) {
+	if err := truo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(transformrule.Table, transformrule.Columns, sqlgraph.NewFieldSpec(transformrule.FieldID, field.TypeUUID))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`db: missing "TransformRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, transformrule.FieldID)
+		for _, f := range fields {
+			if !transformrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("db: invalid field %q for query", f)}
+			}
+			if f != transformrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.Name(); ok {
+		_spec.SetField(transformrule.FieldName, field.TypeString, value)
+	}
+	if value, ok := truo.mutation.ModelID(); ok {
+		_spec.SetField(transformrule.FieldModelID, field.TypeUUID, value)
+	}
+	if truo.mutation.ModelIDCleared() {
+		_spec.ClearField(transformrule.FieldModelID, field.TypeUUID)
+	}
+	if value, ok := truo.mutation.GroupID(); ok {
+		_spec.SetField(transformrule.FieldGroupID, field.TypeUUID, value)
+	}
+	if truo.mutation.GroupIDCleared() {
+		_spec.ClearField(transformrule.FieldGroupID, field.TypeUUID)
+	}
+	if value, ok := truo.mutation.Priority(); ok {
+		_spec.SetField(transformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedPriority(); ok {
+		_spec.AddField(transformrule.FieldPriority, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.Enabled(); ok {
+		_spec.SetField(transformrule.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := truo.mutation.Config(); ok {
+		_spec.SetField(transformrule.FieldConfig, field.TypeJSON, value)
+	}
+	if value, ok := truo.mutation.CreatedAt(); ok {
+		_spec.SetField(transformrule.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.UpdatedAt(); ok {
+		_spec.SetField(transformrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_spec.AddModifiers(truo.modifiers...)
+	_node = &TransformRule{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{transformrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
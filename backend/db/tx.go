@@ -22,6 +22,8 @@ type Tx struct {
 	AdminRole *AdminRoleClient
 	// ApiKey is the client for interacting with the ApiKey builders.
 	ApiKey *ApiKeyClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
 	// BillingPlan is the client for interacting with the BillingPlan builders.
 	BillingPlan *BillingPlanClient
 	// BillingQuota is the client for interacting with the BillingQuota builders.
@@ -30,8 +32,12 @@ type Tx struct {
 	BillingRecord *BillingRecordClient
 	// BillingUsage is the client for interacting with the BillingUsage builders.
 	BillingUsage *BillingUsageClient
+	// BudgetAlert is the client for interacting with the BudgetAlert builders.
+	BudgetAlert *BudgetAlertClient
 	// CodeSnippet is the client for interacting with the CodeSnippet builders.
 	CodeSnippet *CodeSnippetClient
+	// DLPHit is the client for interacting with the DLPHit builders.
+	DLPHit *DLPHitClient
 	// Extension is the client for interacting with the Extension builders.
 	Extension *ExtensionClient
 	// InviteCode is the client for interacting with the InviteCode builders.
@@ -40,22 +46,40 @@ type Tx struct {
 	License *LicenseClient
 	// Model is the client for interacting with the Model builders.
 	Model *ModelClient
+	// ModelHealthCheck is the client for interacting with the ModelHealthCheck builders.
+	ModelHealthCheck *ModelHealthCheckClient
 	// ModelProvider is the client for interacting with the ModelProvider builders.
 	ModelProvider *ModelProviderClient
 	// ModelProviderModel is the client for interacting with the ModelProviderModel builders.
 	ModelProviderModel *ModelProviderModelClient
+	// ResponseCache is the client for interacting with the ResponseCache builders.
+	ResponseCache *ResponseCacheClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SecurityRulePack is the client for interacting with the SecurityRulePack builders.
+	SecurityRulePack *SecurityRulePackClient
+	// SecurityRulePackVersion is the client for interacting with the SecurityRulePackVersion builders.
+	SecurityRulePackVersion *SecurityRulePackVersionClient
 	// SecurityScanning is the client for interacting with the SecurityScanning builders.
 	SecurityScanning *SecurityScanningClient
+	// SecurityScanningComment is the client for interacting with the SecurityScanningComment builders.
+	SecurityScanningComment *SecurityScanningCommentClient
+	// SecurityScanningPolicy is the client for interacting with the SecurityScanningPolicy builders.
+	SecurityScanningPolicy *SecurityScanningPolicyClient
 	// SecurityScanningResult is the client for interacting with the SecurityScanningResult builders.
 	SecurityScanningResult *SecurityScanningResultClient
+	// SecurityScanningSuppression is the client for interacting with the SecurityScanningSuppression builders.
+	SecurityScanningSuppression *SecurityScanningSuppressionClient
 	// Setting is the client for interacting with the Setting builders.
 	Setting *SettingClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
 	// TaskRecord is the client for interacting with the TaskRecord builders.
 	TaskRecord *TaskRecordClient
+	// TransformPolicy is the client for interacting with the TransformPolicy builders.
+	TransformPolicy *TransformPolicyClient
+	// TransformRule is the client for interacting with the TransformRule builders.
+	TransformRule *TransformRuleClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserGroup is the client for interacting with the UserGroup builders.
@@ -207,23 +231,35 @@ func (tx *Tx) init() {
 	tx.AdminLoginHistory = NewAdminLoginHistoryClient(tx.config)
 	tx.AdminRole = NewAdminRoleClient(tx.config)
 	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
 	tx.BillingPlan = NewBillingPlanClient(tx.config)
 	tx.BillingQuota = NewBillingQuotaClient(tx.config)
 	tx.BillingRecord = NewBillingRecordClient(tx.config)
 	tx.BillingUsage = NewBillingUsageClient(tx.config)
+	tx.BudgetAlert = NewBudgetAlertClient(tx.config)
 	tx.CodeSnippet = NewCodeSnippetClient(tx.config)
+	tx.DLPHit = NewDLPHitClient(tx.config)
 	tx.Extension = NewExtensionClient(tx.config)
 	tx.InviteCode = NewInviteCodeClient(tx.config)
 	tx.License = NewLicenseClient(tx.config)
 	tx.Model = NewModelClient(tx.config)
+	tx.ModelHealthCheck = NewModelHealthCheckClient(tx.config)
 	tx.ModelProvider = NewModelProviderClient(tx.config)
 	tx.ModelProviderModel = NewModelProviderModelClient(tx.config)
+	tx.ResponseCache = NewResponseCacheClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.SecurityRulePack = NewSecurityRulePackClient(tx.config)
+	tx.SecurityRulePackVersion = NewSecurityRulePackVersionClient(tx.config)
 	tx.SecurityScanning = NewSecurityScanningClient(tx.config)
+	tx.SecurityScanningComment = NewSecurityScanningCommentClient(tx.config)
+	tx.SecurityScanningPolicy = NewSecurityScanningPolicyClient(tx.config)
 	tx.SecurityScanningResult = NewSecurityScanningResultClient(tx.config)
+	tx.SecurityScanningSuppression = NewSecurityScanningSuppressionClient(tx.config)
 	tx.Setting = NewSettingClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
 	tx.TaskRecord = NewTaskRecordClient(tx.config)
+	tx.TransformPolicy = NewTransformPolicyClient(tx.config)
+	tx.TransformRule = NewTransformRuleClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserGroup = NewUserGroupClient(tx.config)
 	tx.UserGroupAdmin = NewUserGroupAdminClient(tx.config)
@@ -3,6 +3,7 @@
 package db
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/chaitin/MonkeyCode/backend/db/admin"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +25,14 @@ type UserGroup struct {
 	AdminID uuid.UUID `json:"admin_id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// RateLimit holds the value of the "rate_limit" field.
+	RateLimit *types.RateLimit `json:"rate_limit,omitempty"`
+	// AuditRetention holds the value of the "audit_retention" field.
+	AuditRetention *types.AuditRetention `json:"audit_retention,omitempty"`
+	// Budget holds the value of the "budget" field.
+	Budget *types.GroupBudget `json:"budget,omitempty"`
+	// PlanID holds the value of the "plan_id" field.
+	PlanID string `json:"plan_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -100,7 +110,9 @@ func (*UserGroup) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case usergroup.FieldName:
+		case usergroup.FieldRateLimit, usergroup.FieldAuditRetention, usergroup.FieldBudget:
+			values[i] = new([]byte)
+		case usergroup.FieldName, usergroup.FieldPlanID:
 			values[i] = new(sql.NullString)
 		case usergroup.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -139,6 +151,36 @@ func (ug *UserGroup) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ug.Name = value.String
 			}
+		case usergroup.FieldRateLimit:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ug.RateLimit); err != nil {
+					return fmt.Errorf("unmarshal field rate_limit: %w", err)
+				}
+			}
+		case usergroup.FieldAuditRetention:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field audit_retention", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ug.AuditRetention); err != nil {
+					return fmt.Errorf("unmarshal field audit_retention: %w", err)
+				}
+			}
+		case usergroup.FieldBudget:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field budget", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ug.Budget); err != nil {
+					return fmt.Errorf("unmarshal field budget: %w", err)
+				}
+			}
+		case usergroup.FieldPlanID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				ug.PlanID = value.String
+			}
 		case usergroup.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -212,6 +254,18 @@ func (ug *UserGroup) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(ug.Name)
 	builder.WriteString(", ")
+	builder.WriteString("rate_limit=")
+	builder.WriteString(fmt.Sprintf("%v", ug.RateLimit))
+	builder.WriteString(", ")
+	builder.WriteString("audit_retention=")
+	builder.WriteString(fmt.Sprintf("%v", ug.AuditRetention))
+	builder.WriteString(", ")
+	builder.WriteString("budget=")
+	builder.WriteString(fmt.Sprintf("%v", ug.Budget))
+	builder.WriteString(", ")
+	builder.WriteString("plan_id=")
+	builder.WriteString(ug.PlanID)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(ug.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
@@ -18,6 +18,14 @@ const (
 	FieldAdminID = "admin_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldRateLimit holds the string denoting the rate_limit field in the database.
+	FieldRateLimit = "rate_limit"
+	// FieldAuditRetention holds the string denoting the audit_retention field in the database.
+	FieldAuditRetention = "audit_retention"
+	// FieldBudget holds the string denoting the budget field in the database.
+	FieldBudget = "budget"
+	// FieldPlanID holds the string denoting the plan_id field in the database.
+	FieldPlanID = "plan_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
@@ -70,6 +78,10 @@ var Columns = []string{
 	FieldID,
 	FieldAdminID,
 	FieldName,
+	FieldRateLimit,
+	FieldAuditRetention,
+	FieldBudget,
+	FieldPlanID,
 	FieldCreatedAt,
 }
 
@@ -117,6 +129,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByPlanID orders the results by the plan_id field.
+func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
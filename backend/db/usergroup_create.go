@@ -17,6 +17,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupadmin"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupuser"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -40,6 +41,38 @@ func (ugc *UserGroupCreate) SetName(s string) *UserGroupCreate {
 	return ugc
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (ugc *UserGroupCreate) SetRateLimit(tl *types.RateLimit) *UserGroupCreate {
+	ugc.mutation.SetRateLimit(tl)
+	return ugc
+}
+
+// SetAuditRetention sets the "audit_retention" field.
+func (ugc *UserGroupCreate) SetAuditRetention(tr *types.AuditRetention) *UserGroupCreate {
+	ugc.mutation.SetAuditRetention(tr)
+	return ugc
+}
+
+// SetBudget sets the "budget" field.
+func (ugc *UserGroupCreate) SetBudget(tb *types.GroupBudget) *UserGroupCreate {
+	ugc.mutation.SetBudget(tb)
+	return ugc
+}
+
+// SetPlanID sets the "plan_id" field.
+func (ugc *UserGroupCreate) SetPlanID(s string) *UserGroupCreate {
+	ugc.mutation.SetPlanID(s)
+	return ugc
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (ugc *UserGroupCreate) SetNillablePlanID(s *string) *UserGroupCreate {
+	if s != nil {
+		ugc.SetPlanID(*s)
+	}
+	return ugc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ugc *UserGroupCreate) SetCreatedAt(t time.Time) *UserGroupCreate {
 	ugc.mutation.SetCreatedAt(t)
@@ -231,6 +264,22 @@ func (ugc *UserGroupCreate) createSpec() (*UserGroup, *sqlgraph.CreateSpec) {
 		_spec.SetField(usergroup.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := ugc.mutation.RateLimit(); ok {
+		_spec.SetField(usergroup.FieldRateLimit, field.TypeJSON, value)
+		_node.RateLimit = value
+	}
+	if value, ok := ugc.mutation.AuditRetention(); ok {
+		_spec.SetField(usergroup.FieldAuditRetention, field.TypeJSON, value)
+		_node.AuditRetention = value
+	}
+	if value, ok := ugc.mutation.Budget(); ok {
+		_spec.SetField(usergroup.FieldBudget, field.TypeJSON, value)
+		_node.Budget = value
+	}
+	if value, ok := ugc.mutation.PlanID(); ok {
+		_spec.SetField(usergroup.FieldPlanID, field.TypeString, value)
+		_node.PlanID = value
+	}
 	if value, ok := ugc.mutation.CreatedAt(); ok {
 		_spec.SetField(usergroup.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -392,6 +441,78 @@ func (u *UserGroupUpsert) UpdateName() *UserGroupUpsert {
 	return u
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (u *UserGroupUpsert) SetRateLimit(v *types.RateLimit) *UserGroupUpsert {
+	u.Set(usergroup.FieldRateLimit, v)
+	return u
+}
+
+// UpdateRateLimit sets the "rate_limit" field to the value that was provided on create.
+func (u *UserGroupUpsert) UpdateRateLimit() *UserGroupUpsert {
+	u.SetExcluded(usergroup.FieldRateLimit)
+	return u
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (u *UserGroupUpsert) ClearRateLimit() *UserGroupUpsert {
+	u.SetNull(usergroup.FieldRateLimit)
+	return u
+}
+
+// SetAuditRetention sets the "audit_retention" field.
+func (u *UserGroupUpsert) SetAuditRetention(v *types.AuditRetention) *UserGroupUpsert {
+	u.Set(usergroup.FieldAuditRetention, v)
+	return u
+}
+
+// UpdateAuditRetention sets the "audit_retention" field to the value that was provided on create.
+func (u *UserGroupUpsert) UpdateAuditRetention() *UserGroupUpsert {
+	u.SetExcluded(usergroup.FieldAuditRetention)
+	return u
+}
+
+// ClearAuditRetention clears the value of the "audit_retention" field.
+func (u *UserGroupUpsert) ClearAuditRetention() *UserGroupUpsert {
+	u.SetNull(usergroup.FieldAuditRetention)
+	return u
+}
+
+// SetBudget sets the "budget" field.
+func (u *UserGroupUpsert) SetBudget(v *types.GroupBudget) *UserGroupUpsert {
+	u.Set(usergroup.FieldBudget, v)
+	return u
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *UserGroupUpsert) UpdateBudget() *UserGroupUpsert {
+	u.SetExcluded(usergroup.FieldBudget)
+	return u
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (u *UserGroupUpsert) ClearBudget() *UserGroupUpsert {
+	u.SetNull(usergroup.FieldBudget)
+	return u
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *UserGroupUpsert) SetPlanID(v string) *UserGroupUpsert {
+	u.Set(usergroup.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *UserGroupUpsert) UpdatePlanID() *UserGroupUpsert {
+	u.SetExcluded(usergroup.FieldPlanID)
+	return u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *UserGroupUpsert) ClearPlanID() *UserGroupUpsert {
+	u.SetNull(usergroup.FieldPlanID)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserGroupUpsert) SetCreatedAt(v time.Time) *UserGroupUpsert {
 	u.Set(usergroup.FieldCreatedAt, v)
@@ -480,6 +601,90 @@ func (u *UserGroupUpsertOne) UpdateName() *UserGroupUpsertOne {
 	})
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (u *UserGroupUpsertOne) SetRateLimit(v *types.RateLimit) *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetRateLimit(v)
+	})
+}
+
+// UpdateRateLimit sets the "rate_limit" field to the value that was provided on create.
+func (u *UserGroupUpsertOne) UpdateRateLimit() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdateRateLimit()
+	})
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (u *UserGroupUpsertOne) ClearRateLimit() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearRateLimit()
+	})
+}
+
+// SetAuditRetention sets the "audit_retention" field.
+func (u *UserGroupUpsertOne) SetAuditRetention(v *types.AuditRetention) *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetAuditRetention(v)
+	})
+}
+
+// UpdateAuditRetention sets the "audit_retention" field to the value that was provided on create.
+func (u *UserGroupUpsertOne) UpdateAuditRetention() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdateAuditRetention()
+	})
+}
+
+// ClearAuditRetention clears the value of the "audit_retention" field.
+func (u *UserGroupUpsertOne) ClearAuditRetention() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearAuditRetention()
+	})
+}
+
+// SetBudget sets the "budget" field.
+func (u *UserGroupUpsertOne) SetBudget(v *types.GroupBudget) *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetBudget(v)
+	})
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *UserGroupUpsertOne) UpdateBudget() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdateBudget()
+	})
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (u *UserGroupUpsertOne) ClearBudget() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearBudget()
+	})
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *UserGroupUpsertOne) SetPlanID(v string) *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *UserGroupUpsertOne) UpdatePlanID() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *UserGroupUpsertOne) ClearPlanID() *UserGroupUpsertOne {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserGroupUpsertOne) SetCreatedAt(v time.Time) *UserGroupUpsertOne {
 	return u.Update(func(s *UserGroupUpsert) {
@@ -737,6 +942,90 @@ func (u *UserGroupUpsertBulk) UpdateName() *UserGroupUpsertBulk {
 	})
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (u *UserGroupUpsertBulk) SetRateLimit(v *types.RateLimit) *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetRateLimit(v)
+	})
+}
+
+// UpdateRateLimit sets the "rate_limit" field to the value that was provided on create.
+func (u *UserGroupUpsertBulk) UpdateRateLimit() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdateRateLimit()
+	})
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (u *UserGroupUpsertBulk) ClearRateLimit() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearRateLimit()
+	})
+}
+
+// SetAuditRetention sets the "audit_retention" field.
+func (u *UserGroupUpsertBulk) SetAuditRetention(v *types.AuditRetention) *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetAuditRetention(v)
+	})
+}
+
+// UpdateAuditRetention sets the "audit_retention" field to the value that was provided on create.
+func (u *UserGroupUpsertBulk) UpdateAuditRetention() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdateAuditRetention()
+	})
+}
+
+// ClearAuditRetention clears the value of the "audit_retention" field.
+func (u *UserGroupUpsertBulk) ClearAuditRetention() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearAuditRetention()
+	})
+}
+
+// SetBudget sets the "budget" field.
+func (u *UserGroupUpsertBulk) SetBudget(v *types.GroupBudget) *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetBudget(v)
+	})
+}
+
+// UpdateBudget sets the "budget" field to the value that was provided on create.
+func (u *UserGroupUpsertBulk) UpdateBudget() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdateBudget()
+	})
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (u *UserGroupUpsertBulk) ClearBudget() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearBudget()
+	})
+}
+
+// SetPlanID sets the "plan_id" field.
+func (u *UserGroupUpsertBulk) SetPlanID(v string) *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *UserGroupUpsertBulk) UpdatePlanID() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *UserGroupUpsertBulk) ClearPlanID() *UserGroupUpsertBulk {
+	return u.Update(func(s *UserGroupUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *UserGroupUpsertBulk) SetCreatedAt(v time.Time) *UserGroupUpsertBulk {
 	return u.Update(func(s *UserGroupUpsert) {
@@ -17,6 +17,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupadmin"
 	"github.com/chaitin/MonkeyCode/backend/db/usergroupuser"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
 )
 
@@ -62,6 +63,62 @@ func (ugu *UserGroupUpdate) SetNillableName(s *string) *UserGroupUpdate {
 	return ugu
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (ugu *UserGroupUpdate) SetRateLimit(tl *types.RateLimit) *UserGroupUpdate {
+	ugu.mutation.SetRateLimit(tl)
+	return ugu
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (ugu *UserGroupUpdate) ClearRateLimit() *UserGroupUpdate {
+	ugu.mutation.ClearRateLimit()
+	return ugu
+}
+
+// SetAuditRetention sets the "audit_retention" field.
+func (ugu *UserGroupUpdate) SetAuditRetention(tr *types.AuditRetention) *UserGroupUpdate {
+	ugu.mutation.SetAuditRetention(tr)
+	return ugu
+}
+
+// ClearAuditRetention clears the value of the "audit_retention" field.
+func (ugu *UserGroupUpdate) ClearAuditRetention() *UserGroupUpdate {
+	ugu.mutation.ClearAuditRetention()
+	return ugu
+}
+
+// SetBudget sets the "budget" field.
+func (ugu *UserGroupUpdate) SetBudget(tb *types.GroupBudget) *UserGroupUpdate {
+	ugu.mutation.SetBudget(tb)
+	return ugu
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (ugu *UserGroupUpdate) ClearBudget() *UserGroupUpdate {
+	ugu.mutation.ClearBudget()
+	return ugu
+}
+
+// SetPlanID sets the "plan_id" field.
+func (ugu *UserGroupUpdate) SetPlanID(s string) *UserGroupUpdate {
+	ugu.mutation.SetPlanID(s)
+	return ugu
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (ugu *UserGroupUpdate) SetNillablePlanID(s *string) *UserGroupUpdate {
+	if s != nil {
+		ugu.SetPlanID(*s)
+	}
+	return ugu
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (ugu *UserGroupUpdate) ClearPlanID() *UserGroupUpdate {
+	ugu.mutation.ClearPlanID()
+	return ugu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ugu *UserGroupUpdate) SetCreatedAt(t time.Time) *UserGroupUpdate {
 	ugu.mutation.SetCreatedAt(t)
@@ -303,6 +360,30 @@ func (ugu *UserGroupUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ugu.mutation.Name(); ok {
 		_spec.SetField(usergroup.FieldName, field.TypeString, value)
 	}
+	if value, ok := ugu.mutation.RateLimit(); ok {
+		_spec.SetField(usergroup.FieldRateLimit, field.TypeJSON, value)
+	}
+	if ugu.mutation.RateLimitCleared() {
+		_spec.ClearField(usergroup.FieldRateLimit, field.TypeJSON)
+	}
+	if value, ok := ugu.mutation.AuditRetention(); ok {
+		_spec.SetField(usergroup.FieldAuditRetention, field.TypeJSON, value)
+	}
+	if ugu.mutation.AuditRetentionCleared() {
+		_spec.ClearField(usergroup.FieldAuditRetention, field.TypeJSON)
+	}
+	if value, ok := ugu.mutation.Budget(); ok {
+		_spec.SetField(usergroup.FieldBudget, field.TypeJSON, value)
+	}
+	if ugu.mutation.BudgetCleared() {
+		_spec.ClearField(usergroup.FieldBudget, field.TypeJSON)
+	}
+	if value, ok := ugu.mutation.PlanID(); ok {
+		_spec.SetField(usergroup.FieldPlanID, field.TypeString, value)
+	}
+	if ugu.mutation.PlanIDCleared() {
+		_spec.ClearField(usergroup.FieldPlanID, field.TypeString)
+	}
 	if value, ok := ugu.mutation.CreatedAt(); ok {
 		_spec.SetField(usergroup.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -565,6 +646,62 @@ func (uguo *UserGroupUpdateOne) SetNillableName(s *string) *UserGroupUpdateOne {
 	return uguo
 }
 
+// SetRateLimit sets the "rate_limit" field.
+func (uguo *UserGroupUpdateOne) SetRateLimit(tl *types.RateLimit) *UserGroupUpdateOne {
+	uguo.mutation.SetRateLimit(tl)
+	return uguo
+}
+
+// ClearRateLimit clears the value of the "rate_limit" field.
+func (uguo *UserGroupUpdateOne) ClearRateLimit() *UserGroupUpdateOne {
+	uguo.mutation.ClearRateLimit()
+	return uguo
+}
+
+// SetAuditRetention sets the "audit_retention" field.
+func (uguo *UserGroupUpdateOne) SetAuditRetention(tr *types.AuditRetention) *UserGroupUpdateOne {
+	uguo.mutation.SetAuditRetention(tr)
+	return uguo
+}
+
+// ClearAuditRetention clears the value of the "audit_retention" field.
+func (uguo *UserGroupUpdateOne) ClearAuditRetention() *UserGroupUpdateOne {
+	uguo.mutation.ClearAuditRetention()
+	return uguo
+}
+
+// SetBudget sets the "budget" field.
+func (uguo *UserGroupUpdateOne) SetBudget(tb *types.GroupBudget) *UserGroupUpdateOne {
+	uguo.mutation.SetBudget(tb)
+	return uguo
+}
+
+// ClearBudget clears the value of the "budget" field.
+func (uguo *UserGroupUpdateOne) ClearBudget() *UserGroupUpdateOne {
+	uguo.mutation.ClearBudget()
+	return uguo
+}
+
+// SetPlanID sets the "plan_id" field.
+func (uguo *UserGroupUpdateOne) SetPlanID(s string) *UserGroupUpdateOne {
+	uguo.mutation.SetPlanID(s)
+	return uguo
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (uguo *UserGroupUpdateOne) SetNillablePlanID(s *string) *UserGroupUpdateOne {
+	if s != nil {
+		uguo.SetPlanID(*s)
+	}
+	return uguo
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (uguo *UserGroupUpdateOne) ClearPlanID() *UserGroupUpdateOne {
+	uguo.mutation.ClearPlanID()
+	return uguo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uguo *UserGroupUpdateOne) SetCreatedAt(t time.Time) *UserGroupUpdateOne {
 	uguo.mutation.SetCreatedAt(t)
@@ -836,6 +973,30 @@ func (uguo *UserGroupUpdateOne) sqlSave(ctx context.Context) (_node *UserGroup,
 	if value, ok := uguo.mutation.Name(); ok {
 		_spec.SetField(usergroup.FieldName, field.TypeString, value)
 	}
+	if value, ok := uguo.mutation.RateLimit(); ok {
+		_spec.SetField(usergroup.FieldRateLimit, field.TypeJSON, value)
+	}
+	if uguo.mutation.RateLimitCleared() {
+		_spec.ClearField(usergroup.FieldRateLimit, field.TypeJSON)
+	}
+	if value, ok := uguo.mutation.AuditRetention(); ok {
+		_spec.SetField(usergroup.FieldAuditRetention, field.TypeJSON, value)
+	}
+	if uguo.mutation.AuditRetentionCleared() {
+		_spec.ClearField(usergroup.FieldAuditRetention, field.TypeJSON)
+	}
+	if value, ok := uguo.mutation.Budget(); ok {
+		_spec.SetField(usergroup.FieldBudget, field.TypeJSON, value)
+	}
+	if uguo.mutation.BudgetCleared() {
+		_spec.ClearField(usergroup.FieldBudget, field.TypeJSON)
+	}
+	if value, ok := uguo.mutation.PlanID(); ok {
+		_spec.SetField(usergroup.FieldPlanID, field.TypeString, value)
+	}
+	if uguo.mutation.PlanIDCleared() {
+		_spec.ClearField(usergroup.FieldPlanID, field.TypeString)
+	}
 	if value, ok := uguo.mutation.CreatedAt(); ok {
 		_spec.SetField(usergroup.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,138 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/GoYoko/web"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+type AuditUsecase interface {
+	Search(ctx context.Context, req SearchAuditReq) (*ListAuditLogResp, error)
+	Detail(ctx context.Context, id string) (*AuditLogDetail, error)
+	Export(ctx context.Context, req *ExportAuditReq) ([]*AuditLogDetail, error)
+	Retention(ctx context.Context) (*AuditRetentionSetting, error)
+	UpdateGroupRetention(ctx context.Context, req *UpdateGroupAuditRetentionReq) error
+}
+
+type AuditRepo interface {
+	Save(ctx context.Context, record *AuditRecord) error
+	Search(ctx context.Context, req SearchAuditReq) ([]*db.AuditLog, *db.PageInfo, error)
+	Get(ctx context.Context, id string) (*db.AuditLog, error)
+	ListByIDs(ctx context.Context, ids, taskIDs []string) ([]*db.AuditLog, error)
+	GroupRetentions(ctx context.Context) ([]*GroupAuditRetention, error)
+	UpdateGroupRetention(ctx context.Context, id string, retention *types.AuditRetention) error
+	TotalSize(ctx context.Context) (int64, error)
+	DeleteBefore(ctx context.Context, before time.Time, include, exclude []uuid.UUID) (int, error)
+	DeleteOverSize(ctx context.Context, maxSize int64, userIDs []uuid.UUID) (int, error)
+}
+
+// AuditRecord 代理写入的归档内容，Request 和 Response 为未压缩的原文
+type AuditRecord struct {
+	RequestID  string
+	TaskID     string
+	UserID     string
+	ModelID    string
+	ModelName  string
+	ModelType  consts.ModelType
+	Path       string
+	Prompt     string
+	Completion string
+	Request    []byte
+	Response   []byte
+}
+
+type SearchAuditReq struct {
+	*web.Pagination
+	UserID    string           `json:"user_id" query:"user_id"`       // 用户ID
+	ModelID   string           `json:"model_id" query:"model_id"`     // 模型ID
+	ModelType consts.ModelType `json:"model_type" query:"model_type"` // 模型类型
+	TaskID    string           `json:"task_id" query:"task_id"`       // 任务ID
+	Keyword   string           `json:"keyword" query:"keyword"`       // 在提示词和回复中全文检索
+	Start     int64            `json:"start" query:"start"`           // 开始时间
+	End       int64            `json:"end" query:"end"`               // 结束时间
+}
+
+type ListAuditLogResp struct {
+	*db.PageInfo
+
+	Logs []*AuditLog `json:"logs"`
+}
+
+// AuditLog 归档记录
+type AuditLog struct {
+	ID         string           `json:"id"`         // 归档ID
+	RequestID  string           `json:"request_id"` // 请求ID
+	TaskID     string           `json:"task_id"`    // 任务ID
+	UserID     string           `json:"user_id"`    // 用户ID
+	ModelID    string           `json:"model_id"`   // 模型ID
+	ModelName  string           `json:"model_name"` // 模型名称
+	ModelType  consts.ModelType `json:"model_type"` // 模型类型
+	Path       string           `json:"path"`       // 请求路径
+	Prompt     string           `json:"prompt"`     // 提示词
+	Completion string           `json:"completion"` // 回复
+	RawSize    int64            `json:"raw_size"`   // 压缩前的大小
+	Size       int64            `json:"size"`       // 压缩后的大小
+	CreatedAt  int64            `json:"created_at"` // 创建时间
+}
+
+func (a *AuditLog) From(e *db.AuditLog) *AuditLog {
+	if e == nil {
+		return a
+	}
+	a.ID = e.ID.String()
+	a.RequestID = e.RequestID
+	a.TaskID = e.TaskID
+	a.UserID = e.UserID.String()
+	a.ModelID = e.ModelID.String()
+	a.ModelName = e.ModelName
+	a.ModelType = e.ModelType
+	a.Path = e.Path
+	a.Prompt = e.Prompt
+	a.Completion = e.Completion
+	a.RawSize = e.RawSize
+	a.Size = e.Size
+	a.CreatedAt = e.CreatedAt.Unix()
+	return a
+}
+
+// AuditLogDetail 归档详情，包含解压后的请求和响应原文
+type AuditLogDetail struct {
+	AuditLog
+
+	Request  string `json:"request"`  // 请求头和请求体
+	Response string `json:"response"` // 响应头和响应体
+}
+
+type ExportAuditReq struct {
+	IDs     []string `json:"ids"`      // 归档ID列表
+	TaskIDs []string `json:"task_ids"` // 任务ID列表，导出任务下的所有归档
+}
+
+// AuditRetentionSetting 归档保留策略
+type AuditRetentionSetting struct {
+	RetentionDay int                    `json:"retention_day"` // 默认保留天数，0 表示不按时间清理
+	MaxSizeMB    int                    `json:"max_size_mb"`   // 归档总大小上限，0 表示不限制
+	TotalSize    int64                  `json:"total_size"`    // 当前归档总大小
+	Groups       []*GroupAuditRetention `json:"groups"`        // 用户组的保留策略
+}
+
+// GroupAuditRetention 用户组的归档保留策略，覆盖默认配置
+type GroupAuditRetention struct {
+	GroupID      string      `json:"group_id"`      // 用户组ID
+	GroupName    string      `json:"group_name"`    // 用户组名称
+	RetentionDay int         `json:"retention_day"` // 保留天数，0 表示使用默认配置
+	MaxSizeMB    int         `json:"max_size_mb"`   // 组内用户归档的总大小上限，0 表示不限制
+	UserIDs      []uuid.UUID `json:"-"`
+}
+
+type UpdateGroupAuditRetentionReq struct {
+	ID           string `json:"id" validate:"required"`         // 用户组ID
+	RetentionDay int    `json:"retention_day" validate:"gte=0"` // 保留天数，0 表示使用默认配置
+	MaxSizeMB    int    `json:"max_size_mb" validate:"gte=0"`   // 组内用户归档的总大小上限，0 表示不限制
+}
@@ -2,11 +2,14 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/GoYoko/web"
+	"github.com/google/uuid"
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 )
 
@@ -15,6 +18,16 @@ type BillingUsecase interface {
 	ListCompletionRecord(ctx context.Context, req ListRecordReq) (*ListCompletionRecordResp, error)
 	CompletionInfo(ctx context.Context, id, userID string) (*CompletionInfo, error)
 	ChatInfo(ctx context.Context, id, userID string) (*ChatInfo, error)
+	ListPlan(ctx context.Context) ([]*BillingPlan, error)
+	CreatePlan(ctx context.Context, req *CreateBillingPlanReq) (*BillingPlan, error)
+	UpdatePlan(ctx context.Context, req *UpdateBillingPlanReq) (*BillingPlan, error)
+	DeletePlan(ctx context.Context, id string) error
+	AssignPlan(ctx context.Context, req *AssignBillingPlanReq) error
+	ListQuota(ctx context.Context, req ListQuotaReq) (*ListBillingQuotaResp, error)
+	TopUpQuota(ctx context.Context, req *TopUpQuotaReq) (*BillingQuota, error)
+	ListBudget(ctx context.Context) ([]*GroupBudget, error)
+	UpdateGroupBudget(ctx context.Context, req *UpdateGroupBudgetReq) error
+	ListBudgetAlert(ctx context.Context, req ListBudgetAlertReq) (*ListBudgetAlertResp, error)
 }
 
 type BillingRepo interface {
@@ -22,6 +35,20 @@ type BillingRepo interface {
 	ListCompletionRecord(ctx context.Context, req ListRecordReq) (*ListCompletionRecordResp, error)
 	CompletionInfo(ctx context.Context, id, userID string) (*CompletionInfo, error)
 	ChatInfo(ctx context.Context, id, userID string) (*ChatInfo, error)
+	ListPlan(ctx context.Context) ([]*db.BillingPlan, error)
+	CreatePlan(ctx context.Context, req *CreateBillingPlanReq) (*db.BillingPlan, error)
+	UpdatePlan(ctx context.Context, req *UpdateBillingPlanReq) (*db.BillingPlan, error)
+	DeletePlan(ctx context.Context, id string) error
+	AssignPlan(ctx context.Context, req *AssignBillingPlanReq) error
+	ListQuota(ctx context.Context, req ListQuotaReq) (*ListBillingQuotaResp, error)
+	TopUpQuota(ctx context.Context, req *TopUpQuotaReq) (*db.BillingQuota, error)
+	Quota(ctx context.Context, userID string) (*db.BillingQuota, error)
+	Debit(ctx context.Context, record *RecordParam) error
+	GroupBudgets(ctx context.Context) ([]*GroupBudget, error)
+	UpdateGroupBudget(ctx context.Context, id string, budget *types.GroupBudget) error
+	GroupSpend(ctx context.Context, userIDs []uuid.UUID, currency string, since time.Time) (int64, error)
+	CreateBudgetAlert(ctx context.Context, alert *db.BudgetAlert) (bool, error)
+	ListBudgetAlert(ctx context.Context, req ListBudgetAlertReq) (*ListBudgetAlertResp, error)
 }
 
 type ListRecordReq struct {
@@ -46,14 +73,18 @@ type ListCompletionRecordResp struct {
 }
 
 type ChatRecord struct {
-	ID           string `json:"id"`            // 记录ID
-	User         *User  `json:"user"`          // 用户
-	Model        *Model `json:"model"`         // 模型
-	Question     string `json:"question"`      // 问题
-	WorkMode     string `json:"work_mode"`     // 工作模式
-	InputTokens  int64  `json:"input_tokens"`  // 输入token
-	OutputTokens int64  `json:"output_tokens"` // 输出token
-	CreatedAt    int64  `json:"created_at"`    // 创建时间
+	ID            string `json:"id"`             // 记录ID
+	User          *User  `json:"user"`           // 用户
+	Model         *Model `json:"model"`          // 模型
+	Question      string `json:"question"`       // 问题
+	WorkMode      string `json:"work_mode"`      // 工作模式
+	InputTokens   int64  `json:"input_tokens"`   // 输入token
+	OutputTokens  int64  `json:"output_tokens"`  // 输出token
+	CachedTokens  int64  `json:"cached_tokens"`  // 输入中命中提示词缓存的token
+	PolicyVersion int64  `json:"policy_version"` // 应用的改写策略版本，0 表示未改写
+	UsageSource   string `json:"usage_source"`   // token 数的来源 reported: 上游返回 estimated: 估算
+	Status        string `json:"status"`         // 最近一次请求的结束状态 completed aborted upstream_error
+	CreatedAt     int64  `json:"created_at"`     // 创建时间
 }
 
 func (c *ChatRecord) From(e *db.Task) *ChatRecord {
@@ -69,6 +100,10 @@ func (c *ChatRecord) From(e *db.Task) *ChatRecord {
 	c.WorkMode = e.WorkMode
 	c.InputTokens = e.InputTokens
 	c.OutputTokens = e.OutputTokens
+	c.CachedTokens = e.CachedInputTokens
+	c.PolicyVersion = e.PolicyVersion
+	c.UsageSource = string(e.UsageSource)
+	c.Status = string(e.Status)
 	c.CreatedAt = e.CreatedAt.Unix()
 	return c
 }
@@ -80,6 +115,7 @@ type CompletionRecord struct {
 	ProgramLanguage string `json:"program_language"` // 编程语言
 	InputTokens     int64  `json:"input_tokens"`     // 输入token
 	OutputTokens    int64  `json:"output_tokens"`    // 输出token
+	UsageSource     string `json:"usage_source"`     // token 数的来源 reported: 上游返回 estimated: 估算
 	CreatedAt       int64  `json:"created_at"`       // 创建时间
 }
 
@@ -93,15 +129,18 @@ func (c *CompletionRecord) From(e *db.Task) *CompletionRecord {
 	c.ProgramLanguage = e.ProgramLanguage
 	c.InputTokens = e.InputTokens
 	c.OutputTokens = e.OutputTokens
+	c.UsageSource = string(e.UsageSource)
 	c.CreatedAt = e.CreatedAt.Unix()
 	return c
 }
 
 type CompletionInfo struct {
-	ID        string `json:"id"`
-	Prompt    string `json:"prompt"`
-	Content   string `json:"content"`
-	CreatedAt int64  `json:"created_at"`
+	ID        string               `json:"id"`
+	Prompt    string               `json:"prompt"`
+	Content   string               `json:"content"`
+	CreatedAt int64                `json:"created_at"`
+	DLPHits   []*DLPHit            `json:"dlp_hits"` // 外发数据防泄漏命中记录
+	Findings  []*types.CodeFinding `json:"findings"` // 生成代码的安全风险
 }
 
 func (c *CompletionInfo) From(e *db.Task) *CompletionInfo {
@@ -112,15 +151,18 @@ func (c *CompletionInfo) From(e *db.Task) *CompletionInfo {
 	if len(e.Edges.TaskRecords) > 0 {
 		c.Prompt = e.Edges.TaskRecords[0].Prompt
 		c.Content = e.Edges.TaskRecords[0].Completion
+		c.Findings = e.Edges.TaskRecords[0].Findings
 	}
 	c.CreatedAt = e.CreatedAt.Unix()
 	return c
 }
 
 type ChatContent struct {
-	Role      consts.ChatRole `json:"role"`    // 角色，如user: 用户的提问 assistant: 机器人回复 system: 系统消息
-	Content   string          `json:"content"` // 内容
-	CreatedAt int64           `json:"created_at"`
+	Role      consts.ChatRole        `json:"role"`    // 角色，如user: 用户的提问 assistant: 机器人回复 system: 系统消息
+	Content   string                 `json:"content"` // 内容
+	CreatedAt int64                  `json:"created_at"`
+	Findings  []*types.CodeFinding   `json:"findings"` // 生成代码的安全风险，只有 assistant 消息有
+	Messages  []*types.ChoiceMessage `json:"messages"` // 完整的回复，包含推理过程、工具调用和所有 choice，只有 assistant 消息有
 }
 
 func (c *ChatContent) From(e *db.TaskRecord) *ChatContent {
@@ -136,6 +178,8 @@ func (c *ChatContent) From(e *db.TaskRecord) *ChatContent {
 	case consts.ChatRoleSystem:
 		c.Content = e.Completion
 	}
+	c.Findings = e.Findings
+	c.Messages = e.Messages
 	c.CreatedAt = e.CreatedAt.Unix()
 	return c
 }
@@ -143,6 +187,7 @@ func (c *ChatContent) From(e *db.TaskRecord) *ChatContent {
 type ChatInfo struct {
 	ID       string         `json:"id"`
 	Contents []*ChatContent `json:"contents"` // 消息内容
+	DLPHits  []*DLPHit      `json:"dlp_hits"` // 外发数据防泄漏命中记录
 }
 
 func (c *ChatInfo) From(e *db.Task) *ChatInfo {
@@ -154,7 +199,8 @@ func (c *ChatInfo) From(e *db.Task) *ChatInfo {
 		return cvt.From(r, &ChatContent{})
 	})
 	c.Contents = cvt.Filter(c.Contents, func(_ int, r *ChatContent) (*ChatContent, bool) {
-		return r, r.Content != ""
+		// 只有工具调用的回复没有文本内容
+		return r, r.Content != "" || len(r.Messages) > 0
 	})
 	return c
 }
@@ -174,9 +220,10 @@ type BillingQuota struct {
 	CreatedAt int64  `json:"created_at"`
 	UpdatedAt int64  `json:"updated_at"`
 	UserID    string `json:"user_id"`
-	Total     int64  `json:"total"`
-	Used      int64  `json:"used"`
-	Remain    int64  `json:"remain"`
+	PlanID    string `json:"plan_id"` // 套餐ID
+	Total     int64  `json:"total"`   // 总额度
+	Used      int64  `json:"used"`    // 已使用
+	Remain    int64  `json:"remain"`  // 剩余额度
 }
 
 func (b *BillingQuota) From(e *db.BillingQuota) *BillingQuota {
@@ -185,6 +232,7 @@ func (b *BillingQuota) From(e *db.BillingQuota) *BillingQuota {
 	}
 	b.ID = e.ID
 	b.UserID = e.UserID
+	b.PlanID = e.PlanID
 	b.Total = e.Total
 	b.Used = e.Used
 	b.Remain = e.Remain
@@ -193,6 +241,123 @@ func (b *BillingQuota) From(e *db.BillingQuota) *BillingQuota {
 	return b
 }
 
+type BillingPlan struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`        // 套餐名称
+	Description string `json:"description"` // 套餐描述
+	Quota       int64  `json:"quota"`       // 套餐授予的 token 数
+	CreatedAt   int64  `json:"created_at"`
+	UpdatedAt   int64  `json:"updated_at"`
+}
+
+func (b *BillingPlan) From(e *db.BillingPlan) *BillingPlan {
+	if e == nil {
+		return b
+	}
+	b.ID = e.ID
+	b.Name = e.Name
+	b.Description = e.Description
+	b.Quota = e.Quota
+	b.CreatedAt = e.CreatedAt.Unix()
+	b.UpdatedAt = e.UpdatedAt.Unix()
+	return b
+}
+
+type CreateBillingPlanReq struct {
+	Name        string `json:"name" validate:"required"` // 套餐名称
+	Description string `json:"description"`              // 套餐描述
+	Quota       int64  `json:"quota" validate:"gte=0"`   // 套餐授予的 token 数
+}
+
+type UpdateBillingPlanReq struct {
+	ID          string  `json:"id" validate:"required"` // 套餐ID
+	Name        *string `json:"name"`                   // 套餐名称
+	Description *string `json:"description"`            // 套餐描述
+	Quota       *int64  `json:"quota"`                  // 套餐授予的 token 数，只影响之后分配的用户
+}
+
+// AssignBillingPlanReq 为用户或用户组分配套餐，分配后用户额度重置为套餐额度
+type AssignBillingPlanReq struct {
+	PlanID   string   `json:"plan_id" validate:"required"` // 套餐ID
+	UserIDs  []string `json:"user_ids"`                    // 用户ID列表
+	GroupIDs []string `json:"group_ids"`                   // 用户组ID列表，组内用户及之后加入的用户都使用该套餐
+}
+
+type TopUpQuotaReq struct {
+	UserID string `json:"user_id" validate:"required"` // 用户ID
+	Tokens int64  `json:"tokens" validate:"required"`  // 充值 token 数，负数表示扣减
+}
+
+type ListQuotaReq struct {
+	*web.Pagination
+	UserID string `json:"user_id" query:"user_id"` // 用户ID
+	PlanID string `json:"plan_id" query:"plan_id"` // 套餐ID
+}
+
+type ListBillingQuotaResp struct {
+	*db.PageInfo
+
+	Quotas []*BillingQuota `json:"quotas"`
+}
+
+// GroupBudget 用户组的月度预算
+type GroupBudget struct {
+	GroupID    string      `json:"group_id"`   // 用户组ID
+	GroupName  string      `json:"group_name"` // 用户组名称
+	Monthly    float64     `json:"monthly"`    // 月度预算
+	Currency   string      `json:"currency"`   // 货币，为空时使用默认货币
+	Thresholds []int       `json:"thresholds"` // 告警阈值，为预算的百分比，为空时使用默认阈值
+	Spend      float64     `json:"spend"`      // 本月费用
+	UserIDs    []uuid.UUID `json:"-"`
+}
+
+type UpdateGroupBudgetReq struct {
+	ID         string  `json:"id" validate:"required"`   // 用户组ID
+	Monthly    float64 `json:"monthly" validate:"gte=0"` // 月度预算，0 表示取消预算
+	Currency   string  `json:"currency"`                 // 货币，为空时使用默认货币
+	Thresholds []int   `json:"thresholds"`               // 告警阈值，为预算的百分比，为空时使用默认阈值
+}
+
+// BudgetAlert 用户组当月费用超过预算阈值的告警，每个阈值每月只告警一次
+type BudgetAlert struct {
+	ID        string  `json:"id"`
+	GroupID   string  `json:"group_id"`   // 用户组ID
+	GroupName string  `json:"group_name"` // 用户组名称
+	Month     string  `json:"month"`      // 告警月份，如 2025-01
+	Threshold int     `json:"threshold"`  // 触发的阈值
+	Spend     float64 `json:"spend"`      // 触发时的当月费用
+	Budget    float64 `json:"budget"`     // 当月预算
+	Currency  string  `json:"currency"`   // 货币
+	CreatedAt int64   `json:"created_at"`
+}
+
+func (b *BudgetAlert) From(e *db.BudgetAlert) *BudgetAlert {
+	if e == nil {
+		return b
+	}
+	b.ID = e.ID.String()
+	b.GroupID = e.GroupID.String()
+	b.GroupName = e.GroupName
+	b.Month = e.Month
+	b.Threshold = e.Threshold
+	b.Spend = float64(e.Spend) / 1e6
+	b.Budget = float64(e.Budget) / 1e6
+	b.Currency = e.Currency
+	b.CreatedAt = e.CreatedAt.Unix()
+	return b
+}
+
+type ListBudgetAlertReq struct {
+	*web.Pagination
+	GroupID string `json:"group_id" query:"group_id"` // 用户组ID
+}
+
+type ListBudgetAlertResp struct {
+	*db.PageInfo
+
+	Alerts []*BudgetAlert `json:"alerts"`
+}
+
 type ApiKey struct {
 	ID        string `json:"id"`
 	CreatedAt int64  `json:"created_at"`
@@ -16,6 +16,7 @@ type DashboardUsecase interface {
 	UserStat(ctx context.Context, req StatisticsFilter) (*UserStat, error)
 	UserEvents(ctx context.Context, req StatisticsFilter) ([]*UserEvent, error)
 	UserHeatmap(ctx context.Context, userID string) (*UserHeatmapResp, error)
+	CodeFindingStat(ctx context.Context, req StatisticsFilter) (*CodeFindingStat, error)
 }
 
 type DashboardRepo interface {
@@ -26,6 +27,7 @@ type DashboardRepo interface {
 	UserStat(ctx context.Context, req StatisticsFilter) (*UserStat, error)
 	UserEvents(ctx context.Context, req StatisticsFilter) ([]*UserEvent, error)
 	UserHeatmap(ctx context.Context, userID string) ([]*UserHeatmap, error)
+	CodeFindingStat(ctx context.Context, req StatisticsFilter) (*CodeFindingStat, error)
 }
 
 type Statistics struct {
@@ -80,6 +82,8 @@ type UserStat struct {
 	AcceptedPer      []TimePoint[float64] `json:"accepted_per"`        // 接受率统计
 	WorkMode         []CategoryPoint      `json:"work_mode"`           // 工作模式占比
 	ProgramLanguage  []CategoryPoint      `json:"program_language"`    // 编程语言占比
+	Spend            []*SpendStat         `json:"spend"`               // 按货币统计的费用，只包含按天和按模型统计
+	PromptCache      []*ModelCacheStat    `json:"prompt_cache"`        // 各模型的提示词缓存命中率
 }
 
 type UserEvent struct {
@@ -103,15 +107,54 @@ type CategoryStat struct {
 }
 
 type TimeStat struct {
-	TotalUsers       int64                `json:"total_users"`         // 近90天活跃用户数
-	TotalChats       int64                `json:"total_chats"`         // 近90天对话任务数
-	TotalCompletions int64                `json:"total_completions"`   // 近90天补全任务数
-	TotalLinesOfCode int64                `json:"total_lines_of_code"` // 近90天代码行数
-	TotalAcceptedPer float64              `json:"total_accepted_per"`  // 近90天平均接受率
-	ActiveUsers      []TimePoint[int64]   `json:"active_users"`        // 活跃用户数统计
-	RealTimeTokens   []TimePoint[int64]   `json:"real_time_tokens"`    // 实时token数统计
-	Chats            []TimePoint[int64]   `json:"chats"`               // 对话任务数统计
-	Completions      []TimePoint[int64]   `json:"code_completions"`    // 补全任务数统计
-	LinesOfCode      []TimePoint[int64]   `json:"lines_of_code"`       // 代码行数统计
-	AcceptedPer      []TimePoint[float64] `json:"accepted_per"`        // 接受率统计
+	TotalUsers        int64                `json:"total_users"`         // 近90天活跃用户数
+	TotalChats        int64                `json:"total_chats"`         // 近90天对话任务数
+	TotalCompletions  int64                `json:"total_completions"`   // 近90天补全任务数
+	TotalLinesOfCode  int64                `json:"total_lines_of_code"` // 近90天代码行数
+	TotalAcceptedPer  float64              `json:"total_accepted_per"`  // 近90天平均接受率
+	TotalCachedTokens int64                `json:"total_cached_tokens"` // 近90天命中缓存节省的token数
+	ActiveUsers       []TimePoint[int64]   `json:"active_users"`        // 活跃用户数统计
+	RealTimeTokens    []TimePoint[int64]   `json:"real_time_tokens"`    // 实时token数统计
+	Chats             []TimePoint[int64]   `json:"chats"`               // 对话任务数统计
+	Completions       []TimePoint[int64]   `json:"code_completions"`    // 补全任务数统计
+	LinesOfCode       []TimePoint[int64]   `json:"lines_of_code"`       // 代码行数统计
+	AcceptedPer       []TimePoint[float64] `json:"accepted_per"`        // 接受率统计
+	CachedTokens      []TimePoint[int64]   `json:"cached_tokens"`       // 命中缓存节省的token数统计
+	Spend             []*SpendStat         `json:"spend"`               // 按货币统计的费用
+	PromptCache       []*ModelCacheStat    `json:"prompt_cache"`        // 各模型的提示词缓存命中率
+}
+
+// ModelCacheStat 模型的上游提示词缓存命中情况，不包含命中响应缓存的请求
+type ModelCacheStat struct {
+	ModelID      string  `json:"model_id"`      // 模型ID
+	ModelName    string  `json:"model_name"`    // 模型名称
+	InputTokens  int64   `json:"input_tokens"`  // 输入token数
+	CachedTokens int64   `json:"cached_tokens"` // 命中提示词缓存的输入token数
+	HitRatio     float64 `json:"hit_ratio"`     // 命中率，百分比
+}
+
+// SpendStat 一种货币的费用统计，金额单位为货币单位
+type SpendStat struct {
+	Currency string               `json:"currency"` // 货币
+	Total    float64              `json:"total"`    // 总费用
+	Daily    []TimePoint[float64] `json:"daily"`    // 按天统计
+	Users    []SpendPoint         `json:"users"`    // 按用户统计
+	Groups   []SpendPoint         `json:"groups"`   // 按用户组统计，用户属于多个组时分别计入
+	Models   []SpendPoint         `json:"models"`   // 按模型统计
+}
+
+// SpendPoint 用户、用户组或模型的费用
+type SpendPoint struct {
+	ID    string  `json:"id"`    // 用户、用户组或模型ID
+	Name  string  `json:"name"`  // 名称
+	Value float64 `json:"value"` // 费用
+}
+
+// CodeFindingStat 生成代码的安全风险统计
+type CodeFindingStat struct {
+	TotalFindings int64              `json:"total_findings"` // 风险总数
+	TotalRecords  int64              `json:"total_records"`  // 存在风险的回复数
+	Rules         []CategoryPoint    `json:"rules"`          // 按规则统计，分类为规则名称
+	Severity      []CategoryPoint    `json:"severity"`       // 按严重程度统计
+	Findings      []TimePoint[int64] `json:"findings"`       // 风险数统计
 }
@@ -2,11 +2,18 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 )
 
 type ModelUsecase interface {
@@ -17,10 +24,17 @@ type ModelUsecase interface {
 	Delete(ctx context.Context, id string) error
 	GetTokenUsage(ctx context.Context, modelType consts.ModelType) (*ModelTokenUsageResp, error)
 	InitModel(ctx context.Context) error
+	Uptime(ctx context.Context, req *ModelUptimeReq) (*ModelUptimeResp, error)
+	ListTransformRule(ctx context.Context) (*ListTransformRuleResp, error)
+	CreateTransformRule(ctx context.Context, req *CreateTransformRuleReq) (*TransformRule, error)
+	UpdateTransformRule(ctx context.Context, req *UpdateTransformRuleReq) (*TransformRule, error)
+	DeleteTransformRule(ctx context.Context, req *DeleteTransformRuleReq) error
+	ListTransformPolicy(ctx context.Context, req ListTransformPolicyReq) (*ListTransformPolicyResp, error)
 }
 
 type ModelRepo interface {
 	GetWithCache(ctx context.Context, modelType consts.ModelType) (*db.Model, error)
+	ListActive(ctx context.Context, modelType consts.ModelType) ([]*db.Model, error)
 	List(ctx context.Context) (*AllModelResp, error)
 	Create(ctx context.Context, m *CreateModelReq) (*db.Model, error)
 	Update(ctx context.Context, id string, fn func(tx *db.Tx, old *db.Model, up *db.ModelUpdateOne) error) (*db.Model, error)
@@ -29,6 +43,18 @@ type ModelRepo interface {
 	ModelUsage(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]ModelUsage, error)
 	GetTokenUsage(ctx context.Context, modelType consts.ModelType) (*ModelTokenUsageResp, error)
 	InitModel(ctx context.Context, modelName, modelKey, modelURL string) error
+	ListAllActive(ctx context.Context) ([]*db.Model, error)
+	SaveHealthCheck(ctx context.Context, check *ModelHealthCheck) error
+	RecentHealthChecks(ctx context.Context, id uuid.UUID, limit int) ([]*db.ModelHealthCheck, error)
+	HealthStat(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]*ModelHealth, error)
+	Uptime(ctx context.Context, req *ModelUptimeReq) ([]*ModelUptime, error)
+	DeleteHealthChecks(ctx context.Context, before time.Time) (int, error)
+	ListTransformRule(ctx context.Context) ([]*db.TransformRule, error)
+	LatestTransformPolicy(ctx context.Context) (*db.TransformPolicy, error)
+	CreateTransformRule(ctx context.Context, req *CreateTransformRuleReq) (*db.TransformRule, error)
+	UpdateTransformRule(ctx context.Context, req *UpdateTransformRuleReq) (*db.TransformRule, error)
+	DeleteTransformRule(ctx context.Context, req *DeleteTransformRuleReq) error
+	ListTransformPolicy(ctx context.Context, req ListTransformPolicyReq) (*ListTransformPolicyResp, error)
 }
 
 type MyModelListReq struct {
@@ -76,14 +102,17 @@ type GetTokenUsageReq struct {
 
 type CreateModelReq struct {
 	AdminID    uuid.UUID            `json:"-"`
-	ShowName   string               `json:"show_name"`                                                                                                                                            // 模型显示名称
-	ModelName  string               `json:"model_name" validate:"required"`                                                                                                                       // 模型名称 如: deepseek-v3
-	Provider   consts.ModelProvider `json:"provider" validate:"required,oneof=SiliconFlow OpenAI Ollama DeepSeek Moonshot AzureOpenAI BaiZhiCloud Hunyuan BaiLian Volcengine ZhiPu Gemini Other"` // 提供商
-	APIBase    string               `json:"api_base" validate:"required"`                                                                                                                         // 接口地址 如：https://api.qwen.com
-	APIKey     string               `json:"api_key"`                                                                                                                                              // 接口密钥 如：sk-xxxx
+	ShowName   string               `json:"show_name"`                                                                                                                                                      // 模型显示名称
+	ModelName  string               `json:"model_name" validate:"required"`                                                                                                                                 // 模型名称 如: deepseek-v3
+	Aliases    []string             `json:"aliases"`                                                                                                                                                        // 模型别名，客户端可通过别名请求该模型
+	Provider   consts.ModelProvider `json:"provider" validate:"required,oneof=SiliconFlow OpenAI Ollama DeepSeek Moonshot AzureOpenAI BaiZhiCloud Hunyuan BaiLian Volcengine ZhiPu Gemini Anthropic Other"` // 提供商
+	APIBase    string               `json:"api_base" validate:"required"`                                                                                                                                   // 接口地址 如：https://api.qwen.com
+	APIKey     string               `json:"api_key"`                                                                                                                                                        // 接口密钥 如：sk-xxxx
 	APIVersion string               `json:"api_version"`
 	APIHeader  string               `json:"api_header"`
 	ModelType  consts.ModelType     `json:"model_type"` // 模型类型 llm:对话模型 coder:代码模型
+	Weight     int                  `json:"weight"`     // 负载均衡权重，默认为1
+	Pricing    *ModelPricing        `json:"pricing"`    // 模型价格
 	Param      *ModelParam          `json:"param"`      // 高级参数
 }
 
@@ -94,6 +123,8 @@ type ModelParam struct {
 	SupprtImages       bool `json:"support_images"`
 	SupportComputerUse bool `json:"support_computer_use"`
 	SupportPromptCache bool `json:"support_prompt_cache"`
+	ResponseCache      bool `json:"response_cache"`     // 是否缓存响应
+	ResponseCacheTTL   int  `json:"response_cache_ttl"` // 响应缓存有效秒数，0 表示使用默认配置
 }
 
 func DefaultModelParam() *ModelParam {
@@ -108,18 +139,79 @@ func DefaultModelParam() *ModelParam {
 }
 
 type UpdateModelReq struct {
-	ID         string                `json:"id"`                                                                                                                                                   // 模型ID
-	ModelName  *string               `json:"model_name"`                                                                                                                                           // 模型名称
-	ShowName   *string               `json:"show_name"`                                                                                                                                            // 模型显示名称
-	Provider   *consts.ModelProvider `json:"provider" validate:"required,oneof=SiliconFlow OpenAI Ollama DeepSeek Moonshot AzureOpenAI BaiZhiCloud Hunyuan BaiLian Volcengine ZhiPu Gemini Other"` // 提供商
-	APIBase    *string               `json:"api_base"`                                                                                                                                             // 接口地址 如：https://api.qwen.com
-	APIKey     *string               `json:"api_key"`                                                                                                                                              // 接口密钥 如：sk-xxxx
+	ID         string                `json:"id"`                                                                                                                                                             // 模型ID
+	ModelName  *string               `json:"model_name"`                                                                                                                                                     // 模型名称
+	ShowName   *string               `json:"show_name"`                                                                                                                                                      // 模型显示名称
+	Aliases    []string              `json:"aliases"`                                                                                                                                                        // 模型别名，为空时不修改
+	Provider   *consts.ModelProvider `json:"provider" validate:"required,oneof=SiliconFlow OpenAI Ollama DeepSeek Moonshot AzureOpenAI BaiZhiCloud Hunyuan BaiLian Volcengine ZhiPu Gemini Anthropic Other"` // 提供商
+	APIBase    *string               `json:"api_base"`                                                                                                                                                       // 接口地址 如：https://api.qwen.com
+	APIKey     *string               `json:"api_key"`                                                                                                                                                        // 接口密钥 如：sk-xxxx
 	APIVersion *string               `json:"api_version"`
 	APIHeader  *string               `json:"api_header"`
 	Status     *consts.ModelStatus   `json:"status"`          // 状态 active:启用 inactive:禁用
+	Weight     *int                  `json:"weight"`          // 负载均衡权重
+	Pricing    *ModelPricing         `json:"pricing"`         // 模型价格
 	Param      *ModelParam           `json:"param,omitempty"` // 高级参数
 }
 
+// ModelPricing 模型价格，单位为每百万 token 的价格
+type ModelPricing struct {
+	Input       float64 `json:"input"`        // 输入价格
+	Output      float64 `json:"output"`       // 输出价格
+	CachedInput float64 `json:"cached_input"` // 命中提示词缓存的输入价格，0 表示按输入价格计费
+	Currency    string  `json:"currency"`     // 货币，如 CNY、USD，为空时使用默认货币
+}
+
+func (p *ModelPricing) From(e *types.ModelPricing) *ModelPricing {
+	if e == nil {
+		return p
+	}
+	p.Input = e.Input
+	p.Output = e.Output
+	p.CachedInput = e.CachedInput
+	p.Currency = e.Currency
+	return p
+}
+
+// Validate 价格不能为负数，货币使用 ISO 4217 代码
+func (p *ModelPricing) Validate() error {
+	if p.Input < 0 || p.Output < 0 || p.CachedInput < 0 {
+		return fmt.Errorf("pricing must not be negative")
+	}
+	if p.Currency != "" && !ValidCurrency(p.Currency) {
+		return fmt.Errorf("invalid currency %q", p.Currency)
+	}
+	return nil
+}
+
+var currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// ValidCurrency 货币是否为 ISO 4217 代码
+func ValidCurrency(c string) bool {
+	return currencyRegexp.MatchString(c)
+}
+
+// Entity 转换为数据库中保存的价格
+func (p *ModelPricing) Entity() *types.ModelPricing {
+	return &types.ModelPricing{
+		Input:       p.Input,
+		Output:      p.Output,
+		CachedInput: p.CachedInput,
+		Currency:    p.Currency,
+	}
+}
+
+// Cost 计算请求费用，单位为百万分之一货币单位
+// cached 为 input 中命中提示词缓存的部分，按缓存价格计费，未设置缓存价格时按输入价格计费
+func (p *ModelPricing) Cost(input, cached, output int64) int64 {
+	cached = min(cached, input)
+	price := p.CachedInput
+	if price == 0 {
+		price = p.Input
+	}
+	return int64(math.Round(float64(input-cached)*p.Input + float64(cached)*price + float64(output)*p.Output))
+}
+
 type ModelTokenUsageResp struct {
 	TotalInput  int64             `json:"total_input"`  // 总输入token数
 	TotalOutput int64             `json:"total_output"` // 总输出token数
@@ -133,9 +225,53 @@ type ModelTokenUsage struct {
 }
 
 type ModelBasic struct {
-	Name     string               `json:"name"`                                                                                                                                                 // 模型名称
-	Provider consts.ModelProvider `json:"provider" validate:"required,oneof=SiliconFlow OpenAI Ollama DeepSeek Moonshot AzureOpenAI BaiZhiCloud Hunyuan BaiLian Volcengine ZhiPu Gemini Other"` // 提供商
-	APIBase  string               `json:"api_base"`                                                                                                                                             // 接口地址 如：https://api.qwen.com
+	Name     string               `json:"name"`                                                                                                                                                           // 模型名称
+	Provider consts.ModelProvider `json:"provider" validate:"required,oneof=SiliconFlow OpenAI Ollama DeepSeek Moonshot AzureOpenAI BaiZhiCloud Hunyuan BaiLian Volcengine ZhiPu Gemini Anthropic Other"` // 提供商
+	APIBase  string               `json:"api_base"`                                                                                                                                                       // 接口地址 如：https://api.qwen.com
+}
+
+// ModelHealth 模型健康检查统计
+type ModelHealth struct {
+	Status        consts.ModelHealth `json:"status"`          // 健康状态 unknown:未知 healthy:健康 unhealthy:不健康
+	Latency       int64              `json:"latency"`         // 近24小时平均探测耗时，毫秒
+	ErrorRate     float64            `json:"error_rate"`      // 近24小时探测失败率，百分比
+	LastSuccessAt int64              `json:"last_success_at"` // 最近一次探测成功时间
+	LastCheckAt   int64              `json:"last_check_at"`   // 最近一次探测时间
+}
+
+// ModelHealthCheck 单次健康探测结果
+type ModelHealthCheck struct {
+	ModelID uuid.UUID
+	Success bool
+	Latency int64 // 探测耗时，毫秒
+	Error   string
+}
+
+type ModelUptimeReq struct {
+	ID        string `json:"id" query:"id" validate:"required"`                                             // 模型ID
+	Precision string `json:"precision" query:"precision" validate:"required,oneof=hour day" default:"hour"` // 精度: "hour", "day"
+	Duration  int    `json:"duration" query:"duration" validate:"gte=1,lte=90" default:"24"`                // 持续时间 (小时或天数)
+}
+
+// StartTime 统计的起始时间
+func (r *ModelUptimeReq) StartTime() time.Time {
+	if r.Precision == "day" {
+		return time.Now().AddDate(0, 0, -r.Duration)
+	}
+	return time.Now().Add(-time.Duration(r.Duration) * time.Hour)
+}
+
+type ModelUptimeResp struct {
+	Uptime float64        `json:"uptime"` // 统计区间内的可用率，百分比
+	Points []*ModelUptime `json:"points"` // 按精度聚合的可用率
+}
+
+type ModelUptime struct {
+	Timestamp int64   `json:"timestamp"` // 时间戳
+	Total     int64   `json:"total"`     // 探测次数
+	Success   int64   `json:"success"`   // 成功次数
+	Uptime    float64 `json:"uptime"`    // 可用率，百分比
+	Latency   int64   `json:"latency"`   // 平均探测耗时，毫秒
 }
 
 type ModelUsage struct {
@@ -148,6 +284,7 @@ type Model struct {
 	ID         string               `json:"id"`          // 模型ID
 	ShowName   string               `json:"show_name"`   // 模型显示名称
 	ModelName  string               `json:"model_name"`  // 模型名称 如: deepseek-v3
+	Aliases    []string             `json:"aliases"`     // 模型别名
 	Provider   consts.ModelProvider `json:"provider"`    // 提供商
 	APIBase    string               `json:"api_base"`    // 接口地址 如：https://api.qwen.com
 	APIKey     string               `json:"api_key"`     // 接口密钥 如：sk-xxxx
@@ -156,6 +293,10 @@ type Model struct {
 	ModelType  consts.ModelType     `json:"model_type"`  // 模型类型 llm:对话模型 coder:代码模型
 	Status     consts.ModelStatus   `json:"status"`      // 状态 active:启用 inactive:禁用
 	IsActive   bool                 `json:"is_active"`   // 是否启用
+	Weight     int                  `json:"weight"`      // 负载均衡权重
+	Breaker    string               `json:"breaker"`     // 熔断状态 closed:正常 open:熔断 half_open:半开
+	Health     *ModelHealth         `json:"health"`      // 健康检查状态
+	Pricing    ModelPricing         `json:"pricing"`     // 模型价格
 	Input      int64                `json:"input"`       // 输入token数
 	Output     int64                `json:"output"`      // 输出token数
 	Param      ModelParam           `json:"param"`       // 高级参数
@@ -172,6 +313,7 @@ func (m *Model) From(e *db.Model) *Model {
 	m.ID = e.ID.String()
 	m.ShowName = e.ShowName
 	m.ModelName = e.ModelName
+	m.Aliases = e.Aliases
 	m.Provider = e.Provider
 	m.APIBase = e.APIBase
 	m.APIKey = e.APIKey
@@ -181,6 +323,8 @@ func (m *Model) From(e *db.Model) *Model {
 	m.Status = e.Status
 	m.IsInternal = e.IsInternal
 	m.IsActive = e.Status == consts.ModelStatusActive
+	m.Weight = e.Weight
+	cvt.From(e.Pricing, &m.Pricing)
 	if p := e.Parameters; p != nil {
 		m.Param = ModelParam{
 			R1Enabled:          p.R1Enabled,
@@ -189,6 +333,8 @@ func (m *Model) From(e *db.Model) *Model {
 			SupprtImages:       p.SupprtImages,
 			SupportComputerUse: p.SupportComputerUse,
 			SupportPromptCache: p.SupportPromptCache,
+			ResponseCache:      p.ResponseCache,
+			ResponseCacheTTL:   p.ResponseCacheTTL,
 		}
 	}
 	m.CreatedAt = e.CreatedAt.Unix()
@@ -197,6 +343,24 @@ func (m *Model) From(e *db.Model) *Model {
 	return m
 }
 
+// Match 判断客户端请求的模型名称是否为该模型的名称或别名
+func (m *Model) Match(name string) bool {
+	if strings.EqualFold(m.ModelName, name) {
+		return true
+	}
+	for _, alias := range m.Aliases {
+		if strings.EqualFold(alias, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unhealthy 健康检查是否将模型标记为不健康
+func (m *Model) Unhealthy() bool {
+	return m.Health != nil && m.Health.Status == consts.ModelHealthUnhealthy
+}
+
 type CheckModelResp struct {
 	Error   string `json:"error"`
 	Content string `json:"content"`
@@ -2,20 +2,35 @@ package domain
 
 import (
 	"context"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 )
 
 type ProxyUsecase interface {
-	SelectModelWithLoadBalancing(modelName string, modelType consts.ModelType) (*Model, error)
+	SelectModelWithLoadBalancing(req *SelectModelReq) (*Model, error)
+	ReleaseModel(m *Model, latency time.Duration, err error)
 	Record(ctx context.Context, record *RecordParam) error
 	ValidateApiKey(ctx context.Context, key string) (*ApiKey, error)
+	CheckRateLimit(ctx context.Context, key *ApiKey) (*RateLimitResult, error)
+	CheckQuota(ctx context.Context, userID string) (*BillingQuota, error)
 	AcceptCompletion(ctx context.Context, req *AcceptCompletionReq) error
 	Report(ctx context.Context, req *ReportReq) error
 	CreateSecurityScanning(ctx context.Context, req *CreateSecurityScanningReq) (string, error)
 	ListSecurityScanning(ctx context.Context, req *ListSecurityScanningReq) (*ListSecurityScanningBriefResp, error)
 	ListSecurityDetail(ctx context.Context, req *ListSecurityScanningDetailReq) (*ListSecurityScanningDetailResp, error)
+	GetCache(ctx context.Context, req *CacheReq) (*ResponseCache, error)
+	SaveCache(ctx context.Context, req *CacheReq, cache *ResponseCache) error
+	MatchTransform(ctx context.Context, modelID, userID string) (*TransformPolicy, error)
+	RecordDLP(ctx context.Context, record *DLPRecord) error
+	RecordQueueStat(ctx context.Context) (*RecordQueueStat, error)
+	ListDeadRecords(ctx context.Context, limit int64) ([]*DeadRecord, error)
+	RetryDeadRecord(ctx context.Context, id string) error
+	Archive(ctx context.Context, record *AuditRecord) error
 }
 
 type ProxyRepo interface {
@@ -25,6 +40,60 @@ type ProxyRepo interface {
 	Report(ctx context.Context, model *db.Model, req *ReportReq) error
 	SelectModelWithLoadBalancing(modelName string, modelType consts.ModelType) (*db.Model, error)
 	ValidateApiKey(ctx context.Context, key string) (*db.ApiKey, error)
+	RateLimitPolicy(ctx context.Context, userID string) (*RateLimitPolicy, error)
+	GetCache(ctx context.Context, key string) (*db.ResponseCache, error)
+	SimilarCache(ctx context.Context, req *CacheReq, similarity float64) (*db.ResponseCache, error)
+	HitCache(ctx context.Context, id uuid.UUID) error
+	SaveCache(ctx context.Context, req *CacheReq, cache *ResponseCache) error
+	DeleteExpiredCache(ctx context.Context) (int, error)
+	UserGroupIDs(ctx context.Context, userID string) ([]string, error)
+	SaveDLPHits(ctx context.Context, record *DLPRecord) error
+}
+
+// SelectModelReq 负载均衡选择模型的条件
+type SelectModelReq struct {
+	ModelName      string           // 客户端请求的模型名称或别名，为空或没有匹配时使用该类型的全部模型
+	ModelType      consts.ModelType // 模型类型
+	Exclude        []string         // 排除的模型ID，用于失败重试
+	AllowAnthropic bool             // 是否可以选择 Anthropic 协议的模型，只有 /v1/messages 可以直接转发
+}
+
+// RecordQueueStat 请求记录队列状态
+type RecordQueueStat struct {
+	Length        int64 `json:"length"`         // 队列中的记录数
+	Pending       int64 `json:"pending"`        // 正在写入或等待重试的记录数
+	Lag           int64 `json:"lag"`            // 尚未开始写入的记录数
+	Dead          int64 `json:"dead"`           // 死信数
+	Consumers     int64 `json:"consumers"`      // 消费者数
+	Published     int64 `json:"published"`      // 累计入队数
+	Processed     int64 `json:"processed"`      // 累计写入成功数
+	Failed        int64 `json:"failed"`         // 累计写入失败次数，包括重试
+	DeadLettered  int64 `json:"dead_lettered"`  // 累计转入死信数
+	PublishFailed int64 `json:"publish_failed"` // 当前实例入队失败后直接写入的次数
+}
+
+// DeadRecord 多次写入失败的请求记录
+type DeadRecord struct {
+	ID        string           `json:"id"`         // 死信ID
+	SourceID  string           `json:"source_id"`  // 原消息ID
+	Attempts  int64            `json:"attempts"`   // 写入次数
+	Error     string           `json:"error"`      // 最后一次写入的错误
+	TaskID    string           `json:"task_id"`    // 任务ID
+	RequestID string           `json:"request_id"` // 请求ID
+	UserID    string           `json:"user_id"`    // 用户ID
+	ModelID   string           `json:"model_id"`   // 模型ID
+	ModelType consts.ModelType `json:"model_type"` // 模型类型
+	DeadAt    int64            `json:"dead_at"`    // 转入死信的时间
+}
+
+// RetryDeadRecordReq 重新写入死信请求
+type RetryDeadRecordReq struct {
+	ID string `json:"id" validate:"required"` // 死信ID
+}
+
+// ListDeadRecordReq 死信列表请求
+type ListDeadRecordReq struct {
+	Limit int64 `json:"limit" query:"limit"` // 返回条数，默认 100
 }
 
 type VersionInfo struct {
@@ -53,6 +122,7 @@ type RecordParam struct {
 	RequestID       string
 	TaskID          string
 	UserID          string
+	APIKeyID        string
 	ModelID         string
 	ModelType       consts.ModelType
 	Role            consts.ChatRole
@@ -60,14 +130,23 @@ type RecordParam struct {
 	ProgramLanguage string
 	InputTokens     int64
 	OutputTokens    int64
+	CachedTokens    int64 // 输入中命中上游提示词缓存的 token 数
 	IsAccept        bool
 	Completion      string
 	WorkMode        string
 	CodeLines       int64
 	Code            string
-	SourceCode      string         // 当前文件的原文
-	CursorPosition  map[string]any // 光标位置
-	UserInput       string         // 用户实际输入的内容
+	SourceCode      string                 // 当前文件的原文
+	CursorPosition  map[string]any         // 光标位置
+	UserInput       string                 // 用户实际输入的内容
+	CacheHit        bool                   // 是否命中响应缓存
+	PolicyVersion   int64                  // 应用的改写策略版本，0 表示未改写
+	Findings        []*types.CodeFinding   // 生成代码的安全风险
+	UsageSource     consts.UsageSource     // token 数的来源
+	Messages        []*types.ChoiceMessage // 完整的回复，只有包含推理过程、工具调用或多个 choice 时才有
+	Cost            int64                  // 费用，单位为百万分之一货币单位
+	Currency        string                 // 费用的货币
+	Status          consts.TaskStatus      // 请求的结束状态
 }
 
 func (r *RecordParam) Clone() *RecordParam {
@@ -75,6 +154,7 @@ func (r *RecordParam) Clone() *RecordParam {
 		RequestID:       r.RequestID,
 		TaskID:          r.TaskID,
 		UserID:          r.UserID,
+		APIKeyID:        r.APIKeyID,
 		ModelID:         r.ModelID,
 		ModelType:       r.ModelType,
 		Role:            r.Role,
@@ -82,6 +162,7 @@ func (r *RecordParam) Clone() *RecordParam {
 		ProgramLanguage: r.ProgramLanguage,
 		InputTokens:     r.InputTokens,
 		OutputTokens:    r.OutputTokens,
+		CachedTokens:    r.CachedTokens,
 		IsAccept:        r.IsAccept,
 		Completion:      r.Completion,
 		WorkMode:        r.WorkMode,
@@ -89,5 +170,100 @@ func (r *RecordParam) Clone() *RecordParam {
 		SourceCode:      r.SourceCode,
 		CursorPosition:  r.CursorPosition,
 		UserInput:       r.UserInput,
+		CacheHit:        r.CacheHit,
+		PolicyVersion:   r.PolicyVersion,
+		Findings:        r.Findings,
+		UsageSource:     r.UsageSource,
+		Messages:        r.Messages,
+		Cost:            r.Cost,
+		Currency:        r.Currency,
+		Status:          r.Status,
 	}
 }
+
+// CacheReq 响应缓存的查询条件
+type CacheReq struct {
+	Key        string           // 归一化请求的哈希
+	ContextKey string           // 除相似度查找文本外的请求上下文哈希
+	ModelName  string           // 上游模型名称
+	ModelType  consts.ModelType // 模型类型
+	Prompt     string           // 用于相似度查找的请求文本
+	TTL        time.Duration    // 缓存有效期
+	Embedding  []float32        // 请求文本的向量，查询时生成，写入缓存时复用
+}
+
+// ResponseCache 缓存的模型响应
+type ResponseCache struct {
+	ID           string
+	Completion   string
+	FinishReason string
+	InputTokens  int64
+	OutputTokens int64
+}
+
+func (r *ResponseCache) From(e *db.ResponseCache) *ResponseCache {
+	if e == nil {
+		return r
+	}
+	r.ID = e.ID.String()
+	r.Completion = e.Completion
+	r.FinishReason = e.FinishReason
+	r.InputTokens = e.InputTokens
+	r.OutputTokens = e.OutputTokens
+	return r
+}
+
+// RateLimitPolicy 用户生效的限流配置
+type RateLimitPolicy struct {
+	APIKey RateLimit            `json:"api_key"`
+	User   RateLimit            `json:"user"`
+	Groups map[string]RateLimit `json:"groups"` // 用户组ID -> 限制
+}
+
+type RateLimitStatus struct {
+	Limit     int           // 每分钟上限
+	Remaining int           // 剩余额度
+	Reset     time.Duration // 额度恢复满所需时间
+}
+
+type RateLimitResult struct {
+	Allowed    bool
+	Kind       string // 触发限制的类型 requests/tokens
+	Message    string
+	RetryAfter time.Duration
+	Requests   *RateLimitStatus // 请求数限制中余量最少的一项，未限制时为 nil
+	Tokens     *RateLimitStatus // token 数限制中余量最少的一项，未限制时为 nil
+}
+
+// DLPHit 外发数据防泄漏规则的命中记录
+type DLPHit struct {
+	RuleID    string             `json:"rule_id"`    // 规则ID
+	RuleName  string             `json:"rule_name"`  // 规则名称
+	Category  consts.DLPCategory `json:"category"`   // 规则类别 Secrets:密钥 PII:个人信息
+	Action    consts.DLPAction   `json:"action"`     // 处理方式 alert:只记录 redact:脱敏 block:拒绝
+	Preview   string             `json:"preview"`    // 脱敏后的命中内容
+	Count     int                `json:"count"`      // 命中次数
+	CreatedAt int64              `json:"created_at"` // 命中时间
+}
+
+func (d *DLPHit) From(e *db.DLPHit) *DLPHit {
+	if e == nil {
+		return d
+	}
+	d.RuleID = e.RuleID
+	d.RuleName = e.RuleName
+	d.Category = e.Category
+	d.Action = e.Action
+	d.Preview = e.Preview
+	d.Count = e.Count
+	d.CreatedAt = e.CreatedAt.Unix()
+	return d
+}
+
+// DLPRecord 一次请求的防泄漏检测结果
+type DLPRecord struct {
+	TaskID    string
+	RequestID string
+	UserID    string
+	Hits      []*DLPHit
+}
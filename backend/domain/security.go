@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"fmt"
 	"path"
 
 	"github.com/GoYoko/web"
@@ -11,24 +12,67 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/sarif"
 	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
 )
 
 type SecurityScanningUsecase interface {
 	List(ctx context.Context, req ListSecurityScanningReq) (*ListSecurityScanningResp, error)
 	Detail(ctx context.Context, userID, id string) ([]*SecurityScanningRiskDetail, error)
+	ExportSARIF(ctx context.Context, id string) (*sarif.Log, error)
+	ExportWorkspaceSARIF(ctx context.Context, workspaceID string) (*sarif.Log, error)
+	ImportSARIF(ctx context.Context, req *ImportSARIFReq) (*ImportSARIFResp, error)
+	Triage(ctx context.Context, req *TriageSecurityScanningReq) (*SecurityScanningRiskDetail, error)
+	ListComments(ctx context.Context, userID, id string) ([]*SecurityScanningComment, error)
+	ListSuppressions(ctx context.Context, req ListSecurityScanningSuppressionReq) (*ListSecurityScanningSuppressionResp, error)
+	CreateSuppression(ctx context.Context, req *CreateSecurityScanningSuppressionReq) (*SecurityScanningSuppression, error)
+	DeleteSuppression(ctx context.Context, id string) error
+	ListRulePacks(ctx context.Context) ([]*SecurityRulePack, error)
+	CreateRulePack(ctx context.Context, req *CreateSecurityRulePackReq) (*SecurityRulePack, error)
+	UploadRulePackVersion(ctx context.Context, req *UploadSecurityRulePackReq) (*SecurityRulePackVersion, error)
+	UpdateRulePack(ctx context.Context, req *UpdateSecurityRulePackReq) (*SecurityRulePack, error)
+	ListRulePackVersions(ctx context.Context, id string) ([]*SecurityRulePackVersion, error)
+	DeleteRulePack(ctx context.Context, id string) error
+	ListPolicies(ctx context.Context) ([]*SecurityScanningPolicy, error)
+	CreatePolicy(ctx context.Context, req *CreateSecurityScanningPolicyReq) (*SecurityScanningPolicy, error)
+	UpdatePolicy(ctx context.Context, req *UpdateSecurityScanningPolicyReq) (*SecurityScanningPolicy, error)
+	DeletePolicy(ctx context.Context, id string) error
 }
 
 type SecurityScanningRepo interface {
 	Get(ctx context.Context, id string) (*db.SecurityScanning, error)
 	Create(ctx context.Context, req CreateSecurityScanningReq) (string, error)
-	Update(ctx context.Context, id string, fileMap map[string]string, status consts.SecurityScanningStatus, result *scan.Result) error
+	Update(ctx context.Context, id string, status consts.SecurityScanningStatus, errMsg string) error
+	SaveResults(ctx context.Context, req *SaveSecurityScanningReq) error
+	UpdateSubScans(ctx context.Context, id string, subs []*types.SecurityScanningSubScan) error
+	LatestSuccess(ctx context.Context, workspaceID uuid.UUID, language consts.SecurityScanningLanguage, policy string) (*db.SecurityScanning, error)
 	List(ctx context.Context, req ListSecurityScanningReq) (*ListSecurityScanningResp, error)
 	ListDetail(ctx context.Context, req ListSecurityScanningDetailReq) (*ListSecurityScanningDetailResp, error)
 	Detail(ctx context.Context, userID, id string) ([]*SecurityScanningRiskDetail, error)
 	ListBrief(ctx context.Context, req ListSecurityScanningReq) (*ListSecurityScanningBriefResp, error)
 	AllRunning(ctx context.Context) ([]*db.SecurityScanning, error)
 	PageWorkspaceFiles(ctx context.Context, id string, size int, fn func([]*db.WorkspaceFile) error) error
+	GetWithResults(ctx context.Context, id string) (*db.SecurityScanning, error)
+	LatestByWorkspace(ctx context.Context, workspaceID string) ([]*db.SecurityScanning, error)
+	Import(ctx context.Context, req *ImportSecurityScanningReq) (string, error)
+	Triage(ctx context.Context, req *TriageSecurityScanningReq) (*db.SecurityScanningResult, error)
+	ListComments(ctx context.Context, userID, id string) ([]*db.SecurityScanningComment, error)
+	ListSuppressions(ctx context.Context, req ListSecurityScanningSuppressionReq) (*ListSecurityScanningSuppressionResp, error)
+	CreateSuppression(ctx context.Context, req *CreateSecurityScanningSuppressionReq) (*db.SecurityScanningSuppression, error)
+	DeleteSuppression(ctx context.Context, id string) error
+	ListRulePacks(ctx context.Context) ([]*db.SecurityRulePack, error)
+	CreateRulePack(ctx context.Context, req *CreateSecurityRulePackReq, ruleIDs []string) (*db.SecurityRulePack, error)
+	UploadRulePackVersion(ctx context.Context, req *UploadSecurityRulePackReq, ruleIDs []string) (*db.SecurityRulePackVersion, error)
+	UpdateRulePack(ctx context.Context, req *UpdateSecurityRulePackReq) (*db.SecurityRulePack, error)
+	ListRulePackVersions(ctx context.Context, id string) ([]*db.SecurityRulePackVersion, error)
+	DeleteRulePack(ctx context.Context, id string) error
+	ActiveRulePacks(ctx context.Context, ids []uuid.UUID) ([]*db.SecurityRulePack, error)
+	PolicyRulePacks(ctx context.Context, ids []uuid.UUID) ([]*db.SecurityRulePack, error)
+	ListPolicies(ctx context.Context) ([]*db.SecurityScanningPolicy, error)
+	GetPolicy(ctx context.Context, name string) (*db.SecurityScanningPolicy, error)
+	CreatePolicy(ctx context.Context, req *CreateSecurityScanningPolicyReq) (*db.SecurityScanningPolicy, error)
+	UpdatePolicy(ctx context.Context, req *UpdateSecurityScanningPolicyReq) (*db.SecurityScanningPolicy, error)
+	DeletePolicy(ctx context.Context, id string) error
 }
 
 type ListSecurityScanningReq struct {
@@ -41,8 +85,10 @@ type ListSecurityScanningReq struct {
 
 type ListSecurityScanningDetailReq struct {
 	web.Pagination
-	ID     string `json:"id" query:"id"` // 扫描任务id
-	UserID string `json:"-"`
+	ID           string                              `json:"id" query:"id"`                       // 扫描任务id
+	State        consts.SecurityScanningFindingState `json:"state" query:"state"`                 // 相对上一次扫描的状态，为空时返回除已修复外的所有发现
+	TriageStatus consts.SecurityScanningTriageStatus `json:"triage_status" query:"triage_status"` // 处置状态
+	UserID       string                              `json:"-"`
 }
 
 type ListSecurityScanningResp struct {
@@ -64,11 +110,12 @@ type ListSecurityScanningDetailResp struct {
 }
 
 type SecurityScanningBrief struct {
-	ID        string                        `json:"id"`         // 扫描任务id
-	Workspace string                        `json:"workspace"`  // 项目目录
-	Status    consts.SecurityScanningStatus `json:"status"`     // 扫描状态
-	ReportURL string                        `json:"report_url"` // 报告url
-	CreatedAt int64                         `json:"created_at"` // 创建时间
+	ID        string                           `json:"id"`         // 扫描任务id
+	Workspace string                           `json:"workspace"`  // 项目目录
+	Status    consts.SecurityScanningStatus    `json:"status"`     // 扫描状态
+	SubScans  []*types.SecurityScanningSubScan `json:"sub_scans"`  // 自动识别语言时每个规则集的子扫描进度
+	ReportURL string                           `json:"report_url"` // 报告url
+	CreatedAt int64                            `json:"created_at"` // 创建时间
 }
 
 func (s *SecurityScanningBrief) From(e *db.SecurityScanning) *SecurityScanningBrief {
@@ -78,6 +125,7 @@ func (s *SecurityScanningBrief) From(e *db.SecurityScanning) *SecurityScanningBr
 
 	s.ID = e.ID.String()
 	s.Status = e.Status
+	s.SubScans = e.SubScans
 	s.Workspace = e.Workspace
 	s.CreatedAt = e.CreatedAt.Unix()
 
@@ -85,28 +133,105 @@ func (s *SecurityScanningBrief) From(e *db.SecurityScanning) *SecurityScanningBr
 }
 
 type ScanReq struct {
-	TaskID    string `json:"task_id"`
-	UserID    string `json:"user_id"`
-	Workspace string `json:"workspace"` // 项目目录
-	Language  string `json:"language"`  // 扫描语言
+	TaskID     string           `json:"task_id"`
+	UserID     string           `json:"user_id"`
+	Workspace  string           `json:"workspace"`  // 项目目录
+	Language   string           `json:"language"`   // 扫描语言
+	Rules      []*scan.RulePack `json:"rules"`      // 自定义规则包，需先同步到扫描服务
+	Confidence []string         `json:"confidence"` // 报告的置信度，为空时使用默认值
+	Impact     []string         `json:"impact"`     // 报告的影响，为空时使用默认值
 }
 
 type CreateSecurityScanningReq struct {
 	UserID    string                          `json:"user_id"`
 	Workspace string                          `json:"workspace"` // 项目目录
-	Language  consts.SecurityScanningLanguage `json:"language"`  // 扫描语言
+	Language  consts.SecurityScanningLanguage `json:"language"`  // 扫描语言，为 auto 时按工作区文件识别语言，为空时使用扫描策略的语言
+	Policy    string                          `json:"policy"`    // 扫描策略名称
+	Full      bool                            `json:"full"`      // 是否强制全量扫描，默认只扫描上一次成功扫描后变化的文件
+}
+
+// SaveSecurityScanningReq 扫描成功后保存的结果
+type SaveSecurityScanningReq struct {
+	ID           string
+	BaseID       *uuid.UUID                   // 增量扫描对比的上一次扫描，为 nil 表示全量扫描
+	FileHashes   map[string]string            // 扫描时工作区文件的哈希，key 为相对路径
+	ScannedFiles int                          // 本次实际扫描的文件数
+	Results      []*db.SecurityScanningResult // 包含未变化文件沿用的结果和已修复的结果
+}
+
+// ImportSARIFReq 导入外部扫描工具的 SARIF 结果
+type ImportSARIFReq struct {
+	WorkspaceID string     // 结果所属的工作区
+	Log         *sarif.Log // SARIF 日志
+}
+
+// TriageSecurityScanningReq 处置扫描发现
+// 标记为误报或接受风险时按指纹屏蔽工作区中之后扫描出的相同发现
+type TriageSecurityScanningReq struct {
+	UserID     string                              `json:"-"`                      // 用户接口只能处置自己的扫描结果
+	OperatorID string                              `json:"-"`                      // 操作人
+	Operator   string                              `json:"-"`                      // 操作人用户名
+	ID         string                              `json:"id" validate:"required"` // 发现id
+	Status     consts.SecurityScanningTriageStatus `json:"status"`                 // 处置状态，为空时不修改
+	AssigneeID *string                             `json:"assignee_id"`            // 处理人，为空字符串时取消指派，不传时不修改
+	ExpiresAt  int64                               `json:"expires_at"`             // 接受风险的到期时间，为 0 表示永久
+	Comment    string                              `json:"comment"`                // 评论
+}
+
+type ListSecurityScanningSuppressionReq struct {
+	*web.Pagination
+	WorkspaceID string `json:"workspace_id" query:"workspace_id"` // 工作区id
+}
+
+type ListSecurityScanningSuppressionResp struct {
+	*db.PageInfo
+
+	Items []*SecurityScanningSuppression `json:"items"`
+}
+
+// CreateSecurityScanningSuppressionReq 按指纹或规则屏蔽扫描发现，同时屏蔽已有的待处理发现
+type CreateSecurityScanningSuppressionReq struct {
+	AdminID     string                              `json:"-"`
+	Admin       string                              `json:"-"`
+	WorkspaceID string                              `json:"workspace_id"`               // 工作区id，为空表示所有工作区
+	Fingerprint string                              `json:"fingerprint"`                // 发现指纹
+	CheckID     string                              `json:"check_id"`                   // 规则，指纹为空时按规则屏蔽
+	Status      consts.SecurityScanningTriageStatus `json:"status" validate:"required"` // 误报或接受风险
+	Reason      string                              `json:"reason"`                     // 原因
+	ExpiresAt   int64                               `json:"expires_at"`                 // 到期时间，为 0 表示永久
+}
+
+type DeleteSecurityScanningSuppressionReq struct {
+	ID string `json:"id" query:"id" validate:"required"` // 屏蔽规则id
+}
+
+type ImportSARIFResp struct {
+	IDs []string `json:"ids"` // 每个 run 创建的扫描任务id
+}
+
+type ImportSecurityScanningReq struct {
+	WorkspaceID string
+	Tool        string                          // 外部扫描工具名称
+	Language    consts.SecurityScanningLanguage // 扫描语言
+	Results     []*db.SecurityScanningResult    // 文件路径为相对工作区的路径或绝对路径
 }
 
 type SecurityScanningResult struct {
-	ID          string                        `json:"id"`           // 扫描任务id
-	Name        string                        `json:"name"`         // 扫描任务
-	ProjectName string                        `json:"project_name"` // 项目名称
-	Path        string                        `json:"path"`         // 项目路径
-	Status      consts.SecurityScanningStatus `json:"status"`       // 扫描状态
-	Risk        SecurityScanningRiskResult    `json:"risk"`         // 风险结果
-	User        *User                         `json:"user"`         // 用户
-	Error       string                        `json:"error"`        // 错误信息
-	CreatedAt   int64                         `json:"created_at"`   // 扫描开始时间
+	ID           string                           `json:"id"`            // 扫描任务id
+	Name         string                           `json:"name"`          // 扫描任务
+	ProjectName  string                           `json:"project_name"`  // 项目名称
+	Path         string                           `json:"path"`          // 项目路径
+	WorkspaceID  string                           `json:"workspace_id"`  // 工作区id
+	Tool         string                           `json:"tool"`          // 导入的外部扫描工具名称，为空表示内置扫描
+	Policy       string                           `json:"policy"`        // 扫描策略名称
+	Incremental  bool                             `json:"incremental"`   // 是否为增量扫描
+	ScannedFiles int                              `json:"scanned_files"` // 本次实际扫描的文件数
+	Status       consts.SecurityScanningStatus    `json:"status"`        // 扫描状态
+	SubScans     []*types.SecurityScanningSubScan `json:"sub_scans"`     // 自动识别语言时每个规则集的子扫描进度
+	Risk         SecurityScanningRiskResult       `json:"risk"`          // 风险结果
+	User         *User                            `json:"user"`          // 用户
+	Error        string                           `json:"error"`         // 错误信息
+	CreatedAt    int64                            `json:"created_at"`    // 扫描开始时间
 }
 
 func (s *SecurityScanningResult) From(e *db.SecurityScanning) *SecurityScanningResult {
@@ -116,9 +241,18 @@ func (s *SecurityScanningResult) From(e *db.SecurityScanning) *SecurityScanningR
 
 	s.ID = e.ID.String()
 	s.Name = e.Language.RuleName()
+	if e.Tool != "" {
+		s.Name = fmt.Sprintf("%s 导入结果", e.Tool)
+	}
 	s.ProjectName = path.Base(e.Workspace)
 	s.Path = e.Workspace
+	s.WorkspaceID = e.WorkspaceID.String()
+	s.Tool = e.Tool
+	s.Policy = e.Policy
+	s.Incremental = e.BaseID != nil
+	s.ScannedFiles = e.ScannedFiles
 	s.Status = e.Status
+	s.SubScans = e.SubScans
 	s.User = cvt.From(e.Edges.User, &User{})
 	s.Error = e.ErrorMessage
 	s.CreatedAt = e.CreatedAt.Unix()
@@ -131,18 +265,28 @@ type SecurityScanningRiskResult struct {
 	SevereCount   int       `json:"severe_count"`   // 严重数
 	CriticalCount int       `json:"critical_count"` // 高危数
 	SuggestCount  int       `json:"suggest_count"`  // 建议数
+	NewCount      int       `json:"new_count"`      // 本次新增数
+	FixedCount    int       `json:"fixed_count"`    // 本次修复数
+	SuppressCount int       `json:"suppress_count"` // 已屏蔽数，不计入以上风险数
 }
 
 type SecurityScanningRiskDetail struct {
-	ID       string                           `json:"id"`       // 风险id
-	Level    consts.SecurityScanningRiskLevel `json:"level"`    // 风险等级
-	Desc     string                           `json:"desc"`     // 风险描述
-	Lines    string                           `json:"lines"`    // 风险代码行
-	Start    *types.Position                  `json:"start"`    // 风险代码行开始位置
-	End      *types.Position                  `json:"end"`      // 风险代码行结束位置
-	Fix      string                           `json:"fix"`      // 修复建议
-	Filename string                           `json:"filename"` // 风险文件名
-	Content  string                           `json:"content"`  // 代码内容
+	ID       string                              `json:"id"`       // 风险id
+	Level    consts.SecurityScanningRiskLevel    `json:"level"`    // 风险等级
+	Desc     string                              `json:"desc"`     // 风险描述
+	Lines    string                              `json:"lines"`    // 风险代码行
+	Start    *types.Position                     `json:"start"`    // 风险代码行开始位置
+	End      *types.Position                     `json:"end"`      // 风险代码行结束位置
+	Fix      string                              `json:"fix"`      // 修复建议
+	Filename string                              `json:"filename"` // 风险文件名
+	Content  string                              `json:"content"`  // 代码内容
+	State    consts.SecurityScanningFindingState `json:"state"`    // 相对上一次扫描的状态
+
+	CheckID       string                              `json:"check_id"`        // 规则
+	Fingerprint   string                              `json:"fingerprint"`     // 发现指纹
+	TriageStatus  consts.SecurityScanningTriageStatus `json:"triage_status"`   // 处置状态
+	AssigneeID    string                              `json:"assignee_id"`     // 处理人
+	RiskExpiresAt int64                               `json:"risk_expires_at"` // 接受风险的到期时间
 }
 
 func (s *SecurityScanningRiskDetail) From(e *db.SecurityScanningResult) *SecurityScanningRiskDetail {
@@ -159,13 +303,217 @@ func (s *SecurityScanningRiskDetail) From(e *db.SecurityScanningResult) *Securit
 	case "INFO":
 		s.Level = consts.SecurityScanningRiskLevelSuggest
 	}
-	s.Desc = e.AbstractZh
+	// 导入的外部扫描结果可能没有中文描述
+	s.Desc = cvt.ZeroWithDefault(e.AbstractZh, cvt.ZeroWithDefault(e.AbstractEn, e.Message))
 	s.Lines = e.Lines
 	s.Start = e.StartPosition
 	s.End = e.EndPosition
 	s.Filename = e.Path
-	s.Fix = e.MessageZh
+	s.Fix = cvt.ZeroWithDefault(e.MessageZh, e.Message)
 	s.Content = e.FileContent
+	s.State = e.State
+	s.CheckID = e.CheckID
+	s.Fingerprint = e.Fingerprint
+	s.TriageStatus = e.TriageStatus
+	if e.AssigneeID != nil {
+		s.AssigneeID = e.AssigneeID.String()
+	}
+	if e.RiskExpiresAt != nil {
+		s.RiskExpiresAt = e.RiskExpiresAt.Unix()
+	}
+
+	return s
+}
+
+type SecurityScanningComment struct {
+	ID           string                              `json:"id"`
+	Author       string                              `json:"author"`        // 评论人
+	TriageStatus consts.SecurityScanningTriageStatus `json:"triage_status"` // 修改后的处置状态，为空表示未修改
+	Content      string                              `json:"content"`       // 评论内容
+	CreatedAt    int64                               `json:"created_at"`    // 创建时间
+}
+
+func (s *SecurityScanningComment) From(e *db.SecurityScanningComment) *SecurityScanningComment {
+	if e == nil {
+		return s
+	}
+
+	s.ID = e.ID.String()
+	s.Author = e.Author
+	s.TriageStatus = e.TriageStatus
+	s.Content = e.Content
+	s.CreatedAt = e.CreatedAt.Unix()
+
+	return s
+}
+
+type SecurityScanningSuppression struct {
+	ID          string                              `json:"id"`
+	WorkspaceID string                              `json:"workspace_id"` // 工作区id，为空表示所有工作区
+	Fingerprint string                              `json:"fingerprint"`  // 发现指纹
+	CheckID     string                              `json:"check_id"`     // 规则
+	Status      consts.SecurityScanningTriageStatus `json:"status"`       // 误报或接受风险
+	Reason      string                              `json:"reason"`       // 原因
+	ExpiresAt   int64                               `json:"expires_at"`   // 到期时间，为 0 表示永久
+	Creator     string                              `json:"creator"`      // 创建人
+	CreatedAt   int64                               `json:"created_at"`   // 创建时间
+}
+
+func (s *SecurityScanningSuppression) From(e *db.SecurityScanningSuppression) *SecurityScanningSuppression {
+	if e == nil {
+		return s
+	}
+
+	s.ID = e.ID.String()
+	if e.WorkspaceID != nil {
+		s.WorkspaceID = e.WorkspaceID.String()
+	}
+	s.Fingerprint = e.Fingerprint
+	s.CheckID = e.CheckID
+	s.Status = e.Status
+	s.Reason = e.Reason
+	if e.ExpiresAt != nil {
+		s.ExpiresAt = e.ExpiresAt.Unix()
+	}
+	s.Creator = e.Creator
+	s.CreatedAt = e.CreatedAt.Unix()
+
+	return s
+}
+
+type CreateSecurityRulePackReq struct {
+	Admin       string `json:"-"`
+	Name        string `json:"name" validate:"required"`    // 规则包名称，只允许字母、数字和 _.-
+	Description string `json:"description"`                 // 描述
+	Content     string `json:"content" validate:"required"` // semgrep 格式的 YAML 规则
+}
+
+// UploadSecurityRulePackReq 上传规则包的新版本
+type UploadSecurityRulePackReq struct {
+	Admin    string `json:"-"`
+	ID       string `json:"id" validate:"required"`      // 规则包id
+	Content  string `json:"content" validate:"required"` // semgrep 格式的 YAML 规则
+	Activate bool   `json:"activate"`                    // 是否立即用于扫描
+}
+
+type UpdateSecurityRulePackReq struct {
+	ID          string  `json:"id" validate:"required"` // 规则包id
+	Description *string `json:"description"`            // 描述，不传时不修改
+	Enabled     *bool   `json:"enabled"`                // 是否启用，不传时不修改
+	Version     *int    `json:"version"`                // 扫描使用的版本，可回退到历史版本，不传时不修改
+}
+
+type SecurityRulePackIDReq struct {
+	ID string `json:"id" query:"id" validate:"required"` // 规则包id
+}
+
+type SecurityRulePack struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`           // 规则包名称
+	Description   string `json:"description"`    // 描述
+	Enabled       bool   `json:"enabled"`        // 是否启用
+	Version       int    `json:"version"`        // 扫描使用的版本
+	LatestVersion int    `json:"latest_version"` // 最新上传的版本
+	Creator       string `json:"creator"`        // 创建人
+	CreatedAt     int64  `json:"created_at"`     // 创建时间
+	UpdatedAt     int64  `json:"updated_at"`     // 更新时间
+}
+
+func (s *SecurityRulePack) From(e *db.SecurityRulePack) *SecurityRulePack {
+	if e == nil {
+		return s
+	}
+
+	s.ID = e.ID.String()
+	s.Name = e.Name
+	s.Description = e.Description
+	s.Enabled = e.Enabled
+	s.Version = e.Version
+	s.LatestVersion = e.LatestVersion
+	s.Creator = e.Creator
+	s.CreatedAt = e.CreatedAt.Unix()
+	s.UpdatedAt = e.UpdatedAt.Unix()
+
+	return s
+}
+
+type SecurityRulePackVersion struct {
+	ID        string   `json:"id"`
+	Version   int      `json:"version"`    // 版本
+	Checksum  string   `json:"checksum"`   // 内容的 sha256
+	RuleIDs   []string `json:"rule_ids"`   // 包含的规则id
+	Content   string   `json:"content"`    // semgrep 格式的 YAML 规则
+	Creator   string   `json:"creator"`    // 上传人
+	CreatedAt int64    `json:"created_at"` // 上传时间
+}
+
+func (s *SecurityRulePackVersion) From(e *db.SecurityRulePackVersion) *SecurityRulePackVersion {
+	if e == nil {
+		return s
+	}
+
+	s.ID = e.ID.String()
+	s.Version = e.Version
+	s.Checksum = e.Checksum
+	s.RuleIDs = e.RuleIds
+	s.Content = e.Content
+	s.Creator = e.Creator
+	s.CreatedAt = e.CreatedAt.Unix()
+
+	return s
+}
+
+// CreateSecurityScanningPolicyReq 扫描策略组合内置语言规则和自定义规则包，并设置报告的阈值
+type CreateSecurityScanningPolicyReq struct {
+	Name          string                          `json:"name" validate:"required"` // 策略名称，扫描请求中引用
+	Description   string                          `json:"description"`              // 描述
+	Language      consts.SecurityScanningLanguage `json:"language"`                 // 内置语言规则，为空时由扫描请求指定
+	RulePackIDs   []string                        `json:"rule_pack_ids"`            // 自定义规则包id
+	MinConfidence consts.SecurityRuleLevel        `json:"min_confidence"`           // 报告的最低置信度，默认 MEDIUM
+	MinImpact     consts.SecurityRuleLevel        `json:"min_impact"`               // 报告的最低影响，默认 MEDIUM
+}
+
+type UpdateSecurityScanningPolicyReq struct {
+	ID            string                           `json:"id" validate:"required"` // 策略id
+	Description   *string                          `json:"description"`            // 描述，不传时不修改
+	Language      *consts.SecurityScanningLanguage `json:"language"`               // 内置语言规则，不传时不修改
+	RulePackIDs   []string                         `json:"rule_pack_ids"`          // 自定义规则包id，不传时不修改
+	MinConfidence consts.SecurityRuleLevel         `json:"min_confidence"`         // 报告的最低置信度，为空时不修改
+	MinImpact     consts.SecurityRuleLevel         `json:"min_impact"`             // 报告的最低影响，为空时不修改
+}
+
+type SecurityScanningPolicyIDReq struct {
+	ID string `json:"id" query:"id" validate:"required"` // 策略id
+}
+
+type SecurityScanningPolicy struct {
+	ID            string                          `json:"id"`
+	Name          string                          `json:"name"`           // 策略名称
+	Description   string                          `json:"description"`    // 描述
+	Language      consts.SecurityScanningLanguage `json:"language"`       // 内置语言规则
+	RulePackIDs   []string                        `json:"rule_pack_ids"`  // 自定义规则包id
+	MinConfidence consts.SecurityRuleLevel        `json:"min_confidence"` // 报告的最低置信度
+	MinImpact     consts.SecurityRuleLevel        `json:"min_impact"`     // 报告的最低影响
+	CreatedAt     int64                           `json:"created_at"`     // 创建时间
+	UpdatedAt     int64                           `json:"updated_at"`     // 更新时间
+}
+
+func (s *SecurityScanningPolicy) From(e *db.SecurityScanningPolicy) *SecurityScanningPolicy {
+	if e == nil {
+		return s
+	}
+
+	s.ID = e.ID.String()
+	s.Name = e.Name
+	s.Description = e.Description
+	s.Language = e.Language
+	s.RulePackIDs = cvt.Iter(e.RulePackIds, func(_ int, id uuid.UUID) string {
+		return id.String()
+	})
+	s.MinConfidence = e.MinConfidence
+	s.MinImpact = e.MinImpact
+	s.CreatedAt = e.CreatedAt.Unix()
+	s.UpdatedAt = e.UpdatedAt.Unix()
 
 	return s
 }
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/GoYoko/web"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+// TransformProtectedParams 改写规则不能删除的请求参数
+var TransformProtectedParams = []string{"model", "messages", "prompt", "system", "stream"}
+
+// ValidateTransformConfig 检查改写配置的取值范围
+func ValidateTransformConfig(c *types.TransformConfig) error {
+	if c == nil {
+		return nil
+	}
+	for _, t := range []*float64{c.DefaultTemperature, c.MaxTemperature} {
+		if t != nil && (*t < 0 || *t > 2) {
+			return fmt.Errorf("temperature must be between 0 and 2")
+		}
+	}
+	if c.DefaultTemperature != nil && c.MaxTemperature != nil && *c.DefaultTemperature > *c.MaxTemperature {
+		return fmt.Errorf("default_temperature must not exceed max_temperature")
+	}
+	for _, n := range []*int{c.DefaultMaxTokens, c.MaxTokensLimit} {
+		if n != nil && *n <= 0 {
+			return fmt.Errorf("max_tokens must be positive")
+		}
+	}
+	if c.DefaultMaxTokens != nil && c.MaxTokensLimit != nil && *c.DefaultMaxTokens > *c.MaxTokensLimit {
+		return fmt.Errorf("default_max_tokens must not exceed max_tokens_limit")
+	}
+	for _, p := range c.StripParams {
+		if slices.Contains(TransformProtectedParams, p) {
+			return fmt.Errorf("param %s can not be stripped", p)
+		}
+	}
+	return nil
+}
+
+// TransformRule 请求改写规则，按模型和用户组匹配后在转发前改写请求
+type TransformRule struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name"`       // 规则名称
+	ModelID   string                 `json:"model_id"`   // 模型ID，为空表示所有模型
+	GroupID   string                 `json:"group_id"`   // 用户组ID，为空表示所有用户
+	Priority  int                    `json:"priority"`   // 优先级，数值越大越先应用
+	Enabled   bool                   `json:"enabled"`    // 是否启用
+	Config    *types.TransformConfig `json:"config"`     // 改写配置
+	CreatedAt int64                  `json:"created_at"` // 创建时间
+	UpdatedAt int64                  `json:"updated_at"` // 更新时间
+}
+
+func (t *TransformRule) From(e *db.TransformRule) *TransformRule {
+	if e == nil {
+		return t
+	}
+	t.ID = e.ID.String()
+	t.Name = e.Name
+	if e.ModelID != uuid.Nil {
+		t.ModelID = e.ModelID.String()
+	}
+	if e.GroupID != uuid.Nil {
+		t.GroupID = e.GroupID.String()
+	}
+	t.Priority = e.Priority
+	t.Enabled = e.Enabled
+	t.Config = e.Config
+	t.CreatedAt = e.CreatedAt.Unix()
+	t.UpdatedAt = e.UpdatedAt.Unix()
+	return t
+}
+
+// Snapshot 转换为策略版本中保存的规则快照
+func (t *TransformRule) Snapshot() *types.TransformRule {
+	return &types.TransformRule{
+		ID:       t.ID,
+		Name:     t.Name,
+		ModelID:  t.ModelID,
+		GroupID:  t.GroupID,
+		Priority: t.Priority,
+		Enabled:  t.Enabled,
+		Config:   t.Config,
+	}
+}
+
+type ListTransformRuleResp struct {
+	Version int64            `json:"version"` // 当前策略版本
+	Rules   []*TransformRule `json:"rules"`
+}
+
+type CreateTransformRuleReq struct {
+	AdminID  uuid.UUID              `json:"-"`
+	Name     string                 `json:"name" validate:"required"`   // 规则名称
+	ModelID  string                 `json:"model_id"`                   // 模型ID，为空表示所有模型
+	GroupID  string                 `json:"group_id"`                   // 用户组ID，为空表示所有用户
+	Priority int                    `json:"priority"`                   // 优先级，数值越大越先应用
+	Enabled  bool                   `json:"enabled"`                    // 是否启用
+	Config   *types.TransformConfig `json:"config" validate:"required"` // 改写配置
+}
+
+type UpdateTransformRuleReq struct {
+	AdminID  uuid.UUID              `json:"-"`
+	ID       string                 `json:"id" validate:"required"` // 规则ID
+	Name     *string                `json:"name"`                   // 规则名称
+	ModelID  *string                `json:"model_id"`               // 模型ID，为空字符串表示所有模型
+	GroupID  *string                `json:"group_id"`               // 用户组ID，为空字符串表示所有用户
+	Priority *int                   `json:"priority"`               // 优先级
+	Enabled  *bool                  `json:"enabled"`                // 是否启用
+	Config   *types.TransformConfig `json:"config"`                 // 改写配置
+}
+
+type DeleteTransformRuleReq struct {
+	AdminID uuid.UUID `json:"-"`
+	ID      string    `json:"id" query:"id" validate:"required"` // 规则ID
+}
+
+type ListTransformPolicyReq struct {
+	*web.Pagination
+}
+
+// TransformPolicy 改写策略版本，每次修改规则生成一个新版本
+type TransformPolicy struct {
+	Version   int64                  `json:"version"`    // 策略版本
+	Rules     []*types.TransformRule `json:"rules"`      // 该版本的全部规则
+	AdminID   string                 `json:"admin_id"`   // 修改人
+	CreatedAt int64                  `json:"created_at"` // 创建时间
+}
+
+func (t *TransformPolicy) From(e *db.TransformPolicy) *TransformPolicy {
+	if e == nil {
+		return t
+	}
+	t.Version = e.Version
+	t.Rules = e.Rules
+	if e.AdminID != uuid.Nil {
+		t.AdminID = e.AdminID.String()
+	}
+	t.CreatedAt = e.CreatedAt.Unix()
+	return t
+}
+
+type ListTransformPolicyResp struct {
+	*db.PageInfo
+
+	Policies []*TransformPolicy `json:"policies"`
+}
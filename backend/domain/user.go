@@ -38,6 +38,7 @@ type UserUsecase interface {
 	ListRole(ctx context.Context) ([]*Role, error)
 	GrantRole(ctx context.Context, req *GrantRoleReq) error
 	GetPermissions(ctx context.Context, id uuid.UUID) (*Permissions, error)
+	UpdateGroupRateLimit(ctx context.Context, req *UpdateGroupRateLimitReq) error
 }
 
 type UserRepo interface {
@@ -70,6 +71,7 @@ type UserRepo interface {
 	GrantRole(ctx context.Context, req *GrantRoleReq) error
 	GetPermissions(ctx context.Context, id uuid.UUID) (*Permissions, error)
 	CleanPermissionCache(ctx context.Context, id uuid.UUID)
+	UpdateGroupRateLimit(ctx context.Context, id string, limit *types.RateLimit) error
 }
 
 type ProfileUpdateReq struct {
@@ -318,6 +320,7 @@ type UpdateSettingReq struct {
 	DingtalkOAuth        *DingtalkOAuthReq `json:"dingtalk_oauth"`         // 钉钉OAuth配置
 	CustomOAuth          *CustomOAuthReq   `json:"custom_oauth"`           // 自定义OAuth配置
 	BaseURL              *string           `json:"base_url"`               // base url 配置，为了支持前置代理
+	RateLimit            *RateLimitSetting `json:"rate_limit"`             // OpenAI 接口限流配置
 }
 
 type DingtalkOAuthReq struct {
@@ -391,15 +394,16 @@ func (c *CustomOAuth) From(e *types.CustomOAuth) *CustomOAuth {
 }
 
 type Setting struct {
-	EnableSSO            bool          `json:"enable_sso"`             // 是否开启SSO
-	ForceTwoFactorAuth   bool          `json:"force_two_factor_auth"`  // 是否强制两步验证
-	DisablePasswordLogin bool          `json:"disable_password_login"` // 是否禁用密码登录
-	EnableAutoLogin      bool          `json:"enable_auto_login"`      // 是否开启自动登录
-	DingtalkOAuth        DingtalkOAuth `json:"dingtalk_oauth"`         // 钉钉OAuth接入
-	CustomOAuth          CustomOAuth   `json:"custom_oauth"`           // 自定义OAuth接入
-	BaseURL              string        `json:"base_url,omitempty"`     // base url 配置，为了支持前置代理
-	CreatedAt            int64         `json:"created_at"`             // 创建时间
-	UpdatedAt            int64         `json:"updated_at"`             // 更新时间
+	EnableSSO            bool             `json:"enable_sso"`             // 是否开启SSO
+	ForceTwoFactorAuth   bool             `json:"force_two_factor_auth"`  // 是否强制两步验证
+	DisablePasswordLogin bool             `json:"disable_password_login"` // 是否禁用密码登录
+	EnableAutoLogin      bool             `json:"enable_auto_login"`      // 是否开启自动登录
+	DingtalkOAuth        DingtalkOAuth    `json:"dingtalk_oauth"`         // 钉钉OAuth接入
+	CustomOAuth          CustomOAuth      `json:"custom_oauth"`           // 自定义OAuth接入
+	BaseURL              string           `json:"base_url,omitempty"`     // base url 配置，为了支持前置代理
+	RateLimit            RateLimitSetting `json:"rate_limit"`             // OpenAI 接口限流配置
+	CreatedAt            int64            `json:"created_at"`             // 创建时间
+	UpdatedAt            int64            `json:"updated_at"`             // 更新时间
 }
 
 func (s *Setting) From(e *db.Setting) *Setting {
@@ -414,12 +418,48 @@ func (s *Setting) From(e *db.Setting) *Setting {
 	s.DingtalkOAuth = *cvt.From(e.DingtalkOauth, &DingtalkOAuth{})
 	s.CustomOAuth = *cvt.From(e.CustomOauth, &CustomOAuth{})
 	s.BaseURL = e.BaseURL
+	s.RateLimit = *cvt.From(e.RateLimit, &RateLimitSetting{})
 	s.CreatedAt = e.CreatedAt.Unix()
 	s.UpdatedAt = e.UpdatedAt.Unix()
 
 	return s
 }
 
+type RateLimit struct {
+	RPM int `json:"rpm"` // 每分钟请求数，0 表示不限制
+	TPM int `json:"tpm"` // 每分钟 token 数，0 表示不限制
+}
+
+func (r *RateLimit) From(e *types.RateLimit) *RateLimit {
+	if e == nil {
+		return r
+	}
+
+	r.RPM = e.RPM
+	r.TPM = e.TPM
+	return r
+}
+
+type RateLimitSetting struct {
+	APIKey RateLimit `json:"api_key"` // 单个 API Key 的限制
+	User   RateLimit `json:"user"`    // 单个用户的限制
+}
+
+func (r *RateLimitSetting) From(e *types.RateLimitSetting) *RateLimitSetting {
+	if e == nil {
+		return r
+	}
+
+	r.APIKey = *cvt.From(&e.APIKey, &RateLimit{})
+	r.User = *cvt.From(&e.User, &RateLimit{})
+	return r
+}
+
+type UpdateGroupRateLimitReq struct {
+	ID        string    `json:"id" validate:"required"` // 用户组ID
+	RateLimit RateLimit `json:"rate_limit"`             // 用户组共享的限流配置
+}
+
 // CompletionData 补全数据导出结构
 type CompletionData struct {
 	TaskID          string         `json:"task_id"`          // 任务ID
@@ -11,6 +11,8 @@ import (
 
 	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/db/adminloginhistory"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
@@ -58,7 +60,7 @@ func (p PermissionHook) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	switch m.Op() {
 	case ent.OpCreate, ent.OpUpdate, ent.OpDelete, ent.OpDeleteOne, ent.OpUpdateOne:
 		switch m.Type() {
-		case "Model", "Admin", "Setting", "AdminRole", "UserGroup", "UserGroupUser":
+		case "Model", "Admin", "Setting", "AdminRole", "UserGroup", "UserGroupUser", "BillingPlan", "BillingQuota", "TransformRule", "TransformPolicy", "AuditLog", "BudgetAlert":
 			return nil, errcode.ErrPermission.Wrap(fmt.Errorf("model mutation is not allowed"))
 		}
 	}
@@ -131,6 +133,18 @@ func PermissionInterceptor(logger *slog.Logger) ent.Interceptor {
 					return nil
 				})
 
+			case *db.BudgetAlertQuery:
+				return WithPermission(ctx, next, q, func(ctx context.Context, p *domain.Permissions) error {
+					qq.Where(budgetalert.GroupIDIn(p.GroupIDs...))
+					return nil
+				})
+
+			case *db.AuditLogQuery:
+				return WithPermission(ctx, next, q, func(ctx context.Context, p *domain.Permissions) error {
+					qq.Where(auditlog.UserIDIn(p.UserIDs...))
+					return nil
+				})
+
 			case *db.SecurityScanningQuery:
 				return WithPermission(ctx, next, q, func(ctx context.Context, p *domain.Permissions) error {
 					qq.Where(securityscanning.UserIDIn(p.UserIDs...))
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// 请求和响应原文使用 gzip 压缩保存，prompt 和 completion 保存明文用于全文检索
+type AuditLog struct {
+	ent.Schema
+}
+
+func (AuditLog) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "audit_logs",
+		},
+	}
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("request_id"),
+		field.String("task_id").Optional(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("model_id", uuid.UUID{}),
+		field.String("model_name").Optional(),
+		field.String("model_type").GoType(consts.ModelType("")),
+		field.String("path").Optional(),
+		field.Text("prompt").Optional(),
+		field.Text("completion").Optional(),
+		field.Bytes("request").Optional(),  // gzip 压缩的请求头和请求体
+		field.Bytes("response").Optional(), // gzip 压缩的响应头和响应体
+		field.Int64("raw_size").Default(0), // 压缩前的大小
+		field.Int64("size").Default(0),     // 压缩后的大小
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("user_id", "created_at"),
+		index.Fields("task_id"),
+	}
+}
@@ -27,8 +27,9 @@ func (BillingPlan) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Unique(),
 		field.String("name").Unique(),
-		field.String("description"),
-		field.JSON("rules", map[string]any{}),
+		field.String("description").Optional(),
+		field.JSON("rules", map[string]any{}).Optional(),
+		field.Int64("quota").Default(0).Comment("套餐授予的 token 数"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
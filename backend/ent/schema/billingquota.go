@@ -35,6 +35,7 @@ func (BillingQuota) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Unique(),
 		field.String("user_id").Unique(),
+		field.String("plan_id").Optional(),
 		field.Int64("total"),
 		field.Int64("used"),
 		field.Int64("remain"),
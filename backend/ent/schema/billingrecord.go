@@ -26,15 +26,16 @@ func (BillingRecord) Annotations() []schema.Annotation {
 func (BillingRecord) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Unique(),
-		field.String("tenant_id").Unique(),
-		field.String("user_id").Unique(),
+		field.String("tenant_id").Optional(),
+		field.String("user_id"),
+		field.String("request_id").Optional().Nillable().Unique(), // 同一请求只计费一次
 		field.String("model"),
 		field.String("operation"),
 		field.Int64("input_tokens"),
 		field.Int64("output_tokens"),
 		field.Int64("cost"),
 		field.Time("request_time").Default(time.Now),
-		field.JSON("metadata", map[string]any{}),
+		field.JSON("metadata", map[string]any{}).Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -34,7 +34,8 @@ func (BillingUsage) Mixin() []ent.Mixin {
 func (BillingUsage) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("id").Unique(),
-		field.String("user_id").Unique(),
+		field.String("user_id"),
+		field.String("request_id").Optional().Nillable().Unique(), // 同一请求只扣减一次
 		field.String("model_name"),
 		field.Int64("tokens"),
 		field.String("operation"),
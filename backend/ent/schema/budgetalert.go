@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BudgetAlert holds the schema definition for the BudgetAlert entity.
+// 每个用户组每月的每个阈值只告警一次
+type BudgetAlert struct {
+	ent.Schema
+}
+
+func (BudgetAlert) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "budget_alerts",
+		},
+	}
+}
+
+// Fields of the BudgetAlert.
+func (BudgetAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("group_id", uuid.UUID{}),
+		field.String("group_name").Optional(),
+		field.String("month"),  // 告警月份，如 2025-01
+		field.Int("threshold"), // 触发的阈值，为预算的百分比
+		field.Int64("spend"),   // 触发时的当月费用，单位为百万分之一货币单位
+		field.Int64("budget"),  // 当月预算，单位为百万分之一货币单位
+		field.String("currency").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the BudgetAlert.
+func (BudgetAlert) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the BudgetAlert.
+func (BudgetAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("group_id", "month", "threshold").Unique(),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// DLPHit holds the schema definition for the DLPHit entity.
+// 被拒绝的请求没有对应的任务，因此只按 task_id 关联
+type DLPHit struct {
+	ent.Schema
+}
+
+func (DLPHit) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "dlp_hits",
+		},
+	}
+}
+
+// Fields of the DLPHit.
+func (DLPHit) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("task_id"),
+		field.String("request_id").Optional(),
+		field.UUID("user_id", uuid.UUID{}).Optional(),
+		field.String("rule_id"),
+		field.String("rule_name").Optional(),
+		field.String("category").GoType(consts.DLPCategory("")),
+		field.String("action").GoType(consts.DLPAction("")),
+		field.String("preview").Optional(), // 脱敏后的命中内容
+		field.Int("count").Default(1),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the DLPHit.
+func (DLPHit) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the DLPHit.
+func (DLPHit) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id"),
+		index.Fields("created_at"),
+	}
+}
@@ -33,6 +33,7 @@ func (Model) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}),
 		field.UUID("user_id", uuid.UUID{}).Optional(),
 		field.String("model_name"),
+		field.Strings("aliases").Optional(),
 		field.String("model_type").GoType(consts.ModelType("")),
 		field.String("show_name").Optional(),
 		field.String("api_base"),
@@ -45,6 +46,8 @@ func (Model) Fields() []ent.Field {
 		field.String("status").GoType(consts.ModelStatus("")).Default(string(consts.ModelStatusActive)),
 		field.JSON("parameters", &types.ModelParam{}).Optional(),
 		field.Int("context_length").Optional(),
+		field.Int("weight").Default(1),
+		field.JSON("pricing", &types.ModelPricing{}).Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ModelHealthCheck holds the schema definition for the ModelHealthCheck entity.
+type ModelHealthCheck struct {
+	ent.Schema
+}
+
+func (ModelHealthCheck) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "model_health_checks",
+		},
+	}
+}
+
+// Fields of the ModelHealthCheck.
+func (ModelHealthCheck) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("model_id", uuid.UUID{}),
+		field.Bool("success"),
+		field.Int64("latency").Default(0), // 探测耗时，毫秒
+		field.String("error").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the ModelHealthCheck.
+func (ModelHealthCheck) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ModelHealthCheck.
+func (ModelHealthCheck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("model_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
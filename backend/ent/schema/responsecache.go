@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// ResponseCache holds the schema definition for the ResponseCache entity.
+type ResponseCache struct {
+	ent.Schema
+}
+
+func (ResponseCache) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "response_caches",
+		},
+	}
+}
+
+// Fields of the ResponseCache.
+func (ResponseCache) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}),
+		field.String("cache_key").Unique(),     // 归一化请求的哈希
+		field.String("context_key").Optional(), // 除相似度查找文本外的请求上下文哈希
+		field.String("model_name"),
+		field.String("model_type").GoType(consts.ModelType("")),
+		field.Text("prompt").Optional(), // 用于相似度查找的请求文本
+		field.Text("completion"),
+		field.String("finish_reason").Optional(),
+		field.Int64("input_tokens").Default(0),
+		field.Int64("output_tokens").Default(0),
+		field.Int64("hits").Default(0),
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector(1024)",
+			}).
+			Optional(),
+		field.Time("expires_at"),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ResponseCache.
+func (ResponseCache) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the ResponseCache.
+func (ResponseCache) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("model_name", "model_type"),
+		index.Fields("context_key"),
+		index.Fields("expires_at"),
+		index.Fields("embedding").
+			Annotations(
+				entsql.IndexType("hnsw"),
+				entsql.OpClass("vector_cosine_ops"),
+			),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePack holds the schema definition for the SecurityRulePack entity.
+// 自定义扫描规则包，每次上传生成一个新版本
+type SecurityRulePack struct {
+	ent.Schema
+}
+
+func (SecurityRulePack) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "security_rule_packs"},
+	}
+}
+
+// Fields of the SecurityRulePack.
+func (SecurityRulePack) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").Unique(),
+		field.String("description").Optional(),
+		field.Bool("enabled").Default(true),
+		field.Int("version").Default(0).Comment("扫描使用的版本"),
+		field.Int("latest_version").Default(0).Comment("最新上传的版本"),
+		field.String("creator").Optional(),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SecurityRulePack.
+func (SecurityRulePack) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("versions", SecurityRulePackVersion.Type),
+	}
+}
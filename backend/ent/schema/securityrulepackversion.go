@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SecurityRulePackVersion holds the schema definition for the SecurityRulePackVersion entity.
+type SecurityRulePackVersion struct {
+	ent.Schema
+}
+
+func (SecurityRulePackVersion) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "security_rule_pack_versions"},
+	}
+}
+
+// Fields of the SecurityRulePackVersion.
+func (SecurityRulePackVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("pack_id", uuid.UUID{}),
+		field.Int("version"),
+		field.Text("content"),                         // semgrep 格式的 YAML 规则
+		field.String("checksum"),                      // 内容的 sha256
+		field.JSON("rule_ids", []string{}).Optional(), // 包含的规则id
+		field.String("creator").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the SecurityRulePackVersion.
+func (SecurityRulePackVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("pack", SecurityRulePack.Type).Ref("versions").Unique().Field("pack_id").Required(),
+	}
+}
+
+// Indexes of the SecurityRulePackVersion.
+func (SecurityRulePackVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("pack_id", "version").Unique(),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 )
 
 // SecurityScanning holds the schema definition for the SecurityScanning entity.
@@ -37,6 +38,12 @@ func (SecurityScanning) Fields() []ent.Field {
 		field.String("language").GoType(consts.SecurityScanningLanguage("")),
 		field.String("rule").Optional(),
 		field.String("error_message").Optional(),
+		field.String("tool").Optional().Comment("导入的外部扫描工具名称，为空表示内置扫描"),
+		field.String("policy").Optional().Comment("扫描策略名称，为空表示只使用内置语言规则"),
+		field.UUID("base_id", uuid.UUID{}).Optional().Nillable().Comment("增量扫描对比的上一次扫描，为空表示全量扫描"),
+		field.JSON("file_hashes", map[string]string{}).Optional().Comment("扫描时工作区文件的哈希，key 为相对路径"),
+		field.Int("scanned_files").Default(0).Comment("本次实际扫描的文件数"),
+		field.JSON("sub_scans", []*types.SecurityScanningSubScan{}).Optional().Comment("自动识别语言时每个规则集的子扫描进度"),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now),
 	}
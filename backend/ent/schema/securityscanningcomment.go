@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// SecurityScanningComment holds the schema definition for the SecurityScanningComment entity.
+// 扫描发现的处置记录和评论
+type SecurityScanningComment struct {
+	ent.Schema
+}
+
+func (SecurityScanningComment) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "security_scanning_comments"},
+	}
+}
+
+// Fields of the SecurityScanningComment.
+func (SecurityScanningComment) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("result_id", uuid.UUID{}),
+		field.UUID("author_id", uuid.UUID{}),
+		field.String("author").Optional(), // 评论人用户名
+		field.String("triage_status").GoType(consts.SecurityScanningTriageStatus("")).Optional().Comment("评论时修改后的处置状态"),
+		field.Text("content").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the SecurityScanningComment.
+func (SecurityScanningComment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("result", SecurityScanningResult.Type).Ref("comments").Unique().Field("result_id").Required(),
+	}
+}
+
+// Indexes of the SecurityScanningComment.
+func (SecurityScanningComment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("result_id", "created_at"),
+	}
+}
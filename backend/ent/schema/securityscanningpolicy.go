@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// SecurityScanningPolicy holds the schema definition for the SecurityScanningPolicy entity.
+// 扫描策略，组合内置语言规则、自定义规则包和报告的阈值
+type SecurityScanningPolicy struct {
+	ent.Schema
+}
+
+func (SecurityScanningPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "security_scanning_policies"},
+	}
+}
+
+// Fields of the SecurityScanningPolicy.
+func (SecurityScanningPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").Unique(),
+		field.String("description").Optional(),
+		field.String("language").GoType(consts.SecurityScanningLanguage("")).Optional().Comment("内置语言规则，扫描请求未指定语言时使用"),
+		field.JSON("rule_pack_ids", []uuid.UUID{}).Optional(),
+		field.String("min_confidence").GoType(consts.SecurityRuleLevel("")).Default(string(consts.SecurityRuleLevelMedium)),
+		field.String("min_impact").GoType(consts.SecurityRuleLevel("")).Default(string(consts.SecurityRuleLevelMedium)),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SecurityScanningPolicy.
+func (SecurityScanningPolicy) Edges() []ent.Edge {
+	return nil
+}
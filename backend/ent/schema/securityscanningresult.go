@@ -8,8 +8,10 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
-	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 )
 
 // SecurityScanningResult holds the schema definition for the SecurityScanningResult entity.
@@ -46,6 +48,12 @@ func (SecurityScanningResult) Fields() []ent.Field {
 		field.Text("file_content"),
 		field.JSON("start_position", &types.Position{}),
 		field.JSON("end_position", &types.Position{}),
+		field.String("fingerprint").Optional().Comment("发现的指纹，用于对比前后两次扫描"),
+		field.String("state").GoType(consts.SecurityScanningFindingState("")).Optional().Comment("相对上一次扫描的状态 new persisting fixed"),
+		field.String("triage_status").GoType(consts.SecurityScanningTriageStatus("")).Default(string(consts.SecurityScanningTriageOpen)).Comment("处置状态"),
+		field.UUID("assignee_id", uuid.UUID{}).Optional().Nillable().Comment("处理人"),
+		field.Time("risk_expires_at").Optional().Nillable().Comment("接受风险的到期时间，到期后重新计入风险"),
+		field.UUID("suppression_id", uuid.UUID{}).Optional().Nillable().Comment("命中的屏蔽规则"),
 		field.Time("created_at").Default(time.Now),
 	}
 }
@@ -54,5 +62,6 @@ func (SecurityScanningResult) Fields() []ent.Field {
 func (SecurityScanningResult) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("security_scanning", SecurityScanning.Type).Ref("results").Unique().Field("security_scanning_id").Required(),
+		edge.To("comments", SecurityScanningComment.Type),
 	}
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// SecurityScanningSuppression holds the schema definition for the SecurityScanningSuppression entity.
+// 按指纹或规则屏蔽扫描发现，对之后的扫描同样生效
+type SecurityScanningSuppression struct {
+	ent.Schema
+}
+
+func (SecurityScanningSuppression) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "security_scanning_suppressions"},
+	}
+}
+
+// Fields of the SecurityScanningSuppression.
+func (SecurityScanningSuppression) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.UUID("workspace_id", uuid.UUID{}).Optional().Nillable().Comment("生效的工作区，为空表示所有工作区"),
+		field.String("fingerprint").Optional().Comment("屏蔽的发现指纹，为空时按规则屏蔽"),
+		field.String("check_id").Optional().Comment("屏蔽的规则"),
+		field.String("status").GoType(consts.SecurityScanningTriageStatus("")).Comment("误报或接受风险"),
+		field.Text("reason").Optional(),
+		field.Time("expires_at").Optional().Nillable().Comment("到期时间，为空表示永久生效"),
+		field.UUID("creator_id", uuid.UUID{}),
+		field.String("creator").Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the SecurityScanningSuppression.
+func (SecurityScanningSuppression) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SecurityScanningSuppression.
+func (SecurityScanningSuppression) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("workspace_id", "fingerprint"),
+		index.Fields("workspace_id", "check_id"),
+	}
+}
@@ -37,6 +37,7 @@ func (Setting) Fields() []ent.Field {
 		field.JSON("dingtalk_oauth", &types.DingtalkOAuth{}).Optional(),
 		field.JSON("custom_oauth", &types.CustomOAuth{}).Optional(),
 		field.String("base_url").Optional(),
+		field.JSON("rate_limit", &types.RateLimitSetting{}).Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -42,10 +42,17 @@ func (Task) Fields() []ent.Field {
 		field.Int64("code_lines").Optional(),
 		field.Int64("input_tokens").Optional(),
 		field.Int64("output_tokens").Optional(),
+		field.Int64("cached_input_tokens").Optional(), // 命中上游提示词缓存的输入 token 数，包含在 input_tokens 中
 		field.Bool("is_suggested").Default(false),
-		field.String("source_code").Optional(),                     // 当前文件的原文
-		field.JSON("cursor_position", map[string]any{}).Optional(), // 光标位置 {"line": 10, "column": 5}
-		field.String("user_input").Optional(),                      // 用户实际输入的内容
+		field.String("source_code").Optional(),                                 // 当前文件的原文
+		field.JSON("cursor_position", map[string]any{}).Optional(),             // 光标位置 {"line": 10, "column": 5}
+		field.String("user_input").Optional(),                                  // 用户实际输入的内容
+		field.Bool("cache_hit").Default(false),                                 // 是否命中响应缓存
+		field.Int64("policy_version").Optional(),                               // 应用的改写策略版本，0 表示未改写
+		field.String("usage_source").GoType(consts.UsageSource("")).Optional(), // token 数的来源 reported: 上游返回 estimated: 估算
+		field.Int64("cost").Default(0),                                         // 费用，单位为百万分之一货币单位
+		field.String("currency").Optional(),                                    // 费用的货币
+		field.String("status").GoType(consts.TaskStatus("")).Optional(),        // 最近一次请求的结束状态 completed aborted upstream_error
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
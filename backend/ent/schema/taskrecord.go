@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 )
 
 // TaskRecord holds the schema definition for the TaskRecord entity.
@@ -37,6 +38,8 @@ func (TaskRecord) Fields() []ent.Field {
 		field.Int64("output_tokens").Default(0),
 		field.Int64("code_lines").Default(0),
 		field.String("code").Optional(),
+		field.JSON("findings", []*types.CodeFinding{}).Optional(),
+		field.JSON("messages", []*types.ChoiceMessage{}).Optional(), // 完整的回复，包含推理过程、工具调用和所有 choice
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
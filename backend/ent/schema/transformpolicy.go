@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+// TransformPolicy holds the schema definition for the TransformPolicy entity.
+// 每次修改改写规则都生成新版本，保存当时全部规则的快照
+type TransformPolicy struct {
+	ent.Schema
+}
+
+func (TransformPolicy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "transform_policies",
+		},
+	}
+}
+
+// Fields of the TransformPolicy.
+func (TransformPolicy) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.Int64("version").Unique(),
+		field.JSON("rules", []*types.TransformRule{}).Optional(),
+		field.UUID("admin_id", uuid.UUID{}).Optional(),
+		field.Time("created_at").Default(time.Now),
+	}
+}
+
+// Edges of the TransformPolicy.
+func (TransformPolicy) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+// TransformRule holds the schema definition for the TransformRule entity.
+type TransformRule struct {
+	ent.Schema
+}
+
+func (TransformRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table: "transform_rules",
+		},
+	}
+}
+
+// Fields of the TransformRule.
+func (TransformRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New),
+		field.String("name").NotEmpty(),
+		field.UUID("model_id", uuid.UUID{}).Optional(), // 为空表示所有模型
+		field.UUID("group_id", uuid.UUID{}).Optional(), // 为空表示所有用户
+		field.Int("priority").Default(0),
+		field.Bool("enabled").Default(true),
+		field.JSON("config", &types.TransformConfig{}),
+		field.Time("created_at").Default(time.Now),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TransformRule.
+func (TransformRule) Edges() []ent.Edge {
+	return nil
+}
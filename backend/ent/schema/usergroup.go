@@ -9,6 +9,8 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 )
 
 // UserGroup holds the schema definition for the UserGroup entity.
@@ -28,6 +30,10 @@ func (UserGroup) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}),
 		field.UUID("admin_id", uuid.UUID{}),
 		field.String("name").NotEmpty(),
+		field.JSON("rate_limit", &types.RateLimit{}).Optional(),
+		field.JSON("audit_retention", &types.AuditRetention{}).Optional(),
+		field.JSON("budget", &types.GroupBudget{}).Optional(),
+		field.String("plan_id").Optional(),
 		field.Time("created_at").Default(time.Now),
 	}
 }
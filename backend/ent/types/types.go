@@ -1,5 +1,7 @@
 package types
 
+import "github.com/chaitin/MonkeyCode/backend/consts"
+
 type Position struct {
 	Col    int `json:"col"`
 	Line   int `json:"line"`
@@ -38,6 +40,8 @@ type ModelParam struct {
 	SupprtImages       bool `json:"support_images"`
 	SupportComputerUse bool `json:"support_computer_use"`
 	SupportPromptCache bool `json:"support_prompt_cache"`
+	ResponseCache      bool `json:"response_cache"`     // 是否缓存响应
+	ResponseCacheTTL   int  `json:"response_cache_ttl"` // 响应缓存有效秒数，0 表示使用默认配置
 }
 
 func DefaultModelParam() *ModelParam {
@@ -50,3 +54,95 @@ func DefaultModelParam() *ModelParam {
 		SupportPromptCache: false,
 	}
 }
+
+// ModelPricing 模型价格，单位为每百万 token 的价格
+type ModelPricing struct {
+	Input       float64 `json:"input"`        // 输入价格
+	Output      float64 `json:"output"`       // 输出价格
+	CachedInput float64 `json:"cached_input"` // 命中提示词缓存的输入价格，0 表示按输入价格计费
+	Currency    string  `json:"currency"`     // 货币，如 CNY、USD
+}
+
+type RateLimit struct {
+	RPM int `json:"rpm"` // 每分钟请求数，0 表示不限制
+	TPM int `json:"tpm"` // 每分钟 token 数，0 表示不限制
+}
+
+type RateLimitSetting struct {
+	APIKey RateLimit `json:"api_key"` // 单个 API Key 的限制
+	User   RateLimit `json:"user"`    // 单个用户的限制
+}
+
+// AuditRetention 审计归档的保留策略，0 表示使用全局配置
+type AuditRetention struct {
+	RetentionDay int `json:"retention_day"` // 保留天数
+	MaxSizeMB    int `json:"max_size_mb"`   // 组内用户归档的总大小上限，超过后删除最早的归档
+}
+
+// GroupBudget 用户组的月度预算
+type GroupBudget struct {
+	Monthly    float64 `json:"monthly"`    // 每月预算，0 表示不限制
+	Currency   string  `json:"currency"`   // 货币，只统计相同货币的费用
+	Thresholds []int   `json:"thresholds"` // 告警阈值，为预算的百分比，为空时使用默认配置
+}
+
+// TransformConfig 请求改写配置
+type TransformConfig struct {
+	SystemPrepend      string   `json:"system_prepend"`      // 插入到最前面的系统提示词
+	SystemAppend       string   `json:"system_append"`       // 追加到最后面的系统提示词
+	DefaultTemperature *float64 `json:"default_temperature"` // 请求未指定时使用的 temperature
+	MaxTemperature     *float64 `json:"max_temperature"`     // temperature 上限
+	DefaultMaxTokens   *int     `json:"default_max_tokens"`  // 请求未指定时使用的 max_tokens
+	MaxTokensLimit     *int     `json:"max_tokens_limit"`    // max_tokens 上限
+	StripParams        []string `json:"strip_params"`        // 需要删除的请求参数
+}
+
+// TransformRule 改写规则快照
+type TransformRule struct {
+	ID       string           `json:"id"`
+	Name     string           `json:"name"`
+	ModelID  string           `json:"model_id"` // 为空表示所有模型
+	GroupID  string           `json:"group_id"` // 为空表示所有用户
+	Priority int              `json:"priority"`
+	Enabled  bool             `json:"enabled"`
+	Config   *TransformConfig `json:"config"`
+}
+
+// CodeFinding 模型生成代码的安全检测结果
+type CodeFinding struct {
+	CheckID    string   `json:"check_id"`   // 规则ID
+	Severity   string   `json:"severity"`   // 严重程度 ERROR WARNING INFO
+	Category   string   `json:"category"`   // 风险类别
+	Confidence string   `json:"confidence"` // 置信度
+	Impact     string   `json:"impact"`     // 影响程度
+	Title      string   `json:"title"`      // 风险名称
+	Message    string   `json:"message"`    // 修复建议
+	Lines      string   `json:"lines"`      // 风险代码行
+	Start      Position `json:"start"`      // 开始位置，相对整个回复
+	End        Position `json:"end"`        // 结束位置
+}
+
+// ChoiceMessage 从模型响应中重建的一条完整回复，对应响应中的一个 choice
+type ChoiceMessage struct {
+	Index        int         `json:"index"`                   // choice 下标
+	Content      string      `json:"content,omitempty"`       // 回复内容
+	Reasoning    string      `json:"reasoning,omitempty"`     // 推理过程，如 R1 的 reasoning_content
+	ToolCalls    []*ToolCall `json:"tool_calls,omitempty"`    // 工具调用
+	FinishReason string      `json:"finish_reason,omitempty"` // 结束原因
+}
+
+// ToolCall 模型发起的工具调用
+type ToolCall struct {
+	ID        string `json:"id"`        // 调用ID
+	Name      string `json:"name"`      // 工具名称
+	Arguments string `json:"arguments"` // 调用参数，JSON 格式
+}
+
+// SecurityScanningSubScan 自动识别语言的扫描中每个规则集的子扫描进度
+type SecurityScanningSubScan struct {
+	Language consts.SecurityScanningLanguage `json:"language"` // 扫描语言，为空表示扫描策略的自定义规则包
+	Status   consts.SecurityScanningStatus   `json:"status"`   // 子扫描状态
+	Files    int                             `json:"files"`    // 识别为该语言的文件数
+	Results  int                             `json:"results"`  // 本次扫描出的结果数
+	Error    string                          `json:"error"`    // 错误信息
+}
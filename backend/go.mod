@@ -7,6 +7,7 @@ toolchain go1.24.2
 require (
 	entgo.io/ent v0.14.4
 	github.com/GoYoko/web v1.4.0
+	github.com/alicebob/miniredis/v2 v2.37.0
 	github.com/chaitin/ModelKit v1.4.3
 	github.com/doquangtan/socket.io/v4 v4.0.8
 	github.com/golang-migrate/migrate/v4 v4.18.3
@@ -15,6 +16,7 @@ require (
 	github.com/labstack/echo/v4 v4.13.4
 	github.com/lib/pq v1.10.9
 	github.com/lionsoul2014/ip2region/binding/golang v0.0.0-20250630080345-f9402614f6ba
+	github.com/mattn/go-sqlite3 v1.14.33
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/pgvector/pgvector-go v0.3.0
 	github.com/redis/go-redis/v9 v9.7.3
@@ -26,6 +28,7 @@ require (
 	golang.org/x/time v0.12.0
 	google.golang.org/grpc v1.74.2
 	google.golang.org/protobuf v1.36.6
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -124,6 +127,7 @@ require (
 	github.com/valyala/tcplisten v1.0.0 // indirect
 	github.com/yargevad/filepathx v1.0.0 // indirect
 	github.com/yuin/goldmark v1.7.11 // indirect
+	github.com/yuin/gopher-lua v1.1.1 // indirect
 	github.com/zclconf/go-cty v1.16.2 // indirect
 	github.com/zclconf/go-cty-yaml v1.1.0 // indirect
 	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
@@ -145,5 +149,4 @@ require (
 	google.golang.org/genai v1.13.0 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20250603155806-513f23925822 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250728155136-f173205681a0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
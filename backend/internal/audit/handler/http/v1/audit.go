@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/GoYoko/web"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/internal/middleware"
+)
+
+type AuditHandler struct {
+	usecase domain.AuditUsecase
+}
+
+func NewAuditHandler(
+	w *web.Web,
+	usecase domain.AuditUsecase,
+	auth *middleware.AuthMiddleware,
+	active *middleware.ActiveMiddleware,
+	readonly *middleware.ReadOnlyMiddleware,
+) *AuditHandler {
+	a := &AuditHandler{
+		usecase: usecase,
+	}
+
+	g := w.Group("/api/v1/audit")
+	g.Use(auth.Auth(), active.Active("admin"), readonly.Guard())
+
+	g.GET("/log", web.BindHandler(a.Search, web.WithPage()))
+	g.GET("/log/info", web.BaseHandler(a.Detail))
+	g.POST("/export", web.BindHandler(a.Export))
+	g.GET("/retention", web.BaseHandler(a.Retention))
+	g.PUT("/retention/group", web.BindHandler(a.UpdateGroupRetention))
+
+	return a
+}
+
+// Search 检索审计归档
+//
+//	@Tags			Audit
+//	@Summary		检索审计归档
+//	@Description	按用户、模型、时间范围检索审计归档，keyword 在提示词和回复中全文检索
+//	@ID				search-audit-log
+//	@Accept			json
+//	@Produce		json
+//	@Param			page	query		domain.SearchAuditReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.ListAuditLogResp}
+//	@Router			/api/v1/audit/log [get]
+func (h *AuditHandler) Search(c *web.Context, req domain.SearchAuditReq) error {
+	req.Pagination = c.Page()
+	resp, err := h.usecase.Search(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// Detail 获取审计归档详情
+//
+//	@Tags			Audit
+//	@Summary		获取审计归档详情
+//	@Description	获取审计归档详情，包含请求和响应原文
+//	@ID				audit-log-info
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"归档ID"
+//	@Success		200	{object}	web.Resp{data=domain.AuditLogDetail}
+//	@Router			/api/v1/audit/log/info [get]
+func (h *AuditHandler) Detail(c *web.Context) error {
+	detail, err := h.usecase.Detail(c.Request().Context(), c.QueryParam("id"))
+	if err != nil {
+		return err
+	}
+	return c.Success(detail)
+}
+
+// Export 导出审计归档
+//
+//	@Tags			Audit
+//	@Summary		导出审计归档
+//	@Description	导出选中的归档或任务下的所有归档，用于合规审查
+//	@ID				export-audit-log
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.ExportAuditReq	true	"参数"
+//	@Success		200		{object}	[]domain.AuditLogDetail
+//	@Router			/api/v1/audit/export [post]
+func (h *AuditHandler) Export(c *web.Context, req domain.ExportAuditReq) error {
+	logs, err := h.usecase.Export(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	disposition := fmt.Sprintf("attachment; filename=monkeycode-audit-%s.json", time.Now().Format("20060102150405"))
+	c.Response().Header().Set("Content-Disposition", disposition)
+	return c.JSON(http.StatusOK, logs)
+}
+
+// Retention 获取归档保留策略
+//
+//	@Tags			Audit
+//	@Summary		获取归档保留策略
+//	@Description	获取默认保留策略、用户组的保留策略和当前归档总大小
+//	@ID				audit-retention
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{data=domain.AuditRetentionSetting}
+//	@Router			/api/v1/audit/retention [get]
+func (h *AuditHandler) Retention(c *web.Context) error {
+	setting, err := h.usecase.Retention(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(setting)
+}
+
+// UpdateGroupRetention 更新用户组的归档保留策略
+//
+//	@Tags			Audit
+//	@Summary		更新用户组的归档保留策略
+//	@Description	更新用户组的归档保留策略，均为 0 时恢复默认配置
+//	@ID				update-group-audit-retention
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateGroupAuditRetentionReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Router			/api/v1/audit/retention/group [put]
+func (h *AuditHandler) UpdateGroupRetention(c *web.Context, req domain.UpdateGroupAuditRetentionReq) error {
+	if err := h.usecase.UpdateGroupRetention(c.Request().Context(), &req); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
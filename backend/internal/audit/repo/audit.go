@@ -0,0 +1,299 @@
+package repo
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/auditlog"
+	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+type AuditRepo struct {
+	db *db.Client
+}
+
+func NewAuditRepo(db *db.Client) domain.AuditRepo {
+	return &AuditRepo{db: db}
+}
+
+// listFields 列表查询不读取压缩的原文
+var listFields = []string{
+	auditlog.FieldID,
+	auditlog.FieldRequestID,
+	auditlog.FieldTaskID,
+	auditlog.FieldUserID,
+	auditlog.FieldModelID,
+	auditlog.FieldModelName,
+	auditlog.FieldModelType,
+	auditlog.FieldPath,
+	auditlog.FieldPrompt,
+	auditlog.FieldCompletion,
+	auditlog.FieldRawSize,
+	auditlog.FieldSize,
+	auditlog.FieldCreatedAt,
+}
+
+// Save implements domain.AuditRepo.
+func (r *AuditRepo) Save(ctx context.Context, record *domain.AuditRecord) error {
+	ctx = rule.SkipPermission(ctx)
+	userID, err := uuid.Parse(record.UserID)
+	if err != nil {
+		return err
+	}
+	modelID, err := uuid.Parse(record.ModelID)
+	if err != nil {
+		return err
+	}
+	req, err := compress(record.Request)
+	if err != nil {
+		return err
+	}
+	resp, err := compress(record.Response)
+	if err != nil {
+		return err
+	}
+	return r.db.AuditLog.Create().
+		SetRequestID(record.RequestID).
+		SetTaskID(record.TaskID).
+		SetUserID(userID).
+		SetModelID(modelID).
+		SetModelName(record.ModelName).
+		SetModelType(record.ModelType).
+		SetPath(record.Path).
+		SetPrompt(record.Prompt).
+		SetCompletion(record.Completion).
+		SetRequest(req).
+		SetResponse(resp).
+		SetRawSize(int64(len(record.Request) + len(record.Response))).
+		SetSize(int64(len(req) + len(resp))).
+		Exec(ctx)
+}
+
+// Search implements domain.AuditRepo.
+func (r *AuditRepo) Search(ctx context.Context, req domain.SearchAuditReq) ([]*db.AuditLog, *db.PageInfo, error) {
+	q := r.db.AuditLog.Query()
+	if id, err := uuid.Parse(req.UserID); err == nil {
+		q.Where(auditlog.UserID(id))
+	}
+	if id, err := uuid.Parse(req.ModelID); err == nil {
+		q.Where(auditlog.ModelID(id))
+	}
+	if req.ModelType != "" {
+		q.Where(auditlog.ModelType(req.ModelType))
+	}
+	if req.TaskID != "" {
+		q.Where(auditlog.TaskID(req.TaskID))
+	}
+	if req.Keyword != "" {
+		q.Where(auditlog.Or(
+			auditlog.PromptContainsFold(req.Keyword),
+			auditlog.CompletionContainsFold(req.Keyword),
+		))
+	}
+	if req.Start > 0 {
+		q.Where(auditlog.CreatedAtGTE(time.Unix(req.Start, 0)))
+	}
+	if req.End > 0 {
+		q.Where(auditlog.CreatedAtLT(time.Unix(req.End, 0)))
+	}
+
+	cnt, err := q.Clone().Count(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	logs, err := q.
+		Select(listFields...).
+		Order(auditlog.ByCreatedAt(sql.OrderDesc())).
+		Offset(req.Size * (req.Page - 1)).
+		Limit(req.Size).
+		All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return logs, &db.PageInfo{
+		HasNextPage: req.Page*req.Size < cnt,
+		TotalCount:  int64(cnt),
+	}, nil
+}
+
+// Get implements domain.AuditRepo.
+func (r *AuditRepo) Get(ctx context.Context, id string) (*db.AuditLog, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	log, err := r.db.AuditLog.Get(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return decompressLog(log)
+}
+
+// ListByIDs implements domain.AuditRepo.
+func (r *AuditRepo) ListByIDs(ctx context.Context, ids, taskIDs []string) ([]*db.AuditLog, error) {
+	uids := cvt.Filter(ids, func(_ int, id string) (uuid.UUID, bool) {
+		uid, err := uuid.Parse(id)
+		return uid, err == nil
+	})
+	if len(uids) == 0 && len(taskIDs) == 0 {
+		return nil, fmt.Errorf("ids and task_ids are both empty")
+	}
+	logs, err := r.db.AuditLog.Query().
+		Where(auditlog.Or(
+			auditlog.IDIn(uids...),
+			auditlog.TaskIDIn(taskIDs...),
+		)).
+		Order(auditlog.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, log := range logs {
+		if _, err := decompressLog(log); err != nil {
+			return nil, err
+		}
+	}
+	return logs, nil
+}
+
+// GroupRetentions implements domain.AuditRepo.
+func (r *AuditRepo) GroupRetentions(ctx context.Context) ([]*domain.GroupAuditRetention, error) {
+	groups, err := r.db.UserGroup.Query().
+		WithUsers().
+		Where(usergroup.AuditRetentionNotNil()).
+		All(rule.SkipPermission(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(groups, func(_ int, g *db.UserGroup) *domain.GroupAuditRetention {
+		return &domain.GroupAuditRetention{
+			GroupID:      g.ID.String(),
+			GroupName:    g.Name,
+			RetentionDay: g.AuditRetention.RetentionDay,
+			MaxSizeMB:    g.AuditRetention.MaxSizeMB,
+			UserIDs: cvt.Iter(g.Edges.Users, func(_ int, u *db.User) uuid.UUID {
+				return u.ID
+			}),
+		}
+	}), nil
+}
+
+// UpdateGroupRetention implements domain.AuditRepo.
+func (r *AuditRepo) UpdateGroupRetention(ctx context.Context, id string, retention *types.AuditRetention) error {
+	gid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	up := r.db.UserGroup.UpdateOneID(gid)
+	if retention.RetentionDay == 0 && retention.MaxSizeMB == 0 {
+		up.ClearAuditRetention()
+	} else {
+		up.SetAuditRetention(retention)
+	}
+	return up.Exec(ctx)
+}
+
+// TotalSize implements domain.AuditRepo.
+func (r *AuditRepo) TotalSize(ctx context.Context) (int64, error) {
+	var res []struct {
+		Size int64 `json:"size"`
+	}
+	if err := r.db.AuditLog.Query().
+		Modify(func(s *sql.Selector) {
+			s.Select(sql.As("COALESCE(SUM(size), 0)", "size"))
+		}).
+		Scan(rule.SkipPermission(ctx), &res); err != nil {
+		return 0, err
+	}
+	if len(res) == 0 {
+		return 0, nil
+	}
+	return res[0].Size, nil
+}
+
+// DeleteBefore implements domain.AuditRepo.
+// include 不为空时只删除这些用户的归档，exclude 中的用户不删除
+func (r *AuditRepo) DeleteBefore(ctx context.Context, before time.Time, include, exclude []uuid.UUID) (int, error) {
+	q := r.db.AuditLog.Delete().Where(auditlog.CreatedAtLT(before))
+	if len(include) > 0 {
+		q.Where(auditlog.UserIDIn(include...))
+	}
+	if len(exclude) > 0 {
+		q.Where(auditlog.UserIDNotIn(exclude...))
+	}
+	return q.Exec(rule.SkipPermission(ctx))
+}
+
+// DeleteOverSize implements domain.AuditRepo.
+// 从最新的归档开始累计大小，删除超出上限的部分，userIDs 不为空时只统计这些用户的归档
+func (r *AuditRepo) DeleteOverSize(ctx context.Context, maxSize int64, userIDs []uuid.UUID) (int, error) {
+	where, args := "", []any{maxSize}
+	if len(userIDs) > 0 {
+		where = "WHERE user_id = ANY($2::uuid[])"
+		args = append(args, pq.Array(cvt.Iter(userIDs, func(_ int, id uuid.UUID) string {
+			return id.String()
+		})))
+	}
+	res, err := r.db.ExecContext(ctx, fmt.Sprintf(`DELETE FROM audit_logs WHERE id IN (
+	SELECT id FROM (
+		SELECT id, SUM(size) OVER (ORDER BY created_at DESC, id DESC) AS total FROM audit_logs %s
+	) t WHERE total > $1
+)`, where), args...)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
+func compress(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decompress(data []byte) ([]byte, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// decompressLog 将归档中的原文解压，原地替换
+func decompressLog(log *db.AuditLog) (*db.AuditLog, error) {
+	var err error
+	if log.Request, err = decompress(log.Request); err != nil {
+		return nil, fmt.Errorf("decompress request: %w", err)
+	}
+	if log.Response, err = decompress(log.Response); err != nil {
+		return nil, fmt.Errorf("decompress response: %w", err)
+	}
+	return log, nil
+}
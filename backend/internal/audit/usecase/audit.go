@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+type AuditUsecase struct {
+	repo   domain.AuditRepo
+	cfg    *config.Config
+	redis  *redis.Client
+	logger *slog.Logger
+}
+
+func NewAuditUsecase(
+	repo domain.AuditRepo,
+	cfg *config.Config,
+	redis *redis.Client,
+	logger *slog.Logger,
+) domain.AuditUsecase {
+	a := &AuditUsecase{
+		repo:   repo,
+		cfg:    cfg,
+		redis:  redis,
+		logger: logger.With("module", "AuditUsecase"),
+	}
+	go a.cleanup(context.Background())
+	return a
+}
+
+// Search implements domain.AuditUsecase.
+func (a *AuditUsecase) Search(ctx context.Context, req domain.SearchAuditReq) (*domain.ListAuditLogResp, error) {
+	logs, p, err := a.repo.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListAuditLogResp{
+		PageInfo: p,
+		Logs: cvt.Iter(logs, func(_ int, e *db.AuditLog) *domain.AuditLog {
+			return cvt.From(e, &domain.AuditLog{})
+		}),
+	}, nil
+}
+
+// Detail implements domain.AuditUsecase.
+func (a *AuditUsecase) Detail(ctx context.Context, id string) (*domain.AuditLogDetail, error) {
+	log, err := a.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return detail(log), nil
+}
+
+// Export implements domain.AuditUsecase.
+func (a *AuditUsecase) Export(ctx context.Context, req *domain.ExportAuditReq) ([]*domain.AuditLogDetail, error) {
+	logs, err := a.repo.ListByIDs(ctx, req.IDs, req.TaskIDs)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(logs, func(_ int, e *db.AuditLog) *domain.AuditLogDetail {
+		return detail(e)
+	}), nil
+}
+
+func detail(e *db.AuditLog) *domain.AuditLogDetail {
+	return &domain.AuditLogDetail{
+		AuditLog: *cvt.From(e, &domain.AuditLog{}),
+		Request:  string(e.Request),
+		Response: string(e.Response),
+	}
+}
+
+// Retention implements domain.AuditUsecase.
+func (a *AuditUsecase) Retention(ctx context.Context) (*domain.AuditRetentionSetting, error) {
+	groups, err := a.repo.GroupRetentions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	size, err := a.repo.TotalSize(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.AuditRetentionSetting{
+		RetentionDay: a.cfg.Audit.RetentionDay,
+		MaxSizeMB:    a.cfg.Audit.MaxSizeMB,
+		TotalSize:    size,
+		Groups:       groups,
+	}, nil
+}
+
+// UpdateGroupRetention implements domain.AuditUsecase.
+func (a *AuditUsecase) UpdateGroupRetention(ctx context.Context, req *domain.UpdateGroupAuditRetentionReq) error {
+	return a.repo.UpdateGroupRetention(ctx, req.ID, &types.AuditRetention{
+		RetentionDay: req.RetentionDay,
+		MaxSizeMB:    req.MaxSizeMB,
+	})
+}
+
+// cleanup 定期按保留天数和大小上限清理归档
+func (a *AuditUsecase) cleanup(ctx context.Context) {
+	interval := time.Duration(a.cfg.Audit.CleanupInterval) * time.Second
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 多实例部署时每个周期只由一个实例清理
+			ok, err := a.redis.SetNX(ctx, consts.AuditCleanupLock, time.Now().Unix(), interval*9/10).Result()
+			if err != nil || !ok {
+				continue
+			}
+			if err := a.clean(ctx, time.Now()); err != nil {
+				a.logger.With("error", err).WarnContext(ctx, "failed to clean audit logs")
+			}
+		}
+	}
+}
+
+func (a *AuditUsecase) clean(ctx context.Context, now time.Time) error {
+	groups, err := a.repo.GroupRetentions(ctx)
+	if err != nil {
+		return err
+	}
+
+	byDay, overridden := retentionPlan(groups)
+	for day, users := range byDay {
+		n, err := a.repo.DeleteBefore(ctx, now.AddDate(0, 0, -day), users, nil)
+		if err != nil {
+			return err
+		}
+		a.logger.With("day", day).With("users", len(users)).With("count", n).DebugContext(ctx, "group audit logs expired")
+	}
+	if day := a.cfg.Audit.RetentionDay; day > 0 {
+		n, err := a.repo.DeleteBefore(ctx, now.AddDate(0, 0, -day), nil, overridden)
+		if err != nil {
+			return err
+		}
+		a.logger.With("day", day).With("count", n).DebugContext(ctx, "audit logs expired")
+	}
+
+	for _, g := range groups {
+		if g.MaxSizeMB <= 0 || len(g.UserIDs) == 0 {
+			continue
+		}
+		n, err := a.repo.DeleteOverSize(ctx, int64(g.MaxSizeMB)<<20, g.UserIDs)
+		if err != nil {
+			return err
+		}
+		a.logger.With("group_id", g.GroupID).With("count", n).DebugContext(ctx, "group audit logs over size")
+	}
+	if mb := a.cfg.Audit.MaxSizeMB; mb > 0 {
+		n, err := a.repo.DeleteOverSize(ctx, int64(mb)<<20, nil)
+		if err != nil {
+			return err
+		}
+		a.logger.With("count", n).DebugContext(ctx, "audit logs over size")
+	}
+	return nil
+}
+
+// retentionPlan 按保留天数对设置了组策略的用户分组
+// 用户属于多个设置了保留天数的组时，使用最长的保留天数；overridden 为这些用户，不再按默认天数清理
+func retentionPlan(groups []*domain.GroupAuditRetention) (map[int][]uuid.UUID, []uuid.UUID) {
+	days := make(map[uuid.UUID]int)
+	for _, g := range groups {
+		if g.RetentionDay <= 0 {
+			continue
+		}
+		for _, id := range g.UserIDs {
+			days[id] = max(days[id], g.RetentionDay)
+		}
+	}
+	byDay := make(map[int][]uuid.UUID)
+	overridden := make([]uuid.UUID, 0, len(days))
+	for id, day := range days {
+		byDay[day] = append(byDay[day], id)
+		overridden = append(overridden, id)
+	}
+	return byDay, overridden
+}
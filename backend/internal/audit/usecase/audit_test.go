@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+func TestRetentionPlan(t *testing.T) {
+	u1, u2, u3 := uuid.New(), uuid.New(), uuid.New()
+	byDay, overridden := retentionPlan([]*domain.GroupAuditRetention{
+		{RetentionDay: 30, UserIDs: []uuid.UUID{u1, u2}},
+		{RetentionDay: 365, UserIDs: []uuid.UUID{u2}},
+		{MaxSizeMB: 100, UserIDs: []uuid.UUID{u3}},
+	})
+
+	if !slices.Equal(byDay[30], []uuid.UUID{u1}) || !slices.Equal(byDay[365], []uuid.UUID{u2}) || len(byDay) != 2 {
+		t.Errorf("user in several groups should use the longest retention: %v", byDay)
+	}
+	if len(overridden) != 2 || slices.Contains(overridden, u3) {
+		t.Errorf("groups without retention day should not override default: %v", overridden)
+	}
+}
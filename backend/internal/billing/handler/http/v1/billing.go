@@ -9,26 +9,49 @@ import (
 
 type BillingHandler struct {
 	usecase domain.BillingUsecase
+	proxy   domain.ProxyUsecase
 }
 
 func NewBillingHandler(
 	w *web.Web,
 	usecase domain.BillingUsecase,
+	proxy domain.ProxyUsecase,
 	auth *middleware.AuthMiddleware,
 	active *middleware.ActiveMiddleware,
+	readonly *middleware.ReadOnlyMiddleware,
 ) *BillingHandler {
 	b := &BillingHandler{
 		usecase: usecase,
+		proxy:   proxy,
 	}
 
 	g := w.Group("/api/v1/billing")
-	g.Use(auth.Auth(), active.Active("admin"))
+	g.Use(auth.Auth(), active.Active("admin"), readonly.Guard())
 
 	g.GET("/chat/record", web.BindHandler(b.ListChatRecord, web.WithPage()))
 	g.GET("/completion/record", web.BindHandler(b.ListCompletionRecord, web.WithPage()))
 	g.GET("/completion/info", web.BaseHandler(b.CompletionInfo))
 	g.GET("/chat/info", web.BaseHandler(b.ChatInfo))
 
+	// record queue
+	g.GET("/record/queue", web.BaseHandler(b.RecordQueueStat))
+	g.GET("/record/dead", web.BindHandler(b.ListDeadRecord))
+	g.POST("/record/dead/retry", web.BindHandler(b.RetryDeadRecord))
+
+	// plan & quota
+	g.GET("/plan", web.BaseHandler(b.ListPlan))
+	g.POST("/plan", web.BindHandler(b.CreatePlan))
+	g.PUT("/plan", web.BindHandler(b.UpdatePlan))
+	g.DELETE("/plan", web.BaseHandler(b.DeletePlan))
+	g.POST("/plan/assign", web.BindHandler(b.AssignPlan))
+	g.GET("/quota", web.BindHandler(b.ListQuota, web.WithPage()))
+	g.POST("/quota/topup", web.BindHandler(b.TopUpQuota))
+
+	// budget
+	g.GET("/budget", web.BaseHandler(b.ListBudget))
+	g.PUT("/budget/group", web.BindHandler(b.UpdateGroupBudget))
+	g.GET("/budget/alert", web.BindHandler(b.ListBudgetAlert, web.WithPage()))
+
 	return b
 }
 
@@ -109,3 +132,245 @@ func (h *BillingHandler) ChatInfo(c *web.Context) error {
 	}
 	return c.Success(info)
 }
+
+// ListPlan 获取套餐列表
+//
+//	@Tags			Billing
+//	@Summary		获取套餐列表
+//	@Description	获取套餐列表
+//	@ID				list-billing-plan
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{data=[]domain.BillingPlan}
+//	@Router			/api/v1/billing/plan [get]
+func (h *BillingHandler) ListPlan(c *web.Context) error {
+	plans, err := h.usecase.ListPlan(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(plans)
+}
+
+// CreatePlan 创建套餐
+//
+//	@Tags			Billing
+//	@Summary		创建套餐
+//	@Description	创建套餐
+//	@ID				create-billing-plan
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.CreateBillingPlanReq	true	"套餐参数"
+//	@Success		200		{object}	web.Resp{data=domain.BillingPlan}
+//	@Router			/api/v1/billing/plan [post]
+func (h *BillingHandler) CreatePlan(c *web.Context, req domain.CreateBillingPlanReq) error {
+	plan, err := h.usecase.CreatePlan(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(plan)
+}
+
+// UpdatePlan 更新套餐
+//
+//	@Tags			Billing
+//	@Summary		更新套餐
+//	@Description	更新套餐，修改额度只影响之后分配的用户
+//	@ID				update-billing-plan
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateBillingPlanReq	true	"套餐参数"
+//	@Success		200		{object}	web.Resp{data=domain.BillingPlan}
+//	@Router			/api/v1/billing/plan [put]
+func (h *BillingHandler) UpdatePlan(c *web.Context, req domain.UpdateBillingPlanReq) error {
+	plan, err := h.usecase.UpdatePlan(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(plan)
+}
+
+// DeletePlan 删除套餐
+//
+//	@Tags			Billing
+//	@Summary		删除套餐
+//	@Description	删除套餐
+//	@ID				delete-billing-plan
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"套餐ID"
+//	@Success		200	{object}	web.Resp{}
+//	@Router			/api/v1/billing/plan [delete]
+func (h *BillingHandler) DeletePlan(c *web.Context) error {
+	if err := h.usecase.DeletePlan(c.Request().Context(), c.QueryParam("id")); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
+// AssignPlan 分配套餐
+//
+//	@Tags			Billing
+//	@Summary		分配套餐
+//	@Description	为用户或用户组分配套餐，用户额度重置为套餐额度
+//	@ID				assign-billing-plan
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.AssignBillingPlanReq	true	"分配参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Router			/api/v1/billing/plan/assign [post]
+func (h *BillingHandler) AssignPlan(c *web.Context, req domain.AssignBillingPlanReq) error {
+	if err := h.usecase.AssignPlan(c.Request().Context(), &req); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
+// ListQuota 获取用户额度列表
+//
+//	@Tags			Billing
+//	@Summary		获取用户额度列表
+//	@Description	获取用户额度列表
+//	@ID				list-billing-quota
+//	@Accept			json
+//	@Produce		json
+//	@Param			page	query		domain.ListQuotaReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.ListBillingQuotaResp}
+//	@Router			/api/v1/billing/quota [get]
+func (h *BillingHandler) ListQuota(c *web.Context, req domain.ListQuotaReq) error {
+	req.Pagination = c.Page()
+	quotas, err := h.usecase.ListQuota(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+	return c.Success(quotas)
+}
+
+// TopUpQuota 充值用户额度
+//
+//	@Tags			Billing
+//	@Summary		充值用户额度
+//	@Description	充值用户额度，tokens 为负数时扣减
+//	@ID				topup-billing-quota
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.TopUpQuotaReq	true	"充值参数"
+//	@Success		200		{object}	web.Resp{data=domain.BillingQuota}
+//	@Router			/api/v1/billing/quota/topup [post]
+func (h *BillingHandler) TopUpQuota(c *web.Context, req domain.TopUpQuotaReq) error {
+	quota, err := h.usecase.TopUpQuota(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(quota)
+}
+
+// ListBudget 获取用户组预算
+//
+//	@Tags			Billing
+//	@Summary		获取用户组预算
+//	@Description	获取设置了月度预算的用户组及本月费用
+//	@ID				list-budget
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{data=[]domain.GroupBudget}
+//	@Router			/api/v1/billing/budget [get]
+func (h *BillingHandler) ListBudget(c *web.Context) error {
+	budgets, err := h.usecase.ListBudget(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(budgets)
+}
+
+// UpdateGroupBudget 设置用户组预算
+//
+//	@Tags			Billing
+//	@Summary		设置用户组预算
+//	@Description	设置用户组的月度预算和告警阈值，预算为 0 时取消
+//	@ID				update-group-budget
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateGroupBudgetReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Router			/api/v1/billing/budget/group [put]
+func (h *BillingHandler) UpdateGroupBudget(c *web.Context, req domain.UpdateGroupBudgetReq) error {
+	if err := h.usecase.UpdateGroupBudget(c.Request().Context(), &req); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
+// ListBudgetAlert 获取预算告警
+//
+//	@Tags			Billing
+//	@Summary		获取预算告警
+//	@Description	获取用户组当月费用超过预算阈值的告警
+//	@ID				list-budget-alert
+//	@Accept			json
+//	@Produce		json
+//	@Param			page	query		domain.ListBudgetAlertReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.ListBudgetAlertResp}
+//	@Router			/api/v1/billing/budget/alert [get]
+func (h *BillingHandler) ListBudgetAlert(c *web.Context, req domain.ListBudgetAlertReq) error {
+	req.Pagination = c.Page()
+	alerts, err := h.usecase.ListBudgetAlert(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+	return c.Success(alerts)
+}
+
+// RecordQueueStat 获取请求记录队列状态
+//
+//	@Tags			Billing
+//	@Summary		获取请求记录队列状态
+//	@Description	获取请求记录队列的积压、重试和死信统计
+//	@ID				record-queue-stat
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{data=domain.RecordQueueStat}
+//	@Router			/api/v1/billing/record/queue [get]
+func (h *BillingHandler) RecordQueueStat(c *web.Context) error {
+	stat, err := h.proxy.RecordQueueStat(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(stat)
+}
+
+// ListDeadRecord 获取写入失败的请求记录
+//
+//	@Tags			Billing
+//	@Summary		获取写入失败的请求记录
+//	@Description	获取多次写入数据库失败后转入死信的请求记录
+//	@ID				list-dead-record
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.ListDeadRecordReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=[]domain.DeadRecord}
+//	@Router			/api/v1/billing/record/dead [get]
+func (h *BillingHandler) ListDeadRecord(c *web.Context, req domain.ListDeadRecordReq) error {
+	records, err := h.proxy.ListDeadRecords(c.Request().Context(), req.Limit)
+	if err != nil {
+		return err
+	}
+	return c.Success(records)
+}
+
+// RetryDeadRecord 重新写入请求记录
+//
+//	@Tags			Billing
+//	@Summary		重新写入请求记录
+//	@Description	将死信重新放回请求记录队列
+//	@ID				retry-dead-record
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.RetryDeadRecordReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Router			/api/v1/billing/record/dead/retry [post]
+func (h *BillingHandler) RetryDeadRecord(c *web.Context, req domain.RetryDeadRecordReq) error {
+	if err := h.proxy.RetryDeadRecord(c.Request().Context(), req.ID); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
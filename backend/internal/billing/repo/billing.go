@@ -8,6 +8,7 @@ import (
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/dlphit"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
@@ -44,7 +45,11 @@ func (b *BillingRepo) ChatInfo(ctx context.Context, id, userID string) (*domain.
 		return nil, err
 	}
 
-	return cvt.From(record, &domain.ChatInfo{}), nil
+	info := cvt.From(record, &domain.ChatInfo{})
+	if info.DLPHits, err = b.dlpHits(ctx, id); err != nil {
+		return nil, err
+	}
+	return info, nil
 }
 
 // CompletionInfo implements domain.BillingRepo.
@@ -66,7 +71,25 @@ func (b *BillingRepo) CompletionInfo(ctx context.Context, id, userID string) (*d
 		return nil, err
 	}
 
-	return cvt.From(record, &domain.CompletionInfo{}), nil
+	info := cvt.From(record, &domain.CompletionInfo{})
+	if info.DLPHits, err = b.dlpHits(ctx, id); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// dlpHits 查询任务的外发数据防泄漏命中记录
+func (b *BillingRepo) dlpHits(ctx context.Context, taskID string) ([]*domain.DLPHit, error) {
+	hits, err := b.db.DLPHit.Query().
+		Where(dlphit.TaskID(taskID)).
+		Order(dlphit.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(hits, func(_ int, e *db.DLPHit) *domain.DLPHit {
+		return cvt.From(e, &domain.DLPHit{})
+	}), nil
 }
 
 // ListChatRecord implements domain.BillingRepo.
@@ -0,0 +1,130 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/budgetalert"
+	"github.com/chaitin/MonkeyCode/backend/db/task"
+	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
+)
+
+// GroupBudgets implements domain.BillingRepo.
+func (b *BillingRepo) GroupBudgets(ctx context.Context) ([]*domain.GroupBudget, error) {
+	groups, err := b.db.UserGroup.Query().
+		WithUsers().
+		Where(usergroup.BudgetNotNil()).
+		Order(usergroup.ByCreatedAt(sql.OrderAsc())).
+		All(rule.SkipPermission(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(groups, func(_ int, g *db.UserGroup) *domain.GroupBudget {
+		return &domain.GroupBudget{
+			GroupID:    g.ID.String(),
+			GroupName:  g.Name,
+			Monthly:    g.Budget.Monthly,
+			Currency:   g.Budget.Currency,
+			Thresholds: g.Budget.Thresholds,
+			UserIDs: cvt.Iter(g.Edges.Users, func(_ int, u *db.User) uuid.UUID {
+				return u.ID
+			}),
+		}
+	}), nil
+}
+
+// UpdateGroupBudget implements domain.BillingRepo.
+func (b *BillingRepo) UpdateGroupBudget(ctx context.Context, id string, budget *types.GroupBudget) error {
+	gid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	up := b.db.UserGroup.UpdateOneID(gid)
+	if budget.Monthly == 0 {
+		up.ClearBudget()
+	} else {
+		up.SetBudget(budget)
+	}
+	return up.Exec(ctx)
+}
+
+// GroupSpend implements domain.BillingRepo.
+// 返回用户自 since 起指定货币的费用，单位为百万分之一货币单位
+func (b *BillingRepo) GroupSpend(ctx context.Context, userIDs []uuid.UUID, currency string, since time.Time) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	var res []struct {
+		Cost int64 `json:"cost"`
+	}
+	if err := b.db.Task.Query().
+		Where(
+			task.UserIDIn(userIDs...),
+			task.Currency(currency),
+			task.CreatedAtGTE(since),
+		).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As("COALESCE(SUM(cost), 0)", "cost")
+		}).
+		Scan(entx.SkipSoftDelete(ctx), &res); err != nil {
+		return 0, err
+	}
+	if len(res) == 0 {
+		return 0, nil
+	}
+	return res[0].Cost, nil
+}
+
+// CreateBudgetAlert implements domain.BillingRepo.
+// 同一用户组每月每个阈值只保存一次，已存在时返回 false
+func (b *BillingRepo) CreateBudgetAlert(ctx context.Context, alert *db.BudgetAlert) (bool, error) {
+	err := b.db.BudgetAlert.Create().
+		SetGroupID(alert.GroupID).
+		SetGroupName(alert.GroupName).
+		SetMonth(alert.Month).
+		SetThreshold(alert.Threshold).
+		SetSpend(alert.Spend).
+		SetBudget(alert.Budget).
+		SetCurrency(alert.Currency).
+		Exec(ctx)
+	if db.IsConstraintError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ListBudgetAlert implements domain.BillingRepo.
+func (b *BillingRepo) ListBudgetAlert(ctx context.Context, req domain.ListBudgetAlertReq) (*domain.ListBudgetAlertResp, error) {
+	q := b.db.BudgetAlert.Query().
+		Order(budgetalert.ByCreatedAt(sql.OrderDesc()))
+	if req.GroupID != "" {
+		gid, err := uuid.Parse(req.GroupID)
+		if err != nil {
+			return nil, err
+		}
+		q.Where(budgetalert.GroupID(gid))
+	}
+
+	alerts, p, err := q.Page(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListBudgetAlertResp{
+		PageInfo: p,
+		Alerts: cvt.Iter(alerts, func(_ int, e *db.BudgetAlert) *domain.BudgetAlert {
+			return cvt.From(e, &domain.BudgetAlert{})
+		}),
+	}, nil
+}
@@ -0,0 +1,310 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/billingplan"
+	"github.com/chaitin/MonkeyCode/backend/db/billingquota"
+	"github.com/chaitin/MonkeyCode/backend/db/user"
+	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
+	"github.com/chaitin/MonkeyCode/backend/db/usergroupuser"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
+)
+
+// ListPlan implements domain.BillingRepo.
+func (b *BillingRepo) ListPlan(ctx context.Context) ([]*db.BillingPlan, error) {
+	return b.db.BillingPlan.Query().
+		Order(billingplan.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+// CreatePlan implements domain.BillingRepo.
+func (b *BillingRepo) CreatePlan(ctx context.Context, req *domain.CreateBillingPlanReq) (*db.BillingPlan, error) {
+	return b.db.BillingPlan.Create().
+		SetID(uuid.NewString()).
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetQuota(req.Quota).
+		Save(ctx)
+}
+
+// UpdatePlan implements domain.BillingRepo.
+func (b *BillingRepo) UpdatePlan(ctx context.Context, req *domain.UpdateBillingPlanReq) (*db.BillingPlan, error) {
+	up := b.db.BillingPlan.UpdateOneID(req.ID)
+	if req.Name != nil {
+		up.SetName(*req.Name)
+	}
+	if req.Description != nil {
+		up.SetDescription(*req.Description)
+	}
+	if req.Quota != nil {
+		up.SetQuota(*req.Quota)
+	}
+	return up.Save(ctx)
+}
+
+// DeletePlan implements domain.BillingRepo.
+// 删除套餐后，使用该套餐的用户保留剩余额度，新加入用户组的用户不再受额度限制
+func (b *BillingRepo) DeletePlan(ctx context.Context, id string) error {
+	return entx.WithTx(ctx, b.db, func(tx *db.Tx) error {
+		if err := tx.UserGroup.Update().
+			Where(usergroup.PlanID(id)).
+			ClearPlanID().
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.BillingQuota.Update().
+			Where(billingquota.PlanID(id)).
+			ClearPlanID().
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.BillingPlan.DeleteOneID(id).Exec(ctx)
+	})
+}
+
+// AssignPlan implements domain.BillingRepo.
+func (b *BillingRepo) AssignPlan(ctx context.Context, req *domain.AssignBillingPlanReq) error {
+	return entx.WithTx(ctx, b.db, func(tx *db.Tx) error {
+		plan, err := tx.BillingPlan.Get(ctx, req.PlanID)
+		if err != nil {
+			return err
+		}
+
+		userIDs := req.UserIDs
+		for _, id := range req.GroupIDs {
+			gid, err := uuid.Parse(id)
+			if err != nil {
+				return err
+			}
+			if err := tx.UserGroup.UpdateOneID(gid).SetPlanID(plan.ID).Exec(ctx); err != nil {
+				return err
+			}
+			members, err := tx.UserGroupUser.Query().
+				Where(usergroupuser.UserGroupID(gid)).
+				All(ctx)
+			if err != nil {
+				return err
+			}
+			for _, m := range members {
+				userIDs = append(userIDs, m.UserID.String())
+			}
+		}
+
+		for _, id := range cvt.Unique(userIDs) {
+			if _, err := uuid.Parse(id); err != nil {
+				return err
+			}
+			if err := grantQuota(ctx, tx.BillingQuota, id, plan); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// grantQuota 将用户额度重置为套餐额度
+func grantQuota(ctx context.Context, c *db.BillingQuotaClient, userID string, plan *db.BillingPlan) error {
+	return c.Create().
+		SetID(uuid.NewString()).
+		SetUserID(userID).
+		SetPlanID(plan.ID).
+		SetTotal(plan.Quota).
+		SetUsed(0).
+		SetRemain(plan.Quota).
+		OnConflictColumns(billingquota.FieldUserID).
+		Update(func(u *db.BillingQuotaUpsert) {
+			u.SetPlanID(plan.ID)
+			u.SetTotal(plan.Quota)
+			u.SetUsed(0)
+			u.SetRemain(plan.Quota)
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+}
+
+// ListQuota implements domain.BillingRepo.
+func (b *BillingRepo) ListQuota(ctx context.Context, req domain.ListQuotaReq) (*domain.ListBillingQuotaResp, error) {
+	q := b.db.BillingQuota.Query().
+		Order(billingquota.ByUpdatedAt(sql.OrderDesc()))
+	if req.UserID != "" {
+		q.Where(billingquota.UserID(req.UserID))
+	}
+	if req.PlanID != "" {
+		q.Where(billingquota.PlanID(req.PlanID))
+	}
+
+	quotas, p, err := q.Page(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListBillingQuotaResp{
+		PageInfo: p,
+		Quotas: cvt.Iter(quotas, func(_ int, e *db.BillingQuota) *domain.BillingQuota {
+			return cvt.From(e, &domain.BillingQuota{})
+		}),
+	}, nil
+}
+
+// TopUpQuota implements domain.BillingRepo.
+func (b *BillingRepo) TopUpQuota(ctx context.Context, req *domain.TopUpQuotaReq) (*db.BillingQuota, error) {
+	if _, err := uuid.Parse(req.UserID); err != nil {
+		return nil, err
+	}
+	var quota *db.BillingQuota
+	err := entx.WithTx(ctx, b.db, func(tx *db.Tx) error {
+		if err := tx.BillingQuota.Create().
+			SetID(uuid.NewString()).
+			SetUserID(req.UserID).
+			SetTotal(req.Tokens).
+			SetUsed(0).
+			SetRemain(req.Tokens).
+			OnConflictColumns(billingquota.FieldUserID).
+			Update(func(u *db.BillingQuotaUpsert) {
+				u.AddTotal(req.Tokens)
+				u.AddRemain(req.Tokens)
+				u.SetUpdatedAt(time.Now())
+			}).
+			Exec(ctx); err != nil {
+			return err
+		}
+		q, err := tx.BillingQuota.Query().Where(billingquota.UserID(req.UserID)).Only(ctx)
+		if err != nil {
+			return err
+		}
+		quota = q
+		return nil
+	})
+	return quota, err
+}
+
+// Quota implements domain.BillingRepo.
+// 用户没有额度记录时，使用所在用户组的套餐创建额度，都没有时返回 nil 表示不限制
+func (b *BillingRepo) Quota(ctx context.Context, userID string) (*db.BillingQuota, error) {
+	ctx = rule.SkipPermission(ctx)
+	q, err := b.db.BillingQuota.Query().Where(billingquota.UserID(userID)).Only(ctx)
+	if err == nil {
+		return q, nil
+	}
+	if !db.IsNotFound(err) {
+		return nil, err
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := b.db.UserGroup.Query().
+		Where(
+			usergroup.HasUsersWith(user.ID(uid)),
+			usergroup.PlanIDNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	// 用户属于多个有套餐的用户组时，使用额度最多的套餐
+	plan, err := b.db.BillingPlan.Query().
+		Where(billingplan.IDIn(cvt.Iter(groups, func(_ int, g *db.UserGroup) string {
+			return g.PlanID
+		})...)).
+		Order(billingplan.ByQuota(sql.OrderDesc())).
+		First(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.db.BillingQuota.Create().
+		SetID(uuid.NewString()).
+		SetUserID(userID).
+		SetPlanID(plan.ID).
+		SetTotal(plan.Quota).
+		SetUsed(0).
+		SetRemain(plan.Quota).
+		OnConflictColumns(billingquota.FieldUserID).
+		Ignore().
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	return b.db.BillingQuota.Query().Where(billingquota.UserID(userID)).Only(ctx)
+}
+
+// Debit implements domain.BillingRepo.
+// 按请求实际使用的 token 数扣减额度，并记录用量与费用
+// 用量和费用记录以 request_id 唯一，记录已存在时说明该请求已经扣减过，直接返回
+func (b *BillingRepo) Debit(ctx context.Context, record *domain.RecordParam) error {
+	ctx = rule.SkipPermission(ctx)
+	modelID, err := uuid.Parse(record.ModelID)
+	if err != nil {
+		return err
+	}
+	m, err := b.db.Model.Get(ctx, modelID)
+	if err != nil {
+		return err
+	}
+
+	tokens := record.InputTokens + record.OutputTokens
+	operation := string(record.ModelType)
+	var requestID *string
+	if record.RequestID != "" {
+		requestID = &record.RequestID
+	}
+
+	err = entx.WithTx(ctx, b.db, func(tx *db.Tx) error {
+		if err := tx.BillingRecord.Create().
+			SetID(uuid.NewString()).
+			SetUserID(record.UserID).
+			SetNillableRequestID(requestID).
+			SetModel(m.ModelName).
+			SetOperation(operation).
+			SetInputTokens(record.InputTokens).
+			SetOutputTokens(record.OutputTokens).
+			SetCost(record.Cost).
+			SetMetadata(map[string]any{
+				"task_id":    record.TaskID,
+				"request_id": record.RequestID,
+				"model_id":   record.ModelID,
+				"api_key_id": record.APIKeyID,
+				"currency":   record.Currency,
+				// 命中上游提示词缓存的输入 token 数
+				"cached_tokens": record.CachedTokens,
+			}).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.BillingUsage.Create().
+			SetID(uuid.NewString()).
+			SetUserID(record.UserID).
+			SetNillableRequestID(requestID).
+			SetModelName(m.ModelName).
+			SetTokens(tokens).
+			SetOperation(operation).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.BillingQuota.Update().
+			Where(billingquota.UserID(record.UserID)).
+			AddUsed(tokens).
+			AddRemain(-tokens).
+			Exec(ctx)
+	})
+	if db.IsConstraintError(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,215 @@
+package repo
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/billingquota"
+	"github.com/chaitin/MonkeyCode/backend/db/migrate"
+	_ "github.com/chaitin/MonkeyCode/backend/db/runtime"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+)
+
+// newTestRepo 使用内存 sqlite 创建额度相关的表
+func newTestRepo(t *testing.T) (*BillingRepo, *db.Client) {
+	t.Helper()
+	client, err := db.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	tables := []*schema.Table{
+		migrate.AdminsTable,
+		migrate.UsersTable,
+		migrate.UserGroupsTable,
+		migrate.UserGroupUsersTable,
+		migrate.ModelsTable,
+		migrate.BillingPlansTable,
+		migrate.BillingQuotasTable,
+		migrate.BillingRecordsTable,
+		migrate.BillingUsagesTable,
+	}
+	if err := migrate.Create(context.Background(), client.Schema, tables); err != nil {
+		t.Fatal(err)
+	}
+	return &BillingRepo{db: client}, client
+}
+
+func createPlan(t *testing.T, ctx context.Context, c *db.Client, name string, quota int64) *db.BillingPlan {
+	t.Helper()
+	p, err := c.BillingPlan.Create().
+		SetID(uuid.NewString()).
+		SetName(name).
+		SetQuota(quota).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestQuota(t *testing.T) {
+	r, c := newTestRepo(t)
+	ctx := rule.SkipPermission(context.Background())
+
+	admin, err := c.Admin.Create().
+		SetID(uuid.New()).
+		SetUsername("admin").
+		SetPassword("x").
+		SetStatus(consts.AdminStatusActive).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := c.User.Create().SetID(uuid.New()).SetUsername("alice").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := c.User.Create().SetID(uuid.New()).SetUsername("bob").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	small := createPlan(t, ctx, c, "small", 100)
+	large := createPlan(t, ctx, c, "large", 1000)
+	for _, p := range []*db.BillingPlan{small, large} {
+		g, err := c.UserGroup.Create().
+			SetID(uuid.New()).
+			SetName(p.Name).
+			SetAdminID(admin.ID).
+			SetPlanID(p.ID).
+			Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := c.UserGroupUser.Create().
+			SetID(uuid.New()).
+			SetUserGroupID(g.ID).
+			SetUserID(u.ID).
+			Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 没有额度记录且不在有套餐的用户组中时不限制
+	q, err := r.Quota(ctx, other.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q != nil {
+		t.Fatalf("quota = %+v, want nil", q)
+	}
+
+	// 使用额度最多的套餐创建额度
+	q, err = r.Quota(ctx, u.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q == nil || q.PlanID != large.ID || q.Total != 1000 || q.Used != 0 || q.Remain != 1000 {
+		t.Fatalf("quota = %+v, want plan %s with 1000 remain", q, large.ID)
+	}
+
+	// 已有额度记录时直接返回
+	if err := c.BillingQuota.Update().
+		Where(billingquota.UserID(u.ID.String())).
+		SetRemain(10).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	q, err = r.Quota(ctx, u.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Remain != 10 {
+		t.Fatalf("remain = %d, want 10", q.Remain)
+	}
+	n, err := c.BillingQuota.Query().Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1 {
+		t.Fatalf("quota rows = %d, want 1", n)
+	}
+}
+
+func TestDebit(t *testing.T) {
+	r, c := newTestRepo(t)
+	ctx := rule.SkipPermission(context.Background())
+
+	m, err := c.Model.Create().
+		SetID(uuid.New()).
+		SetModelName("gpt-4o").
+		SetModelType(consts.ModelTypeLLM).
+		SetAPIBase("http://localhost").
+		SetProvider(consts.ModelProviderOpenAI).
+		Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID := uuid.NewString()
+	if err := c.BillingQuota.Create().
+		SetID(uuid.NewString()).
+		SetUserID(userID).
+		SetTotal(100).
+		SetUsed(0).
+		SetRemain(100).
+		Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	record := &domain.RecordParam{
+		RequestID:    "req-1",
+		UserID:       userID,
+		ModelID:      m.ID.String(),
+		ModelType:    consts.ModelTypeLLM,
+		InputTokens:  30,
+		OutputTokens: 50,
+	}
+	check := func(used, remain int64, records int) {
+		t.Helper()
+		q, err := c.BillingQuota.Query().Where(billingquota.UserID(userID)).Only(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Total != 100 || q.Used != used || q.Remain != remain {
+			t.Fatalf("quota = %d/%d/%d, want 100/%d/%d", q.Total, q.Used, q.Remain, used, remain)
+		}
+		nr, err := c.BillingRecord.Query().Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nu, err := c.BillingUsage.Query().Count(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if nr != records || nu != records {
+			t.Fatalf("records = %d, usages = %d, want %d", nr, nu, records)
+		}
+	}
+
+	if err := r.Debit(ctx, record); err != nil {
+		t.Fatal(err)
+	}
+	check(80, 20, 1)
+
+	// 同一请求重复扣减不生效
+	if err := r.Debit(ctx, record); err != nil {
+		t.Fatal(err)
+	}
+	check(80, 20, 1)
+
+	// 额度可以扣成负数，由代理在下一次请求时拒绝
+	record.RequestID = "req-2"
+	if err := r.Debit(ctx, record); err != nil {
+		t.Fatal(err)
+	}
+	check(160, -60, 2)
+}
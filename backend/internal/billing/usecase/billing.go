@@ -2,16 +2,38 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
 
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 )
 
 type BillingUsecase struct {
-	repo domain.BillingRepo
+	repo   domain.BillingRepo
+	cfg    *config.Config
+	redis  *redis.Client
+	logger *slog.Logger
 }
 
-func NewBillingUsecase(repo domain.BillingRepo) domain.BillingUsecase {
-	return &BillingUsecase{repo: repo}
+func NewBillingUsecase(
+	repo domain.BillingRepo,
+	cfg *config.Config,
+	redis *redis.Client,
+	logger *slog.Logger,
+) domain.BillingUsecase {
+	b := &BillingUsecase{
+		repo:   repo,
+		cfg:    cfg,
+		redis:  redis,
+		logger: logger.With("module", "BillingUsecase"),
+	}
+	go b.budgetCheck(context.Background())
+	return b
 }
 
 // ListChatRecord implements domain.BillingUsecase.
@@ -33,3 +55,62 @@ func (b *BillingUsecase) CompletionInfo(ctx context.Context, id, userID string)
 func (b *BillingUsecase) ChatInfo(ctx context.Context, id, userID string) (*domain.ChatInfo, error) {
 	return b.repo.ChatInfo(ctx, id, userID)
 }
+
+// ListPlan implements domain.BillingUsecase.
+func (b *BillingUsecase) ListPlan(ctx context.Context) ([]*domain.BillingPlan, error) {
+	plans, err := b.repo.ListPlan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(plans, func(_ int, e *db.BillingPlan) *domain.BillingPlan {
+		return cvt.From(e, &domain.BillingPlan{})
+	}), nil
+}
+
+// CreatePlan implements domain.BillingUsecase.
+func (b *BillingUsecase) CreatePlan(ctx context.Context, req *domain.CreateBillingPlanReq) (*domain.BillingPlan, error) {
+	plan, err := b.repo.CreatePlan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(plan, &domain.BillingPlan{}), nil
+}
+
+// UpdatePlan implements domain.BillingUsecase.
+func (b *BillingUsecase) UpdatePlan(ctx context.Context, req *domain.UpdateBillingPlanReq) (*domain.BillingPlan, error) {
+	if req.Quota != nil && *req.Quota < 0 {
+		return nil, fmt.Errorf("quota must not be negative")
+	}
+	plan, err := b.repo.UpdatePlan(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(plan, &domain.BillingPlan{}), nil
+}
+
+// DeletePlan implements domain.BillingUsecase.
+func (b *BillingUsecase) DeletePlan(ctx context.Context, id string) error {
+	return b.repo.DeletePlan(ctx, id)
+}
+
+// AssignPlan implements domain.BillingUsecase.
+func (b *BillingUsecase) AssignPlan(ctx context.Context, req *domain.AssignBillingPlanReq) error {
+	if len(req.UserIDs) == 0 && len(req.GroupIDs) == 0 {
+		return fmt.Errorf("user_ids and group_ids are both empty")
+	}
+	return b.repo.AssignPlan(ctx, req)
+}
+
+// ListQuota implements domain.BillingUsecase.
+func (b *BillingUsecase) ListQuota(ctx context.Context, req domain.ListQuotaReq) (*domain.ListBillingQuotaResp, error) {
+	return b.repo.ListQuota(ctx, req)
+}
+
+// TopUpQuota implements domain.BillingUsecase.
+func (b *BillingUsecase) TopUpQuota(ctx context.Context, req *domain.TopUpQuotaReq) (*domain.BillingQuota, error) {
+	quota, err := b.repo.TopUpQuota(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(quota, &domain.BillingQuota{}), nil
+}
@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+// costUnit 任务费用的单位为百万分之一货币单位
+const costUnit = 1e6
+
+// ListBudget implements domain.BillingUsecase.
+func (b *BillingUsecase) ListBudget(ctx context.Context) ([]*domain.GroupBudget, error) {
+	groups, err := b.repo.GroupBudgets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	since := monthStart(time.Now())
+	for _, g := range groups {
+		b.withDefault(g)
+		spend, err := b.repo.GroupSpend(ctx, g.UserIDs, g.Currency, since)
+		if err != nil {
+			return nil, err
+		}
+		g.Spend = float64(spend) / costUnit
+	}
+	return groups, nil
+}
+
+// UpdateGroupBudget implements domain.BillingUsecase.
+func (b *BillingUsecase) UpdateGroupBudget(ctx context.Context, req *domain.UpdateGroupBudgetReq) error {
+	if req.Currency != "" && !domain.ValidCurrency(req.Currency) {
+		return fmt.Errorf("invalid currency %q", req.Currency)
+	}
+	for _, t := range req.Thresholds {
+		if t <= 0 {
+			return fmt.Errorf("threshold must be positive")
+		}
+	}
+	thresholds := slices.Clone(req.Thresholds)
+	slices.Sort(thresholds)
+	return b.repo.UpdateGroupBudget(ctx, req.ID, &types.GroupBudget{
+		Monthly:    req.Monthly,
+		Currency:   req.Currency,
+		Thresholds: slices.Compact(thresholds),
+	})
+}
+
+// ListBudgetAlert implements domain.BillingUsecase.
+func (b *BillingUsecase) ListBudgetAlert(ctx context.Context, req domain.ListBudgetAlertReq) (*domain.ListBudgetAlertResp, error) {
+	return b.repo.ListBudgetAlert(ctx, req)
+}
+
+func (b *BillingUsecase) withDefault(g *domain.GroupBudget) {
+	if g.Currency == "" {
+		g.Currency = b.cfg.Billing.Currency
+	}
+	if len(g.Thresholds) == 0 {
+		g.Thresholds = b.cfg.Billing.BudgetThresholds
+	}
+}
+
+// budgetCheck 定期检查用户组当月费用，超过阈值时告警
+func (b *BillingUsecase) budgetCheck(ctx context.Context) {
+	interval := time.Duration(b.cfg.Billing.BudgetCheckInterval) * time.Second
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// 多实例部署时每个周期只由一个实例检查
+			ok, err := b.redis.SetNX(ctx, consts.BudgetCheckLock, time.Now().Unix(), interval*9/10).Result()
+			if err != nil || !ok {
+				continue
+			}
+			if err := b.checkBudget(ctx, time.Now()); err != nil {
+				b.logger.With("error", err).WarnContext(ctx, "failed to check group budget")
+			}
+		}
+	}
+}
+
+func (b *BillingUsecase) checkBudget(ctx context.Context, now time.Time) error {
+	groups, err := b.repo.GroupBudgets(ctx)
+	if err != nil {
+		return err
+	}
+	month := now.Format("2006-01")
+	since := monthStart(now)
+	for _, g := range groups {
+		b.withDefault(g)
+		budget := int64(g.Monthly * costUnit)
+		if budget <= 0 {
+			continue
+		}
+		spend, err := b.repo.GroupSpend(ctx, g.UserIDs, g.Currency, since)
+		if err != nil {
+			return err
+		}
+		gid, err := uuid.Parse(g.GroupID)
+		if err != nil {
+			return err
+		}
+		for _, t := range crossedThresholds(spend, budget, g.Thresholds) {
+			created, err := b.repo.CreateBudgetAlert(ctx, &db.BudgetAlert{
+				GroupID:   gid,
+				GroupName: g.GroupName,
+				Month:     month,
+				Threshold: t,
+				Spend:     spend,
+				Budget:    budget,
+				Currency:  g.Currency,
+			})
+			if err != nil {
+				return err
+			}
+			if created {
+				b.logger.With(
+					"group", g.GroupName,
+					"threshold", t,
+					"spend", float64(spend)/costUnit,
+					"budget", g.Monthly,
+					"currency", g.Currency,
+				).WarnContext(ctx, "group spend crossed budget threshold")
+			}
+		}
+	}
+	return nil
+}
+
+// crossedThresholds 返回费用已达到的阈值
+func crossedThresholds(spend, budget int64, thresholds []int) []int {
+	var res []int
+	for _, t := range thresholds {
+		if t > 0 && spend*100 >= budget*int64(t) {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+func monthStart(now time.Time) time.Time {
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+}
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCrossedThresholds(t *testing.T) {
+	for _, c := range []struct {
+		spend, budget int64
+		want          []int
+	}{
+		{spend: 0, budget: 100, want: nil},
+		{spend: 79, budget: 100, want: nil},
+		{spend: 80, budget: 100, want: []int{80}},
+		{spend: 150, budget: 100, want: []int{80, 100, 150}},
+	} {
+		got := crossedThresholds(c.spend, c.budget, []int{80, 100, 150, 0})
+		if !slices.Equal(got, c.want) {
+			t.Errorf("spend %d budget %d: expect %v, got %v", c.spend, c.budget, c.want, got)
+		}
+	}
+}
@@ -28,6 +28,7 @@ func NewDashboardHandler(
 	g.GET("/user-events", web.BaseHandler(h.UserEvents))
 	g.GET("/user-code-rank", web.BindHandler(h.UserCodeRank))
 	g.GET("/user-heatmap", web.BaseHandler(h.UserHeatmap))
+	g.GET("/code-finding-stat", web.BindHandler(h.CodeFindingStat))
 
 	return h
 }
@@ -167,3 +168,22 @@ func (h *DashboardHandler) UserHeatmap(c *web.Context) error {
 	}
 	return c.Success(userHeatmap)
 }
+
+// CodeFindingStat 获取生成代码的安全风险统计
+//
+//	@Tags			Dashboard
+//	@Summary		获取生成代码的安全风险统计
+//	@Description	获取模型生成代码中检测到的安全风险统计
+//	@ID				code-finding-stat-dashboard
+//	@Accept			json
+//	@Produce		json
+//	@Param			filter	query		domain.StatisticsFilter	true	"筛选参数"
+//	@Success		200		{object}	web.Resp{data=domain.CodeFindingStat}
+//	@Router			/api/v1/dashboard/code-finding-stat [get]
+func (h *DashboardHandler) CodeFindingStat(c *web.Context, req domain.StatisticsFilter) error {
+	stat, err := h.usecase.CodeFindingStat(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+	return c.Success(stat)
+}
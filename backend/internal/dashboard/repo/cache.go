@@ -0,0 +1,58 @@
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/task"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+type modelCacheRow struct {
+	ModelID      uuid.UUID `json:"model_id"`
+	InputTokens  int64     `json:"input_tokens"`
+	CachedTokens int64     `json:"cached_tokens"`
+}
+
+// promptCache 按模型统计上游提示词缓存命中率，命中响应缓存的请求没有调用上游，不参与统计
+func (d *DashboardRepo) promptCache(ctx context.Context, req domain.StatisticsFilter, ps ...predicate.Task) ([]*domain.ModelCacheStat, error) {
+	rows := make([]modelCacheRow, 0)
+	if err := d.db.Task.Query().
+		Where(task.CreatedAtGTE(req.StartTime())).
+		Where(task.CacheHit(false)).
+		Where(task.ModelIDNotNil()).
+		Where(task.InputTokensGT(0)).
+		Where(ps...).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As("model_id", "model_id"),
+				sql.As("SUM(input_tokens)", "input_tokens"),
+				sql.As("COALESCE(SUM(cached_input_tokens), 0)", "cached_tokens"),
+			).
+				GroupBy(task.FieldModelID).
+				OrderBy(sql.Desc("input_tokens"))
+		}).
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	names, err := d.modelNames(ctx, cvt.Iter(rows, func(_ int, r modelCacheRow) uuid.UUID {
+		return r.ModelID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(rows, func(_ int, r modelCacheRow) *domain.ModelCacheStat {
+		return &domain.ModelCacheStat{
+			ModelID:      r.ModelID.String(),
+			ModelName:    names[r.ModelID.String()],
+			InputTokens:  r.InputTokens,
+			CachedTokens: r.CachedTokens,
+			HitRatio:     float64(r.CachedTokens) / float64(r.InputTokens) * 100,
+		}
+	}), nil
+}
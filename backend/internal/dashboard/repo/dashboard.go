@@ -3,6 +3,7 @@ package repo
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -11,6 +12,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
+	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
 	"github.com/chaitin/MonkeyCode/backend/db/user"
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
@@ -87,6 +89,7 @@ type DateValue struct {
 	CodeCount     int64     `json:"code_count"`
 	AcceptedCount int64     `json:"accepted_count"`
 	CodeLines     int64     `json:"code_lines"`
+	CachedTokens  int64     `json:"cached_tokens"`
 	Count         int64     `json:"count"`
 }
 
@@ -114,6 +117,7 @@ func (d *DashboardRepo) TimeStat(ctx context.Context, req domain.StatisticsFilte
 				sql.As("COUNT(*) FILTER (WHERE is_suggested = true AND model_type = 'coder')", "code_count"),
 				sql.As("COUNT(*) FILTER (WHERE is_accept = true AND model_type = 'coder')", "accepted_count"),
 				sql.As("SUM(code_lines) FILTER (WHERE is_accept = true)", "code_lines"),
+				sql.As("COALESCE(SUM(input_tokens + output_tokens) FILTER (WHERE cache_hit = true), 0)", "cached_tokens"),
 			).
 				GroupBy("date").
 				OrderBy(sql.Asc("date"))
@@ -144,6 +148,7 @@ func (d *DashboardRepo) TimeStat(ctx context.Context, req domain.StatisticsFilte
 		Completions:    []domain.TimePoint[int64]{},
 		LinesOfCode:    []domain.TimePoint[int64]{},
 		AcceptedPer:    []domain.TimePoint[float64]{},
+		CachedTokens:   []domain.TimePoint[int64]{},
 	}
 
 	if len(udv) > 0 {
@@ -164,6 +169,7 @@ func (d *DashboardRepo) TimeStat(ctx context.Context, req domain.StatisticsFilte
 		ts.TotalChats += v.LlmCount
 		ts.TotalCompletions += v.CodeCount
 		ts.TotalLinesOfCode += v.CodeLines
+		ts.TotalCachedTokens += v.CachedTokens
 		ts.ActiveUsers = append(ts.ActiveUsers, domain.TimePoint[int64]{
 			Timestamp: v.Date.Unix(),
 			Value:     v.UserCount,
@@ -180,6 +186,10 @@ func (d *DashboardRepo) TimeStat(ctx context.Context, req domain.StatisticsFilte
 			Timestamp: v.Date.Unix(),
 			Value:     v.CodeLines,
 		})
+		ts.CachedTokens = append(ts.CachedTokens, domain.TimePoint[int64]{
+			Timestamp: v.Date.Unix(),
+			Value:     v.CachedTokens,
+		})
 		if v.CodeCount > 0 {
 			ts.AcceptedPer = append(ts.AcceptedPer, domain.TimePoint[float64]{
 				Timestamp: v.Date.Unix(),
@@ -192,6 +202,15 @@ func (d *DashboardRepo) TimeStat(ctx context.Context, req domain.StatisticsFilte
 		ts.TotalAcceptedPer = float64(totalAccepted) / float64(totalSuggested) * 100
 	}
 
+	spend, err := d.spend(ctx, req, true)
+	if err != nil {
+		return nil, err
+	}
+	ts.Spend = spend
+	if ts.PromptCache, err = d.promptCache(ctx, req); err != nil {
+		return nil, err
+	}
+
 	return ts, nil
 }
 
@@ -374,6 +393,14 @@ func (d *DashboardRepo) UserStat(ctx context.Context, req domain.StatisticsFilte
 	if codeCount > 0 {
 		us.TotalAcceptedPer = float64(acceptedCount) / float64(codeCount) * 100
 	}
+	spend, err := d.spend(ctx, req, false, task.UserID(id))
+	if err != nil {
+		return nil, err
+	}
+	us.Spend = spend
+	if us.PromptCache, err = d.promptCache(ctx, req, task.UserID(id)); err != nil {
+		return nil, err
+	}
 	return us, nil
 }
 
@@ -407,3 +434,77 @@ func (d *DashboardRepo) UserHeatmap(ctx context.Context, userID string) ([]*doma
 		}
 	}), nil
 }
+
+// CodeFindingStat implements domain.DashboardRepo.
+func (d *DashboardRepo) CodeFindingStat(ctx context.Context, req domain.StatisticsFilter) (*domain.CodeFindingStat, error) {
+	ctx = entx.SkipSoftDelete(ctx)
+	q := d.db.TaskRecord.Query().
+		Where(taskrecord.CreatedAtGTE(req.StartTime())).
+		Where(taskrecord.FindingsNotNil())
+	if req.UserID != "" {
+		id, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return nil, err
+		}
+		q.Where(taskrecord.HasTaskWith(task.UserID(id)))
+	}
+
+	var ds []DateValue
+	if err := q.Clone().
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(fmt.Sprintf("date_trunc('%s', created_at)", req.Precision), "date"),
+				sql.As("SUM(jsonb_array_length(findings))", "count"),
+			).
+				GroupBy("date").
+				OrderBy(sql.Asc("date"))
+		}).
+		Scan(ctx, &ds); err != nil {
+		return nil, err
+	}
+
+	records, err := q.Select(taskrecord.FieldFindings).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stat := &domain.CodeFindingStat{
+		TotalRecords: int64(len(records)),
+		Rules:        []domain.CategoryPoint{},
+		Severity:     []domain.CategoryPoint{},
+		Findings:     []domain.TimePoint[int64]{},
+	}
+	rules := make(map[string]int64)
+	severity := make(map[string]int64)
+	for _, r := range records {
+		for _, f := range r.Findings {
+			stat.TotalFindings++
+			rules[f.Title]++
+			severity[f.Severity]++
+		}
+	}
+	stat.Rules = categoryPoints(rules)
+	stat.Severity = categoryPoints(severity)
+	for _, v := range ds {
+		stat.Findings = append(stat.Findings, domain.TimePoint[int64]{
+			Timestamp: v.Date.Unix(),
+			Value:     v.Count,
+		})
+	}
+	return stat, nil
+}
+
+// categoryPoints 按数量从大到小排列
+func categoryPoints(m map[string]int64) []domain.CategoryPoint {
+	ps := make([]domain.CategoryPoint, 0, len(m))
+	for k, v := range m {
+		ps = append(ps, domain.CategoryPoint{Category: k, Value: v})
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i].Value != ps[j].Value {
+			return ps[i].Value > ps[j].Value
+		}
+		return ps[i].Category < ps[j].Category
+	})
+	return ps
+}
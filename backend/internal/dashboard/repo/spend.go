@@ -0,0 +1,183 @@
+package repo
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/model"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/task"
+	"github.com/chaitin/MonkeyCode/backend/db/user"
+	"github.com/chaitin/MonkeyCode/backend/db/usergroupuser"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// costUnit 任务费用的单位为百万分之一货币单位
+const costUnit = 1e6
+
+type spendRow struct {
+	Date     time.Time `json:"date"`
+	UserID   string    `json:"user_id"`
+	ModelID  string    `json:"model_id"`
+	Currency string    `json:"currency"`
+	Cost     int64     `json:"cost"`
+}
+
+// spend 按货币统计费用，withUsers 为 false 时不统计用户和用户组
+func (d *DashboardRepo) spend(ctx context.Context, req domain.StatisticsFilter, withUsers bool, ps ...predicate.Task) ([]*domain.SpendStat, error) {
+	rows := make([]spendRow, 0)
+	q := d.db.Task.Query().
+		Where(task.CreatedAtGTE(req.StartTime())).
+		Where(task.CostGT(0)).
+		Where(ps...)
+	if err := q.Modify(func(s *sql.Selector) {
+		s.Select(
+			sql.As("date_trunc('day', created_at)", "date"),
+			sql.As("COALESCE(user_id::text, '')", "user_id"),
+			sql.As("COALESCE(model_id::text, '')", "model_id"),
+			sql.As("COALESCE(currency, '')", "currency"),
+			sql.As("SUM(cost)", "cost"),
+		).
+			GroupBy("date", "user_id", "model_id", "currency").
+			OrderBy(sql.Asc("date"))
+	}).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return []*domain.SpendStat{}, nil
+	}
+
+	var userIDs, modelIDs []uuid.UUID
+	for _, r := range rows {
+		if id, err := uuid.Parse(r.UserID); err == nil {
+			userIDs = append(userIDs, id)
+		}
+		if id, err := uuid.Parse(r.ModelID); err == nil {
+			modelIDs = append(modelIDs, id)
+		}
+	}
+	userIDs, modelIDs = cvt.Unique(userIDs), cvt.Unique(modelIDs)
+
+	modelNames, err := d.modelNames(ctx, modelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	userNames := make(map[string]string)
+	userGroups := make(map[string][]*db.UserGroup)
+	if withUsers {
+		us, err := d.db.User.Query().Where(user.IDIn(userIDs...)).All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range us {
+			userNames[u.ID.String()] = u.Username
+		}
+		ugs, err := d.db.UserGroupUser.Query().
+			WithUserGroup().
+			Where(usergroupuser.UserIDIn(userIDs...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, ug := range ugs {
+			if g := ug.Edges.UserGroup; g != nil {
+				userGroups[ug.UserID.String()] = append(userGroups[ug.UserID.String()], g)
+			}
+		}
+	}
+
+	type acc struct {
+		stat   *domain.SpendStat
+		daily  map[int64]float64
+		users  map[string]float64
+		groups map[string]float64
+		models map[string]float64
+	}
+	accs := make(map[string]*acc)
+	groupNames := make(map[string]string)
+	currencies := make([]string, 0)
+	for _, r := range rows {
+		a, ok := accs[r.Currency]
+		if !ok {
+			a = &acc{
+				stat:   &domain.SpendStat{Currency: r.Currency},
+				daily:  make(map[int64]float64),
+				users:  make(map[string]float64),
+				groups: make(map[string]float64),
+				models: make(map[string]float64),
+			}
+			accs[r.Currency] = a
+			currencies = append(currencies, r.Currency)
+		}
+		v := float64(r.Cost) / costUnit
+		a.stat.Total += v
+		a.daily[r.Date.Unix()] += v
+		a.models[r.ModelID] += v
+		if !withUsers || r.UserID == "" {
+			continue
+		}
+		a.users[r.UserID] += v
+		for _, g := range userGroups[r.UserID] {
+			gid := g.ID.String()
+			groupNames[gid] = g.Name
+			a.groups[gid] += v
+		}
+	}
+
+	sort.Strings(currencies)
+	return cvt.Iter(currencies, func(_ int, c string) *domain.SpendStat {
+		a := accs[c]
+		a.stat.Daily = make([]domain.TimePoint[float64], 0, len(a.daily))
+		for ts, v := range a.daily {
+			a.stat.Daily = append(a.stat.Daily, domain.TimePoint[float64]{Timestamp: ts, Value: v})
+		}
+		sort.Slice(a.stat.Daily, func(i, j int) bool {
+			return a.stat.Daily[i].Timestamp < a.stat.Daily[j].Timestamp
+		})
+		a.stat.Models = spendPoints(a.models, modelNames)
+		if withUsers {
+			a.stat.Users = spendPoints(a.users, userNames)
+			a.stat.Groups = spendPoints(a.groups, groupNames)
+		}
+		return a.stat
+	}), nil
+}
+
+// spendPoints 按费用从高到低排序
+func spendPoints(m map[string]float64, names map[string]string) []domain.SpendPoint {
+	ps := make([]domain.SpendPoint, 0, len(m))
+	for id, v := range m {
+		ps = append(ps, domain.SpendPoint{ID: id, Name: names[id], Value: v})
+	}
+	sort.Slice(ps, func(i, j int) bool {
+		if ps[i].Value != ps[j].Value {
+			return ps[i].Value > ps[j].Value
+		}
+		return ps[i].ID < ps[j].ID
+	})
+	return ps
+}
+
+// modelNames 模型ID对应的显示名称，未设置显示名称时使用模型名称
+func (d *DashboardRepo) modelNames(ctx context.Context, ids []uuid.UUID) (map[string]string, error) {
+	ms, err := d.db.Model.Query().Where(model.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(ms))
+	for _, m := range ms {
+		name := m.ShowName
+		if name == "" {
+			name = m.ModelName
+		}
+		names[m.ID.String()] = name
+	}
+	return names, nil
+}
@@ -41,6 +41,10 @@ func (u *DashboardUsecase) UserCodeRank(ctx context.Context, req domain.Statisti
 	return u.repo.UserCodeRank(ctx, req)
 }
 
+func (u *DashboardUsecase) CodeFindingStat(ctx context.Context, req domain.StatisticsFilter) (*domain.CodeFindingStat, error) {
+	return u.repo.CodeFindingStat(ctx, req)
+}
+
 func (u *DashboardUsecase) UserHeatmap(ctx context.Context, userID string) (*domain.UserHeatmapResp, error) {
 	rs, err := u.repo.UserHeatmap(ctx, userID)
 	if err != nil {
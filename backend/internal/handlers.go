@@ -1,6 +1,7 @@
 package internal
 
 import (
+	auditv1 "github.com/chaitin/MonkeyCode/backend/internal/audit/handler/http/v1"
 	billingv1 "github.com/chaitin/MonkeyCode/backend/internal/billing/handler/http/v1"
 	codesnippetv1 "github.com/chaitin/MonkeyCode/backend/internal/codesnippet/handler/http/v1"
 	dashv1 "github.com/chaitin/MonkeyCode/backend/internal/dashboard/handler/v1"
@@ -23,4 +24,5 @@ type APIHandlers struct {
 	BillingHandler       *billingv1.BillingHandler
 	WorkspaceFileHandler *workspacehandlerv1.WorkspaceFileHandler
 	SecurityHandler      *securityv1.SecurityHandler
+	AuditHandler         *auditv1.AuditHandler
 }
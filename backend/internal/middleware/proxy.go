@@ -2,13 +2,18 @@ package middleware
 
 import (
 	"context"
+	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/pkg/anthropic"
 	"github.com/chaitin/MonkeyCode/backend/pkg/logger"
 )
 
@@ -16,15 +21,20 @@ const (
 	ApiContextKey = "session:apikey"
 )
 
+type ApiKeyCtxKey struct{}
+
 type ProxyMiddleware struct {
 	usecase domain.ProxyUsecase
+	logger  *slog.Logger
 }
 
 func NewProxyMiddleware(
 	usecase domain.ProxyUsecase,
+	logger *slog.Logger,
 ) *ProxyMiddleware {
 	return &ProxyMiddleware{
 		usecase: usecase,
+		logger:  logger.With("middleware", "proxy"),
 	}
 }
 
@@ -46,6 +56,7 @@ func (p *ProxyMiddleware) Auth() echo.MiddlewareFunc {
 
 			ctx := c.Request().Context()
 			ctx = context.WithValue(ctx, logger.UserIDKey{}, key.UserID)
+			ctx = context.WithValue(ctx, ApiKeyCtxKey{}, key)
 			ctx = rule.SkipPermission(ctx)
 			c.SetRequest(c.Request().WithContext(ctx))
 			c.Set(ApiContextKey, key)
@@ -54,6 +65,58 @@ func (p *ProxyMiddleware) Auth() echo.MiddlewareFunc {
 	}
 }
 
+// RateLimit 按 API Key、用户和用户组限制请求数与 token 数
+func (p *ProxyMiddleware) RateLimit() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := GetApiKey(c)
+			if key == nil {
+				return next(c)
+			}
+
+			res, err := p.usecase.CheckRateLimit(c.Request().Context(), key)
+			if err != nil {
+				// 限流组件异常时放行，避免影响正常使用
+				p.logger.With("error", err).ErrorContext(c.Request().Context(), "failed to check rate limit")
+				return next(c)
+			}
+
+			h := c.Response().Header()
+			if s := res.Requests; s != nil {
+				h.Set("x-ratelimit-limit-requests", strconv.Itoa(s.Limit))
+				h.Set("x-ratelimit-remaining-requests", strconv.Itoa(s.Remaining))
+				h.Set("x-ratelimit-reset-requests", s.Reset.Round(time.Millisecond).String())
+			}
+			if s := res.Tokens; s != nil {
+				h.Set("x-ratelimit-limit-tokens", strconv.Itoa(s.Limit))
+				h.Set("x-ratelimit-remaining-tokens", strconv.Itoa(s.Remaining))
+				h.Set("x-ratelimit-reset-tokens", s.Reset.Round(time.Millisecond).String())
+			}
+			if !res.Allowed {
+				h.Set("Retry-After", strconv.Itoa(int(math.Ceil(res.RetryAfter.Seconds()))))
+				if strings.HasSuffix(c.Request().URL.Path, "/messages") {
+					return c.JSON(http.StatusTooManyRequests, anthropic.NewError(anthropic.ErrRateLimit, res.Message))
+				}
+				return c.JSON(http.StatusTooManyRequests, echo.Map{
+					"error": echo.Map{
+						"message": res.Message,
+						"type":    res.Kind,
+						"param":   nil,
+						"code":    "rate_limit_exceeded",
+					},
+				})
+			}
+			return next(c)
+		}
+	}
+}
+
+// GetApiKeyFromContext 从请求上下文中获取 API Key
+func GetApiKeyFromContext(ctx context.Context) *domain.ApiKey {
+	key, _ := ctx.Value(ApiKeyCtxKey{}).(*domain.ApiKey)
+	return key
+}
+
 func GetApiKey(c echo.Context) *domain.ApiKey {
 	i := c.Get(ApiContextKey)
 	if i == nil {
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/internal/proxy/usecase"
+)
+
+type rateLimitRepo struct {
+	domain.ProxyRepo
+	policy *domain.RateLimitPolicy
+}
+
+func (r *rateLimitRepo) RateLimitPolicy(context.Context, string) (*domain.RateLimitPolicy, error) {
+	return r.policy, nil
+}
+
+type runningRepo struct {
+	domain.SecurityScanningRepo
+}
+
+func (runningRepo) AllRunning(context.Context) ([]*db.SecurityScanning, error) {
+	return nil, nil
+}
+
+func newRateLimitServer(t *testing.T, policy *domain.RateLimitPolicy) *echo.Echo {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+	uc := usecase.NewProxyUsecase(&rateLimitRepo{policy: policy}, nil, runningRepo{}, nil, nil, nil, slog.Default(), &config.Config{}, rdb)
+	m := NewProxyMiddleware(uc, slog.Default())
+
+	e := echo.New()
+	key := &domain.ApiKey{ID: "k1", UserID: "u1"}
+	setKey := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(ApiContextKey, key)
+			return next(c)
+		}
+	}
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.POST("/v1/chat/completions", ok, setKey, m.RateLimit())
+	e.POST("/v1/messages", ok, setKey, m.RateLimit())
+	return e
+}
+
+func serve(e *echo.Echo, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	e.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+	return w
+}
+
+func TestRateLimit(t *testing.T) {
+	e := newRateLimitServer(t, &domain.RateLimitPolicy{
+		APIKey: domain.RateLimit{RPM: 2, TPM: 1000},
+	})
+
+	w := serve(e, "/v1/chat/completions")
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d", w.Code)
+	}
+	want := map[string]string{
+		"x-ratelimit-limit-requests":     "2",
+		"x-ratelimit-remaining-requests": "1",
+		"x-ratelimit-reset-requests":     "30s",
+		"x-ratelimit-limit-tokens":       "1000",
+		"x-ratelimit-remaining-tokens":   "1000",
+		"x-ratelimit-reset-tokens":       "0s",
+	}
+	for k, v := range want {
+		if got := w.Header().Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	if w.Header().Get("Retry-After") != "" {
+		t.Error("Retry-After should only be set when denied")
+	}
+
+	serve(e, "/v1/chat/completions")
+	w = serve(e, "/v1/chat/completions")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", w.Code)
+	}
+	// 每分钟 2 次，补充一次需要 30 秒
+	if got := w.Header().Get("Retry-After"); got != "30" {
+		t.Errorf("Retry-After = %q, want 30", got)
+	}
+	if got := w.Header().Get("x-ratelimit-remaining-requests"); got != "0" {
+		t.Errorf("x-ratelimit-remaining-requests = %q, want 0", got)
+	}
+	var body struct {
+		Error struct {
+			Message string `json:"message"`
+			Type    string `json:"type"`
+			Code    string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body.Error.Code != "rate_limit_exceeded" || body.Error.Type != "requests" || body.Error.Message == "" {
+		t.Errorf("unexpected body %s", w.Body.String())
+	}
+
+	// Anthropic 协议返回 Anthropic 格式的错误
+	w = serve(e, "/v1/messages")
+	var anth struct {
+		Type  string `json:"type"`
+		Error struct {
+			Type string `json:"type"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &anth); err != nil {
+		t.Fatal(err)
+	}
+	if w.Code != http.StatusTooManyRequests || anth.Type != "error" || anth.Error.Type != "rate_limit_error" {
+		t.Errorf("unexpected anthropic response %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestRateLimitUnlimited(t *testing.T) {
+	e := newRateLimitServer(t, &domain.RateLimitPolicy{})
+	for range 5 {
+		w := serve(e, "/v1/chat/completions")
+		if w.Code != http.StatusOK || w.Header().Get("x-ratelimit-limit-requests") != "" {
+			t.Fatalf("unlimited policy should not limit, got %d %v", w.Code, w.Header())
+		}
+	}
+}
@@ -36,11 +36,19 @@ func NewModelHandler(
 	g.GET("/provider/supported", web.BindHandler(m.GetProviderModelList))
 	g.GET("/token-usage", web.BindHandler(m.GetTokenUsage))
 	g.GET("/my", web.BindHandler(m.MyModelList))
+	g.GET("/uptime", web.BindHandler(m.Uptime))
 	g.POST("", web.BindHandler(m.Create))
 	g.POST("/check", web.BindHandler(m.Check))
 	g.PUT("", web.BindHandler(m.Update))
 	g.DELETE("", web.BaseHandler(m.Delete))
 
+	// 请求改写规则
+	g.GET("/transform", web.BaseHandler(m.ListTransformRule))
+	g.POST("/transform", web.BindHandler(m.CreateTransformRule))
+	g.PUT("/transform", web.BindHandler(m.UpdateTransformRule))
+	g.DELETE("/transform", web.BindHandler(m.DeleteTransformRule))
+	g.GET("/transform/policy", web.BindHandler(m.ListTransformPolicy, web.WithPage()))
+
 	return m
 }
 
@@ -181,6 +189,25 @@ func (h *ModelHandler) GetTokenUsage(c *web.Context, req domain.GetTokenUsageReq
 	return c.Success(resp)
 }
 
+// Uptime 获取模型可用率历史
+//
+//	@Tags			Model
+//	@Summary		获取模型可用率历史
+//	@Description	获取模型健康检查的可用率历史
+//	@ID				get-model-uptime
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.ModelUptimeReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.ModelUptimeResp}
+//	@Router			/api/v1/model/uptime [get]
+func (h *ModelHandler) Uptime(c *web.Context, req domain.ModelUptimeReq) error {
+	resp, err := h.usecase.Uptime(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
 // GetProviderModelList 获取供应商支持的模型列表
 //
 //	@Tags			Model
@@ -236,6 +263,103 @@ func (h *ModelHandler) Delete(c *web.Context) error {
 	return c.Success(nil)
 }
 
+// ListTransformRule 获取请求改写规则
+//
+//	@Tags			Model
+//	@Summary		获取请求改写规则
+//	@Description	获取请求改写规则和当前策略版本
+//	@ID				list-transform-rule
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{data=domain.ListTransformRuleResp}
+//	@Router			/api/v1/model/transform [get]
+func (h *ModelHandler) ListTransformRule(c *web.Context) error {
+	resp, err := h.usecase.ListTransformRule(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// CreateTransformRule 创建请求改写规则
+//
+//	@Tags			Model
+//	@Summary		创建请求改写规则
+//	@Description	创建请求改写规则，生成新的策略版本
+//	@ID				create-transform-rule
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.CreateTransformRuleReq	true	"规则"
+//	@Success		200		{object}	web.Resp{data=domain.TransformRule}
+//	@Router			/api/v1/model/transform [post]
+func (h *ModelHandler) CreateTransformRule(c *web.Context, req domain.CreateTransformRuleReq) error {
+	req.AdminID = middleware.GetAdmin(c).ID
+	rule, err := h.usecase.CreateTransformRule(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(rule)
+}
+
+// UpdateTransformRule 更新请求改写规则
+//
+//	@Tags			Model
+//	@Summary		更新请求改写规则
+//	@Description	更新请求改写规则，生成新的策略版本
+//	@ID				update-transform-rule
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateTransformRuleReq	true	"规则"
+//	@Success		200		{object}	web.Resp{data=domain.TransformRule}
+//	@Router			/api/v1/model/transform [put]
+func (h *ModelHandler) UpdateTransformRule(c *web.Context, req domain.UpdateTransformRuleReq) error {
+	req.AdminID = middleware.GetAdmin(c).ID
+	rule, err := h.usecase.UpdateTransformRule(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(rule)
+}
+
+// DeleteTransformRule 删除请求改写规则
+//
+//	@Tags			Model
+//	@Summary		删除请求改写规则
+//	@Description	删除请求改写规则，生成新的策略版本
+//	@ID				delete-transform-rule
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.DeleteTransformRuleReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Router			/api/v1/model/transform [delete]
+func (h *ModelHandler) DeleteTransformRule(c *web.Context, req domain.DeleteTransformRuleReq) error {
+	req.AdminID = middleware.GetAdmin(c).ID
+	if err := h.usecase.DeleteTransformRule(c.Request().Context(), &req); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
+// ListTransformPolicy 获取改写策略历史版本
+//
+//	@Tags			Model
+//	@Summary		获取改写策略历史版本
+//	@Description	获取改写策略历史版本，每个版本包含当时的全部规则
+//	@ID				list-transform-policy
+//	@Accept			json
+//	@Produce		json
+//	@Param			page	query		domain.ListTransformPolicyReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.ListTransformPolicyResp}
+//	@Router			/api/v1/model/transform/policy [get]
+func (h *ModelHandler) ListTransformPolicy(c *web.Context, req domain.ListTransformPolicyReq) error {
+	req.Pagination = c.Page()
+	resp, err := h.usecase.ListTransformPolicy(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
 func (h *ModelHandler) InitModel() error {
 	return h.usecase.InitModel(context.Background())
 }
@@ -0,0 +1,147 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/model"
+	"github.com/chaitin/MonkeyCode/backend/db/modelhealthcheck"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// ListAllActive 获取所有类型中启用的模型，用于健康检查
+func (r *ModelRepo) ListAllActive(ctx context.Context) ([]*db.Model, error) {
+	return r.db.Model.Query().
+		Where(model.Status(consts.ModelStatusActive)).
+		Order(model.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+// SaveHealthCheck implements domain.ModelRepo.
+func (r *ModelRepo) SaveHealthCheck(ctx context.Context, check *domain.ModelHealthCheck) error {
+	create := r.db.ModelHealthCheck.Create().
+		SetModelID(check.ModelID).
+		SetSuccess(check.Success).
+		SetLatency(check.Latency)
+	if check.Error != "" {
+		create.SetError(check.Error)
+	}
+	return create.Exec(ctx)
+}
+
+// RecentHealthChecks 获取模型最近的探测记录，按时间倒序
+func (r *ModelRepo) RecentHealthChecks(ctx context.Context, id uuid.UUID, limit int) ([]*db.ModelHealthCheck, error) {
+	return r.db.ModelHealthCheck.Query().
+		Where(modelhealthcheck.ModelID(id)).
+		Order(modelhealthcheck.ByCreatedAt(sql.OrderDesc())).
+		Limit(limit).
+		All(ctx)
+}
+
+type healthStat struct {
+	ModelID       uuid.UUID `json:"model_id"`
+	Total         int64     `json:"total"`
+	Failed        int64     `json:"failed"`
+	Latency       float64   `json:"latency"`
+	LastSuccessAt time.Time `json:"last_success_at"`
+	LastCheckAt   time.Time `json:"last_check_at"`
+}
+
+// HealthStat 统计模型自 since 以来的探测结果，不包含健康状态
+func (r *ModelRepo) HealthStat(ctx context.Context, ids []uuid.UUID, since time.Time) (map[uuid.UUID]*domain.ModelHealth, error) {
+	var stats []healthStat
+	if err := r.db.ModelHealthCheck.Query().
+		Where(
+			modelhealthcheck.ModelIDIn(ids...),
+			modelhealthcheck.CreatedAtGTE(since),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As(modelhealthcheck.FieldModelID, "model_id"),
+				sql.As("COUNT(*)", "total"),
+				sql.As("COUNT(*) FILTER (WHERE success = false)", "failed"),
+				sql.As("COALESCE(AVG(latency) FILTER (WHERE success = true), 0)", "latency"),
+				sql.As("COALESCE(MAX(created_at) FILTER (WHERE success = true), 'epoch')", "last_success_at"),
+				sql.As("MAX(created_at)", "last_check_at"),
+			).
+				GroupBy("model_id")
+		}).
+		Scan(ctx, &stats); err != nil {
+		return nil, err
+	}
+
+	return cvt.IterToMap(stats, func(_ int, s healthStat) (uuid.UUID, *domain.ModelHealth) {
+		h := &domain.ModelHealth{
+			Status:      consts.ModelHealthUnknown,
+			Latency:     int64(s.Latency),
+			LastCheckAt: s.LastCheckAt.Unix(),
+		}
+		if s.Total > 0 {
+			h.ErrorRate = float64(s.Failed) / float64(s.Total) * 100
+		}
+		if !s.LastSuccessAt.Equal(time.Unix(0, 0)) {
+			h.LastSuccessAt = s.LastSuccessAt.Unix()
+		}
+		return s.ModelID, h
+	}), nil
+}
+
+type uptimePoint struct {
+	Date    time.Time `json:"date"`
+	Total   int64     `json:"total"`
+	Success int64     `json:"success"`
+	Latency float64   `json:"latency"`
+}
+
+// Uptime 按精度聚合模型的探测结果
+func (r *ModelRepo) Uptime(ctx context.Context, req *domain.ModelUptimeReq) ([]*domain.ModelUptime, error) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	var points []uptimePoint
+	if err := r.db.ModelHealthCheck.Query().
+		Where(
+			modelhealthcheck.ModelID(id),
+			modelhealthcheck.CreatedAtGTE(req.StartTime()),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				sql.As("date_trunc('"+req.Precision+"', created_at)", "date"),
+				sql.As("COUNT(*)", "total"),
+				sql.As("COUNT(*) FILTER (WHERE success = true)", "success"),
+				sql.As("COALESCE(AVG(latency) FILTER (WHERE success = true), 0)", "latency"),
+			).
+				GroupBy("date").
+				OrderBy(sql.Asc("date"))
+		}).
+		Scan(ctx, &points); err != nil {
+		return nil, err
+	}
+
+	return cvt.Iter(points, func(_ int, p uptimePoint) *domain.ModelUptime {
+		u := &domain.ModelUptime{
+			Timestamp: p.Date.Unix(),
+			Total:     p.Total,
+			Success:   p.Success,
+			Latency:   int64(p.Latency),
+		}
+		if p.Total > 0 {
+			u.Uptime = float64(p.Success) / float64(p.Total) * 100
+		}
+		return u
+	}), nil
+}
+
+// DeleteHealthChecks 删除 before 之前的探测记录
+func (r *ModelRepo) DeleteHealthChecks(ctx context.Context, before time.Time) (int, error) {
+	return r.db.ModelHealthCheck.Delete().
+		Where(modelhealthcheck.CreatedAtLT(before)).
+		Exec(ctx)
+}
@@ -38,7 +38,8 @@ func (r *ModelRepo) GetWithCache(ctx context.Context, modelType consts.ModelType
 	m, err := r.db.Model.Query().
 		Where(model.ModelType(modelType)).
 		Where(model.Status(consts.ModelStatusActive)).
-		Only(ctx)
+		Order(model.ByCreatedAt(sql.OrderAsc())).
+		First(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -47,6 +48,15 @@ func (r *ModelRepo) GetWithCache(ctx context.Context, modelType consts.ModelType
 	return m, nil
 }
 
+// ListActive 获取该类型下所有启用的模型，用于负载均衡
+func (r *ModelRepo) ListActive(ctx context.Context, modelType consts.ModelType) ([]*db.Model, error) {
+	return r.db.Model.Query().
+		Where(model.ModelType(modelType)).
+		Where(model.Status(consts.ModelStatusActive)).
+		Order(model.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+}
+
 func (r *ModelRepo) Create(ctx context.Context, m *domain.CreateModelReq) (*db.Model, error) {
 	n, err := r.db.Model.Query().Where(model.ModelType(m.ModelType)).Count(ctx)
 	if err != nil {
@@ -69,6 +79,15 @@ func (r *ModelRepo) Create(ctx context.Context, m *domain.CreateModelReq) (*db.M
 		SetAPIHeader(m.APIHeader).
 		SetModelType(m.ModelType).
 		SetStatus(status)
+	if len(m.Aliases) > 0 {
+		create.SetAliases(m.Aliases)
+	}
+	if m.Weight > 0 {
+		create.SetWeight(m.Weight)
+	}
+	if m.Pricing != nil {
+		create.SetPricing(m.Pricing.Entity())
+	}
 	if m.Param != nil {
 		create.SetParameters(&types.ModelParam{
 			R1Enabled:          m.Param.R1Enabled,
@@ -77,6 +96,8 @@ func (r *ModelRepo) Create(ctx context.Context, m *domain.CreateModelReq) (*db.M
 			SupprtImages:       m.Param.SupprtImages,
 			SupportComputerUse: m.Param.SupportComputerUse,
 			SupportPromptCache: m.Param.SupportPromptCache,
+			ResponseCache:      m.Param.ResponseCache,
+			ResponseCacheTTL:   m.Param.ResponseCacheTTL,
 		})
 	} else {
 		create.SetParameters(types.DefaultModelParam())
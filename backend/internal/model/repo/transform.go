@@ -0,0 +1,189 @@
+package repo
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/transformpolicy"
+	"github.com/chaitin/MonkeyCode/backend/db/transformrule"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
+)
+
+// ListTransformRule implements domain.ModelRepo.
+func (r *ModelRepo) ListTransformRule(ctx context.Context) ([]*db.TransformRule, error) {
+	return r.db.TransformRule.Query().
+		Order(transformrule.ByPriority(sql.OrderDesc()), transformrule.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+// LatestTransformPolicy 获取最新的策略版本，没有版本时返回 nil
+func (r *ModelRepo) LatestTransformPolicy(ctx context.Context) (*db.TransformPolicy, error) {
+	p, err := r.db.TransformPolicy.Query().
+		Order(transformpolicy.ByVersion(sql.OrderDesc())).
+		First(ctx)
+	if db.IsNotFound(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// CreateTransformRule implements domain.ModelRepo.
+func (r *ModelRepo) CreateTransformRule(ctx context.Context, req *domain.CreateTransformRuleReq) (*db.TransformRule, error) {
+	modelID, err := optionalUUID(req.ModelID)
+	if err != nil {
+		return nil, err
+	}
+	groupID, err := optionalUUID(req.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule *db.TransformRule
+	err = entx.WithTx(ctx, r.db, func(tx *db.Tx) error {
+		create := tx.TransformRule.Create().
+			SetName(req.Name).
+			SetPriority(req.Priority).
+			SetEnabled(req.Enabled).
+			SetConfig(req.Config)
+		if modelID != uuid.Nil {
+			create.SetModelID(modelID)
+		}
+		if groupID != uuid.Nil {
+			create.SetGroupID(groupID)
+		}
+		rule, err = create.Save(ctx)
+		if err != nil {
+			return err
+		}
+		return snapshotTransform(ctx, tx, req.AdminID)
+	})
+	return rule, err
+}
+
+// UpdateTransformRule implements domain.ModelRepo.
+func (r *ModelRepo) UpdateTransformRule(ctx context.Context, req *domain.UpdateTransformRuleReq) (*db.TransformRule, error) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule *db.TransformRule
+	err = entx.WithTx(ctx, r.db, func(tx *db.Tx) error {
+		up := tx.TransformRule.UpdateOneID(id)
+		if req.Name != nil {
+			up.SetName(*req.Name)
+		}
+		if req.ModelID != nil {
+			modelID, err := optionalUUID(*req.ModelID)
+			if err != nil {
+				return err
+			}
+			if modelID == uuid.Nil {
+				up.ClearModelID()
+			} else {
+				up.SetModelID(modelID)
+			}
+		}
+		if req.GroupID != nil {
+			groupID, err := optionalUUID(*req.GroupID)
+			if err != nil {
+				return err
+			}
+			if groupID == uuid.Nil {
+				up.ClearGroupID()
+			} else {
+				up.SetGroupID(groupID)
+			}
+		}
+		if req.Priority != nil {
+			up.SetPriority(*req.Priority)
+		}
+		if req.Enabled != nil {
+			up.SetEnabled(*req.Enabled)
+		}
+		if req.Config != nil {
+			up.SetConfig(req.Config)
+		}
+		rule, err = up.Save(ctx)
+		if err != nil {
+			return err
+		}
+		return snapshotTransform(ctx, tx, req.AdminID)
+	})
+	return rule, err
+}
+
+// DeleteTransformRule implements domain.ModelRepo.
+func (r *ModelRepo) DeleteTransformRule(ctx context.Context, req *domain.DeleteTransformRuleReq) error {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return err
+	}
+	return entx.WithTx(ctx, r.db, func(tx *db.Tx) error {
+		if err := tx.TransformRule.DeleteOneID(id).Exec(ctx); err != nil {
+			return err
+		}
+		return snapshotTransform(ctx, tx, req.AdminID)
+	})
+}
+
+// ListTransformPolicy implements domain.ModelRepo.
+func (r *ModelRepo) ListTransformPolicy(ctx context.Context, req domain.ListTransformPolicyReq) (*domain.ListTransformPolicyResp, error) {
+	policies, p, err := r.db.TransformPolicy.Query().
+		Order(transformpolicy.ByVersion(sql.OrderDesc())).
+		Page(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListTransformPolicyResp{
+		PageInfo: p,
+		Policies: cvt.Iter(policies, func(_ int, e *db.TransformPolicy) *domain.TransformPolicy {
+			return cvt.From(e, &domain.TransformPolicy{})
+		}),
+	}, nil
+}
+
+// snapshotTransform 保存当前全部规则为新的策略版本
+// 版本号有唯一索引，并发修改时后提交的事务失败，需要重试
+func snapshotTransform(ctx context.Context, tx *db.Tx, adminID uuid.UUID) error {
+	rules, err := tx.TransformRule.Query().
+		Order(transformrule.ByPriority(sql.OrderDesc()), transformrule.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	var version int64
+	latest, err := tx.TransformPolicy.Query().
+		Order(transformpolicy.ByVersion(sql.OrderDesc())).
+		First(ctx)
+	if err != nil && !db.IsNotFound(err) {
+		return err
+	}
+	if latest != nil {
+		version = latest.Version
+	}
+
+	create := tx.TransformPolicy.Create().
+		SetVersion(version + 1).
+		SetRules(cvt.Iter(rules, func(_ int, e *db.TransformRule) *types.TransformRule {
+			return cvt.From(e, &domain.TransformRule{}).Snapshot()
+		}))
+	if adminID != uuid.Nil {
+		create.SetAdminID(adminID)
+	}
+	return create.Exec(ctx)
+}
+
+// optionalUUID 解析可以为空的ID，空字符串返回 uuid.Nil
+func optionalUUID(s string) (uuid.UUID, error) {
+	if s == "" {
+		return uuid.Nil, nil
+	}
+	return uuid.Parse(s)
+}
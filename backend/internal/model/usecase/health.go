@@ -0,0 +1,196 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	modelkitDomain "github.com/chaitin/ModelKit/domain"
+	modelkit "github.com/chaitin/ModelKit/usecase"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// healthStatWindow 健康统计的时间窗口
+const healthStatWindow = 24 * time.Hour
+
+// monitor 定期探测启用的模型，记录耗时和结果，连续失败的模型标记为不健康
+// 探测会实际调用上游模型产生费用，默认关闭，配置探测间隔后开启
+func (m *ModelUsecase) monitor(ctx context.Context) {
+	interval := time.Duration(m.cfg.LLMProxy.HealthCheck.Interval) * time.Second
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.checkAll(ctx, interval)
+		}
+	}
+}
+
+// checkAll 并发探测启用的模型，健康状态变化时通知代理刷新模型池
+func (m *ModelUsecase) checkAll(ctx context.Context, interval time.Duration) {
+	// 多实例部署时每个周期只由一个实例探测
+	ok, err := m.redis.SetNX(ctx, consts.ModelHealthCheckLock, time.Now().Unix(), interval*9/10).Result()
+	if err != nil || !ok {
+		return
+	}
+
+	models, err := m.repo.ListAllActive(ctx)
+	if err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to list models for health check")
+		return
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		changed = make(map[consts.ModelType]struct{})
+	)
+	for _, model := range models {
+		wg.Add(1)
+		go func(model *db.Model) {
+			defer wg.Done()
+			if m.check(ctx, model) {
+				mu.Lock()
+				changed[model.ModelType] = struct{}{}
+				mu.Unlock()
+			}
+		}(model)
+	}
+	wg.Wait()
+
+	for t := range changed {
+		m.notifyChanged(ctx, t)
+	}
+
+	before := time.Now().AddDate(0, 0, -m.cfg.LLMProxy.HealthCheck.RetentionDay)
+	if _, err := m.repo.DeleteHealthChecks(ctx, before); err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to delete expired health checks")
+	}
+}
+
+// check 探测单个模型并更新健康状态，状态变化时返回 true
+func (m *ModelUsecase) check(ctx context.Context, model *db.Model) bool {
+	tctx, cancel := context.WithTimeout(ctx, time.Duration(m.cfg.LLMProxy.HealthCheck.Timeout)*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	res, err := modelkit.CheckModel(tctx, &modelkitDomain.CheckModelReq{
+		Provider:   string(model.Provider),
+		Model:      model.ModelName,
+		BaseURL:    model.APIBase,
+		APIKey:     model.APIKey,
+		APIHeader:  model.APIHeader,
+		APIVersion: model.APIVersion,
+		Type:       string(model.ModelType),
+	})
+	if err == nil && res.Error != "" {
+		err = errors.New(res.Error)
+	}
+	hc := &domain.ModelHealthCheck{
+		ModelID: model.ID,
+		Success: err == nil,
+		Latency: time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		hc.Error = err.Error()
+	}
+	if err := m.repo.SaveHealthCheck(ctx, hc); err != nil {
+		m.logger.With("model_id", model.ID).With("error", err).WarnContext(ctx, "failed to save health check")
+		return false
+	}
+	return m.updateHealth(ctx, model.ID)
+}
+
+// updateHealth 根据最近的探测记录更新健康状态
+// 变为不健康或从不健康恢复时返回 true，需要刷新模型池
+func (m *ModelUsecase) updateHealth(ctx context.Context, id uuid.UUID) bool {
+	status, err := m.healthStatus(ctx, id)
+	if err != nil {
+		m.logger.With("model_id", id).With("error", err).WarnContext(ctx, "failed to get health status")
+		return false
+	}
+	prev, _ := m.redis.HGet(ctx, consts.ModelHealthKey, id.String()).Result()
+	if prev == string(status) {
+		return false
+	}
+	if err := m.redis.HSet(ctx, consts.ModelHealthKey, id.String(), string(status)).Err(); err != nil {
+		m.logger.With("model_id", id).With("error", err).WarnContext(ctx, "failed to save health status")
+		return false
+	}
+	m.logger.With("model_id", id).With("from", prev).With("to", status).WarnContext(ctx, "model health changed")
+	return prev == string(consts.ModelHealthUnhealthy) || status == consts.ModelHealthUnhealthy
+}
+
+// healthStatus 根据最近的探测记录判断健康状态
+// 最近的探测中有成功即为健康，连续失败达到阈值为不健康
+func (m *ModelUsecase) healthStatus(ctx context.Context, id uuid.UUID) (consts.ModelHealth, error) {
+	threshold := max(m.cfg.LLMProxy.HealthCheck.UnhealthyThreshold, 1)
+	checks, err := m.repo.RecentHealthChecks(ctx, id, threshold)
+	if err != nil {
+		return "", err
+	}
+	for _, c := range checks {
+		if c.Success {
+			return consts.ModelHealthHealthy, nil
+		}
+	}
+	if len(checks) >= threshold {
+		return consts.ModelHealthUnhealthy, nil
+	}
+	return consts.ModelHealthUnknown, nil
+}
+
+// health 获取模型的健康统计
+func (m *ModelUsecase) health(ctx context.Context, ids []uuid.UUID) map[uuid.UUID]*domain.ModelHealth {
+	stats, err := m.repo.HealthStat(ctx, ids, time.Now().Add(-healthStatWindow))
+	if err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to get model health stat")
+		stats = make(map[uuid.UUID]*domain.ModelHealth)
+	}
+	status, err := m.redis.HGetAll(ctx, consts.ModelHealthKey).Result()
+	if err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to get model health status")
+	}
+	for _, id := range ids {
+		h, ok := stats[id]
+		if !ok {
+			h = &domain.ModelHealth{}
+			stats[id] = h
+		}
+		h.Status = consts.ModelHealthUnknown
+		if s, ok := status[id.String()]; ok {
+			h.Status = consts.ModelHealth(s)
+		}
+	}
+	return stats
+}
+
+// Uptime implements domain.ModelUsecase.
+func (m *ModelUsecase) Uptime(ctx context.Context, req *domain.ModelUptimeReq) (*domain.ModelUptimeResp, error) {
+	points, err := m.repo.Uptime(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	resp := &domain.ModelUptimeResp{Points: points}
+	var total, success int64
+	for _, p := range points {
+		total += p.Total
+		success += p.Success
+	}
+	if total > 0 {
+		resp.Uptime = float64(success) / float64(total) * 100
+	}
+	return resp, nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+type healthRepo struct {
+	domain.ModelRepo
+	checks []bool // 探测结果，最新的在最后
+	lists  int
+}
+
+func (r *healthRepo) RecentHealthChecks(_ context.Context, _ uuid.UUID, limit int) ([]*db.ModelHealthCheck, error) {
+	var checks []*db.ModelHealthCheck
+	for i := len(r.checks) - 1; i >= 0 && len(checks) < limit; i-- {
+		checks = append(checks, &db.ModelHealthCheck{Success: r.checks[i]})
+	}
+	return checks, nil
+}
+
+func (r *healthRepo) ListAllActive(context.Context) ([]*db.Model, error) {
+	r.lists++
+	return nil, nil
+}
+
+func (r *healthRepo) DeleteHealthChecks(context.Context, time.Time) (int, error) {
+	return 0, nil
+}
+
+func newHealthUsecase(t *testing.T) (*ModelUsecase, *healthRepo, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	cfg := &config.Config{}
+	cfg.LLMProxy.HealthCheck.UnhealthyThreshold = 3
+	cfg.LLMProxy.HealthCheck.RetentionDay = 30
+	repo := &healthRepo{}
+	m := &ModelUsecase{
+		logger: slog.Default(),
+		repo:   repo,
+		cfg:    cfg,
+		redis:  redis.NewClient(&redis.Options{Addr: mr.Addr()}),
+	}
+	return m, repo, mr
+}
+
+func TestUpdateHealth(t *testing.T) {
+	m, repo, mr := newHealthUsecase(t)
+	ctx := context.Background()
+	id := uuid.New()
+
+	steps := []struct {
+		check   bool
+		status  consts.ModelHealth
+		changed bool
+	}{
+		{false, consts.ModelHealthUnknown, false},
+		{false, consts.ModelHealthUnknown, false},
+		{false, consts.ModelHealthUnhealthy, true}, // 连续失败达到阈值
+		{false, consts.ModelHealthUnhealthy, false},
+		{true, consts.ModelHealthHealthy, true}, // 从不健康恢复
+		{false, consts.ModelHealthHealthy, false},
+	}
+	for i, s := range steps {
+		repo.checks = append(repo.checks, s.check)
+		if changed := m.updateHealth(ctx, id); changed != s.changed {
+			t.Errorf("step %d: changed = %v, want %v", i, changed, s.changed)
+		}
+		if got := mr.HGet(consts.ModelHealthKey, id.String()); got != string(s.status) {
+			t.Errorf("step %d: status = %q, want %q", i, got, s.status)
+		}
+	}
+}
+
+func TestCheckAllLock(t *testing.T) {
+	m, repo, mr := newHealthUsecase(t)
+	other, otherRepo, _ := newHealthUsecase(t)
+	other.redis = m.redis
+	ctx := context.Background()
+	interval := time.Minute
+
+	m.checkAll(ctx, interval)
+	other.checkAll(ctx, interval)
+	m.checkAll(ctx, interval)
+	if repo.lists != 1 || otherRepo.lists != 0 {
+		t.Fatalf("only one check per interval, got %d and %d", repo.lists, otherRepo.lists)
+	}
+
+	// 锁在下一个周期前过期
+	mr.FastForward(interval)
+	other.checkAll(ctx, interval)
+	if otherRepo.lists != 1 {
+		t.Fatalf("lock should expire before next interval")
+	}
+}
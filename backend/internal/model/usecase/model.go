@@ -2,18 +2,20 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
 
 	"github.com/chaitin/MonkeyCode/backend/config"
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
-	"github.com/chaitin/MonkeyCode/backend/db/model"
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/breaker"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 )
 
@@ -22,12 +24,14 @@ type ModelUsecase struct {
 	repo   domain.ModelRepo
 	cfg    *config.Config
 	client *http.Client
+	redis  *redis.Client
 }
 
 func NewModelUsecase(
 	logger *slog.Logger,
 	repo domain.ModelRepo,
 	cfg *config.Config,
+	redis *redis.Client,
 ) domain.ModelUsecase {
 	client := &http.Client{
 		Timeout: time.Second * 30,
@@ -38,7 +42,16 @@ func NewModelUsecase(
 			IdleConnTimeout:     time.Second * 30,
 		},
 	}
-	return &ModelUsecase{repo: repo, cfg: cfg, logger: logger, client: client}
+	m := &ModelUsecase{repo: repo, cfg: cfg, logger: logger, client: client, redis: redis}
+	go m.monitor(context.Background())
+	return m
+}
+
+// notifyChanged 通知代理重新加载该类型的模型池，modelType 为空时重新加载全部
+func (m *ModelUsecase) notifyChanged(ctx context.Context, modelType consts.ModelType) {
+	if err := m.redis.Publish(ctx, consts.ModelChangedChannel, string(modelType)).Err(); err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to publish model changed")
+	}
 }
 
 func (m *ModelUsecase) MyModelList(ctx context.Context, req *domain.MyModelListReq) ([]*domain.Model, error) {
@@ -53,12 +66,22 @@ func (m *ModelUsecase) MyModelList(ctx context.Context, req *domain.MyModelListR
 	if err != nil {
 		return nil, err
 	}
+	breakers, err := m.redis.HGetAll(ctx, consts.ModelBreakerKey).Result()
+	if err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to get model breaker state")
+	}
+	health := m.health(ctx, ids)
 	return cvt.Iter(models, func(_ int, e *db.Model) *domain.Model {
 		tmp := cvt.From(e, &domain.Model{}).From(e)
 		if usage, ok := usages[e.ID]; ok {
 			tmp.Input = usage.Input
 			tmp.Output = usage.Output
 		}
+		tmp.Breaker = string(breaker.StateClosed)
+		if state, ok := breakers[e.ID.String()]; ok {
+			tmp.Breaker = state
+		}
+		tmp.Health = health[e.ID]
 		return tmp
 	}), nil
 }
@@ -69,10 +92,16 @@ func (m *ModelUsecase) List(ctx context.Context) (*domain.AllModelResp, error) {
 
 // Create implements domain.ModelUsecase.
 func (m *ModelUsecase) Create(ctx context.Context, req *domain.CreateModelReq) (*domain.Model, error) {
+	if req.Pricing != nil {
+		if err := req.Pricing.Validate(); err != nil {
+			return nil, err
+		}
+	}
 	model, err := m.repo.Create(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	m.notifyChanged(ctx, model.ModelType)
 	return cvt.From(model, &domain.Model{}), nil
 }
 
@@ -107,16 +136,23 @@ func (m *ModelUsecase) Update(ctx context.Context, req *domain.UpdateModelReq) (
 			up.SetShowName(*req.ShowName)
 		}
 		if req.Status != nil {
-			if *req.Status == consts.ModelStatusActive {
-				if err := tx.Model.Update().
-					Where(model.ModelType(old.ModelType)).
-					SetStatus(consts.ModelStatusInactive).
-					Exec(ctx); err != nil {
-					return err
-				}
-			}
 			up.SetStatus(*req.Status)
 		}
+		if req.Aliases != nil {
+			up.SetAliases(req.Aliases)
+		}
+		if req.Weight != nil {
+			if *req.Weight <= 0 {
+				return fmt.Errorf("weight must be greater than 0")
+			}
+			up.SetWeight(*req.Weight)
+		}
+		if req.Pricing != nil {
+			if err := req.Pricing.Validate(); err != nil {
+				return err
+			}
+			up.SetPricing(req.Pricing.Entity())
+		}
 		if req.Param != nil {
 			up.SetParameters(&types.ModelParam{
 				R1Enabled:          req.Param.R1Enabled,
@@ -125,6 +161,8 @@ func (m *ModelUsecase) Update(ctx context.Context, req *domain.UpdateModelReq) (
 				SupprtImages:       req.Param.SupprtImages,
 				SupportComputerUse: req.Param.SupportComputerUse,
 				SupportPromptCache: req.Param.SupportPromptCache,
+				ResponseCache:      req.Param.ResponseCache,
+				ResponseCacheTTL:   req.Param.ResponseCacheTTL,
 			})
 		}
 		return nil
@@ -132,6 +170,7 @@ func (m *ModelUsecase) Update(ctx context.Context, req *domain.UpdateModelReq) (
 	if err != nil {
 		return nil, err
 	}
+	m.notifyChanged(ctx, model.ModelType)
 	return cvt.From(model, &domain.Model{}), nil
 }
 
@@ -144,5 +183,12 @@ func (m *ModelUsecase) InitModel(ctx context.Context) error {
 }
 
 func (m *ModelUsecase) Delete(ctx context.Context, id string) error {
-	return m.repo.Delete(ctx, id)
+	if err := m.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := m.redis.HDel(ctx, consts.ModelHealthKey, id).Err(); err != nil {
+		m.logger.With("model_id", id).With("error", err).WarnContext(ctx, "failed to delete model health status")
+	}
+	m.notifyChanged(ctx, "")
+	return nil
 }
@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// notifyTransformChanged 通知代理重新加载改写策略
+func (m *ModelUsecase) notifyTransformChanged(ctx context.Context) {
+	if err := m.redis.Publish(ctx, consts.TransformChangedChannel, "").Err(); err != nil {
+		m.logger.With("error", err).WarnContext(ctx, "failed to publish transform changed")
+	}
+}
+
+// ListTransformRule implements domain.ModelUsecase.
+func (m *ModelUsecase) ListTransformRule(ctx context.Context) (*domain.ListTransformRuleResp, error) {
+	rules, err := m.repo.ListTransformRule(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := m.repo.LatestTransformPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &domain.ListTransformRuleResp{
+		Rules: cvt.Iter(rules, func(_ int, e *db.TransformRule) *domain.TransformRule {
+			return cvt.From(e, &domain.TransformRule{})
+		}),
+	}
+	if policy != nil {
+		resp.Version = policy.Version
+	}
+	return resp, nil
+}
+
+// CreateTransformRule implements domain.ModelUsecase.
+func (m *ModelUsecase) CreateTransformRule(ctx context.Context, req *domain.CreateTransformRuleReq) (*domain.TransformRule, error) {
+	if err := domain.ValidateTransformConfig(req.Config); err != nil {
+		return nil, err
+	}
+	rule, err := m.repo.CreateTransformRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	m.notifyTransformChanged(ctx)
+	return cvt.From(rule, &domain.TransformRule{}), nil
+}
+
+// UpdateTransformRule implements domain.ModelUsecase.
+func (m *ModelUsecase) UpdateTransformRule(ctx context.Context, req *domain.UpdateTransformRuleReq) (*domain.TransformRule, error) {
+	if err := domain.ValidateTransformConfig(req.Config); err != nil {
+		return nil, err
+	}
+	rule, err := m.repo.UpdateTransformRule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	m.notifyTransformChanged(ctx)
+	return cvt.From(rule, &domain.TransformRule{}), nil
+}
+
+// DeleteTransformRule implements domain.ModelUsecase.
+func (m *ModelUsecase) DeleteTransformRule(ctx context.Context, req *domain.DeleteTransformRuleReq) error {
+	if err := m.repo.DeleteTransformRule(ctx, req); err != nil {
+		return err
+	}
+	m.notifyTransformChanged(ctx)
+	return nil
+}
+
+// ListTransformPolicy implements domain.ModelUsecase.
+func (m *ModelUsecase) ListTransformPolicy(ctx context.Context, req domain.ListTransformPolicyReq) (*domain.ListTransformPolicyResp, error) {
+	return m.repo.ListTransformPolicy(ctx, req)
+}
@@ -57,9 +57,11 @@ func NewV1Handler(
 	g.GET("/models", web.BaseHandler(h.ModelList))
 	g.POST("/completion/accept", web.BindHandler(h.AcceptCompletion), active.Active("apikey"))
 	g.POST("/report", web.BindHandler(h.Report), active.Active("apikey"))
-	g.POST("/chat/completions", web.BaseHandler(h.ChatCompletion), active.Active("apikey"))
-	g.POST("/completions", web.BaseHandler(h.Completions), active.Active("apikey"))
-	g.POST("/embeddings", web.BaseHandler(h.Embeddings), active.Active("apikey"))
+	g.POST("/chat/completions", web.BaseHandler(h.ChatCompletion), active.Active("apikey"), middleware.RateLimit())
+	g.POST("/completions", web.BaseHandler(h.Completions), active.Active("apikey"), middleware.RateLimit())
+	g.POST("/messages", web.BaseHandler(h.Messages), active.Active("apikey"), middleware.RateLimit())
+	g.POST("/responses", web.BaseHandler(h.Responses), active.Active("apikey"), middleware.RateLimit())
+	g.POST("/embeddings", web.BaseHandler(h.Embeddings), active.Active("apikey"), middleware.RateLimit())
 	g.POST("/security/scanning", web.BindHandler(h.CreateSecurityScanning), active.Active("apikey"))
 	g.GET("/security/scanning", web.BindHandler(h.ListSecurityScanning, web.WithPage()), active.Active("apikey"))
 	g.GET("/security/scanning/detail", web.BindHandler(h.ListSecurityScanningDetail, web.WithPage()), active.Active("apikey"))
@@ -175,6 +177,36 @@ func (h *V1Handler) Completions(c *web.Context) error {
 	return nil
 }
 
+// Messages 处理 Anthropic Messages 请求
+//
+//	@Tags			OpenAIV1
+//	@Summary		处理 Anthropic Messages 请求
+//	@Description	兼容 Anthropic Messages 协议，上游为 OpenAI 兼容接口时自动转换请求和响应
+//	@ID				messages
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{}
+//	@Router			/v1/messages [post]
+func (h *V1Handler) Messages(c *web.Context) error {
+	h.proxy.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
+// Responses 处理 OpenAI Responses 请求
+//
+//	@Tags			OpenAIV1
+//	@Summary		处理 OpenAI Responses 请求
+//	@Description	兼容 OpenAI Responses 协议，转换为 Chat Completions 请求上游，不支持 previous_response_id
+//	@ID				responses
+//	@Accept			json
+//	@Produce		json
+//	@Success		200	{object}	web.Resp{}
+//	@Router			/v1/responses [post]
+func (h *V1Handler) Responses(c *web.Context) error {
+	h.proxy.ServeHTTP(c.Response(), c.Request())
+	return nil
+}
+
 // Embeddings 处理嵌入请求
 //
 //	@Tags			OpenAIV1
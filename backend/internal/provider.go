@@ -3,6 +3,9 @@ package internal
 import (
 	"github.com/google/wire"
 
+	auditv1 "github.com/chaitin/MonkeyCode/backend/internal/audit/handler/http/v1"
+	auditrepo "github.com/chaitin/MonkeyCode/backend/internal/audit/repo"
+	auditusecase "github.com/chaitin/MonkeyCode/backend/internal/audit/usecase"
 	billingv1 "github.com/chaitin/MonkeyCode/backend/internal/billing/handler/http/v1"
 	billingrepo "github.com/chaitin/MonkeyCode/backend/internal/billing/repo"
 	billingusecase "github.com/chaitin/MonkeyCode/backend/internal/billing/usecase"
@@ -50,6 +53,7 @@ func NewAPIHandlers(
 	billingV1 *billingv1.BillingHandler,
 	workspaceFileV1 *workspacehandlerv1.WorkspaceFileHandler,
 	securityV1 *securityv1.SecurityHandler,
+	auditV1 *auditv1.AuditHandler,
 ) *APIHandlers {
 	return &APIHandlers{
 		OpenAIV1Handler:      openaiV1,
@@ -61,6 +65,7 @@ func NewAPIHandlers(
 		BillingHandler:       billingV1,
 		WorkspaceFileHandler: workspaceFileV1,
 		SecurityHandler:      securityV1,
+		AuditHandler:         auditV1,
 	}
 }
 
@@ -105,4 +110,7 @@ var Provider = wire.NewSet(
 	securityusecase.NewSecurityScanningUsecase,
 	securityv1.NewSecurityHandler,
 	codesnippetservice.NewOpenAIEmbeddingService,
+	auditrepo.NewAuditRepo,
+	auditusecase.NewAuditUsecase,
+	auditv1.NewAuditHandler,
 )
@@ -0,0 +1,212 @@
+package adapter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// Request 发往上游的请求
+type Request struct {
+	Model  *domain.Model
+	Path   string // OpenAI 接口路径，如 /chat/completions
+	Body   []byte // OpenAI 格式的请求体
+	Stream bool   // 是否为流式请求
+
+	IncludeUsage bool // 流式请求是否要求上游在最后返回 usage
+}
+
+// Adapter 将 OpenAI 格式的请求适配到不同供应商的上游接口
+type Adapter interface {
+	// Rewrite 改写请求的地址、认证、请求头和请求体
+	Rewrite(out *http.Request, req *Request) error
+	// Response 将上游响应转换为 OpenAI 格式
+	Response(resp *http.Response, req *Request) error
+}
+
+var (
+	mu       sync.RWMutex
+	adapters = map[consts.ModelProvider]Adapter{
+		consts.ModelProviderZhiPu:       &ZhiPu{},
+		consts.ModelProviderAzureOpenAI: &Azure{},
+		consts.ModelProviderAnthropic:   &Anthropic{},
+		consts.ModelProviderGemini:      &Gemini{},
+		consts.ModelProviderOllama:      &Ollama{},
+	}
+)
+
+// Register 注册供应商适配器，会覆盖已有的适配器
+func Register(provider consts.ModelProvider, a Adapter) {
+	mu.Lock()
+	defer mu.Unlock()
+	adapters[provider] = a
+}
+
+// Get 获取供应商适配器，未注册的供应商使用 OpenAI 兼容接口
+func Get(provider consts.ModelProvider) Adapter {
+	mu.RLock()
+	defer mu.RUnlock()
+	if a, ok := adapters[provider]; ok {
+		return a
+	}
+	return &OpenAI{}
+}
+
+// authHeaders 客户端携带的平台密钥，不能透传给上游
+var authHeaders = []string{"Authorization", "X-API-Key", "Api-Key", "X-Goog-Api-Key"}
+
+// Rewrite 使用模型供应商的适配器改写请求，并附加模型配置的自定义请求头
+func Rewrite(out *http.Request, req *Request) error {
+	for _, h := range authHeaders {
+		out.Header.Del(h)
+	}
+	if len(req.Body) > 0 {
+		var r struct {
+			Stream bool `json:"stream"`
+		}
+		if err := json.Unmarshal(req.Body, &r); err == nil {
+			req.Stream = r.Stream
+		}
+	}
+	if err := Get(req.Model.Provider).Rewrite(out, req); err != nil {
+		return err
+	}
+	for k, v := range ParseHeader(req.Model.APIHeader) {
+		out.Header.Set(k, v)
+	}
+	return nil
+}
+
+// Response 使用模型供应商的适配器转换上游响应
+func Response(resp *http.Response, req *Request) error {
+	return Get(req.Model.Provider).Response(resp, req)
+}
+
+// ParseHeader 解析模型的自定义请求头，每行一个，如：Authorization: Bearer sk-xxxx 或 key=value
+func ParseHeader(header string) map[string]string {
+	headers := make(map[string]string)
+	for _, line := range strings.Split(header, "\n") {
+		i := strings.IndexAny(line, ":=")
+		if i <= 0 {
+			continue
+		}
+		key := strings.TrimSpace(line[:i])
+		if key == "" {
+			continue
+		}
+		headers[key] = strings.TrimSpace(line[i+1:])
+	}
+	return headers
+}
+
+// setURL 将请求指向 base 下的 path，保留 base 中的查询参数
+func setURL(out *http.Request, base, path string) error {
+	ul, err := url.Parse(base)
+	if err != nil {
+		return err
+	}
+	if ul.Scheme == "" || ul.Host == "" {
+		return fmt.Errorf("invalid api base %q", base)
+	}
+	out.URL.Scheme = ul.Scheme
+	out.URL.Host = ul.Host
+	out.URL.Path = strings.TrimSuffix(ul.Path, "/") + path
+	out.URL.RawPath = ""
+	out.URL.RawQuery = ul.RawQuery
+	out.Host = ul.Host
+	return nil
+}
+
+// basePath 返回 base 地址中的路径
+func basePath(base string) string {
+	ul, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(ul.Path, "/")
+}
+
+func setBody(out *http.Request, body []byte) {
+	out.Body = io.NopCloser(bytes.NewReader(body))
+	out.ContentLength = int64(len(body))
+	out.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	out.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+}
+
+// setModel 将请求体中的 model 替换为上游真实的模型名称，并移除上游不支持的字段
+func setModel(body []byte, name string, drop ...string) ([]byte, error) {
+	if len(body) == 0 {
+		return body, nil
+	}
+	req := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	for _, k := range drop {
+		delete(req, k)
+	}
+	b, err := json.Marshal(name)
+	if err != nil {
+		return nil, err
+	}
+	req["model"] = b
+	return json.Marshal(req)
+}
+
+// includeUsage 为流式请求设置 stream_options.include_usage，客户端已设置时不覆盖
+func includeUsage(req *Request, body []byte) ([]byte, error) {
+	if !req.Stream || !req.IncludeUsage || len(body) == 0 {
+		return body, nil
+	}
+	if req.Path != "/chat/completions" && req.Path != "/completions" {
+		return body, nil
+	}
+	m := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, err
+	}
+	opts := make(map[string]json.RawMessage)
+	if raw, ok := m["stream_options"]; ok && string(raw) != "null" {
+		if err := json.Unmarshal(raw, &opts); err != nil {
+			return nil, err
+		}
+		if _, ok := opts["include_usage"]; ok {
+			return body, nil
+		}
+	}
+	opts["include_usage"] = json.RawMessage("true")
+	b, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+	m["stream_options"] = b
+	return json.Marshal(m)
+}
+
+// replaceBody 替换响应体，并修正响应长度
+func replaceBody(resp *http.Response, body []byte) {
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+	resp.Header.Del("Content-Encoding")
+}
+
+// streamBody 替换为流式响应体，长度未知
+func streamBody(resp *http.Response, body io.ReadCloser) {
+	resp.Body = body
+	resp.ContentLength = -1
+	resp.Header.Del("Content-Length")
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Set("Content-Type", "text/event-stream")
+}
@@ -0,0 +1,452 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rokku-c/go-openai"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+const chatBody = `{"model":"alias","stream":%t,"max_tokens":16,"metadata":{"task_id":"t1"},"messages":[{"role":"system","content":"be brief"},{"role":"user","content":"hi"}]}`
+
+func chat(stream bool) string {
+	return fmt.Sprintf(chatBody, stream)
+}
+
+func TestAdapter(t *testing.T) {
+	cases := []struct {
+		name     string
+		provider consts.ModelProvider
+		base     string // 拼接在 stand-in 地址后
+		model    string
+		version  string
+		header   string
+		path     string
+		body     string
+		// 上游 stand-in 的响应
+		status int
+		ctype  string
+		reply  string
+		// 校验上游收到的请求
+		upstream func(t *testing.T, r *http.Request, body map[string]any)
+		// 校验转换后返回给客户端的响应
+		client func(t *testing.T, body string)
+	}{
+		{
+			name:     "openai",
+			provider: consts.ModelProviderOpenAI,
+			base:     "/v1",
+			model:    "gpt-4o",
+			header:   "X-Custom: a\nX-Other=b",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusOK,
+			reply:    `{"id":"c1","choices":[{"message":{"role":"assistant","content":"ok"}}]}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/v1/chat/completions")
+				expect(t, r.Header.Get("Authorization"), "Bearer sk-test")
+				expect(t, r.Header.Get("X-Custom"), "a")
+				expect(t, r.Header.Get("X-Other"), "b")
+				expect(t, body["model"], "gpt-4o")
+				if body["metadata"] == nil {
+					t.Error("metadata should be kept")
+				}
+			},
+			client: func(t *testing.T, body string) {
+				expect(t, body, `{"id":"c1","choices":[{"message":{"role":"assistant","content":"ok"}}]}`)
+			},
+		},
+		{
+			name:     "zhipu strips metadata",
+			provider: consts.ModelProviderZhiPu,
+			base:     "/api/paas/v4",
+			model:    "glm-4",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusOK,
+			reply:    `{}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/api/paas/v4/chat/completions")
+				if _, ok := body["metadata"]; ok {
+					t.Error("metadata should be removed")
+				}
+			},
+		},
+		{
+			name:     "azure",
+			provider: consts.ModelProviderAzureOpenAI,
+			model:    "gpt-4o",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusOK,
+			reply:    `{}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/openai/deployments/gpt-4o/chat/completions")
+				expect(t, r.URL.Query().Get("api-version"), azureVersion)
+				expect(t, r.Header.Get("Api-Key"), "sk-test")
+				expect(t, r.Header.Get("Authorization"), "")
+			},
+		},
+		{
+			name:     "azure deployment base and version",
+			provider: consts.ModelProviderAzureOpenAI,
+			base:     "/openai/deployments/prod",
+			model:    "gpt-4o",
+			version:  "2025-01-01-preview",
+			path:     "/completions",
+			body:     `{"model":"gpt-4o","prompt":"a"}`,
+			status:   http.StatusOK,
+			reply:    `{}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/openai/deployments/prod/completions")
+				expect(t, r.URL.Query().Get("api-version"), "2025-01-01-preview")
+			},
+		},
+		{
+			name:     "anthropic",
+			provider: consts.ModelProviderAnthropic,
+			base:     "/v1",
+			model:    "claude-sonnet-4",
+			path:     "/messages",
+			body:     `{"model":"claude","max_tokens":16,"messages":[{"role":"user","content":"hi"}]}`,
+			status:   http.StatusOK,
+			reply:    `{}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/v1/messages")
+				expect(t, r.Header.Get("X-API-Key"), "sk-test")
+				expect(t, r.Header.Get("Anthropic-Version"), "2023-06-01")
+				expect(t, r.Header.Get("Authorization"), "")
+				expect(t, body["model"], "claude-sonnet-4")
+			},
+		},
+		{
+			name:     "gemini openai compatible",
+			provider: consts.ModelProviderGemini,
+			base:     "/v1beta/openai",
+			model:    "gemini-2.0-flash",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusOK,
+			reply:    `{}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/v1beta/openai/chat/completions")
+				expect(t, r.Header.Get("Authorization"), "Bearer sk-test")
+			},
+		},
+		{
+			name:     "gemini native",
+			provider: consts.ModelProviderGemini,
+			model:    "gemini-2.0-flash",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusOK,
+			reply:    `{"candidates":[{"content":{"role":"model","parts":[{"text":"hel"},{"text":"lo"}]},"finishReason":"STOP"}],"usageMetadata":{"promptTokenCount":3,"candidatesTokenCount":2,"totalTokenCount":5}}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/v1beta/models/gemini-2.0-flash:generateContent")
+				expect(t, r.Header.Get("X-Goog-Api-Key"), "sk-test")
+				expect(t, r.Header.Get("Authorization"), "")
+				b, _ := json.Marshal(body)
+				expect(t, string(b), `{"contents":[{"parts":[{"text":"hi"}],"role":"user"}],"generationConfig":{"maxOutputTokens":16},"systemInstruction":{"parts":[{"text":"be brief"}]}}`)
+			},
+			client: func(t *testing.T, body string) {
+				var resp openai.ChatCompletionResponse
+				if err := json.Unmarshal([]byte(body), &resp); err != nil {
+					t.Fatal(err)
+				}
+				expect(t, resp.Choices[0].Message.Content, "hello")
+				expect(t, resp.Choices[0].FinishReason, openai.FinishReasonStop)
+				expect(t, resp.Usage.TotalTokens, 5)
+			},
+		},
+		{
+			name:     "gemini native stream",
+			provider: consts.ModelProviderGemini,
+			base:     "/v1beta",
+			model:    "gemini-2.0-flash",
+			path:     "/chat/completions",
+			body:     chat(true),
+			status:   http.StatusOK,
+			ctype:    "text/event-stream",
+			reply: "data: {\"candidates\":[{\"content\":{\"parts\":[{\"text\":\"hel\"}]}}]}\r\n\r\n" +
+				"data: {\"candidates\":[{\"content\":{\"parts\":[{\"functionCall\":{\"name\":\"ls\",\"args\":{\"path\":\".\"}}}]},\"finishReason\":\"STOP\"}],\"usageMetadata\":{\"promptTokenCount\":3,\"candidatesTokenCount\":2}}\r\n\r\n",
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/v1beta/models/gemini-2.0-flash:streamGenerateContent")
+				expect(t, r.URL.Query().Get("alt"), "sse")
+			},
+			client: func(t *testing.T, body string) {
+				chunks := streamChunks(t, body)
+				if len(chunks) != 3 {
+					t.Fatalf("unexpected chunks: %s", body)
+				}
+				expect(t, chunks[0].Choices[0].Delta.Content, "hel")
+				tc := chunks[1].Choices[0].Delta.ToolCalls
+				if len(tc) != 1 || tc[0].Function.Arguments != `{"path":"."}` || *tc[0].Index != 0 {
+					t.Fatalf("unexpected tool calls: %+v", tc)
+				}
+				expect(t, chunks[1].Choices[0].FinishReason, openai.FinishReasonToolCalls)
+				expect(t, chunks[2].Usage.TotalTokens, 5)
+			},
+		},
+		{
+			name:     "gemini native error",
+			provider: consts.ModelProviderGemini,
+			model:    "gemini-2.0-flash",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusBadRequest,
+			reply:    `{"error":{"code":400,"message":"API key not valid","status":"INVALID_ARGUMENT"}}`,
+			client: func(t *testing.T, body string) {
+				expect(t, body, `{"error":{"message":"API key not valid","type":"upstream_error"}}`)
+			},
+		},
+		{
+			name:     "ollama openai compatible",
+			provider: consts.ModelProviderOllama,
+			base:     "/v1",
+			model:    "qwen2.5-coder",
+			path:     "/completions",
+			body:     `{"model":"qwen","prompt":"a"}`,
+			status:   http.StatusOK,
+			reply:    `{}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/v1/completions")
+				expect(t, body["model"], "qwen2.5-coder")
+			},
+		},
+		{
+			name:     "ollama native chat",
+			provider: consts.ModelProviderOllama,
+			model:    "qwen2.5-coder",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusOK,
+			reply:    `{"model":"qwen2.5-coder","message":{"role":"assistant","content":"ok"},"done":true,"done_reason":"stop","prompt_eval_count":4,"eval_count":1}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/api/chat")
+				expect(t, body["stream"], false)
+				expect[any](t, body["options"].(map[string]any)["num_predict"], float64(16))
+			},
+			client: func(t *testing.T, body string) {
+				var resp openai.ChatCompletionResponse
+				if err := json.Unmarshal([]byte(body), &resp); err != nil {
+					t.Fatal(err)
+				}
+				expect(t, resp.Choices[0].Message.Content, "ok")
+				expect(t, resp.Usage.PromptTokens, 4)
+				expect(t, resp.Usage.CompletionTokens, 1)
+			},
+		},
+		{
+			name:     "ollama native generate stream",
+			provider: consts.ModelProviderOllama,
+			model:    "qwen2.5-coder",
+			path:     "/completions",
+			body:     `{"model":"qwen","prompt":"func main","suffix":"}","stream":true}`,
+			status:   http.StatusOK,
+			ctype:    "application/x-ndjson",
+			reply: `{"response":"() {","done":false}` + "\n" +
+				`{"response":"","done":true,"done_reason":"length","prompt_eval_count":5,"eval_count":2}` + "\n",
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/api/generate")
+				expect(t, body["prompt"], "func main")
+				expect(t, body["suffix"], "}")
+				expect(t, body["stream"], true)
+			},
+			client: func(t *testing.T, body string) {
+				if !strings.HasSuffix(body, "data: [DONE]\n\n") {
+					t.Fatalf("missing done: %s", body)
+				}
+				var texts []openai.CompletionResponse
+				for _, data := range sseData(body) {
+					var r openai.CompletionResponse
+					if err := json.Unmarshal([]byte(data), &r); err != nil {
+						t.Fatal(err)
+					}
+					texts = append(texts, r)
+				}
+				if len(texts) != 3 {
+					t.Fatalf("unexpected chunks: %s", body)
+				}
+				expect(t, texts[0].Choices[0].Text, "() {")
+				expect(t, texts[1].Choices[0].FinishReason, "length")
+				expect(t, texts[2].Usage.TotalTokens, 7)
+			},
+		},
+		{
+			name:     "ollama native embeddings",
+			provider: consts.ModelProviderOllama,
+			model:    "nomic-embed-text",
+			path:     "/embeddings",
+			body:     `{"model":"embed","input":["a","b"]}`,
+			status:   http.StatusOK,
+			reply:    `{"model":"nomic-embed-text","embeddings":[[0.1,0.2],[0.3,0.4]],"prompt_eval_count":6}`,
+			upstream: func(t *testing.T, r *http.Request, body map[string]any) {
+				expect(t, r.URL.Path, "/api/embed")
+				expect(t, body["model"], "nomic-embed-text")
+				expect(t, len(body["input"].([]any)), 2)
+			},
+			client: func(t *testing.T, body string) {
+				var resp openai.EmbeddingResponse
+				if err := json.Unmarshal([]byte(body), &resp); err != nil {
+					t.Fatal(err)
+				}
+				expect(t, len(resp.Data), 2)
+				expect(t, resp.Data[1].Index, 1)
+				expect(t, resp.Data[1].Embedding[0], float32(0.3))
+				expect(t, resp.Usage.PromptTokens, 6)
+			},
+		},
+		{
+			name:     "ollama native error",
+			provider: consts.ModelProviderOllama,
+			model:    "missing",
+			path:     "/chat/completions",
+			body:     chat(false),
+			status:   http.StatusNotFound,
+			reply:    `{"error":"model \"missing\" not found"}`,
+			client: func(t *testing.T, body string) {
+				expect(t, body, `{"error":{"message":"model \"missing\" not found","type":"upstream_error"}}`)
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if c.upstream != nil {
+					b, _ := io.ReadAll(r.Body)
+					body := make(map[string]any)
+					if err := json.Unmarshal(b, &body); err != nil {
+						t.Errorf("unmarshal upstream body: %v", err)
+					}
+					c.upstream(t, r, body)
+				}
+				if c.ctype != "" {
+					w.Header().Set("Content-Type", c.ctype)
+				}
+				w.WriteHeader(c.status)
+				io.WriteString(w, c.reply)
+			}))
+			defer srv.Close()
+
+			req := &Request{
+				Model: &domain.Model{
+					Provider:   c.provider,
+					ModelName:  c.model,
+					APIBase:    srv.URL + c.base,
+					APIKey:     "sk-test",
+					APIVersion: c.version,
+					APIHeader:  c.header,
+				},
+				Path: c.path,
+				Body: []byte(c.body),
+			}
+			out, err := http.NewRequest(http.MethodPost, "http://monkeycode/v1"+c.path, strings.NewReader(c.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 客户端携带的平台密钥
+			out.Header.Set("Authorization", "Bearer platform-key")
+			out.Header.Set("X-API-Key", "platform-key")
+			if err := Rewrite(out, req); err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := http.DefaultTransport.RoundTrip(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			if err := Response(resp, req); err != nil {
+				t.Fatal(err)
+			}
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.ContentLength >= 0 && resp.ContentLength != int64(len(body)) {
+				t.Fatalf("content length %d, body %d", resp.ContentLength, len(body))
+			}
+			if c.client != nil {
+				c.client(t, string(body))
+			}
+		})
+	}
+}
+
+func TestParseHeader(t *testing.T) {
+	got := ParseHeader("Authorization: Bearer sk-x\r\nX-Key=a=b\n\ninvalid\n: empty")
+	want := map[string]string{"Authorization": "Bearer sk-x", "X-Key": "a=b"}
+	if len(got) != len(want) {
+		t.Fatalf("unexpected headers: %v", got)
+	}
+	for k, v := range want {
+		expect(t, got[k], v)
+	}
+}
+
+func TestIncludeUsage(t *testing.T) {
+	cases := []struct {
+		req  Request
+		body string
+		want string
+	}{
+		{Request{Path: "/chat/completions", Stream: true, IncludeUsage: true}, `{"stream":true}`, `{"include_usage":true}`},
+		{Request{Path: "/completions", Stream: true, IncludeUsage: true}, `{"stream":true,"stream_options":{"x":1}}`, `{"include_usage":true,"x":1}`},
+		// 客户端明确设置时不覆盖
+		{Request{Path: "/chat/completions", Stream: true, IncludeUsage: true}, `{"stream":true,"stream_options":{"include_usage":false}}`, `{"include_usage":false}`},
+		{Request{Path: "/chat/completions", IncludeUsage: true}, `{"stream":false}`, ""},
+		{Request{Path: "/chat/completions", Stream: true}, `{"stream":true}`, ""},
+		{Request{Path: "/embeddings", Stream: true, IncludeUsage: true}, `{"stream":true}`, ""},
+	}
+	for _, c := range cases {
+		out, err := includeUsage(&c.req, []byte(c.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(out, &m); err != nil {
+			t.Fatal(err)
+		}
+		expect(t, string(m["stream_options"]), c.want)
+	}
+}
+
+func expect[T comparable](t *testing.T, got, want T) {
+	t.Helper()
+	if got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func sseData(body string) []string {
+	var out []string
+	for _, line := range strings.Split(body, "\n") {
+		data, ok := strings.CutPrefix(line, "data: ")
+		if ok && data != "[DONE]" {
+			out = append(out, data)
+		}
+	}
+	return out
+}
+
+func streamChunks(t *testing.T, body string) []openai.ChatCompletionStreamResponse {
+	t.Helper()
+	var chunks []openai.ChatCompletionStreamResponse
+	for _, data := range sseData(body) {
+		var c openai.ChatCompletionStreamResponse
+		if err := json.Unmarshal([]byte(data), &c); err != nil {
+			t.Fatal(err)
+		}
+		chunks = append(chunks, c)
+	}
+	return chunks
+}
@@ -0,0 +1,37 @@
+package adapter
+
+import (
+	"net/http"
+
+	"github.com/chaitin/MonkeyCode/backend/pkg/anthropic"
+)
+
+// Anthropic 透传 Messages 请求，使用 x-api-key 认证
+type Anthropic struct{}
+
+var _ Adapter = &Anthropic{}
+
+// Rewrite implements Adapter.
+func (a *Anthropic) Rewrite(out *http.Request, req *Request) error {
+	m := req.Model
+	body, err := setModel(req.Body, m.ModelName)
+	if err != nil {
+		return err
+	}
+	if err := setURL(out, m.APIBase, req.Path); err != nil {
+		return err
+	}
+	out.Header.Set("X-API-Key", m.APIKey)
+	if m.APIVersion != "" {
+		out.Header.Set("Anthropic-Version", m.APIVersion)
+	} else if out.Header.Get("Anthropic-Version") == "" {
+		out.Header.Set("Anthropic-Version", anthropic.Version)
+	}
+	setBody(out, body)
+	return nil
+}
+
+// Response implements Adapter.
+func (a *Anthropic) Response(*http.Response, *Request) error {
+	return nil
+}
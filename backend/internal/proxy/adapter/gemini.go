@@ -0,0 +1,404 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rokku-c/go-openai"
+)
+
+// Gemini 使用原生 generateContent 接口，地址中包含 /openai 时使用 OpenAI 兼容接口
+type Gemini struct{}
+
+var _ Adapter = &Gemini{}
+
+// geminiVersion 地址中没有指定版本时使用的接口版本
+const geminiVersion = "/v1beta"
+
+func (g *Gemini) compatible(base string) bool {
+	return strings.Contains(basePath(base), "/openai")
+}
+
+// Rewrite implements Adapter.
+func (g *Gemini) Rewrite(out *http.Request, req *Request) error {
+	m := req.Model
+	if g.compatible(m.APIBase) {
+		return rewriteOpenAI(out, req)
+	}
+	if req.Path != "/chat/completions" {
+		return fmt.Errorf("gemini native api does not support %s", req.Path)
+	}
+
+	var creq openai.ChatCompletionRequest
+	if err := json.Unmarshal(req.Body, &creq); err != nil {
+		return err
+	}
+	body, err := json.Marshal(toGemini(&creq))
+	if err != nil {
+		return err
+	}
+
+	base := strings.TrimSuffix(m.APIBase, "/")
+	if basePath(base) == "" {
+		base += geminiVersion
+	}
+	action := ":generateContent"
+	if req.Stream {
+		action = ":streamGenerateContent"
+	}
+	name := strings.TrimPrefix(m.ModelName, "models/")
+	if err := setURL(out, base, "/models/"+url.PathEscape(name)+action); err != nil {
+		return err
+	}
+	if req.Stream {
+		q := out.URL.Query()
+		q.Set("alt", "sse")
+		out.URL.RawQuery = q.Encode()
+	}
+	out.Header.Set("X-Goog-Api-Key", m.APIKey)
+	out.Header.Set("Content-Type", "application/json")
+	setBody(out, body)
+	return nil
+}
+
+// Response implements Adapter.
+func (g *Gemini) Response(resp *http.Response, req *Request) error {
+	if g.compatible(req.Model.APIBase) {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return convertError(resp, func(body []byte) string {
+			var e struct {
+				Error struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			json.Unmarshal(body, &e)
+			return e.Error.Message
+		})
+	}
+
+	if req.Stream {
+		w := newChunkWriter(req.Model.ModelName, "chat.completion.chunk")
+		s := &geminiStream{}
+		streamBody(resp, newLineReader(resp.Body, w, s.line))
+		return nil
+	}
+
+	var gresp geminiResponse
+	if err := readJSON(resp, &gresp); err != nil {
+		return err
+	}
+	out := openai.ChatCompletionResponse{
+		ID:      "chatcmpl-" + uuid.NewString(),
+		Object:  "chat.completion",
+		Created: time.Now().Unix(),
+		Model:   req.Model.ModelName,
+	}
+	if gresp.ResponseID != "" {
+		out.ID = gresp.ResponseID
+	}
+	msg := openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant}
+	var reason openai.FinishReason
+	if len(gresp.Candidates) > 0 {
+		c := gresp.Candidates[0]
+		for i, p := range c.Content.Parts {
+			if p.Text != "" && !p.Thought {
+				msg.Content += p.Text
+			}
+			if p.FunctionCall != nil {
+				msg.ToolCalls = append(msg.ToolCalls, geminiToolCall(i, p.FunctionCall, false))
+			}
+		}
+		reason = geminiFinishReason(c.FinishReason, len(msg.ToolCalls) > 0)
+	}
+	out.Choices = []openai.ChatCompletionChoice{{Message: msg, FinishReason: reason}}
+	if u := gresp.UsageMetadata; u != nil {
+		out.Usage = *usage(u.PromptTokenCount, u.CandidatesTokenCount+u.ThoughtsTokenCount)
+	}
+	return writeJSON(resp, out)
+}
+
+// geminiStream 转换 streamGenerateContent 的 SSE 响应
+type geminiStream struct {
+	started bool
+	tools   int
+}
+
+func (s *geminiStream) line(line string, w *chunkWriter) {
+	data, ok := strings.CutPrefix(line, "data:")
+	if !ok {
+		return
+	}
+	var gresp geminiResponse
+	if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &gresp); err != nil {
+		return
+	}
+	if u := gresp.UsageMetadata; u != nil {
+		w.usage = usage(u.PromptTokenCount, u.CandidatesTokenCount+u.ThoughtsTokenCount)
+	}
+	if len(gresp.Candidates) == 0 {
+		return
+	}
+
+	c := gresp.Candidates[0]
+	delta := openai.ChatCompletionStreamChoiceDelta{}
+	if !s.started {
+		s.started = true
+		delta.Role = openai.ChatMessageRoleAssistant
+	}
+	for _, p := range c.Content.Parts {
+		if p.Text != "" && !p.Thought {
+			delta.Content += p.Text
+		}
+		if p.FunctionCall != nil {
+			delta.ToolCalls = append(delta.ToolCalls, geminiToolCall(s.tools, p.FunctionCall, true))
+			s.tools++
+		}
+	}
+	w.chat(delta, geminiFinishReason(c.FinishReason, s.tools > 0))
+}
+
+type geminiRequest struct {
+	Contents          []geminiContent   `json:"contents"`
+	SystemInstruction *geminiContent    `json:"systemInstruction,omitempty"`
+	Tools             []geminiTool      `json:"tools,omitempty"`
+	ToolConfig        *geminiToolConfig `json:"toolConfig,omitempty"`
+	GenerationConfig  geminiConfig      `json:"generationConfig"`
+}
+
+type geminiContent struct {
+	Role  string       `json:"role,omitempty"`
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiPart struct {
+	Text             string                  `json:"text,omitempty"`
+	Thought          bool                    `json:"thought,omitempty"`
+	InlineData       *geminiInlineData       `json:"inlineData,omitempty"`
+	FunctionCall     *geminiFunctionCall     `json:"functionCall,omitempty"`
+	FunctionResponse *geminiFunctionResponse `json:"functionResponse,omitempty"`
+}
+
+type geminiInlineData struct {
+	MimeType string `json:"mimeType"`
+	Data     string `json:"data"`
+}
+
+type geminiFunctionCall struct {
+	Name string          `json:"name"`
+	Args json.RawMessage `json:"args,omitempty"`
+}
+
+type geminiFunctionResponse struct {
+	Name     string         `json:"name"`
+	Response map[string]any `json:"response"`
+}
+
+type geminiTool struct {
+	FunctionDeclarations []geminiFunction `json:"functionDeclarations"`
+}
+
+type geminiFunction struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Parameters  any    `json:"parameters,omitempty"`
+}
+
+type geminiToolConfig struct {
+	FunctionCallingConfig struct {
+		Mode                 string   `json:"mode"`
+		AllowedFunctionNames []string `json:"allowedFunctionNames,omitempty"`
+	} `json:"functionCallingConfig"`
+}
+
+type geminiConfig struct {
+	Temperature     float32  `json:"temperature,omitempty"`
+	TopP            float32  `json:"topP,omitempty"`
+	MaxOutputTokens int      `json:"maxOutputTokens,omitempty"`
+	StopSequences   []string `json:"stopSequences,omitempty"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content      geminiContent `json:"content"`
+		FinishReason string        `json:"finishReason"`
+	} `json:"candidates"`
+	UsageMetadata *struct {
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+		ThoughtsTokenCount   int `json:"thoughtsTokenCount"`
+		TotalTokenCount      int `json:"totalTokenCount"`
+	} `json:"usageMetadata"`
+	ResponseID string `json:"responseId"`
+}
+
+// toGemini 将 Chat Completions 请求转换为 generateContent 请求
+func toGemini(req *openai.ChatCompletionRequest) *geminiRequest {
+	out := &geminiRequest{
+		Contents: []geminiContent{},
+		GenerationConfig: geminiConfig{
+			Temperature:     req.Temperature,
+			TopP:            req.TopP,
+			MaxOutputTokens: req.MaxTokens,
+			StopSequences:   req.Stop,
+		},
+	}
+	if req.MaxCompletionTokens > 0 {
+		out.GenerationConfig.MaxOutputTokens = req.MaxCompletionTokens
+	}
+
+	// tool 消息只携带 tool_call_id，需要根据之前的调用找到函数名
+	names := make(map[string]string)
+	for _, m := range req.Messages {
+		switch m.Role {
+		case openai.ChatMessageRoleSystem, "developer":
+			if out.SystemInstruction == nil {
+				out.SystemInstruction = &geminiContent{}
+			}
+			out.SystemInstruction.Parts = append(out.SystemInstruction.Parts, geminiParts(m)...)
+
+		case openai.ChatMessageRoleAssistant:
+			c := geminiContent{Role: "model", Parts: geminiParts(m)}
+			for _, tc := range m.ToolCalls {
+				names[tc.ID] = tc.Function.Name
+				args := json.RawMessage(tc.Function.Arguments)
+				if !json.Valid(args) {
+					args = json.RawMessage("{}")
+				}
+				c.Parts = append(c.Parts, geminiPart{FunctionCall: &geminiFunctionCall{Name: tc.Function.Name, Args: args}})
+			}
+			if len(c.Parts) > 0 {
+				out.Contents = append(out.Contents, c)
+			}
+
+		case openai.ChatMessageRoleTool:
+			var result any = m.Content
+			if json.Valid([]byte(m.Content)) {
+				result = json.RawMessage(m.Content)
+			}
+			out.Contents = append(out.Contents, geminiContent{
+				Role: "user",
+				Parts: []geminiPart{{FunctionResponse: &geminiFunctionResponse{
+					Name:     names[m.ToolCallID],
+					Response: map[string]any{"content": result},
+				}}},
+			})
+
+		default:
+			if parts := geminiParts(m); len(parts) > 0 {
+				out.Contents = append(out.Contents, geminiContent{Role: "user", Parts: parts})
+			}
+		}
+	}
+
+	if len(req.Tools) > 0 {
+		tool := geminiTool{}
+		for _, t := range req.Tools {
+			if t.Function == nil {
+				continue
+			}
+			tool.FunctionDeclarations = append(tool.FunctionDeclarations, geminiFunction{
+				Name:        t.Function.Name,
+				Description: t.Function.Description,
+				Parameters:  t.Function.Parameters,
+			})
+		}
+		out.Tools = []geminiTool{tool}
+		out.ToolConfig = geminiToolChoice(req.ToolChoice)
+	}
+	return out
+}
+
+// geminiParts 转换消息中的文本和图片
+func geminiParts(m openai.ChatCompletionMessage) []geminiPart {
+	if len(m.MultiContent) == 0 {
+		if m.Content == "" {
+			return nil
+		}
+		return []geminiPart{{Text: m.Content}}
+	}
+	var parts []geminiPart
+	for _, p := range m.MultiContent {
+		switch p.Type {
+		case openai.ChatMessagePartTypeText:
+			parts = append(parts, geminiPart{Text: p.Text})
+		case openai.ChatMessagePartTypeImageURL:
+			if p.ImageURL == nil {
+				continue
+			}
+			// 只支持 data:image/png;base64,xxx 格式的图片
+			meta, data, ok := strings.Cut(strings.TrimPrefix(p.ImageURL.URL, "data:"), ";base64,")
+			if !ok {
+				continue
+			}
+			parts = append(parts, geminiPart{InlineData: &geminiInlineData{MimeType: meta, Data: data}})
+		}
+	}
+	return parts
+}
+
+func geminiToolChoice(choice any) *geminiToolConfig {
+	cfg := &geminiToolConfig{}
+	switch c := choice.(type) {
+	case string:
+		switch c {
+		case "none":
+			cfg.FunctionCallingConfig.Mode = "NONE"
+		case "required":
+			cfg.FunctionCallingConfig.Mode = "ANY"
+		default:
+			return nil
+		}
+	case map[string]any:
+		fn, _ := c["function"].(map[string]any)
+		name, _ := fn["name"].(string)
+		if name == "" {
+			return nil
+		}
+		cfg.FunctionCallingConfig.Mode = "ANY"
+		cfg.FunctionCallingConfig.AllowedFunctionNames = []string{name}
+	default:
+		return nil
+	}
+	return cfg
+}
+
+func geminiToolCall(index int, fc *geminiFunctionCall, stream bool) openai.ToolCall {
+	args := string(fc.Args)
+	if args == "" {
+		args = "{}"
+	}
+	tc := openai.ToolCall{
+		ID:       "call_" + strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Type:     openai.ToolTypeFunction,
+		Function: openai.FunctionCall{Name: fc.Name, Arguments: args},
+	}
+	if stream {
+		tc.Index = &index
+	}
+	return tc
+}
+
+func geminiFinishReason(reason string, tools bool) openai.FinishReason {
+	switch reason {
+	case "":
+		return ""
+	case "STOP":
+		if tools {
+			return openai.FinishReasonToolCalls
+		}
+		return openai.FinishReasonStop
+	case "MAX_TOKENS":
+		return openai.FinishReasonLength
+	case "SAFETY", "RECITATION", "BLOCKLIST", "PROHIBITED_CONTENT", "SPII":
+		return openai.FinishReasonContentFilter
+	default:
+		return openai.FinishReasonStop
+	}
+}
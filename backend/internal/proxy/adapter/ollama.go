@@ -0,0 +1,360 @@
+package adapter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rokku-c/go-openai"
+)
+
+// Ollama 使用原生 /api/chat、/api/generate 和 /api/embed 接口，地址以 /v1 结尾时使用 OpenAI 兼容接口
+type Ollama struct{}
+
+var _ Adapter = &Ollama{}
+
+func (o *Ollama) compatible(base string) bool {
+	return strings.HasSuffix(basePath(base), "/v1")
+}
+
+// Rewrite implements Adapter.
+func (o *Ollama) Rewrite(out *http.Request, req *Request) error {
+	m := req.Model
+	if o.compatible(m.APIBase) {
+		return rewriteOpenAI(out, req)
+	}
+
+	var (
+		path string
+		body []byte
+		err  error
+	)
+	switch req.Path {
+	case "/chat/completions":
+		var creq openai.ChatCompletionRequest
+		if err := json.Unmarshal(req.Body, &creq); err != nil {
+			return err
+		}
+		path = "/api/chat"
+		body, err = json.Marshal(toOllamaChat(&creq, m.ModelName))
+	case "/completions":
+		var creq openai.CompletionRequest
+		if err := json.Unmarshal(req.Body, &creq); err != nil {
+			return err
+		}
+		path = "/api/generate"
+		body, err = json.Marshal(toOllamaGenerate(&creq, m.ModelName))
+	case "/embeddings":
+		var ereq ollamaEmbedRequest
+		if err := json.Unmarshal(req.Body, &ereq); err != nil {
+			return err
+		}
+		ereq.Model = m.ModelName
+		path = "/api/embed"
+		body, err = json.Marshal(ereq)
+	default:
+		return fmt.Errorf("ollama native api does not support %s", req.Path)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := setURL(out, m.APIBase, path); err != nil {
+		return err
+	}
+	if m.APIKey != "" {
+		out.Header.Set("Authorization", "Bearer "+m.APIKey)
+	}
+	out.Header.Set("Content-Type", "application/json")
+	setBody(out, body)
+	return nil
+}
+
+// Response implements Adapter.
+func (o *Ollama) Response(resp *http.Response, req *Request) error {
+	if o.compatible(req.Model.APIBase) {
+		return nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return convertError(resp, func(body []byte) string {
+			var e struct {
+				Error string `json:"error"`
+			}
+			json.Unmarshal(body, &e)
+			return e.Error
+		})
+	}
+
+	if req.Path == "/embeddings" {
+		var eresp ollamaEmbedResponse
+		if err := readJSON(resp, &eresp); err != nil {
+			return err
+		}
+		data := make([]openai.Embedding, 0, len(eresp.Embeddings))
+		for i, e := range eresp.Embeddings {
+			data = append(data, openai.Embedding{Object: "embedding", Embedding: e, Index: i})
+		}
+		u := usage(eresp.PromptEvalCount, 0)
+		return writeJSON(resp, openai.EmbeddingResponse{
+			Object: "list",
+			Data:   data,
+			Model:  openai.EmbeddingModel(req.Model.ModelName),
+			Usage:  *u,
+		})
+	}
+
+	chat := req.Path == "/chat/completions"
+	if req.Stream {
+		object := "text_completion"
+		if chat {
+			object = "chat.completion.chunk"
+		}
+		s := &ollamaStream{chat: chat}
+		streamBody(resp, newLineReader(resp.Body, newChunkWriter(req.Model.ModelName, object), s.line))
+		return nil
+	}
+
+	var oresp ollamaResponse
+	if err := readJSON(resp, &oresp); err != nil {
+		return err
+	}
+	id := "chatcmpl-" + uuid.NewString()
+	created := time.Now().Unix()
+	if !chat {
+		return writeJSON(resp, openai.CompletionResponse{
+			ID:      id,
+			Object:  "text_completion",
+			Created: created,
+			Model:   req.Model.ModelName,
+			Choices: []openai.CompletionChoice{{
+				Text:         oresp.Response,
+				FinishReason: string(ollamaFinishReason(oresp.DoneReason, false)),
+			}},
+			Usage: *usage(oresp.PromptEvalCount, oresp.EvalCount),
+		})
+	}
+
+	msg := openai.ChatCompletionMessage{Role: openai.ChatMessageRoleAssistant}
+	if oresp.Message != nil {
+		msg.Content = oresp.Message.Content
+		for i, tc := range oresp.Message.ToolCalls {
+			msg.ToolCalls = append(msg.ToolCalls, tc.openai(i, false))
+		}
+	}
+	return writeJSON(resp, openai.ChatCompletionResponse{
+		ID:      id,
+		Object:  "chat.completion",
+		Created: created,
+		Model:   req.Model.ModelName,
+		Choices: []openai.ChatCompletionChoice{{
+			Message:      msg,
+			FinishReason: ollamaFinishReason(oresp.DoneReason, len(msg.ToolCalls) > 0),
+		}},
+		Usage: *usage(oresp.PromptEvalCount, oresp.EvalCount),
+	})
+}
+
+// ollamaStream 转换 NDJSON 格式的流式响应
+type ollamaStream struct {
+	chat    bool
+	started bool
+	tools   int
+}
+
+func (s *ollamaStream) line(line string, w *chunkWriter) {
+	var oresp ollamaResponse
+	if err := json.Unmarshal([]byte(line), &oresp); err != nil {
+		return
+	}
+	if oresp.Done {
+		w.usage = usage(oresp.PromptEvalCount, oresp.EvalCount)
+	}
+
+	if !s.chat {
+		var reason string
+		if oresp.Done {
+			reason = string(ollamaFinishReason(oresp.DoneReason, false))
+		}
+		if oresp.Response != "" || reason != "" {
+			w.text(oresp.Response, reason)
+		}
+		return
+	}
+
+	delta := openai.ChatCompletionStreamChoiceDelta{}
+	if !s.started {
+		s.started = true
+		delta.Role = openai.ChatMessageRoleAssistant
+	}
+	if m := oresp.Message; m != nil {
+		delta.Content = m.Content
+		for _, tc := range m.ToolCalls {
+			delta.ToolCalls = append(delta.ToolCalls, tc.openai(s.tools, true))
+			s.tools++
+		}
+	}
+	var reason openai.FinishReason
+	if oresp.Done {
+		reason = ollamaFinishReason(oresp.DoneReason, s.tools > 0)
+	}
+	w.chat(delta, reason)
+}
+
+type ollamaChatRequest struct {
+	Model    string          `json:"model"`
+	Messages []ollamaMessage `json:"messages"`
+	Tools    []openai.Tool   `json:"tools,omitempty"`
+	Stream   bool            `json:"stream"`
+	Options  ollamaOptions   `json:"options"`
+}
+
+type ollamaGenerateRequest struct {
+	Model   string        `json:"model"`
+	Prompt  string        `json:"prompt"`
+	Suffix  string        `json:"suffix,omitempty"`
+	Stream  bool          `json:"stream"`
+	Options ollamaOptions `json:"options"`
+}
+
+// ollamaEmbedRequest input 可以是字符串或字符串数组
+type ollamaEmbedRequest struct {
+	Model string `json:"model"`
+	Input any    `json:"input"`
+}
+
+type ollamaEmbedResponse struct {
+	Embeddings      [][]float32 `json:"embeddings"`
+	PromptEvalCount int         `json:"prompt_eval_count"`
+}
+
+type ollamaMessage struct {
+	Role      string           `json:"role"`
+	Content   string           `json:"content"`
+	Images    []string         `json:"images,omitempty"`
+	ToolCalls []ollamaToolCall `json:"tool_calls,omitempty"`
+}
+
+type ollamaToolCall struct {
+	Function struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	} `json:"function"`
+}
+
+func (tc ollamaToolCall) openai(index int, stream bool) openai.ToolCall {
+	args := string(tc.Function.Arguments)
+	if args == "" || args == "null" {
+		args = "{}"
+	}
+	out := openai.ToolCall{
+		ID:       "call_" + strings.ReplaceAll(uuid.NewString(), "-", ""),
+		Type:     openai.ToolTypeFunction,
+		Function: openai.FunctionCall{Name: tc.Function.Name, Arguments: args},
+	}
+	if stream {
+		out.Index = &index
+	}
+	return out
+}
+
+type ollamaOptions struct {
+	Temperature float32  `json:"temperature,omitempty"`
+	TopP        float32  `json:"top_p,omitempty"`
+	NumPredict  int      `json:"num_predict,omitempty"`
+	Stop        []string `json:"stop,omitempty"`
+}
+
+type ollamaResponse struct {
+	Model           string         `json:"model"`
+	Message         *ollamaMessage `json:"message"`
+	Response        string         `json:"response"`
+	Done            bool           `json:"done"`
+	DoneReason      string         `json:"done_reason"`
+	PromptEvalCount int            `json:"prompt_eval_count"`
+	EvalCount       int            `json:"eval_count"`
+}
+
+// toOllamaChat 将 Chat Completions 请求转换为 /api/chat 请求
+func toOllamaChat(req *openai.ChatCompletionRequest, model string) *ollamaChatRequest {
+	out := &ollamaChatRequest{
+		Model:    model,
+		Messages: []ollamaMessage{},
+		Tools:    req.Tools,
+		Stream:   req.Stream,
+		Options: ollamaOptions{
+			Temperature: req.Temperature,
+			TopP:        req.TopP,
+			NumPredict:  req.MaxTokens,
+			Stop:        req.Stop,
+		},
+	}
+	if req.MaxCompletionTokens > 0 {
+		out.Options.NumPredict = req.MaxCompletionTokens
+	}
+	for _, m := range req.Messages {
+		msg := ollamaMessage{Role: m.Role, Content: m.Content}
+		for _, p := range m.MultiContent {
+			switch p.Type {
+			case openai.ChatMessagePartTypeText:
+				msg.Content += p.Text
+			case openai.ChatMessagePartTypeImageURL:
+				// 只支持 base64 编码的图片
+				if p.ImageURL == nil {
+					continue
+				}
+				if _, data, ok := strings.Cut(p.ImageURL.URL, ";base64,"); ok {
+					msg.Images = append(msg.Images, data)
+				}
+			}
+		}
+		for _, tc := range m.ToolCalls {
+			var call ollamaToolCall
+			call.Function.Name = tc.Function.Name
+			call.Function.Arguments = json.RawMessage(tc.Function.Arguments)
+			if !json.Valid(call.Function.Arguments) {
+				call.Function.Arguments = json.RawMessage("{}")
+			}
+			msg.ToolCalls = append(msg.ToolCalls, call)
+		}
+		out.Messages = append(out.Messages, msg)
+	}
+	return out
+}
+
+// toOllamaGenerate 将 Completions 请求转换为 /api/generate 请求
+func toOllamaGenerate(req *openai.CompletionRequest, model string) *ollamaGenerateRequest {
+	out := &ollamaGenerateRequest{
+		Model:  model,
+		Suffix: req.Suffix,
+		Stream: req.Stream,
+		Options: ollamaOptions{
+			Temperature: req.Temperature,
+			TopP:        req.TopP,
+			NumPredict:  req.MaxTokens,
+			Stop:        req.Stop,
+		},
+	}
+	switch p := req.Prompt.(type) {
+	case string:
+		out.Prompt = p
+	case []any:
+		if len(p) > 0 {
+			out.Prompt, _ = p[0].(string)
+		}
+	}
+	return out
+}
+
+func ollamaFinishReason(reason string, tools bool) openai.FinishReason {
+	switch {
+	case reason == "length":
+		return openai.FinishReasonLength
+	case tools:
+		return openai.FinishReasonToolCalls
+	default:
+		return openai.FinishReasonStop
+	}
+}
@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// OpenAI 兼容 OpenAI 接口的供应商
+type OpenAI struct{}
+
+var _ Adapter = &OpenAI{}
+
+// Rewrite implements Adapter.
+func (o *OpenAI) Rewrite(out *http.Request, req *Request) error {
+	return rewriteOpenAI(out, req)
+}
+
+// Response implements Adapter.
+func (o *OpenAI) Response(*http.Response, *Request) error {
+	return nil
+}
+
+func rewriteOpenAI(out *http.Request, req *Request, drop ...string) error {
+	body, err := setModel(req.Body, req.Model.ModelName, drop...)
+	if err != nil {
+		return err
+	}
+	if body, err = includeUsage(req, body); err != nil {
+		return err
+	}
+	if err := setURL(out, req.Model.APIBase, req.Path); err != nil {
+		return err
+	}
+	if req.Model.APIKey != "" {
+		out.Header.Set("Authorization", "Bearer "+req.Model.APIKey)
+	}
+	setBody(out, body)
+	return nil
+}
+
+// ZhiPu 智谱不支持 metadata 字段
+type ZhiPu struct {
+	OpenAI
+}
+
+// Rewrite implements Adapter.
+func (z *ZhiPu) Rewrite(out *http.Request, req *Request) error {
+	return rewriteOpenAI(out, req, "metadata")
+}
+
+// azureVersion Azure OpenAI 默认的 api-version
+const azureVersion = "2024-10-21"
+
+// Azure 使用部署路径、api-key 请求头和 api-version 查询参数
+type Azure struct {
+	OpenAI
+}
+
+// Rewrite implements Adapter.
+func (a *Azure) Rewrite(out *http.Request, req *Request) error {
+	m := req.Model
+	body, err := setModel(req.Body, m.ModelName)
+	if err != nil {
+		return err
+	}
+	if body, err = includeUsage(req, body); err != nil {
+		return err
+	}
+	base := strings.TrimSuffix(m.APIBase, "/")
+	if !strings.Contains(base, "/openai/deployments/") {
+		base += "/openai/deployments/" + url.PathEscape(m.ModelName)
+	}
+	if err := setURL(out, base, req.Path); err != nil {
+		return err
+	}
+
+	version := m.APIVersion
+	if version == "" {
+		version = azureVersion
+	}
+	q := out.URL.Query()
+	q.Set("api-version", version)
+	out.URL.RawQuery = q.Encode()
+	out.Header.Set("Api-Key", m.APIKey)
+	setBody(out, body)
+	return nil
+}
@@ -0,0 +1,183 @@
+package adapter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rokku-c/go-openai"
+)
+
+// lineReader 逐行读取上游的流式响应，转换为 OpenAI 的 SSE 响应
+type lineReader struct {
+	src    io.ReadCloser
+	reader *bufio.Reader
+	buf    bytes.Buffer
+	done   bool
+	line   func(line string, w *chunkWriter)
+	w      *chunkWriter
+}
+
+var _ io.ReadCloser = &lineReader{}
+
+func newLineReader(src io.ReadCloser, w *chunkWriter, line func(line string, w *chunkWriter)) *lineReader {
+	l := &lineReader{
+		src:    src,
+		reader: bufio.NewReader(src),
+		line:   line,
+		w:      w,
+	}
+	w.buf = &l.buf
+	return l
+}
+
+// Read implements io.ReadCloser.
+func (l *lineReader) Read(p []byte) (int, error) {
+	for l.buf.Len() == 0 {
+		if l.done {
+			return 0, io.EOF
+		}
+		line, err := l.reader.ReadString('\n')
+		if line = strings.TrimSpace(line); line != "" {
+			l.line(line, l.w)
+		}
+		if errors.Is(err, io.EOF) {
+			l.done = true
+			l.w.finish()
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	return l.buf.Read(p)
+}
+
+// Close implements io.ReadCloser.
+func (l *lineReader) Close() error {
+	return l.src.Close()
+}
+
+// chunkWriter 生成 OpenAI 格式的流式响应块
+type chunkWriter struct {
+	buf     *bytes.Buffer
+	id      string
+	model   string
+	created int64
+	object  string
+	usage   *openai.Usage
+	done    bool
+}
+
+func newChunkWriter(model, object string) *chunkWriter {
+	return &chunkWriter{
+		id:      "chatcmpl-" + uuid.NewString(),
+		model:   model,
+		created: time.Now().Unix(),
+		object:  object,
+	}
+}
+
+// chat 写入对话增量
+func (w *chunkWriter) chat(delta openai.ChatCompletionStreamChoiceDelta, reason openai.FinishReason) {
+	w.write(openai.ChatCompletionStreamResponse{
+		ID:      w.id,
+		Object:  w.object,
+		Created: w.created,
+		Model:   w.model,
+		Choices: []openai.ChatCompletionStreamChoice{{Delta: delta, FinishReason: reason}},
+	})
+}
+
+// text 写入补全增量
+func (w *chunkWriter) text(text string, reason string) {
+	w.write(openai.CompletionResponse{
+		ID:      w.id,
+		Object:  w.object,
+		Created: w.created,
+		Model:   w.model,
+		Choices: []openai.CompletionChoice{{Text: text, FinishReason: reason}},
+	})
+}
+
+// finish 写入用量和结束标记
+func (w *chunkWriter) finish() {
+	if w.done {
+		return
+	}
+	w.done = true
+	if w.usage != nil {
+		w.write(map[string]any{
+			"id":      w.id,
+			"object":  w.object,
+			"created": w.created,
+			"model":   w.model,
+			"choices": []any{},
+			"usage":   w.usage,
+		})
+	}
+	w.buf.WriteString("data: [DONE]\n\n")
+}
+
+func (w *chunkWriter) write(v any) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	w.buf.WriteString("data: ")
+	w.buf.Write(b)
+	w.buf.WriteString("\n\n")
+}
+
+// readJSON 读取完整的上游响应
+func readJSON(resp *http.Response, v any) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// writeJSON 将转换后的响应写回
+func writeJSON(resp *http.Response, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	replaceBody(resp, body)
+	resp.Header.Set("Content-Type", "application/json")
+	return nil
+}
+
+// convertError 将上游的错误响应转换为 OpenAI 格式，message 从原始响应体中提取错误信息
+func convertError(resp *http.Response, message func(body []byte) string) error {
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	msg := message(body)
+	if msg == "" {
+		msg = string(body)
+	}
+	return writeJSON(resp, openai.ErrorResponse{
+		Error: &openai.APIError{
+			Type:    "upstream_error",
+			Message: msg,
+		},
+	})
+}
+
+// usage 根据输入输出 token 数生成用量
+func usage(prompt, completion int) *openai.Usage {
+	return &openai.Usage{
+		PromptTokens:     prompt,
+		CompletionTokens: completion,
+		TotalTokens:      prompt + completion,
+	}
+}
@@ -0,0 +1,84 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// auditSkipHeaders 不归档的请求头，避免保存凭据
+var auditSkipHeaders = []string{"Authorization", "Proxy-Authorization", "X-Api-Key", "Cookie", "Set-Cookie"}
+
+// auditBuffer 缓存需要归档的响应原文，超过上限的部分丢弃
+type auditBuffer struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func newAuditBuffer(limit int) *auditBuffer {
+	return &auditBuffer{limit: limit}
+}
+
+// Write 为 nil 时不缓存
+func (b *auditBuffer) Write(p []byte) {
+	if b == nil || b.truncated {
+		return
+	}
+	if n := b.limit - b.buf.Len(); len(p) > n {
+		p, b.truncated = p[:n], true
+	}
+	b.buf.Write(p)
+}
+
+// dumpHTTP 按请求头、空行、请求体的格式生成归档原文
+func dumpHTTP(header http.Header, body []byte, limit int, truncated bool) []byte {
+	var b bytes.Buffer
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		if !slices.ContainsFunc(auditSkipHeaders, func(s string) bool { return strings.EqualFold(s, k) }) {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s: %s\n", k, strings.Join(header[k], ","))
+	}
+	b.WriteString("\n")
+	if len(body) > limit {
+		body, truncated = body[:limit], true
+	}
+	b.Write(body)
+	if truncated {
+		b.WriteString("\n...(truncated)")
+	}
+	return b.Bytes()
+}
+
+// archive 将请求和响应原文写入审计归档
+func (r *Recorder) archive(rc *domain.RecordParam) {
+	if r.audit == nil {
+		return
+	}
+	err := r.usecase.Archive(context.Background(), &domain.AuditRecord{
+		RequestID:  rc.RequestID,
+		TaskID:     rc.TaskID,
+		UserID:     rc.UserID,
+		ModelID:    rc.ModelID,
+		ModelName:  r.ctx.Model.ModelName,
+		ModelType:  rc.ModelType,
+		Path:       r.ctx.Path,
+		Prompt:     rc.Prompt,
+		Completion: rc.Completion,
+		Request:    dumpHTTP(r.ctx.Header, r.ctx.Body, r.audit.limit, false),
+		Response:   dumpHTTP(r.ctx.RespHeader, r.audit.buf.Bytes(), r.audit.limit, r.audit.truncated),
+	})
+	if err != nil {
+		r.logger.With("task_id", rc.TaskID).WarnContext(r.ctx.ctx, "archive request failed", "error", err)
+	}
+}
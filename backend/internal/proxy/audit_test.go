@@ -0,0 +1,39 @@
+package proxy
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestDumpHTTP(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Bearer sk-secret")
+	header.Set("Content-Type", "application/json")
+	header.Set("X-Request-Id", "r1")
+
+	s := string(dumpHTTP(header, []byte(`{"model":"m"}`), 1024, false))
+	if strings.Contains(s, "sk-secret") {
+		t.Errorf("credentials should not be archived:\n%s", s)
+	}
+	if want := "Content-Type: application/json\nX-Request-Id: r1\n\n{\"model\":\"m\"}"; s != want {
+		t.Errorf("unexpected dump:\n%s", s)
+	}
+
+	if s := string(dumpHTTP(nil, []byte("0123456789"), 4, false)); s != "\n0123\n...(truncated)" {
+		t.Errorf("body should be truncated: %q", s)
+	}
+}
+
+func TestAuditBuffer(t *testing.T) {
+	b := newAuditBuffer(5)
+	b.Write([]byte("abc"))
+	b.Write([]byte("defg"))
+	b.Write([]byte("h"))
+	if b.buf.String() != "abcde" || !b.truncated {
+		t.Errorf("unexpected buffer %q truncated %v", b.buf.String(), b.truncated)
+	}
+
+	var nilBuf *auditBuffer
+	nilBuf.Write([]byte("x"))
+}
@@ -0,0 +1,273 @@
+package proxy
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/rokku-c/go-openai"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// volatileFields 不影响生成结果的请求字段，计算缓存键时忽略
+var volatileFields = []string{"model", "stream", "stream_options", "metadata", "user"}
+
+// cacheKey 归一化请求体后计算缓存键
+// 请求体按 JSON 重新编码，字段顺序和空白不影响结果
+func cacheKey(m *domain.Model, body []byte) (string, error) {
+	req, err := normalizeRequest(body)
+	if err != nil {
+		return "", err
+	}
+	return hashRequest(m, req)
+}
+
+// cacheContextKey 计算除相似度查找文本外的请求上下文哈希
+// 包括系统提示词、历史消息、补全的 suffix 和采样参数，相似度查找只在上下文相同的缓存中进行
+func cacheContextKey(m *domain.Model, body []byte) (string, error) {
+	req, err := normalizeRequest(body)
+	if err != nil {
+		return "", err
+	}
+	switch m.ModelType {
+	case consts.ModelTypeCoder:
+		delete(req, "prompt")
+	case consts.ModelTypeLLM:
+		// 与 cachePrompt 对应，只去掉最后一条用户消息的内容，保留其位置
+		msgs, _ := req["messages"].([]any)
+		for i := len(msgs) - 1; i >= 0; i-- {
+			if msg, ok := msgs[i].(map[string]any); ok && msg["role"] == openai.ChatMessageRoleUser {
+				delete(msg, "content")
+				break
+			}
+		}
+	}
+	return hashRequest(m, req)
+}
+
+// normalizeRequest 解析请求体并删除不影响生成结果的字段
+func normalizeRequest(body []byte) (map[string]any, error) {
+	var req map[string]any
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	for _, f := range volatileFields {
+		delete(req, f)
+	}
+	return req, nil
+}
+
+func hashRequest(m *domain.Model, req map[string]any) (string, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write([]byte(string(m.ModelType) + "\n" + m.ModelName + "\n"))
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// cachePrompt 提取用于相似度查找的请求文本，补全取 prompt，对话取最后一条用户消息
+func cachePrompt(modelType consts.ModelType, body []byte) string {
+	var req struct {
+		Prompt   any `json:"prompt"`
+		Messages []struct {
+			Role    string `json:"role"`
+			Content any    `json:"content"`
+		} `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		return ""
+	}
+	switch modelType {
+	case consts.ModelTypeCoder:
+		s, _ := req.Prompt.(string)
+		return s
+	case consts.ModelTypeLLM:
+		for i := len(req.Messages) - 1; i >= 0; i-- {
+			if req.Messages[i].Role != openai.ChatMessageRoleUser {
+				continue
+			}
+			s, _ := req.Messages[i].Content.(string)
+			return s
+		}
+	}
+	return ""
+}
+
+// cacheable 是否可以缓存该请求的响应
+// 只缓存 OpenAI 协议且单个候选结果的对话和补全请求，由模型的高级参数开启
+func (l *LLMProxy) cacheable(pctx *ProxyCtx) bool {
+	if pctx.Model == nil || !pctx.Model.Param.ResponseCache || pctx.anthropic() || pctx.responses() || len(pctx.body) == 0 {
+		return false
+	}
+	if pctx.Model.ModelType != consts.ModelTypeLLM && pctx.Model.ModelType != consts.ModelTypeCoder {
+		return false
+	}
+	var req struct {
+		N int `json:"n"`
+	}
+	if err := json.Unmarshal(pctx.body, &req); err != nil {
+		return false
+	}
+	return req.N <= 1
+}
+
+// lookupCache 查找请求的缓存响应，命中时由 retryTransport 直接回放，不再请求上游
+// 缓存键使用改写后的请求体，改写规则变化后不会命中旧的响应
+func (l *LLMProxy) lookupCache(pctx *ProxyCtx) {
+	key, err := cacheKey(pctx.Model, pctx.body)
+	if err != nil {
+		return
+	}
+	contextKey, err := cacheContextKey(pctx.Model, pctx.body)
+	if err != nil {
+		return
+	}
+	ttl := l.cfg.LLMProxy.Cache.TTL
+	if pctx.Model.Param.ResponseCacheTTL > 0 {
+		ttl = pctx.Model.Param.ResponseCacheTTL
+	}
+	req := &domain.CacheReq{
+		Key:        key,
+		ContextKey: contextKey,
+		ModelName:  pctx.Model.ModelName,
+		ModelType:  pctx.Model.ModelType,
+		Prompt:     cachePrompt(pctx.Model.ModelType, pctx.body),
+		TTL:        time.Duration(ttl) * time.Second,
+	}
+	pctx.cache = req
+
+	c, err := l.usecase.GetCache(pctx.ctx, req)
+	if err != nil {
+		// 缓存异常时请求上游，不影响正常使用
+		l.logger.With("key", key).With("error", err).WarnContext(pctx.ctx, "get response cache failed")
+		return
+	}
+	if c != nil {
+		l.logger.With("key", key).With("cache_id", c.ID).DebugContext(pctx.ctx, "response cache hit")
+	}
+	pctx.cached = c
+}
+
+// replayCache 将缓存的响应构造为上游响应，流式请求回放为 SSE
+func replayCache(req *http.Request, pctx *ProxyCtx) (*http.Response, error) {
+	body, err := replayBody(pctx)
+	if err != nil {
+		return nil, err
+	}
+	header := make(http.Header)
+	length := int64(len(body))
+	if pctx.Stream {
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-cache")
+		length = -1
+	} else {
+		header.Set("Content-Type", "application/json")
+		header.Set("Content-Length", strconv.Itoa(len(body)))
+	}
+	header.Set("X-Cache", "HIT")
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: length,
+		Request:       req,
+	}, nil
+}
+
+// replayBody 按 OpenAI 协议构造缓存响应的响应体
+func replayBody(pctx *ProxyCtx) ([]byte, error) {
+	c := pctx.cached
+	created := time.Now().Unix()
+	usage := openai.Usage{
+		PromptTokens:     int(c.InputTokens),
+		CompletionTokens: int(c.OutputTokens),
+		TotalTokens:      int(c.InputTokens + c.OutputTokens),
+	}
+
+	switch pctx.Model.ModelType {
+	case consts.ModelTypeLLM:
+		id := "chatcmpl-" + pctx.RequestID
+		if !pctx.Stream {
+			return json.Marshal(openai.ChatCompletionResponse{
+				ID:      id,
+				Object:  "chat.completion",
+				Created: created,
+				Model:   pctx.Model.ModelName,
+				Choices: []openai.ChatCompletionChoice{{
+					Message: openai.ChatCompletionMessage{
+						Role:    openai.ChatMessageRoleAssistant,
+						Content: c.Completion,
+					},
+					FinishReason: openai.FinishReason(c.FinishReason),
+				}},
+				Usage: usage,
+			})
+		}
+		chunk := func(delta openai.ChatCompletionStreamChoiceDelta, reason string, u *openai.Usage) openai.ChatCompletionStreamResponse {
+			return openai.ChatCompletionStreamResponse{
+				ID:      id,
+				Object:  "chat.completion.chunk",
+				Created: created,
+				Model:   pctx.Model.ModelName,
+				Choices: []openai.ChatCompletionStreamChoice{{
+					Delta:        delta,
+					FinishReason: openai.FinishReason(reason),
+				}},
+				Usage: u,
+			}
+		}
+		return sse(
+			chunk(openai.ChatCompletionStreamChoiceDelta{Role: openai.ChatMessageRoleAssistant, Content: c.Completion}, "", nil),
+			chunk(openai.ChatCompletionStreamChoiceDelta{}, c.FinishReason, &usage),
+		)
+
+	case consts.ModelTypeCoder:
+		resp := openai.CompletionResponse{
+			ID:      "cmpl-" + pctx.RequestID,
+			Object:  "text_completion",
+			Created: created,
+			Model:   pctx.Model.ModelName,
+			Choices: []openai.CompletionChoice{{
+				Text:         c.Completion,
+				FinishReason: c.FinishReason,
+			}},
+			Usage: usage,
+		}
+		if !pctx.Stream {
+			return json.Marshal(resp)
+		}
+		return sse(resp)
+	}
+	return nil, fmt.Errorf("unsupported model type %s", pctx.Model.ModelType)
+}
+
+// sse 将数据编码为 SSE 事件，并以 [DONE] 结束
+func sse(events ...any) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, ev := range events {
+		b, err := json.Marshal(ev)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("data: ")
+		buf.Write(b)
+		buf.WriteString("\n\n")
+	}
+	buf.WriteString("data: [DONE]\n\n")
+	return buf.Bytes(), nil
+}
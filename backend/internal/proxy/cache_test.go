@@ -0,0 +1,155 @@
+package proxy
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/rokku-c/go-openai"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+func TestCacheKey(t *testing.T) {
+	coder := &domain.Model{ModelName: "qwen-coder", ModelType: consts.ModelTypeCoder}
+	key := func(m *domain.Model, body string) string {
+		k, err := cacheKey(m, []byte(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+
+	base := key(coder, `{"model":"coder","prompt":"import os","max_tokens":32,"stream":true,"metadata":{"task_id":"t1"}}`)
+	same := key(coder, `{ "max_tokens": 32, "prompt": "import os", "model": "alias", "metadata": {"task_id": "t2"} }`)
+	if base != same {
+		t.Error("key should ignore field order, model alias, stream and metadata")
+	}
+	if key(coder, `{"prompt":"import sys","max_tokens":32}`) == base {
+		t.Error("key should change with prompt")
+	}
+	if key(coder, `{"prompt":"import os","max_tokens":64}`) == base {
+		t.Error("key should change with sampling parameters")
+	}
+	other := &domain.Model{ModelName: "deepseek-coder", ModelType: consts.ModelTypeCoder}
+	if key(other, `{"prompt":"import os","max_tokens":32}`) == base {
+		t.Error("key should change with upstream model")
+	}
+	if _, err := cacheKey(coder, []byte(`not json`)); err == nil {
+		t.Error("invalid body should fail")
+	}
+}
+
+func TestCacheContextKey(t *testing.T) {
+	llm := &domain.Model{ModelName: "qwen", ModelType: consts.ModelTypeLLM}
+	coder := &domain.Model{ModelName: "qwen-coder", ModelType: consts.ModelTypeCoder}
+	key := func(m *domain.Model, body string) string {
+		k, err := cacheContextKey(m, []byte(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+	chat := func(system, history, question string, temperature float64) string {
+		b, _ := json.Marshal(map[string]any{
+			"temperature": temperature,
+			"messages": []map[string]string{
+				{"role": "system", "content": system},
+				{"role": "user", "content": history},
+				{"role": "assistant", "content": "ok"},
+				{"role": "user", "content": question},
+			},
+		})
+		return string(b)
+	}
+
+	base := key(llm, chat("you are a bot", "hi", "what is go", 0))
+	if key(llm, chat("you are a bot", "hi", "what is golang", 0)) != base {
+		t.Error("context key should ignore the last user message")
+	}
+	if key(llm, chat("you are a pirate", "hi", "what is go", 0)) == base {
+		t.Error("context key should change with system prompt")
+	}
+	if key(llm, chat("you are a bot", "hello", "what is go", 0)) == base {
+		t.Error("context key should change with history")
+	}
+	if key(llm, chat("you are a bot", "hi", "what is go", 0.8)) == base {
+		t.Error("context key should change with sampling parameters")
+	}
+
+	fim := key(coder, `{"prompt":"import os","suffix":"\nmain()","max_tokens":32}`)
+	if key(coder, `{"prompt":"import sys","suffix":"\nmain()","max_tokens":32}`) != fim {
+		t.Error("context key should ignore coder prompt")
+	}
+	if key(coder, `{"prompt":"import os","suffix":"\nrun()","max_tokens":32}`) == fim {
+		t.Error("context key should change with suffix")
+	}
+}
+
+func TestCachePrompt(t *testing.T) {
+	if p := cachePrompt(consts.ModelTypeCoder, []byte(`{"prompt":"import os"}`)); p != "import os" {
+		t.Errorf("coder prompt = %q", p)
+	}
+	body := `{"messages":[{"role":"user","content":"first"},{"role":"assistant","content":"ok"},{"role":"user","content":"second"}]}`
+	if p := cachePrompt(consts.ModelTypeLLM, []byte(body)); p != "second" {
+		t.Errorf("chat prompt = %q", p)
+	}
+}
+
+func TestReplayCache(t *testing.T) {
+	cached := &domain.ResponseCache{
+		Completion:   "import os\n",
+		FinishReason: "stop",
+		InputTokens:  10,
+		OutputTokens: 3,
+	}
+	pctx := func(mt consts.ModelType, stream bool) *ProxyCtx {
+		return &ProxyCtx{
+			RequestID: "r1",
+			Model:     &domain.Model{ModelName: "m", ModelType: mt},
+			Stream:    stream,
+			cached:    cached,
+		}
+	}
+	read := func(p *ProxyCtx) (*http.Response, string) {
+		resp, err := replayCache(&http.Request{}, p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := io.ReadAll(resp.Body)
+		return resp, string(b)
+	}
+
+	resp, body := read(pctx(consts.ModelTypeLLM, false))
+	if resp.Header.Get("Content-Type") != "application/json" {
+		t.Errorf("content type = %s", resp.Header.Get("Content-Type"))
+	}
+	var chat openai.ChatCompletionResponse
+	if err := json.Unmarshal([]byte(body), &chat); err != nil {
+		t.Fatal(err)
+	}
+	if chat.Choices[0].Message.Content != cached.Completion || chat.Usage.TotalTokens != 13 {
+		t.Errorf("unexpected chat response %s", body)
+	}
+
+	resp, body = read(pctx(consts.ModelTypeLLM, true))
+	if !strings.Contains(resp.Header.Get("Content-Type"), "stream") || resp.ContentLength != -1 {
+		t.Errorf("stream response header = %v", resp.Header)
+	}
+	if !strings.HasSuffix(body, "data: [DONE]\n\n") || strings.Count(body, "data: ") != 3 {
+		t.Errorf("unexpected sse body %s", body)
+	}
+
+	_, body = read(pctx(consts.ModelTypeCoder, true))
+	line := strings.TrimPrefix(strings.SplitN(body, "\n", 2)[0], "data: ")
+	var completion openai.CompletionResponse
+	if err := json.Unmarshal([]byte(line), &completion); err != nil {
+		t.Fatal(err)
+	}
+	if completion.Choices[0].Text != cached.Completion || completion.Usage.PromptTokens != 10 {
+		t.Errorf("unexpected completion chunk %s", line)
+	}
+}
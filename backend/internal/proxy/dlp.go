@@ -0,0 +1,232 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/dlp"
+)
+
+// dlpSkipKeys 不检测的请求字段，图片等二进制数据的熵很高，会被误判为密钥
+var dlpSkipKeys = map[string]bool{
+	"model":        true,
+	"role":         true,
+	"type":         true,
+	"tool_call_id": true,
+	"url":          true,
+	"data":         true,
+}
+
+// dlpBlockedError 请求命中了拒绝策略
+type dlpBlockedError struct {
+	rules []string
+}
+
+func (e *dlpBlockedError) Error() string {
+	return "request blocked by data loss prevention policy: " + strings.Join(e.rules, ", ")
+}
+
+// dlpAction 按规则ID、类别的顺序查找配置的处理方式，都没有配置时使用 fallback
+// 配置为 off 时返回空字符串，表示停用该规则
+func dlpAction(cfg *config.Config, id string, category consts.DLPCategory, fallback string) consts.DLPAction {
+	// viper 读取的 map 键均为小写
+	actions := cfg.LLMProxy.DLP.Actions
+	a, ok := actions[strings.ToLower(id)]
+	if !ok {
+		a, ok = actions[strings.ToLower(string(category))]
+	}
+	if !ok {
+		a = fallback
+	}
+	if a == "" {
+		a = cfg.LLMProxy.DLP.Action
+	}
+	switch action := consts.DLPAction(a); action {
+	case consts.DLPActionAlert, consts.DLPActionRedact, consts.DLPActionBlock:
+		return action
+	}
+	return ""
+}
+
+// newDLPScanner 按配置创建外发数据检测器，未开启时返回 nil
+func newDLPScanner(cfg *config.Config, logger *slog.Logger) *dlp.Scanner {
+	c := cfg.LLMProxy.DLP
+	if !c.Enabled {
+		return nil
+	}
+	rules := dlp.Builtin(func(id string, category consts.DLPCategory) consts.DLPAction {
+		return dlpAction(cfg, id, category, "")
+	})
+	for _, r := range c.Rules {
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			logger.With("rule", r.ID).With("error", err).Error("invalid dlp rule pattern")
+			continue
+		}
+		if r.Group < 0 || r.Group > pattern.NumSubexp() {
+			logger.With("rule", r.ID).Error("invalid dlp rule group")
+			continue
+		}
+		category := consts.DLPCategory(r.Category)
+		if category == "" {
+			category = consts.DLPCategorySecrets
+		}
+		action := dlpAction(cfg, r.ID, category, r.Action)
+		if action == "" {
+			continue
+		}
+		rules = append(rules, &dlp.Rule{
+			ID:       r.ID,
+			Name:     r.Name,
+			Category: category,
+			Pattern:  pattern,
+			Group:    r.Group,
+			Action:   action,
+		})
+	}
+
+	var entropy *dlp.Entropy
+	if c.Entropy.Enabled {
+		if action := dlpAction(cfg, dlp.EntropyRuleID, consts.DLPCategorySecrets, ""); action != "" {
+			entropy = &dlp.Entropy{
+				Threshold: c.Entropy.Threshold,
+				MinLength: c.Entropy.MinLength,
+				Action:    action,
+			}
+		}
+	}
+	return dlp.New(rules, entropy)
+}
+
+// scanBody 检测请求体中的字符串字段，返回全部命中结果，有需要脱敏的内容时返回改写后的请求体
+func scanBody(s *dlp.Scanner, body []byte) ([]*dlp.Finding, []byte, error) {
+	var req any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&req); err != nil {
+		return nil, nil, err
+	}
+
+	var all []*dlp.Finding
+	redacted := false
+	req = walkStrings(req, "", func(text string) string {
+		findings := s.Scan(text)
+		if len(findings) == 0 {
+			return text
+		}
+		all = append(all, findings...)
+		out := dlp.Redact(text, findings)
+		if out != text {
+			redacted = true
+		}
+		return out
+	})
+	if !redacted {
+		return all, body, nil
+	}
+	out, err := json.Marshal(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return all, out, nil
+}
+
+// walkStrings 遍历 JSON 中的字符串，用 fn 的返回值替换
+func walkStrings(v any, key string, fn func(string) string) any {
+	switch x := v.(type) {
+	case string:
+		if dlpSkipKeys[key] || strings.HasPrefix(x, "data:") {
+			return x
+		}
+		return fn(x)
+	case map[string]any:
+		for k, val := range x {
+			x[k] = walkStrings(val, k, fn)
+		}
+		return x
+	case []any:
+		for i, val := range x {
+			x[i] = walkStrings(val, key, fn)
+		}
+		return x
+	}
+	return v
+}
+
+// dlpHits 按规则汇总命中结果
+func dlpHits(findings []*dlp.Finding) []*domain.DLPHit {
+	var hits []*domain.DLPHit
+	idx := make(map[string]*domain.DLPHit)
+	for _, f := range findings {
+		if h, ok := idx[f.RuleID]; ok {
+			h.Count++
+			continue
+		}
+		h := &domain.DLPHit{
+			RuleID:   f.RuleID,
+			RuleName: f.RuleName,
+			Category: f.Category,
+			Action:   f.Action,
+			Preview:  dlp.Mask(f.Match),
+			Count:    1,
+		}
+		idx[f.RuleID] = h
+		hits = append(hits, h)
+	}
+	return hits
+}
+
+// checkDLP 在请求离开网络前检测密钥和个人信息，按策略脱敏、拒绝或只记录
+// 命中结果记录到请求所属的任务
+func (l *LLMProxy) checkDLP(pctx *ProxyCtx) error {
+	if l.dlp == nil || len(pctx.Body) == 0 {
+		return nil
+	}
+	findings, body, err := scanBody(l.dlp, pctx.Body)
+	if err != nil || len(findings) == 0 {
+		return nil
+	}
+
+	hits := dlpHits(findings)
+	var blocked []string
+	for _, h := range hits {
+		if h.Action == consts.DLPActionBlock {
+			blocked = append(blocked, h.RuleID)
+		}
+	}
+	taskID := pctx.Metadata["task_id"]
+	if taskID == "" {
+		taskID = pctx.RequestID
+	}
+	record := &domain.DLPRecord{
+		TaskID:    taskID,
+		RequestID: pctx.RequestID,
+		UserID:    pctx.UserID,
+		Hits:      hits,
+	}
+	l.logger.With(
+		"task_id", taskID,
+		"hits", len(findings),
+		"blocked", blocked,
+	).WarnContext(pctx.ctx, "dlp rules matched")
+	go func() {
+		if err := l.usecase.RecordDLP(context.Background(), record); err != nil {
+			l.logger.With("task_id", taskID).With("error", err).Warn("record dlp hits failed")
+		}
+	}()
+
+	if len(blocked) > 0 {
+		return &dlpBlockedError{rules: blocked}
+	}
+	pctx.Body = body
+	// 元数据中的 prompt、code 等同样经过脱敏，记录时不能保存原始内容
+	pctx.parseBody()
+	return nil
+}
@@ -0,0 +1,179 @@
+package proxy
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rokku-c/go-openai"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+// newCodeGuard 按配置创建生成代码检测器，未开启时返回 nil
+func newCodeGuard(cfg *config.Config) *scan.Inline {
+	c := cfg.LLMProxy.CodeGuard
+	if !c.Enabled {
+		return nil
+	}
+	rules := slices.DeleteFunc(scan.InlineRules(), func(r *scan.InlineRule) bool {
+		return slices.Contains(c.Disabled, r.ID)
+	})
+	return scan.NewInline(rules)
+}
+
+// codeFindings 检测模型回复中的代码
+func codeFindings(guard *scan.Inline, completion string) []*types.CodeFinding {
+	if guard == nil || completion == "" {
+		return nil
+	}
+	var findings []*types.CodeFinding
+	for _, item := range guard.Scan(completion) {
+		findings = append(findings, &types.CodeFinding{
+			CheckID:    item.CheckID,
+			Severity:   item.Extra.Severity,
+			Category:   item.Extra.Metadata.CategoryFeysh["zh-CN"],
+			Confidence: item.Extra.Metadata.Confidence,
+			Impact:     item.Extra.Metadata.Impact,
+			Title:      item.Extra.Metadata.NameFeysh["zh-CN"],
+			Message:    item.Extra.Metadata.MessageZh,
+			Lines:      item.Extra.Lines,
+			Start:      types.Position(item.Start),
+			End:        types.Position(item.End),
+		})
+	}
+	return findings
+}
+
+// guardWarning 生成追加到回复末尾的风险提示，同一规则只提示一次
+func guardWarning(findings []*types.CodeFinding) string {
+	if len(findings) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString("\n\n> ⚠️ MonkeyCode 安全提示：生成的代码可能存在以下风险，请确认后再使用\n")
+	seen := make(map[string]bool)
+	for _, f := range findings {
+		if seen[f.CheckID] {
+			continue
+		}
+		seen[f.CheckID] = true
+		fmt.Fprintf(&b, "> - 第 %d 行 **%s**：%s\n", f.Start.Line, f.Title, f.Message)
+	}
+	return b.String()
+}
+
+// warnReader 在 OpenAI 格式的对话流式响应结束前追加风险提示
+type warnReader struct {
+	src     io.ReadCloser
+	reader  *bufio.Reader
+	guard   *scan.Inline
+	buf     bytes.Buffer
+	content strings.Builder
+	id      string
+	model   string
+	warned  bool
+	done    bool
+}
+
+var _ io.ReadCloser = &warnReader{}
+
+func newWarnReader(src io.ReadCloser, guard *scan.Inline) *warnReader {
+	return &warnReader{
+		src:    src,
+		reader: bufio.NewReader(src),
+		guard:  guard,
+	}
+}
+
+// Read implements io.ReadCloser.
+func (w *warnReader) Read(p []byte) (int, error) {
+	for w.buf.Len() == 0 {
+		if w.done {
+			return 0, io.EOF
+		}
+		line, err := w.reader.ReadString('\n')
+		if line != "" {
+			w.processLine(line)
+		}
+		if errors.Is(err, io.EOF) {
+			w.warn()
+			w.done = true
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	return w.buf.Read(p)
+}
+
+// Close implements io.ReadCloser.
+func (w *warnReader) Close() error {
+	return w.src.Close()
+}
+
+func (w *warnReader) processLine(line string) {
+	trimmed := strings.TrimSpace(line)
+	if data, ok := strings.CutPrefix(trimmed, "data:"); ok {
+		data = strings.TrimSpace(data)
+		if data == "[DONE]" {
+			w.warn()
+		} else {
+			var chunk openai.ChatCompletionStreamResponse
+			if err := json.Unmarshal([]byte(data), &chunk); err == nil {
+				w.id, w.model = chunk.ID, chunk.Model
+				if len(chunk.Choices) > 0 {
+					w.content.WriteString(chunk.Choices[0].Delta.Content)
+				}
+			}
+		}
+	}
+	w.buf.WriteString(line)
+}
+
+// warn 检测已输出的内容，有风险时写入一个包含提示的 chunk
+func (w *warnReader) warn() {
+	if w.warned {
+		return
+	}
+	w.warned = true
+	text := guardWarning(codeFindings(w.guard, w.content.String()))
+	if text == "" {
+		return
+	}
+	b, err := json.Marshal(openai.ChatCompletionStreamResponse{
+		ID:      w.id,
+		Object:  "chat.completion.chunk",
+		Created: time.Now().Unix(),
+		Model:   w.model,
+		Choices: []openai.ChatCompletionStreamChoice{{
+			Delta: openai.ChatCompletionStreamChoiceDelta{Content: text},
+		}},
+	})
+	if err != nil {
+		return
+	}
+	w.buf.WriteString("data: ")
+	w.buf.Write(b)
+	w.buf.WriteString("\n\n")
+}
+
+// warnable 是否需要在响应末尾追加风险提示，只处理对话的流式响应，代码补全的内容会直接插入编辑器
+func (l *LLMProxy) warnable(pctx *ProxyCtx, resp *http.Response) bool {
+	if l.guard == nil || !l.cfg.LLMProxy.CodeGuard.Warn {
+		return false
+	}
+	if pctx.Model.ModelType != consts.ModelTypeLLM || (pctx.anthropic() && !pctx.translate()) {
+		return false
+	}
+	return strings.Contains(resp.Header.Get("Content-Type"), "stream")
+}
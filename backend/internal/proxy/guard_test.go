@@ -0,0 +1,47 @@
+package proxy
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+func TestWarnReader(t *testing.T) {
+	guard := scan.NewInline(scan.InlineRules())
+	stream := strings.Join([]string{
+		`data: {"id":"c1","model":"m","choices":[{"index":0,"delta":{"content":"` + "```go\\n" + `"}}]}`,
+		``,
+		`data: {"id":"c1","model":"m","choices":[{"index":0,"delta":{"content":"cfg := &tls.Config{InsecureSkipVerify: true}\\n` + "```" + `"}}]}`,
+		``,
+		`data: {"id":"c1","model":"m","choices":[{"index":0,"delta":{},"finish_reason":"stop"}]}`,
+		``,
+		`data: [DONE]`,
+		``,
+		``,
+	}, "\n")
+
+	out, err := io.ReadAll(newWarnReader(io.NopCloser(strings.NewReader(stream)), guard))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(out)
+	warn := strings.Index(s, "MonkeyCode 安全提示")
+	done := strings.Index(s, "data: [DONE]")
+	if warn < 0 || done < warn {
+		t.Fatalf("warning should be appended before [DONE]:\n%s", s)
+	}
+	if !strings.Contains(s, "禁用 TLS 证书校验") || !strings.Contains(s, `"id":"c1"`) {
+		t.Errorf("unexpected warning chunk:\n%s", s)
+	}
+	if !strings.HasPrefix(s, stream[:strings.Index(stream, "data: [DONE]")]) {
+		t.Error("original chunks should be kept")
+	}
+
+	clean := "data: {\"id\":\"c2\",\"choices\":[{\"index\":0,\"delta\":{\"content\":\"hello\"}}]}\n\ndata: [DONE]\n\n"
+	out, _ = io.ReadAll(newWarnReader(io.NopCloser(strings.NewReader(clean)), guard))
+	if string(out) != clean {
+		t.Errorf("clean stream should be unchanged:\n%s", out)
+	}
+}
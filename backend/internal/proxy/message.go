@@ -0,0 +1,230 @@
+package proxy
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/rokku-c/go-openai"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/anthropic"
+)
+
+// chatMessage Chat Completions 响应中的消息或增量，兼容推理模型的 reasoning_content 和 reasoning 字段
+type chatMessage struct {
+	Content          string            `json:"content"`
+	ReasoningContent string            `json:"reasoning_content"`
+	Reasoning        string            `json:"reasoning"`
+	ToolCalls        []openai.ToolCall `json:"tool_calls"`
+}
+
+type chatChoice struct {
+	Index        int         `json:"index"`
+	Delta        chatMessage `json:"delta"`   // 流式响应
+	Message      chatMessage `json:"message"` // 非流式响应
+	FinishReason string      `json:"finish_reason"`
+}
+
+// chatResponse Chat Completions 的响应或流式响应的一个 chunk
+type chatResponse struct {
+	ID      string       `json:"id"`
+	Choices []chatChoice `json:"choices"`
+	Usage   *chatUsage   `json:"usage"`
+}
+
+// completionResponse Completions 的响应或流式响应的一个 chunk
+type completionResponse struct {
+	openai.CompletionResponse
+	Usage *chatUsage `json:"usage"`
+}
+
+// chatUsage OpenAI 兼容的用量，命中提示词缓存的 token 数在 prompt_tokens_details.cached_tokens，
+// DeepSeek 在 prompt_cache_hit_tokens
+type chatUsage struct {
+	openai.Usage
+	PromptCacheHitTokens int `json:"prompt_cache_hit_tokens"`
+}
+
+// apply 将用量写入记录，上游返回 0 时保留已有的值
+func (u *chatUsage) apply(rc *domain.RecordParam) {
+	if u == nil {
+		return
+	}
+	if input := u.PromptTokens; input > 0 {
+		rc.InputTokens = int64(input)
+	}
+	if output := u.CompletionTokens; output > 0 {
+		rc.OutputTokens = int64(output)
+	}
+	cached := u.PromptCacheHitTokens
+	if d := u.PromptTokensDetails; d != nil && d.CachedTokens > 0 {
+		cached = d.CachedTokens
+	}
+	if cached > 0 {
+		rc.CachedTokens = int64(cached)
+	}
+}
+
+// applyAnthropicUsage Anthropic 的 input_tokens 不包含读写缓存的 token，合并后与 OpenAI 的 prompt_tokens 含义一致
+func applyAnthropicUsage(u *anthropic.Usage, rc *domain.RecordParam) {
+	if u == nil {
+		return
+	}
+	if input := u.InputTokens + u.CacheReadInputTokens + u.CacheCreationInputTokens; input > 0 {
+		rc.InputTokens = int64(input)
+	}
+	if output := u.OutputTokens; output > 0 {
+		rc.OutputTokens = int64(output)
+	}
+	if cached := u.CacheReadInputTokens; cached > 0 {
+		rc.CachedTokens = int64(cached)
+	}
+}
+
+// choices 从响应中重建每个 choice 的完整回复
+type choices struct {
+	msgs  map[int]*types.ChoiceMessage
+	tools map[[2]int]*types.ToolCall // choice 下标和工具调用下标
+}
+
+func newChoices() *choices {
+	return &choices{
+		msgs:  make(map[int]*types.ChoiceMessage),
+		tools: make(map[[2]int]*types.ToolCall),
+	}
+}
+
+func (c *choices) get(index int) *types.ChoiceMessage {
+	m, ok := c.msgs[index]
+	if !ok {
+		m = &types.ChoiceMessage{Index: index}
+		c.msgs[index] = m
+	}
+	return m
+}
+
+// tool 获取工具调用，流式响应中同一个工具调用分多次返回，按下标合并
+func (c *choices) tool(index, i int) *types.ToolCall {
+	key := [2]int{index, i}
+	t, ok := c.tools[key]
+	if !ok {
+		t = &types.ToolCall{}
+		c.tools[key] = t
+		m := c.get(index)
+		m.ToolCalls = append(m.ToolCalls, t)
+	}
+	return t
+}
+
+// addOpenAI 合并 Chat Completions 的 choice，流式响应传入 Delta，非流式响应传入 Message
+func (c *choices) addOpenAI(ch chatChoice, msg chatMessage) {
+	m := c.get(ch.Index)
+	m.Content += msg.Content
+	m.Reasoning += msg.ReasoningContent + msg.Reasoning
+	for i, tc := range msg.ToolCalls {
+		if tc.Index != nil {
+			i = *tc.Index
+		}
+		t := c.tool(ch.Index, i)
+		if tc.ID != "" {
+			t.ID = tc.ID
+		}
+		if tc.Function.Name != "" {
+			t.Name = tc.Function.Name
+		}
+		t.Arguments += tc.Function.Arguments
+	}
+	if ch.FinishReason != "" {
+		m.FinishReason = ch.FinishReason
+	}
+}
+
+// addAnthropicEvent 合并 Anthropic 的流式事件，工具调用按内容块下标合并
+func (c *choices) addAnthropicEvent(ev *anthropic.StreamEvent) {
+	m := c.get(0)
+	switch ev.Type {
+	case "content_block_start":
+		if b := ev.ContentBlock; b != nil && b.Type == anthropic.BlockToolUse {
+			t := c.tool(0, ev.Index)
+			t.ID = b.ID
+			t.Name = b.Name
+		}
+	case "content_block_delta":
+		if ev.Delta == nil {
+			return
+		}
+		switch ev.Delta.Type {
+		case "text_delta":
+			m.Content += ev.Delta.Text
+		case "thinking_delta":
+			m.Reasoning += ev.Delta.Thinking
+		case "input_json_delta":
+			c.tool(0, ev.Index).Arguments += ev.Delta.PartialJSON
+		}
+	case "message_delta":
+		if ev.Delta != nil && ev.Delta.StopReason != "" {
+			m.FinishReason = ev.Delta.StopReason
+		}
+	}
+}
+
+// addAnthropic 合并 Anthropic 的非流式响应
+func (c *choices) addAnthropic(resp *anthropic.MessagesResponse) {
+	m := c.get(0)
+	m.Content = resp.Content.Text()
+	m.FinishReason = resp.StopReason
+	for i, b := range resp.Content {
+		switch b.Type {
+		case anthropic.BlockThinking:
+			m.Reasoning += b.Thinking
+		case anthropic.BlockToolUse:
+			t := c.tool(0, i)
+			t.ID = b.ID
+			t.Name = b.Name
+			t.Arguments = string(b.Input)
+		}
+	}
+}
+
+// list 按下标返回所有回复
+func (c *choices) list() []*types.ChoiceMessage {
+	msgs := make([]*types.ChoiceMessage, 0, len(c.msgs))
+	for _, m := range c.msgs {
+		msgs = append(msgs, m)
+	}
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].Index < msgs[j].Index
+	})
+	return msgs
+}
+
+// structured 回复中是否有纯文本 Completion 无法表示的内容，没有时不单独保存
+func structured(msgs []*types.ChoiceMessage) bool {
+	if len(msgs) > 1 {
+		return true
+	}
+	for _, m := range msgs {
+		if m.Reasoning != "" || len(m.ToolCalls) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// outputText 回复中所有由模型生成的文本，用于估算输出 token 数
+func outputText(completion string, msgs []*types.ChoiceMessage) string {
+	if len(msgs) == 0 {
+		return completion
+	}
+	var b strings.Builder
+	for _, m := range msgs {
+		b.WriteString(m.Reasoning)
+		b.WriteString(m.Content)
+		for _, t := range m.ToolCalls {
+			b.WriteString(t.Name)
+			b.WriteString(t.Arguments)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,129 @@
+package proxy
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+func streamRecorder(inPath string, provider consts.ModelProvider) *Recorder {
+	return &Recorder{
+		ctx: &ProxyCtx{
+			ctx:    context.Background(),
+			inPath: inPath,
+			Model:  &domain.Model{ModelType: consts.ModelTypeLLM, Provider: provider},
+		},
+		logger:  slog.Default(),
+		choices: newChoices(),
+	}
+}
+
+func TestRecorderOpenAIStream(t *testing.T) {
+	r := streamRecorder("/v1/chat/completions", consts.ModelProviderOpenAI)
+	rc := &domain.RecordParam{ModelType: consts.ModelTypeLLM}
+	for _, line := range []string{
+		`data: {"choices":[{"index":0,"delta":{"role":"assistant","reasoning_content":"think "}}]}`,
+		`data: {"choices":[{"index":0,"delta":{"reasoning_content":"more"}},{"index":1,"delta":{"content":"second"}}]}`,
+		`data: {"choices":[{"index":0,"delta":{"content":"let me read"}}]}`,
+		`data: {"choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"read_file","arguments":""}}]}}]}`,
+		`data: {"choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"path\":"}}]}}]}`,
+		`data: {"choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"a.go\"}"}},{"index":1,"id":"call_2","function":{"name":"ls","arguments":"{}"}}]}}]}`,
+		`data: {"choices":[{"index":0,"delta":{},"finish_reason":"tool_calls"},{"index":1,"delta":{},"finish_reason":"stop"}]}`,
+		`data: {"choices":[],"usage":{"prompt_tokens":12,"completion_tokens":34}}`,
+		`data: [DONE]`,
+	} {
+		if err := r.processSSELine(context.Background(), line, rc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r.applyChoices(rc)
+
+	if rc.Completion != "let me read" || r.finish != "tool_calls" {
+		t.Errorf("unexpected completion %q finish %q", rc.Completion, r.finish)
+	}
+	if rc.InputTokens != 12 || rc.OutputTokens != 34 {
+		t.Errorf("unexpected usage %d %d", rc.InputTokens, rc.OutputTokens)
+	}
+	if len(rc.Messages) != 2 {
+		t.Fatalf("expect 2 choices, got %d", len(rc.Messages))
+	}
+	m := rc.Messages[0]
+	if m.Reasoning != "think more" || len(m.ToolCalls) != 2 {
+		t.Fatalf("unexpected message %+v", m)
+	}
+	if tc := m.ToolCalls[0]; tc.ID != "call_1" || tc.Name != "read_file" || tc.Arguments != `{"path":"a.go"}` {
+		t.Errorf("unexpected tool call %+v", tc)
+	}
+	if tc := m.ToolCalls[1]; tc.ID != "call_2" || tc.Name != "ls" || tc.Arguments != `{}` {
+		t.Errorf("unexpected tool call %+v", tc)
+	}
+	if m := rc.Messages[1]; m.Index != 1 || m.Content != "second" || m.FinishReason != "stop" {
+		t.Errorf("unexpected second choice %+v", m)
+	}
+}
+
+func TestRecorderAnthropicStream(t *testing.T) {
+	r := streamRecorder(messagesPath, consts.ModelProviderAnthropic)
+	rc := &domain.RecordParam{ModelType: consts.ModelTypeLLM}
+	for _, line := range []string{
+		`data: {"type":"message_start","message":{"usage":{"input_tokens":7,"cache_read_input_tokens":20,"cache_creation_input_tokens":3}}}`,
+		`data: {"type":"content_block_start","index":0,"content_block":{"type":"thinking","thinking":""}}`,
+		`data: {"type":"content_block_delta","index":0,"delta":{"type":"thinking_delta","thinking":"hmm"}}`,
+		`data: {"type":"content_block_start","index":1,"content_block":{"type":"text","text":""}}`,
+		`data: {"type":"content_block_delta","index":1,"delta":{"type":"text_delta","text":"ok"}}`,
+		`data: {"type":"content_block_start","index":2,"content_block":{"type":"tool_use","id":"tu_1","name":"grep","input":{}}}`,
+		`data: {"type":"content_block_delta","index":2,"delta":{"type":"input_json_delta","partial_json":"{\"q\":"}}`,
+		`data: {"type":"content_block_delta","index":2,"delta":{"type":"input_json_delta","partial_json":"\"x\"}"}}`,
+		`data: {"type":"message_delta","delta":{"stop_reason":"tool_use"},"usage":{"output_tokens":9}}`,
+	} {
+		if err := r.processSSELine(context.Background(), line, rc); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r.applyChoices(rc)
+
+	if rc.Completion != "ok" || rc.InputTokens != 30 || rc.CachedTokens != 20 || rc.OutputTokens != 9 {
+		t.Errorf("unexpected record %+v", rc)
+	}
+	if len(rc.Messages) != 1 {
+		t.Fatalf("expect 1 message, got %d", len(rc.Messages))
+	}
+	m := rc.Messages[0]
+	if m.Reasoning != "hmm" || m.FinishReason != "tool_use" || len(m.ToolCalls) != 1 {
+		t.Fatalf("unexpected message %+v", m)
+	}
+	if tc := m.ToolCalls[0]; tc.ID != "tu_1" || tc.Name != "grep" || tc.Arguments != `{"q":"x"}` {
+		t.Errorf("unexpected tool call %+v", tc)
+	}
+}
+
+func TestRecorderPlainText(t *testing.T) {
+	r := streamRecorder("/v1/chat/completions", consts.ModelProviderOpenAI)
+	rc := &domain.RecordParam{ModelType: consts.ModelTypeLLM}
+	r.processSSELine(context.Background(), `data: {"choices":[{"index":0,"delta":{"content":"hi"},"finish_reason":"stop"}]}`, rc)
+	r.applyChoices(rc)
+	// 纯文本回复不单独保存结构化内容
+	if rc.Completion != "hi" || r.finish != "stop" || rc.Messages != nil {
+		t.Errorf("unexpected record %+v", rc)
+	}
+}
+
+func TestRecorderCachedTokens(t *testing.T) {
+	for _, c := range []struct {
+		name  string
+		usage string
+	}{
+		{"openai", `{"prompt_tokens":100,"completion_tokens":5,"prompt_tokens_details":{"cached_tokens":64}}`},
+		{"deepseek", `{"prompt_tokens":100,"completion_tokens":5,"prompt_cache_hit_tokens":64,"prompt_cache_miss_tokens":36}`},
+	} {
+		r := streamRecorder("/v1/chat/completions", consts.ModelProviderOpenAI)
+		rc := &domain.RecordParam{ModelType: consts.ModelTypeLLM}
+		r.processSSELine(context.Background(), `data: {"choices":[],"usage":`+c.usage+`}`, rc)
+		if rc.InputTokens != 100 || rc.CachedTokens != 64 || rc.OutputTokens != 5 {
+			t.Errorf("%s: unexpected usage %d %d %d", c.name, rc.InputTokens, rc.CachedTokens, rc.OutputTokens)
+		}
+	}
+}
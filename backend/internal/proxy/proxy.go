@@ -4,34 +4,148 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"log/slog"
 	"net"
 	"net/http"
 	"net/http/httputil"
-	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/rokku-c/go-openai"
+
 	"github.com/chaitin/MonkeyCode/backend/config"
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/internal/middleware"
+	"github.com/chaitin/MonkeyCode/backend/internal/proxy/adapter"
+	"github.com/chaitin/MonkeyCode/backend/pkg/anthropic"
+	"github.com/chaitin/MonkeyCode/backend/pkg/dlp"
 	"github.com/chaitin/MonkeyCode/backend/pkg/logger"
-	"github.com/chaitin/MonkeyCode/backend/pkg/tee"
+	"github.com/chaitin/MonkeyCode/backend/pkg/responses"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
 )
 
 type CtxKey struct{}
 
+// maxBodySize 请求体最大缓存大小
+const maxBodySize = 10 * 1024 * 1024
+
+// messagesPath Anthropic Messages 协议的请求路径
+const messagesPath = "/v1/messages"
+
+// responsesPath OpenAI Responses 协议的请求路径，统一转换为 Chat Completions 请求上游
+const responsesPath = "/v1/responses"
+
 type ProxyCtx struct {
-	ctx        context.Context
-	Path       string
-	Model      *domain.Model
-	Header     http.Header
-	RespHeader http.Header
-	ReqTee     *tee.ReqTee
-	RequestID  string
-	UserID     string
-	Metadata   map[string]string
+	ctx           context.Context
+	Path          string
+	ModelName     string // 客户端请求的模型名称或别名
+	Model         *domain.Model
+	Header        http.Header
+	RespHeader    http.Header
+	Body          []byte // 原始请求体，重试时重新发送
+	RequestID     string
+	UserID        string
+	APIKeyID      string
+	Metadata      map[string]string
+	StartAt       time.Time
+	Latency       time.Duration // 首字节耗时
+	Tried         []string      // 已尝试的模型ID
+	Stream        bool          // 是否流式请求
+	PolicyVersion int64         // 应用的改写策略版本，0 表示未改写
+
+	inPath   string
+	chat     []byte                  // Responses 请求转换后的 Chat Completions 请求体
+	body     []byte                  // 改写规则处理后的请求体
+	upstream *adapter.Request        // 适配器改写后的上游请求
+	cache    *domain.CacheReq        // 响应缓存的查询条件，为 nil 表示不缓存
+	cached   *domain.ResponseCache   // 命中的缓存响应
+	err      error                   // rewrite 阶段的错误，由 transport 返回给 errorHandler
+	cancel   context.CancelCauseFunc // 取消上游请求，客户端断开时请求的 context 也会被取消
+	usecase  domain.ProxyUsecase
+	mu       sync.Mutex
+	released bool
+}
+
+// parseBody 从请求体中读取模型名称、是否流式和元数据
+func (p *ProxyCtx) parseBody() {
+	var req struct {
+		Model    string         `json:"model"`
+		Stream   bool           `json:"stream"`
+		Metadata map[string]any `json:"metadata"`
+	}
+	if err := json.Unmarshal(p.Body, &req); err == nil {
+		p.ModelName = req.Model
+		p.Stream = req.Stream
+		p.Metadata = stringMap(req.Metadata)
+	}
+}
+
+// Release 归还负载均衡选中的模型，多次调用只生效一次
+func (p *ProxyCtx) Release(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.released || p.Model == nil {
+		return
+	}
+	p.released = true
+	p.usecase.ReleaseModel(p.Model, p.Latency, err)
+}
+
+// anthropic 客户端是否使用 Anthropic Messages 协议
+func (p *ProxyCtx) anthropic() bool {
+	return p.inPath == messagesPath
+}
+
+// responses 客户端是否使用 OpenAI Responses 协议
+func (p *ProxyCtx) responses() bool {
+	return p.inPath == responsesPath
+}
+
+// reqBody 改写规则处理前的 OpenAI 或 Anthropic 请求体
+func (p *ProxyCtx) reqBody() []byte {
+	if p.responses() {
+		return p.chat
+	}
+	return p.Body
+}
+
+// translate 是否需要在 Anthropic 与 OpenAI 协议之间转换
+func (p *ProxyCtx) translate() bool {
+	return p.anthropic() && p.Model != nil && p.Model.Provider != consts.ModelProviderAnthropic
+}
+
+func (p *ProxyCtx) selectReq(modelType consts.ModelType) *domain.SelectModelReq {
+	return &domain.SelectModelReq{
+		ModelName:      p.ModelName,
+		ModelType:      modelType,
+		Exclude:        p.Tried,
+		AllowAnthropic: p.anthropic(),
+	}
+}
+
+// fallback 实际使用的模型是否不是客户端请求的模型
+func (p *ProxyCtx) fallback() bool {
+	return p.ModelName != "" && p.Model != nil && !p.Model.Match(p.ModelName)
+}
+
+// switchModel 归还失败的模型并切换到新的候选模型
+func (p *ProxyCtx) switchModel(m *domain.Model, err error) {
+	p.Release(err)
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.Model = m
+	p.released = false
+	p.StartAt = time.Now()
+	p.Latency = 0
+	p.Tried = append(p.Tried, m.ID)
+	// 缓存键与模型绑定，切换模型后不再写入缓存
+	p.cache = nil
 }
 
 type LLMProxy struct {
@@ -40,6 +154,8 @@ type LLMProxy struct {
 	usecase   domain.ProxyUsecase
 	transport *http.Transport
 	proxy     *httputil.ReverseProxy
+	dlp       *dlp.Scanner
+	guard     *scan.Inline
 }
 
 func NewLLMProxy(
@@ -51,6 +167,8 @@ func NewLLMProxy(
 		logger:  logger,
 		cfg:     cfg,
 		usecase: usecase,
+		dlp:     newDLPScanner(cfg, logger),
+		guard:   newCodeGuard(cfg),
 	}
 
 	l.transport = &http.Transport{
@@ -64,7 +182,7 @@ func NewLLMProxy(
 		}).DialContext,
 	}
 	l.proxy = &httputil.ReverseProxy{
-		Transport:      l.transport,
+		Transport:      &retryTransport{l: l},
 		Rewrite:        l.rewrite,
 		ModifyResponse: l.modifyResponse,
 		ErrorHandler:   l.errorHandler,
@@ -74,6 +192,16 @@ func NewLLMProxy(
 }
 
 func (l *LLMProxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if userID, _ := r.Context().Value(logger.UserIDKey{}).(string); userID != "" {
+		q, err := l.usecase.CheckQuota(r.Context(), userID)
+		if err != nil {
+			// 额度查询异常时放行，避免影响正常使用
+			l.logger.ErrorContext(r.Context(), "check quota failed", slog.String("user_id", userID), slog.Any("err", err))
+		} else if q != nil && q.Remain <= 0 {
+			writeError(w, r.URL.Path, http.StatusTooManyRequests, "insufficient_quota", "insufficient_quota", "You exceeded your current quota, please contact the administrator.")
+			return
+		}
+	}
 	l.proxy.ServeHTTP(w, r)
 }
 
@@ -85,106 +213,345 @@ func (l *LLMProxy) Close() error {
 var modelType = map[string]consts.ModelType{
 	"/v1/chat/completions": consts.ModelTypeLLM,
 	"/v1/completions":      consts.ModelTypeCoder,
+	"/v1/embeddings":       consts.ModelTypeEmbedding,
+	messagesPath:           consts.ModelTypeLLM,
+	responsesPath:          consts.ModelTypeLLM,
 }
 
 func (l *LLMProxy) rewrite(r *httputil.ProxyRequest) {
 	l.logger.DebugContext(r.In.Context(), "rewrite request", slog.String("path", r.In.URL.Path))
+	pctx := &ProxyCtx{
+		ctx:       r.In.Context(),
+		RequestID: r.In.Context().Value(logger.RequestIDKey{}).(string),
+		UserID:    r.In.Context().Value(logger.UserIDKey{}).(string),
+		Header:    r.In.Header,
+		StartAt:   time.Now(),
+		inPath:    r.In.URL.Path,
+		usecase:   l.usecase,
+	}
+	if key := middleware.GetApiKeyFromContext(r.In.Context()); key != nil {
+		pctx.APIKeyID = key.ID
+	}
+	ctx, cancel := context.WithCancelCause(r.In.Context())
+	pctx.cancel = cancel
+	r.Out = r.Out.WithContext(context.WithValue(ctx, CtxKey{}, pctx))
+
 	mt, ok := modelType[r.In.URL.Path]
 	if !ok {
 		l.logger.ErrorContext(r.In.Context(), "model type not found", slog.String("path", r.In.URL.Path))
+		pctx.err = fmt.Errorf("unsupported path %s", r.In.URL.Path)
 		return
 	}
 
-	m, err := l.usecase.SelectModelWithLoadBalancing("", mt)
-	if err != nil {
-		l.logger.ErrorContext(r.In.Context(), "select model with load balancing failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
-		return
-	}
-	ul, err := url.Parse(m.APIBase)
-	if err != nil {
-		l.logger.ErrorContext(r.In.Context(), "parse model api base failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
-		return
-	}
-
-	metadata := make(map[string]string)
-	if m.Provider == consts.ModelProviderZhiPu {
-		body, err := io.ReadAll(r.In.Body)
+	if r.In.Body != nil {
+		body, err := io.ReadAll(io.LimitReader(r.In.Body, maxBodySize+1))
 		if err != nil {
 			l.logger.ErrorContext(r.In.Context(), "read request body failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
+			pctx.err = err
 			return
 		}
-		req := make(map[string]any)
-		err = json.Unmarshal(body, &req)
-		if err != nil {
-			l.logger.ErrorContext(r.In.Context(), "unmarshal request body failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
+		if len(body) > maxBodySize {
+			pctx.err = fmt.Errorf("request body exceeds %d bytes", maxBodySize)
 			return
 		}
-		if md, ok := req["metadata"].(map[string]any); ok {
-			for k, v := range md {
-				metadata[k] = v.(string)
-			}
-		}
-		delete(req, "metadata")
-		body, err = json.Marshal(req)
-		if err != nil {
-			l.logger.ErrorContext(r.In.Context(), "marshal request body failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
+		pctx.Body = body
+		pctx.parseBody()
+		if err := l.checkDLP(pctx); err != nil {
+			pctx.err = err
 			return
 		}
-		r.In.Body = io.NopCloser(bytes.NewBuffer(body))
-		r.In.ContentLength = int64(len(body))
-		r.Out.Body = io.NopCloser(bytes.NewBuffer(body))
-		r.Out.ContentLength = int64(len(body))
-	}
-
-	path := r.In.URL.Path
-	path = strings.ReplaceAll(path, "/v1", "")
-	path = ul.Path + path
-	if r.In.ContentLength > 0 {
-		tee := tee.NewReqTeeWithMaxSize(r.In.Body, 10*1024*1024)
-		r.Out.Body = tee
-		ctx := context.WithValue(r.In.Context(), CtxKey{}, &ProxyCtx{
-			ctx:       r.In.Context(),
-			Path:      path,
-			Model:     m,
-			ReqTee:    tee,
-			RequestID: r.In.Context().Value(logger.RequestIDKey{}).(string),
-			UserID:    r.In.Context().Value(logger.UserIDKey{}).(string),
-			Header:    r.In.Header,
-			Metadata:  metadata,
-		})
-		r.Out = r.Out.WithContext(ctx)
-	}
-
-	r.Out.URL.Scheme = ul.Scheme
-	r.Out.URL.Host = ul.Host
-	r.Out.URL.Path = path
-	r.Out.Header.Set("Authorization", "Bearer "+m.APIKey)
+		if pctx.responses() {
+			chat, err := toChatBody(body)
+			if err != nil {
+				l.logger.ErrorContext(r.In.Context(), "translate responses request failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
+				pctx.err = err
+				return
+			}
+			pctx.chat = chat
+		}
+	}
+
+	m, err := l.usecase.SelectModelWithLoadBalancing(pctx.selectReq(mt))
+	if err != nil {
+		l.logger.ErrorContext(r.In.Context(), "select model with load balancing failed", slog.String("path", r.In.URL.Path), slog.Any("err", err))
+		pctx.err = err
+		return
+	}
+	pctx.Model = m
+	pctx.Tried = []string{m.ID}
+
+	if err := l.direct(r.Out, pctx); err != nil {
+		pctx.err = err
+		pctx.Release(err)
+		return
+	}
+	if l.cacheable(pctx) {
+		l.lookupCache(pctx)
+	}
 	r.SetXForwarded()
-	r.Out.Host = ul.Host
 	l.logger.With(
 		"in", r.In.URL.Path,
 		"out", r.Out.URL.Path,
-		"metadata", metadata,
+		"metadata", pctx.Metadata,
 	).DebugContext(r.In.Context(), "rewrite request")
 }
 
+// direct 将请求指向 pctx 当前选中的模型，先应用改写规则，再由模型供应商的适配器改写地址、认证和请求体
+func (l *LLMProxy) direct(out *http.Request, pctx *ProxyCtx) error {
+	pctx.body = l.transform(pctx)
+	body := pctx.body
+	path := strings.ReplaceAll(pctx.inPath, "/v1", "")
+	if len(body) > 0 && pctx.translate() {
+		var err error
+		body, err = toOpenAIBody(body)
+		if err != nil {
+			l.logger.ErrorContext(pctx.ctx, "translate anthropic request failed", slog.String("path", pctx.inPath), slog.Any("err", err))
+			return err
+		}
+		path = "/chat/completions"
+	}
+	if pctx.responses() {
+		// 请求体已在 rewrite 中转换
+		path = "/chat/completions"
+	}
+
+	req := &adapter.Request{
+		Model:        pctx.Model,
+		Path:         path,
+		Body:         body,
+		IncludeUsage: l.includeUsage(pctx.Model),
+	}
+	if err := adapter.Rewrite(out, req); err != nil {
+		l.logger.With(
+			"path", pctx.inPath,
+			"provider", pctx.Model.Provider,
+			"error", err,
+		).ErrorContext(pctx.ctx, "rewrite upstream request failed")
+		return err
+	}
+	pctx.upstream = req
+	pctx.Path = out.URL.Path
+	return nil
+}
+
+// toOpenAIBody 将 Anthropic Messages 请求体转换为 OpenAI Chat Completions 请求体
+func toOpenAIBody(body []byte) ([]byte, error) {
+	var req anthropic.MessagesRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	out, err := anthropic.ToOpenAI(&req)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+// toChatBody 将 Responses 请求体转换为 OpenAI Chat Completions 请求体
+func toChatBody(body []byte) ([]byte, error) {
+	var req responses.Request
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, err
+	}
+	out, err := responses.ToOpenAI(&req)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(out)
+}
+
+// stringMap 提取 metadata 中的字符串字段
+func stringMap(m map[string]any) map[string]string {
+	out := make(map[string]string)
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
+
+// upstreamError 判断上游是否在返回响应前失败，需要切换模型
+func upstreamError(resp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return fmt.Errorf("upstream status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// retryTransport 在上游开始返回数据前失败时，切换到下一个候选模型重试
+// 候选模型只包括请求的模型和配置的回退模型，见 SelectModelWithLoadBalancing
+type retryTransport struct {
+	l *LLMProxy
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	pctx, ok := req.Context().Value(CtxKey{}).(*ProxyCtx)
+	if !ok {
+		return t.l.transport.RoundTrip(req)
+	}
+	if pctx.err != nil {
+		return nil, pctx.err
+	}
+	if pctx.cached != nil {
+		return replayCache(req, pctx)
+	}
+
+	for {
+		resp, err := t.l.transport.RoundTrip(req)
+		cause := upstreamError(resp, err)
+		if cause == nil || req.Context().Err() != nil || len(pctx.Tried) > t.l.cfg.LLMProxy.MaxRetries {
+			return resp, err
+		}
+
+		next, serr := t.l.usecase.SelectModelWithLoadBalancing(pctx.selectReq(pctx.Model.ModelType))
+		if serr != nil {
+			// 所有候选模型都已失败，返回最后一次的结果
+			return resp, err
+		}
+		t.l.logger.With(
+			"from", pctx.Model.ID,
+			"to", next.ID,
+			"from_model", pctx.Model.ModelName,
+			"to_model", next.ModelName,
+			"error", cause,
+		).WarnContext(req.Context(), "upstream failed, retry with next model")
+
+		if resp != nil {
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		pctx.switchModel(next, cause)
+		req = req.Clone(req.Context())
+		if err := t.l.direct(req, pctx); err != nil {
+			pctx.Release(err)
+			return nil, err
+		}
+	}
+}
+
 func (l *LLMProxy) modifyResponse(resp *http.Response) error {
+	// 上游的限流信息属于平台密钥，不透传给客户端
+	for k := range resp.Header {
+		if strings.HasPrefix(strings.ToLower(k), "x-ratelimit-") {
+			resp.Header.Del(k)
+		}
+	}
+
+	ctx := resp.Request.Context()
+	pctx, ok := ctx.Value(CtxKey{}).(*ProxyCtx)
+	if ok {
+		pctx.Latency = time.Since(pctx.StartAt)
+		// 回退到其他模型时告知客户端实际使用的模型
+		if pctx.fallback() {
+			resp.Header.Set("X-Model-Fallback", pctx.Model.ModelName)
+		}
+		if pctx.upstream != nil && pctx.cached == nil {
+			// 非 OpenAI 协议的上游响应先转换为 OpenAI 格式
+			if err := adapter.Response(resp, pctx.upstream); err != nil {
+				l.logger.With(
+					"provider", pctx.Model.Provider,
+					"error", err,
+				).ErrorContext(ctx, "convert upstream response failed")
+				return err
+			}
+		}
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		l.logger.ErrorContext(resp.Request.Context(), "modify response failed", slog.String("body", string(body)))
+		if ok && pctx.translate() {
+			if b, err := json.Marshal(anthropic.ErrorFromOpenAI(resp.StatusCode, body)); err == nil {
+				body = b
+				resp.Header.Set("Content-Type", "application/json")
+				resp.Header.Set("Content-Length", strconv.Itoa(len(body)))
+				resp.ContentLength = int64(len(body))
+			}
+		}
 		resp.Body = io.NopCloser(bytes.NewBuffer(body))
+		if ok {
+			pctx.Release(upstreamError(resp, nil))
+		}
 		return nil
 	}
 
-	ctx := resp.Request.Context()
-	if pctx, ok := ctx.Value(CtxKey{}).(*ProxyCtx); ok {
-		pctx.ctx = ctx
-		pctx.RespHeader = resp.Header
-		resp.Body = NewRecorder(l.cfg, pctx, resp.Body, l.logger, l.usecase)
+	if !ok {
+		return nil
+	}
+	if len(pctx.Body) == 0 {
+		pctx.Release(nil)
+		return nil
 	}
+	pctx.ctx = ctx
+	pctx.RespHeader = resp.Header
+	var body io.ReadCloser = NewRecorder(l.cfg, pctx, resp.Body, l.logger, l.usecase, l.guard)
+	if l.warnable(pctx, resp) {
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		body = newWarnReader(body, l.guard)
+	}
+	if pctx.translate() {
+		// 记录上游的原始响应，再转换为 Anthropic 格式返回给客户端
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		if strings.Contains(resp.Header.Get("Content-Type"), "stream") {
+			body = anthropic.NewStreamReader(body, pctx.ModelName)
+		} else {
+			body = anthropic.NewResponseReader(body)
+		}
+	} else if pctx.responses() {
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		if strings.Contains(resp.Header.Get("Content-Type"), "stream") {
+			body = responses.NewStreamReader(body, pctx.ModelName)
+		} else {
+			body = responses.NewResponseReader(body)
+		}
+	}
+	resp.Body = body
 	return nil
 }
 
 func (l *LLMProxy) errorHandler(w http.ResponseWriter, r *http.Request, err error) {
 	l.logger.ErrorContext(r.Context(), "error handler", slog.String("path", r.URL.Path), slog.Any("err", err))
+	pctx, ok := r.Context().Value(CtxKey{}).(*ProxyCtx)
+	if r.Context().Err() != nil {
+		// 客户端在上游返回前断开，不计为上游错误
+		if ok {
+			pctx.Release(nil)
+		}
+		return
+	}
+	path := r.URL.Path
+	if ok {
+		pctx.Release(err)
+		path = pctx.inPath
+	}
+	var blocked *dlpBlockedError
+	if errors.As(err, &blocked) {
+		writeError(w, path, http.StatusBadRequest, "invalid_request_error", "dlp_blocked", blocked.Error())
+		return
+	}
+	writeError(w, path, http.StatusBadGateway, "upstream_error", nil, err.Error())
+}
+
+// writeError 按客户端使用的协议返回错误
+func writeError(w http.ResponseWriter, path string, status int, typ string, code any, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if path == messagesPath {
+		json.NewEncoder(w).Encode(anthropic.NewError(anthropic.ErrorType(status), message))
+		return
+	}
+	json.NewEncoder(w).Encode(openai.ErrorResponse{
+		Error: &openai.APIError{
+			Type:    typ,
+			Code:    code,
+			Message: message,
+		},
+	})
 }
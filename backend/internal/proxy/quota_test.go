@@ -0,0 +1,111 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/logger"
+)
+
+// quotaUsecase 返回固定的用户额度
+type quotaUsecase struct {
+	streamUsecase
+	quota *domain.BillingQuota
+	err   error
+	users []string
+}
+
+func (s *quotaUsecase) CheckQuota(_ context.Context, userID string) (*domain.BillingQuota, error) {
+	s.users = append(s.users, userID)
+	return s.quota, s.err
+}
+
+func TestCheckQuota(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"id":"c1","choices":[{"index":0,"message":{"role":"assistant","content":"hi"}}]}`)
+	}))
+	t.Cleanup(upstream.Close)
+
+	tests := []struct {
+		name   string
+		quota  *domain.BillingQuota
+		err    error
+		status int
+	}{
+		{name: "unlimited", status: http.StatusOK},
+		{name: "remain", quota: &domain.BillingQuota{Total: 100, Used: 99, Remain: 1}, status: http.StatusOK},
+		{name: "exhausted", quota: &domain.BillingQuota{Total: 100, Used: 100, Remain: 0}, status: http.StatusTooManyRequests},
+		{name: "overdrawn", quota: &domain.BillingQuota{Total: 100, Used: 120, Remain: -20}, status: http.StatusTooManyRequests},
+		// 额度查询失败时放行
+		{name: "error", err: errors.New("db down"), status: http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &quotaUsecase{
+				streamUsecase: streamUsecase{
+					model: &domain.Model{
+						ID:        "m1",
+						ModelName: "gpt-4.1",
+						Provider:  consts.ModelProviderOpenAI,
+						ModelType: consts.ModelTypeLLM,
+						APIBase:   upstream.URL,
+						Param:     *domain.DefaultModelParam(),
+					},
+					records:  make(chan *domain.RecordParam, 1),
+					released: make(chan error, 1),
+				},
+				quota: tt.quota,
+				err:   tt.err,
+			}
+			l := NewLLMProxy(slog.Default(), &config.Config{}, uc)
+			t.Cleanup(func() { l.Close() })
+
+			body := `{"model":"gpt-4.1","messages":[{"role":"user","content":"hi"}]}`
+			r := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(body))
+			ctx := context.WithValue(r.Context(), logger.RequestIDKey{}, "req-1")
+			ctx = context.WithValue(ctx, logger.UserIDKey{}, "u1")
+			w := httptest.NewRecorder()
+			l.ServeHTTP(w, r.WithContext(ctx))
+
+			if w.Code != tt.status {
+				t.Fatalf("status = %d, want %d, body %s", w.Code, tt.status, w.Body.String())
+			}
+			if len(uc.users) != 1 || uc.users[0] != "u1" {
+				t.Errorf("CheckQuota users = %v, want [u1]", uc.users)
+			}
+			if tt.status == http.StatusOK {
+				waitRecord(t, &uc.streamUsecase)
+				return
+			}
+
+			var resp struct {
+				Error struct {
+					Type string `json:"type"`
+					Code string `json:"code"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+				t.Fatal(err)
+			}
+			if resp.Error.Type != "insufficient_quota" || resp.Error.Code != "insufficient_quota" {
+				t.Errorf("error = %+v, want insufficient_quota", resp.Error)
+			}
+			select {
+			case <-uc.records:
+				t.Error("rejected request should not be recorded")
+			default:
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"log/slog"
 	"net/http"
@@ -17,9 +18,15 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/config"
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/anthropic"
 	"github.com/chaitin/MonkeyCode/backend/pkg/diff"
+	"github.com/chaitin/MonkeyCode/backend/pkg/responses"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
 )
 
+// errStreamIdle 流式响应长时间没有收到数据，主动断开上游
+var errStreamIdle = errors.New("upstream stream idle timeout")
+
 type Recorder struct {
 	cfg     *config.Config
 	usecase domain.ProxyUsecase
@@ -28,6 +35,17 @@ type Recorder struct {
 	ctx     *ProxyCtx
 	logger  *slog.Logger
 	logFile *os.File
+	finish  string       // 上游返回的结束原因
+	guard   *scan.Inline // 生成代码检测器，为 nil 时不检测
+	audit   *auditBuffer // 审计归档的响应原文，为 nil 时不归档
+	choices *choices     // 从对话响应中重建的完整回复
+
+	idle    *time.Timer   // 流式响应的空闲计时器，超时后取消上游请求
+	timeout time.Duration // 流式响应的空闲超时
+	eof     bool          // 是否完整读取了上游响应
+	readErr error         // 读取上游响应的错误
+	status  consts.TaskStatus
+	cause   error // 上游错误，用于熔断统计
 }
 
 var _ io.ReadCloser = &Recorder{}
@@ -38,6 +56,7 @@ func NewRecorder(
 	src io.ReadCloser,
 	logger *slog.Logger,
 	usecase domain.ProxyUsecase,
+	guard *scan.Inline,
 ) *Recorder {
 	r := &Recorder{
 		cfg:     cfg,
@@ -46,6 +65,17 @@ func NewRecorder(
 		src:     src,
 		ctx:     ctx,
 		logger:  logger,
+		guard:   guard,
+		choices: newChoices(),
+	}
+	if cfg.Audit.Enabled {
+		r.audit = newAuditBuffer(cfg.Audit.MaxBodyKB * 1024)
+	}
+	if sec := cfg.LLMProxy.StreamIdleTimeout; sec > 0 && ctx.cancel != nil && strings.Contains(ctx.RespHeader.Get("Content-Type"), "stream") {
+		r.timeout = time.Duration(sec) * time.Second
+		r.idle = time.AfterFunc(r.timeout, func() {
+			ctx.cancel(errStreamIdle)
+		})
 	}
 	go r.handleShadow()
 	return r
@@ -60,11 +90,7 @@ func formatHeader(header http.Header) map[string]string {
 }
 
 func (r *Recorder) handleShadow() {
-	body, err := r.ctx.ReqTee.GetBody()
-	if err != nil {
-		r.logger.WarnContext(r.ctx.ctx, "get req tee body failed", "error", err)
-		return
-	}
+	body := r.ctx.Body
 
 	var (
 		taskID, mode, prompt, language, tool, code, sourceCode, userInput string
@@ -73,6 +99,28 @@ func (r *Recorder) handleShadow() {
 
 	switch r.ctx.Model.ModelType {
 	case consts.ModelTypeLLM:
+		if r.ctx.anthropic() {
+			var req anthropic.MessagesRequest
+			if err := json.Unmarshal(body, &req); err != nil {
+				r.logger.WarnContext(r.ctx.ctx, "unmarshal messages request failed", "error", err)
+				return
+			}
+			prompt = lastUserPrompt(req.Messages)
+			// Anthropic 客户端不会传递 task_id，每个请求记录为一个任务
+			taskID = r.ctx.RequestID
+			break
+		}
+		if r.ctx.responses() {
+			var req responses.Request
+			if err := json.Unmarshal(body, &req); err != nil {
+				r.logger.WarnContext(r.ctx.ctx, "unmarshal responses request failed", "error", err)
+				return
+			}
+			prompt = lastInputPrompt(req.Input)
+			// Responses 客户端不会传递 task_id，每个请求记录为一个任务
+			taskID = r.ctx.RequestID
+			break
+		}
 		var req openai.ChatCompletionRequest
 		if err := json.Unmarshal(body, &req); err != nil {
 			r.logger.WarnContext(r.ctx.ctx, "unmarshal chat completion request failed", "error", err)
@@ -90,6 +138,10 @@ func (r *Recorder) handleShadow() {
 			tool = md["tool"]
 			code = md["code"]
 		}
+		// 普通 OpenAI 客户端不会传递 task_id，每个请求记录为一个任务
+		if taskID == "" {
+			taskID = r.ctx.RequestID
+		}
 
 	case consts.ModelTypeCoder:
 		var req domain.CompletionRequest
@@ -114,6 +166,17 @@ func (r *Recorder) handleShadow() {
 		}
 		userInput = req.Metadata["user_input"]
 
+	case consts.ModelTypeEmbedding:
+		var req struct {
+			Input json.RawMessage `json:"input"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			r.logger.WarnContext(r.ctx.ctx, "unmarshal embedding request failed", "error", err)
+			return
+		}
+		prompt = embeddingInput(req.Input)
+		taskID = r.ctx.RequestID
+
 	default:
 		r.logger.WarnContext(r.ctx.ctx, "skip handle shadow, model type not support", "modelType", r.ctx.Model.ModelType)
 		return
@@ -126,6 +189,7 @@ func (r *Recorder) handleShadow() {
 		RequestID:       r.ctx.RequestID,
 		TaskID:          taskID,
 		UserID:          r.ctx.UserID,
+		APIKeyID:        r.ctx.APIKeyID,
 		ModelID:         r.ctx.Model.ID,
 		ModelType:       r.ctx.Model.ModelType,
 		WorkMode:        mode,
@@ -135,6 +199,8 @@ func (r *Recorder) handleShadow() {
 		SourceCode:      sourceCode,
 		CursorPosition:  cursorPosition,
 		UserInput:       userInput,
+		CacheHit:        r.ctx.cached != nil,
+		PolicyVersion:   r.ctx.PolicyVersion,
 	}
 
 	switch tool {
@@ -153,6 +219,24 @@ func (r *Recorder) handleShadow() {
 	} else {
 		r.handleJson(rc)
 	}
+	if rc.ModelType == consts.ModelTypeLLM {
+		r.applyChoices(rc)
+	}
+	rc.Status = r.status
+	if rc.Status != consts.TaskStatusCompleted {
+		r.logger.With(
+			"task_id", rc.TaskID,
+			"status", rc.Status,
+			"completion", len(rc.Completion),
+			"error", r.cause,
+		).WarnContext(r.ctx.ctx, "response ended early, record partial completion")
+	}
+	r.estimateUsage(rc)
+	r.applyCost(rc)
+	rc.Findings = codeFindings(r.guard, rc.Completion)
+	if len(rc.Findings) > 0 {
+		r.logger.With("task_id", rc.TaskID).With("findings", len(rc.Findings)).WarnContext(r.ctx.ctx, "generated code has security findings")
+	}
 
 	r.logger.
 		With("header", formatHeader(r.ctx.Header)).
@@ -162,6 +246,27 @@ func (r *Recorder) handleShadow() {
 	if err := r.usecase.Record(context.Background(), rc); err != nil {
 		r.logger.With("record", rc).WarnContext(r.ctx.ctx, "记录请求失败", "error", err)
 	}
+	r.archive(rc)
+	r.saveCache(rc)
+}
+
+// saveCache 缓存正常结束的上游响应，工具调用等无法通过文本回放的响应不缓存
+func (r *Recorder) saveCache(rc *domain.RecordParam) {
+	// 多个 choice 的响应无法通过文本回放
+	if r.ctx.cache == nil || r.ctx.cached != nil || rc.Completion == "" || len(rc.Messages) > 1 || rc.Status != consts.TaskStatusCompleted {
+		return
+	}
+	if r.finish != string(openai.FinishReasonStop) && r.finish != string(openai.FinishReasonLength) {
+		return
+	}
+	if err := r.usecase.SaveCache(context.Background(), r.ctx.cache, &domain.ResponseCache{
+		Completion:   rc.Completion,
+		FinishReason: r.finish,
+		InputTokens:  rc.InputTokens,
+		OutputTokens: rc.OutputTokens,
+	}); err != nil {
+		r.logger.With("key", r.ctx.cache.Key).WarnContext(r.ctx.ctx, "save response cache failed", "error", err)
+	}
 }
 
 func (r *Recorder) writeMeta(body []byte) {
@@ -214,27 +319,33 @@ func (r *Recorder) handleJson(rc *domain.RecordParam) {
 		if r.logFile != nil {
 			r.logFile.Write(data)
 		}
+		r.audit.Write(data)
 	}
 
 	switch rc.ModelType {
 	case consts.ModelTypeLLM:
-		var resp openai.ChatCompletionResponse
+		if r.anthropicUpstream() {
+			var resp anthropic.MessagesResponse
+			if err := json.Unmarshal([]byte(buffer.String()), &resp); err != nil {
+				r.logger.WarnContext(r.ctx.ctx, "unmarshal messages response failed", "error", err)
+				return
+			}
+			r.choices.addAnthropic(&resp)
+			applyAnthropicUsage(&resp.Usage, rc)
+			return
+		}
+		var resp chatResponse
 		if err := json.Unmarshal([]byte(buffer.String()), &resp); err != nil {
 			r.logger.WarnContext(r.ctx.ctx, "unmarshal chat completion response failed", "error", err)
 			return
 		}
-		if len(resp.Choices) > 0 {
-			rc.Completion = resp.Choices[0].Message.Content
-			if input := resp.Usage.PromptTokens; input > 0 {
-				rc.InputTokens = int64(input)
-			}
-			if output := resp.Usage.CompletionTokens; output > 0 {
-				rc.OutputTokens = int64(output)
-			}
+		for _, ch := range resp.Choices {
+			r.choices.addOpenAI(ch, ch.Message)
 		}
+		resp.Usage.apply(rc)
 
 	case consts.ModelTypeCoder:
-		var resp openai.CompletionResponse
+		var resp completionResponse
 		if err := json.Unmarshal([]byte(buffer.String()), &resp); err != nil {
 			r.logger.WarnContext(r.ctx.ctx, "unmarshal completion response failed", "error", err)
 			return
@@ -242,16 +353,23 @@ func (r *Recorder) handleJson(rc *domain.RecordParam) {
 		if rc.TaskID == "" {
 			rc.TaskID = resp.ID
 		}
-		if input := resp.Usage.PromptTokens; input > 0 {
-			rc.InputTokens = int64(input)
-		}
-		if output := resp.Usage.CompletionTokens; output > 0 {
-			rc.OutputTokens = int64(output)
-		}
+		resp.Usage.apply(rc)
 		if len(resp.Choices) > 0 {
 			rc.Completion = resp.Choices[0].Text
+			r.finish = resp.Choices[0].FinishReason
 			rc.CodeLines = int64(strings.Count(resp.Choices[0].Text, "\n"))
 		}
+
+	case consts.ModelTypeEmbedding:
+		// 只解析用量，不解析向量
+		var resp struct {
+			Usage openai.Usage `json:"usage"`
+		}
+		if err := json.Unmarshal([]byte(buffer.String()), &resp); err != nil {
+			r.logger.WarnContext(r.ctx.ctx, "unmarshal embedding response failed", "error", err)
+			return
+		}
+		rc.InputTokens = int64(resp.Usage.PromptTokens)
 	}
 }
 
@@ -264,6 +382,7 @@ func (r *Recorder) handleStream(rc *domain.RecordParam) {
 		if r.logFile != nil {
 			r.logFile.Write(data)
 		}
+		r.audit.Write(data)
 
 		lines := strings.Split(cnt, "\n")
 		if len(lines) > 0 {
@@ -298,28 +417,22 @@ func (r *Recorder) processSSELine(ctx context.Context, line string, rc *domain.R
 
 	switch r.ctx.Model.ModelType {
 	case consts.ModelTypeLLM:
-		var resp openai.ChatCompletionStreamResponse
+		if r.anthropicUpstream() {
+			r.processAnthropicEvent(ctx, data, rc)
+			return nil
+		}
+		var resp chatResponse
 		if err := json.Unmarshal([]byte(data), &resp); err != nil {
 			r.logger.With("model_type", r.ctx.Model.ModelType).With("data", data).WarnContext(ctx, "解析SSE行失败", "error", err)
 			return nil
 		}
-		if resp.Usage != nil {
-			if input := resp.Usage.PromptTokens; input > 0 {
-				rc.InputTokens = int64(input)
-			}
-			if output := resp.Usage.CompletionTokens; output > 0 {
-				rc.OutputTokens = int64(output)
-			}
-		}
-		if len(resp.Choices) > 0 {
-			content := resp.Choices[0].Delta.Content
-			if content != "" {
-				rc.Completion += content
-			}
+		resp.Usage.apply(rc)
+		for _, ch := range resp.Choices {
+			r.choices.addOpenAI(ch, ch.Delta)
 		}
 
 	case consts.ModelTypeCoder:
-		var resp openai.CompletionResponse
+		var resp completionResponse
 		if err := json.Unmarshal([]byte(data), &resp); err != nil {
 			r.logger.With("model_type", r.ctx.Model.ModelType).With("data", data).WarnContext(ctx, "解析SSE行失败", "error", err)
 			return nil
@@ -328,23 +441,105 @@ func (r *Recorder) processSSELine(ctx context.Context, line string, rc *domain.R
 		if rc.TaskID == "" {
 			rc.TaskID = resp.ID
 		}
-		if input := resp.Usage.PromptTokens; input > 0 {
-			rc.InputTokens = int64(input)
-		}
-		if output := resp.Usage.CompletionTokens; output > 0 {
-			rc.OutputTokens = int64(output)
-		}
+		resp.Usage.apply(rc)
 		if len(resp.Choices) > 0 {
 			rc.Completion += resp.Choices[0].Text
 			rc.CodeLines += int64(strings.Count(resp.Choices[0].Text, "\n"))
+			if reason := resp.Choices[0].FinishReason; reason != "" {
+				r.finish = reason
+			}
 		}
 	}
 
 	return nil
 }
 
+// applyChoices 使用重建的回复填充记录，Completion 为第一个 choice 的内容
+func (r *Recorder) applyChoices(rc *domain.RecordParam) {
+	msgs := r.choices.list()
+	if len(msgs) == 0 {
+		return
+	}
+	rc.Completion = msgs[0].Content
+	r.finish = msgs[0].FinishReason
+	if structured(msgs) {
+		rc.Messages = msgs
+	}
+}
+
+// anthropicUpstream 上游是否返回 Anthropic 格式的响应
+func (r *Recorder) anthropicUpstream() bool {
+	return r.ctx.anthropic() && !r.ctx.translate()
+}
+
+// processAnthropicEvent 解析 Anthropic 的流式事件
+func (r *Recorder) processAnthropicEvent(ctx context.Context, data string, rc *domain.RecordParam) {
+	var ev anthropic.StreamEvent
+	if err := json.Unmarshal([]byte(data), &ev); err != nil {
+		r.logger.With("data", data).WarnContext(ctx, "解析SSE行失败", "error", err)
+		return
+	}
+	r.choices.addAnthropicEvent(&ev)
+	switch ev.Type {
+	case "message_start":
+		if ev.Message != nil {
+			applyAnthropicUsage(&ev.Message.Usage, rc)
+		}
+	case "message_delta":
+		applyAnthropicUsage(ev.Usage, rc)
+	}
+}
+
+// lastUserPrompt 获取最后一条用户消息的文本作为提问
+func lastUserPrompt(messages []anthropic.Message) string {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role != anthropic.RoleUser {
+			continue
+		}
+		if text := messages[i].Content.Text(); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+// lastInputPrompt 获取 Responses 请求中最后一条用户消息的文本作为提问
+func lastInputPrompt(input responses.Input) string {
+	for i := len(input) - 1; i >= 0; i-- {
+		if input[i].Role != responses.RoleUser {
+			continue
+		}
+		if text := input[i].Content.Text(); text != "" {
+			return text
+		}
+	}
+	return ""
+}
+
+// embeddingInput 拼接向量请求的文本输入，input 可以是字符串或字符串数组，token 数组不记录
+func embeddingInput(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var texts []string
+	if err := json.Unmarshal(raw, &texts); err == nil {
+		return strings.Join(texts, "\n")
+	}
+	return ""
+}
+
 // Close implements io.ReadCloser.
+// 客户端断开或上游出错时 ReverseProxy 会提前关闭响应，此时取消上游请求并记录已收到的部分回复
 func (r *Recorder) Close() error {
+	if r.idle != nil {
+		r.idle.Stop()
+	}
+	r.status, r.cause = r.endStatus()
+	r.ctx.Release(r.cause)
+	if r.ctx.cancel != nil {
+		r.ctx.cancel(context.Canceled)
+	}
 	if r.shadown != nil {
 		close(r.shadown)
 	}
@@ -358,12 +553,33 @@ func (r *Recorder) Close() error {
 func (r *Recorder) Read(p []byte) (n int, err error) {
 	n, err = r.src.Read(p)
 	if n > 0 {
+		if r.idle != nil {
+			r.idle.Reset(r.timeout)
+		}
 		data := make([]byte, n)
 		copy(data, p[:n])
 		r.shadown <- data
 	}
-	if err != nil {
-		return
+	if errors.Is(err, io.EOF) {
+		r.eof = true
+	} else if err != nil {
+		r.readErr = err
 	}
 	return
 }
+
+// endStatus 按读取上游响应的结果判断请求的结束状态
+// 客户端断开时请求的 context 被取消，读取上游的错误不计为上游错误
+func (r *Recorder) endStatus() (consts.TaskStatus, error) {
+	if cause := context.Cause(r.ctx.ctx); errors.Is(cause, errStreamIdle) {
+		return consts.TaskStatusUpstreamError, cause
+	}
+	switch {
+	case r.eof:
+		return consts.TaskStatusCompleted, nil
+	case r.readErr != nil && r.ctx.ctx.Err() == nil:
+		return consts.TaskStatusUpstreamError, r.readErr
+	default:
+		return consts.TaskStatusAborted, nil
+	}
+}
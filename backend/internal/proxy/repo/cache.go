@@ -0,0 +1,90 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/responsecache"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// GetCache implements domain.ProxyRepo.
+func (r *ProxyRepo) GetCache(ctx context.Context, key string) (*db.ResponseCache, error) {
+	return r.db.ResponseCache.Query().
+		Where(
+			responsecache.CacheKey(key),
+			responsecache.ExpiresAtGT(time.Now()),
+		).
+		Only(ctx)
+}
+
+// SimilarCache implements domain.ProxyRepo.
+// 查找同一模型且请求上下文相同时，余弦相似度不低于 similarity 的最相近的缓存
+func (r *ProxyRepo) SimilarCache(ctx context.Context, req *domain.CacheReq, similarity float64) (*db.ResponseCache, error) {
+	vec := pgvector.NewVector(req.Embedding)
+	return r.db.ResponseCache.Query().
+		Where(
+			responsecache.ModelName(req.ModelName),
+			responsecache.ModelType(req.ModelType),
+			responsecache.ContextKey(req.ContextKey),
+			responsecache.ExpiresAtGT(time.Now()),
+			responsecache.EmbeddingNotNil(),
+		).
+		Where(func(s *sql.Selector) {
+			// "<=>" 为 pgvector 的余弦距离，距离 = 1 - 相似度
+			s.Where(sql.ExprP("embedding <=> ? <= ?", vec, 1-similarity))
+		}).
+		Order(func(s *sql.Selector) {
+			s.OrderExpr(sql.ExprP("embedding <=> ?", vec))
+		}).
+		First(ctx)
+}
+
+// HitCache implements domain.ProxyRepo.
+func (r *ProxyRepo) HitCache(ctx context.Context, id uuid.UUID) error {
+	return r.db.ResponseCache.UpdateOneID(id).AddHits(1).Exec(ctx)
+}
+
+// SaveCache implements domain.ProxyRepo.
+func (r *ProxyRepo) SaveCache(ctx context.Context, req *domain.CacheReq, cache *domain.ResponseCache) error {
+	expiresAt := time.Now().Add(req.TTL)
+	create := r.db.ResponseCache.Create().
+		SetID(uuid.New()).
+		SetCacheKey(req.Key).
+		SetContextKey(req.ContextKey).
+		SetModelName(req.ModelName).
+		SetModelType(req.ModelType).
+		SetPrompt(req.Prompt).
+		SetCompletion(cache.Completion).
+		SetFinishReason(cache.FinishReason).
+		SetInputTokens(cache.InputTokens).
+		SetOutputTokens(cache.OutputTokens).
+		SetExpiresAt(expiresAt)
+	if len(req.Embedding) > 0 {
+		create.SetEmbedding(pgvector.NewVector(req.Embedding))
+	}
+	return create.
+		OnConflictColumns(responsecache.FieldCacheKey).
+		Update(func(u *db.ResponseCacheUpsert) {
+			u.SetCompletion(cache.Completion)
+			u.SetFinishReason(cache.FinishReason)
+			u.SetInputTokens(cache.InputTokens)
+			u.SetOutputTokens(cache.OutputTokens)
+			u.SetHits(0)
+			u.SetExpiresAt(expiresAt)
+			u.SetUpdatedAt(time.Now())
+		}).
+		Exec(ctx)
+}
+
+// DeleteExpiredCache implements domain.ProxyRepo.
+func (r *ProxyRepo) DeleteExpiredCache(ctx context.Context) (int, error) {
+	return r.db.ResponseCache.Delete().
+		Where(responsecache.ExpiresAtLTE(time.Now())).
+		Exec(ctx)
+}
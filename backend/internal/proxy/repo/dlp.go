@@ -0,0 +1,33 @@
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// SaveDLPHits implements domain.ProxyRepo.
+func (r *ProxyRepo) SaveDLPHits(ctx context.Context, record *domain.DLPRecord) error {
+	ctx = rule.SkipPermission(ctx)
+	userID, _ := uuid.Parse(record.UserID)
+	return r.db.DLPHit.CreateBulk(cvt.Iter(record.Hits, func(_ int, h *domain.DLPHit) *db.DLPHitCreate {
+		create := r.db.DLPHit.Create().
+			SetTaskID(record.TaskID).
+			SetRequestID(record.RequestID).
+			SetRuleID(h.RuleID).
+			SetRuleName(h.RuleName).
+			SetCategory(h.Category).
+			SetAction(h.Action).
+			SetPreview(h.Preview).
+			SetCount(h.Count)
+		if userID != uuid.Nil {
+			create.SetUserID(userID)
+		}
+		return create
+	})...).Exec(ctx)
+}
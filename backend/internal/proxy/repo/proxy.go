@@ -17,8 +17,11 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/model"
 	"github.com/chaitin/MonkeyCode/backend/db/task"
 	"github.com/chaitin/MonkeyCode/backend/db/taskrecord"
+	"github.com/chaitin/MonkeyCode/backend/db/user"
+	"github.com/chaitin/MonkeyCode/backend/db/usergroup"
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 	"github.com/chaitin/MonkeyCode/backend/pkg/diff"
 	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
 )
@@ -77,6 +80,87 @@ func (r *ProxyRepo) ValidateApiKey(ctx context.Context, key string) (*db.ApiKey,
 	return a, nil
 }
 
+func (r *ProxyRepo) RateLimitPolicy(ctx context.Context, userID string) (*domain.RateLimitPolicy, error) {
+	key := fmt.Sprintf(consts.RateLimitPolicyKeyFmt, userID)
+	if data, err := r.redis.Get(ctx, key).Result(); err == nil {
+		p := &domain.RateLimitPolicy{}
+		if err := json.Unmarshal([]byte(data), p); err == nil {
+			return p, nil
+		}
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	ctx = rule.SkipPermission(ctx)
+	s, err := r.db.Setting.Query().First(ctx)
+	if err != nil && !db.IsNotFound(err) {
+		return nil, err
+	}
+	groups, err := r.db.UserGroup.Query().
+		Where(usergroup.HasUsersWith(user.ID(uid))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &domain.RateLimitPolicy{Groups: make(map[string]domain.RateLimit)}
+	if s != nil {
+		setting := cvt.From(s.RateLimit, &domain.RateLimitSetting{})
+		p.APIKey = setting.APIKey
+		p.User = setting.User
+	}
+	for _, g := range groups {
+		if g.RateLimit != nil && (g.RateLimit.RPM > 0 || g.RateLimit.TPM > 0) {
+			p.Groups[g.ID.String()] = *cvt.From(g.RateLimit, &domain.RateLimit{})
+		}
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.Set(ctx, key, b, time.Minute).Err(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// UserGroupIDs 获取用户所在的用户组，结果缓存一分钟
+func (r *ProxyRepo) UserGroupIDs(ctx context.Context, userID string) ([]string, error) {
+	key := fmt.Sprintf(consts.UserGroupsKeyFmt, userID)
+	if data, err := r.redis.Get(ctx, key).Result(); err == nil {
+		var ids []string
+		if err := json.Unmarshal([]byte(data), &ids); err == nil {
+			return ids, nil
+		}
+	}
+
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := r.db.UserGroup.Query().
+		Where(usergroup.HasUsersWith(user.ID(uid))).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := cvt.Iter(groups, func(_ int, id uuid.UUID) string {
+		return id.String()
+	})
+
+	b, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.Set(ctx, key, b, time.Minute).Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (r *ProxyRepo) Record(ctx context.Context, record *domain.RecordParam) error {
 	ctx = rule.SkipPermission(ctx)
 	if record.TaskID == "" {
@@ -103,6 +187,7 @@ func (r *ProxyRepo) Record(ctx context.Context, record *domain.RecordParam) erro
 				SetProgramLanguage(record.ProgramLanguage).
 				SetInputTokens(record.InputTokens).
 				SetOutputTokens(record.OutputTokens).
+				SetCachedInputTokens(record.CachedTokens).
 				SetIsAccept(record.IsAccept).
 				SetModelType(record.ModelType).
 				SetWorkMode(record.WorkMode).
@@ -111,6 +196,12 @@ func (r *ProxyRepo) Record(ctx context.Context, record *domain.RecordParam) erro
 				SetSourceCode(record.SourceCode).
 				SetCursorPosition(record.CursorPosition).
 				SetUserInput(record.UserInput).
+				SetCacheHit(record.CacheHit).
+				SetPolicyVersion(record.PolicyVersion).
+				SetUsageSource(record.UsageSource).
+				SetCost(record.Cost).
+				SetCurrency(record.Currency).
+				SetStatus(record.Status).
 				Save(ctx)
 			isNew = true
 		}
@@ -128,6 +219,7 @@ func (r *ProxyRepo) Record(ctx context.Context, record *domain.RecordParam) erro
 			if t.RequestID != record.RequestID {
 				up.SetRequestID(record.RequestID)
 				up.AddInputTokens(record.InputTokens)
+				up.AddCachedInputTokens(record.CachedTokens)
 			}
 			// 更新新字段，如果提供了的话
 			if record.SourceCode != "" {
@@ -142,6 +234,26 @@ func (r *ProxyRepo) Record(ctx context.Context, record *domain.RecordParam) erro
 			if record.UserInput != "" {
 				up.SetUserInput(record.UserInput)
 			}
+			if record.CacheHit {
+				up.SetCacheHit(true)
+			}
+			if record.PolicyVersion > 0 {
+				up.SetPolicyVersion(record.PolicyVersion)
+			}
+			// 任务中任意一次请求的 token 数为估算值，整个任务标记为估算
+			if record.UsageSource != "" && (t.UsageSource == "" || record.UsageSource == consts.UsageSourceEstimated) {
+				up.SetUsageSource(record.UsageSource)
+			}
+			if record.Cost > 0 {
+				up.AddCost(record.Cost)
+			}
+			if t.Currency == "" && record.Currency != "" {
+				up.SetCurrency(record.Currency)
+			}
+			// 任务的状态以最近一次请求为准
+			if record.Status != "" {
+				up.SetStatus(record.Status)
+			}
 			if err := up.Exec(ctx); err != nil {
 				return err
 			}
@@ -163,15 +275,21 @@ func (r *ProxyRepo) Record(ctx context.Context, record *domain.RecordParam) erro
 			}
 		}
 
-		_, err = tx.TaskRecord.Create().
+		create := tx.TaskRecord.Create().
 			SetTaskID(t.ID).
 			SetRole(record.Role).
 			SetPrompt(record.Prompt).
 			SetCompletion(record.Completion).
 			SetOutputTokens(record.OutputTokens).
 			SetCodeLines(record.CodeLines).
-			SetCode(record.Code).
-			Save(ctx)
+			SetCode(record.Code)
+		if len(record.Findings) > 0 {
+			create.SetFindings(record.Findings)
+		}
+		if len(record.Messages) > 0 {
+			create.SetMessages(record.Messages)
+		}
+		_, err = create.Save(ctx)
 
 		return err
 	})
@@ -263,6 +381,11 @@ func (r *ProxyRepo) Report(ctx context.Context, model *db.Model, req *domain.Rep
 				Exec(ctx); err != nil {
 				return err
 			}
+			if err := tx.Task.UpdateOneID(rc.ID).
+				SetStatus(consts.TaskStatusAborted).
+				Exec(ctx); err != nil {
+				return err
+			}
 
 		case consts.ReportActionAccept:
 			if err := tx.Task.UpdateOneID(rc.ID).
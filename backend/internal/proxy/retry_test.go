@@ -0,0 +1,97 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/balancer"
+	"github.com/chaitin/MonkeyCode/backend/pkg/logger"
+)
+
+// retryUsecase 按顺序返回未尝试过的候选模型
+type retryUsecase struct {
+	streamUsecase
+	models []*domain.Model
+	reqs   []domain.SelectModelReq
+}
+
+func (s *retryUsecase) SelectModelWithLoadBalancing(req *domain.SelectModelReq) (*domain.Model, error) {
+	s.reqs = append(s.reqs, *req)
+	for _, m := range s.models {
+		if !slices.Contains(req.Exclude, m.ID) {
+			return m, nil
+		}
+	}
+	return nil, balancer.ErrNoAvailableNode
+}
+
+func TestRetryFallbackHeader(t *testing.T) {
+	failed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(failed.Close)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		io.WriteString(w, `{"id":"c1","choices":[{"index":0,"message":{"role":"assistant","content":"hi"}}]}`)
+	}))
+	t.Cleanup(ok.Close)
+
+	model := func(id, name, base string) *domain.Model {
+		return &domain.Model{
+			ID:        id,
+			ModelName: name,
+			Provider:  consts.ModelProviderOpenAI,
+			ModelType: consts.ModelTypeLLM,
+			APIBase:   base,
+			Param:     *domain.DefaultModelParam(),
+		}
+	}
+	cfg := &config.Config{}
+	cfg.LLMProxy.MaxRetries = 2
+	uc := &retryUsecase{
+		streamUsecase: streamUsecase{
+			records:  make(chan *domain.RecordParam, 1),
+			released: make(chan error, 2),
+		},
+		models: []*domain.Model{model("m1", "gpt-4.1", failed.URL), model("m2", "glm-4", ok.URL)},
+	}
+	l := NewLLMProxy(slog.Default(), cfg, uc)
+	t.Cleanup(func() { l.Close() })
+
+	body := `{"model":"gpt-4.1","messages":[{"role":"user","content":"hi"}]}`
+	r := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(body))
+	ctx := context.WithValue(r.Context(), logger.RequestIDKey{}, "req-1")
+	ctx = context.WithValue(ctx, logger.UserIDKey{}, "")
+	w := httptest.NewRecorder()
+	l.ServeHTTP(w, r.WithContext(ctx))
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("X-Model-Fallback"); got != "glm-4" {
+		t.Errorf("X-Model-Fallback = %q, want glm-4", got)
+	}
+	// 重试时仍按客户端请求的模型选择，由 SelectModelWithLoadBalancing 决定可回退的模型
+	if len(uc.reqs) != 2 || uc.reqs[1].ModelName != "gpt-4.1" || !slices.Equal(uc.reqs[1].Exclude, []string{"m1"}) {
+		t.Errorf("unexpected select requests %+v", uc.reqs)
+	}
+	select {
+	case rc := <-uc.records:
+		// 请求没有 metadata.task_id 时按 request_id 记录
+		if rc.TaskID != "req-1" {
+			t.Errorf("task_id = %q, want req-1", rc.TaskID)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not recorded")
+	}
+}
@@ -0,0 +1,262 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/breaker"
+	"github.com/chaitin/MonkeyCode/backend/pkg/logger"
+)
+
+const firstChunk = `data: {"id":"c1","choices":[{"index":0,"delta":{"role":"assistant","content":"Hel"}}]}` + "\n\n"
+
+type streamUsecase struct {
+	domain.ProxyUsecase
+	model    *domain.Model
+	records  chan *domain.RecordParam
+	released chan error
+}
+
+func (s *streamUsecase) SelectModelWithLoadBalancing(*domain.SelectModelReq) (*domain.Model, error) {
+	return s.model, nil
+}
+
+func (s *streamUsecase) ReleaseModel(_ *domain.Model, _ time.Duration, err error) {
+	s.released <- err
+}
+
+func (s *streamUsecase) MatchTransform(context.Context, string, string) (*domain.TransformPolicy, error) {
+	return nil, nil
+}
+
+func (s *streamUsecase) Record(_ context.Context, rc *domain.RecordParam) error {
+	s.records <- rc
+	return nil
+}
+
+// stallUpstream 返回第一段数据后交给 stall 处理，stall 返回后通知 done
+func stallUpstream(t *testing.T, stall func(w http.ResponseWriter, r *http.Request)) (*httptest.Server, chan struct{}) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(done)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, firstChunk)
+		w.(http.Flusher).Flush()
+		stall(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, done
+}
+
+func newStreamProxy(t *testing.T, upstream string, idle int) (*httptest.Server, *streamUsecase) {
+	cfg := &config.Config{}
+	cfg.LLMProxy.StreamIdleTimeout = idle
+	uc := &streamUsecase{
+		model: &domain.Model{
+			ID:        "m1",
+			ModelName: "gpt-4.1",
+			Provider:  consts.ModelProviderOpenAI,
+			ModelType: consts.ModelTypeLLM,
+			APIBase:   upstream,
+			Param:     *domain.DefaultModelParam(),
+		},
+		records:  make(chan *domain.RecordParam, 1),
+		released: make(chan error, 1),
+	}
+	l := NewLLMProxy(slog.Default(), cfg, uc)
+	front := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), logger.RequestIDKey{}, "req-1")
+		ctx = context.WithValue(ctx, logger.UserIDKey{}, "")
+		l.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	t.Cleanup(func() {
+		front.Close()
+		l.Close()
+	})
+	return front, uc
+}
+
+func streamRequest(ctx context.Context, t *testing.T, url string) *http.Response {
+	body := `{"model":"gpt-4.1","stream":true,"messages":[{"role":"user","content":"hi"}],"metadata":{"task_id":"task-1"}}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/v1/chat/completions", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp
+}
+
+func waitRecord(t *testing.T, uc *streamUsecase) *domain.RecordParam {
+	select {
+	case rc := <-uc.records:
+		return rc
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not recorded")
+		return nil
+	}
+}
+
+func waitUpstream(t *testing.T, done chan struct{}) {
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("upstream request not canceled")
+	}
+}
+
+func TestStreamClientAbort(t *testing.T) {
+	upstream, done := stallUpstream(t, func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	front, uc := newStreamProxy(t, upstream.URL, 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp := streamRequest(ctx, t, front.URL)
+	defer resp.Body.Close()
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	if err != nil || !strings.Contains(line, "Hel") {
+		t.Fatalf("unexpected first line %q: %v", line, err)
+	}
+	cancel()
+
+	waitUpstream(t, done)
+	rc := waitRecord(t, uc)
+	if rc.Status != consts.TaskStatusAborted || rc.Completion != "Hel" || rc.TaskID != "task-1" {
+		t.Fatalf("unexpected record status %q completion %q task %q", rc.Status, rc.Completion, rc.TaskID)
+	}
+	if err := <-uc.released; err != nil {
+		t.Errorf("client abort should not count as upstream error: %v", err)
+	}
+}
+
+// breakerUsecase 与 ProxyUsecase.ReleaseModel 一样按归还的错误更新熔断器
+type breakerUsecase struct {
+	*streamUsecase
+	breaker *breaker.Breaker
+}
+
+func (s *breakerUsecase) ReleaseModel(m *domain.Model, latency time.Duration, err error) {
+	if err != nil {
+		s.breaker.Failure()
+	} else {
+		s.breaker.Success()
+	}
+	s.streamUsecase.ReleaseModel(m, latency, err)
+}
+
+func TestClientAbortBeforeResponse(t *testing.T) {
+	received := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		// 读完请求体后服务端才能感知连接断开
+		io.Copy(io.Discard, r.Body)
+		close(received)
+		<-r.Context().Done()
+	}))
+	t.Cleanup(upstream.Close)
+
+	_, suc := newStreamProxy(t, upstream.URL, 0)
+	uc := &breakerUsecase{
+		streamUsecase: suc,
+		breaker:       breaker.New(breaker.Config{FailureThreshold: 1, OpenTimeout: time.Minute}, nil),
+	}
+	l := NewLLMProxy(slog.Default(), &config.Config{}, uc)
+	front := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), logger.RequestIDKey{}, "req-1")
+		ctx = context.WithValue(ctx, logger.UserIDKey{}, "")
+		l.ServeHTTP(w, r.WithContext(ctx))
+	}))
+	t.Cleanup(func() {
+		front.Close()
+		l.Close()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-received
+		cancel()
+	}()
+	body := `{"model":"gpt-4.1","messages":[{"role":"user","content":"hi"}]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, front.URL+"/v1/chat/completions", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := http.DefaultClient.Do(req); err == nil {
+		resp.Body.Close()
+		t.Fatal("expect request canceled")
+	}
+
+	select {
+	case err := <-suc.released:
+		if err != nil {
+			t.Errorf("client abort should not count as upstream error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("model not released")
+	}
+	if s := uc.breaker.State(); s != breaker.StateClosed {
+		t.Errorf("breaker state = %s, want closed", s)
+	}
+}
+
+func TestStreamUpstreamError(t *testing.T) {
+	upstream, _ := stallUpstream(t, func(w http.ResponseWriter, _ *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			return
+		}
+		conn.Close()
+	})
+	front, uc := newStreamProxy(t, upstream.URL, 0)
+
+	resp := streamRequest(context.Background(), t, front.URL)
+	io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	rc := waitRecord(t, uc)
+	if rc.Status != consts.TaskStatusUpstreamError || rc.Completion != "Hel" {
+		t.Fatalf("unexpected record status %q completion %q", rc.Status, rc.Completion)
+	}
+	if err := <-uc.released; err == nil {
+		t.Error("expect upstream error released to the model")
+	}
+}
+
+func TestStreamIdleTimeout(t *testing.T) {
+	upstream, done := stallUpstream(t, func(_ http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	})
+	front, uc := newStreamProxy(t, upstream.URL, 1)
+
+	start := time.Now()
+	resp := streamRequest(context.Background(), t, front.URL)
+	io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if d := time.Since(start); d > 4*time.Second {
+		t.Errorf("idle stream not closed in time: %s", d)
+	}
+
+	waitUpstream(t, done)
+	rc := waitRecord(t, uc)
+	if rc.Status != consts.TaskStatusUpstreamError || rc.Completion != "Hel" {
+		t.Fatalf("unexpected record status %q completion %q", rc.Status, rc.Completion)
+	}
+	if err := <-uc.released; err == nil {
+		t.Error("expect idle timeout released to the model")
+	}
+}
@@ -0,0 +1,179 @@
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"slices"
+
+	"github.com/rokku-c/go-openai"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+// transformer 合并后的改写规则
+type transformer struct {
+	prepend            []string
+	append             []string
+	defaultTemperature *float64
+	maxTemperature     *float64
+	defaultMaxTokens   *int
+	maxTokens          *int
+	strip              []string
+}
+
+// maxTokensFields 限制输出长度的请求参数，新版 OpenAI 接口使用 max_completion_tokens
+var maxTokensFields = []string{"max_tokens", "max_completion_tokens"}
+
+// newTransformer 按优先级从高到低合并规则
+// 系统提示词按优先级依次排列，默认值取优先级最高的规则，上限取最严格的规则
+func newTransformer(rules []*types.TransformRule) *transformer {
+	t := &transformer{}
+	for _, r := range rules {
+		c := r.Config
+		if c.SystemPrepend != "" {
+			t.prepend = append(t.prepend, c.SystemPrepend)
+		}
+		if c.SystemAppend != "" {
+			t.append = append(t.append, c.SystemAppend)
+		}
+		if t.defaultTemperature == nil {
+			t.defaultTemperature = c.DefaultTemperature
+		}
+		if c.MaxTemperature != nil && (t.maxTemperature == nil || *c.MaxTemperature < *t.maxTemperature) {
+			t.maxTemperature = c.MaxTemperature
+		}
+		if t.defaultMaxTokens == nil {
+			t.defaultMaxTokens = c.DefaultMaxTokens
+		}
+		if c.MaxTokensLimit != nil && (t.maxTokens == nil || *c.MaxTokensLimit < *t.maxTokens) {
+			t.maxTokens = c.MaxTokensLimit
+		}
+		for _, p := range c.StripParams {
+			if !slices.Contains(t.strip, p) && !slices.Contains(domain.TransformProtectedParams, p) {
+				t.strip = append(t.strip, p)
+			}
+		}
+	}
+	return t
+}
+
+// apply 改写客户端协议的请求体，anthropic 表示请求体为 Anthropic Messages 格式
+func (t *transformer) apply(body []byte, anthropic bool) ([]byte, error) {
+	var req map[string]any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&req); err != nil {
+		return nil, err
+	}
+
+	for _, p := range t.strip {
+		delete(req, p)
+	}
+
+	if v, ok := number(req["temperature"]); ok {
+		if t.maxTemperature != nil && v > *t.maxTemperature {
+			req["temperature"] = *t.maxTemperature
+		}
+	} else if t.defaultTemperature != nil {
+		req["temperature"] = *t.defaultTemperature
+	}
+
+	found := false
+	for _, f := range maxTokensFields {
+		v, ok := number(req[f])
+		if !ok {
+			continue
+		}
+		found = true
+		if t.maxTokens != nil && v > float64(*t.maxTokens) {
+			req[f] = *t.maxTokens
+		}
+	}
+	if !found && t.defaultMaxTokens != nil {
+		n := *t.defaultMaxTokens
+		if t.maxTokens != nil {
+			n = min(n, *t.maxTokens)
+		}
+		req["max_tokens"] = n
+	}
+
+	if len(t.prepend) > 0 || len(t.append) > 0 {
+		if anthropic {
+			req["system"] = t.anthropicSystem(req["system"])
+		} else if msgs, ok := req["messages"].([]any); ok {
+			req["messages"] = t.messages(msgs)
+		}
+	}
+	return json.Marshal(req)
+}
+
+// messages 在 OpenAI 对话消息前后插入系统消息
+func (t *transformer) messages(msgs []any) []any {
+	out := make([]any, 0, len(msgs)+len(t.prepend)+len(t.append))
+	for _, s := range t.prepend {
+		out = append(out, map[string]any{"role": openai.ChatMessageRoleSystem, "content": s})
+	}
+	out = append(out, msgs...)
+	for _, s := range t.append {
+		out = append(out, map[string]any{"role": openai.ChatMessageRoleSystem, "content": s})
+	}
+	return out
+}
+
+// anthropicSystem 在 Anthropic 的 system 字段前后插入文本，system 可以是字符串或文本块数组
+func (t *transformer) anthropicSystem(system any) any {
+	blocks := []any{}
+	for _, s := range t.prepend {
+		blocks = append(blocks, map[string]any{"type": "text", "text": s})
+	}
+	switch v := system.(type) {
+	case string:
+		if v != "" {
+			blocks = append(blocks, map[string]any{"type": "text", "text": v})
+		}
+	case []any:
+		blocks = append(blocks, v...)
+	}
+	for _, s := range t.append {
+		blocks = append(blocks, map[string]any{"type": "text", "text": s})
+	}
+	return blocks
+}
+
+// number 读取 JSON 数字参数
+func number(v any) (float64, bool) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, false
+	}
+	f, err := n.Float64()
+	return f, err == nil
+}
+
+// transform 按匹配的改写规则处理请求体，并记录应用的策略版本
+// 规则查询或改写失败时使用原始请求体，不影响正常使用，向量请求没有可改写的参数
+func (l *LLMProxy) transform(pctx *ProxyCtx) []byte {
+	pctx.PolicyVersion = 0
+	src := pctx.reqBody()
+	if len(src) == 0 || pctx.Model.ModelType == consts.ModelTypeEmbedding {
+		return src
+	}
+	policy, err := l.usecase.MatchTransform(pctx.ctx, pctx.Model.ID, pctx.UserID)
+	if err != nil {
+		l.logger.With("model_id", pctx.Model.ID).With("error", err).WarnContext(pctx.ctx, "match transform rules failed")
+		return src
+	}
+	if policy == nil {
+		return src
+	}
+	body, err := newTransformer(policy.Rules).apply(src, pctx.anthropic())
+	if err != nil {
+		l.logger.ErrorContext(pctx.ctx, "transform request failed", slog.String("path", pctx.inPath), slog.Any("err", err))
+		return src
+	}
+	pctx.PolicyVersion = policy.Version
+	return body
+}
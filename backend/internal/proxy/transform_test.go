@@ -0,0 +1,124 @@
+package proxy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestTransformerMerge(t *testing.T) {
+	tr := newTransformer([]*types.TransformRule{
+		{Config: &types.TransformConfig{
+			SystemPrepend:      "org",
+			DefaultTemperature: ptr(0.2),
+			MaxTokensLimit:     ptr(4096),
+			StripParams:        []string{"logit_bias", "model"},
+		}},
+		{Config: &types.TransformConfig{
+			SystemPrepend:      "group",
+			SystemAppend:       "tail",
+			DefaultTemperature: ptr(0.8),
+			MaxTemperature:     ptr(1.0),
+			MaxTokensLimit:     ptr(1024),
+			StripParams:        []string{"logit_bias", "seed"},
+		}},
+	})
+	if len(tr.prepend) != 2 || tr.prepend[0] != "org" || tr.prepend[1] != "group" {
+		t.Errorf("prepend = %v", tr.prepend)
+	}
+	if *tr.defaultTemperature != 0.2 {
+		t.Errorf("default temperature should come from the highest priority rule, got %v", *tr.defaultTemperature)
+	}
+	if *tr.maxTokens != 1024 {
+		t.Errorf("max tokens should be the strictest limit, got %v", *tr.maxTokens)
+	}
+	if len(tr.strip) != 2 {
+		t.Errorf("strip should skip protected and duplicate params, got %v", tr.strip)
+	}
+}
+
+func TestTransformerApply(t *testing.T) {
+	tr := newTransformer([]*types.TransformRule{{Config: &types.TransformConfig{
+		SystemPrepend:      "follow the coding standard",
+		SystemAppend:       "answer in Chinese",
+		DefaultTemperature: ptr(0.3),
+		DefaultMaxTokens:   ptr(2048),
+		MaxTokensLimit:     ptr(1024),
+		StripParams:        []string{"logit_bias"},
+	}}})
+
+	body := `{"model":"m","messages":[{"role":"user","content":"hi"}],"logit_bias":{"1":2},"max_completion_tokens":4096}`
+	out, err := tr.apply([]byte(body), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req struct {
+		Messages []struct {
+			Role    string `json:"role"`
+			Content string `json:"content"`
+		} `json:"messages"`
+		LogitBias           any      `json:"logit_bias"`
+		Temperature         *float64 `json:"temperature"`
+		MaxTokens           *int     `json:"max_tokens"`
+		MaxCompletionTokens int      `json:"max_completion_tokens"`
+	}
+	if err := json.Unmarshal(out, &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.Messages) != 3 || req.Messages[0].Role != "system" || req.Messages[2].Content != "answer in Chinese" {
+		t.Errorf("unexpected messages %s", out)
+	}
+	if req.LogitBias != nil {
+		t.Error("logit_bias should be stripped")
+	}
+	if req.Temperature == nil || *req.Temperature != 0.3 {
+		t.Error("default temperature should be set")
+	}
+	if req.MaxTokens != nil || req.MaxCompletionTokens != 1024 {
+		t.Errorf("max_completion_tokens should be clamped without adding max_tokens: %s", out)
+	}
+
+	out, err = tr.apply([]byte(`{"prompt":"import os","temperature":1.5}`), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var completion map[string]any
+	json.Unmarshal(out, &completion)
+	if completion["max_tokens"] != float64(1024) || completion["temperature"] != 1.5 {
+		t.Errorf("unexpected completion body %s", out)
+	}
+	if _, ok := completion["messages"]; ok {
+		t.Error("completion request should not get messages")
+	}
+}
+
+func TestTransformerAnthropic(t *testing.T) {
+	tr := newTransformer([]*types.TransformRule{{Config: &types.TransformConfig{
+		SystemPrepend:  "org",
+		MaxTemperature: ptr(0.5),
+	}}})
+	out, err := tr.apply([]byte(`{"system":"client","temperature":0.9,"max_tokens":100,"messages":[]}`), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req struct {
+		System []struct {
+			Text string `json:"text"`
+		} `json:"system"`
+		Temperature float64 `json:"temperature"`
+	}
+	if err := json.Unmarshal(out, &req); err != nil {
+		t.Fatal(err)
+	}
+	if len(req.System) != 2 || req.System[0].Text != "org" || req.System[1].Text != "client" {
+		t.Errorf("unexpected system %s", out)
+	}
+	if req.Temperature != 0.5 {
+		t.Errorf("temperature should be clamped, got %v", req.Temperature)
+	}
+}
@@ -0,0 +1,135 @@
+package proxy
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/tokenizer"
+)
+
+// 按 OpenAI 的计算方式，每条消息额外占用 3 个 token，带 name 时再加 1 个，回复前缀占用 3 个
+const (
+	tokensPerMessage = 3
+	tokensPerName    = 1
+	tokensPerReply   = 3
+	tokensPerImage   = 85 // 低精度图片的 token 数，无法获取图片尺寸时使用
+)
+
+// includeUsage 是否要求模型的上游在流式响应的最后返回 usage
+func (l *LLMProxy) includeUsage(m *domain.Model) bool {
+	u := l.cfg.LLMProxy.Usage
+	return u.IncludeUsage && !slices.Contains(u.SkipProviders, string(m.Provider))
+}
+
+// estimateUsage 上游未返回 usage 时，按请求体和回复计算 token 数，并记录来源
+func (r *Recorder) estimateUsage(rc *domain.RecordParam) {
+	rc.UsageSource = consts.UsageSourceReported
+	if rc.InputTokens > 0 && rc.OutputTokens > 0 || !r.cfg.LLMProxy.Usage.Estimate {
+		return
+	}
+	tk := tokenizer.ForModel(r.ctx.Model.ModelName)
+	if rc.InputTokens == 0 {
+		rc.InputTokens = int64(promptTokens(tk, r.ctx.reqBody()))
+		rc.UsageSource = consts.UsageSourceEstimated
+	}
+	if text := outputText(rc.Completion, rc.Messages); rc.OutputTokens == 0 && text != "" {
+		rc.OutputTokens = int64(tk.Count(text))
+		rc.UsageSource = consts.UsageSourceEstimated
+	}
+}
+
+// applyCost 按模型价格计算请求费用，命中缓存的请求没有调用上游，不计费
+func (r *Recorder) applyCost(rc *domain.RecordParam) {
+	p := r.ctx.Model.Pricing
+	rc.Currency = p.Currency
+	if rc.Currency == "" {
+		rc.Currency = r.cfg.Billing.Currency
+	}
+	if rc.CacheHit {
+		return
+	}
+	rc.Cost = p.Cost(rc.InputTokens, rc.CachedTokens, rc.OutputTokens)
+}
+
+// promptBody 兼容 OpenAI Chat Completions、Completions、Embeddings 和 Anthropic Messages 的请求体
+type promptBody struct {
+	System   json.RawMessage `json:"system"`
+	Messages []struct {
+		Role       string          `json:"role"`
+		Name       string          `json:"name"`
+		Content    json.RawMessage `json:"content"`
+		ToolCalls  json.RawMessage `json:"tool_calls"`
+		ToolCallID string          `json:"tool_call_id"`
+	} `json:"messages"`
+	Prompt json.RawMessage `json:"prompt"`
+	Suffix string          `json:"suffix"`
+	Input  json.RawMessage `json:"input"`
+	Tools  json.RawMessage `json:"tools"`
+}
+
+// promptTokens 计算请求的输入 token 数
+func promptTokens(tk tokenizer.Tokenizer, body []byte) int {
+	var req promptBody
+	if err := json.Unmarshal(body, &req); err != nil {
+		return 0
+	}
+	n := contentTokens(tk, req.System) + contentTokens(tk, req.Prompt) + tk.Count(req.Suffix) + contentTokens(tk, req.Input)
+	for _, m := range req.Messages {
+		n += tokensPerMessage + tk.Count(m.Role) + contentTokens(tk, m.Content)
+		if m.Name != "" {
+			n += tokensPerName + tk.Count(m.Name)
+		}
+		if len(m.ToolCalls) > 0 {
+			n += tk.Count(string(m.ToolCalls))
+		}
+		n += tk.Count(m.ToolCallID)
+	}
+	if len(req.Messages) > 0 {
+		n += tokensPerReply
+	}
+	// 工具定义在上游会被转换为内部格式，按 JSON 原文近似计算
+	if len(req.Tools) > 0 && string(req.Tools) != "null" {
+		n += tk.Count(string(req.Tools))
+	}
+	return n
+}
+
+// contentTokens 计算消息内容的 token 数，内容可以是字符串、字符串数组或内容块数组
+func contentTokens(tk tokenizer.Tokenizer, raw json.RawMessage) int {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return tk.Count(s)
+	}
+	var parts []json.RawMessage
+	if err := json.Unmarshal(raw, &parts); err != nil {
+		return tk.Count(string(raw))
+	}
+	n := 0
+	for _, p := range parts {
+		var part struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		}
+		if err := json.Unmarshal(p, &part); err != nil {
+			// Completions 的 prompt 可以是字符串数组
+			n += contentTokens(tk, p)
+			continue
+		}
+		switch {
+		case part.Text != "":
+			n += tk.Count(part.Text)
+		case strings.Contains(part.Type, "image"):
+			n += tokensPerImage
+		default:
+			// tool_use、tool_result 等内容块按 JSON 原文近似计算
+			n += tk.Count(string(p))
+		}
+	}
+	return n
+}
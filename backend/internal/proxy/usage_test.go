@@ -0,0 +1,133 @@
+package proxy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// words 按空白切分计数，便于核对消息的额外开销
+type words struct{}
+
+func (words) Count(text string) int {
+	return len(strings.Fields(text))
+}
+
+func TestPromptTokens(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+		want int
+	}{
+		{
+			name: "chat",
+			// 2 条消息各 3 + role 1，内容 2 + 3，name 1 + 1，回复前缀 3
+			body: `{"messages":[{"role":"system","content":"be brief"},{"role":"user","name":"bob","content":[{"type":"text","text":"hello big world"},{"type":"image_url","image_url":{"url":"x"}}]}]}`,
+			want: 4 + 2 + 4 + 3 + tokensPerImage + 2 + 3,
+		},
+		{
+			name: "anthropic",
+			body: `{"system":[{"type":"text","text":"you are helpful"}],"messages":[{"role":"user","content":"hi"}]}`,
+			want: 3 + 4 + 1 + 3,
+		},
+		{
+			name: "completion",
+			body: `{"prompt":"def main():","suffix":"return 0"}`,
+			want: 2 + 2,
+		},
+		{
+			name: "prompt array",
+			body: `{"prompt":["a b","c"]}`,
+			want: 3,
+		},
+		{
+			name: "embedding",
+			body: `{"input":["hello world","a"]}`,
+			want: 3,
+		},
+		{
+			name: "invalid",
+			body: `not json`,
+			want: 0,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := promptTokens(words{}, []byte(c.body)); got != c.want {
+				t.Errorf("got %d, want %d", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEstimateUsage(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.LLMProxy.Usage.Estimate = true
+	r := &Recorder{
+		cfg: cfg,
+		ctx: &ProxyCtx{
+			Model: &domain.Model{ModelName: "gpt-4o"},
+			Body:  []byte(`{"messages":[{"role":"user","content":"hi"}]}`),
+		},
+	}
+
+	rc := &domain.RecordParam{InputTokens: 10, OutputTokens: 5, Completion: "ok"}
+	r.estimateUsage(rc)
+	if rc.UsageSource != consts.UsageSourceReported || rc.InputTokens != 10 || rc.OutputTokens != 5 {
+		t.Errorf("reported usage should be kept: %+v", rc)
+	}
+
+	rc = &domain.RecordParam{InputTokens: 10, Completion: "hello world"}
+	r.estimateUsage(rc)
+	if rc.UsageSource != consts.UsageSourceEstimated || rc.InputTokens != 10 || rc.OutputTokens == 0 {
+		t.Errorf("output tokens should be estimated: %+v", rc)
+	}
+
+	rc = &domain.RecordParam{Completion: "hello world"}
+	r.estimateUsage(rc)
+	if rc.UsageSource != consts.UsageSourceEstimated || rc.InputTokens == 0 || rc.OutputTokens == 0 {
+		t.Errorf("usage should be estimated: %+v", rc)
+	}
+
+	cfg.LLMProxy.Usage.Estimate = false
+	rc = &domain.RecordParam{Completion: "hello world"}
+	r.estimateUsage(rc)
+	if rc.UsageSource != consts.UsageSourceReported || rc.InputTokens != 0 || rc.OutputTokens != 0 {
+		t.Errorf("usage should not be estimated when disabled: %+v", rc)
+	}
+}
+
+func TestApplyCost(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Billing.Currency = "CNY"
+	r := &Recorder{
+		cfg: cfg,
+		ctx: &ProxyCtx{
+			Model: &domain.Model{Pricing: domain.ModelPricing{Input: 2, Output: 8}},
+		},
+	}
+
+	rc := &domain.RecordParam{InputTokens: 1000, OutputTokens: 500}
+	r.applyCost(rc)
+	if rc.Cost != 6000 || rc.Currency != "CNY" {
+		t.Errorf("unexpected cost %d %s", rc.Cost, rc.Currency)
+	}
+
+	// 命中提示词缓存的 token 按缓存价格计费
+	r.ctx.Model.Pricing.CachedInput = 0.5
+	rc = &domain.RecordParam{InputTokens: 1000, CachedTokens: 800, OutputTokens: 500}
+	r.applyCost(rc)
+	if rc.Cost != 4800 {
+		t.Errorf("unexpected cost with cached tokens %d", rc.Cost)
+	}
+
+	r.ctx.Model.Pricing.Currency = "USD"
+	rc = &domain.RecordParam{InputTokens: 1000, OutputTokens: 500, CacheHit: true}
+	r.applyCost(rc)
+	if rc.Cost != 0 || rc.Currency != "USD" {
+		t.Errorf("cache hit should not be charged: %d %s", rc.Cost, rc.Currency)
+	}
+}
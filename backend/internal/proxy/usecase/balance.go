@@ -0,0 +1,254 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/balancer"
+	"github.com/chaitin/MonkeyCode/backend/pkg/breaker"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// poolRefreshInterval 兜底刷新间隔，防止丢失模型变更通知
+const poolRefreshInterval = time.Minute
+
+type modelPool = balancer.Balancer[*domain.Model]
+
+func (p *ProxyUsecase) strategy(modelType consts.ModelType) balancer.Strategy {
+	if s, ok := p.cfg.LLMProxy.LoadBalance[string(modelType)]; ok && s != "" {
+		return balancer.Strategy(s)
+	}
+	return balancer.StrategyWeightedRoundRobin
+}
+
+// pool 获取模型类型对应的模型池，首次访问时从数据库加载
+func (p *ProxyUsecase) pool(ctx context.Context, modelType consts.ModelType) (*modelPool, error) {
+	p.poolMu.RLock()
+	b, ok := p.pools[modelType]
+	p.poolMu.RUnlock()
+	if ok {
+		return b, nil
+	}
+
+	p.poolMu.Lock()
+	defer p.poolMu.Unlock()
+	if b, ok := p.pools[modelType]; ok {
+		return b, nil
+	}
+	b = balancer.New[*domain.Model](p.strategy(modelType))
+	if err := p.loadPool(ctx, modelType, b); err != nil {
+		return nil, err
+	}
+	p.pools[modelType] = b
+	return b, nil
+}
+
+func (p *ProxyUsecase) loadPool(ctx context.Context, modelType consts.ModelType, b *modelPool) error {
+	models, err := p.modelRepo.ListActive(ctx, modelType)
+	if err != nil {
+		return err
+	}
+	health, err := p.redis.HGetAll(ctx, consts.ModelHealthKey).Result()
+	if err != nil {
+		p.logger.With("error", err).WarnContext(ctx, "failed to get model health status")
+	}
+	b.Update(cvt.Iter(models, func(_ int, m *db.Model) *balancer.Node[*domain.Model] {
+		v := cvt.From(m, &domain.Model{})
+		if s, ok := health[m.ID.String()]; ok {
+			v.Health = &domain.ModelHealth{Status: consts.ModelHealth(s)}
+		}
+		return balancer.NewNode(m.ID.String(), m.Weight, v)
+	}))
+	b.SetStrategy(p.strategy(modelType))
+	p.logger.With("model_type", modelType).With("count", len(models)).DebugContext(ctx, "model pool loaded")
+	return nil
+}
+
+// reloadPools 重新加载已创建的模型池，modelType 为空时重新加载全部
+func (p *ProxyUsecase) reloadPools(ctx context.Context, modelType consts.ModelType) {
+	p.poolMu.RLock()
+	pools := make(map[consts.ModelType]*modelPool, len(p.pools))
+	for t, b := range p.pools {
+		if modelType == "" || t == modelType {
+			pools[t] = b
+		}
+	}
+	p.poolMu.RUnlock()
+
+	for t, b := range pools {
+		if err := p.loadPool(ctx, t, b); err != nil {
+			p.logger.With("model_type", t).With("error", err).WarnContext(ctx, "failed to reload model pool")
+		}
+	}
+}
+
+// watchModels 订阅模型和改写规则的变更通知，管理员修改后实时刷新模型池和改写策略
+func (p *ProxyUsecase) watchModels(ctx context.Context) {
+	sub := p.redis.Subscribe(ctx, consts.ModelChangedChannel, consts.TransformChangedChannel)
+	defer sub.Close()
+
+	ticker := time.NewTicker(poolRefreshInterval)
+	defer ticker.Stop()
+
+	ch := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+			if msg.Channel == consts.TransformChangedChannel {
+				p.logger.DebugContext(ctx, "transform changed")
+				p.reloadTransform(ctx)
+				continue
+			}
+			p.logger.With("model_type", msg.Payload).DebugContext(ctx, "model changed")
+			p.reloadPools(ctx, consts.ModelType(msg.Payload))
+		case <-ticker.C:
+			p.reloadPools(ctx, "")
+			p.reloadTransform(ctx)
+		}
+	}
+}
+
+// breaker 获取模型对应的熔断器
+func (p *ProxyUsecase) breaker(id string) *breaker.Breaker {
+	p.breakerMu.Lock()
+	defer p.breakerMu.Unlock()
+	if b, ok := p.breakers[id]; ok {
+		return b
+	}
+	b := breaker.New(breaker.Config{
+		FailureThreshold: p.cfg.LLMProxy.Breaker.FailureThreshold,
+		OpenTimeout:      time.Duration(p.cfg.LLMProxy.Breaker.OpenSecond) * time.Second,
+	}, func(from, to breaker.State) {
+		go p.saveBreakerState(id, from, to)
+	})
+	p.breakers[id] = b
+	return b
+}
+
+// saveBreakerState 将熔断状态写入 redis，供管理后台展示
+func (p *ProxyUsecase) saveBreakerState(id string, from, to breaker.State) {
+	ctx := context.Background()
+	p.logger.With("model_id", id).With("from", from).With("to", to).WarnContext(ctx, "model breaker state changed")
+	var err error
+	if to == breaker.StateClosed {
+		err = p.redis.HDel(ctx, consts.ModelBreakerKey, id).Err()
+	} else {
+		err = p.redis.HSet(ctx, consts.ModelBreakerKey, id, string(to)).Err()
+	}
+	if err != nil {
+		p.logger.With("model_id", id).With("error", err).WarnContext(ctx, "failed to save model breaker state")
+	}
+}
+
+// SelectModelWithLoadBalancing implements domain.ProxyUsecase.
+// ModelName 可以是模型名称或别名，未指定或没有匹配的模型时使用该类型的全部模型
+// 请求的模型都不可用时只按顺序回退到配置的回退模型，不会切换到其他模型
+func (p *ProxyUsecase) SelectModelWithLoadBalancing(req *domain.SelectModelReq) (*domain.Model, error) {
+	b, err := p.pool(context.Background(), req.ModelType)
+	if err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[string]struct{}, len(req.Exclude))
+	for _, id := range req.Exclude {
+		excluded[id] = struct{}{}
+	}
+	available := func(n *balancer.Node[*domain.Model]) bool {
+		if _, ok := excluded[n.ID]; ok {
+			return false
+		}
+		if !req.AllowAnthropic && n.Value.Provider == consts.ModelProviderAnthropic {
+			return false
+		}
+		return p.breaker(n.ID).Ready()
+	}
+	healthy := func(n *balancer.Node[*domain.Model]) bool {
+		return !n.Value.Unhealthy() && available(n)
+	}
+	groups := p.candidates(b, req)
+	picks := make([]func(n *balancer.Node[*domain.Model]) bool, 0, len(groups)*2)
+	// 所有候选模型都不健康时仍然尝试，避免健康检查误判导致服务不可用
+	for _, check := range []func(n *balancer.Node[*domain.Model]) bool{healthy, available} {
+		for _, group := range groups {
+			picks = append(picks, func(n *balancer.Node[*domain.Model]) bool {
+				return group(n) && check(n)
+			})
+		}
+	}
+
+	for {
+		var (
+			node *balancer.Node[*domain.Model]
+			err  error
+		)
+		for _, pick := range picks {
+			node, err = b.Pick(pick)
+			if !errors.Is(err, balancer.ErrNoAvailableNode) {
+				break
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		// 半开状态下可能被其他请求抢先探测，换一个节点
+		if !p.breaker(node.ID).Allow() {
+			excluded[node.ID] = struct{}{}
+			continue
+		}
+		node.Acquire()
+		return node.Value, nil
+	}
+}
+
+// candidates 按优先级返回候选模型的匹配条件，依次为请求的模型和配置的回退模型
+func (p *ProxyUsecase) candidates(b *modelPool, req *domain.SelectModelReq) []func(n *balancer.Node[*domain.Model]) bool {
+	match := func(name string) func(n *balancer.Node[*domain.Model]) bool {
+		return func(n *balancer.Node[*domain.Model]) bool {
+			return n.Value.Match(name)
+		}
+	}
+	if req.ModelName == "" || !slices.ContainsFunc(b.Nodes(), match(req.ModelName)) {
+		return []func(n *balancer.Node[*domain.Model]) bool{
+			func(*balancer.Node[*domain.Model]) bool { return true },
+		}
+	}
+	groups := []func(n *balancer.Node[*domain.Model]) bool{match(req.ModelName)}
+	for _, name := range p.cfg.LLMProxy.Fallback[string(req.ModelType)] {
+		groups = append(groups, match(name))
+	}
+	return groups
+}
+
+// ReleaseModel implements domain.ProxyUsecase.
+func (p *ProxyUsecase) ReleaseModel(m *domain.Model, latency time.Duration, err error) {
+	if m == nil {
+		return
+	}
+	if err != nil {
+		p.breaker(m.ID).Failure()
+		// 失败请求的耗时不计入延迟统计
+		latency = 0
+	} else {
+		p.breaker(m.ID).Success()
+	}
+
+	p.poolMu.RLock()
+	b, ok := p.pools[m.ModelType]
+	p.poolMu.RUnlock()
+	if !ok {
+		return
+	}
+	if node, ok := b.Get(m.ID); ok {
+		node.Release(latency)
+	}
+}
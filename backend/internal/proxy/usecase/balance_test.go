@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/balancer"
+	"github.com/chaitin/MonkeyCode/backend/pkg/breaker"
+)
+
+func newBalanceUsecase(fallback []string, models ...*domain.Model) *ProxyUsecase {
+	cfg := &config.Config{}
+	cfg.LLMProxy.Breaker.FailureThreshold = 5
+	cfg.LLMProxy.Breaker.OpenSecond = 30
+	cfg.LLMProxy.Fallback = map[string][]string{string(consts.ModelTypeLLM): fallback}
+	b := balancer.New[*domain.Model](balancer.StrategyWeightedRoundRobin)
+	nodes := make([]*balancer.Node[*domain.Model], 0, len(models))
+	for _, m := range models {
+		m.ModelType = consts.ModelTypeLLM
+		nodes = append(nodes, balancer.NewNode(m.ID, 1, m))
+	}
+	b.Update(nodes)
+	return &ProxyUsecase{
+		cfg:      cfg,
+		pools:    map[consts.ModelType]*modelPool{consts.ModelTypeLLM: b},
+		breakers: make(map[string]*breaker.Breaker),
+	}
+}
+
+func TestSelectModel(t *testing.T) {
+	unhealthy := &domain.ModelHealth{Status: consts.ModelHealthUnhealthy}
+	a := &domain.Model{ID: "a", ModelName: "deepseek-v3", Aliases: []string{"fast"}}
+	b := &domain.Model{ID: "b", ModelName: "qwen-max"}
+	c := &domain.Model{ID: "c", ModelName: "glm-4"}
+
+	cases := []struct {
+		name     string
+		fallback []string
+		health   map[*domain.Model]*domain.ModelHealth
+		req      domain.SelectModelReq
+		want     []string // 多次选择可能得到的模型，为空表示没有可用模型
+	}{
+		{name: "alias", req: domain.SelectModelReq{ModelName: "FAST"}, want: []string{"a"}},
+		{name: "name", req: domain.SelectModelReq{ModelName: "qwen-max"}, want: []string{"b"}},
+		{name: "unmatched uses all models", req: domain.SelectModelReq{ModelName: "gpt-4o"}, want: []string{"a", "b", "c"}},
+		{name: "empty uses all models", req: domain.SelectModelReq{}, want: []string{"a", "b", "c"}},
+		{name: "no fallback", req: domain.SelectModelReq{ModelName: "fast", Exclude: []string{"a"}}},
+		{
+			name:     "fallback in order",
+			fallback: []string{"glm-4", "qwen-max"},
+			req:      domain.SelectModelReq{ModelName: "fast", Exclude: []string{"a"}},
+			want:     []string{"c"},
+		},
+		{
+			name:     "skip tried fallback",
+			fallback: []string{"glm-4", "qwen-max"},
+			req:      domain.SelectModelReq{ModelName: "fast", Exclude: []string{"a", "c"}},
+			want:     []string{"b"},
+		},
+		{
+			name:     "healthy fallback before unhealthy model",
+			fallback: []string{"glm-4"},
+			health:   map[*domain.Model]*domain.ModelHealth{a: unhealthy},
+			req:      domain.SelectModelReq{ModelName: "fast"},
+			want:     []string{"c"},
+		},
+		{
+			name:     "unhealthy model when all unhealthy",
+			fallback: []string{"glm-4"},
+			health:   map[*domain.Model]*domain.ModelHealth{a: unhealthy, c: unhealthy},
+			req:      domain.SelectModelReq{ModelName: "fast"},
+			want:     []string{"a"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			models := make([]*domain.Model, 0, 3)
+			for _, m := range []*domain.Model{a, b, c} {
+				cp := *m
+				cp.Health = tc.health[m]
+				models = append(models, &cp)
+			}
+			p := newBalanceUsecase(tc.fallback, models...)
+			got := make(map[string]bool)
+			for range 6 {
+				req := tc.req
+				req.ModelType = consts.ModelTypeLLM
+				m, err := p.SelectModelWithLoadBalancing(&req)
+				if len(tc.want) == 0 {
+					if !errors.Is(err, balancer.ErrNoAvailableNode) {
+						t.Fatalf("expected no available model, got %v %v", m, err)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				got[m.ID] = true
+				p.ReleaseModel(m, 0, nil)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("selected %v, want %v", got, tc.want)
+			}
+			for _, id := range tc.want {
+				if !got[id] {
+					t.Fatalf("selected %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
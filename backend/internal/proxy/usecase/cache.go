@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+const (
+	cacheCleanInterval    = time.Hour       // 过期缓存的清理间隔
+	cacheEmbeddingTimeout = 2 * time.Second // 相似度查找生成向量的超时时间
+)
+
+// GetCache implements domain.ProxyUsecase.
+// 先按请求哈希精确匹配，开启相似度查找时再在上下文相同的缓存中按请求文本的向量查找，未命中返回 nil
+// 生成向量失败或超时时跳过相似度查找，不阻塞请求
+func (p *ProxyUsecase) GetCache(ctx context.Context, req *domain.CacheReq) (*domain.ResponseCache, error) {
+	c, err := p.repo.GetCache(ctx, req.Key)
+	if err != nil && !db.IsNotFound(err) {
+		return nil, err
+	}
+
+	if c == nil && p.cfg.LLMProxy.Cache.Semantic && req.Prompt != "" && req.ContextKey != "" {
+		c, err = p.similarCache(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if c == nil {
+		return nil, nil
+	}
+
+	if err := p.repo.HitCache(ctx, c.ID); err != nil {
+		p.logger.With("id", c.ID).With("error", err).WarnContext(ctx, "failed to update cache hits")
+	}
+	return (&domain.ResponseCache{}).From(c), nil
+}
+
+// similarCache 按请求文本的向量查找相似的缓存，生成向量失败时返回 nil
+func (p *ProxyUsecase) similarCache(ctx context.Context, req *domain.CacheReq) (*db.ResponseCache, error) {
+	ectx, cancel := context.WithTimeout(ctx, cacheEmbeddingTimeout)
+	defer cancel()
+	embedding, err := p.embedding.GenerateEmbeddingFromContent(ectx, req.Prompt)
+	if err != nil {
+		p.logger.With("key", req.Key).With("error", err).WarnContext(ctx, "generate cache embedding failed, skip similarity lookup")
+		return nil, nil
+	}
+	req.Embedding = embedding
+	c, err := p.repo.SimilarCache(ctx, req, p.cfg.LLMProxy.Cache.Similarity)
+	if err != nil && !db.IsNotFound(err) {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SaveCache implements domain.ProxyUsecase.
+func (p *ProxyUsecase) SaveCache(ctx context.Context, req *domain.CacheReq, cache *domain.ResponseCache) error {
+	return p.repo.SaveCache(ctx, req, cache)
+}
+
+// cleanCache 定期删除过期的响应缓存
+func (p *ProxyUsecase) cleanCache(ctx context.Context) {
+	ticker := time.NewTicker(cacheCleanInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := p.repo.DeleteExpiredCache(ctx)
+			if err != nil {
+				p.logger.With("error", err).WarnContext(ctx, "failed to delete expired response cache")
+				continue
+			}
+			p.logger.With("count", n).DebugContext(ctx, "expired response cache deleted")
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/config"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/internal/codesnippet/service"
+)
+
+type cacheRepo struct {
+	domain.ProxyRepo
+	similar int
+}
+
+func (r *cacheRepo) GetCache(context.Context, string) (*db.ResponseCache, error) {
+	return nil, nil
+}
+
+func (r *cacheRepo) SimilarCache(context.Context, *domain.CacheReq, float64) (*db.ResponseCache, error) {
+	r.similar++
+	return nil, nil
+}
+
+// slowEmbedding 直到上下文结束才返回
+type slowEmbedding struct {
+	service.EmbeddingService
+}
+
+func (slowEmbedding) GenerateEmbeddingFromContent(ctx context.Context, _ string) ([]float32, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestGetCacheEmbeddingTimeout(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.LLMProxy.Cache.Semantic = true
+	repo := &cacheRepo{}
+	p := &ProxyUsecase{repo: repo, embedding: slowEmbedding{}, cfg: cfg, logger: slog.Default()}
+
+	start := time.Now()
+	c, err := p.GetCache(context.Background(), &domain.CacheReq{Key: "k", ContextKey: "ctx", Prompt: "what is go"})
+	if err != nil || c != nil {
+		t.Fatalf("expected cache miss, got %v %v", c, err)
+	}
+	if d := time.Since(start); d > cacheEmbeddingTimeout+time.Second {
+		t.Errorf("embedding blocked for %s", d)
+	}
+	if repo.similar != 0 {
+		t.Error("similarity lookup should be skipped when embedding fails")
+	}
+}
@@ -0,0 +1,15 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+// RecordDLP implements domain.ProxyUsecase.
+func (p *ProxyUsecase) RecordDLP(ctx context.Context, record *domain.DLPRecord) error {
+	if len(record.Hits) == 0 {
+		return nil
+	}
+	return p.repo.SaveDLPHits(ctx, record)
+}
@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+)
+
+// fileLanguages 工作区文件的语言类型对应的扫描语言
+var fileLanguages = map[string]consts.SecurityScanningLanguage{
+	"go":          consts.SecurityScanningLanguageGo,
+	"python":      consts.SecurityScanningLanguagePython,
+	"java":        consts.SecurityScanningLanguageJava,
+	"javascript":  consts.SecurityScanningLanguageJavaScript,
+	"typescript":  consts.SecurityScanningLanguageJavaScript,
+	"jsx":         consts.SecurityScanningLanguageJavaScript,
+	"tsx":         consts.SecurityScanningLanguageJavaScript,
+	"html":        consts.SecurityScanningLanguageHTML,
+	"php":         consts.SecurityScanningLanguagePHP,
+	"rust":        consts.SecurityScanningLanguageRust,
+	"swift":       consts.SecurityScanningLanguageSwift,
+	"kotlin":      consts.SecurityScanningLanguageKotlin,
+	"c":           consts.SecurityScanningLanguageCpp,
+	"cpp":         consts.SecurityScanningLanguageCpp,
+	"csharp":      consts.SecurityScanningLanguageCS,
+	"ruby":        consts.SecurityScanningLanguageRuby,
+	"scala":       consts.SecurityScanningLanguageScala,
+	"dart":        consts.SecurityScanningLanguageDart,
+	"lua":         consts.SecurityScanningLanguageLua,
+	"groovy":      consts.SecurityScanningLanguageGroovy,
+	"shell":       consts.SecurityScanningLanguageShell,
+	"bash":        consts.SecurityScanningLanguageShell,
+	"sql":         consts.SecurityScanningLanguageSQL,
+	"solidity":    consts.SecurityScanningLanguageSolidity,
+	"ocaml":       consts.SecurityScanningLanguageOCaml,
+	"objective-c": consts.SecurityScanningLanguageObjectiveC,
+	"cobol":       consts.SecurityScanningLanguageCOBOL,
+	"fortran":     consts.SecurityScanningLanguageFortran,
+}
+
+// extLanguages 文件扩展名对应的扫描语言，工作区文件没有语言类型时使用
+var extLanguages = map[string]consts.SecurityScanningLanguage{
+	".go":     consts.SecurityScanningLanguageGo,
+	".py":     consts.SecurityScanningLanguagePython,
+	".java":   consts.SecurityScanningLanguageJava,
+	".js":     consts.SecurityScanningLanguageJavaScript,
+	".mjs":    consts.SecurityScanningLanguageJavaScript,
+	".cjs":    consts.SecurityScanningLanguageJavaScript,
+	".jsx":    consts.SecurityScanningLanguageJavaScript,
+	".ts":     consts.SecurityScanningLanguageJavaScript,
+	".tsx":    consts.SecurityScanningLanguageJavaScript,
+	".vue":    consts.SecurityScanningLanguageJavaScript,
+	".html":   consts.SecurityScanningLanguageHTML,
+	".htm":    consts.SecurityScanningLanguageHTML,
+	".php":    consts.SecurityScanningLanguagePHP,
+	".rs":     consts.SecurityScanningLanguageRust,
+	".swift":  consts.SecurityScanningLanguageSwift,
+	".kt":     consts.SecurityScanningLanguageKotlin,
+	".kts":    consts.SecurityScanningLanguageKotlin,
+	".c":      consts.SecurityScanningLanguageCpp,
+	".h":      consts.SecurityScanningLanguageCpp,
+	".cc":     consts.SecurityScanningLanguageCpp,
+	".cpp":    consts.SecurityScanningLanguageCpp,
+	".cxx":    consts.SecurityScanningLanguageCpp,
+	".hpp":    consts.SecurityScanningLanguageCpp,
+	".cs":     consts.SecurityScanningLanguageCS,
+	".rb":     consts.SecurityScanningLanguageRuby,
+	".scala":  consts.SecurityScanningLanguageScala,
+	".dart":   consts.SecurityScanningLanguageDart,
+	".lua":    consts.SecurityScanningLanguageLua,
+	".groovy": consts.SecurityScanningLanguageGroovy,
+	".gradle": consts.SecurityScanningLanguageGroovy,
+	".sh":     consts.SecurityScanningLanguageShell,
+	".bash":   consts.SecurityScanningLanguageShell,
+	".sql":    consts.SecurityScanningLanguageSQL,
+	".sol":    consts.SecurityScanningLanguageSolidity,
+	".ml":     consts.SecurityScanningLanguageOCaml,
+	".mli":    consts.SecurityScanningLanguageOCaml,
+	".m":      consts.SecurityScanningLanguageObjectiveC,
+	".mm":     consts.SecurityScanningLanguageObjectiveC,
+	".cob":    consts.SecurityScanningLanguageCOBOL,
+	".cbl":    consts.SecurityScanningLanguageCOBOL,
+	".f":      consts.SecurityScanningLanguageFortran,
+	".f90":    consts.SecurityScanningLanguageFortran,
+	".f95":    consts.SecurityScanningLanguageFortran,
+}
+
+// detectLanguage 识别工作区文件的扫描语言，无法识别时返回空
+func detectLanguage(f *db.WorkspaceFile) consts.SecurityScanningLanguage {
+	if l, ok := fileLanguages[strings.ToLower(f.Language)]; ok {
+		return l
+	}
+	return extLanguages[strings.ToLower(path.Ext(f.Path))]
+}
+
+// languageStat 统计工作区各语言的文件数，用于规划自动识别语言的子扫描
+type languageStat struct {
+	files   map[consts.SecurityScanningLanguage]int
+	changed map[consts.SecurityScanningLanguage]int
+	total   int
+	dirty   int
+}
+
+func newLanguageStat() *languageStat {
+	return &languageStat{
+		files:   make(map[consts.SecurityScanningLanguage]int),
+		changed: make(map[consts.SecurityScanningLanguage]int),
+	}
+}
+
+// add 记录一个文件，changed 表示文件需要重新扫描
+func (s *languageStat) add(f *db.WorkspaceFile, changed bool) {
+	s.total++
+	if changed {
+		s.dirty++
+	}
+	l := detectLanguage(f)
+	if l == "" {
+		return
+	}
+	s.files[l]++
+	if changed {
+		s.changed[l]++
+	}
+}
+
+// subScans 每种识别出的语言一个子扫描，并始终包含敏感信息和 IaC 扫描
+// rules 为 true 时扫描策略的自定义规则包单独作为一个子扫描
+// 增量扫描中没有变化文件的子扫描标记为跳过，full 为 true 时全部扫描
+func (s *languageStat) subScans(full, rules bool) []*types.SecurityScanningSubScan {
+	langs := make([]consts.SecurityScanningLanguage, 0, len(s.files))
+	for l := range s.files {
+		if l != consts.SecurityScanningLanguageSecrets && l != consts.SecurityScanningLanguageIaC {
+			langs = append(langs, l)
+		}
+	}
+	slices.Sort(langs)
+
+	subs := make([]*types.SecurityScanningSubScan, 0, len(langs)+3)
+	add := func(l consts.SecurityScanningLanguage, files, changed int) {
+		status := consts.SecurityScanningStatusPending
+		if !full && changed == 0 {
+			status = consts.SecurityScanningStatusSkipped
+		}
+		subs = append(subs, &types.SecurityScanningSubScan{
+			Language: l,
+			Status:   status,
+			Files:    files,
+		})
+	}
+	for _, l := range langs {
+		add(l, s.files[l], s.changed[l])
+	}
+	add(consts.SecurityScanningLanguageSecrets, s.total, s.dirty)
+	add(consts.SecurityScanningLanguageIaC, s.total, s.dirty)
+	if rules {
+		add("", s.total, s.dirty)
+	}
+	return subs
+}
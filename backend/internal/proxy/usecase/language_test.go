@@ -0,0 +1,72 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	tests := []struct {
+		language string
+		path     string
+		want     consts.SecurityScanningLanguage
+	}{
+		{"go", "main.go", consts.SecurityScanningLanguageGo},
+		{"typescript", "src/app.ts", consts.SecurityScanningLanguageJavaScript},
+		{"", "lib/util.PY", consts.SecurityScanningLanguagePython},
+		{"", "include/a.hpp", consts.SecurityScanningLanguageCpp},
+		{"css", "style.css", ""},
+		{"unknown", "Makefile", ""},
+	}
+	for _, tt := range tests {
+		if got := detectLanguage(&db.WorkspaceFile{Language: tt.language, Path: tt.path}); got != tt.want {
+			t.Errorf("%s %s: got %q, want %q", tt.language, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestSubScans(t *testing.T) {
+	stat := newLanguageStat()
+	stat.add(&db.WorkspaceFile{Path: "main.go"}, false)
+	stat.add(&db.WorkspaceFile{Path: "web/app.ts"}, true)
+	stat.add(&db.WorkspaceFile{Path: "web/index.js"}, false)
+	stat.add(&db.WorkspaceFile{Path: "Dockerfile"}, false)
+
+	type want struct {
+		language consts.SecurityScanningLanguage
+		status   consts.SecurityScanningStatus
+		files    int
+	}
+	check := func(name string, full, rules bool, ws []want) {
+		subs := stat.subScans(full, rules)
+		if len(subs) != len(ws) {
+			t.Fatalf("%s: unexpected sub scans %d", name, len(subs))
+		}
+		for i, w := range ws {
+			s := subs[i]
+			if s.Language != w.language || s.Status != w.status || s.Files != w.files {
+				t.Errorf("%s: sub scan %d got %+v, want %+v", name, i, *s, w)
+			}
+		}
+	}
+
+	check("incremental", false, false, []want{
+		{consts.SecurityScanningLanguageGo, consts.SecurityScanningStatusSkipped, 1},
+		{consts.SecurityScanningLanguageJavaScript, consts.SecurityScanningStatusPending, 2},
+		{consts.SecurityScanningLanguageSecrets, consts.SecurityScanningStatusPending, 4},
+		{consts.SecurityScanningLanguageIaC, consts.SecurityScanningStatusPending, 4},
+	})
+	check("full with rules", true, true, []want{
+		{consts.SecurityScanningLanguageGo, consts.SecurityScanningStatusPending, 1},
+		{consts.SecurityScanningLanguageJavaScript, consts.SecurityScanningStatusPending, 2},
+		{consts.SecurityScanningLanguageSecrets, consts.SecurityScanningStatusPending, 4},
+		{consts.SecurityScanningLanguageIaC, consts.SecurityScanningStatusPending, 4},
+		{"", consts.SecurityScanningStatusPending, 4},
+	})
+
+	if subs := newLanguageStat().subScans(false, false); len(subs) != 2 || subs[0].Status != consts.SecurityScanningStatusSkipped {
+		t.Errorf("expect skipped secrets and iac for empty workspace, got %d", len(subs))
+	}
+}
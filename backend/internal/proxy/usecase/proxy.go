@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -16,25 +18,46 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db"
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/internal/codesnippet/service"
+	"github.com/chaitin/MonkeyCode/backend/pkg/breaker"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 	"github.com/chaitin/MonkeyCode/backend/pkg/queuerunner"
+	"github.com/chaitin/MonkeyCode/backend/pkg/ratelimit"
 	"github.com/chaitin/MonkeyCode/backend/pkg/request"
 	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+	"github.com/chaitin/MonkeyCode/backend/pkg/streamqueue"
 )
 
 type ProxyUsecase struct {
 	repo         domain.ProxyRepo
 	modelRepo    domain.ModelRepo
 	securityRepo domain.SecurityScanningRepo
+	billingRepo  domain.BillingRepo
+	auditRepo    domain.AuditRepo
+	embedding    service.EmbeddingService
 	logger       *slog.Logger
+	cfg          *config.Config
+	redis        *redis.Client
 	queuerunner  *queuerunner.QueueRunner[domain.CreateSecurityScanningReq]
+	records      *streamqueue.Queue[domain.RecordParam]
 	client       *request.Client
+	limiter      *ratelimit.Limiter
+	poolMu       sync.RWMutex
+	pools        map[consts.ModelType]*modelPool
+	breakerMu    sync.Mutex
+	breakers     map[string]*breaker.Breaker
+	transformMu  sync.RWMutex
+	transform    *domain.TransformPolicy
 }
 
 func NewProxyUsecase(
 	repo domain.ProxyRepo,
 	modelRepo domain.ModelRepo,
 	securityRepo domain.SecurityScanningRepo,
+	billingRepo domain.BillingRepo,
+	auditRepo domain.AuditRepo,
+	embedding service.EmbeddingService,
 	logger *slog.Logger,
 	cfg *config.Config,
 	redis *redis.Client,
@@ -51,12 +74,24 @@ func NewProxyUsecase(
 		repo:         repo,
 		modelRepo:    modelRepo,
 		securityRepo: securityRepo,
+		billingRepo:  billingRepo,
+		auditRepo:    auditRepo,
+		embedding:    embedding,
 		logger:       logger.With("module", "ProxyUsecase"),
+		cfg:          cfg,
+		redis:        redis,
 		queuerunner:  queuerunner.NewQueueRunner[domain.CreateSecurityScanningReq](cfg, redis, logger),
 		client:       client,
+		limiter:      ratelimit.New(redis),
+		pools:        make(map[consts.ModelType]*modelPool),
+		breakers:     make(map[string]*breaker.Breaker),
 	}
+	p.records = p.newRecordQueue()
 	go p.queuerunner.Run(context.Background())
+	go p.records.Run(context.Background(), p.saveRecord)
 	go p.requeue()
+	go p.watchModels(context.Background())
+	go p.cleanCache(context.Background())
 	return p
 }
 
@@ -71,23 +106,11 @@ func (p *ProxyUsecase) requeue() {
 			UserID:    scanning.UserID.String(),
 			Workspace: scanning.Workspace,
 			Language:  scanning.Language,
+			Policy:    scanning.Policy,
 		}, p.TaskHandle)
 	}
 }
 
-func (p *ProxyUsecase) Record(ctx context.Context, record *domain.RecordParam) error {
-	return p.repo.Record(ctx, record)
-}
-
-// SelectModelWithLoadBalancing implements domain.ProxyUsecase.
-func (p *ProxyUsecase) SelectModelWithLoadBalancing(modelName string, modelType consts.ModelType) (*domain.Model, error) {
-	model, err := p.modelRepo.GetWithCache(context.Background(), modelType)
-	if err != nil {
-		return nil, err
-	}
-	return cvt.From(model, &domain.Model{}), nil
-}
-
 func (p *ProxyUsecase) ValidateApiKey(ctx context.Context, key string) (*domain.ApiKey, error) {
 	apiKey, err := p.repo.ValidateApiKey(ctx, key)
 	if err != nil {
@@ -114,6 +137,16 @@ func (p *ProxyUsecase) Report(ctx context.Context, req *domain.ReportReq) error
 }
 
 func (p *ProxyUsecase) CreateSecurityScanning(ctx context.Context, req *domain.CreateSecurityScanningReq) (string, error) {
+	if req.Policy != "" {
+		policy, err := p.securityRepo.GetPolicy(ctx, req.Policy)
+		if err != nil {
+			return "", err
+		}
+		req.Language = cvt.ZeroWithDefault(req.Language, policy.Language)
+	}
+	if req.Language == "" {
+		return "", fmt.Errorf("language or policy with a language is required")
+	}
 	id, err := p.securityRepo.Create(ctx, *req)
 	if err != nil {
 		return "", err
@@ -124,25 +157,77 @@ func (p *ProxyUsecase) CreateSecurityScanning(ctx context.Context, req *domain.C
 func (p *ProxyUsecase) TaskHandle(ctx context.Context, task *queuerunner.Task[domain.CreateSecurityScanningReq]) error {
 	ctx = rule.SkipPermission(ctx)
 	id := task.ID
-	if err := p.securityRepo.Update(ctx, id, nil, consts.SecurityScanningStatusRunning, nil); err != nil {
+	if err := p.securityRepo.Update(ctx, id, consts.SecurityScanningStatusRunning, ""); err != nil {
 		p.logger.With("id", task.ID).With("error", err).ErrorContext(ctx, "failed to update security scanning")
 		return err
 	}
 	p.logger.With("id", id).DebugContext(ctx, "task started")
 
-	// 落盘文件
 	scanning, err := p.securityRepo.Get(ctx, id)
 	if err != nil {
 		p.logger.With("id", id).With("error", err).ErrorContext(ctx, "failed to get security scanning")
 		return err
 	}
+
+	scanReq := domain.ScanReq{
+		TaskID:   task.ID,
+		UserID:   task.Data.UserID,
+		Language: scanning.Language.Rule(),
+	}
+	policy, packs, err := p.syncRules(ctx, scanning.Policy)
+	if err != nil {
+		if err := p.securityRepo.Update(ctx, id, consts.SecurityScanningStatusFailed, err.Error()); err != nil {
+			p.logger.With("id", task.ID).With("error", err).ErrorContext(ctx, "failed to update security scanning")
+		}
+		p.logger.With("id", id).With("policy", scanning.Policy).With("error", err).ErrorContext(ctx, "failed to sync rule packs")
+		return err
+	}
+	if policy != nil {
+		scanReq.Confidence = policy.MinConfidence.AtLeast()
+		scanReq.Impact = policy.MinImpact.AtLeast()
+		scanReq.Rules = cvt.Iter(rulePacks(packs), func(_ int, r *scan.RulePack) *scan.RulePack {
+			return &scan.RulePack{Name: r.Name, Version: r.Version, Checksum: r.Checksum}
+		})
+	}
+
+	// 增量扫描只扫描上一次成功扫描后变化的文件
+	var base *db.SecurityScanning
+	if !task.Data.Full {
+		base, err = p.securityRepo.LatestSuccess(ctx, scanning.WorkspaceID, scanning.Language, scanning.Policy)
+		if err != nil && !db.IsNotFound(err) {
+			p.logger.With("id", id).With("error", err).ErrorContext(ctx, "failed to get last security scanning")
+			return err
+		}
+		if base != nil && policy != nil {
+			// 停用的规则包不在 packs 中，需要按策略引用的全部规则包判断
+			refs, err := p.securityRepo.PolicyRulePacks(ctx, policy.RulePackIds)
+			if err != nil {
+				p.logger.With("id", id).With("error", err).ErrorContext(ctx, "failed to get policy rule packs")
+				return err
+			}
+			if rulesChanged(base, policy, refs) {
+				base = nil
+			}
+		}
+	}
+
+	// 落盘文件
+	root := scanning.Edges.WorkspaceEdge.RootPath
 	prefix := fmt.Sprintf("/app/static/codes/%s", id)
-	rootPath := path.Join(prefix, scanning.Edges.WorkspaceEdge.RootPath)
+	rootPath := path.Join(prefix, root)
 	defer os.RemoveAll(prefix)
 
+	hashes := make(map[string]string)
 	fileMap := make(map[string]string)
+	stat := newLanguageStat()
 	if err = p.securityRepo.PageWorkspaceFiles(ctx, scanning.WorkspaceID.String(), 20, func(rs []*db.WorkspaceFile) error {
 		for _, r := range rs {
+			hashes[r.Path] = fileHash(r)
+			unchanged := base != nil && base.FileHashes[r.Path] == hashes[r.Path]
+			stat.add(r, !unchanged)
+			if unchanged {
+				continue
+			}
 			filename := path.Join(rootPath, r.Path)
 			dir := path.Dir(filename)
 			p.logger.With("path", dir).DebugContext(ctx, "create dir")
@@ -161,33 +246,132 @@ func (p *ProxyUsecase) TaskHandle(ctx context.Context, task *queuerunner.Task[do
 		return err
 	}
 
-	result, err := request.Post[scan.Result](p.client, "/api/v1/scan", domain.ScanReq{
-		TaskID:    task.ID,
-		UserID:    task.Data.UserID,
-		Workspace: rootPath,
-		Language:  task.Data.Language.Rule(),
-	})
-
+	var current []*db.SecurityScanningResult
+	scanReq.Workspace = rootPath
+	switch {
+	case scanning.Language == consts.SecurityScanningLanguageAuto:
+		// 自动识别语言时每个规则集一个子扫描，结果合并到当前扫描
+		current, err = p.runSubScans(ctx, id, scanReq, stat.subScans(base == nil, len(scanReq.Rules) > 0), prefix, fileMap)
+	case base == nil || len(fileMap) > 0:
+		current, err = p.scan(scanReq, prefix, fileMap)
+	}
 	if err != nil {
-		if err = p.securityRepo.Update(ctx, id, fileMap, consts.SecurityScanningStatusFailed, &scan.Result{
-			Output: err.Error(),
-		}); err != nil {
+		if err := p.securityRepo.Update(ctx, id, consts.SecurityScanningStatusFailed, err.Error()); err != nil {
 			p.logger.With("id", task.ID).With("error", err).ErrorContext(ctx, "failed to update security scanning")
 		}
 		p.logger.With("id", task.ID).With("error", err).ErrorContext(ctx, "failed to scan")
 		return err
 	}
 
-	result.Prefix = prefix
-	if err := p.securityRepo.Update(ctx, id, fileMap, consts.SecurityScanningStatusSuccess, result); err != nil {
+	req := &domain.SaveSecurityScanningReq{
+		ID:           id,
+		FileHashes:   hashes,
+		ScannedFiles: len(fileMap),
+		Results:      diffResults(base, unchangedFiles(base, root, hashes), current),
+	}
+	if base != nil {
+		req.BaseID = &base.ID
+	}
+	if err := p.securityRepo.SaveResults(ctx, req); err != nil {
 		p.logger.With("id", task.ID).With("error", err).ErrorContext(ctx, "failed to update security scanning")
 		return err
 	}
 
-	p.logger.With("id", task.ID).DebugContext(ctx, "task done")
+	p.logger.With("id", task.ID).With("scanned", len(fileMap)).DebugContext(ctx, "task done")
 	return nil
 }
 
+// scan 调用扫描服务扫描落盘的文件
+func (p *ProxyUsecase) scan(req domain.ScanReq, prefix string, fileMap map[string]string) ([]*db.SecurityScanningResult, error) {
+	result, err := request.Post[scan.Result](p.client, "/api/v1/scan", req)
+	if err != nil {
+		return nil, err
+	}
+	result.Prefix = prefix
+	return scanResults(result, fileMap), nil
+}
+
+// runSubScans 并发执行子扫描并记录每个子扫描的进度，任一子扫描失败时返回错误
+// 语言子扫描只使用内置语言规则，自定义规则包单独扫描一次
+func (p *ProxyUsecase) runSubScans(ctx context.Context, id string, req domain.ScanReq, subs []*types.SecurityScanningSubScan, prefix string, fileMap map[string]string) ([]*db.SecurityScanningResult, error) {
+	for _, sub := range subs {
+		if sub.Status == consts.SecurityScanningStatusPending {
+			sub.Status = consts.SecurityScanningStatusRunning
+		}
+	}
+	if err := p.securityRepo.UpdateSubScans(ctx, id, subs); err != nil {
+		return nil, err
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		current []*db.SecurityScanningResult
+		failed  []string
+	)
+	for i, sub := range subs {
+		if sub.Status != consts.SecurityScanningStatusRunning {
+			continue
+		}
+		r := req
+		r.TaskID = fmt.Sprintf("%s-%d", id, i)
+		r.Language = sub.Language.Rule()
+		if sub.Language != "" {
+			r.Rules = nil
+		}
+		wg.Add(1)
+		go func(sub *types.SecurityScanningSubScan, r domain.ScanReq) {
+			defer wg.Done()
+			rs, err := p.scan(r, prefix, fileMap)
+
+			mu.Lock()
+			defer mu.Unlock()
+			sub.Status = consts.SecurityScanningStatusSuccess
+			sub.Results = len(rs)
+			if err != nil {
+				sub.Status = consts.SecurityScanningStatusFailed
+				sub.Error = err.Error()
+				failed = append(failed, cvt.ZeroWithDefault(string(sub.Language), "rules"))
+				p.logger.With("id", id).With("language", sub.Language).With("error", err).ErrorContext(ctx, "failed to run sub scan")
+			}
+			current = append(current, rs...)
+			if err := p.securityRepo.UpdateSubScans(ctx, id, subs); err != nil {
+				p.logger.With("id", id).With("error", err).WarnContext(ctx, "failed to update sub scans")
+			}
+		}(sub, r)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return nil, fmt.Errorf("sub scans failed: %s", strings.Join(failed, ", "))
+	}
+	// 不同规则集可能命中同一处代码的同一规则
+	return cvt.UniqueFn(current, func(r *db.SecurityScanningResult) string {
+		return r.Fingerprint
+	}), nil
+}
+
+// syncRules 将扫描策略启用的规则包同步到扫描服务，未使用扫描策略时返回 nil
+func (p *ProxyUsecase) syncRules(ctx context.Context, name string) (*db.SecurityScanningPolicy, []*db.SecurityRulePack, error) {
+	if name == "" {
+		return nil, nil, nil
+	}
+	policy, err := p.securityRepo.GetPolicy(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	packs, err := p.securityRepo.ActiveRulePacks(ctx, policy.RulePackIds)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, r := range rulePacks(packs) {
+		if _, err := request.Put[scan.RulePack](p.client, "/api/v1/rules", r); err != nil {
+			return nil, nil, fmt.Errorf("failed to sync rule pack %s: %w", r.Name, err)
+		}
+	}
+	return policy, packs, nil
+}
+
 func (p *ProxyUsecase) ListSecurityScanning(ctx context.Context, req *domain.ListSecurityScanningReq) (*domain.ListSecurityScanningBriefResp, error) {
 	return p.securityRepo.ListBrief(ctx, *req)
 }
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// CheckQuota implements domain.ProxyUsecase.
+// 返回 nil 表示用户没有套餐，不限制用量
+func (p *ProxyUsecase) CheckQuota(ctx context.Context, userID string) (*domain.BillingQuota, error) {
+	q, err := p.billingRepo.Quota(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if q == nil {
+		return nil, nil
+	}
+	return cvt.From(q, &domain.BillingQuota{}), nil
+}
+
+// debit 按请求实际使用的 token 数扣减额度并记录费用
+// 由请求记录的消费协程调用，扣减以 request_id 幂等，失败时返回错误由队列重试
+func (p *ProxyUsecase) debit(ctx context.Context, record *domain.RecordParam) error {
+	// 命中缓存的请求没有调用上游模型，不计费
+	if record.CacheHit || record.InputTokens+record.OutputTokens <= 0 || record.UserID == "" {
+		return nil
+	}
+	if err := p.billingRepo.Debit(ctx, record); err != nil {
+		return fmt.Errorf("debit billing quota: %w", err)
+	}
+	return nil
+}
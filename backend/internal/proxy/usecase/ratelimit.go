@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/ratelimit"
+)
+
+const (
+	rateLimitRequests = "requests"
+	rateLimitTokens   = "tokens"
+)
+
+type limitTarget struct {
+	scope consts.RateLimitScope
+	id    string
+	limit domain.RateLimit
+}
+
+type limitBucket struct {
+	target limitTarget
+	kind   string
+}
+
+func limitTargets(policy *domain.RateLimitPolicy, apiKeyID, userID string) []limitTarget {
+	targets := []limitTarget{
+		{scope: consts.RateLimitScopeAPIKey, id: apiKeyID, limit: policy.APIKey},
+		{scope: consts.RateLimitScopeUser, id: userID, limit: policy.User},
+	}
+	for id, limit := range policy.Groups {
+		targets = append(targets, limitTarget{scope: consts.RateLimitScopeGroup, id: id, limit: limit})
+	}
+	return targets
+}
+
+func (b limitBucket) bucket(limit, cost int) ratelimit.Bucket {
+	return ratelimit.Bucket{
+		Key:   fmt.Sprintf(consts.RateLimitKeyFmt, b.target.scope, b.target.id, b.kind),
+		Limit: limit,
+		Cost:  cost,
+	}
+}
+
+// CheckRateLimit implements domain.ProxyUsecase.
+// 请求数按次扣减，token 数只检查余额，实际用量在记录请求时扣减
+func (p *ProxyUsecase) CheckRateLimit(ctx context.Context, key *domain.ApiKey) (*domain.RateLimitResult, error) {
+	res := &domain.RateLimitResult{Allowed: true}
+	policy, err := p.repo.RateLimitPolicy(ctx, key.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		metas   []limitBucket
+		buckets []ratelimit.Bucket
+	)
+	for _, t := range limitTargets(policy, key.ID, key.UserID) {
+		if t.limit.RPM > 0 {
+			m := limitBucket{target: t, kind: rateLimitRequests}
+			metas = append(metas, m)
+			buckets = append(buckets, m.bucket(t.limit.RPM, 1))
+		}
+		if t.limit.TPM > 0 {
+			m := limitBucket{target: t, kind: rateLimitTokens}
+			metas = append(metas, m)
+			buckets = append(buckets, m.bucket(t.limit.TPM, 0))
+		}
+	}
+	if len(buckets) == 0 {
+		return res, nil
+	}
+
+	r, err := p.limiter.Take(ctx, buckets)
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range buckets {
+		status := &domain.RateLimitStatus{
+			Limit:     b.Limit,
+			Remaining: max(r.Remaining[i], 0),
+		}
+		status.Reset = time.Duration(b.Limit-status.Remaining) * time.Minute / time.Duration(b.Limit)
+		switch metas[i].kind {
+		case rateLimitRequests:
+			if res.Requests == nil || status.Remaining < res.Requests.Remaining {
+				res.Requests = status
+			}
+		case rateLimitTokens:
+			if res.Tokens == nil || status.Remaining < res.Tokens.Remaining {
+				res.Tokens = status
+			}
+		}
+	}
+
+	if !r.Allowed {
+		m := metas[r.Denied]
+		res.Allowed = false
+		res.Kind = m.kind
+		res.RetryAfter = r.RetryAfter
+		res.Message = fmt.Sprintf("Rate limit reached for %s %s per min: Limit %d. Please try again in %s.",
+			m.target.scope, m.kind, buckets[r.Denied].Limit, r.RetryAfter.Round(time.Millisecond))
+		p.logger.With("scope", m.target.scope).With("id", m.target.id).With("kind", m.kind).WarnContext(ctx, "rate limit exceeded")
+	}
+	return res, nil
+}
+
+// consumeTokens 按请求实际使用的 token 数扣减限流额度
+func (p *ProxyUsecase) consumeTokens(ctx context.Context, record *domain.RecordParam) {
+	tokens := int(record.InputTokens + record.OutputTokens)
+	if tokens <= 0 || record.UserID == "" {
+		return
+	}
+	policy, err := p.repo.RateLimitPolicy(ctx, record.UserID)
+	if err != nil {
+		p.logger.With("error", err).WarnContext(ctx, "failed to get rate limit policy")
+		return
+	}
+
+	var buckets []ratelimit.Bucket
+	for _, t := range limitTargets(policy, record.APIKeyID, record.UserID) {
+		if t.limit.TPM <= 0 || t.id == "" {
+			continue
+		}
+		buckets = append(buckets, limitBucket{target: t, kind: rateLimitTokens}.bucket(t.limit.TPM, tokens))
+	}
+	if err := p.limiter.Consume(ctx, buckets); err != nil {
+		p.logger.With("error", err).WarnContext(ctx, "failed to consume rate limit tokens")
+	}
+}
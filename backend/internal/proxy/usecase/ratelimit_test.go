@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/ratelimit"
+)
+
+type policyRepo struct {
+	domain.ProxyRepo
+	policy *domain.RateLimitPolicy
+}
+
+func (r *policyRepo) RateLimitPolicy(context.Context, string) (*domain.RateLimitPolicy, error) {
+	return r.policy, nil
+}
+
+func TestCheckRateLimit(t *testing.T) {
+	mr := miniredis.RunT(t)
+	policy := &domain.RateLimitPolicy{
+		APIKey: domain.RateLimit{RPM: 5},
+		User:   domain.RateLimit{RPM: 2, TPM: 100},
+		Groups: map[string]domain.RateLimit{"g1": {RPM: 10}},
+	}
+	p := &ProxyUsecase{
+		repo:    &policyRepo{policy: policy},
+		logger:  slog.Default(),
+		limiter: ratelimit.New(redis.NewClient(&redis.Options{Addr: mr.Addr()})),
+	}
+	ctx := context.Background()
+	key := &domain.ApiKey{ID: "k1", UserID: "u1"}
+
+	res, err := p.CheckRateLimit(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 返回余量最少的一项
+	if !res.Allowed || res.Requests.Limit != 2 || res.Requests.Remaining != 1 || res.Requests.Reset != 30*time.Second {
+		t.Fatalf("unexpected requests status %+v", res.Requests)
+	}
+	if res.Tokens.Limit != 100 || res.Tokens.Remaining != 100 || res.Tokens.Reset != 0 {
+		t.Fatalf("unexpected tokens status %+v", res.Tokens)
+	}
+
+	if res, _ = p.CheckRateLimit(ctx, key); !res.Allowed {
+		t.Fatalf("second request should be allowed, got %+v", res)
+	}
+	res, err = p.CheckRateLimit(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Allowed || res.Kind != rateLimitRequests || res.Requests.Remaining != 0 || res.RetryAfter <= 0 {
+		t.Fatalf("third request should be denied, got %+v", res)
+	}
+	if !strings.Contains(res.Message, "user requests per min: Limit 2") {
+		t.Errorf("unexpected message %q", res.Message)
+	}
+
+	// 请求结束后按实际用量扣减 token，余额不足时拒绝
+	mr.FlushAll()
+	p.consumeTokens(ctx, &domain.RecordParam{UserID: "u1", APIKeyID: "k1", InputTokens: 80, OutputTokens: 40})
+	res, err = p.CheckRateLimit(ctx, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Allowed || res.Kind != rateLimitTokens || res.Tokens.Remaining != 0 {
+		t.Fatalf("tokens should be exhausted, got %+v", res)
+	}
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/streamqueue"
+)
+
+// newRecordQueue 创建请求记录队列，记录先写入 Redis Stream，再由消费协程写入数据库
+func (p *ProxyUsecase) newRecordQueue() *streamqueue.Queue[domain.RecordParam] {
+	c := p.cfg.LLMProxy.RecordQueue
+	return streamqueue.New[domain.RecordParam](p.redis, streamqueue.Config{
+		Stream:      consts.RecordStream,
+		Group:       consts.RecordGroup,
+		Workers:     c.Workers,
+		MaxAttempts: c.MaxAttempts,
+		Backoff:     time.Duration(c.Backoff) * time.Second,
+		MaxBackoff:  time.Duration(c.MaxBackoff) * time.Second,
+		DeadMaxLen:  int64(c.DeadMaxLen),
+	}, p.logger)
+}
+
+// Record implements domain.ProxyUsecase.
+func (p *ProxyUsecase) Record(ctx context.Context, record *domain.RecordParam) error {
+	// 命中缓存的请求没有调用上游模型，不占用限流额度
+	if !record.CacheHit {
+		p.consumeTokens(ctx, record)
+	}
+	if err := p.records.Publish(ctx, record); err != nil {
+		// Redis 不可用时直接写入数据库，避免丢失记录
+		p.logger.With("fn", "Record").With("task_id", record.TaskID).With("error", err).WarnContext(ctx, "publish record failed, write directly")
+		return p.saveRecord(ctx, record)
+	}
+	return nil
+}
+
+// saveRecord 消费请求记录，扣减额度后写入数据库
+// 队列至少投递一次，扣减以 request_id 幂等，先扣减再写入保证重试时不会漏扣或多扣
+func (p *ProxyUsecase) saveRecord(ctx context.Context, record *domain.RecordParam) error {
+	if record.UserID == "" || record.ModelID == "" {
+		return streamqueue.Permanent(fmt.Errorf("invalid record: user_id %q model_id %q", record.UserID, record.ModelID))
+	}
+	// 没有 task_id 的请求记录为一个任务，仍需扣减额度
+	if record.TaskID == "" {
+		record.TaskID = record.RequestID
+	}
+	if err := p.debit(ctx, record); err != nil {
+		return err
+	}
+	return p.repo.Record(ctx, record)
+}
+
+// RecordQueueStat implements domain.ProxyUsecase.
+func (p *ProxyUsecase) RecordQueueStat(ctx context.Context) (*domain.RecordQueueStat, error) {
+	s, err := p.records.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.RecordQueueStat{
+		Length:        s.Length,
+		Pending:       s.Pending,
+		Lag:           s.Lag,
+		Dead:          s.Dead,
+		Consumers:     s.Consumers,
+		Published:     s.Published,
+		Processed:     s.Processed,
+		Failed:        s.Failed,
+		DeadLettered:  s.DeadLettered,
+		PublishFailed: s.PublishFailed,
+	}, nil
+}
+
+// ListDeadRecords implements domain.ProxyUsecase.
+func (p *ProxyUsecase) ListDeadRecords(ctx context.Context, limit int64) ([]*domain.DeadRecord, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	letters, err := p.records.DeadLetters(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*domain.DeadRecord, 0, len(letters))
+	for _, l := range letters {
+		r := &domain.DeadRecord{
+			ID:       l.ID,
+			SourceID: l.SourceID,
+			Attempts: l.Attempts,
+			Error:    l.Error,
+			DeadAt:   l.DeadAt.Unix(),
+		}
+		if l.Data != nil {
+			r.TaskID = l.Data.TaskID
+			r.RequestID = l.Data.RequestID
+			r.UserID = l.Data.UserID
+			r.ModelID = l.Data.ModelID
+			r.ModelType = l.Data.ModelType
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// RetryDeadRecord implements domain.ProxyUsecase.
+func (p *ProxyUsecase) RetryDeadRecord(ctx context.Context, id string) error {
+	return p.records.Retry(ctx, id)
+}
+
+// Archive implements domain.ProxyUsecase.
+func (p *ProxyUsecase) Archive(ctx context.Context, record *domain.AuditRecord) error {
+	return p.auditRepo.Save(ctx, record)
+}
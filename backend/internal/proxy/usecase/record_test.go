@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/streamqueue"
+)
+
+type recordRepo struct {
+	domain.ProxyRepo
+	records []string
+}
+
+func (r *recordRepo) Record(_ context.Context, record *domain.RecordParam) error {
+	r.records = append(r.records, record.RequestID)
+	return nil
+}
+
+type debitRepo struct {
+	domain.BillingRepo
+	err    error
+	debits []string
+}
+
+func (r *debitRepo) Debit(_ context.Context, record *domain.RecordParam) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.debits = append(r.debits, record.RequestID)
+	return nil
+}
+
+func TestSaveRecord(t *testing.T) {
+	repo := &recordRepo{}
+	billing := &debitRepo{err: errors.New("db down")}
+	p := &ProxyUsecase{repo: repo, billingRepo: billing}
+	record := &domain.RecordParam{
+		TaskID:       "t1",
+		RequestID:    "r1",
+		UserID:       "u1",
+		ModelID:      "m1",
+		InputTokens:  10,
+		OutputTokens: 5,
+	}
+
+	// 扣减失败时返回错误由队列重试，且不写入记录
+	if err := p.saveRecord(context.Background(), record); err == nil {
+		t.Fatal("expected debit error")
+	}
+	if len(repo.records) != 0 {
+		t.Errorf("record written before debit: %v", repo.records)
+	}
+
+	billing.err = nil
+	if err := p.saveRecord(context.Background(), record); err != nil {
+		t.Fatal(err)
+	}
+	if len(billing.debits) != 1 || len(repo.records) != 1 {
+		t.Errorf("unexpected debits %v records %v", billing.debits, repo.records)
+	}
+
+	// 命中缓存的请求只写入记录，不计费
+	hit := *record
+	hit.RequestID = "r2"
+	hit.CacheHit = true
+	if err := p.saveRecord(context.Background(), &hit); err != nil {
+		t.Fatal(err)
+	}
+	if len(billing.debits) != 1 || len(repo.records) != 2 {
+		t.Errorf("unexpected debits %v records %v", billing.debits, repo.records)
+	}
+
+	// 没有 task_id 的请求按 request_id 记录并扣减
+	noTask := *record
+	noTask.RequestID = "r3"
+	noTask.TaskID = ""
+	if err := p.saveRecord(context.Background(), &noTask); err != nil {
+		t.Fatal(err)
+	}
+	if len(billing.debits) != 2 || len(repo.records) != 3 || noTask.TaskID != "r3" {
+		t.Errorf("unexpected debits %v records %v task %q", billing.debits, repo.records, noTask.TaskID)
+	}
+
+	invalid := &domain.RecordParam{TaskID: "t2", UserID: "u1"}
+	if err := p.saveRecord(context.Background(), invalid); !streamqueue.IsPermanent(err) {
+		t.Errorf("expected permanent error, got %v", err)
+	}
+}
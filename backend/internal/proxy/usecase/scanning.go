@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+// loginFingerprint 扫描引擎未登录时返回的占位指纹，不能用于区分结果
+const loginFingerprint = "requires login"
+
+// fileHash 工作区文件的哈希，上传时未计算哈希则按内容计算
+func fileHash(f *db.WorkspaceFile) string {
+	if f.Hash != "" {
+		return f.Hash
+	}
+	h := sha256.Sum256([]byte(f.Content))
+	return hex.EncodeToString(h[:])
+}
+
+// fingerprint 扫描结果的指纹，优先使用扫描引擎的指纹
+// 否则按规则、文件和命中的代码计算，不包含行号，代码移动后仍能对应到同一个结果
+func fingerprint(r *db.SecurityScanningResult, engine string) string {
+	if engine != "" && engine != loginFingerprint {
+		return engine
+	}
+	return scan.Fingerprint(r.CheckID, r.Path, r.Lines)
+}
+
+// scanResults 将扫描引擎的结果转换为扫描结果，路径去掉落盘目录前缀
+func scanResults(result *scan.Result, fileMap map[string]string) []*db.SecurityScanningResult {
+	return cvt.Iter(result.Results, func(_ int, item *scan.ResultItem) *db.SecurityScanningResult {
+		r := &db.SecurityScanningResult{
+			CheckID:     item.CheckID,
+			EngineKind:  item.Extra.EngineKind,
+			Lines:       item.Extra.Lines,
+			Message:     item.Extra.Message,
+			MessageZh:   item.Extra.Metadata.MessageZh,
+			Severity:    item.Extra.Severity,
+			AbstractEn:  item.Extra.Metadata.AbstractFeysh["en-US"],
+			AbstractZh:  item.Extra.Metadata.AbstractFeysh["zh-CN"],
+			CategoryEn:  item.Extra.Metadata.CategoryFeysh["en-US"],
+			CategoryZh:  item.Extra.Metadata.CategoryFeysh["zh-CN"],
+			Confidence:  item.Extra.Metadata.Confidence,
+			Cwe:         []any{item.Extra.Metadata.Cwe},
+			Impact:      item.Extra.Metadata.Impact,
+			Owasp:       []any{item.Extra.Metadata.Owasp},
+			Path:        strings.ReplaceAll(item.Path, result.Prefix, ""),
+			FileContent: fileMap[item.Path],
+			StartPosition: &types.Position{
+				Col:    item.Start.Col,
+				Line:   item.Start.Line,
+				Offset: item.Start.Offset,
+			},
+			EndPosition: &types.Position{
+				Col:    item.End.Col,
+				Line:   item.End.Line,
+				Offset: item.End.Offset,
+			},
+		}
+		r.Fingerprint = fingerprint(r, item.Extra.Fingerprint)
+		return r
+	})
+}
+
+// unchangedFiles 与上一次扫描相比内容未变化的文件，key 为结果中的文件路径
+func unchangedFiles(base *db.SecurityScanning, root string, hashes map[string]string) map[string]bool {
+	m := make(map[string]bool)
+	if base == nil {
+		return m
+	}
+	for rel, h := range hashes {
+		if base.FileHashes[rel] == h {
+			m[path.Join(root, rel)] = true
+		}
+	}
+	return m
+}
+
+// diffResults 对比上一次扫描标记结果的状态，仍然存在的发现沿用上一次的处置
+// 未变化文件的结果沿用上一次扫描，重新扫描或已删除的文件中不再出现的结果标记为已修复
+func diffResults(base *db.SecurityScanning, unchanged map[string]bool, current []*db.SecurityScanningResult) []*db.SecurityScanningResult {
+	rs := make([]*db.SecurityScanningResult, 0, len(current))
+	if base == nil {
+		for _, r := range current {
+			r.State = consts.SecurityScanningFindingNew
+			rs = append(rs, r)
+		}
+		return rs
+	}
+
+	prev := make(map[string]*db.SecurityScanningResult)
+	for _, r := range base.Edges.Results {
+		prev[fingerprint(r, r.Fingerprint)] = r
+	}
+	seen := make(map[string]bool)
+	for _, r := range current {
+		r.State = consts.SecurityScanningFindingNew
+		if p, ok := prev[r.Fingerprint]; ok {
+			r.State = consts.SecurityScanningFindingPersisting
+			r.TriageStatus = p.TriageStatus
+			r.AssigneeID = p.AssigneeID
+			r.RiskExpiresAt = p.RiskExpiresAt
+			r.SuppressionID = p.SuppressionID
+		}
+		seen[r.Fingerprint] = true
+		rs = append(rs, r)
+	}
+
+	for _, r := range base.Edges.Results {
+		c := *r
+		c.Fingerprint = fingerprint(r, r.Fingerprint)
+		switch {
+		case unchanged[r.Path]:
+			c.State = consts.SecurityScanningFindingPersisting
+		case !seen[c.Fingerprint]:
+			c.State = consts.SecurityScanningFindingFixed
+		default:
+			continue
+		}
+		rs = append(rs, &c)
+	}
+	return rs
+}
+
+// rulePacks 扫描策略使用的规则包版本，同步到扫描服务时携带内容
+func rulePacks(packs []*db.SecurityRulePack) []*scan.RulePack {
+	rs := make([]*scan.RulePack, 0, len(packs))
+	for _, p := range packs {
+		for _, v := range p.Edges.Versions {
+			rs = append(rs, &scan.RulePack{
+				Name:     p.Name,
+				Version:  v.Version,
+				Checksum: v.Checksum,
+				Content:  v.Content,
+			})
+		}
+	}
+	return rs
+}
+
+// rulesChanged 上一次扫描后扫描策略或规则包有变化时，未变化文件的结果不能沿用
+// packs 为策略引用的全部规则包，停用规则包也会更新 UpdatedAt
+func rulesChanged(base *db.SecurityScanning, policy *db.SecurityScanningPolicy, packs []*db.SecurityRulePack) bool {
+	if base == nil || policy == nil {
+		return false
+	}
+	if policy.UpdatedAt.After(base.CreatedAt) {
+		return true
+	}
+	for _, p := range packs {
+		if p.UpdatedAt.After(base.CreatedAt) {
+			return true
+		}
+	}
+	return false
+}
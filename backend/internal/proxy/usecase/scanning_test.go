@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+func TestScanResults(t *testing.T) {
+	item := func(path, lines, fp string) *scan.ResultItem {
+		return &scan.ResultItem{
+			CheckID: "go.sqli",
+			Path:    path,
+			Extra:   scan.Extra{Lines: lines, Fingerprint: fp},
+		}
+	}
+	prefix := "/app/static/codes/1"
+	result := &scan.Result{Prefix: prefix, Results: []*scan.ResultItem{
+		item(prefix+"/src/a.go", "db.Query(q)", "engine-fp"),
+		item(prefix+"/src/a.go", "db.Query(q)", loginFingerprint),
+		item(prefix+"/src/a.go", "\tdb.Query(q)  \n", ""),
+	}}
+	rs := scanResults(result, map[string]string{prefix + "/src/a.go": "package a"})
+	if rs[0].Path != "/src/a.go" || rs[0].FileContent != "package a" || rs[0].Fingerprint != "engine-fp" {
+		t.Errorf("unexpected result %+v", rs[0])
+	}
+	// 未登录的占位指纹按内容计算，空白不同不影响指纹
+	if rs[1].Fingerprint == loginFingerprint || rs[1].Fingerprint != rs[2].Fingerprint {
+		t.Errorf("unexpected fingerprint %q %q", rs[1].Fingerprint, rs[2].Fingerprint)
+	}
+}
+
+func TestDiffResults(t *testing.T) {
+	result := func(path, fp string) *db.SecurityScanningResult {
+		return &db.SecurityScanningResult{CheckID: "go.sqli", Path: path, Fingerprint: fp}
+	}
+	hashes := map[string]string{"a.go": "1", "b.go": "2", "c.go": "3"}
+	// 仍然存在的发现沿用上一次的处置
+	assignee := uuid.New()
+	triaged := result("/w/b.go", "b1")
+	triaged.TriageStatus = consts.SecurityScanningTriageConfirmed
+	triaged.AssigneeID = &assignee
+	base := &db.SecurityScanning{
+		FileHashes: map[string]string{"a.go": "1", "b.go": "1", "d.go": "1"},
+		Edges: db.SecurityScanningEdges{Results: []*db.SecurityScanningResult{
+			result("/w/a.go", "a1"),
+			triaged,
+			result("/w/b.go", "b2"),
+			result("/w/d.go", "d1"),
+		}},
+	}
+	unchanged := unchangedFiles(base, "/w", hashes)
+	if len(unchanged) != 1 || !unchanged["/w/a.go"] {
+		t.Fatalf("unexpected unchanged files %v", unchanged)
+	}
+
+	rs := diffResults(base, unchanged, []*db.SecurityScanningResult{
+		result("/w/b.go", "b1"),
+		result("/w/c.go", "c1"),
+	})
+	got := make(map[string]consts.SecurityScanningFindingState)
+	for _, r := range rs {
+		got[r.Fingerprint] = r.State
+	}
+	want := map[string]consts.SecurityScanningFindingState{
+		"b1": consts.SecurityScanningFindingPersisting,
+		"c1": consts.SecurityScanningFindingNew,
+		"a1": consts.SecurityScanningFindingPersisting,
+		"b2": consts.SecurityScanningFindingFixed,
+		"d1": consts.SecurityScanningFindingFixed,
+	}
+	if len(rs) != len(want) {
+		t.Fatalf("expect %d results, got %d", len(want), len(rs))
+	}
+	for fp, s := range want {
+		if got[fp] != s {
+			t.Errorf("%s: expect %q, got %q", fp, s, got[fp])
+		}
+	}
+	for _, r := range rs {
+		if r.Fingerprint == "b1" && (r.TriageStatus != consts.SecurityScanningTriageConfirmed || r.AssigneeID != &assignee) {
+			t.Errorf("triage not carried over %+v", r)
+		}
+	}
+	if base.Edges.Results[0].State != "" {
+		t.Error("base results should not be modified")
+	}
+
+	// 没有上一次扫描时全部为新发现
+	for _, r := range diffResults(nil, nil, []*db.SecurityScanningResult{result("/w/a.go", "a1")}) {
+		if r.State != consts.SecurityScanningFindingNew {
+			t.Errorf("unexpected state %q", r.State)
+		}
+	}
+}
+
+func TestRulesChanged(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	base := &db.SecurityScanning{CreatedAt: now}
+	policy := &db.SecurityScanningPolicy{UpdatedAt: now.Add(-time.Hour)}
+	pack := &db.SecurityRulePack{
+		Name:      "org-go",
+		UpdatedAt: now.Add(-time.Hour),
+		Edges: db.SecurityRulePackEdges{Versions: []*db.SecurityRulePackVersion{
+			{Version: 3, Checksum: "sum", Content: "rules: []"},
+		}},
+	}
+	packs := []*db.SecurityRulePack{pack}
+
+	rs := rulePacks(packs)
+	if len(rs) != 1 || rs[0].Name != "org-go" || rs[0].Version != 3 || rs[0].Content != "rules: []" {
+		t.Fatalf("unexpected rule packs %+v", rs)
+	}
+	if rulesChanged(nil, policy, packs) || rulesChanged(base, nil, nil) {
+		t.Error("expect unchanged without base or policy")
+	}
+	if rulesChanged(base, policy, packs) {
+		t.Error("expect unchanged rules")
+	}
+	pack.UpdatedAt = now.Add(time.Minute)
+	if !rulesChanged(base, policy, packs) {
+		t.Error("expect changed rule pack")
+	}
+	pack.UpdatedAt = now.Add(-time.Hour)
+	policy.UpdatedAt = now.Add(time.Minute)
+	if !rulesChanged(base, policy, packs) {
+		t.Error("expect changed policy")
+	}
+
+	// 停用的规则包不再参与扫描，上一次扫描中该规则包的结果不能沿用
+	policy.UpdatedAt = now.Add(-time.Hour)
+	disabled := &db.SecurityRulePack{Name: "org-java", Enabled: false, UpdatedAt: now.Add(time.Minute)}
+	if !rulesChanged(base, policy, []*db.SecurityRulePack{pack, disabled}) {
+		t.Error("expect changed for disabled rule pack")
+	}
+}
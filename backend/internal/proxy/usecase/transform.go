@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// loadTransform 从数据库加载最新的改写策略
+func (p *ProxyUsecase) loadTransform(ctx context.Context) (*domain.TransformPolicy, error) {
+	e, err := p.modelRepo.LatestTransformPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy := cvt.From(e, &domain.TransformPolicy{})
+	p.transformMu.Lock()
+	p.transform = policy
+	p.transformMu.Unlock()
+	return policy, nil
+}
+
+// currentTransform 获取缓存的改写策略，首次访问时从数据库加载
+func (p *ProxyUsecase) currentTransform(ctx context.Context) (*domain.TransformPolicy, error) {
+	p.transformMu.RLock()
+	policy := p.transform
+	p.transformMu.RUnlock()
+	if policy != nil {
+		return policy, nil
+	}
+	return p.loadTransform(ctx)
+}
+
+// reloadTransform 改写规则变更后刷新缓存的策略
+func (p *ProxyUsecase) reloadTransform(ctx context.Context) {
+	if _, err := p.loadTransform(ctx); err != nil {
+		p.logger.With("error", err).WarnContext(ctx, "failed to reload transform policy")
+	}
+}
+
+// MatchTransform 获取请求适用的改写规则，按优先级从高到低排列
+// 没有匹配的规则时返回 nil
+func (p *ProxyUsecase) MatchTransform(ctx context.Context, modelID, userID string) (*domain.TransformPolicy, error) {
+	policy, err := p.currentTransform(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []string
+	var matched []*types.TransformRule
+	for _, r := range policy.Rules {
+		if !r.Enabled || r.Config == nil || (r.ModelID != "" && r.ModelID != modelID) {
+			continue
+		}
+		if r.GroupID != "" {
+			if groups == nil {
+				if groups, err = p.repo.UserGroupIDs(ctx, userID); err != nil {
+					return nil, err
+				}
+			}
+			if !slices.Contains(groups, r.GroupID) {
+				continue
+			}
+		}
+		matched = append(matched, r)
+	}
+	if len(matched) == 0 {
+		return nil, nil
+	}
+	return &domain.TransformPolicy{Version: policy.Version, Rules: matched}, nil
+}
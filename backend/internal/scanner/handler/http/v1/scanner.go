@@ -21,12 +21,28 @@ func NewScannerHandler(w *web.Web, logger *slog.Logger) *ScannerHandler {
 	}
 
 	w.POST("/api/v1/scan", web.BindHandler(s.Scan))
+	w.PUT("/api/v1/rules", web.BindHandler(s.SyncRules))
 
 	return s
 }
 
 func (s *ScannerHandler) Scan(ctx *web.Context, req domain.ScanReq) error {
-	result, err := scan.Scan(req.TaskID, req.Workspace, req.Language)
+	opts := &scan.Options{
+		Confidence: req.Confidence,
+		Impact:     req.Impact,
+	}
+	if req.Language != "" {
+		opts.Configs = append(opts.Configs, req.Language)
+	}
+	for _, r := range req.Rules {
+		filename, err := scan.RulePackFile(scan.RuleDir, r)
+		if err != nil {
+			return fmt.Errorf("rule pack %s v%d not synced: %w", r.Name, r.Version, err)
+		}
+		opts.Configs = append(opts.Configs, filename)
+	}
+
+	result, err := scan.Scan(req.TaskID, req.Workspace, opts)
 	if err != nil {
 		s.logger.With("id", req.TaskID).With("error", err).ErrorContext(ctx.Request().Context(), "failed to scan")
 		return fmt.Errorf("failed to scan: %w", err)
@@ -34,3 +50,16 @@ func (s *ScannerHandler) Scan(ctx *web.Context, req domain.ScanReq) error {
 	s.logger.With("id", req.TaskID).InfoContext(ctx.Request().Context(), "task done")
 	return ctx.JSON(http.StatusOK, result)
 }
+
+// SyncRules 保存服务端同步的自定义规则包
+func (s *ScannerHandler) SyncRules(ctx *web.Context, req scan.RulePack) error {
+	if err := scan.WriteRulePack(scan.RuleDir, &req); err != nil {
+		s.logger.With("name", req.Name).With("version", req.Version).With("error", err).ErrorContext(ctx.Request().Context(), "failed to sync rule pack")
+		return fmt.Errorf("failed to sync rule pack: %w", err)
+	}
+	return ctx.JSON(http.StatusOK, &scan.RulePack{
+		Name:     req.Name,
+		Version:  req.Version,
+		Checksum: req.Checksum,
+	})
+}
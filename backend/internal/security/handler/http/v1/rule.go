@@ -0,0 +1,206 @@
+package v1
+
+import (
+	"github.com/GoYoko/web"
+
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/internal/middleware"
+)
+
+// ListRulePacks 规则包列表
+//
+//	@Tags			Security Scanning
+//	@Summary		规则包列表
+//	@Description	获取自定义扫描规则包列表
+//	@ID				security-scanning-list-rule-packs
+//	@Accept			json
+//	@Produce		json
+//	@Success		200		{object}	web.Resp{data=[]domain.SecurityRulePack}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/rules [get]
+func (s *SecurityHandler) ListRulePacks(c *web.Context) error {
+	resp, err := s.usecase.ListRulePacks(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// CreateRulePack 创建规则包
+//
+//	@Tags			Security Scanning
+//	@Summary		创建规则包
+//	@Description	上传 semgrep 格式的 YAML 规则创建规则包，内容作为第一个版本
+//	@ID				security-scanning-create-rule-pack
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.CreateSecurityRulePackReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityRulePack}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/rules [post]
+func (s *SecurityHandler) CreateRulePack(c *web.Context, req domain.CreateSecurityRulePackReq) error {
+	req.Admin = middleware.GetAdmin(c).Username
+	resp, err := s.usecase.CreateRulePack(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// UpdateRulePack 更新规则包
+//
+//	@Tags			Security Scanning
+//	@Summary		更新规则包
+//	@Description	修改规则包的描述、启用状态和扫描使用的版本
+//	@ID				security-scanning-update-rule-pack
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateSecurityRulePackReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityRulePack}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/rules [put]
+func (s *SecurityHandler) UpdateRulePack(c *web.Context, req domain.UpdateSecurityRulePackReq) error {
+	resp, err := s.usecase.UpdateRulePack(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// DeleteRulePack 删除规则包
+//
+//	@Tags			Security Scanning
+//	@Summary		删除规则包
+//	@Description	删除规则包及其所有版本，被扫描策略引用时不能删除
+//	@ID				security-scanning-delete-rule-pack
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.SecurityRulePackIDReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/rules [delete]
+func (s *SecurityHandler) DeleteRulePack(c *web.Context, req domain.SecurityRulePackIDReq) error {
+	if err := s.usecase.DeleteRulePack(c.Request().Context(), req.ID); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
+// ListRulePackVersions 规则包版本列表
+//
+//	@Tags			Security Scanning
+//	@Summary		规则包版本列表
+//	@Description	获取规则包的所有版本
+//	@ID				security-scanning-list-rule-pack-versions
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.SecurityRulePackIDReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=[]domain.SecurityRulePackVersion}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/rules/versions [get]
+func (s *SecurityHandler) ListRulePackVersions(c *web.Context, req domain.SecurityRulePackIDReq) error {
+	resp, err := s.usecase.ListRulePackVersions(c.Request().Context(), req.ID)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// UploadRulePackVersion 上传规则包版本
+//
+//	@Tags			Security Scanning
+//	@Summary		上传规则包版本
+//	@Description	上传规则包的新版本，可选择立即用于扫描
+//	@ID				security-scanning-upload-rule-pack-version
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UploadSecurityRulePackReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityRulePackVersion}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/rules/versions [post]
+func (s *SecurityHandler) UploadRulePackVersion(c *web.Context, req domain.UploadSecurityRulePackReq) error {
+	req.Admin = middleware.GetAdmin(c).Username
+	resp, err := s.usecase.UploadRulePackVersion(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// ListPolicies 扫描策略列表
+//
+//	@Tags			Security Scanning
+//	@Summary		扫描策略列表
+//	@Description	获取扫描策略列表
+//	@ID				security-scanning-list-policies
+//	@Accept			json
+//	@Produce		json
+//	@Success		200		{object}	web.Resp{data=[]domain.SecurityScanningPolicy}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/policies [get]
+func (s *SecurityHandler) ListPolicies(c *web.Context) error {
+	resp, err := s.usecase.ListPolicies(c.Request().Context())
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// CreatePolicy 创建扫描策略
+//
+//	@Tags			Security Scanning
+//	@Summary		创建扫描策略
+//	@Description	创建扫描策略，扫描请求可以按名称引用
+//	@ID				security-scanning-create-policy
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.CreateSecurityScanningPolicyReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityScanningPolicy}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/policies [post]
+func (s *SecurityHandler) CreatePolicy(c *web.Context, req domain.CreateSecurityScanningPolicyReq) error {
+	resp, err := s.usecase.CreatePolicy(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// UpdatePolicy 更新扫描策略
+//
+//	@Tags			Security Scanning
+//	@Summary		更新扫描策略
+//	@Description	更新扫描策略
+//	@ID				security-scanning-update-policy
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateSecurityScanningPolicyReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityScanningPolicy}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/policies [put]
+func (s *SecurityHandler) UpdatePolicy(c *web.Context, req domain.UpdateSecurityScanningPolicyReq) error {
+	resp, err := s.usecase.UpdatePolicy(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// DeletePolicy 删除扫描策略
+//
+//	@Tags			Security Scanning
+//	@Summary		删除扫描策略
+//	@Description	删除扫描策略
+//	@ID				security-scanning-delete-policy
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.SecurityScanningPolicyIDReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/policies [delete]
+func (s *SecurityHandler) DeletePolicy(c *web.Context, req domain.SecurityScanningPolicyIDReq) error {
+	if err := s.usecase.DeletePolicy(c.Request().Context(), req.ID); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
@@ -1,12 +1,20 @@
 package v1
 
 import (
+	"fmt"
+	"io"
+	"net/http"
+
 	"github.com/GoYoko/web"
 
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/internal/middleware"
+	"github.com/chaitin/MonkeyCode/backend/pkg/sarif"
 )
 
+// maxSARIFSize 导入的 SARIF 文件大小上限
+const maxSARIFSize = 64 << 20
+
 type SecurityHandler struct {
 	usecase domain.SecurityScanningUsecase
 }
@@ -26,6 +34,24 @@ func NewSecurityHandler(
 
 	g.GET("", web.BindHandler(s.List))
 	g.GET("/detail", web.BaseHandler(s.Detail))
+	g.GET("/sarif", web.BaseHandler(s.ExportSARIF))
+	g.GET("/workspace/sarif", web.BaseHandler(s.ExportWorkspaceSARIF))
+	g.POST("/sarif", web.BaseHandler(s.ImportSARIF))
+	g.PUT("/triage", web.BindHandler(s.Triage))
+	g.GET("/comments", web.BaseHandler(s.ListComments))
+	g.GET("/suppressions", web.BindHandler(s.ListSuppressions, web.WithPage()))
+	g.POST("/suppressions", web.BindHandler(s.CreateSuppression))
+	g.DELETE("/suppressions", web.BindHandler(s.DeleteSuppression))
+	g.GET("/rules", web.BaseHandler(s.ListRulePacks))
+	g.POST("/rules", web.BindHandler(s.CreateRulePack))
+	g.PUT("/rules", web.BindHandler(s.UpdateRulePack))
+	g.DELETE("/rules", web.BindHandler(s.DeleteRulePack))
+	g.GET("/rules/versions", web.BindHandler(s.ListRulePackVersions))
+	g.POST("/rules/versions", web.BindHandler(s.UploadRulePackVersion))
+	g.GET("/policies", web.BaseHandler(s.ListPolicies))
+	g.POST("/policies", web.BindHandler(s.CreatePolicy))
+	g.PUT("/policies", web.BindHandler(s.UpdatePolicy))
+	g.DELETE("/policies", web.BindHandler(s.DeletePolicy))
 
 	return s
 }
@@ -69,3 +95,184 @@ func (s *SecurityHandler) Detail(c *web.Context) error {
 	}
 	return c.Success(resp)
 }
+
+// ExportSARIF 导出扫描结果为 SARIF
+//
+//	@Tags			Security Scanning
+//	@Summary		导出扫描结果为 SARIF
+//	@Description	导出一次扫描的结果为 SARIF 2.1.0，供代码扫描平台导入
+//	@ID				security-scanning-export-sarif
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"扫描任务id"
+//	@Success		200	{object}	sarif.Log
+//	@Failure		401	{object}	string
+//	@Router			/api/v1/security/scanning/sarif [get]
+func (s *SecurityHandler) ExportSARIF(c *web.Context) error {
+	id := c.QueryParam("id")
+	l, err := s.usecase.ExportSARIF(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+	return sarifAttachment(c, fmt.Sprintf("monkeycode-scan-%s.sarif", id), l)
+}
+
+// ExportWorkspaceSARIF 导出工作区的扫描结果为 SARIF
+//
+//	@Tags			Security Scanning
+//	@Summary		导出工作区的扫描结果为 SARIF
+//	@Description	导出工作区每种扫描最近一次成功的结果，每次扫描为一个 run
+//	@ID				security-scanning-export-workspace-sarif
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspace_id	query		string	true	"工作区id"
+//	@Success		200				{object}	sarif.Log
+//	@Failure		401				{object}	string
+//	@Router			/api/v1/security/scanning/workspace/sarif [get]
+func (s *SecurityHandler) ExportWorkspaceSARIF(c *web.Context) error {
+	id := c.QueryParam("workspace_id")
+	l, err := s.usecase.ExportWorkspaceSARIF(c.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+	return sarifAttachment(c, fmt.Sprintf("monkeycode-workspace-%s.sarif", id), l)
+}
+
+// ImportSARIF 导入 SARIF 扫描结果
+//
+//	@Tags			Security Scanning
+//	@Summary		导入 SARIF 扫描结果
+//	@Description	导入外部扫描工具的 SARIF 2.1.0 结果，每个 run 创建一个扫描任务，相对路径按工作区根目录解析
+//	@ID				security-scanning-import-sarif
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspace_id	query		string		true	"工作区id"
+//	@Param			param			body		sarif.Log	true	"SARIF 日志"
+//	@Success		200				{object}	web.Resp{data=domain.ImportSARIFResp}
+//	@Failure		401				{object}	string
+//	@Router			/api/v1/security/scanning/sarif [post]
+func (s *SecurityHandler) ImportSARIF(c *web.Context) error {
+	l, err := sarif.Parse(io.LimitReader(c.Request().Body, maxSARIFSize))
+	if err != nil {
+		return err
+	}
+	resp, err := s.usecase.ImportSARIF(c.Request().Context(), &domain.ImportSARIFReq{
+		WorkspaceID: c.QueryParam("workspace_id"),
+		Log:         l,
+	})
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// Triage 处置扫描发现
+//
+//	@Tags			Security Scanning
+//	@Summary		处置扫描发现
+//	@Description	修改发现的处置状态、处理人并添加评论，标记为误报或接受风险时之后扫描出的相同发现同样被屏蔽
+//	@ID				security-scanning-triage
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.TriageSecurityScanningReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityScanningRiskDetail}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/triage [put]
+func (s *SecurityHandler) Triage(c *web.Context, req domain.TriageSecurityScanningReq) error {
+	admin := middleware.GetAdmin(c)
+	req.OperatorID = admin.ID.String()
+	req.Operator = admin.Username
+	resp, err := s.usecase.Triage(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// ListComments 获取扫描发现的评论
+//
+//	@Tags			Security Scanning
+//	@Summary		获取扫描发现的评论
+//	@Description	获取扫描发现的处置记录和评论
+//	@ID				security-scanning-list-comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"发现id"
+//	@Success		200	{object}	web.Resp{data=[]domain.SecurityScanningComment}
+//	@Failure		401	{object}	string
+//	@Router			/api/v1/security/scanning/comments [get]
+func (s *SecurityHandler) ListComments(c *web.Context) error {
+	resp, err := s.usecase.ListComments(c.Request().Context(), "", c.QueryParam("id"))
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// ListSuppressions 获取屏蔽规则
+//
+//	@Tags			Security Scanning
+//	@Summary		获取屏蔽规则
+//	@Description	获取屏蔽规则，指定工作区时包含对所有工作区生效的规则
+//	@ID				security-scanning-list-suppressions
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.ListSecurityScanningSuppressionReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.ListSecurityScanningSuppressionResp}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/suppressions [get]
+func (s *SecurityHandler) ListSuppressions(c *web.Context, req domain.ListSecurityScanningSuppressionReq) error {
+	req.Pagination = c.Page()
+	resp, err := s.usecase.ListSuppressions(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// CreateSuppression 创建屏蔽规则
+//
+//	@Tags			Security Scanning
+//	@Summary		创建屏蔽规则
+//	@Description	按指纹或规则屏蔽扫描发现，已有的待处理发现同时被屏蔽
+//	@ID				security-scanning-create-suppression
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.CreateSecurityScanningSuppressionReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityScanningSuppression}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/suppressions [post]
+func (s *SecurityHandler) CreateSuppression(c *web.Context, req domain.CreateSecurityScanningSuppressionReq) error {
+	admin := middleware.GetAdmin(c)
+	req.AdminID = admin.ID.String()
+	req.Admin = admin.Username
+	resp, err := s.usecase.CreateSuppression(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// DeleteSuppression 删除屏蔽规则
+//
+//	@Tags			Security Scanning
+//	@Summary		删除屏蔽规则
+//	@Description	删除屏蔽规则，被屏蔽的发现恢复为待处理
+//	@ID				security-scanning-delete-suppression
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	query		domain.DeleteSecurityScanningSuppressionReq	true	"参数"
+//	@Success		200		{object}	web.Resp{}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/security/scanning/suppressions [delete]
+func (s *SecurityHandler) DeleteSuppression(c *web.Context, req domain.DeleteSecurityScanningSuppressionReq) error {
+	if err := s.usecase.DeleteSuppression(c.Request().Context(), req.ID); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
+func sarifAttachment(c *web.Context, filename string, l *sarif.Log) error {
+	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	return c.JSON(http.StatusOK, l)
+}
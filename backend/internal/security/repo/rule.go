@@ -0,0 +1,300 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepack"
+	"github.com/chaitin/MonkeyCode/backend/db/securityrulepackversion"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningpolicy"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+// ListRulePacks implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) ListRulePacks(ctx context.Context) ([]*db.SecurityRulePack, error) {
+	return s.db.SecurityRulePack.Query().
+		Order(securityrulepack.ByName()).
+		All(ctx)
+}
+
+// CreateRulePack implements domain.SecurityScanningRepo.
+// 创建规则包时保存第一个版本并立即启用
+func (s *SecurityScanningRepo) CreateRulePack(ctx context.Context, req *domain.CreateSecurityRulePackReq, ruleIDs []string) (*db.SecurityRulePack, error) {
+	var pack *db.SecurityRulePack
+	err := entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		var err error
+		if pack, err = tx.SecurityRulePack.Create().
+			SetName(req.Name).
+			SetDescription(req.Description).
+			SetVersion(1).
+			SetLatestVersion(1).
+			SetCreator(req.Admin).
+			Save(ctx); err != nil {
+			if db.IsConstraintError(err) {
+				return fmt.Errorf("rule pack %s already exists", req.Name)
+			}
+			return err
+		}
+		return tx.SecurityRulePackVersion.Create().
+			SetPackID(pack.ID).
+			SetVersion(1).
+			SetContent(req.Content).
+			SetChecksum(scan.Checksum(req.Content)).
+			SetRuleIds(ruleIDs).
+			SetCreator(req.Admin).
+			Exec(ctx)
+	})
+	return pack, err
+}
+
+// UploadRulePackVersion implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) UploadRulePackVersion(ctx context.Context, req *domain.UploadSecurityRulePackReq, ruleIDs []string) (*db.SecurityRulePackVersion, error) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *db.SecurityRulePackVersion
+	err = entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		pack, err := tx.SecurityRulePack.Query().
+			Where(securityrulepack.ID(id)).
+			ForUpdate().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		version := pack.LatestVersion + 1
+		if v, err = tx.SecurityRulePackVersion.Create().
+			SetPackID(pack.ID).
+			SetVersion(version).
+			SetContent(req.Content).
+			SetChecksum(scan.Checksum(req.Content)).
+			SetRuleIds(ruleIDs).
+			SetCreator(req.Admin).
+			Save(ctx); err != nil {
+			return err
+		}
+		up := tx.SecurityRulePack.UpdateOneID(pack.ID).SetLatestVersion(version)
+		if req.Activate {
+			up.SetVersion(version)
+		}
+		return up.Exec(ctx)
+	})
+	return v, err
+}
+
+// UpdateRulePack implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) UpdateRulePack(ctx context.Context, req *domain.UpdateSecurityRulePackReq) (*db.SecurityRulePack, error) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	up := s.db.SecurityRulePack.UpdateOneID(id).
+		SetNillableDescription(req.Description).
+		SetNillableEnabled(req.Enabled)
+	if req.Version != nil {
+		exist, err := s.db.SecurityRulePackVersion.Query().
+			Where(
+				securityrulepackversion.PackID(id),
+				securityrulepackversion.Version(*req.Version),
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !exist {
+			return nil, fmt.Errorf("rule pack version %d not found", *req.Version)
+		}
+		up.SetVersion(*req.Version)
+	}
+	return up.Save(ctx)
+}
+
+// ListRulePackVersions implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) ListRulePackVersions(ctx context.Context, id string) ([]*db.SecurityRulePackVersion, error) {
+	pid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.SecurityRulePackVersion.Query().
+		Where(securityrulepackversion.PackID(pid)).
+		Order(securityrulepackversion.ByVersion(sql.OrderDesc())).
+		All(ctx)
+}
+
+// DeleteRulePack implements domain.SecurityScanningRepo.
+// 被扫描策略引用的规则包不能删除
+func (s *SecurityScanningRepo) DeleteRulePack(ctx context.Context, id string) error {
+	pid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		policies, err := tx.SecurityScanningPolicy.Query().
+			Where(func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(securityscanningpolicy.FieldRulePackIds, pid.String()))
+			}).
+			Select(securityscanningpolicy.FieldName).
+			Strings(ctx)
+		if err != nil {
+			return err
+		}
+		if len(policies) > 0 {
+			return fmt.Errorf("rule pack is used by policies %v", policies)
+		}
+		if _, err := tx.SecurityRulePackVersion.Delete().
+			Where(securityrulepackversion.PackID(pid)).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.SecurityRulePack.DeleteOneID(pid).Exec(ctx)
+	})
+}
+
+// ActiveRulePacks implements domain.SecurityScanningRepo.
+// 返回启用的规则包，versions 中只包含扫描使用的版本
+func (s *SecurityScanningRepo) ActiveRulePacks(ctx context.Context, ids []uuid.UUID) ([]*db.SecurityRulePack, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	packs, err := s.db.SecurityRulePack.Query().
+		Where(
+			securityrulepack.IDIn(ids...),
+			securityrulepack.Enabled(true),
+			securityrulepack.VersionGT(0),
+		).
+		Order(securityrulepack.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range packs {
+		v, err := s.db.SecurityRulePackVersion.Query().
+			Where(
+				securityrulepackversion.PackID(p.ID),
+				securityrulepackversion.Version(p.Version),
+			).
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		p.Edges.Versions = []*db.SecurityRulePackVersion{v}
+	}
+	return packs, nil
+}
+
+// PolicyRulePacks implements domain.SecurityScanningRepo.
+// 返回扫描策略引用的全部规则包，包括已停用的，用于判断规则是否变化
+func (s *SecurityScanningRepo) PolicyRulePacks(ctx context.Context, ids []uuid.UUID) ([]*db.SecurityRulePack, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	return s.db.SecurityRulePack.Query().
+		Where(securityrulepack.IDIn(ids...)).
+		All(ctx)
+}
+
+// ListPolicies implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) ListPolicies(ctx context.Context) ([]*db.SecurityScanningPolicy, error) {
+	return s.db.SecurityScanningPolicy.Query().
+		Order(securityscanningpolicy.ByName()).
+		All(ctx)
+}
+
+// GetPolicy implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) GetPolicy(ctx context.Context, name string) (*db.SecurityScanningPolicy, error) {
+	p, err := s.db.SecurityScanningPolicy.Query().
+		Where(securityscanningpolicy.Name(name)).
+		Only(ctx)
+	if db.IsNotFound(err) {
+		return nil, fmt.Errorf("security scanning policy %s not found", name)
+	}
+	return p, err
+}
+
+// CreatePolicy implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) CreatePolicy(ctx context.Context, req *domain.CreateSecurityScanningPolicyReq) (*db.SecurityScanningPolicy, error) {
+	ids, err := s.rulePackIDs(ctx, req.RulePackIDs)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.db.SecurityScanningPolicy.Create().
+		SetName(req.Name).
+		SetDescription(req.Description).
+		SetLanguage(req.Language).
+		SetRulePackIds(ids).
+		SetMinConfidence(req.MinConfidence).
+		SetMinImpact(req.MinImpact).
+		Save(ctx)
+	if db.IsConstraintError(err) {
+		return nil, fmt.Errorf("security scanning policy %s already exists", req.Name)
+	}
+	return p, err
+}
+
+// UpdatePolicy implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) UpdatePolicy(ctx context.Context, req *domain.UpdateSecurityScanningPolicyReq) (*db.SecurityScanningPolicy, error) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	up := s.db.SecurityScanningPolicy.UpdateOneID(id).
+		SetNillableDescription(req.Description).
+		SetNillableLanguage(req.Language)
+	if req.RulePackIDs != nil {
+		ids, err := s.rulePackIDs(ctx, req.RulePackIDs)
+		if err != nil {
+			return nil, err
+		}
+		up.SetRulePackIds(ids)
+	}
+	if req.MinConfidence != "" {
+		up.SetMinConfidence(req.MinConfidence)
+	}
+	if req.MinImpact != "" {
+		up.SetMinImpact(req.MinImpact)
+	}
+	return up.Save(ctx)
+}
+
+// DeletePolicy implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) DeletePolicy(ctx context.Context, id string) error {
+	pid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return s.db.SecurityScanningPolicy.DeleteOneID(pid).Exec(ctx)
+}
+
+// rulePackIDs 解析并校验策略引用的规则包
+func (s *SecurityScanningRepo) rulePackIDs(ctx context.Context, ids []string) ([]uuid.UUID, error) {
+	uids := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		uid, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, uid)
+	}
+	if len(uids) == 0 {
+		return uids, nil
+	}
+	n, err := s.db.SecurityRulePack.Query().
+		Where(securityrulepack.IDIn(uids...)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n != len(uids) {
+		return nil, fmt.Errorf("rule pack not found")
+	}
+	return uids, nil
+}
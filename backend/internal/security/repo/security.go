@@ -3,13 +3,14 @@ package repo
 import (
 	"context"
 	"fmt"
-	"strings"
+	"path"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 
 	"github.com/chaitin/MonkeyCode/backend/consts"
 	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
 	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
 	"github.com/chaitin/MonkeyCode/backend/db/workspace"
@@ -19,7 +20,6 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
-	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
 )
 
 type SecurityScanningRepo struct {
@@ -54,6 +54,7 @@ func (s *SecurityScanningRepo) Create(ctx context.Context, req domain.CreateSecu
 		SetWorkspaceID(w.ID).
 		SetLanguage(req.Language).
 		SetRule(req.Language.RuleName()).
+		SetPolicy(req.Policy).
 		SetWorkspace(req.Workspace).
 		SetStatus(consts.SecurityScanningStatusPending).
 		Save(ctx)
@@ -66,78 +67,120 @@ func (s *SecurityScanningRepo) Create(ctx context.Context, req domain.CreateSecu
 }
 
 // Update implements domain.SecurityScanningRepo.
-func (s *SecurityScanningRepo) Update(ctx context.Context, id string, fileMap map[string]string, status consts.SecurityScanningStatus, result *scan.Result) error {
+func (s *SecurityScanningRepo) Update(ctx context.Context, id string, status consts.SecurityScanningStatus, errMsg string) error {
 	uid, err := uuid.Parse(id)
 	if err != nil {
 		return err
 	}
+	up := s.db.SecurityScanning.UpdateOneID(uid).
+		SetStatus(status)
+	if errMsg != "" {
+		up.SetErrorMessage(errMsg)
+	}
+	return up.Exec(ctx)
+}
 
-	return entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
-		up := s.db.SecurityScanning.Update().
-			Where(securityscanning.ID(uid)).
-			SetStatus(status)
+// UpdateSubScans implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) UpdateSubScans(ctx context.Context, id string, subs []*types.SecurityScanningSubScan) error {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return s.db.SecurityScanning.UpdateOneID(uid).
+		SetSubScans(subs).
+		Exec(ctx)
+}
 
-		if result != nil && result.Output != "" {
-			up.SetErrorMessage(result.Output)
-		}
+// SaveResults implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) SaveResults(ctx context.Context, req *domain.SaveSecurityScanningReq) error {
+	uid, err := uuid.Parse(req.ID)
+	if err != nil {
+		return err
+	}
 
-		if err := up.Exec(ctx); err != nil {
+	return entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		scanning, err := tx.SecurityScanning.Get(ctx, uid)
+		if err != nil {
+			return err
+		}
+		if err := applySuppressions(ctx, tx, scanning.WorkspaceID, req.Results); err != nil {
 			return err
 		}
 
-		if result == nil {
-			return nil
+		if err := tx.SecurityScanning.UpdateOneID(uid).
+			SetStatus(consts.SecurityScanningStatusSuccess).
+			SetNillableBaseID(req.BaseID).
+			SetFileHashes(req.FileHashes).
+			SetScannedFiles(req.ScannedFiles).
+			Exec(ctx); err != nil {
+			return err
 		}
 
 		cs := make([]*db.SecurityScanningResultCreate, 0)
-		for _, item := range result.Results {
-			c := s.db.SecurityScanningResult.Create().
+		for _, r := range req.Results {
+			c := tx.SecurityScanningResult.Create().
 				SetSecurityScanningID(uid).
-				SetCheckID(item.CheckID).
-				SetEngineKind(item.Extra.EngineKind).
-				SetLines(item.Extra.Lines).
-				SetMessage(item.Extra.Message).
-				SetMessageZh(item.Extra.Metadata.MessageZh).
-				SetSeverity(item.Extra.Severity).
-				SetAbstractEn(item.Extra.Metadata.AbstractFeysh["en-US"]).
-				SetAbstractZh(item.Extra.Metadata.AbstractFeysh["zh-CN"]).
-				SetCategoryEn(item.Extra.Metadata.CategoryFeysh["en-US"]).
-				SetCategoryZh(item.Extra.Metadata.CategoryFeysh["zh-CN"]).
-				SetConfidence(item.Extra.Metadata.Confidence).
-				SetCwe([]any{item.Extra.Metadata.Cwe}).
-				SetImpact(item.Extra.Metadata.Impact).
-				SetOwasp([]any{item.Extra.Metadata.Owasp}).
-				SetPath(strings.ReplaceAll(item.Path, result.Prefix, "")).
-				SetFileContent(fileMap[item.Path]).
-				SetStartPosition(&types.Position{
-					Col:    item.Start.Col,
-					Line:   item.Start.Line,
-					Offset: item.Start.Offset,
-				}).
-				SetEndPosition(&types.Position{
-					Col:    item.End.Col,
-					Line:   item.End.Line,
-					Offset: item.End.Offset,
-				})
+				SetCheckID(r.CheckID).
+				SetEngineKind(r.EngineKind).
+				SetLines(r.Lines).
+				SetPath(r.Path).
+				SetMessage(r.Message).
+				SetMessageZh(r.MessageZh).
+				SetSeverity(r.Severity).
+				SetAbstractEn(r.AbstractEn).
+				SetAbstractZh(r.AbstractZh).
+				SetCategoryEn(r.CategoryEn).
+				SetCategoryZh(r.CategoryZh).
+				SetConfidence(r.Confidence).
+				SetCwe(r.Cwe).
+				SetImpact(r.Impact).
+				SetOwasp(r.Owasp).
+				SetFileContent(r.FileContent).
+				SetStartPosition(r.StartPosition).
+				SetEndPosition(r.EndPosition).
+				SetFingerprint(r.Fingerprint).
+				SetState(r.State).
+				SetTriageStatus(cvt.ZeroWithDefault(r.TriageStatus, consts.SecurityScanningTriageOpen)).
+				SetNillableAssigneeID(r.AssigneeID).
+				SetNillableRiskExpiresAt(r.RiskExpiresAt).
+				SetNillableSuppressionID(r.SuppressionID)
 			cs = append(cs, c)
-			if len(cs) >= 10 {
-				if err := s.db.SecurityScanningResult.CreateBulk(cs...).Exec(ctx); err != nil {
+			if len(cs) >= 100 {
+				if err := tx.SecurityScanningResult.CreateBulk(cs...).Exec(ctx); err != nil {
 					return err
 				}
 				cs = cs[:0]
 			}
 		}
-
 		if len(cs) > 0 {
-			if err := s.db.SecurityScanningResult.CreateBulk(cs...).Exec(ctx); err != nil {
-				return err
-			}
+			return tx.SecurityScanningResult.CreateBulk(cs...).Exec(ctx)
 		}
-
 		return nil
 	})
 }
 
+// LatestSuccess implements domain.SecurityScanningRepo.
+// 返回工作区指定语言和扫描策略最近一次成功的内置扫描及其未修复的结果
+func (s *SecurityScanningRepo) LatestSuccess(ctx context.Context, workspaceID uuid.UUID, language consts.SecurityScanningLanguage, policy string) (*db.SecurityScanning, error) {
+	ps := securityscanning.PolicyEQ(policy)
+	if policy == "" {
+		ps = securityscanning.Or(securityscanning.PolicyIsNil(), securityscanning.PolicyEQ(""))
+	}
+	return s.db.SecurityScanning.Query().
+		WithResults(func(q *db.SecurityScanningResultQuery) {
+			q.Where(unfixed())
+		}).
+		Where(
+			securityscanning.WorkspaceID(workspaceID),
+			securityscanning.Language(language),
+			securityscanning.Status(consts.SecurityScanningStatusSuccess),
+			securityscanning.Or(securityscanning.ToolIsNil(), securityscanning.Tool("")),
+			ps,
+		).
+		Order(securityscanning.ByCreatedAt(sql.OrderDesc())).
+		First(ctx)
+}
+
 // List implements domain.SecurityScanningRepo.
 func (s *SecurityScanningRepo) List(ctx context.Context, req domain.ListSecurityScanningReq) (*domain.ListSecurityScanningResp, error) {
 	query := s.db.SecurityScanning.Query().
@@ -217,7 +260,7 @@ func (s *SecurityScanningRepo) Detail(ctx context.Context, userID, id string) ([
 	}
 
 	q := s.db.SecurityScanningResult.Query().
-		Where(securityscanningresult.SecurityScanningID(sid)).
+		Where(securityscanningresult.SecurityScanningID(sid), unfixed()).
 		Order(
 			BySeverityOrder(),
 			securityscanningresult.ByCreatedAt(sql.OrderDesc()),
@@ -252,9 +295,12 @@ func (s *SecurityScanningRepo) RiskCountByIDs(ctx context.Context, ids []uuid.UU
 		Modify(func(s *sql.Selector) {
 			s.Select(
 				sql.As("security_scanning_id", "id"),
-				sql.As("count(*) filter (where severity in ('CRITICAL', 'ERROR'))", "severe_count"),
-				sql.As("count(*) filter (where severity = 'WARNING')", "critical_count"),
-				sql.As("count(*) filter (where severity = 'INFO')", "suggest_count"),
+				sql.As("count(*) filter (where severity in ('CRITICAL', 'ERROR') and "+counted+")", "severe_count"),
+				sql.As("count(*) filter (where severity = 'WARNING' and "+counted+")", "critical_count"),
+				sql.As("count(*) filter (where severity = 'INFO' and "+counted+")", "suggest_count"),
+				sql.As("count(*) filter (where "+notFixed+" and "+suppressed+")", "suppress_count"),
+				sql.As("count(*) filter (where state = 'new')", "new_count"),
+				sql.As("count(*) filter (where state = 'fixed')", "fixed_count"),
 			).
 				GroupBy(securityscanningresult.FieldSecurityScanningID)
 		}).
@@ -326,6 +372,14 @@ func (s *SecurityScanningRepo) ListDetail(ctx context.Context, req domain.ListSe
 			securityscanningresult.ByCreatedAt(sql.OrderDesc()),
 			securityscanningresult.ByID(sql.OrderDesc()),
 		)
+	if req.State != "" {
+		q.Where(securityscanningresult.State(req.State))
+	} else {
+		q.Where(unfixed())
+	}
+	if req.TriageStatus != "" {
+		q.Where(securityscanningresult.TriageStatus(req.TriageStatus))
+	}
 
 	rs, p, err := q.Page(ctx, req.Page, req.Size)
 	if err != nil {
@@ -340,6 +394,19 @@ func (s *SecurityScanningRepo) ListDetail(ctx context.Context, req domain.ListSe
 	}, nil
 }
 
+// notFixed 增量扫描中已修复的结果只用于对比，不计入风险
+const notFixed = "(state is null or state <> 'fixed')"
+
+// counted 计入风险统计的发现
+const counted = notFixed + " and not " + suppressed
+
+func unfixed() predicate.SecurityScanningResult {
+	return securityscanningresult.Or(
+		securityscanningresult.StateIsNil(),
+		securityscanningresult.StateNEQ(consts.SecurityScanningFindingFixed),
+	)
+}
+
 func BySeverityOrder() func(s *sql.Selector) {
 	return func(s *sql.Selector) {
 		s.OrderExprFunc(func(b *sql.Builder) {
@@ -347,3 +414,139 @@ func BySeverityOrder() func(s *sql.Selector) {
 		})
 	}
 }
+
+// GetWithResults implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) GetWithResults(ctx context.Context, id string) (*db.SecurityScanning, error) {
+	sid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.db.SecurityScanning.Query().
+		WithResults(func(q *db.SecurityScanningResultQuery) {
+			q.Order(
+				BySeverityOrder(),
+				securityscanningresult.ByCreatedAt(sql.OrderAsc()),
+				securityscanningresult.ByID(sql.OrderAsc()),
+			)
+		}).
+		Where(securityscanning.ID(sid)).
+		First(ctx)
+}
+
+// LatestByWorkspace implements domain.SecurityScanningRepo.
+// 返回工作区每种扫描最近一次成功的结果，内置扫描按语言区分，导入结果按工具区分
+func (s *SecurityScanningRepo) LatestByWorkspace(ctx context.Context, workspaceID string) ([]*db.SecurityScanning, error) {
+	wid, err := uuid.Parse(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	scannings, err := s.db.SecurityScanning.Query().
+		Where(
+			securityscanning.WorkspaceID(wid),
+			securityscanning.Status(consts.SecurityScanningStatusSuccess),
+		).
+		Order(securityscanning.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	scannings = cvt.UniqueFn(scannings, func(e *db.SecurityScanning) string {
+		return string(e.Language) + "/" + e.Tool
+	})
+	if len(scannings) == 0 {
+		return scannings, nil
+	}
+
+	ids := cvt.Iter(scannings, func(_ int, e *db.SecurityScanning) uuid.UUID {
+		return e.ID
+	})
+	return s.db.SecurityScanning.Query().
+		WithResults(func(q *db.SecurityScanningResultQuery) {
+			q.Order(
+				BySeverityOrder(),
+				securityscanningresult.ByCreatedAt(sql.OrderAsc()),
+				securityscanningresult.ByID(sql.OrderAsc()),
+			)
+		}).
+		Where(securityscanning.IDIn(ids...)).
+		Order(securityscanning.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+}
+
+// Import implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) Import(ctx context.Context, req *domain.ImportSecurityScanningReq) (string, error) {
+	wid, err := uuid.Parse(req.WorkspaceID)
+	if err != nil {
+		return "", err
+	}
+
+	id := uuid.New()
+	err = entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		w, err := tx.Workspace.Get(ctx, wid)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.SecurityScanning.Create().
+			SetID(id).
+			SetUserID(w.UserID).
+			SetWorkspaceID(w.ID).
+			SetWorkspace(w.RootPath).
+			SetLanguage(req.Language).
+			SetTool(req.Tool).
+			SetStatus(consts.SecurityScanningStatusSuccess).
+			Exec(ctx); err != nil {
+			return err
+		}
+		if err := applySuppressions(ctx, tx, w.ID, req.Results); err != nil {
+			return err
+		}
+
+		cs := make([]*db.SecurityScanningResultCreate, 0)
+		for _, r := range req.Results {
+			p := r.Path
+			if !path.IsAbs(p) {
+				p = path.Join(w.RootPath, p)
+			}
+			c := tx.SecurityScanningResult.Create().
+				SetSecurityScanningID(id).
+				SetCheckID(r.CheckID).
+				SetEngineKind(r.EngineKind).
+				SetLines(r.Lines).
+				SetPath(p).
+				SetMessage(r.Message).
+				SetMessageZh(r.MessageZh).
+				SetSeverity(r.Severity).
+				SetAbstractEn(r.AbstractEn).
+				SetAbstractZh(r.AbstractZh).
+				SetCategoryEn(r.CategoryEn).
+				SetCategoryZh(r.CategoryZh).
+				SetConfidence(r.Confidence).
+				SetCwe(r.Cwe).
+				SetImpact(r.Impact).
+				SetOwasp(r.Owasp).
+				SetFileContent(r.FileContent).
+				SetStartPosition(r.StartPosition).
+				SetEndPosition(r.EndPosition).
+				SetFingerprint(r.Fingerprint).
+				SetTriageStatus(cvt.ZeroWithDefault(r.TriageStatus, consts.SecurityScanningTriageOpen)).
+				SetNillableRiskExpiresAt(r.RiskExpiresAt).
+				SetNillableSuppressionID(r.SuppressionID)
+			cs = append(cs, c)
+			if len(cs) >= 100 {
+				if err := tx.SecurityScanningResult.CreateBulk(cs...).Exec(ctx); err != nil {
+					return err
+				}
+				cs = cs[:0]
+			}
+		}
+		if len(cs) > 0 {
+			return tx.SecurityScanningResult.CreateBulk(cs...).Exec(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
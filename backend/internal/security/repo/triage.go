@@ -0,0 +1,339 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/db/predicate"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanning"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningcomment"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningresult"
+	"github.com/chaitin/MonkeyCode/backend/db/securityscanningsuppression"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
+)
+
+// suppressed 误报和未到期的接受风险不计入风险统计
+const suppressed = "(triage_status in ('false_positive', 'accepted_risk') and (risk_expires_at is null or risk_expires_at > now()))"
+
+// Triage implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) Triage(ctx context.Context, req *domain.TriageSecurityScanningReq) (*db.SecurityScanningResult, error) {
+	rid, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := uuid.Parse(req.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *db.SecurityScanningResult
+	err = entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		q := tx.SecurityScanningResult.Query().
+			WithSecurityScanning().
+			Where(securityscanningresult.ID(rid))
+		if req.UserID != "" {
+			uid, err := uuid.Parse(req.UserID)
+			if err != nil {
+				return err
+			}
+			q.Where(securityscanningresult.HasSecurityScanningWith(securityscanning.UserID(uid)))
+		}
+		r, err := q.Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		up := tx.SecurityScanningResult.UpdateOneID(rid)
+		if req.AssigneeID != nil {
+			if *req.AssigneeID == "" {
+				up.ClearAssigneeID()
+			} else {
+				aid, err := uuid.Parse(*req.AssigneeID)
+				if err != nil {
+					return err
+				}
+				up.SetAssigneeID(aid)
+			}
+		}
+
+		if req.Status != "" {
+			expires := expiresAt(req.Status, req.ExpiresAt)
+			up.SetTriageStatus(req.Status).
+				SetNillableRiskExpiresAt(expires)
+			if expires == nil {
+				up.ClearRiskExpiresAt()
+			}
+
+			var spID *uuid.UUID
+
+			// 同一工作区中相同指纹的发现共用一条屏蔽规则
+			if r.Fingerprint != "" {
+				wid := r.Edges.SecurityScanning.WorkspaceID
+				ids, err := tx.SecurityScanningSuppression.Query().
+					Where(
+						securityscanningsuppression.WorkspaceID(wid),
+						securityscanningsuppression.Fingerprint(r.Fingerprint),
+					).
+					IDs(ctx)
+				if err != nil {
+					return err
+				}
+				if err := unsuppress(ctx, tx, ids...); err != nil {
+					return err
+				}
+
+				if req.Status.Suppressed() {
+					sp, err := tx.SecurityScanningSuppression.Create().
+						SetWorkspaceID(wid).
+						SetFingerprint(r.Fingerprint).
+						SetCheckID(r.CheckID).
+						SetStatus(req.Status).
+						SetReason(req.Comment).
+						SetNillableExpiresAt(expires).
+						SetCreatorID(operator).
+						SetCreator(req.Operator).
+						Save(ctx)
+					if err != nil {
+						return err
+					}
+					if err := suppress(ctx, tx, sp); err != nil {
+						return err
+					}
+					spID = &sp.ID
+				}
+			}
+			if spID != nil {
+				up.SetSuppressionID(*spID)
+			} else {
+				up.ClearSuppressionID()
+			}
+		}
+
+		if res, err = up.Save(ctx); err != nil {
+			return err
+		}
+
+		if req.Status == "" && req.Comment == "" {
+			return nil
+		}
+		return tx.SecurityScanningComment.Create().
+			SetResultID(rid).
+			SetAuthorID(operator).
+			SetAuthor(req.Operator).
+			SetTriageStatus(req.Status).
+			SetContent(req.Comment).
+			Exec(ctx)
+	})
+	return res, err
+}
+
+// ListComments implements domain.SecurityScanningRepo.
+// 有指纹的发现返回工作区中相同指纹的所有评论，跨扫描保留处置记录
+func (s *SecurityScanningRepo) ListComments(ctx context.Context, userID, id string) ([]*db.SecurityScanningComment, error) {
+	rid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+	q := s.db.SecurityScanningResult.Query().
+		WithSecurityScanning().
+		Where(securityscanningresult.ID(rid))
+	if userID != "" {
+		uid, err := uuid.Parse(userID)
+		if err != nil {
+			return nil, err
+		}
+		q.Where(securityscanningresult.HasSecurityScanningWith(securityscanning.UserID(uid)))
+	}
+	r, err := q.Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p := securityscanningcomment.ResultID(rid)
+	if r.Fingerprint != "" {
+		p = securityscanningcomment.HasResultWith(
+			securityscanningresult.Fingerprint(r.Fingerprint),
+			securityscanningresult.HasSecurityScanningWith(securityscanning.WorkspaceID(r.Edges.SecurityScanning.WorkspaceID)),
+		)
+	}
+	return s.db.SecurityScanningComment.Query().
+		Where(p).
+		Order(securityscanningcomment.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+}
+
+// ListSuppressions implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) ListSuppressions(ctx context.Context, req domain.ListSecurityScanningSuppressionReq) (*domain.ListSecurityScanningSuppressionResp, error) {
+	q := s.db.SecurityScanningSuppression.Query().
+		Order(securityscanningsuppression.ByCreatedAt(sql.OrderDesc()))
+	if req.WorkspaceID != "" {
+		wid, err := uuid.Parse(req.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		q.Where(securityscanningsuppression.Or(
+			securityscanningsuppression.WorkspaceID(wid),
+			securityscanningsuppression.WorkspaceIDIsNil(),
+		))
+	}
+
+	ss, p, err := q.Page(ctx, req.Page, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ListSecurityScanningSuppressionResp{
+		PageInfo: p,
+		Items: cvt.Iter(ss, func(_ int, e *db.SecurityScanningSuppression) *domain.SecurityScanningSuppression {
+			return cvt.From(e, &domain.SecurityScanningSuppression{})
+		}),
+	}, nil
+}
+
+// CreateSuppression implements domain.SecurityScanningRepo.
+func (s *SecurityScanningRepo) CreateSuppression(ctx context.Context, req *domain.CreateSecurityScanningSuppressionReq) (*db.SecurityScanningSuppression, error) {
+	creator, err := uuid.Parse(req.AdminID)
+	if err != nil {
+		return nil, err
+	}
+	var wid *uuid.UUID
+	if req.WorkspaceID != "" {
+		id, err := uuid.Parse(req.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+		wid = &id
+	}
+
+	var sp *db.SecurityScanningSuppression
+	err = entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		var err error
+		if sp, err = tx.SecurityScanningSuppression.Create().
+			SetNillableWorkspaceID(wid).
+			SetFingerprint(req.Fingerprint).
+			SetCheckID(req.CheckID).
+			SetStatus(req.Status).
+			SetReason(req.Reason).
+			SetNillableExpiresAt(expiresAt(req.Status, req.ExpiresAt)).
+			SetCreatorID(creator).
+			SetCreator(req.Admin).
+			Save(ctx); err != nil {
+			return err
+		}
+		return suppress(ctx, tx, sp)
+	})
+	return sp, err
+}
+
+// DeleteSuppression implements domain.SecurityScanningRepo.
+// 删除后由该规则屏蔽的发现恢复为待处理
+func (s *SecurityScanningRepo) DeleteSuppression(ctx context.Context, id string) error {
+	sid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return entx.WithTx(ctx, s.db, func(tx *db.Tx) error {
+		return unsuppress(ctx, tx, sid)
+	})
+}
+
+// expiresAt 只有接受风险可以设置到期时间
+func expiresAt(status consts.SecurityScanningTriageStatus, ts int64) *time.Time {
+	if status != consts.SecurityScanningTriageAcceptedRisk || ts <= 0 {
+		return nil
+	}
+	t := time.Unix(ts, 0)
+	return &t
+}
+
+// suppress 屏蔽规则生效范围内待处理的发现
+func suppress(ctx context.Context, tx *db.Tx, sp *db.SecurityScanningSuppression) error {
+	ps := []predicate.SecurityScanningResult{
+		securityscanningresult.TriageStatus(consts.SecurityScanningTriageOpen),
+		securityscanningresult.SuppressionIDIsNil(),
+	}
+	if sp.Fingerprint != "" {
+		ps = append(ps, securityscanningresult.Fingerprint(sp.Fingerprint))
+	} else {
+		ps = append(ps, securityscanningresult.CheckID(sp.CheckID))
+	}
+	if sp.WorkspaceID != nil {
+		ps = append(ps, securityscanningresult.HasSecurityScanningWith(securityscanning.WorkspaceID(*sp.WorkspaceID)))
+	}
+	return tx.SecurityScanningResult.Update().
+		Where(ps...).
+		SetTriageStatus(sp.Status).
+		SetNillableRiskExpiresAt(sp.ExpiresAt).
+		SetSuppressionID(sp.ID).
+		Exec(ctx)
+}
+
+// unsuppress 删除屏蔽规则，被屏蔽的发现恢复为待处理
+func unsuppress(ctx context.Context, tx *db.Tx, ids ...uuid.UUID) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := tx.SecurityScanningResult.Update().
+		Where(securityscanningresult.SuppressionIDIn(ids...)).
+		SetTriageStatus(consts.SecurityScanningTriageOpen).
+		ClearRiskExpiresAt().
+		ClearSuppressionID().
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := tx.SecurityScanningSuppression.Delete().
+		Where(securityscanningsuppression.IDIn(ids...)).
+		Exec(ctx)
+	return err
+}
+
+// applySuppressions 对新写入的发现应用工作区生效中的屏蔽规则，指纹优先于规则
+func applySuppressions(ctx context.Context, tx *db.Tx, workspaceID uuid.UUID, rs []*db.SecurityScanningResult) error {
+	ss, err := tx.SecurityScanningSuppression.Query().
+		Where(
+			securityscanningsuppression.Or(
+				securityscanningsuppression.WorkspaceID(workspaceID),
+				securityscanningsuppression.WorkspaceIDIsNil(),
+			),
+			securityscanningsuppression.Or(
+				securityscanningsuppression.ExpiresAtIsNil(),
+				securityscanningsuppression.ExpiresAtGT(time.Now()),
+			),
+		).
+		Order(securityscanningsuppression.ByCreatedAt(sql.OrderAsc())).
+		All(ctx)
+	if err != nil || len(ss) == 0 {
+		return err
+	}
+
+	byFingerprint := make(map[string]*db.SecurityScanningSuppression)
+	byRule := make(map[string]*db.SecurityScanningSuppression)
+	for _, sp := range ss {
+		if sp.Fingerprint != "" {
+			byFingerprint[sp.Fingerprint] = sp
+		} else if sp.CheckID != "" {
+			byRule[sp.CheckID] = sp
+		}
+	}
+	for _, r := range rs {
+		if r.SuppressionID != nil || (r.TriageStatus != "" && r.TriageStatus != consts.SecurityScanningTriageOpen) {
+			continue
+		}
+		sp, ok := byFingerprint[r.Fingerprint]
+		if !ok || r.Fingerprint == "" {
+			if sp, ok = byRule[r.CheckID]; !ok {
+				continue
+			}
+		}
+		r.TriageStatus = sp.Status
+		r.RiskExpiresAt = sp.ExpiresAt
+		r.SuppressionID = &sp.ID
+	}
+	return nil
+}
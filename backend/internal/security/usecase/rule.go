@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+// ListRulePacks implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ListRulePacks(ctx context.Context) ([]*domain.SecurityRulePack, error) {
+	ps, err := s.repo.ListRulePacks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(ps, func(_ int, e *db.SecurityRulePack) *domain.SecurityRulePack {
+		return cvt.From(e, &domain.SecurityRulePack{})
+	}), nil
+}
+
+// CreateRulePack implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) CreateRulePack(ctx context.Context, req *domain.CreateSecurityRulePackReq) (*domain.SecurityRulePack, error) {
+	if !scan.ValidRulePackName(req.Name) {
+		return nil, fmt.Errorf("invalid rule pack name %q", req.Name)
+	}
+	ids, err := scan.ParseRules(req.Content)
+	if err != nil {
+		return nil, err
+	}
+	p, err := s.repo.CreateRulePack(ctx, req, ids)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(p, &domain.SecurityRulePack{}), nil
+}
+
+// UploadRulePackVersion implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) UploadRulePackVersion(ctx context.Context, req *domain.UploadSecurityRulePackReq) (*domain.SecurityRulePackVersion, error) {
+	ids, err := scan.ParseRules(req.Content)
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.repo.UploadRulePackVersion(ctx, req, ids)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(v, &domain.SecurityRulePackVersion{}), nil
+}
+
+// UpdateRulePack implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) UpdateRulePack(ctx context.Context, req *domain.UpdateSecurityRulePackReq) (*domain.SecurityRulePack, error) {
+	if req.Description == nil && req.Enabled == nil && req.Version == nil {
+		return nil, fmt.Errorf("nothing to update")
+	}
+	p, err := s.repo.UpdateRulePack(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(p, &domain.SecurityRulePack{}), nil
+}
+
+// ListRulePackVersions implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ListRulePackVersions(ctx context.Context, id string) ([]*domain.SecurityRulePackVersion, error) {
+	vs, err := s.repo.ListRulePackVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(vs, func(_ int, e *db.SecurityRulePackVersion) *domain.SecurityRulePackVersion {
+		return cvt.From(e, &domain.SecurityRulePackVersion{})
+	}), nil
+}
+
+// DeleteRulePack implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) DeleteRulePack(ctx context.Context, id string) error {
+	return s.repo.DeleteRulePack(ctx, id)
+}
+
+// ListPolicies implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ListPolicies(ctx context.Context) ([]*domain.SecurityScanningPolicy, error) {
+	ps, err := s.repo.ListPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(ps, func(_ int, e *db.SecurityScanningPolicy) *domain.SecurityScanningPolicy {
+		return cvt.From(e, &domain.SecurityScanningPolicy{})
+	}), nil
+}
+
+// CreatePolicy implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) CreatePolicy(ctx context.Context, req *domain.CreateSecurityScanningPolicyReq) (*domain.SecurityScanningPolicy, error) {
+	req.MinConfidence = cvt.ZeroWithDefault(req.MinConfidence, consts.SecurityRuleLevelMedium)
+	req.MinImpact = cvt.ZeroWithDefault(req.MinImpact, consts.SecurityRuleLevelMedium)
+	if err := checkPolicy(req.Language, req.RulePackIDs, req.MinConfidence, req.MinImpact); err != nil {
+		return nil, err
+	}
+	if req.Language == "" && len(req.RulePackIDs) == 0 {
+		return nil, fmt.Errorf("policy requires a language or rule packs")
+	}
+	req.RulePackIDs = cvt.Unique(req.RulePackIDs)
+	p, err := s.repo.CreatePolicy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(p, &domain.SecurityScanningPolicy{}), nil
+}
+
+// UpdatePolicy implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) UpdatePolicy(ctx context.Context, req *domain.UpdateSecurityScanningPolicyReq) (*domain.SecurityScanningPolicy, error) {
+	var language consts.SecurityScanningLanguage
+	if req.Language != nil {
+		language = *req.Language
+	}
+	if err := checkPolicy(language, req.RulePackIDs, req.MinConfidence, req.MinImpact); err != nil {
+		return nil, err
+	}
+	if req.RulePackIDs != nil {
+		req.RulePackIDs = cvt.Unique(req.RulePackIDs)
+	}
+	p, err := s.repo.UpdatePolicy(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(p, &domain.SecurityScanningPolicy{}), nil
+}
+
+// DeletePolicy implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) DeletePolicy(ctx context.Context, id string) error {
+	return s.repo.DeletePolicy(ctx, id)
+}
+
+// checkPolicy 校验策略的语言和阈值，为空的字段不校验
+func checkPolicy(language consts.SecurityScanningLanguage, packIDs []string, confidence, impact consts.SecurityRuleLevel) error {
+	if language != "" && !language.Valid() {
+		return fmt.Errorf("invalid language %q", language)
+	}
+	for _, id := range packIDs {
+		if id == "" {
+			return fmt.Errorf("empty rule pack id")
+		}
+	}
+	if confidence != "" && !confidence.Valid() {
+		return fmt.Errorf("invalid min_confidence %q", confidence)
+	}
+	if impact != "" && !impact.Valid() {
+		return fmt.Errorf("invalid min_impact %q", impact)
+	}
+	return nil
+}
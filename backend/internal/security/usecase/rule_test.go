@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+func TestCheckPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		language   consts.SecurityScanningLanguage
+		packIDs    []string
+		confidence consts.SecurityRuleLevel
+		impact     consts.SecurityRuleLevel
+		ok         bool
+	}{
+		{"empty", "", nil, "", "", true},
+		{"language", consts.SecurityScanningLanguageGo, nil, consts.SecurityRuleLevelHigh, consts.SecurityRuleLevelLow, true},
+		{"invalid language", "golang", nil, "", "", false},
+		{"empty pack id", "", []string{""}, "", "", false},
+		{"invalid confidence", "", nil, "CRITICAL", "", false},
+		{"invalid impact", "", nil, "", "medium", false},
+	}
+	for _, tt := range tests {
+		if err := checkPolicy(tt.language, tt.packIDs, tt.confidence, tt.impact); (err == nil) != tt.ok {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
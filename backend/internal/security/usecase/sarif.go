@@ -0,0 +1,360 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+	"github.com/chaitin/MonkeyCode/backend/pkg/sarif"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+const (
+	sarifTool      = "MonkeyCode"
+	sarifToolURI   = "https://github.com/chaitin/MonkeyCode"
+	sarifEngine    = "SARIF" // 导入结果的引擎类型
+	fingerprintKey = "monkeycode/v1"
+	cweTagPrefix   = "external/cwe/cwe-"
+	owaspTagPrefix = "OWASP-"
+)
+
+var (
+	cweRe = regexp.MustCompile(`(?i)^CWE-(\d+)`)
+
+	severities = []string{"CRITICAL", "ERROR", "WARNING", "INFO"}
+
+	// securitySeverity 代码扫描平台按 security-severity 分数区分严重程度
+	securitySeverity = map[string]string{
+		"CRITICAL": "9.5",
+		"ERROR":    "8.0",
+		"WARNING":  "5.5",
+		"INFO":     "2.0",
+	}
+)
+
+// ExportSARIF implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ExportSARIF(ctx context.Context, id string) (*sarif.Log, error) {
+	scanning, err := s.repo.GetWithResults(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return sarif.New(exportRun(scanning)), nil
+}
+
+// ExportWorkspaceSARIF implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ExportWorkspaceSARIF(ctx context.Context, workspaceID string) (*sarif.Log, error) {
+	scannings, err := s.repo.LatestByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return sarif.New(cvt.Iter(scannings, func(_ int, e *db.SecurityScanning) *sarif.Run {
+		return exportRun(e)
+	})...), nil
+}
+
+// ImportSARIF implements domain.SecurityScanningUsecase.
+// 每个 run 导入为一个扫描任务
+func (s *SecurityScanningUsecase) ImportSARIF(ctx context.Context, req *domain.ImportSARIFReq) (*domain.ImportSARIFResp, error) {
+	if req.Log == nil || len(req.Log.Runs) == 0 {
+		return nil, fmt.Errorf("sarif log has no runs")
+	}
+	resp := &domain.ImportSARIFResp{IDs: make([]string, 0, len(req.Log.Runs))}
+	for _, run := range req.Log.Runs {
+		id, err := s.repo.Import(ctx, &domain.ImportSecurityScanningReq{
+			WorkspaceID: req.WorkspaceID,
+			Tool:        cvt.ZeroWithDefault(run.Tool.Driver.Name, sarifEngine),
+			Language:    consts.SecurityScanningLanguage(run.Properties.Get("language")),
+			Results:     importResults(run),
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.IDs = append(resp.IDs, id)
+	}
+	return resp, nil
+}
+
+// exportRun 将一次扫描转换为 SARIF run，同一规则只输出一次
+func exportRun(e *db.SecurityScanning) *sarif.Run {
+	category := cvt.ZeroWithDefault(e.Language.Rule(), "sarif")
+	run := &sarif.Run{
+		Tool: sarif.Tool{Driver: sarif.Driver{
+			Name:           cvt.ZeroWithDefault(e.Tool, sarifTool),
+			InformationURI: sarifToolURI,
+			Rules:          []*sarif.Rule{},
+		}},
+		AutomationDetails: &sarif.AutomationDetails{ID: fmt.Sprintf("%s/%s", category, e.ID)},
+		Results:           []*sarif.Result{},
+		Properties: sarif.Properties{
+			"scan_id":   e.ID.String(),
+			"workspace": e.Workspace,
+			"language":  string(e.Language),
+		},
+	}
+	index := make(map[string]int)
+	for _, r := range e.Edges.Results {
+		// 已修复的结果不再导出
+		if r.State == consts.SecurityScanningFindingFixed {
+			continue
+		}
+		i, ok := index[r.CheckID]
+		if !ok {
+			i = len(run.Tool.Driver.Rules)
+			index[r.CheckID] = i
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, exportRule(r))
+		}
+		run.Results = append(run.Results, exportResult(e.Workspace, r, i))
+	}
+	return run
+}
+
+func exportRule(r *db.SecurityScanningResult) *sarif.Rule {
+	cwe, owasp := sarif.Strings(r.Cwe), sarif.Strings(r.Owasp)
+	tags := []string{"security"}
+	for _, c := range cwe {
+		if m := cweRe.FindStringSubmatch(c); m != nil {
+			tags = append(tags, cweTagPrefix+m[1])
+		}
+	}
+	props := sarif.Properties{"tags": cvt.Unique(tags)}
+	setProp(props, "precision", strings.ToLower(r.Confidence))
+	setProp(props, "security-severity", securitySeverity[r.Severity])
+	setProp(props, "category", r.CategoryEn)
+	setProp(props, "category_zh", r.CategoryZh)
+	setProp(props, "abstract_zh", r.AbstractZh)
+	if len(cwe) > 0 {
+		props["cwe"] = cwe
+	}
+	if len(owasp) > 0 {
+		props["owasp"] = owasp
+	}
+
+	rule := &sarif.Rule{
+		ID:                   r.CheckID,
+		DefaultConfiguration: &sarif.Configuration{Level: level(r.Severity)},
+		Properties:           props,
+	}
+	if r.AbstractEn != "" {
+		rule.ShortDescription = &sarif.Message{Text: r.AbstractEn}
+	}
+	return rule
+}
+
+func exportResult(workspace string, r *db.SecurityScanningResult, ruleIndex int) *sarif.Result {
+	loc := &sarif.ArtifactLocation{}
+	if rel, ok := strings.CutPrefix(r.Path, strings.TrimSuffix(workspace, "/")+"/"); ok {
+		loc.URI = (&url.URL{Path: rel}).String()
+		loc.URIBaseID = sarif.SrcRoot
+	} else {
+		loc.URI = (&url.URL{Scheme: "file", Path: r.Path}).String()
+	}
+
+	region := &sarif.Region{}
+	if p := r.StartPosition; p != nil {
+		region.StartLine, region.StartColumn, region.CharOffset = p.Line, p.Col, p.Offset
+	}
+	if p := r.EndPosition; p != nil {
+		region.EndLine, region.EndColumn = p.Line, p.Col
+		if start := r.StartPosition; start != nil && p.Offset > start.Offset {
+			region.CharLength = p.Offset - start.Offset
+		}
+	}
+	if r.Lines != "" {
+		region.Snippet = &sarif.ArtifactContent{Text: r.Lines}
+	}
+
+	props := sarif.Properties{"severity": r.Severity}
+	setProp(props, "confidence", r.Confidence)
+	setProp(props, "impact", r.Impact)
+	setProp(props, "message_zh", r.MessageZh)
+	setProp(props, "state", string(r.State))
+	res := &sarif.Result{
+		RuleID:    r.CheckID,
+		RuleIndex: &ruleIndex,
+		Level:     level(r.Severity),
+		// SARIF 要求结果必须有消息
+		Message: sarif.Message{Text: cvt.ZeroWithDefault(r.Message, cvt.ZeroWithDefault(r.AbstractEn, r.CheckID))},
+		Locations: []*sarif.Location{{
+			PhysicalLocation: &sarif.PhysicalLocation{ArtifactLocation: loc, Region: region},
+		}},
+		Properties: props,
+	}
+	if r.Fingerprint != "" {
+		res.PartialFingerprints = map[string]string{fingerprintKey: r.Fingerprint}
+	}
+	return res
+}
+
+// importResults 将 SARIF run 中的结果转换为扫描结果，文件路径为相对工作区的路径或绝对路径
+func importResults(run *sarif.Run) []*db.SecurityScanningResult {
+	return cvt.Iter(run.Results, func(_ int, res *sarif.Result) *db.SecurityScanningResult {
+		rule := run.Rule(res)
+		if rule == nil {
+			rule = &sarif.Rule{ID: res.RuleID}
+		}
+		r := &db.SecurityScanningResult{
+			CheckID:       cvt.ZeroWithDefault(res.RuleID, rule.ID),
+			EngineKind:    sarifEngine,
+			Message:       res.Message.Text,
+			MessageZh:     res.Properties.Get("message_zh"),
+			Severity:      severity(res, rule),
+			AbstractEn:    cvt.ZeroWithDefault(rule.ShortDescription.String(), rule.Name),
+			AbstractZh:    rule.Properties.Get("abstract_zh"),
+			CategoryEn:    rule.Properties.Get("category"),
+			CategoryZh:    rule.Properties.Get("category_zh"),
+			Confidence:    confidence(res, rule),
+			Impact:        res.Properties.Get("impact"),
+			Cwe:           anys(cwes(rule)),
+			Owasp:         anys(owasps(rule)),
+			StartPosition: &types.Position{},
+			EndPosition:   &types.Position{},
+		}
+
+		if loc := res.PhysicalLocation(); loc != nil {
+			importLocation(r, loc)
+		}
+		r.Fingerprint = fingerprintOf(res, r)
+		return r
+	})
+}
+
+// importLocation 读取结果的文件路径、位置和代码片段
+func importLocation(r *db.SecurityScanningResult, loc *sarif.PhysicalLocation) {
+	if a := loc.ArtifactLocation; a != nil {
+		r.Path = a.URI
+		if u, err := url.Parse(a.URI); err == nil && u.Path != "" {
+			r.Path = path.Clean(u.Path)
+		}
+	}
+	if reg := loc.Region; reg != nil {
+		r.StartPosition = &types.Position{Line: reg.StartLine, Col: reg.StartColumn, Offset: reg.CharOffset}
+		r.EndPosition = &types.Position{
+			Line:   cvt.ZeroWithDefault(reg.EndLine, reg.StartLine),
+			Col:    reg.EndColumn,
+			Offset: reg.CharOffset + reg.CharLength,
+		}
+		if reg.Snippet != nil {
+			r.Lines = reg.Snippet.Text
+		}
+	}
+}
+
+// fingerprintOf 优先使用本系统导出的指纹，否则按键名顺序取第一个
+// 没有 partialFingerprints 时与扫描结果一样按规则、文件和代码计算，重复导入时指纹不变
+func fingerprintOf(res *sarif.Result, r *db.SecurityScanningResult) string {
+	if fp := res.PartialFingerprints[fingerprintKey]; fp != "" {
+		return fp
+	}
+	keys := slices.Sorted(maps.Keys(res.PartialFingerprints))
+	if len(keys) == 0 {
+		return scan.Fingerprint(r.CheckID, r.Path, r.Lines)
+	}
+	return res.PartialFingerprints[keys[0]]
+}
+
+// level 扫描结果的严重程度对应的 SARIF 级别
+func level(severity string) sarif.Level {
+	switch severity {
+	case "CRITICAL", "ERROR":
+		return sarif.LevelError
+	case "INFO":
+		return sarif.LevelNote
+	default:
+		return sarif.LevelWarning
+	}
+}
+
+// severity 依次按结果的 severity 属性、规则的 security-severity 分数和 SARIF 级别确定严重程度
+func severity(res *sarif.Result, rule *sarif.Rule) string {
+	if s := strings.ToUpper(res.Properties.Get("severity")); slices.Contains(severities, s) {
+		return s
+	}
+	if score, err := strconv.ParseFloat(rule.Properties.Get("security-severity"), 64); err == nil {
+		switch {
+		case score >= 9:
+			return "CRITICAL"
+		case score >= 7:
+			return "ERROR"
+		case score >= 4:
+			return "WARNING"
+		default:
+			return "INFO"
+		}
+	}
+	l := res.Level
+	if l == "" && rule.DefaultConfiguration != nil {
+		l = rule.DefaultConfiguration.Level
+	}
+	switch l {
+	case sarif.LevelError:
+		return "ERROR"
+	case sarif.LevelNote, sarif.LevelNone:
+		return "INFO"
+	default:
+		// SARIF 未指定级别时默认为 warning
+		return "WARNING"
+	}
+}
+
+func confidence(res *sarif.Result, rule *sarif.Rule) string {
+	if c := res.Properties.Get("confidence"); c != "" {
+		return c
+	}
+	p := rule.Properties.Get("precision")
+	if p == "very-high" {
+		return "HIGH"
+	}
+	return strings.ToUpper(p)
+}
+
+// cwes 优先使用规则的 cwe 属性，没有时从标签中解析
+func cwes(rule *sarif.Rule) []string {
+	if cwe := rule.Properties.Strings("cwe"); len(cwe) > 0 {
+		return cwe
+	}
+	var cwe []string
+	for _, tag := range rule.Properties.Strings("tags") {
+		if id, ok := strings.CutPrefix(strings.ToLower(tag), cweTagPrefix); ok {
+			cwe = append(cwe, "CWE-"+id)
+		} else if cweRe.MatchString(tag) {
+			cwe = append(cwe, tag)
+		}
+	}
+	return cvt.Unique(cwe)
+}
+
+func owasps(rule *sarif.Rule) []string {
+	if owasp := rule.Properties.Strings("owasp"); len(owasp) > 0 {
+		return owasp
+	}
+	var owasp []string
+	for _, tag := range rule.Properties.Strings("tags") {
+		if o, ok := strings.CutPrefix(tag, owaspTagPrefix); ok {
+			owasp = append(owasp, o)
+		}
+	}
+	return owasp
+}
+
+func anys(ss []string) []any {
+	return cvt.Iter(ss, func(_ int, s string) any {
+		return s
+	})
+}
+
+func setProp(p sarif.Properties, key, value string) {
+	if value != "" {
+		p[key] = value
+	}
+}
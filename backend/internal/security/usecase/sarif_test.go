@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
+	"github.com/chaitin/MonkeyCode/backend/pkg/sarif"
+	"github.com/chaitin/MonkeyCode/backend/pkg/scan"
+)
+
+func TestExportRun(t *testing.T) {
+	result := func(checkID, severity, path string) *db.SecurityScanningResult {
+		return &db.SecurityScanningResult{
+			CheckID:       checkID,
+			Severity:      severity,
+			Path:          path,
+			Message:       "use of unsafe input",
+			MessageZh:     "使用了不安全的输入",
+			AbstractEn:    "SQL injection",
+			AbstractZh:    "SQL 注入",
+			Confidence:    "HIGH",
+			Impact:        "MEDIUM",
+			Lines:         "db.Query(q)",
+			Cwe:           []any{[]any{"CWE-89: SQL Injection"}},
+			Owasp:         []any{"A03:2021 - Injection"},
+			StartPosition: &types.Position{Line: 3, Col: 2, Offset: 20},
+			EndPosition:   &types.Position{Line: 3, Col: 13, Offset: 31},
+			Fingerprint:   "fp-" + checkID,
+		}
+	}
+	fixed := result("go.xss", "WARNING", "/home/dev/project/web.go")
+	fixed.State = consts.SecurityScanningFindingFixed
+	e := &db.SecurityScanning{
+		ID:        uuid.New(),
+		Workspace: "/home/dev/project",
+		Language:  consts.SecurityScanningLanguageGo,
+		Edges: db.SecurityScanningEdges{Results: []*db.SecurityScanningResult{
+			result("go.sqli", "ERROR", "/home/dev/project/db/query.go"),
+			result("go.sqli", "ERROR", "/home/dev/project/db/user file.go"),
+			result("go.weak-hash", "INFO", "/tmp/other.go"),
+			fixed,
+		}},
+	}
+
+	run := exportRun(e)
+	if run.Tool.Driver.Name != sarifTool || run.AutomationDetails.ID != "go/"+e.ID.String() {
+		t.Fatalf("unexpected run %+v", run)
+	}
+	if len(run.Tool.Driver.Rules) != 2 || len(run.Results) != 3 {
+		t.Fatalf("expect 2 rules and 3 results, got %d %d", len(run.Tool.Driver.Rules), len(run.Results))
+	}
+	rule := run.Tool.Driver.Rules[0]
+	if rule.Properties.Get("security-severity") != "8.0" || rule.Properties.Get("precision") != "high" {
+		t.Errorf("unexpected rule properties %+v", rule.Properties)
+	}
+	if tags := rule.Properties.Strings("tags"); !reflect.DeepEqual(tags, []string{"security", "external/cwe/cwe-89"}) {
+		t.Errorf("unexpected tags %v", tags)
+	}
+
+	res := run.Results[1]
+	loc := res.PhysicalLocation()
+	if *res.RuleIndex != 0 || res.Level != sarif.LevelError || loc.ArtifactLocation.URI != "db/user%20file.go" || loc.ArtifactLocation.URIBaseID != sarif.SrcRoot {
+		t.Errorf("unexpected result %+v %+v", res, loc.ArtifactLocation)
+	}
+	if res.PartialFingerprints[fingerprintKey] != "fp-go.sqli" {
+		t.Errorf("unexpected fingerprints %v", res.PartialFingerprints)
+	}
+	if r := loc.Region; r.StartLine != 3 || r.StartColumn != 2 || r.EndColumn != 13 || r.CharOffset != 20 || r.CharLength != 11 {
+		t.Errorf("unexpected region %+v", r)
+	}
+	if loc := run.Results[2].PhysicalLocation(); loc.ArtifactLocation.URI != "file:///tmp/other.go" || *run.Results[2].RuleIndex != 1 {
+		t.Errorf("unexpected location %+v", loc.ArtifactLocation)
+	}
+
+	// 导出后再导入，字段保持不变
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(sarif.New(run)); err != nil {
+		t.Fatal(err)
+	}
+	l, err := sarif.Parse(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := importResults(l.Runs[0])
+	if len(rs) != 3 {
+		t.Fatalf("expect 3 results, got %d", len(rs))
+	}
+	got, want := rs[1], result("go.sqli", "ERROR", "db/user file.go")
+	want.EngineKind = sarifEngine
+	want.Cwe = []any{"CWE-89: SQL Injection"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected import\n got %+v\nwant %+v", got, want)
+	}
+	if rs[2].Path != "/tmp/other.go" || rs[2].Severity != "INFO" {
+		t.Errorf("unexpected import %+v", rs[2])
+	}
+}
+
+func TestImportResults(t *testing.T) {
+	body := `{
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {"name": "Semgrep OSS", "rules": [
+				{
+					"id": "python.flask.xss",
+					"shortDescription": {"text": "Cross-site scripting"},
+					"defaultConfiguration": {"level": "warning"},
+					"properties": {"precision": "very-high", "tags": ["CWE-79: XSS", "OWASP-A07:2017 - XSS", "security"]}
+				},
+				{"id": "java.crypto", "properties": {"security-severity": "9.1", "tags": ["external/cwe/cwe-327"]}}
+			]}},
+			"results": [
+				{
+					"ruleId": "python.flask.xss",
+					"message": {"text": "user input in template"},
+					"partialFingerprints": {"primaryLocationLineHash": "abc:1", "context": "def"},
+					"locations": [{"physicalLocation": {"artifactLocation": {"uri": "app/views.py"}, "region": {"startLine": 10, "startColumn": 5}}}]
+				},
+				{"ruleId": "java.crypto", "level": "note", "message": {"text": "weak cipher"}},
+				{"ruleId": "unknown", "level": "error", "message": {"text": "no rule"}}
+			]
+		}]
+	}`
+	l, err := sarif.Parse(strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rs := importResults(l.Runs[0])
+	if len(rs) != 3 {
+		t.Fatalf("expect 3 results, got %d", len(rs))
+	}
+
+	r := rs[0]
+	if r.Severity != "WARNING" || r.Confidence != "HIGH" || r.AbstractEn != "Cross-site scripting" || r.Path != "app/views.py" {
+		t.Errorf("unexpected result %+v", r)
+	}
+	if !reflect.DeepEqual(r.Cwe, []any{"CWE-79: XSS"}) || !reflect.DeepEqual(r.Owasp, []any{"A07:2017 - XSS"}) {
+		t.Errorf("unexpected cwe %v owasp %v", r.Cwe, r.Owasp)
+	}
+	// 没有 partialFingerprints 时按规则、文件和代码计算
+	if r.Fingerprint != "def" || rs[1].Fingerprint != scan.Fingerprint("java.crypto", "", "") {
+		t.Errorf("unexpected fingerprint %q %q", r.Fingerprint, rs[1].Fingerprint)
+	}
+	if r.StartPosition.Line != 10 || r.StartPosition.Col != 5 || r.EndPosition.Line != 10 {
+		t.Errorf("unexpected position %+v %+v", r.StartPosition, r.EndPosition)
+	}
+	// security-severity 分数优先于级别
+	if r := rs[1]; r.Severity != "CRITICAL" || !reflect.DeepEqual(r.Cwe, []any{"CWE-327"}) {
+		t.Errorf("unexpected result %+v", r)
+	}
+	if r := rs[2]; r.Severity != "ERROR" || r.CheckID != "unknown" || r.Path != "" {
+		t.Errorf("unexpected result %+v", r)
+	}
+}
+
+func TestImportFingerprint(t *testing.T) {
+	parse := func(snippet string, line int) *db.SecurityScanningResult {
+		body := fmt.Sprintf(`{
+			"version": "2.1.0",
+			"runs": [{
+				"tool": {"driver": {"name": "gosec"}},
+				"results": [{
+					"ruleId": "G101",
+					"message": {"text": "hardcoded credentials"},
+					"locations": [{"physicalLocation": {
+						"artifactLocation": {"uri": "file:///src/app/config.go"},
+						"region": {"startLine": %d, "snippet": {"text": %q}}
+					}}]
+				}]
+			}]
+		}`, line, snippet)
+		l, err := sarif.Parse(strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return importResults(l.Runs[0])[0]
+	}
+
+	// 重复导入时指纹不变，代码移动或空白变化不影响指纹
+	a := parse(`password := "secret"`, 10)
+	b := parse("  password  :=  \"secret\"\n", 42)
+	if a.Fingerprint == "" || a.Fingerprint != b.Fingerprint {
+		t.Errorf("fingerprint not stable: %q %q", a.Fingerprint, b.Fingerprint)
+	}
+	if want := scan.Fingerprint("G101", "/src/app/config.go", `password := "secret"`); a.Fingerprint != want {
+		t.Errorf("fingerprint = %q, want %q", a.Fingerprint, want)
+	}
+	if c := parse(`token := "secret"`, 10); c.Fingerprint == a.Fingerprint {
+		t.Error("different code should have different fingerprint")
+	}
+}
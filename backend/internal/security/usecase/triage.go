@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/db"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
+)
+
+// Triage implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) Triage(ctx context.Context, req *domain.TriageSecurityScanningReq) (*domain.SecurityScanningRiskDetail, error) {
+	if err := checkTriage(req, time.Now()); err != nil {
+		return nil, err
+	}
+	r, err := s.repo.Triage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(r, &domain.SecurityScanningRiskDetail{}), nil
+}
+
+// ListComments implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ListComments(ctx context.Context, userID, id string) ([]*domain.SecurityScanningComment, error) {
+	cs, err := s.repo.ListComments(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.Iter(cs, func(_ int, e *db.SecurityScanningComment) *domain.SecurityScanningComment {
+		return cvt.From(e, &domain.SecurityScanningComment{})
+	}), nil
+}
+
+// ListSuppressions implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) ListSuppressions(ctx context.Context, req domain.ListSecurityScanningSuppressionReq) (*domain.ListSecurityScanningSuppressionResp, error) {
+	return s.repo.ListSuppressions(ctx, req)
+}
+
+// CreateSuppression implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) CreateSuppression(ctx context.Context, req *domain.CreateSecurityScanningSuppressionReq) (*domain.SecurityScanningSuppression, error) {
+	if err := checkSuppression(req, time.Now()); err != nil {
+		return nil, err
+	}
+	sp, err := s.repo.CreateSuppression(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return cvt.From(sp, &domain.SecurityScanningSuppression{}), nil
+}
+
+// DeleteSuppression implements domain.SecurityScanningUsecase.
+func (s *SecurityScanningUsecase) DeleteSuppression(ctx context.Context, id string) error {
+	return s.repo.DeleteSuppression(ctx, id)
+}
+
+func checkTriage(req *domain.TriageSecurityScanningReq, now time.Time) error {
+	if req.Status == "" && req.AssigneeID == nil && req.Comment == "" {
+		return fmt.Errorf("nothing to update")
+	}
+	if req.Status != "" && !req.Status.Valid() {
+		return fmt.Errorf("invalid triage status %q", req.Status)
+	}
+	return checkExpires(req.Status, req.ExpiresAt, now)
+}
+
+func checkSuppression(req *domain.CreateSecurityScanningSuppressionReq, now time.Time) error {
+	if req.Fingerprint == "" && req.CheckID == "" {
+		return fmt.Errorf("fingerprint or check_id is required")
+	}
+	if !req.Status.Suppressed() {
+		return fmt.Errorf("suppression status must be %s or %s", consts.SecurityScanningTriageFalsePositive, consts.SecurityScanningTriageAcceptedRisk)
+	}
+	return checkExpires(req.Status, req.ExpiresAt, now)
+}
+
+// checkExpires 只有接受风险可以设置到期时间，且必须晚于当前时间
+func checkExpires(status consts.SecurityScanningTriageStatus, expiresAt int64, now time.Time) error {
+	if expiresAt == 0 {
+		return nil
+	}
+	if status != consts.SecurityScanningTriageAcceptedRisk {
+		return fmt.Errorf("expires_at is only allowed for %s", consts.SecurityScanningTriageAcceptedRisk)
+	}
+	if expiresAt <= now.Unix() {
+		return fmt.Errorf("expires_at must be in the future")
+	}
+	return nil
+}
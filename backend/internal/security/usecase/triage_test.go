@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+	"github.com/chaitin/MonkeyCode/backend/domain"
+)
+
+func TestCheckTriage(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	empty := ""
+	tests := []struct {
+		name string
+		req  domain.TriageSecurityScanningReq
+		ok   bool
+	}{
+		{"empty", domain.TriageSecurityScanningReq{}, false},
+		{"unassign", domain.TriageSecurityScanningReq{AssigneeID: &empty}, true},
+		{"comment", domain.TriageSecurityScanningReq{Comment: "looking"}, true},
+		{"invalid status", domain.TriageSecurityScanningReq{Status: "ignored"}, false},
+		{"false positive", domain.TriageSecurityScanningReq{Status: consts.SecurityScanningTriageFalsePositive}, true},
+		{"accept risk", domain.TriageSecurityScanningReq{Status: consts.SecurityScanningTriageAcceptedRisk, ExpiresAt: now.Unix() + 60}, true},
+		{"accept risk expired", domain.TriageSecurityScanningReq{Status: consts.SecurityScanningTriageAcceptedRisk, ExpiresAt: now.Unix()}, false},
+		{"expires without accept", domain.TriageSecurityScanningReq{Status: consts.SecurityScanningTriageConfirmed, ExpiresAt: now.Unix() + 60}, false},
+	}
+	for _, tt := range tests {
+		if err := checkTriage(&tt.req, now); (err == nil) != tt.ok {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
+
+func TestCheckSuppression(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		name string
+		req  domain.CreateSecurityScanningSuppressionReq
+		ok   bool
+	}{
+		{"no target", domain.CreateSecurityScanningSuppressionReq{Status: consts.SecurityScanningTriageFalsePositive}, false},
+		{"rule", domain.CreateSecurityScanningSuppressionReq{CheckID: "go.sqli", Status: consts.SecurityScanningTriageFalsePositive}, true},
+		{"not suppressed", domain.CreateSecurityScanningSuppressionReq{Fingerprint: "fp", Status: consts.SecurityScanningTriageConfirmed}, false},
+		{"accept risk", domain.CreateSecurityScanningSuppressionReq{Fingerprint: "fp", Status: consts.SecurityScanningTriageAcceptedRisk, ExpiresAt: now.Unix() + 60}, true},
+	}
+	for _, tt := range tests {
+		if err := checkSuppression(&tt.req, now); (err == nil) != tt.ok {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
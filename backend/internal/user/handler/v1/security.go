@@ -49,3 +49,48 @@ func (u *UserHandler) SecurityDetail(c *web.Context) error {
 	}
 	return c.Success(resp)
 }
+
+// SecurityTriage 处置用户扫描发现
+//
+//	@Tags			User Security Scanning
+//	@Summary		处置用户扫描发现
+//	@Description	修改发现的处置状态、处理人并添加评论，标记为误报或接受风险时之后扫描出的相同发现同样被屏蔽
+//	@ID				user-security-scanning-triage
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.TriageSecurityScanningReq	true	"参数"
+//	@Success		200		{object}	web.Resp{data=domain.SecurityScanningRiskDetail}
+//	@Failure		401		{object}	string
+//	@Router			/api/v1/user/security/scanning/triage [put]
+func (u *UserHandler) SecurityTriage(c *web.Context, req domain.TriageSecurityScanningReq) error {
+	user := middleware.GetUser(c)
+	req.UserID = user.ID
+	req.OperatorID = user.ID
+	req.Operator = user.Username
+	resp, err := u.scuse.Triage(c.Request().Context(), &req)
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
+
+// SecurityComments 获取用户扫描发现的评论
+//
+//	@Tags			User Security Scanning
+//	@Summary		获取用户扫描发现的评论
+//	@Description	获取用户扫描发现的处置记录和评论
+//	@ID				user-security-scanning-list-comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id	query		string	true	"发现id"
+//	@Success		200	{object}	web.Resp{data=[]domain.SecurityScanningComment}
+//	@Failure		401	{object}	string
+//	@Router			/api/v1/user/security/scanning/comments [get]
+func (u *UserHandler) SecurityComments(c *web.Context) error {
+	user := middleware.GetUser(c)
+	resp, err := u.scuse.ListComments(c.Request().Context(), user.ID, c.QueryParam("id"))
+	if err != nil {
+		return err
+	}
+	return c.Success(resp)
+}
@@ -91,6 +91,7 @@ func NewUserHandler(
 	admin.DELETE("/delete", web.BaseHandler(u.DeleteAdmin))
 	admin.GET("/export-completion-data", web.BaseHandler(u.ExportCompletionData))
 	admin.POST("/role", web.BindHandler(u.GrantRole))
+	admin.PUT("/group/rate-limit", web.BindHandler(u.UpdateGroupRateLimit))
 
 	// user
 	g := w.Group("/api/v1/user")
@@ -135,6 +136,8 @@ func NewUserHandler(
 	sc.Use(auth.UserAuth(), active.Active("user"))
 	sc.GET("/scanning", web.BindHandler(u.SecurityList, web.WithPage()))
 	sc.GET("/scanning/detail", web.BaseHandler(u.SecurityDetail))
+	sc.PUT("/scanning/triage", web.BindHandler(u.SecurityTriage))
+	sc.GET("/scanning/comments", web.BaseHandler(u.SecurityComments))
 
 	return u
 }
@@ -583,6 +586,24 @@ func (h *UserHandler) GrantRole(c *web.Context, req domain.GrantRoleReq) error {
 	return c.Success(nil)
 }
 
+// UpdateGroupRateLimit 更新用户组限流配置
+//
+//	@Tags			Admin
+//	@Summary		更新用户组限流配置
+//	@Description	更新用户组限流配置，组内所有用户共享该限制
+//	@ID				update-group-rate-limit
+//	@Accept			json
+//	@Produce		json
+//	@Param			param	body		domain.UpdateGroupRateLimitReq	true	"限流配置"
+//	@Success		200		{object}	web.Resp
+//	@Router			/api/v1/admin/group/rate-limit [put]
+func (h *UserHandler) UpdateGroupRateLimit(c *web.Context, req domain.UpdateGroupRateLimitReq) error {
+	if err := h.usecase.UpdateGroupRateLimit(c.Request().Context(), &req); err != nil {
+		return err
+	}
+	return c.Success(nil)
+}
+
 // GetSetting 获取系统设置
 //
 //	@Tags			Admin
@@ -29,6 +29,7 @@ import (
 	"github.com/chaitin/MonkeyCode/backend/db/userloginhistory"
 	"github.com/chaitin/MonkeyCode/backend/domain"
 	"github.com/chaitin/MonkeyCode/backend/ent/rule"
+	"github.com/chaitin/MonkeyCode/backend/ent/types"
 	"github.com/chaitin/MonkeyCode/backend/errcode"
 	"github.com/chaitin/MonkeyCode/backend/pkg/cvt"
 	"github.com/chaitin/MonkeyCode/backend/pkg/entx"
@@ -677,6 +678,14 @@ func (r *UserRepo) GetPermissions(ctx context.Context, id uuid.UUID) (*domain.Pe
 	return res, nil
 }
 
+func (r *UserRepo) UpdateGroupRateLimit(ctx context.Context, id string, limit *types.RateLimit) error {
+	gid, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	return r.db.UserGroup.UpdateOneID(gid).SetRateLimit(limit).Exec(ctx)
+}
+
 func (r *UserRepo) ListRole(ctx context.Context) ([]*db.Role, error) {
 	return r.db.Role.Query().All(ctx)
 }
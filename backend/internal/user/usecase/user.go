@@ -394,6 +394,12 @@ func (u *UserUsecase) UpdateSetting(ctx context.Context, req *domain.UpdateSetti
 		if req.BaseURL != nil {
 			up.SetBaseURL(*req.BaseURL)
 		}
+		if req.RateLimit != nil {
+			up.SetRateLimit(&types.RateLimitSetting{
+				APIKey: types.RateLimit{RPM: req.RateLimit.APIKey.RPM, TPM: req.RateLimit.APIKey.TPM},
+				User:   types.RateLimit{RPM: req.RateLimit.User.RPM, TPM: req.RateLimit.User.TPM},
+			})
+		}
 	})
 	if err != nil {
 		return nil, err
@@ -708,3 +714,13 @@ func (u *UserUsecase) ListRole(ctx context.Context) ([]*domain.Role, error) {
 func (u *UserUsecase) GrantRole(ctx context.Context, req *domain.GrantRoleReq) error {
 	return u.repo.GrantRole(ctx, req)
 }
+
+func (u *UserUsecase) UpdateGroupRateLimit(ctx context.Context, req *domain.UpdateGroupRateLimitReq) error {
+	if req.RateLimit.RPM < 0 || req.RateLimit.TPM < 0 {
+		return fmt.Errorf("invalid rate limit")
+	}
+	return u.repo.UpdateGroupRateLimit(ctx, req.ID, &types.RateLimit{
+		RPM: req.RateLimit.RPM,
+		TPM: req.RateLimit.TPM,
+	})
+}
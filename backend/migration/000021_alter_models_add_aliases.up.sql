@@ -0,0 +1 @@
+ALTER TABLE models ADD COLUMN IF NOT EXISTS aliases JSONB;
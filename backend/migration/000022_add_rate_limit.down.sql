@@ -0,0 +1,2 @@
+ALTER TABLE settings DROP COLUMN IF EXISTS rate_limit;
+ALTER TABLE user_groups DROP COLUMN IF EXISTS rate_limit;
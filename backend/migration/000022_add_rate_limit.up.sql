@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN IF NOT EXISTS rate_limit JSONB;
+ALTER TABLE user_groups ADD COLUMN IF NOT EXISTS rate_limit JSONB;
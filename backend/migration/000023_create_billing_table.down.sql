@@ -0,0 +1,5 @@
+ALTER TABLE user_groups DROP COLUMN IF EXISTS plan_id;
+DROP TABLE IF EXISTS billing_records;
+DROP TABLE IF EXISTS billing_usages;
+DROP TABLE IF EXISTS billing_quotas;
+DROP TABLE IF EXISTS billing_plans;
@@ -0,0 +1,57 @@
+CREATE TABLE IF NOT EXISTS billing_plans (
+    id VARCHAR(64) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    rules JSONB,
+    quota BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_billing_plans_name ON billing_plans (name);
+
+CREATE TABLE IF NOT EXISTS billing_quotas (
+    id VARCHAR(64) PRIMARY KEY,
+    deleted_at TIMESTAMP,
+    user_id VARCHAR(64) NOT NULL,
+    plan_id VARCHAR(64),
+    total BIGINT NOT NULL DEFAULT 0,
+    used BIGINT NOT NULL DEFAULT 0,
+    remain BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_billing_quotas_user_id ON billing_quotas (user_id);
+
+CREATE TABLE IF NOT EXISTS billing_usages (
+    id VARCHAR(64) PRIMARY KEY,
+    deleted_at TIMESTAMP,
+    user_id VARCHAR(64) NOT NULL,
+    model_name VARCHAR(255) NOT NULL,
+    tokens BIGINT NOT NULL DEFAULT 0,
+    operation VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_billing_usages_user_id_created_at ON billing_usages (user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS billing_records (
+    id VARCHAR(64) PRIMARY KEY,
+    tenant_id VARCHAR(64),
+    user_id VARCHAR(64) NOT NULL,
+    model VARCHAR(255) NOT NULL,
+    operation VARCHAR(64) NOT NULL,
+    input_tokens BIGINT NOT NULL DEFAULT 0,
+    output_tokens BIGINT NOT NULL DEFAULT 0,
+    cost BIGINT NOT NULL DEFAULT 0,
+    request_time TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    metadata JSONB,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_billing_records_user_id_request_time ON billing_records (user_id, request_time);
+
+ALTER TABLE user_groups ADD COLUMN IF NOT EXISTS plan_id VARCHAR(64);
@@ -0,0 +1,2 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS cache_hit;
+DROP TABLE IF EXISTS response_caches;
@@ -0,0 +1,25 @@
+CREATE EXTENSION IF NOT EXISTS vector;
+
+CREATE TABLE IF NOT EXISTS response_caches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    cache_key VARCHAR(64) NOT NULL,
+    model_name VARCHAR(255) NOT NULL,
+    model_type VARCHAR(32) NOT NULL,
+    prompt TEXT,
+    completion TEXT NOT NULL,
+    finish_reason VARCHAR(32),
+    input_tokens BIGINT NOT NULL DEFAULT 0,
+    output_tokens BIGINT NOT NULL DEFAULT 0,
+    hits BIGINT NOT NULL DEFAULT 0,
+    embedding vector(1024),
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_response_caches_cache_key ON response_caches (cache_key);
+CREATE INDEX IF NOT EXISTS idx_response_caches_model_name_model_type ON response_caches (model_name, model_type);
+CREATE INDEX IF NOT EXISTS idx_response_caches_expires_at ON response_caches (expires_at);
+CREATE INDEX IF NOT EXISTS idx_response_caches_embedding ON response_caches USING hnsw (embedding vector_cosine_ops);
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS cache_hit BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS model_health_checks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    model_id UUID NOT NULL,
+    success BOOLEAN NOT NULL,
+    latency BIGINT NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_model_health_checks_model_id_created_at ON model_health_checks (model_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_model_health_checks_created_at ON model_health_checks (created_at);
@@ -0,0 +1,3 @@
+ALTER TABLE tasks DROP COLUMN IF EXISTS policy_version;
+DROP TABLE IF EXISTS transform_policies;
+DROP TABLE IF EXISTS transform_rules;
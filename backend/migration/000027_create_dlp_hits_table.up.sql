@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS dlp_hits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    task_id VARCHAR(255) NOT NULL,
+    request_id VARCHAR(255),
+    user_id UUID,
+    rule_id VARCHAR(64) NOT NULL,
+    rule_name VARCHAR(255),
+    category VARCHAR(32) NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    preview TEXT,
+    count INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_dlp_hits_task_id ON dlp_hits (task_id);
+CREATE INDEX IF NOT EXISTS idx_dlp_hits_created_at ON dlp_hits (created_at);
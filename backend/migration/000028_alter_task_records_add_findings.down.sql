@@ -0,0 +1 @@
+ALTER TABLE task_records DROP COLUMN IF EXISTS findings;
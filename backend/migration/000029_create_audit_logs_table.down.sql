@@ -0,0 +1,3 @@
+ALTER TABLE user_groups DROP COLUMN IF EXISTS audit_retention;
+
+DROP TABLE IF EXISTS audit_logs;
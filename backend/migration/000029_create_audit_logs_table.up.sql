@@ -0,0 +1,27 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    request_id VARCHAR(255) NOT NULL,
+    task_id VARCHAR(255),
+    user_id UUID NOT NULL,
+    model_id UUID NOT NULL,
+    model_name VARCHAR(255),
+    model_type VARCHAR(32) NOT NULL,
+    path VARCHAR(255),
+    prompt TEXT,
+    completion TEXT,
+    request BYTEA,
+    response BYTEA,
+    raw_size BIGINT NOT NULL DEFAULT 0,
+    size BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs (created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_user_id_created_at ON audit_logs (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_task_id ON audit_logs (task_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_prompt_trgm ON audit_logs USING GIN (prompt gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_completion_trgm ON audit_logs USING GIN (completion gin_trgm_ops);
+
+ALTER TABLE user_groups ADD COLUMN IF NOT EXISTS audit_retention JSONB;
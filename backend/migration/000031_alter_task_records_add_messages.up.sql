@@ -0,0 +1 @@
+ALTER TABLE task_records ADD COLUMN IF NOT EXISTS messages JSONB;
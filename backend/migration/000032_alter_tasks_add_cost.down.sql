@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS budget_alerts;
+ALTER TABLE user_groups DROP COLUMN IF EXISTS budget;
+ALTER TABLE tasks DROP COLUMN IF EXISTS currency;
+ALTER TABLE tasks DROP COLUMN IF EXISTS cost;
@@ -0,0 +1,19 @@
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS cost BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS currency VARCHAR(16);
+
+ALTER TABLE user_groups ADD COLUMN IF NOT EXISTS budget JSONB;
+
+CREATE TABLE IF NOT EXISTS budget_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    group_id UUID NOT NULL,
+    group_name VARCHAR(255),
+    month VARCHAR(7) NOT NULL,
+    threshold INT NOT NULL,
+    spend BIGINT NOT NULL,
+    budget BIGINT NOT NULL,
+    currency VARCHAR(16),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_idx_budget_alerts_group_id_month_threshold ON budget_alerts (group_id, month, threshold);
+CREATE INDEX IF NOT EXISTS idx_budget_alerts_created_at ON budget_alerts (created_at);
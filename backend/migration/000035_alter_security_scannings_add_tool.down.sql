@@ -0,0 +1 @@
+ALTER TABLE security_scannings DROP COLUMN IF EXISTS tool;
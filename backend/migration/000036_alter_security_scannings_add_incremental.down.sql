@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_security_scannings_workspace_id_language;
+ALTER TABLE security_scanning_results DROP COLUMN IF EXISTS state;
+ALTER TABLE security_scanning_results DROP COLUMN IF EXISTS fingerprint;
+ALTER TABLE security_scannings DROP COLUMN IF EXISTS scanned_files;
+ALTER TABLE security_scannings DROP COLUMN IF EXISTS file_hashes;
+ALTER TABLE security_scannings DROP COLUMN IF EXISTS base_id;
@@ -0,0 +1,6 @@
+ALTER TABLE security_scannings ADD COLUMN IF NOT EXISTS base_id UUID;
+ALTER TABLE security_scannings ADD COLUMN IF NOT EXISTS file_hashes JSONB;
+ALTER TABLE security_scannings ADD COLUMN IF NOT EXISTS scanned_files INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE security_scanning_results ADD COLUMN IF NOT EXISTS fingerprint VARCHAR(255);
+ALTER TABLE security_scanning_results ADD COLUMN IF NOT EXISTS state VARCHAR(32);
+CREATE INDEX IF NOT EXISTS idx_security_scannings_workspace_id_language ON security_scannings (workspace_id, language);
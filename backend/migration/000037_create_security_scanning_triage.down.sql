@@ -0,0 +1,8 @@
+DROP TABLE IF EXISTS security_scanning_suppressions;
+DROP TABLE IF EXISTS security_scanning_comments;
+DROP INDEX IF EXISTS idx_security_scanning_results_fingerprint;
+DROP INDEX IF EXISTS idx_security_scanning_results_suppression_id;
+ALTER TABLE security_scanning_results DROP COLUMN IF EXISTS suppression_id;
+ALTER TABLE security_scanning_results DROP COLUMN IF EXISTS risk_expires_at;
+ALTER TABLE security_scanning_results DROP COLUMN IF EXISTS assignee_id;
+ALTER TABLE security_scanning_results DROP COLUMN IF EXISTS triage_status;
@@ -0,0 +1,34 @@
+ALTER TABLE security_scanning_results ADD COLUMN IF NOT EXISTS triage_status VARCHAR(32) NOT NULL DEFAULT 'open';
+ALTER TABLE security_scanning_results ADD COLUMN IF NOT EXISTS assignee_id UUID;
+ALTER TABLE security_scanning_results ADD COLUMN IF NOT EXISTS risk_expires_at TIMESTAMP;
+ALTER TABLE security_scanning_results ADD COLUMN IF NOT EXISTS suppression_id UUID;
+CREATE INDEX IF NOT EXISTS idx_security_scanning_results_suppression_id ON security_scanning_results (suppression_id);
+CREATE INDEX IF NOT EXISTS idx_security_scanning_results_fingerprint ON security_scanning_results (fingerprint);
+
+CREATE TABLE IF NOT EXISTS security_scanning_comments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    result_id UUID NOT NULL,
+    author_id UUID NOT NULL,
+    author VARCHAR(255),
+    triage_status VARCHAR(32),
+    content TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_scanning_comments_result_id_created_at ON security_scanning_comments (result_id, created_at);
+
+CREATE TABLE IF NOT EXISTS security_scanning_suppressions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    workspace_id UUID,
+    fingerprint VARCHAR(255),
+    check_id VARCHAR(255),
+    status VARCHAR(32) NOT NULL,
+    reason TEXT,
+    expires_at TIMESTAMP,
+    creator_id UUID NOT NULL,
+    creator VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_security_scanning_suppressions_workspace_id_fingerprint ON security_scanning_suppressions (workspace_id, fingerprint);
+CREATE INDEX IF NOT EXISTS idx_security_scanning_suppressions_workspace_id_check_id ON security_scanning_suppressions (workspace_id, check_id);
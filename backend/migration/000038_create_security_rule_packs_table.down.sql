@@ -0,0 +1,4 @@
+ALTER TABLE security_scannings DROP COLUMN IF EXISTS policy;
+DROP TABLE IF EXISTS security_scanning_policies;
+DROP TABLE IF EXISTS security_rule_pack_versions;
+DROP TABLE IF EXISTS security_rule_packs;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS security_rule_packs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(64) NOT NULL,
+    description VARCHAR(255),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    version INTEGER NOT NULL DEFAULT 0,
+    latest_version INTEGER NOT NULL DEFAULT 0,
+    creator VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_idx_security_rule_packs_name ON security_rule_packs (name);
+
+CREATE TABLE IF NOT EXISTS security_rule_pack_versions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pack_id UUID NOT NULL,
+    version INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    checksum VARCHAR(64) NOT NULL,
+    rule_ids JSONB,
+    creator VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_idx_security_rule_pack_versions_pack_id_version ON security_rule_pack_versions (pack_id, version);
+
+CREATE TABLE IF NOT EXISTS security_scanning_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    description VARCHAR(255),
+    language VARCHAR(255),
+    rule_pack_ids JSONB,
+    min_confidence VARCHAR(16) NOT NULL DEFAULT 'MEDIUM',
+    min_impact VARCHAR(16) NOT NULL DEFAULT 'MEDIUM',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS unique_idx_security_scanning_policies_name ON security_scanning_policies (name);
+
+ALTER TABLE security_scannings ADD COLUMN IF NOT EXISTS policy VARCHAR(255);
@@ -0,0 +1 @@
+ALTER TABLE security_scannings ADD COLUMN IF NOT EXISTS sub_scans JSONB;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS unique_idx_billing_records_request_id;
+DROP INDEX IF EXISTS unique_idx_billing_usages_request_id;
+ALTER TABLE billing_records DROP COLUMN IF EXISTS request_id;
+ALTER TABLE billing_usages DROP COLUMN IF EXISTS request_id;
@@ -0,0 +1,4 @@
+ALTER TABLE billing_usages ADD COLUMN IF NOT EXISTS request_id VARCHAR(64);
+ALTER TABLE billing_records ADD COLUMN IF NOT EXISTS request_id VARCHAR(64);
+CREATE UNIQUE INDEX IF NOT EXISTS unique_idx_billing_usages_request_id ON billing_usages (request_id);
+CREATE UNIQUE INDEX IF NOT EXISTS unique_idx_billing_records_request_id ON billing_records (request_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_response_caches_context_key;
+ALTER TABLE response_caches DROP COLUMN IF EXISTS context_key;
@@ -0,0 +1,2 @@
+ALTER TABLE response_caches ADD COLUMN IF NOT EXISTS context_key VARCHAR(64);
+CREATE INDEX IF NOT EXISTS idx_response_caches_context_key ON response_caches (context_key);
@@ -0,0 +1,133 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rokku-c/go-openai"
+)
+
+func TestToOpenAI(t *testing.T) {
+	body := `{
+		"model": "claude",
+		"max_tokens": 1024,
+		"system": [{"type": "text", "text": "be brief"}],
+		"stream": true,
+		"tools": [{"name": "ls", "description": "list", "input_schema": {"type": "object"}}],
+		"tool_choice": {"type": "any"},
+		"messages": [
+			{"role": "user", "content": "hi"},
+			{"role": "assistant", "content": [
+				{"type": "text", "text": "calling"},
+				{"type": "tool_use", "id": "tu_1", "name": "ls", "input": {"path": "."}}
+			]},
+			{"role": "user", "content": [
+				{"type": "tool_result", "tool_use_id": "tu_1", "content": "a.go"},
+				{"type": "text", "text": "next"}
+			]}
+		]
+	}`
+	var req MessagesRequest
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ToOpenAI(&req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out.MaxTokens != 1024 || !out.Stream || out.StreamOptions == nil || !out.StreamOptions.IncludeUsage {
+		t.Fatalf("unexpected options: %+v", out)
+	}
+	if out.ToolChoice != "required" || len(out.Tools) != 1 {
+		t.Fatalf("unexpected tools: %+v %+v", out.Tools, out.ToolChoice)
+	}
+	roles := make([]string, 0, len(out.Messages))
+	for _, m := range out.Messages {
+		roles = append(roles, m.Role)
+	}
+	if got := strings.Join(roles, ","); got != "system,user,assistant,tool,user" {
+		t.Fatalf("unexpected roles: %s", got)
+	}
+	if tc := out.Messages[2].ToolCalls; len(tc) != 1 || tc[0].ID != "tu_1" || tc[0].Function.Arguments != `{"path": "."}` {
+		t.Fatalf("unexpected tool calls: %+v", tc)
+	}
+	if m := out.Messages[3]; m.ToolCallID != "tu_1" || m.Content != "a.go" {
+		t.Fatalf("unexpected tool message: %+v", m)
+	}
+}
+
+func TestFromOpenAI(t *testing.T) {
+	resp := FromOpenAI(&openai.ChatCompletionResponse{
+		ID:    "chatcmpl-1",
+		Model: "gpt",
+		Choices: []openai.ChatCompletionChoice{{
+			FinishReason: openai.FinishReasonToolCalls,
+			Message: openai.ChatCompletionMessage{
+				Content: "ok",
+				ToolCalls: []openai.ToolCall{{
+					ID:       "call_1",
+					Function: openai.FunctionCall{Name: "ls", Arguments: "not json"},
+				}},
+			},
+		}},
+		Usage: openai.Usage{
+			PromptTokens:        3,
+			CompletionTokens:    5,
+			PromptTokensDetails: &openai.PromptTokensDetails{CachedTokens: 2},
+		},
+	})
+
+	// 命中缓存的 token 不计入 input_tokens
+	if resp.StopReason != StopToolUse || resp.Usage.InputTokens != 1 || resp.Usage.CacheReadInputTokens != 2 || resp.Usage.OutputTokens != 5 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if len(resp.Content) != 2 || resp.Content[1].Type != BlockToolUse || string(resp.Content[1].Input) != "{}" {
+		t.Fatalf("unexpected content: %+v", resp.Content)
+	}
+}
+
+func TestStreamReader(t *testing.T) {
+	src := strings.Join([]string{
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"role":"assistant","content":"Hel"}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"content":"lo"}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"ls","arguments":""}}]}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{}"}}]},"finish_reason":"tool_calls"}]}`,
+		`data: {"id":"c1","choices":[],"usage":{"prompt_tokens":7,"completion_tokens":9,"total_tokens":16}}`,
+		`data: [DONE]`,
+		``,
+	}, "\n\n")
+
+	out, err := io.ReadAll(NewStreamReader(io.NopCloser(strings.NewReader(src)), "claude"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var types []string
+	var last StreamEvent
+	for _, line := range strings.Split(string(out), "\n") {
+		data, ok := strings.CutPrefix(line, "data: ")
+		if !ok {
+			continue
+		}
+		var ev StreamEvent
+		if err := json.Unmarshal([]byte(data), &ev); err != nil {
+			t.Fatal(err)
+		}
+		types = append(types, ev.Type)
+		if ev.Type == "message_delta" {
+			last = ev
+		}
+	}
+
+	want := "message_start,content_block_start,content_block_delta,content_block_delta,content_block_stop," +
+		"content_block_start,content_block_delta,content_block_stop,message_delta,message_stop"
+	if got := strings.Join(types, ","); got != want {
+		t.Fatalf("unexpected events:\n got %s\nwant %s", got, want)
+	}
+	if last.Delta.StopReason != StopToolUse || last.Usage.InputTokens != 7 || last.Usage.OutputTokens != 9 {
+		t.Fatalf("unexpected message delta: %+v %+v", last.Delta, last.Usage)
+	}
+}
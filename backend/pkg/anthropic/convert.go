@@ -0,0 +1,239 @@
+package anthropic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/rokku-c/go-openai"
+)
+
+// ToOpenAI 将 Messages 请求转换为 OpenAI Chat Completions 请求
+func ToOpenAI(req *MessagesRequest) (*openai.ChatCompletionRequest, error) {
+	out := &openai.ChatCompletionRequest{
+		Model:     req.Model,
+		MaxTokens: req.MaxTokens,
+		Stop:      req.StopSequences,
+		Stream:    req.Stream,
+	}
+	if req.Temperature != nil {
+		out.Temperature = *req.Temperature
+	}
+	if req.TopP != nil {
+		out.TopP = *req.TopP
+	}
+	if req.Stream {
+		// 流式响应需要返回用量，用于记录和计费
+		out.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+	}
+	if uid, ok := req.Metadata["user_id"].(string); ok {
+		out.User = uid
+	}
+
+	if system := req.System.Text(); system != "" {
+		out.Messages = append(out.Messages, openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: system,
+		})
+	}
+	for _, m := range req.Messages {
+		msgs, err := convertMessage(m)
+		if err != nil {
+			return nil, err
+		}
+		out.Messages = append(out.Messages, msgs...)
+	}
+
+	for _, t := range req.Tools {
+		out.Tools = append(out.Tools, openai.Tool{
+			Type: openai.ToolTypeFunction,
+			Function: &openai.FunctionDefinition{
+				Name:        t.Name,
+				Description: t.Description,
+				Parameters:  t.InputSchema,
+			},
+		})
+	}
+	if tc := req.ToolChoice; tc != nil && len(out.Tools) > 0 {
+		switch tc.Type {
+		case "auto":
+			out.ToolChoice = "auto"
+		case "any":
+			out.ToolChoice = "required"
+		case "none":
+			out.ToolChoice = "none"
+		case "tool":
+			out.ToolChoice = openai.ToolChoice{
+				Type:     openai.ToolTypeFunction,
+				Function: openai.ToolFunction{Name: tc.Name},
+			}
+		}
+	}
+	return out, nil
+}
+
+// convertMessage 转换单条消息，tool_result 需要拆分为独立的 tool 消息
+func convertMessage(m Message) ([]openai.ChatCompletionMessage, error) {
+	switch m.Role {
+	case RoleUser:
+		var (
+			msgs  []openai.ChatCompletionMessage
+			parts []openai.ChatMessagePart
+		)
+		for _, b := range m.Content {
+			switch b.Type {
+			case BlockText:
+				parts = append(parts, openai.ChatMessagePart{Type: openai.ChatMessagePartTypeText, Text: b.Text})
+			case BlockImage:
+				if b.Source == nil {
+					continue
+				}
+				url := b.Source.URL
+				if b.Source.Type == "base64" {
+					url = fmt.Sprintf("data:%s;base64,%s", b.Source.MediaType, b.Source.Data)
+				}
+				parts = append(parts, openai.ChatMessagePart{
+					Type:     openai.ChatMessagePartTypeImageURL,
+					ImageURL: &openai.ChatMessageImageURL{URL: url},
+				})
+			case BlockToolResult:
+				content := b.Content.Text()
+				if b.IsError && content == "" {
+					content = "error"
+				}
+				msgs = append(msgs, openai.ChatCompletionMessage{
+					Role:       openai.ChatMessageRoleTool,
+					ToolCallID: b.ToolUseID,
+					Content:    content,
+				})
+			}
+		}
+		if len(parts) == 0 {
+			return msgs, nil
+		}
+		msg := openai.ChatCompletionMessage{Role: openai.ChatMessageRoleUser}
+		if len(parts) == 1 && parts[0].Type == openai.ChatMessagePartTypeText {
+			msg.Content = parts[0].Text
+		} else {
+			msg.MultiContent = parts
+		}
+		return append(msgs, msg), nil
+
+	case RoleAssistant:
+		msg := openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleAssistant,
+			Content: m.Content.Text(),
+		}
+		for _, b := range m.Content {
+			if b.Type != BlockToolUse {
+				continue
+			}
+			args := string(b.Input)
+			if args == "" {
+				args = "{}"
+			}
+			msg.ToolCalls = append(msg.ToolCalls, openai.ToolCall{
+				ID:   b.ID,
+				Type: openai.ToolTypeFunction,
+				Function: openai.FunctionCall{
+					Name:      b.Name,
+					Arguments: args,
+				},
+			})
+		}
+		return []openai.ChatCompletionMessage{msg}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported message role %q", m.Role)
+	}
+}
+
+// StopReason 将 OpenAI 的 finish_reason 转换为 stop_reason
+func StopReason(reason openai.FinishReason) string {
+	switch reason {
+	case openai.FinishReasonLength:
+		return StopMaxTokens
+	case openai.FinishReasonToolCalls, openai.FinishReasonFunctionCall:
+		return StopToolUse
+	default:
+		return StopEndTurn
+	}
+}
+
+// FromOpenAI 将 OpenAI Chat Completions 响应转换为 Messages 响应
+func FromOpenAI(resp *openai.ChatCompletionResponse) *MessagesResponse {
+	out := &MessagesResponse{
+		ID:         resp.ID,
+		Type:       "message",
+		Role:       RoleAssistant,
+		Model:      resp.Model,
+		Content:    Content{},
+		StopReason: StopEndTurn,
+		Usage:      usage(&resp.Usage),
+	}
+	if len(resp.Choices) == 0 {
+		return out
+	}
+
+	choice := resp.Choices[0]
+	out.StopReason = StopReason(choice.FinishReason)
+	if choice.Message.Content != "" {
+		out.Content = append(out.Content, ContentBlock{Type: BlockText, Text: choice.Message.Content})
+	}
+	for _, tc := range choice.Message.ToolCalls {
+		out.Content = append(out.Content, ContentBlock{
+			Type:  BlockToolUse,
+			ID:    tc.ID,
+			Name:  tc.Function.Name,
+			Input: toolInput(tc.Function.Arguments),
+		})
+	}
+	return out
+}
+
+// toolInput 工具参数不是合法 JSON 时返回空对象
+func toolInput(args string) json.RawMessage {
+	if args == "" || !json.Valid([]byte(args)) {
+		return json.RawMessage("{}")
+	}
+	return json.RawMessage(args)
+}
+
+// ErrorFromOpenAI 将 OpenAI 格式的错误响应体转换为 Anthropic 格式
+func ErrorFromOpenAI(status int, body []byte) *ErrorResponse {
+	msg := string(body)
+	var resp openai.ErrorResponse
+	if err := json.Unmarshal(body, &resp); err == nil && resp.Error != nil && resp.Error.Message != "" {
+		msg = resp.Error.Message
+	}
+	return NewError(ErrorType(status), msg)
+}
+
+// ErrorType 根据 HTTP 状态码返回错误类型
+func ErrorType(status int) string {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return ErrAuthentication
+	case status == http.StatusTooManyRequests:
+		return ErrRateLimit
+	case status == http.StatusServiceUnavailable:
+		return ErrOverloaded
+	case status >= http.StatusBadRequest && status < http.StatusInternalServerError:
+		return ErrInvalidRequest
+	default:
+		return ErrAPI
+	}
+}
+
+// usage 转换用量，OpenAI 的 prompt_tokens 包含命中缓存的 token，Anthropic 分开返回
+func usage(u *openai.Usage) Usage {
+	out := Usage{
+		InputTokens:  u.PromptTokens,
+		OutputTokens: u.CompletionTokens,
+	}
+	if d := u.PromptTokensDetails; d != nil && d.CachedTokens > 0 {
+		out.InputTokens -= d.CachedTokens
+		out.CacheReadInputTokens = d.CachedTokens
+	}
+	return out
+}
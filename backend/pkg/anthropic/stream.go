@@ -0,0 +1,234 @@
+package anthropic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/rokku-c/go-openai"
+)
+
+// StreamReader 将 OpenAI 的流式响应转换为 Anthropic 的流式事件
+type StreamReader struct {
+	src    io.ReadCloser
+	reader *bufio.Reader
+	model  string
+	buf    bytes.Buffer
+	done   bool
+
+	started    bool
+	id         string
+	index      int    // 当前内容块下标
+	block      string // 当前内容块类型，为空表示没有打开的内容块
+	toolIndex  map[int]int
+	stopReason string
+	usage      Usage
+}
+
+var _ io.ReadCloser = &StreamReader{}
+
+func NewStreamReader(src io.ReadCloser, model string) *StreamReader {
+	return &StreamReader{
+		src:       src,
+		reader:    bufio.NewReader(src),
+		model:     model,
+		index:     -1,
+		toolIndex: make(map[int]int),
+	}
+}
+
+// Read implements io.ReadCloser.
+func (s *StreamReader) Read(p []byte) (int, error) {
+	for s.buf.Len() == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		line, err := s.reader.ReadString('\n')
+		if line != "" {
+			s.processLine(line)
+		}
+		if errors.Is(err, io.EOF) {
+			s.finish()
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	return s.buf.Read(p)
+}
+
+// Close implements io.ReadCloser.
+func (s *StreamReader) Close() error {
+	return s.src.Close()
+}
+
+func (s *StreamReader) processLine(line string) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "data:") {
+		return
+	}
+	data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	if data == "" {
+		return
+	}
+	if data == "[DONE]" {
+		s.finish()
+		return
+	}
+
+	var chunk openai.ChatCompletionStreamResponse
+	if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+		return
+	}
+	s.start(chunk.ID)
+	if chunk.Usage != nil {
+		s.usage = usage(chunk.Usage)
+	}
+	if len(chunk.Choices) == 0 {
+		return
+	}
+
+	choice := chunk.Choices[0]
+	if text := choice.Delta.Content; text != "" {
+		s.open(BlockText, map[string]any{"type": BlockText, "text": ""})
+		s.event("content_block_delta", map[string]any{
+			"index": s.index,
+			"delta": map[string]any{"type": "text_delta", "text": text},
+		})
+	}
+	for _, tc := range choice.Delta.ToolCalls {
+		i := 0
+		if tc.Index != nil {
+			i = *tc.Index
+		}
+		if _, ok := s.toolIndex[i]; !ok {
+			s.close()
+			s.open(BlockToolUse, map[string]any{
+				"type":  BlockToolUse,
+				"id":    tc.ID,
+				"name":  tc.Function.Name,
+				"input": map[string]any{},
+			})
+			s.toolIndex[i] = s.index
+		}
+		if tc.Function.Arguments != "" && s.toolIndex[i] == s.index {
+			s.event("content_block_delta", map[string]any{
+				"index": s.index,
+				"delta": map[string]any{"type": "input_json_delta", "partial_json": tc.Function.Arguments},
+			})
+		}
+	}
+	if choice.FinishReason != "" {
+		s.stopReason = StopReason(choice.FinishReason)
+	}
+}
+
+func (s *StreamReader) start(id string) {
+	if s.started {
+		return
+	}
+	s.started = true
+	s.id = id
+	s.event("message_start", map[string]any{
+		"message": map[string]any{
+			"id":            id,
+			"type":          "message",
+			"role":          RoleAssistant,
+			"model":         s.model,
+			"content":       []any{},
+			"stop_reason":   nil,
+			"stop_sequence": nil,
+			"usage":         Usage{},
+		},
+	})
+}
+
+// open 打开新的内容块，类型相同时复用当前内容块
+func (s *StreamReader) open(typ string, block map[string]any) {
+	if s.block == typ && typ != BlockToolUse {
+		return
+	}
+	s.close()
+	s.index++
+	s.block = typ
+	s.event("content_block_start", map[string]any{
+		"index":         s.index,
+		"content_block": block,
+	})
+}
+
+func (s *StreamReader) close() {
+	if s.block == "" {
+		return
+	}
+	s.event("content_block_stop", map[string]any{"index": s.index})
+	s.block = ""
+}
+
+func (s *StreamReader) finish() {
+	if s.done {
+		return
+	}
+	s.done = true
+	s.start(s.id)
+	s.close()
+	if s.stopReason == "" {
+		s.stopReason = StopEndTurn
+	}
+	s.event("message_delta", map[string]any{
+		"delta": map[string]any{"stop_reason": s.stopReason, "stop_sequence": nil},
+		"usage": s.usage,
+	})
+	s.event("message_stop", map[string]any{})
+}
+
+func (s *StreamReader) event(typ string, data map[string]any) {
+	data["type"] = typ
+	b, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	s.buf.WriteString("event: " + typ + "\n")
+	s.buf.WriteString("data: ")
+	s.buf.Write(b)
+	s.buf.WriteString("\n\n")
+}
+
+// ResponseReader 读取完整的 OpenAI 响应并转换为 Messages 响应
+type ResponseReader struct {
+	src io.ReadCloser
+	buf *bytes.Reader
+}
+
+var _ io.ReadCloser = &ResponseReader{}
+
+func NewResponseReader(src io.ReadCloser) *ResponseReader {
+	return &ResponseReader{src: src}
+}
+
+// Read implements io.ReadCloser.
+func (r *ResponseReader) Read(p []byte) (int, error) {
+	if r.buf == nil {
+		body, err := io.ReadAll(r.src)
+		if err != nil {
+			return 0, err
+		}
+		var resp openai.ChatCompletionResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		b, err := json.Marshal(FromOpenAI(&resp))
+		if err != nil {
+			return 0, err
+		}
+		r.buf = bytes.NewReader(b)
+	}
+	return r.buf.Read(p)
+}
+
+// Close implements io.ReadCloser.
+func (r *ResponseReader) Close() error {
+	return r.src.Close()
+}
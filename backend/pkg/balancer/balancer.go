@@ -0,0 +1,214 @@
+package balancer
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type Strategy string
+
+const (
+	StrategyWeightedRoundRobin Strategy = "weighted_round_robin" // 平滑加权轮询
+	StrategyLeastInFlight      Strategy = "least_inflight"       // 最少在途请求
+	StrategyLatency            Strategy = "latency"              // 延迟感知
+)
+
+// latencyAlpha 延迟 EWMA 的平滑系数
+const latencyAlpha = 0.3
+
+var ErrNoAvailableNode = errors.New("no available node")
+
+// Node 负载均衡节点，ID、Weight、Value 创建后不再修改，可以在 Pick 返回后无锁读取
+type Node[T any] struct {
+	ID     string
+	Weight int
+	Value  T
+
+	current int // 平滑加权轮询的当前权重，只在持有 Balancer 锁时读写
+	stats   *nodeStats
+}
+
+// nodeStats 节点的运行统计，Update 替换节点时由新旧节点共享
+type nodeStats struct {
+	inflight atomic.Int64
+	latency  atomic.Int64 // EWMA 延迟，单位纳秒
+}
+
+func NewNode[T any](id string, weight int, value T) *Node[T] {
+	if weight <= 0 {
+		weight = 1
+	}
+	return &Node[T]{ID: id, Weight: weight, Value: value, stats: &nodeStats{}}
+}
+
+// InFlight 返回节点当前在途请求数
+func (n *Node[T]) InFlight() int64 {
+	return n.stats.inflight.Load()
+}
+
+// Latency 返回节点的 EWMA 延迟
+func (n *Node[T]) Latency() time.Duration {
+	return time.Duration(n.stats.latency.Load())
+}
+
+// Acquire 标记一个请求开始
+func (n *Node[T]) Acquire() {
+	n.stats.inflight.Add(1)
+}
+
+// Release 标记一个请求结束，latency 为 0 时不更新延迟统计
+func (n *Node[T]) Release(latency time.Duration) {
+	if n.stats.inflight.Add(-1) < 0 {
+		n.stats.inflight.Store(0)
+	}
+	if latency <= 0 {
+		return
+	}
+	for {
+		old := n.stats.latency.Load()
+		next := int64(latency)
+		if old > 0 {
+			next = int64(latencyAlpha*float64(latency) + (1-latencyAlpha)*float64(old))
+		}
+		if n.stats.latency.CompareAndSwap(old, next) {
+			return
+		}
+	}
+}
+
+type Balancer[T any] struct {
+	mu       sync.Mutex
+	strategy Strategy
+	nodes    []*Node[T]
+	offset   int
+}
+
+func New[T any](strategy Strategy) *Balancer[T] {
+	return &Balancer[T]{strategy: strategy}
+}
+
+func (b *Balancer[T]) Strategy() Strategy {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.strategy
+}
+
+func (b *Balancer[T]) SetStrategy(strategy Strategy) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.strategy = strategy
+}
+
+// Update 替换节点列表，ID 相同的节点保留在途请求数与延迟统计
+// 不修改已有节点，调用方持有的旧节点仍然有效，Release 会计入新节点的统计
+func (b *Balancer[T]) Update(nodes []*Node[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	old := make(map[string]*Node[T], len(b.nodes))
+	for _, n := range b.nodes {
+		old[n.ID] = n
+	}
+
+	next := make([]*Node[T], 0, len(nodes))
+	for _, n := range nodes {
+		if o, ok := old[n.ID]; ok {
+			n.current = o.current
+			n.stats = o.stats
+		}
+		if n.stats == nil {
+			n.stats = &nodeStats{}
+		}
+		next = append(next, n)
+	}
+	b.nodes = next
+}
+
+// Nodes 返回当前节点列表的副本
+func (b *Balancer[T]) Nodes() []*Node[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*Node[T](nil), b.nodes...)
+}
+
+func (b *Balancer[T]) Get(id string) (*Node[T], bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, n := range b.nodes {
+		if n.ID == id {
+			return n, true
+		}
+	}
+	return nil, false
+}
+
+// Pick 按策略选择一个节点，filter 返回 false 的节点会被跳过
+func (b *Balancer[T]) Pick(filter func(*Node[T]) bool) (*Node[T], error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	candidates := make([]*Node[T], 0, len(b.nodes))
+	for i := range b.nodes {
+		// 从偏移位置开始遍历，保证同分节点之间轮转
+		n := b.nodes[(i+b.offset)%len(b.nodes)]
+		if filter == nil || filter(n) {
+			candidates = append(candidates, n)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, ErrNoAvailableNode
+	}
+	b.offset++
+
+	switch b.strategy {
+	case StrategyLeastInFlight:
+		return leastInFlight(candidates), nil
+	case StrategyLatency:
+		return lowestLatency(candidates), nil
+	default:
+		return weightedRoundRobin(candidates), nil
+	}
+}
+
+func weightedRoundRobin[T any](nodes []*Node[T]) *Node[T] {
+	var best *Node[T]
+	total := 0
+	for _, n := range nodes {
+		n.current += n.Weight
+		total += n.Weight
+		if best == nil || n.current > best.current {
+			best = n
+		}
+	}
+	best.current -= total
+	return best
+}
+
+func leastInFlight[T any](nodes []*Node[T]) *Node[T] {
+	best := nodes[0]
+	for _, n := range nodes[1:] {
+		// 比较 inflight/weight，避免浮点运算
+		if n.InFlight()*int64(best.Weight) < best.InFlight()*int64(n.Weight) {
+			best = n
+		}
+	}
+	return best
+}
+
+func lowestLatency[T any](nodes []*Node[T]) *Node[T] {
+	best := nodes[0]
+	bestScore := latencyScore(best)
+	for _, n := range nodes[1:] {
+		if s := latencyScore(n); s < bestScore {
+			best, bestScore = n, s
+		}
+	}
+	return best
+}
+
+// latencyScore 未采样的节点得分为 0，优先被选中以获得延迟数据
+func latencyScore[T any](n *Node[T]) float64 {
+	return float64(n.Latency()) * float64(n.InFlight()+1) / float64(n.Weight)
+}
@@ -0,0 +1,138 @@
+package balancer
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWeightedRoundRobin(t *testing.T) {
+	b := New[string](StrategyWeightedRoundRobin)
+	b.Update([]*Node[string]{
+		NewNode("a", 3, "a"),
+		NewNode("b", 1, "b"),
+	})
+
+	counts := make(map[string]int)
+	for range 8 {
+		n, err := b.Pick(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[n.ID]++
+	}
+	if counts["a"] != 6 || counts["b"] != 2 {
+		t.Fatalf("unexpected distribution: %v", counts)
+	}
+}
+
+func TestLeastInFlight(t *testing.T) {
+	b := New[string](StrategyLeastInFlight)
+	b.Update([]*Node[string]{
+		NewNode("a", 1, "a"),
+		NewNode("b", 1, "b"),
+	})
+
+	a, _ := b.Get("a")
+	a.Acquire()
+	for range 4 {
+		n, err := b.Pick(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n.ID != "b" {
+			t.Fatalf("expected b, got %s", n.ID)
+		}
+	}
+}
+
+func TestLatency(t *testing.T) {
+	b := New[string](StrategyLatency)
+	b.Update([]*Node[string]{
+		NewNode("slow", 1, "slow"),
+		NewNode("fast", 1, "fast"),
+	})
+
+	slow, _ := b.Get("slow")
+	slow.Acquire()
+	slow.Release(2 * time.Second)
+	fast, _ := b.Get("fast")
+	fast.Acquire()
+	fast.Release(200 * time.Millisecond)
+
+	n, err := b.Pick(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.ID != "fast" {
+		t.Fatalf("expected fast, got %s", n.ID)
+	}
+}
+
+func TestUpdateKeepsStats(t *testing.T) {
+	b := New[string](StrategyLeastInFlight)
+	b.Update([]*Node[string]{NewNode("a", 1, "a")})
+	a, _ := b.Get("a")
+	a.Acquire()
+
+	b.Update([]*Node[string]{NewNode("a", 2, "a2"), NewNode("c", 1, "c")})
+	n, _ := b.Get("a")
+	if n.InFlight() != 1 || n.Weight != 2 || n.Value != "a2" {
+		t.Fatalf("stats not kept: inflight=%d weight=%d value=%s", n.InFlight(), n.Weight, n.Value)
+	}
+
+	if _, err := b.Pick(func(n *Node[string]) bool { return n.ID == "x" }); err != ErrNoAvailableNode {
+		t.Fatalf("expected ErrNoAvailableNode, got %v", err)
+	}
+}
+
+// TestConcurrentUpdatePick 需要配合 -race 运行，Update 不能修改 Pick 已返回的节点
+func TestConcurrentUpdatePick(t *testing.T) {
+	b := New[string](StrategyWeightedRoundRobin)
+	b.Update([]*Node[string]{NewNode("a", 1, "a0"), NewNode("b", 1, "b0")})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := range 500 {
+			b.Update([]*Node[string]{
+				NewNode("a", i%3+1, fmt.Sprintf("a%d", i)),
+				NewNode("b", 1, fmt.Sprintf("b%d", i)),
+			})
+		}
+	}()
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 500 {
+				n, err := b.Pick(nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				n.Acquire()
+				if n.Value == "" || n.Weight <= 0 {
+					t.Errorf("unexpected node %s value=%q weight=%d", n.ID, n.Value, n.Weight)
+				}
+				n.Release(time.Millisecond)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 旧节点上的在途请求在替换后仍计入新节点
+	old, _ := b.Get("a")
+	old.Acquire()
+	b.Update([]*Node[string]{NewNode("a", 1, "a"), NewNode("b", 1, "b")})
+	n, _ := b.Get("a")
+	if n == old || n.InFlight() != 1 {
+		t.Fatalf("expected replaced node with shared stats, inflight=%d", n.InFlight())
+	}
+	old.Release(0)
+	if n.InFlight() != 0 {
+		t.Fatalf("release on old node not reflected, inflight=%d", n.InFlight())
+	}
+}
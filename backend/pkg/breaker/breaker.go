@@ -0,0 +1,127 @@
+package breaker
+
+import (
+	"sync"
+	"time"
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"    // 正常
+	StateOpen     State = "open"      // 熔断
+	StateHalfOpen State = "half_open" // 半开，放行一个探测请求
+)
+
+type Config struct {
+	FailureThreshold int           // 连续失败多少次后熔断
+	OpenTimeout      time.Duration // 熔断持续时间，到期后进入半开状态
+}
+
+type Breaker struct {
+	mu       sync.Mutex
+	cfg      Config
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+	onChange func(from, to State)
+	now      func() time.Time
+}
+
+// New 创建熔断器，onChange 在状态变化时回调，可以为 nil
+func New(cfg Config, onChange func(from, to State)) *Breaker {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = 1
+	}
+	return &Breaker{
+		cfg:      cfg,
+		state:    StateClosed,
+		onChange: onChange,
+		now:      time.Now,
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Ready 判断当前是否可能放行请求，不改变熔断器状态
+func (b *Breaker) Ready() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case StateOpen:
+		return b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout
+	case StateHalfOpen:
+		return !b.probing
+	default:
+		return true
+	}
+}
+
+// Allow 申请放行一个请求，半开状态下同一时间只放行一个探测请求
+func (b *Breaker) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return false
+		}
+		b.setState(StateHalfOpen)
+		b.probing = true
+		return true
+	case StateHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Success 记录一次成功请求
+func (b *Breaker) Success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.probing = false
+	if b.state != StateClosed {
+		b.setState(StateClosed)
+	}
+}
+
+// Failure 记录一次失败请求
+func (b *Breaker) Failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	switch b.state {
+	case StateHalfOpen:
+		b.open()
+	case StateClosed:
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.open()
+		}
+	}
+}
+
+func (b *Breaker) open() {
+	b.failures = 0
+	b.openedAt = b.now()
+	b.setState(StateOpen)
+}
+
+func (b *Breaker) setState(to State) {
+	from := b.state
+	b.state = to
+	if b.onChange != nil && from != to {
+		b.onChange(from, to)
+	}
+}
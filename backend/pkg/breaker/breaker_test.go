@@ -0,0 +1,54 @@
+package breaker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	var changes []State
+	b := New(Config{FailureThreshold: 2, OpenTimeout: time.Minute}, func(_, to State) {
+		changes = append(changes, to)
+	})
+	b.now = func() time.Time { return now }
+
+	b.Failure()
+	if !b.Allow() {
+		t.Fatal("should allow before threshold")
+	}
+	b.Failure()
+	if b.State() != StateOpen || b.Allow() || b.Ready() {
+		t.Fatalf("expected open, got %s", b.State())
+	}
+
+	now = now.Add(time.Minute)
+	if !b.Ready() || !b.Allow() {
+		t.Fatal("should allow probe after open timeout")
+	}
+	if b.State() != StateHalfOpen || b.Allow() {
+		t.Fatal("only one probe allowed in half open")
+	}
+
+	b.Failure()
+	if b.State() != StateOpen {
+		t.Fatalf("failed probe should reopen, got %s", b.State())
+	}
+
+	now = now.Add(time.Minute)
+	b.Allow()
+	b.Success()
+	if b.State() != StateClosed || !b.Allow() {
+		t.Fatalf("successful probe should close, got %s", b.State())
+	}
+
+	want := []State{StateOpen, StateHalfOpen, StateOpen, StateHalfOpen, StateClosed}
+	if len(changes) != len(want) {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Fatalf("unexpected changes: %v", changes)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package dlp
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// Rule 正则检测规则
+type Rule struct {
+	ID       string
+	Name     string
+	Category consts.DLPCategory
+	Pattern  *regexp.Regexp
+	Group    int               // 需要处理的捕获组，0 表示整个匹配
+	Validate func(string) bool // 对命中内容的二次校验，为 nil 时不校验
+	Action   consts.DLPAction
+}
+
+// Entropy 高熵字符串检测，用于发现没有固定格式的密钥
+type Entropy struct {
+	Threshold float64 // 每个字符的最低香农熵
+	MinLength int     // 最短长度
+	Action    consts.DLPAction
+}
+
+// EntropyRuleID 高熵字符串检测的规则ID
+const EntropyRuleID = "high_entropy"
+
+// Finding 命中的敏感内容
+type Finding struct {
+	RuleID   string
+	RuleName string
+	Category consts.DLPCategory
+	Action   consts.DLPAction
+	Start    int
+	End      int
+	Match    string
+}
+
+type Scanner struct {
+	rules   []*Rule
+	entropy *Entropy
+}
+
+// New 创建检测器，entropy 为 nil 时不做高熵检测
+func New(rules []*Rule, entropy *Entropy) *Scanner {
+	return &Scanner{rules: rules, entropy: entropy}
+}
+
+var tokenPattern = regexp.MustCompile(`[A-Za-z0-9+/=_\-]+`)
+
+// Scan 检测文本中的敏感内容，结果按位置排序，重叠的命中只保留先出现的一个
+func (s *Scanner) Scan(text string) []*Finding {
+	var findings []*Finding
+	for _, r := range s.rules {
+		for _, m := range r.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := m[2*r.Group], m[2*r.Group+1]
+			if start < 0 || start == end {
+				continue
+			}
+			if r.Validate != nil && !r.Validate(text[start:end]) {
+				continue
+			}
+			findings = append(findings, &Finding{
+				RuleID:   r.ID,
+				RuleName: r.Name,
+				Category: r.Category,
+				Action:   r.Action,
+				Start:    start,
+				End:      end,
+				Match:    text[start:end],
+			})
+		}
+	}
+	if s.entropy != nil {
+		for _, m := range tokenPattern.FindAllStringIndex(text, -1) {
+			token := text[m[0]:m[1]]
+			if len(token) < s.entropy.MinLength || !mixed(token) || ShannonEntropy(token) < s.entropy.Threshold {
+				continue
+			}
+			findings = append(findings, &Finding{
+				RuleID:   EntropyRuleID,
+				RuleName: "高熵字符串",
+				Category: consts.DLPCategorySecrets,
+				Action:   s.entropy.Action,
+				Start:    m[0],
+				End:      m[1],
+				Match:    token,
+			})
+		}
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].Start != findings[j].Start {
+			return findings[i].Start < findings[j].Start
+		}
+		return findings[i].End > findings[j].End
+	})
+	out := findings[:1]
+	for _, f := range findings[1:] {
+		if f.Start >= out[len(out)-1].End {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// Redact 将需要脱敏的命中内容替换为占位符，findings 必须来自 Scan
+func Redact(text string, findings []*Finding) string {
+	var b strings.Builder
+	last := 0
+	for _, f := range findings {
+		if f.Action != consts.DLPActionRedact && f.Action != consts.DLPActionBlock {
+			continue
+		}
+		b.WriteString(text[last:f.Start])
+		b.WriteString(Placeholder(f.RuleID))
+		last = f.End
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// Placeholder 脱敏后的占位符
+func Placeholder(ruleID string) string {
+	return fmt.Sprintf("[REDACTED:%s]", ruleID)
+}
+
+// Mask 生成用于记录的脱敏预览，只保留开头几个字符
+func Mask(s string) string {
+	r := []rune(s)
+	keep := min(4, len(r)/4)
+	return string(r[:keep]) + strings.Repeat("*", min(len(r)-keep, 8))
+}
+
+// ShannonEntropy 计算每个字符的香农熵
+func ShannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+	freq := make(map[rune]int)
+	n := 0
+	for _, c := range s {
+		freq[c]++
+		n++
+	}
+	var e float64
+	for _, c := range freq {
+		p := float64(c) / float64(n)
+		e -= p * math.Log2(p)
+	}
+	return e
+}
+
+// mixed 是否同时包含字母和数字，排除较长的普通标识符
+func mixed(s string) bool {
+	var letter, digit bool
+	for _, c := range s {
+		switch {
+		case c >= '0' && c <= '9':
+			digit = true
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			letter = true
+		}
+	}
+	return letter && digit
+}
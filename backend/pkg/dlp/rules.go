@@ -0,0 +1,60 @@
+package dlp
+
+import (
+	"regexp"
+
+	"github.com/chaitin/MonkeyCode/backend/consts"
+)
+
+// builtin 内置检测规则，处理方式由调用方按策略设置
+var builtin = []struct {
+	id       string
+	name     string
+	category consts.DLPCategory
+	pattern  string
+	group    int
+	validate func(string) bool
+}{
+	{"private_key", "私钥", consts.DLPCategorySecrets, `-----BEGIN (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----[\s\S]*?-----END (?:[A-Z]+ )*PRIVATE KEY(?: BLOCK)?-----`, 0, nil},
+	{"aws_access_key", "AWS Access Key", consts.DLPCategorySecrets, `\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`, 0, nil},
+	{"github_token", "GitHub Token", consts.DLPCategorySecrets, `\bgh[pousr]_[A-Za-z0-9]{36,}\b`, 0, nil},
+	{"slack_token", "Slack Token", consts.DLPCategorySecrets, `\bxox[abposr]-[A-Za-z0-9-]{10,}\b`, 0, nil},
+	{"google_api_key", "Google API Key", consts.DLPCategorySecrets, `\bAIza[0-9A-Za-z_\-]{35}\b`, 0, nil},
+	{"openai_api_key", "OpenAI API Key", consts.DLPCategorySecrets, `\bsk-(?:proj-)?[A-Za-z0-9_\-]{20,}\b`, 0, nil},
+	{"jwt", "JWT", consts.DLPCategorySecrets, `\beyJ[A-Za-z0-9_\-]{10,}\.eyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}`, 0, nil},
+	{"connection_string", "连接串密码", consts.DLPCategorySecrets, `\b[a-zA-Z][a-zA-Z0-9+.\-]*://[^\s:/@]+:([^\s@/]+)@`, 1, nil},
+	{"generic_secret", "密钥赋值", consts.DLPCategorySecrets, `(?i)(?:api[_\-]?key|secret(?:[_\-]?key)?|access[_\-]?token|auth[_\-]?token|passwd|password)\b["']?\s*[:=]\s*["']([^\s"']{8,})["']`, 1, secretLike},
+	{"email", "邮箱地址", consts.DLPCategoryPII, `\b[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}\b`, 0, nil},
+	{"cn_mobile", "手机号", consts.DLPCategoryPII, `\b1[3-9]\d{9}\b`, 0, nil},
+	{"cn_id_card", "身份证号", consts.DLPCategoryPII, `\b[1-9]\d{5}(?:19|20)\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])\d{3}[\dXx]\b`, 0, nil},
+}
+
+// Builtin 返回内置规则，action 根据规则ID和类别决定处理方式，返回空字符串表示停用该规则
+func Builtin(action func(id string, category consts.DLPCategory) consts.DLPAction) []*Rule {
+	var rules []*Rule
+	for _, b := range builtin {
+		a := action(b.id, b.category)
+		if a == "" {
+			continue
+		}
+		rules = append(rules, &Rule{
+			ID:       b.id,
+			Name:     b.name,
+			Category: b.category,
+			Pattern:  regexp.MustCompile(b.pattern),
+			Group:    b.group,
+			Validate: b.validate,
+			Action:   a,
+		})
+	}
+	return rules
+}
+
+// secretLikeEntropy 密钥赋值中字符串字面量的最低香农熵
+const secretLikeEntropy = 3.5
+
+// secretLike 赋值给密钥变量的字面量是否像真实密钥
+// 排除 "your-api-key-here" 之类的占位符和普通单词，只保留同时包含字母数字的高熵字符串
+func secretLike(s string) bool {
+	return mixed(s) && ShannonEntropy(s) >= secretLikeEntropy
+}
@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// script 基于 redis 的令牌桶，所有桶按每分钟 limit 个令牌匀速补充
+//
+//	KEYS: 令牌桶 key
+//	ARGV: now(毫秒), force, 之后每个桶依次为 limit, cost
+//
+// force 为 0 时任意一个桶令牌不足则全部不扣减；为 1 时强制扣减，余额可以为负
+var script = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local force = tonumber(ARGV[2])
+local tokens = {}
+local denied = 0
+local wait = 0
+for i = 1, #KEYS do
+	local limit = tonumber(ARGV[1 + i * 2])
+	local cost = tonumber(ARGV[2 + i * 2])
+	local rate = limit / 60000
+	local b = redis.call('HMGET', KEYS[i], 'tokens', 'ts')
+	local t = tonumber(b[1]) or limit
+	local ts = tonumber(b[2]) or now
+	t = math.min(limit, t + math.max(0, now - ts) * rate)
+	tokens[i] = t
+	if force == 0 and denied == 0 and (t < cost or t <= 0) then
+		denied = i
+		wait = math.ceil((math.max(cost, 1) - t) / rate)
+	end
+end
+local res = {denied, wait}
+for i = 1, #KEYS do
+	local limit = tonumber(ARGV[1 + i * 2])
+	local cost = tonumber(ARGV[2 + i * 2])
+	if denied == 0 then
+		tokens[i] = tokens[i] - cost
+	end
+	redis.call('HSET', KEYS[i], 'tokens', tostring(tokens[i]), 'ts', now)
+	redis.call('PEXPIRE', KEYS[i], 120000)
+	res[i + 2] = math.floor(tokens[i])
+end
+return res
+`)
+
+type Bucket struct {
+	Key   string
+	Limit int // 每分钟令牌数
+	Cost  int // 本次消耗的令牌数，为 0 时只检查余额是否大于 0
+}
+
+type Result struct {
+	Allowed    bool
+	Denied     int           // 令牌不足的桶下标，允许时为 -1
+	Remaining  []int         // 各个桶剩余的令牌数
+	RetryAfter time.Duration // 令牌不足时需要等待的时间
+}
+
+type Limiter struct {
+	redis *redis.Client
+	now   func() time.Time
+}
+
+func New(redis *redis.Client) *Limiter {
+	return &Limiter{redis: redis, now: time.Now}
+}
+
+// Take 原子地检查并扣减多个令牌桶，任意一个桶令牌不足时均不扣减
+func (l *Limiter) Take(ctx context.Context, buckets []Bucket) (*Result, error) {
+	return l.run(ctx, buckets, false)
+}
+
+// Consume 强制扣减令牌，余额可以为负，用于请求结束后按实际用量扣减
+func (l *Limiter) Consume(ctx context.Context, buckets []Bucket) error {
+	_, err := l.run(ctx, buckets, true)
+	return err
+}
+
+func (l *Limiter) run(ctx context.Context, buckets []Bucket, force bool) (*Result, error) {
+	res := &Result{Allowed: true, Denied: -1}
+	if len(buckets) == 0 {
+		return res, nil
+	}
+
+	keys := make([]string, 0, len(buckets))
+	args := make([]any, 0, 2+len(buckets)*2)
+	args = append(args, l.now().UnixMilli(), 0)
+	if force {
+		args[1] = 1
+	}
+	for _, b := range buckets {
+		keys = append(keys, b.Key)
+		args = append(args, b.Limit, b.Cost)
+	}
+
+	vals, err := script.Run(ctx, l.redis, keys, args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if denied := vals[0]; denied > 0 {
+		res.Allowed = false
+		res.Denied = int(denied - 1)
+		res.RetryAfter = time.Duration(vals[1]) * time.Millisecond
+	}
+	for _, v := range vals[2:] {
+		res.Remaining = append(res.Remaining, int(v))
+	}
+	return res, nil
+}
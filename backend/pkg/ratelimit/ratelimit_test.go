@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func newLimiter(t *testing.T) (*Limiter, *time.Time) {
+	mr := miniredis.RunT(t)
+	now := time.Unix(1700000000, 0)
+	l := New(redis.NewClient(&redis.Options{Addr: mr.Addr()}))
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestTakeExhaustAndRefill(t *testing.T) {
+	l, now := newLimiter(t)
+	ctx := context.Background()
+	buckets := []Bucket{{Key: "rpm", Limit: 3, Cost: 1}}
+
+	for i := range 3 {
+		res, err := l.Take(ctx, buckets)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !res.Allowed || res.Remaining[0] != 2-i {
+			t.Fatalf("take %d: allowed=%v remaining=%v", i, res.Allowed, res.Remaining)
+		}
+	}
+
+	res, err := l.Take(ctx, buckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每分钟 3 个令牌，补充一个需要 20 秒
+	if res.Allowed || res.Denied != 0 || res.Remaining[0] != 0 || res.RetryAfter != 20*time.Second {
+		t.Fatalf("expected denied, got %+v", res)
+	}
+
+	*now = now.Add(10 * time.Second)
+	if res, _ := l.Take(ctx, buckets); res.Allowed || res.RetryAfter != 10*time.Second {
+		t.Fatalf("half refilled bucket should be denied, got %+v", res)
+	}
+	*now = now.Add(10 * time.Second)
+	if res, _ := l.Take(ctx, buckets); !res.Allowed || res.Remaining[0] != 0 {
+		t.Fatalf("refilled bucket should be allowed, got %+v", res)
+	}
+
+	// 补充不超过上限
+	*now = now.Add(time.Hour)
+	if res, _ := l.Take(ctx, buckets); !res.Allowed || res.Remaining[0] != 2 {
+		t.Fatalf("bucket should be capped at limit, got %+v", res)
+	}
+}
+
+func TestTakeMultiBucket(t *testing.T) {
+	l, _ := newLimiter(t)
+	ctx := context.Background()
+	key := Bucket{Key: "key", Limit: 10, Cost: 1}
+	user := Bucket{Key: "user", Limit: 1, Cost: 1}
+
+	if res, _ := l.Take(ctx, []Bucket{key, user}); !res.Allowed {
+		t.Fatalf("first take should be allowed, got %+v", res)
+	}
+	res, err := l.Take(ctx, []Bucket{key, user})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Allowed || res.Denied != 1 {
+		t.Fatalf("user bucket should deny, got %+v", res)
+	}
+	// 任意一个桶不足时其他桶也不扣减
+	if res.Remaining[0] != 9 {
+		t.Fatalf("key bucket should not be taken, remaining %d", res.Remaining[0])
+	}
+}
+
+func TestConsume(t *testing.T) {
+	l, now := newLimiter(t)
+	ctx := context.Background()
+	tpm := Bucket{Key: "tpm", Limit: 600}
+
+	// token 桶只检查余额，实际用量在请求结束后强制扣减，余额可以为负
+	if err := l.Consume(ctx, []Bucket{{Key: "tpm", Limit: 600, Cost: 900}}); err != nil {
+		t.Fatal(err)
+	}
+	res, err := l.Take(ctx, []Bucket{tpm})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每秒补充 10 个令牌，余额恢复到 1 需要 30.1 秒
+	if res.Allowed || res.Remaining[0] != -300 || res.RetryAfter != 30100*time.Millisecond {
+		t.Fatalf("expected denied until balance is positive, got %+v", res)
+	}
+
+	*now = now.Add(res.RetryAfter)
+	if res, _ := l.Take(ctx, []Bucket{tpm}); !res.Allowed || res.Remaining[0] != 1 {
+		t.Fatalf("expected allowed after refill, got %+v", res)
+	}
+}
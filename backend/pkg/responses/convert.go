@@ -0,0 +1,252 @@
+package responses
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rokku-c/go-openai"
+)
+
+// ErrPreviousResponse 代理不保存历史响应，客户端需要在 input 中传递完整的对话
+var ErrPreviousResponse = errors.New("previous_response_id is not supported, send the full conversation in input")
+
+// ToOpenAI 将 Responses 请求转换为 OpenAI Chat Completions 请求
+func ToOpenAI(req *Request) (*openai.ChatCompletionRequest, error) {
+	if req.PreviousResponseID != "" {
+		return nil, ErrPreviousResponse
+	}
+	out := &openai.ChatCompletionRequest{
+		Model:     req.Model,
+		MaxTokens: req.MaxOutputTokens,
+		Stream:    req.Stream,
+		User:      req.User,
+	}
+	if req.Temperature != nil {
+		out.Temperature = *req.Temperature
+	}
+	if req.TopP != nil {
+		out.TopP = *req.TopP
+	}
+	if req.Stream {
+		// 流式响应需要返回用量，用于记录和计费
+		out.StreamOptions = &openai.StreamOptions{IncludeUsage: true}
+	}
+
+	if req.Instructions != "" {
+		out.Messages = append(out.Messages, openai.ChatCompletionMessage{
+			Role:    openai.ChatMessageRoleSystem,
+			Content: req.Instructions,
+		})
+	}
+	for _, item := range req.Input {
+		msgs, err := convertItem(out.Messages, item)
+		if err != nil {
+			return nil, err
+		}
+		out.Messages = msgs
+	}
+
+	for _, t := range req.Tools {
+		// web_search 等内置工具由 OpenAI 托管执行，其它上游无法处理，忽略
+		if t.Type != "function" {
+			continue
+		}
+		out.Tools = append(out.Tools, openai.Tool{
+			Type: openai.ToolTypeFunction,
+			Function: &openai.FunctionDefinition{
+				Name:        t.Name,
+				Description: t.Description,
+				Strict:      t.Strict,
+				Parameters:  t.Parameters,
+			},
+		})
+	}
+	if len(out.Tools) > 0 {
+		out.ToolChoice = toolChoice(req.ToolChoice)
+		if req.ParallelToolCalls != nil {
+			out.ParallelToolCalls = *req.ParallelToolCalls
+		}
+	}
+	return out, nil
+}
+
+// convertItem 将输入项追加到消息列表，连续的 function_call 合并到同一条助手消息
+func convertItem(msgs []openai.ChatCompletionMessage, item Item) ([]openai.ChatCompletionMessage, error) {
+	switch item.Type {
+	case "", ItemMessage:
+		msg, err := convertMessage(item)
+		if err != nil {
+			return nil, err
+		}
+		return append(msgs, msg), nil
+
+	case ItemFunctionCall:
+		tc := openai.ToolCall{
+			ID:   item.CallID,
+			Type: openai.ToolTypeFunction,
+			Function: openai.FunctionCall{
+				Name:      item.Name,
+				Arguments: item.Arguments,
+			},
+		}
+		if n := len(msgs); n > 0 && msgs[n-1].Role == openai.ChatMessageRoleAssistant {
+			msgs[n-1].ToolCalls = append(msgs[n-1].ToolCalls, tc)
+			return msgs, nil
+		}
+		return append(msgs, openai.ChatCompletionMessage{
+			Role:      openai.ChatMessageRoleAssistant,
+			ToolCalls: []openai.ToolCall{tc},
+		}), nil
+
+	case ItemFunctionCallOutput:
+		return append(msgs, openai.ChatCompletionMessage{
+			Role:       openai.ChatMessageRoleTool,
+			ToolCallID: item.CallID,
+			Content:    item.Output.Text(),
+		}), nil
+
+	case ItemReasoning:
+		// 推理内容只对 OpenAI 的推理模型有效
+		return msgs, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported input item type %q", item.Type)
+	}
+}
+
+func convertMessage(item Item) (openai.ChatCompletionMessage, error) {
+	msg := openai.ChatCompletionMessage{}
+	switch item.Role {
+	case RoleUser, RoleAssistant, RoleSystem:
+		msg.Role = item.Role
+	case RoleDeveloper:
+		msg.Role = openai.ChatMessageRoleSystem
+	default:
+		return msg, fmt.Errorf("unsupported message role %q", item.Role)
+	}
+
+	var parts []openai.ChatMessagePart
+	for _, p := range item.Content {
+		switch p.Type {
+		case PartInputText, PartOutputText:
+			parts = append(parts, openai.ChatMessagePart{Type: openai.ChatMessagePartTypeText, Text: p.Text})
+		case PartInputImage:
+			if p.ImageURL == "" {
+				continue
+			}
+			parts = append(parts, openai.ChatMessagePart{
+				Type:     openai.ChatMessagePartTypeImageURL,
+				ImageURL: &openai.ChatMessageImageURL{URL: p.ImageURL, Detail: openai.ImageURLDetail(p.Detail)},
+			})
+		}
+	}
+	if item.Role != RoleUser || len(parts) == 1 && parts[0].Type == openai.ChatMessagePartTypeText {
+		msg.Content = item.Content.Text()
+	} else {
+		msg.MultiContent = parts
+	}
+	return msg, nil
+}
+
+// toolChoice 转换 tool_choice，可以是 auto、none、required 或指定的函数
+func toolChoice(raw json.RawMessage) any {
+	if len(raw) == 0 {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var tc struct {
+		Type string `json:"type"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &tc); err != nil || tc.Type != "function" {
+		return nil
+	}
+	return openai.ToolChoice{
+		Type:     openai.ToolTypeFunction,
+		Function: openai.ToolFunction{Name: tc.Name},
+	}
+}
+
+// Status 将 OpenAI 的 finish_reason 转换为响应状态，未完成时返回原因
+func Status(reason openai.FinishReason) (string, *IncompleteDetails) {
+	switch reason {
+	case openai.FinishReasonLength:
+		return StatusIncomplete, &IncompleteDetails{Reason: "max_output_tokens"}
+	case openai.FinishReasonContentFilter:
+		return StatusIncomplete, &IncompleteDetails{Reason: "content_filter"}
+	default:
+		return StatusCompleted, nil
+	}
+}
+
+// FromOpenAI 将 OpenAI Chat Completions 响应转换为 Responses 响应
+func FromOpenAI(resp *openai.ChatCompletionResponse) *Response {
+	out := newResponse(resp.ID, resp.Model, resp.Created)
+	out.Status = StatusCompleted
+	out.Usage = usage(&resp.Usage)
+	if len(resp.Choices) == 0 {
+		return out
+	}
+
+	choice := resp.Choices[0]
+	out.Status, out.IncompleteDetails = Status(choice.FinishReason)
+	if text := choice.Message.Content; text != "" {
+		out.Output = append(out.Output, messageItem(resp.ID, text))
+	}
+	for _, tc := range choice.Message.ToolCalls {
+		out.Output = append(out.Output, functionItem(tc.ID, tc.Function.Name, tc.Function.Arguments))
+	}
+	return out
+}
+
+func newResponse(id, model string, created int64) *Response {
+	if created == 0 {
+		created = time.Now().Unix()
+	}
+	return &Response{
+		ID:        "resp_" + id,
+		Object:    "response",
+		CreatedAt: created,
+		Status:    StatusInProgress,
+		Model:     model,
+		Output:    []Item{},
+	}
+}
+
+func messageItem(id, text string) Item {
+	return Item{
+		Type:    ItemMessage,
+		ID:      "msg_" + id,
+		Status:  StatusCompleted,
+		Role:    RoleAssistant,
+		Content: Content{{Type: PartOutputText, Text: text, Annotations: []any{}}},
+	}
+}
+
+func functionItem(callID, name, args string) Item {
+	return Item{
+		Type:      ItemFunctionCall,
+		ID:        "fc_" + callID,
+		Status:    StatusCompleted,
+		CallID:    callID,
+		Name:      name,
+		Arguments: args,
+	}
+}
+
+func usage(u *openai.Usage) *Usage {
+	out := &Usage{
+		InputTokens:  u.PromptTokens,
+		OutputTokens: u.CompletionTokens,
+		TotalTokens:  u.PromptTokens + u.CompletionTokens,
+	}
+	if d := u.PromptTokensDetails; d != nil {
+		out.InputTokensDetails.CachedTokens = d.CachedTokens
+	}
+	return out
+}
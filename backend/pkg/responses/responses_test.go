@@ -0,0 +1,179 @@
+package responses
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/rokku-c/go-openai"
+)
+
+func TestToOpenAI(t *testing.T) {
+	body := `{
+		"model": "gpt-4.1",
+		"instructions": "be brief",
+		"max_output_tokens": 512,
+		"stream": true,
+		"tools": [
+			{"type": "function", "name": "ls", "parameters": {"type": "object"}},
+			{"type": "web_search"}
+		],
+		"tool_choice": {"type": "function", "name": "ls"},
+		"input": [
+			{"role": "developer", "content": "use tools"},
+			{"role": "user", "content": [{"type": "input_text", "text": "list"}]},
+			{"type": "reasoning", "id": "rs_1", "summary": []},
+			{"type": "function_call", "call_id": "call_1", "name": "ls", "arguments": "{}"},
+			{"type": "function_call", "call_id": "call_2", "name": "ls", "arguments": "{\"path\":\"a\"}"},
+			{"type": "function_call_output", "call_id": "call_1", "output": "a.go"},
+			{"type": "function_call_output", "call_id": "call_2", "output": "b.go"},
+			{"type": "message", "role": "assistant", "content": [{"type": "output_text", "text": "done"}]}
+		]
+	}`
+	var req Request
+	if err := json.Unmarshal([]byte(body), &req); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ToOpenAI(&req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out.MaxTokens != 512 || !out.Stream || out.StreamOptions == nil || !out.StreamOptions.IncludeUsage {
+		t.Fatalf("unexpected options: %+v", out)
+	}
+	if len(out.Tools) != 1 {
+		t.Fatalf("unexpected tools: %+v", out.Tools)
+	}
+	if tc, ok := out.ToolChoice.(openai.ToolChoice); !ok || tc.Function.Name != "ls" {
+		t.Fatalf("unexpected tool choice: %+v", out.ToolChoice)
+	}
+	roles := make([]string, 0, len(out.Messages))
+	for _, m := range out.Messages {
+		roles = append(roles, m.Role)
+	}
+	if got := strings.Join(roles, ","); got != "system,system,user,assistant,tool,tool,assistant" {
+		t.Fatalf("unexpected roles: %s", got)
+	}
+	if m := out.Messages[2]; m.Content != "list" {
+		t.Fatalf("unexpected user message: %+v", m)
+	}
+	if tc := out.Messages[3].ToolCalls; len(tc) != 2 || tc[1].ID != "call_2" || tc[1].Function.Arguments != `{"path":"a"}` {
+		t.Fatalf("unexpected tool calls: %+v", tc)
+	}
+	if m := out.Messages[5]; m.ToolCallID != "call_2" || m.Content != "b.go" {
+		t.Fatalf("unexpected tool message: %+v", m)
+	}
+
+	req = Request{Model: "gpt-4.1", PreviousResponseID: "resp_1"}
+	if _, err := ToOpenAI(&req); !errors.Is(err, ErrPreviousResponse) {
+		t.Fatalf("expect previous response error, got %v", err)
+	}
+}
+
+func TestInputString(t *testing.T) {
+	var req Request
+	if err := json.Unmarshal([]byte(`{"model":"m","input":"hi"}`), &req); err != nil {
+		t.Fatal(err)
+	}
+	out, err := ToOpenAI(&req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Messages) != 1 || out.Messages[0].Role != RoleUser || out.Messages[0].Content != "hi" {
+		t.Fatalf("unexpected messages: %+v", out.Messages)
+	}
+}
+
+func TestFromOpenAI(t *testing.T) {
+	resp := &openai.ChatCompletionResponse{
+		ID:    "chatcmpl-1",
+		Model: "gpt-4.1",
+		Choices: []openai.ChatCompletionChoice{{
+			Message: openai.ChatCompletionMessage{
+				Content: "calling",
+				ToolCalls: []openai.ToolCall{{
+					ID:       "call_1",
+					Function: openai.FunctionCall{Name: "ls", Arguments: `{"path":"."}`},
+				}},
+			},
+			FinishReason: openai.FinishReasonLength,
+		}},
+		Usage: openai.Usage{PromptTokens: 10, CompletionTokens: 5},
+	}
+	out := FromOpenAI(resp)
+	if out.ID != "resp_chatcmpl-1" || out.Status != StatusIncomplete || out.IncompleteDetails.Reason != "max_output_tokens" {
+		t.Fatalf("unexpected response: %+v", out)
+	}
+	if len(out.Output) != 2 || out.Output[0].Content.Text() != "calling" || out.Output[1].CallID != "call_1" {
+		t.Fatalf("unexpected output: %+v", out.Output)
+	}
+	if out.Usage.TotalTokens != 15 {
+		t.Fatalf("unexpected usage: %+v", out.Usage)
+	}
+}
+
+func TestStreamReader(t *testing.T) {
+	upstream := strings.Join([]string{
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"role":"assistant","content":"Hel"}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"content":"lo"}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"ls","arguments":"{\"p\":"}}]}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"1}"}}]}}]}`,
+		`data: {"id":"c1","choices":[{"index":0,"delta":{},"finish_reason":"tool_calls"}]}`,
+		`data: {"id":"c1","choices":[],"usage":{"prompt_tokens":3,"completion_tokens":4,"total_tokens":7}}`,
+		`data: [DONE]`,
+	}, "\n\n") + "\n\n"
+
+	out, err := io.ReadAll(NewStreamReader(io.NopCloser(strings.NewReader(upstream)), "gpt-4.1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		types []string
+		last  struct {
+			Response Response `json:"response"`
+		}
+	)
+	for _, line := range strings.Split(string(out), "\n") {
+		if !strings.HasPrefix(line, "event: ") {
+			continue
+		}
+		types = append(types, strings.TrimPrefix(line, "event: "))
+	}
+	want := []string{
+		"response.created",
+		"response.in_progress",
+		"response.output_item.added",
+		"response.content_part.added",
+		"response.output_text.delta",
+		"response.output_text.delta",
+		"response.output_text.done",
+		"response.content_part.done",
+		"response.output_item.done",
+		"response.output_item.added",
+		"response.function_call_arguments.delta",
+		"response.function_call_arguments.delta",
+		"response.function_call_arguments.done",
+		"response.output_item.done",
+		"response.completed",
+	}
+	if got := strings.Join(types, ","); got != strings.Join(want, ",") {
+		t.Fatalf("unexpected events:\n%s", got)
+	}
+
+	events := strings.Split(strings.TrimSpace(string(out)), "\n\n")
+	data := strings.TrimPrefix(strings.Split(events[len(events)-1], "\n")[1], "data: ")
+	if err := json.Unmarshal([]byte(data), &last); err != nil {
+		t.Fatal(err)
+	}
+	resp := last.Response
+	if resp.Status != StatusCompleted || len(resp.Output) != 2 || resp.Usage.TotalTokens != 7 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.Output[0].Content.Text() != "Hello" || resp.Output[1].Arguments != `{"p":1}` {
+		t.Fatalf("unexpected output: %+v", resp.Output)
+	}
+}
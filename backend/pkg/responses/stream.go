@@ -0,0 +1,279 @@
+package responses
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/rokku-c/go-openai"
+)
+
+// StreamReader 将 OpenAI 的流式响应转换为 Responses 的流式事件
+type StreamReader struct {
+	src    io.ReadCloser
+	reader *bufio.Reader
+	model  string
+	buf    bytes.Buffer
+	done   bool
+
+	id        string // 上游响应 ID
+	resp      *Response
+	seq       int
+	current   int         // 当前输出项下标，-1 表示没有打开的输出项
+	toolIndex map[int]int // 工具调用下标对应的输出项下标
+	reason    openai.FinishReason
+}
+
+var _ io.ReadCloser = &StreamReader{}
+
+func NewStreamReader(src io.ReadCloser, model string) *StreamReader {
+	return &StreamReader{
+		src:       src,
+		reader:    bufio.NewReader(src),
+		model:     model,
+		current:   -1,
+		toolIndex: make(map[int]int),
+	}
+}
+
+// Read implements io.ReadCloser.
+func (s *StreamReader) Read(p []byte) (int, error) {
+	for s.buf.Len() == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		line, err := s.reader.ReadString('\n')
+		if line != "" {
+			s.processLine(line)
+		}
+		if errors.Is(err, io.EOF) {
+			s.finish()
+		} else if err != nil {
+			return 0, err
+		}
+	}
+	return s.buf.Read(p)
+}
+
+// Close implements io.ReadCloser.
+func (s *StreamReader) Close() error {
+	return s.src.Close()
+}
+
+func (s *StreamReader) processLine(line string) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "data:") {
+		return
+	}
+	data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+	if data == "" {
+		return
+	}
+	if data == "[DONE]" {
+		s.finish()
+		return
+	}
+
+	var chunk openai.ChatCompletionStreamResponse
+	if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+		return
+	}
+	s.start(chunk.ID, chunk.Created)
+	if chunk.Usage != nil {
+		s.resp.Usage = usage(chunk.Usage)
+	}
+	if len(chunk.Choices) == 0 {
+		return
+	}
+
+	choice := chunk.Choices[0]
+	if text := choice.Delta.Content; text != "" {
+		s.text(text)
+	}
+	for _, tc := range choice.Delta.ToolCalls {
+		i := 0
+		if tc.Index != nil {
+			i = *tc.Index
+		}
+		if _, ok := s.toolIndex[i]; !ok {
+			s.open(functionItem(tc.ID, tc.Function.Name, ""))
+			s.toolIndex[i] = s.current
+		}
+		idx := s.toolIndex[i]
+		if tc.Function.Arguments != "" && idx == s.current {
+			item := &s.resp.Output[idx]
+			item.Arguments += tc.Function.Arguments
+			s.event("response.function_call_arguments.delta", map[string]any{
+				"item_id":      item.ID,
+				"output_index": idx,
+				"delta":        tc.Function.Arguments,
+			})
+		}
+	}
+	if choice.FinishReason != "" {
+		s.reason = choice.FinishReason
+	}
+}
+
+func (s *StreamReader) start(id string, created int64) {
+	if s.resp != nil {
+		return
+	}
+	s.id = id
+	s.resp = newResponse(id, s.model, created)
+	s.event("response.created", map[string]any{"response": s.resp})
+	s.event("response.in_progress", map[string]any{"response": s.resp})
+}
+
+// text 追加文本，当前输出项不是消息时打开新的消息
+func (s *StreamReader) text(delta string) {
+	if s.current < 0 || s.resp.Output[s.current].Type != ItemMessage {
+		item := messageItem(s.id, "")
+		item.Status = StatusInProgress
+		s.open(item)
+		s.event("response.content_part.added", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  s.current,
+			"content_index": 0,
+			"part":          item.Content[0],
+		})
+	}
+	item := &s.resp.Output[s.current]
+	item.Content[0].Text += delta
+	s.event("response.output_text.delta", map[string]any{
+		"item_id":       item.ID,
+		"output_index":  s.current,
+		"content_index": 0,
+		"delta":         delta,
+	})
+}
+
+// open 关闭当前输出项并打开新的输出项
+func (s *StreamReader) open(item Item) {
+	s.close()
+	item.Status = StatusInProgress
+	s.resp.Output = append(s.resp.Output, item)
+	s.current = len(s.resp.Output) - 1
+	added := map[string]any{
+		"type":   item.Type,
+		"id":     item.ID,
+		"status": item.Status,
+	}
+	if item.Type == ItemMessage {
+		added["role"] = RoleAssistant
+		added["content"] = []any{}
+	} else {
+		added["call_id"] = item.CallID
+		added["name"] = item.Name
+		added["arguments"] = ""
+	}
+	s.event("response.output_item.added", map[string]any{
+		"output_index": s.current,
+		"item":         added,
+	})
+}
+
+// close 结束当前输出项
+func (s *StreamReader) close() {
+	if s.current < 0 {
+		return
+	}
+	idx := s.current
+	s.current = -1
+	item := &s.resp.Output[idx]
+	item.Status = StatusCompleted
+	if item.Type == ItemMessage {
+		part := item.Content[0]
+		s.event("response.output_text.done", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  idx,
+			"content_index": 0,
+			"text":          part.Text,
+		})
+		s.event("response.content_part.done", map[string]any{
+			"item_id":       item.ID,
+			"output_index":  idx,
+			"content_index": 0,
+			"part":          part,
+		})
+	} else {
+		s.event("response.function_call_arguments.done", map[string]any{
+			"item_id":      item.ID,
+			"output_index": idx,
+			"arguments":    item.Arguments,
+		})
+	}
+	s.event("response.output_item.done", map[string]any{
+		"output_index": idx,
+		"item":         item,
+	})
+}
+
+func (s *StreamReader) finish() {
+	if s.done {
+		return
+	}
+	s.done = true
+	s.start("", 0)
+	s.close()
+	s.resp.Status, s.resp.IncompleteDetails = Status(s.reason)
+	typ := "response.completed"
+	if s.resp.Status == StatusIncomplete {
+		typ = "response.incomplete"
+	}
+	s.event(typ, map[string]any{"response": s.resp})
+}
+
+func (s *StreamReader) event(typ string, data map[string]any) {
+	data["type"] = typ
+	data["sequence_number"] = s.seq
+	s.seq++
+	b, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	s.buf.WriteString("event: " + typ + "\n")
+	s.buf.WriteString("data: ")
+	s.buf.Write(b)
+	s.buf.WriteString("\n\n")
+}
+
+// ResponseReader 读取完整的 OpenAI 响应并转换为 Responses 响应
+type ResponseReader struct {
+	src io.ReadCloser
+	buf *bytes.Reader
+}
+
+var _ io.ReadCloser = &ResponseReader{}
+
+func NewResponseReader(src io.ReadCloser) *ResponseReader {
+	return &ResponseReader{src: src}
+}
+
+// Read implements io.ReadCloser.
+func (r *ResponseReader) Read(p []byte) (int, error) {
+	if r.buf == nil {
+		body, err := io.ReadAll(r.src)
+		if err != nil {
+			return 0, err
+		}
+		var resp openai.ChatCompletionResponse
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return 0, err
+		}
+		b, err := json.Marshal(FromOpenAI(&resp))
+		if err != nil {
+			return 0, err
+		}
+		r.buf = bytes.NewReader(b)
+	}
+	return r.buf.Read(p)
+}
+
+// Close implements io.ReadCloser.
+func (r *ResponseReader) Close() error {
+	return r.src.Close()
+}
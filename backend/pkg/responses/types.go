@@ -0,0 +1,158 @@
+package responses
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+	RoleSystem    = "system"
+	RoleDeveloper = "developer"
+)
+
+const (
+	ItemMessage            = "message"
+	ItemFunctionCall       = "function_call"
+	ItemFunctionCallOutput = "function_call_output"
+	ItemReasoning          = "reasoning"
+)
+
+const (
+	PartInputText  = "input_text"
+	PartInputImage = "input_image"
+	PartOutputText = "output_text"
+)
+
+const (
+	StatusCompleted  = "completed"
+	StatusIncomplete = "incomplete"
+	StatusInProgress = "in_progress"
+)
+
+type Request struct {
+	Model              string            `json:"model"`
+	Input              Input             `json:"input"`
+	Instructions       string            `json:"instructions,omitempty"`
+	MaxOutputTokens    int               `json:"max_output_tokens,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	ParallelToolCalls  *bool             `json:"parallel_tool_calls,omitempty"`
+	PreviousResponseID string            `json:"previous_response_id,omitempty"`
+	Stream             bool              `json:"stream,omitempty"`
+	Temperature        *float32          `json:"temperature,omitempty"`
+	TopP               *float32          `json:"top_p,omitempty"`
+	Tools              []Tool            `json:"tools,omitempty"`
+	ToolChoice         json.RawMessage   `json:"tool_choice,omitempty"`
+	User               string            `json:"user,omitempty"`
+}
+
+// Input 请求的输入，可以是字符串或输入项数组，字符串视为一条用户消息
+type Input []Item
+
+func (in *Input) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*in = Input{{Type: ItemMessage, Role: RoleUser, Content: Content{{Type: PartInputText, Text: s}}}}
+		return nil
+	}
+	var items []Item
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*in = items
+	return nil
+}
+
+// Item 输入或输出项，消息省略 type 时按 message 处理
+type Item struct {
+	Type   string `json:"type,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status,omitempty"`
+
+	// message
+	Role    string  `json:"role,omitempty"`
+	Content Content `json:"content,omitempty"`
+
+	// function_call
+	CallID    string `json:"call_id,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments,omitempty"`
+
+	// function_call_output
+	Output Content `json:"output,omitempty"`
+}
+
+// Content 消息内容，请求中可以是字符串或内容数组
+type Content []Part
+
+func (c *Content) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*c = Content{{Type: PartInputText, Text: s}}
+		return nil
+	}
+	var parts []Part
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return err
+	}
+	*c = parts
+	return nil
+}
+
+// Text 拼接内容中的所有文本
+func (c Content) Text() string {
+	var texts []string
+	for _, p := range c {
+		if (p.Type == PartInputText || p.Type == PartOutputText) && p.Text != "" {
+			texts = append(texts, p.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+type Part struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+
+	// input_image
+	ImageURL string `json:"image_url,omitempty"`
+	Detail   string `json:"detail,omitempty"`
+
+	// output_text，响应中必须返回数组
+	Annotations []any `json:"annotations"`
+}
+
+type Tool struct {
+	Type        string          `json:"type"`
+	Name        string          `json:"name,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Parameters  json.RawMessage `json:"parameters,omitempty"`
+	Strict      bool            `json:"strict,omitempty"`
+}
+
+type Response struct {
+	ID                string             `json:"id"`
+	Object            string             `json:"object"`
+	CreatedAt         int64              `json:"created_at"`
+	Status            string             `json:"status"`
+	Model             string             `json:"model"`
+	Output            []Item             `json:"output"`
+	IncompleteDetails *IncompleteDetails `json:"incomplete_details"`
+	Usage             *Usage             `json:"usage"`
+}
+
+type IncompleteDetails struct {
+	Reason string `json:"reason"` // max_output_tokens 或 content_filter
+}
+
+type Usage struct {
+	InputTokens        int                `json:"input_tokens"`
+	InputTokensDetails InputTokensDetails `json:"input_tokens_details"`
+	OutputTokens       int                `json:"output_tokens"`
+	TotalTokens        int                `json:"total_tokens"`
+}
+
+type InputTokensDetails struct {
+	CachedTokens int `json:"cached_tokens"` // 命中提示词缓存的 token 数，包含在 input_tokens 中
+}
@@ -0,0 +1,208 @@
+// Package sarif 实现 SARIF 2.1.0 中描述静态分析结果所需的部分结构
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+package sarif
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	Version = "2.1.0"
+	Schema  = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	// SrcRoot 结果中文件路径相对的基准目录
+	SrcRoot = "%SRCROOT%"
+)
+
+var ErrVersion = errors.New("unsupported sarif version")
+
+type Level string
+
+const (
+	LevelError   Level = "error"
+	LevelWarning Level = "warning"
+	LevelNote    Level = "note"
+	LevelNone    Level = "none"
+)
+
+type Log struct {
+	Schema  string `json:"$schema,omitempty"`
+	Version string `json:"version"`
+	Runs    []*Run `json:"runs"`
+}
+
+// New 创建 SARIF 2.1.0 日志
+func New(runs ...*Run) *Log {
+	if runs == nil {
+		runs = []*Run{}
+	}
+	return &Log{
+		Schema:  Schema,
+		Version: Version,
+		Runs:    runs,
+	}
+}
+
+// Parse 解析 SARIF 日志，只支持 2.1.0 版本
+func Parse(r io.Reader) (*Log, error) {
+	var l Log
+	if err := json.NewDecoder(r).Decode(&l); err != nil {
+		return nil, fmt.Errorf("decode sarif: %w", err)
+	}
+	if l.Version != Version {
+		return nil, fmt.Errorf("%w %q", ErrVersion, l.Version)
+	}
+	return &l, nil
+}
+
+// Run 一次扫描工具的运行结果
+type Run struct {
+	Tool               Tool                         `json:"tool"`
+	AutomationDetails  *AutomationDetails           `json:"automationDetails,omitempty"`
+	OriginalURIBaseIDs map[string]*ArtifactLocation `json:"originalUriBaseIds,omitempty"`
+	Results            []*Result                    `json:"results"`
+	Properties         Properties                   `json:"properties,omitempty"`
+}
+
+// Rule 查找结果对应的规则，先按 ruleIndex 再按 ruleId 查找
+func (r *Run) Rule(res *Result) *Rule {
+	rules := r.Tool.Driver.Rules
+	if i := res.RuleIndex; i != nil && *i >= 0 && *i < len(rules) {
+		return rules[*i]
+	}
+	for _, rule := range rules {
+		if rule.ID == res.RuleID {
+			return rule
+		}
+	}
+	return nil
+}
+
+type Tool struct {
+	Driver Driver `json:"driver"`
+}
+
+type Driver struct {
+	Name           string  `json:"name"`
+	Version        string  `json:"version,omitempty"`
+	InformationURI string  `json:"informationUri,omitempty"`
+	Rules          []*Rule `json:"rules,omitempty"`
+}
+
+type AutomationDetails struct {
+	ID string `json:"id,omitempty"`
+}
+
+// Rule 扫描规则，对应 reportingDescriptor
+type Rule struct {
+	ID                   string         `json:"id"`
+	Name                 string         `json:"name,omitempty"`
+	ShortDescription     *Message       `json:"shortDescription,omitempty"`
+	FullDescription      *Message       `json:"fullDescription,omitempty"`
+	Help                 *Message       `json:"help,omitempty"`
+	DefaultConfiguration *Configuration `json:"defaultConfiguration,omitempty"`
+	Properties           Properties     `json:"properties,omitempty"`
+}
+
+type Configuration struct {
+	Level Level `json:"level,omitempty"`
+}
+
+type Message struct {
+	Text     string `json:"text"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+// String 消息文本，消息为 nil 时返回空字符串
+func (m *Message) String() string {
+	if m == nil {
+		return ""
+	}
+	return m.Text
+}
+
+type Result struct {
+	RuleID              string            `json:"ruleId,omitempty"`
+	RuleIndex           *int              `json:"ruleIndex,omitempty"`
+	Level               Level             `json:"level,omitempty"`
+	Message             Message           `json:"message"`
+	Locations           []*Location       `json:"locations,omitempty"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Properties          Properties        `json:"properties,omitempty"`
+}
+
+// PhysicalLocation 结果的第一个物理位置
+func (r *Result) PhysicalLocation() *PhysicalLocation {
+	for _, l := range r.Locations {
+		if l != nil && l.PhysicalLocation != nil {
+			return l.PhysicalLocation
+		}
+	}
+	return nil
+}
+
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+}
+
+type PhysicalLocation struct {
+	ArtifactLocation *ArtifactLocation `json:"artifactLocation,omitempty"`
+	Region           *Region           `json:"region,omitempty"`
+}
+
+type ArtifactLocation struct {
+	URI       string `json:"uri,omitempty"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+// Region 代码区域，行列从 1 开始，偏移从 0 开始
+type Region struct {
+	StartLine   int              `json:"startLine,omitempty"`
+	StartColumn int              `json:"startColumn,omitempty"`
+	EndLine     int              `json:"endLine,omitempty"`
+	EndColumn   int              `json:"endColumn,omitempty"`
+	CharOffset  int              `json:"charOffset,omitempty"`
+	CharLength  int              `json:"charLength,omitempty"`
+	Snippet     *ArtifactContent `json:"snippet,omitempty"`
+}
+
+type ArtifactContent struct {
+	Text string `json:"text,omitempty"`
+}
+
+// Properties 属性包，用于携带 SARIF 未定义的扩展字段
+type Properties map[string]any
+
+// Get 读取字符串属性
+func (p Properties) Get(key string) string {
+	s, _ := p[key].(string)
+	return s
+}
+
+// Strings 读取字符串或字符串数组属性，嵌套数组会被展开
+func (p Properties) Strings(key string) []string {
+	return Strings(p[key])
+}
+
+// Strings 将字符串或任意嵌套的字符串数组展开
+func Strings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		var ss []string
+		for _, e := range v {
+			ss = append(ss, Strings(e)...)
+		}
+		return ss
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package sarif
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	body := `{
+		"version": "2.1.0",
+		"runs": [{
+			"tool": {"driver": {"name": "scanner", "rules": [
+				{"id": "r1", "properties": {"cwe": ["CWE-79"]}},
+				{"id": "r2"}
+			]}},
+			"results": [
+				{"ruleId": "r2", "ruleIndex": 1, "message": {"text": "by index"}},
+				{"ruleId": "r1", "message": {"text": "by id"}, "locations": [{}, {"physicalLocation": {"artifactLocation": {"uri": "a.go"}, "region": {"startLine": 3}}}]}
+			]
+		}]
+	}`
+	l, err := Parse(strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := l.Runs[0]
+	if r := run.Rule(run.Results[0]); r == nil || r.ID != "r2" {
+		t.Fatalf("unexpected rule %+v", r)
+	}
+	r := run.Rule(run.Results[1])
+	if r == nil || r.ID != "r1" {
+		t.Fatalf("unexpected rule %+v", r)
+	}
+	if got := r.Properties.Strings("cwe"); !reflect.DeepEqual(got, []string{"CWE-79"}) {
+		t.Errorf("unexpected cwe %v", got)
+	}
+	loc := run.Results[1].PhysicalLocation()
+	if loc == nil || loc.ArtifactLocation.URI != "a.go" || loc.Region.StartLine != 3 {
+		t.Fatalf("unexpected location %+v", loc)
+	}
+
+	if _, err := Parse(strings.NewReader(`{"version":"2.0.0","runs":[]}`)); !errors.Is(err, ErrVersion) {
+		t.Errorf("expect version error, got %v", err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	b, err := json.Marshal(New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"$schema":"`+Schema+`","version":"2.1.0","runs":[]}` {
+		t.Errorf("unexpected log %s", b)
+	}
+}
+
+func TestStrings(t *testing.T) {
+	v := []any{"CWE-79", []any{"CWE-89", ""}, 1}
+	if got := Strings(v); !reflect.DeepEqual(got, []string{"CWE-79", "CWE-89"}) {
+		t.Errorf("unexpected strings %v", got)
+	}
+}
@@ -0,0 +1,19 @@
+package scan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// Fingerprint 按规则、文件和命中的代码计算结果指纹
+// 不包含行号且忽略代码中的空白差异，代码移动或重新格式化后仍能对应到同一个结果
+func Fingerprint(checkID, path, lines string) string {
+	h := sha256.New()
+	h.Write([]byte(checkID))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write([]byte(strings.Join(strings.Fields(lines), " ")))
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,95 @@
+package scan
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// InlineEngineKind 代码片段检测结果的引擎类型
+const InlineEngineKind = "inline"
+
+// InlineRule 代码片段检测规则，元数据与扫描引擎的规则保持一致，便于统一展示
+type InlineRule struct {
+	ID       string
+	Severity string // ERROR WARNING INFO
+	Message  string
+	Pattern  *regexp.Regexp
+	Metadata Metadata
+}
+
+// Inline 不依赖扫描引擎的代码片段检测，用于检查模型生成的代码
+type Inline struct {
+	rules []*InlineRule
+}
+
+func NewInline(rules []*InlineRule) *Inline {
+	return &Inline{rules: rules}
+}
+
+var fencePattern = regexp.MustCompile("(?m)^[ \t]*(```|~~~)[^\n]*(?:\n|$)")
+
+// Scan 检测文本中的代码，包含 Markdown 代码块时只检测代码块，结果的位置相对整个文本
+func (e *Inline) Scan(text string) []*ResultItem {
+	var items []*ResultItem
+	for _, b := range codeBlocks(text) {
+		for _, r := range e.rules {
+			for _, m := range r.Pattern.FindAllStringIndex(text[b[0]:b[1]], -1) {
+				start, end := b[0]+m[0], b[0]+m[1]
+				items = append(items, &ResultItem{
+					CheckID: r.ID,
+					Start:   position(text, start),
+					End:     position(text, end),
+					Extra: Extra{
+						EngineKind: InlineEngineKind,
+						Lines:      line(text, start, end),
+						Message:    r.Message,
+						Metadata:   r.Metadata,
+						Severity:   r.Severity,
+					},
+				})
+			}
+		}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].Start.Offset < items[j].Start.Offset
+	})
+	return items
+}
+
+// codeBlocks 返回代码块内容的区间，没有代码块时返回整个文本，未闭合的代码块延伸到文本末尾
+func codeBlocks(text string) [][2]int {
+	fences := fencePattern.FindAllStringSubmatchIndex(text, -1)
+	if len(fences) == 0 {
+		return [][2]int{{0, len(text)}}
+	}
+	var blocks [][2]int
+	for i := 0; i < len(fences); i += 2 {
+		start := fences[i][1]
+		end := len(text)
+		if i+1 < len(fences) {
+			end = fences[i+1][0]
+		}
+		blocks = append(blocks, [2]int{start, end})
+	}
+	return blocks
+}
+
+func position(text string, offset int) Position {
+	before := text[:offset]
+	return Position{
+		Line:   strings.Count(before, "\n") + 1,
+		Col:    offset - strings.LastIndex(before, "\n"),
+		Offset: offset,
+	}
+}
+
+// line 返回命中内容所在的完整行
+func line(text string, start, end int) string {
+	s := strings.LastIndex(text[:start], "\n") + 1
+	e := strings.Index(text[end:], "\n")
+	if e < 0 {
+		return text[s:]
+	}
+	return text[s : end+e]
+}
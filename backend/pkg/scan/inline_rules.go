@@ -0,0 +1,98 @@
+package scan
+
+import "regexp"
+
+func feysh(zh, en string) map[string]string {
+	return map[string]string{"zh-CN": zh, "en-US": en}
+}
+
+// InlineRules 内置的代码片段检测规则
+func InlineRules() []*InlineRule {
+	return []*InlineRule{
+		{
+			ID:       "inline.hardcoded-credential",
+			Severity: "ERROR",
+			Message:  "Hard-coded credential, load it from environment variables or a secret manager instead",
+			Pattern:  regexp.MustCompile(`(?i)\b[\w.]*(?:password|passwd|pwd|secret|api_?key|access_?key|auth_?token|private_?key)\w*["']?\s*(?::=|[:=]|=>)\s*["'][^"'\s$%{}<>]{6,}["']`),
+			Metadata: Metadata{
+				AbstractFeysh: feysh("硬编码凭据", "Hard-coded credential"),
+				Category:      "security",
+				CategoryFeysh: feysh("敏感信息泄露", "Sensitive information exposure"),
+				Confidence:    "MEDIUM",
+				Cwe:           []any{"CWE-798: Use of Hard-coded Credentials"},
+				Impact:        "HIGH",
+				MessageZh:     "代码中硬编码了凭据，应改为从环境变量或密钥管理服务读取",
+				NameFeysh:     feysh("硬编码凭据", "Hard-coded credential"),
+				Owasp:         []any{"A07:2021 - Identification and Authentication Failures"},
+			},
+		},
+		{
+			ID:       "inline.eval-user-input",
+			Severity: "ERROR",
+			Message:  "Dynamic evaluation of user-controlled input allows code injection",
+			Pattern:  regexp.MustCompile(`\b(?:eval|exec|Function|setTimeout|setInterval)\s*\(\s*(?:input\s*\(|request\.|req\.(?:body|query|params)|sys\.argv|process\.argv|\$_(?:GET|POST|REQUEST|COOKIE)|params\[|location\.|document\.)`),
+			Metadata: Metadata{
+				AbstractFeysh: feysh("动态执行用户输入", "Evaluation of user input"),
+				Category:      "security",
+				CategoryFeysh: feysh("代码注入", "Code injection"),
+				Confidence:    "HIGH",
+				Cwe:           []any{"CWE-95: Improper Neutralization of Directives in Dynamically Evaluated Code ('Eval Injection')"},
+				Impact:        "HIGH",
+				MessageZh:     "将用户可控的输入传给动态执行函数会导致代码注入，应改为显式解析输入",
+				NameFeysh:     feysh("动态执行用户输入", "Evaluation of user input"),
+				Owasp:         []any{"A03:2021 - Injection"},
+			},
+		},
+		{
+			ID:       "inline.tls-verify-disabled",
+			Severity: "WARNING",
+			Message:  "TLS certificate verification is disabled, which allows man-in-the-middle attacks",
+			Pattern:  regexp.MustCompile(`InsecureSkipVerify\s*:\s*true|\bverify\s*=\s*False\b|rejectUnauthorized\s*:\s*false|NODE_TLS_REJECT_UNAUTHORIZED['"]?\s*\]?\s*=\s*['"]?0|CURLOPT_SSL_VERIFY(?:PEER|HOST)\s*,\s*(?:false|0)\b|ssl\._create_unverified_context|\bCERT_NONE\b|setHostnameVerifier\s*\(\s*(?:NoopHostnameVerifier|SSLConnectionSocketFactory\.ALLOW_ALL)`),
+			Metadata: Metadata{
+				AbstractFeysh: feysh("禁用 TLS 证书校验", "TLS verification disabled"),
+				Category:      "security",
+				CategoryFeysh: feysh("不安全的传输", "Insecure transport"),
+				Confidence:    "HIGH",
+				Cwe:           []any{"CWE-295: Improper Certificate Validation"},
+				Impact:        "MEDIUM",
+				MessageZh:     "关闭了 TLS 证书校验，容易遭受中间人攻击，应使用可信证书或配置自定义 CA",
+				NameFeysh:     feysh("禁用 TLS 证书校验", "TLS verification disabled"),
+				Owasp:         []any{"A02:2021 - Cryptographic Failures"},
+			},
+		},
+		{
+			ID:       "inline.shell-injection",
+			Severity: "WARNING",
+			Message:  "Shell command built from dynamic input may allow command injection",
+			Pattern:  regexp.MustCompile(`\bos\.system\s*\([^)\n]*(?:\+|%|\.format\(|f["'])|\bsubprocess\.\w+\([^\n]*shell\s*=\s*True|\bchild_process\.exec(?:Sync)?\s*\(\s*(?:` + "`" + `[^` + "`" + `]*\$\{|[^,)\n]*\+)`),
+			Metadata: Metadata{
+				AbstractFeysh: feysh("命令注入", "Command injection"),
+				Category:      "security",
+				CategoryFeysh: feysh("命令注入", "Command injection"),
+				Confidence:    "MEDIUM",
+				Cwe:           []any{"CWE-78: Improper Neutralization of Special Elements used in an OS Command ('OS Command Injection')"},
+				Impact:        "HIGH",
+				MessageZh:     "拼接动态内容执行 Shell 命令可能导致命令注入，应使用参数列表调用",
+				NameFeysh:     feysh("命令注入", "Command injection"),
+				Owasp:         []any{"A03:2021 - Injection"},
+			},
+		},
+		{
+			ID:       "inline.sql-concat",
+			Severity: "WARNING",
+			Message:  "SQL statement built by string concatenation may allow SQL injection",
+			Pattern:  regexp.MustCompile(`(?i)\b(?:execute|exec|query|raw)\s*\(\s*(?:f["'](?:select|insert|update|delete)\b|["'](?:select|insert|update|delete)\b[^"'\n]*["']\s*(?:\+|%|\.format\())`),
+			Metadata: Metadata{
+				AbstractFeysh: feysh("SQL 注入", "SQL injection"),
+				Category:      "security",
+				CategoryFeysh: feysh("SQL 注入", "SQL injection"),
+				Confidence:    "MEDIUM",
+				Cwe:           []any{"CWE-89: Improper Neutralization of Special Elements used in an SQL Command ('SQL Injection')"},
+				Impact:        "HIGH",
+				MessageZh:     "通过字符串拼接构造 SQL 语句可能导致 SQL 注入，应使用参数化查询",
+				NameFeysh:     feysh("SQL 注入", "SQL injection"),
+				Owasp:         []any{"A03:2021 - Injection"},
+			},
+		},
+	}
+}
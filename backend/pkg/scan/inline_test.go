@@ -0,0 +1,52 @@
+package scan
+
+import "testing"
+
+func TestInlineRules(t *testing.T) {
+	e := NewInline(InlineRules())
+	cases := map[string]string{
+		"inline.hardcoded-credential": `db_password = "Sup3rS3cret!"`,
+		"inline.eval-user-input":      `result = eval(input("expr: "))`,
+		"inline.tls-verify-disabled":  `tr := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}`,
+		"inline.shell-injection":      `subprocess.run("ls " + path, shell=True)`,
+		"inline.sql-concat":           `cursor.execute("SELECT * FROM users WHERE id = " + uid)`,
+	}
+	for id, code := range cases {
+		items := e.Scan(code)
+		if len(items) != 1 || items[0].CheckID != id {
+			t.Errorf("%s: unexpected items %+v", id, items)
+		}
+	}
+
+	safe := []string{
+		`password = os.environ["DB_PASSWORD"]`,
+		`cursor.execute("SELECT * FROM users WHERE id = %s", (uid,))`,
+		`requests.get(url, verify=True)`,
+	}
+	for _, code := range safe {
+		if items := e.Scan(code); len(items) != 0 {
+			t.Errorf("%q should not match, got %+v", code, items)
+		}
+	}
+}
+
+func TestInlineCodeBlocks(t *testing.T) {
+	e := NewInline(InlineRules())
+	text := "Set verify=False in prose is ignored.\n\n```python\nimport requests\nrequests.get(url, verify=False)\n```\n\nDone."
+	items := e.Scan(text)
+	if len(items) != 1 {
+		t.Fatalf("unexpected items %+v", items)
+	}
+	it := items[0]
+	if it.Start.Line != 5 || it.Start.Col != 19 || it.Extra.Lines != "requests.get(url, verify=False)" {
+		t.Errorf("unexpected position %+v lines %q", it.Start, it.Extra.Lines)
+	}
+	if it.Extra.EngineKind != InlineEngineKind || it.Extra.Metadata.MessageZh == "" {
+		t.Errorf("metadata should be filled: %+v", it.Extra)
+	}
+
+	// 未闭合的代码块延伸到文本末尾，流式输出中断时也能检测
+	if items := e.Scan("```go\nc := &tls.Config{InsecureSkipVerify: true}"); len(items) != 1 {
+		t.Errorf("unterminated block should be scanned, got %+v", items)
+	}
+}
@@ -0,0 +1,121 @@
+package scan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// RuleDir 扫描服务保存自定义规则包的目录
+const RuleDir = "/app/rules"
+
+var (
+	ErrChecksum = errors.New("rule pack checksum mismatch")
+
+	rulePackNameRe = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,63}$`)
+)
+
+// RulePack 自定义规则包的一个版本，同步到扫描服务时携带内容
+type RulePack struct {
+	Name     string `json:"name"`
+	Version  int    `json:"version"`
+	Checksum string `json:"checksum"`
+	Content  string `json:"content,omitempty"`
+}
+
+// Path 规则包在规则目录中的相对路径
+func (p *RulePack) Path() string {
+	return filepath.Join(p.Name, fmt.Sprintf("v%d.yaml", p.Version))
+}
+
+// ValidRulePackName 规则包名称会作为目录名，只允许字母、数字和 _.-
+func ValidRulePackName(name string) bool {
+	return rulePackNameRe.MatchString(name) && name != "." && name != ".."
+}
+
+// Checksum 规则包内容的 sha256
+func Checksum(content string) string {
+	h := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(h[:])
+}
+
+// ParseRules 校验规则包内容，返回其中的规则id
+// 规则包为 semgrep 格式的 YAML，顶层 rules 中每条规则必须有唯一的 id
+func ParseRules(content string) ([]string, error) {
+	var doc struct {
+		Rules []map[string]any `yaml:"rules"`
+	}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, fmt.Errorf("invalid rule yaml: %w", err)
+	}
+	if len(doc.Rules) == 0 {
+		return nil, fmt.Errorf("rule pack has no rules")
+	}
+	ids := make([]string, 0, len(doc.Rules))
+	seen := make(map[string]bool)
+	for i, r := range doc.Rules {
+		id, _ := r["id"].(string)
+		if id == "" {
+			return nil, fmt.Errorf("rule %d has no id", i)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("duplicate rule id %q", id)
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// WriteRulePack 将规则包写入规则目录，同名同版本且校验和一致时跳过
+func WriteRulePack(dir string, p *RulePack) error {
+	if !ValidRulePackName(p.Name) {
+		return fmt.Errorf("invalid rule pack name %q", p.Name)
+	}
+	if Checksum(p.Content) != p.Checksum {
+		return ErrChecksum
+	}
+	filename := filepath.Join(dir, p.Path())
+	if b, err := os.ReadFile(filename); err == nil && Checksum(string(b)) == p.Checksum {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	// 先写临时文件再重命名，避免扫描读到写了一半的规则
+	tmp, err := os.CreateTemp(filepath.Dir(filename), ".rules-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.WriteString(p.Content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
+
+// RulePackFile 规则包在规则目录中的文件，校验和不一致时返回 ErrChecksum
+func RulePackFile(dir string, p *RulePack) (string, error) {
+	if !ValidRulePackName(p.Name) {
+		return "", fmt.Errorf("invalid rule pack name %q", p.Name)
+	}
+	filename := filepath.Join(dir, p.Path())
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	if Checksum(string(b)) != p.Checksum {
+		return "", ErrChecksum
+	}
+	return filename, nil
+}
@@ -0,0 +1,90 @@
+package scan
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+const testRules = `rules:
+  - id: org.no-println
+    languages: [go]
+    severity: INFO
+    message: use the logger
+    pattern: fmt.Println(...)
+  - id: org.no-exec
+    languages: [go]
+    severity: ERROR
+    message: avoid exec
+    pattern: exec.Command(...)
+`
+
+func TestParseRules(t *testing.T) {
+	ids, err := ParseRules(testRules)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ids, []string{"org.no-println", "org.no-exec"}) {
+		t.Errorf("unexpected ids %v", ids)
+	}
+
+	bad := []string{
+		"rules: [",
+		"foo: bar",
+		"rules:\n  - message: no id\n",
+		"rules:\n  - id: a\n  - id: a\n",
+	}
+	for _, c := range bad {
+		if _, err := ParseRules(c); err == nil {
+			t.Errorf("expect error for %q", c)
+		}
+	}
+}
+
+func TestWriteRulePack(t *testing.T) {
+	dir := t.TempDir()
+	p := &RulePack{Name: "org-go", Version: 2, Checksum: Checksum(testRules), Content: testRules}
+	if err := WriteRulePack(dir, p); err != nil {
+		t.Fatal(err)
+	}
+	// 重复同步时跳过写入
+	if err := WriteRulePack(dir, p); err != nil {
+		t.Fatal(err)
+	}
+
+	ref := &RulePack{Name: p.Name, Version: p.Version, Checksum: p.Checksum}
+	filename, err := RulePackFile(dir, ref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := os.ReadFile(filename); string(b) != testRules {
+		t.Errorf("unexpected content %q", b)
+	}
+
+	ref.Checksum = Checksum("other")
+	if _, err := RulePackFile(dir, ref); !errors.Is(err, ErrChecksum) {
+		t.Errorf("expect checksum error, got %v", err)
+	}
+	if err := WriteRulePack(dir, &RulePack{Name: "../x", Checksum: Checksum(""), Content: ""}); err == nil {
+		t.Error("expect invalid name error")
+	}
+	if err := WriteRulePack(dir, &RulePack{Name: "x", Checksum: "bad", Content: testRules}); !errors.Is(err, ErrChecksum) {
+		t.Errorf("expect checksum error, got %v", err)
+	}
+}
+
+func TestOptionsArgs(t *testing.T) {
+	o := &Options{Configs: []string{"go", "/app/rules/org-go/v2.yaml"}, Confidence: []string{"HIGH"}}
+	got := o.args("/code", "/tmp/1.json")
+	want := []string{
+		"--metrics=off", "--disable-version-check", "--disable-nosem",
+		"--confidence=HIGH", "--impact=HIGH", "--impact=MEDIUM",
+		"--time", "--json", "--output", "/tmp/1.json",
+		"--config", "go", "--config", "/app/rules/org-go/v2.yaml",
+		"/code",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected args\n got %v\nwant %v", got, want)
+	}
+}
@@ -9,26 +9,50 @@ import (
 	"strings"
 )
 
-func Scan(id string, workspace, rule string) (*Result, error) {
+// Options 扫描使用的规则和过滤条件
+type Options struct {
+	Configs    []string // 规则，内置的语言规则或规则文件路径
+	Confidence []string // 报告的置信度，为空时只报告 HIGH 和 MEDIUM
+	Impact     []string // 报告的影响，为空时只报告 HIGH 和 MEDIUM
+}
+
+var defaultLevels = []string{"HIGH", "MEDIUM"}
+
+func (o *Options) args(workspace, output string) []string {
+	args := []string{
+		"--metrics=off",
+		"--disable-version-check",
+		"--disable-nosem",
+	}
+	for _, c := range levels(o.Confidence) {
+		args = append(args, "--confidence="+c)
+	}
+	for _, i := range levels(o.Impact) {
+		args = append(args, "--impact="+i)
+	}
+	args = append(args, "--time", "--json", "--output", output)
+	for _, c := range o.Configs {
+		args = append(args, "--config", c)
+	}
+	return append(args, workspace)
+}
+
+func levels(ls []string) []string {
+	if len(ls) == 0 {
+		return defaultLevels
+	}
+	return ls
+}
+
+func Scan(id string, workspace string, opts *Options) (*Result, error) {
 	if _, err := os.Stat(workspace); err != nil {
 		return nil, fmt.Errorf("failed to stat workspace: %w", err)
 	}
+	if len(opts.Configs) == 0 {
+		return nil, fmt.Errorf("no rules to scan")
+	}
 	output := fmt.Sprintf("/tmp/%s.json", id)
-	cmd := exec.Command(
-		"/app/assets/sgp/sgp",
-		"--metrics=off",
-		"--disable-version-check",
-		"--disable-nosem",
-		"--confidence=HIGH",
-		"--confidence=MEDIUM",
-		"--impact=HIGH",
-		"--impact=MEDIUM",
-		"--time",
-		"--json",
-		"--output", output,
-		"--config", rule,
-		workspace,
-	)
+	cmd := exec.Command("/app/assets/sgp/sgp", opts.args(workspace, output)...)
 	defer os.Remove(output)
 
 	log.Printf("[Scan] Executing command: %s %s", cmd.Path, strings.Join(cmd.Args[1:], " "))
@@ -0,0 +1,396 @@
+package streamqueue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Config 队列配置
+type Config struct {
+	Stream      string        // 消息流名称，死信流、统计和错误信息使用相同前缀
+	Group       string        // 消费组名称
+	Workers     int           // 并发消费的协程数
+	MaxAttempts int           // 最多处理次数，超过后转入死信流
+	Backoff     time.Duration // 首次重试的等待时间，之后每次翻倍
+	MaxBackoff  time.Duration // 重试等待时间上限
+	Block       time.Duration // 读取消息时的最长阻塞时间
+	DeadMaxLen  int64         // 死信流保留的最多消息数，超过后近似裁剪最早的死信
+}
+
+// Handler 处理消息，返回错误时消息保留在待确认列表中，等待重试
+type Handler[T any] func(ctx context.Context, v *T) error
+
+// permanentError 无法通过重试恢复的错误
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 标记错误不需要重试，消息直接转入死信流
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent 是否为不需要重试的错误
+func IsPermanent(err error) bool {
+	var p *permanentError
+	return errors.As(err, &p)
+}
+
+// Stats 队列统计，用于观察积压情况
+type Stats struct {
+	Length        int64 `json:"length"`         // 消息流中的消息数
+	Pending       int64 `json:"pending"`        // 已读取但未确认的消息数，包括等待重试的消息
+	Lag           int64 `json:"lag"`            // 尚未被读取的消息数
+	Dead          int64 `json:"dead"`           // 死信数
+	Consumers     int64 `json:"consumers"`      // 消费者数
+	Published     int64 `json:"published"`      // 累计写入数
+	Processed     int64 `json:"processed"`      // 累计处理成功数
+	Failed        int64 `json:"failed"`         // 累计处理失败次数，包括重试
+	DeadLettered  int64 `json:"dead_lettered"`  // 累计转入死信流数
+	PublishFailed int64 `json:"publish_failed"` // 当前实例启动以来写入失败数
+}
+
+// DeadLetter 死信
+type DeadLetter[T any] struct {
+	ID       string    `json:"id"`        // 死信ID
+	SourceID string    `json:"source_id"` // 原消息ID
+	Attempts int64     `json:"attempts"`  // 处理次数
+	Error    string    `json:"error"`     // 最后一次处理的错误
+	Data     *T        `json:"data"`      // 消息内容
+	DeadAt   time.Time `json:"dead_at"`   // 转入死信流的时间
+}
+
+// Queue 基于 Redis Stream 的持久化队列，至少处理一次
+// 消息处理成功后才确认并删除，进程退出或处理失败的消息由其他消费者按退避时间重新认领
+type Queue[T any] struct {
+	rdb           *redis.Client
+	cfg           Config
+	consumer      string
+	logger        *slog.Logger
+	publishFailed atomic.Int64
+}
+
+func New[T any](rdb *redis.Client, cfg Config, logger *slog.Logger) *Queue[T] {
+	host, _ := os.Hostname()
+	cfg.Workers = max(cfg.Workers, 1)
+	cfg.MaxAttempts = max(cfg.MaxAttempts, 1)
+	if cfg.Backoff <= 0 {
+		cfg.Backoff = 5 * time.Second
+	}
+	if cfg.MaxBackoff < cfg.Backoff {
+		cfg.MaxBackoff = cfg.Backoff
+	}
+	if cfg.Block <= 0 {
+		cfg.Block = 5 * time.Second
+	}
+	if cfg.DeadMaxLen <= 0 {
+		cfg.DeadMaxLen = 10000
+	}
+	return &Queue[T]{
+		rdb:      rdb,
+		cfg:      cfg,
+		consumer: fmt.Sprintf("%s-%d", host, os.Getpid()),
+		logger:   logger.With("stream", cfg.Stream),
+	}
+}
+
+func (q *Queue[T]) deadStream() string { return q.cfg.Stream + ":dead" }
+func (q *Queue[T]) statsKey() string   { return q.cfg.Stream + ":stats" }
+func (q *Queue[T]) errorsKey() string  { return q.cfg.Stream + ":errors" }
+
+// Publish 写入消息，写入成功即持久化
+func (q *Queue[T]) Publish(ctx context.Context, v *T) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	pipe := q.rdb.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{Stream: q.cfg.Stream, Values: map[string]any{"data": b}})
+	pipe.HIncrBy(ctx, q.statsKey(), "published", 1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		q.publishFailed.Add(1)
+		return err
+	}
+	return nil
+}
+
+// Run 启动消费协程，阻塞直到 ctx 结束
+func (q *Queue[T]) Run(ctx context.Context, h Handler[T]) {
+	for {
+		err := q.rdb.XGroupCreateMkStream(ctx, q.cfg.Stream, q.cfg.Group, "0").Err()
+		if err == nil || strings.Contains(err.Error(), "BUSYGROUP") {
+			break
+		}
+		q.logger.With("error", err).WarnContext(ctx, "create consumer group failed")
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(q.cfg.Block):
+		}
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < q.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.work(ctx, h)
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		q.reclaim(ctx, h)
+	}()
+	wg.Wait()
+}
+
+// work 读取新消息并处理
+func (q *Queue[T]) work(ctx context.Context, h Handler[T]) {
+	for ctx.Err() == nil {
+		streams, err := q.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    q.cfg.Group,
+			Consumer: q.consumer,
+			Streams:  []string{q.cfg.Stream, ">"},
+			Count:    10,
+			Block:    q.cfg.Block,
+		}).Result()
+		if err != nil {
+			if !errors.Is(err, redis.Nil) && ctx.Err() == nil {
+				q.logger.With("error", err).WarnContext(ctx, "read stream failed")
+				time.Sleep(q.cfg.Backoff)
+			}
+			continue
+		}
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				q.handle(ctx, h, msg, 1)
+			}
+		}
+	}
+}
+
+// reclaim 定期认领等待时间超过退避时间的待确认消息重新处理，超过最多处理次数的转入死信流
+func (q *Queue[T]) reclaim(ctx context.Context, h Handler[T]) {
+	ticker := time.NewTicker(q.cfg.Backoff)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		pending, err := q.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+			Stream: q.cfg.Stream,
+			Group:  q.cfg.Group,
+			Idle:   q.cfg.Backoff,
+			Start:  "-",
+			End:    "+",
+			Count:  100,
+		}).Result()
+		if err != nil {
+			if ctx.Err() == nil {
+				q.logger.With("error", err).WarnContext(ctx, "list pending messages failed")
+			}
+			continue
+		}
+		for _, p := range pending {
+			if p.Idle < q.backoff(p.RetryCount) {
+				continue
+			}
+			msgs, err := q.rdb.XClaim(ctx, &redis.XClaimArgs{
+				Stream:   q.cfg.Stream,
+				Group:    q.cfg.Group,
+				Consumer: q.consumer,
+				MinIdle:  p.Idle,
+				Messages: []string{p.ID},
+			}).Result()
+			if err != nil {
+				q.logger.With("id", p.ID).With("error", err).WarnContext(ctx, "claim message failed")
+				continue
+			}
+			if len(msgs) == 0 {
+				// 消息已被删除，只需确认
+				q.rdb.XAck(ctx, q.cfg.Stream, q.cfg.Group, p.ID)
+				continue
+			}
+			attempts := p.RetryCount + 1
+			if p.RetryCount >= int64(q.cfg.MaxAttempts) {
+				q.deadLetter(ctx, msgs[0], p.RetryCount, "")
+				continue
+			}
+			q.handle(ctx, h, msgs[0], attempts)
+		}
+	}
+}
+
+// backoff 第 n 次处理失败后的等待时间
+func (q *Queue[T]) backoff(n int64) time.Duration {
+	d := q.cfg.Backoff
+	for i := int64(1); i < n && d < q.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, q.cfg.MaxBackoff)
+}
+
+func (q *Queue[T]) handle(ctx context.Context, h Handler[T], msg redis.XMessage, attempts int64) {
+	var v T
+	data, _ := msg.Values["data"].(string)
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		q.deadLetter(ctx, msg, attempts, fmt.Sprintf("decode message: %v", err))
+		return
+	}
+
+	err := h(ctx, &v)
+	if err == nil {
+		pipe := q.rdb.TxPipeline()
+		pipe.XAck(ctx, q.cfg.Stream, q.cfg.Group, msg.ID)
+		pipe.XDel(ctx, q.cfg.Stream, msg.ID)
+		pipe.HDel(ctx, q.errorsKey(), msg.ID)
+		pipe.HIncrBy(ctx, q.statsKey(), "processed", 1)
+		if _, err := pipe.Exec(ctx); err != nil {
+			q.logger.With("id", msg.ID).With("error", err).WarnContext(ctx, "ack message failed")
+		}
+		return
+	}
+
+	q.logger.With("id", msg.ID).With("attempts", attempts).With("error", err).WarnContext(ctx, "handle message failed")
+	if IsPermanent(err) || attempts >= int64(q.cfg.MaxAttempts) {
+		q.deadLetter(ctx, msg, attempts, err.Error())
+		return
+	}
+	pipe := q.rdb.TxPipeline()
+	pipe.HSet(ctx, q.errorsKey(), msg.ID, err.Error())
+	pipe.HIncrBy(ctx, q.statsKey(), "failed", 1)
+	pipe.Exec(ctx)
+}
+
+// deadLetter 将消息转入死信流，reason 为空时使用最后一次处理记录的错误
+func (q *Queue[T]) deadLetter(ctx context.Context, msg redis.XMessage, attempts int64, reason string) {
+	if reason == "" {
+		reason, _ = q.rdb.HGet(ctx, q.errorsKey(), msg.ID).Result()
+	}
+	pipe := q.rdb.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{
+		Stream: q.deadStream(),
+		MaxLen: q.cfg.DeadMaxLen,
+		Approx: true,
+		Values: map[string]any{
+			"data":      msg.Values["data"],
+			"source_id": msg.ID,
+			"attempts":  attempts,
+			"error":     reason,
+		},
+	})
+	pipe.XAck(ctx, q.cfg.Stream, q.cfg.Group, msg.ID)
+	pipe.XDel(ctx, q.cfg.Stream, msg.ID)
+	pipe.HDel(ctx, q.errorsKey(), msg.ID)
+	pipe.HIncrBy(ctx, q.statsKey(), "failed", 1)
+	pipe.HIncrBy(ctx, q.statsKey(), "dead_lettered", 1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		q.logger.With("id", msg.ID).With("error", err).ErrorContext(ctx, "move message to dead letter failed")
+		return
+	}
+	q.logger.With("id", msg.ID).With("attempts", attempts).With("error", reason).ErrorContext(ctx, "message moved to dead letter")
+}
+
+// Stats 返回队列统计
+func (q *Queue[T]) Stats(ctx context.Context) (*Stats, error) {
+	s := &Stats{PublishFailed: q.publishFailed.Load()}
+	pipe := q.rdb.Pipeline()
+	length := pipe.XLen(ctx, q.cfg.Stream)
+	dead := pipe.XLen(ctx, q.deadStream())
+	counters := pipe.HGetAll(ctx, q.statsKey())
+	groups := pipe.XInfoGroups(ctx, q.cfg.Stream)
+	// 消息流尚未创建时 XINFO 返回错误，不影响其他统计
+	pipe.Exec(ctx)
+
+	var err error
+	if s.Length, err = length.Result(); err != nil {
+		return nil, err
+	}
+	s.Dead = dead.Val()
+	for k, v := range counters.Val() {
+		n, _ := strconv.ParseInt(v, 10, 64)
+		switch k {
+		case "published":
+			s.Published = n
+		case "processed":
+			s.Processed = n
+		case "failed":
+			s.Failed = n
+		case "dead_lettered":
+			s.DeadLettered = n
+		}
+	}
+	for _, g := range groups.Val() {
+		if g.Name == q.cfg.Group {
+			s.Pending = g.Pending
+			s.Lag = g.Lag
+			s.Consumers = g.Consumers
+		}
+	}
+	return s, nil
+}
+
+// DeadLetters 返回最近的死信
+func (q *Queue[T]) DeadLetters(ctx context.Context, count int64) ([]*DeadLetter[T], error) {
+	msgs, err := q.rdb.XRevRangeN(ctx, q.deadStream(), "+", "-", count).Result()
+	if err != nil {
+		return nil, err
+	}
+	letters := make([]*DeadLetter[T], 0, len(msgs))
+	for _, msg := range msgs {
+		d := &DeadLetter[T]{ID: msg.ID}
+		d.SourceID, _ = msg.Values["source_id"].(string)
+		d.Error, _ = msg.Values["error"].(string)
+		if a, ok := msg.Values["attempts"].(string); ok {
+			d.Attempts, _ = strconv.ParseInt(a, 10, 64)
+		}
+		if ms, err := strconv.ParseInt(strings.Split(msg.ID, "-")[0], 10, 64); err == nil {
+			d.DeadAt = time.UnixMilli(ms)
+		}
+		if data, ok := msg.Values["data"].(string); ok {
+			var v T
+			if err := json.Unmarshal([]byte(data), &v); err == nil {
+				d.Data = &v
+			}
+		}
+		letters = append(letters, d)
+	}
+	return letters, nil
+}
+
+// Retry 将死信重新写入消息流
+func (q *Queue[T]) Retry(ctx context.Context, id string) error {
+	msgs, err := q.rdb.XRangeN(ctx, q.deadStream(), id, id, 1).Result()
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return fmt.Errorf("dead letter %s not found", id)
+	}
+	pipe := q.rdb.TxPipeline()
+	pipe.XAdd(ctx, &redis.XAddArgs{Stream: q.cfg.Stream, Values: map[string]any{"data": msgs[0].Values["data"]}})
+	pipe.XDel(ctx, q.deadStream(), id)
+	_, err = pipe.Exec(ctx)
+	return err
+}
@@ -0,0 +1,98 @@
+package streamqueue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestBackoff(t *testing.T) {
+	q := New[struct{}](nil, Config{
+		Stream:     "test",
+		Backoff:    time.Second,
+		MaxBackoff: 10 * time.Second,
+	}, slog.Default())
+	want := []time.Duration{time.Second, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for n, w := range want {
+		if got := q.backoff(int64(n)); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", n, got, w)
+		}
+	}
+	if q.cfg.Workers != 1 || q.cfg.MaxAttempts != 1 {
+		t.Errorf("invalid config should be corrected: %+v", q.cfg)
+	}
+}
+
+func TestPermanent(t *testing.T) {
+	base := errors.New("task_id is empty")
+	err := fmt.Errorf("record: %w", Permanent(base))
+	if !IsPermanent(err) || !errors.Is(err, base) {
+		t.Error("wrapped permanent error should be detected")
+	}
+	if IsPermanent(base) || Permanent(nil) != nil {
+		t.Error("plain error should not be permanent")
+	}
+}
+
+func TestDeadMaxLen(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { rdb.Close() })
+
+	q := New[struct{ N int }](rdb, Config{
+		Stream:     "test",
+		Group:      "test",
+		Block:      10 * time.Millisecond,
+		DeadMaxLen: 3,
+	}, slog.Default())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i := range 5 {
+		if err := q.Publish(ctx, &struct{ N int }{N: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		q.Run(ctx, func(context.Context, *struct{ N int }) error {
+			return Permanent(errors.New("invalid"))
+		})
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		s, err := q.Stats(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.DeadLettered == 5 {
+			// 死信流只保留最近的死信
+			if s.Dead != 3 || s.Length != 0 {
+				t.Fatalf("dead = %d, length = %d, want 3 and 0", s.Dead, s.Length)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("messages not dead-lettered: %+v", s)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	letters, err := q.DeadLetters(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(letters) != 3 || letters[0].Data.N != 4 || letters[2].Data.N != 2 {
+		t.Errorf("unexpected dead letters %+v", letters)
+	}
+	cancel()
+	<-done
+}
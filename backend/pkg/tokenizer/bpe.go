@@ -0,0 +1,97 @@
+package tokenizer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// BPE 字节级 BPE 分词器，词表为 tiktoken 格式
+type BPE struct {
+	ranks map[string]int
+	o200k bool // 是否使用 o200k_base 的预分词规则
+}
+
+var _ Tokenizer = &BPE{}
+
+// LoadBPE 读取 tiktoken 格式的词表，每行为 base64 编码的 token 和它的序号
+func LoadBPE(r io.Reader, o200k bool) (*BPE, error) {
+	ranks := make(map[string]int)
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		token, rank, ok := bytes.Cut(line, []byte(" "))
+		if !ok {
+			return nil, fmt.Errorf("line %d: invalid format", n)
+		}
+		b, err := base64.StdEncoding.DecodeString(string(token))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		v, err := strconv.Atoi(string(rank))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		ranks[string(b)] = v
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if len(ranks) == 0 {
+		return nil, fmt.Errorf("empty table")
+	}
+	return &BPE{ranks: ranks, o200k: o200k}, nil
+}
+
+// Count implements Tokenizer.
+func (b *BPE) Count(text string) int {
+	n := 0
+	for _, piece := range split(text, b.o200k) {
+		n += len(b.merge([]byte(piece))) - 1
+	}
+	return n
+}
+
+// Encode 返回文本的 token 序号
+func (b *BPE) Encode(text string) []int {
+	ids := make([]int, 0, len(text)/3)
+	for _, piece := range split(text, b.o200k) {
+		p := []byte(piece)
+		parts := b.merge(p)
+		for i := 0; i+1 < len(parts); i++ {
+			ids = append(ids, b.ranks[string(p[parts[i]:parts[i+1]])])
+		}
+	}
+	return ids
+}
+
+// merge 按序号从小到大合并相邻字节，返回每个 token 的起始位置和结尾位置，token 数为 len-1
+func (b *BPE) merge(piece []byte) []int {
+	if _, ok := b.ranks[string(piece)]; ok {
+		return []int{0, len(piece)}
+	}
+	parts := make([]int, len(piece)+1)
+	for i := range parts {
+		parts[i] = i
+	}
+	for len(parts) > 2 {
+		best, at := math.MaxInt, -1
+		for i := 0; i+2 < len(parts); i++ {
+			if r, ok := b.ranks[string(piece[parts[i]:parts[i+2]])]; ok && r < best {
+				best, at = r, i
+			}
+		}
+		if at < 0 {
+			break
+		}
+		parts = append(parts[:at+1], parts[at+2:]...)
+	}
+	return parts
+}
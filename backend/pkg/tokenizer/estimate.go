@@ -0,0 +1,44 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Estimator 未内嵌词表时的估算器，按预分词结果和字符类别估算 token 数
+type Estimator struct {
+	o200k bool
+}
+
+var _ Tokenizer = Estimator{}
+
+// Count implements Tokenizer.
+func (e Estimator) Count(text string) int {
+	n := 0
+	for _, piece := range split(text, e.o200k) {
+		n += estimate(piece)
+	}
+	return n
+}
+
+// estimate 估算单个分段的 token 数
+// 英文单词、数字和符号在常用词表中通常为一个 token，较长的分段约每 4 个字节增加一个 token
+// 中日韩文字约每个字一个 token，其他非 ASCII 文字约每两个字符一个 token
+func estimate(piece string) int {
+	ascii, cjk, other := 0, 0, 0
+	for _, r := range piece {
+		switch {
+		case r < utf8.RuneSelf:
+			ascii++
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			cjk++
+		default:
+			other++
+		}
+	}
+	n := cjk + (other+1)/2
+	if ascii > 0 {
+		n += 1 + max(0, ascii-6+3)/4
+	}
+	return max(n, 1)
+}
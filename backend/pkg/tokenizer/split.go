@@ -0,0 +1,169 @@
+package tokenizer
+
+import (
+	"unicode"
+)
+
+// split 按编码的预分词规则切分文本，等价于 tiktoken 中的正则：
+//
+//	cl100k_base: '(?i:[sdmt]|ll|ve|re)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+(?!\S)|\s+
+//	o200k_base:  在 cl100k_base 的基础上按大小写切分单词，缩写跟随单词，标点后可跟随 /
+//
+// Go 的正则不支持 (?!\S)，因此手写匹配
+func split(text string, o200k bool) []string {
+	rs := []rune(text)
+	pieces := make([]string, 0, len(rs)/4+1)
+	for i := 0; i < len(rs); {
+		var j int
+		if o200k {
+			j = matchO200k(rs, i)
+		} else {
+			j = matchCl100k(rs, i)
+		}
+		pieces = append(pieces, string(rs[i:j]))
+		i = j
+	}
+	return pieces
+}
+
+func matchCl100k(rs []rune, i int) int {
+	if j := contraction(rs, i); j > i {
+		return j
+	}
+	// [^\r\n\p{L}\p{N}]?\p{L}+
+	if unicode.IsLetter(rs[i]) {
+		return run(rs, i, unicode.IsLetter)
+	}
+	if isPrefix(rs[i]) && i+1 < len(rs) && unicode.IsLetter(rs[i+1]) {
+		return run(rs, i+1, unicode.IsLetter)
+	}
+	if j := number(rs, i); j > i {
+		return j
+	}
+	if j := punct(rs, i, isNewline); j > i {
+		return j
+	}
+	return space(rs, i)
+}
+
+func matchO200k(rs []rune, i int) int {
+	start := i
+	if isPrefix(rs[i]) && i+1 < len(rs) && (isUpper(rs[i+1]) || isLower(rs[i+1])) {
+		start = i + 1
+	}
+	for s := start; s >= i; s-- {
+		if j := word(rs, s); j > s {
+			if j < len(rs) {
+				return contraction(rs, j)
+			}
+			return j
+		}
+	}
+	if j := number(rs, i); j > i {
+		return j
+	}
+	if j := punct(rs, i, func(r rune) bool { return isNewline(r) || r == '/' }); j > i {
+		return j
+	}
+	return space(rs, i)
+}
+
+// word 匹配 [\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]*[\p{Ll}\p{Lm}\p{Lo}\p{M}]+ 或 [\p{Lu}\p{Lt}\p{Lm}\p{Lo}\p{M}]+[\p{Ll}\p{Lm}\p{Lo}\p{M}]*
+func word(rs []rune, i int) int {
+	upper := run(rs, i, isUpper)
+	for u := upper; u >= i; u-- {
+		if j := run(rs, u, isLower); j > u {
+			return j
+		}
+	}
+	if upper > i {
+		return run(rs, upper, isLower)
+	}
+	return i
+}
+
+// contraction 匹配英文缩写 's 't 're 've 'm 'll 'd，不区分大小写
+func contraction(rs []rune, i int) int {
+	if rs[i] != '\'' || i+1 >= len(rs) {
+		return i
+	}
+	if i+2 < len(rs) {
+		switch string(unicode.ToLower(rs[i+1])) + string(unicode.ToLower(rs[i+2])) {
+		case "ll", "ve", "re":
+			return i + 3
+		}
+	}
+	switch unicode.ToLower(rs[i+1]) {
+	case 's', 'd', 'm', 't':
+		return i + 2
+	}
+	return i
+}
+
+// number 匹配 \p{N}{1,3}
+func number(rs []rune, i int) int {
+	j := i
+	for j < len(rs) && j-i < 3 && unicode.IsNumber(rs[j]) {
+		j++
+	}
+	return j
+}
+
+// punct 匹配 " ?[^\s\p{L}\p{N}]+" 及其后的 tail 字符
+func punct(rs []rune, i int, tail func(rune) bool) int {
+	j := i
+	if rs[j] == ' ' {
+		j++
+	}
+	if j >= len(rs) || !isSymbol(rs[j]) {
+		return i
+	}
+	j = run(rs, j, isSymbol)
+	return run(rs, j, tail)
+}
+
+// space 匹配 \s*[\r\n]+|\s+(?!\S)|\s+
+func space(rs []rune, i int) int {
+	j := run(rs, i, unicode.IsSpace)
+	if j == i {
+		// 不属于任何规则的字符单独成段
+		return i + 1
+	}
+	for k := j - 1; k >= i; k-- {
+		if isNewline(rs[k]) {
+			return k + 1
+		}
+	}
+	// 空白后跟随非空白字符时，最后一个空白留给下一段
+	if j < len(rs) && j-i > 1 {
+		return j - 1
+	}
+	return j
+}
+
+func run(rs []rune, i int, fn func(rune) bool) int {
+	for i < len(rs) && fn(rs[i]) {
+		i++
+	}
+	return i
+}
+
+func isNewline(r rune) bool {
+	return r == '\r' || r == '\n'
+}
+
+func isPrefix(r rune) bool {
+	return !isNewline(r) && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
+func isSymbol(r rune) bool {
+	return !unicode.IsSpace(r) && !unicode.IsLetter(r) && !unicode.IsNumber(r)
+}
+
+func isUpper(r rune) bool {
+	return unicode.In(r, unicode.Lu, unicode.Lt, unicode.Lm, unicode.Lo, unicode.M)
+}
+
+func isLower(r rune) bool {
+	return unicode.In(r, unicode.Ll, unicode.Lm, unicode.Lo, unicode.M)
+}
@@ -0,0 +1,11 @@
+//go:build !tokenizer_estimate
+
+package tokenizer
+
+import "embed"
+
+// tables 内嵌的词表，由 make tokenizer 下载到 tables 目录后编译进二进制
+// 只内嵌词表文件，缺少词表时编译失败，开发环境可使用 -tags tokenizer_estimate 跳过
+//
+//go:embed tables/cl100k_base.tiktoken tables/o200k_base.tiktoken
+var tables embed.FS
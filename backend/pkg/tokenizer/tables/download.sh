@@ -0,0 +1,21 @@
+#!/bin/sh
+# 下载 tiktoken 词表并校验哈希
+set -e
+
+DIR=$(cd "$(dirname "$0")" && pwd)
+BASE=${TIKTOKEN_BASE:-https://openaipublic.blob.core.windows.net/encodings}
+
+fetch() {
+	name=$1
+	sum=$2
+	file="$DIR/$name.tiktoken"
+	if [ -f "$file" ] && echo "$sum  $file" | sha256sum -c - >/dev/null 2>&1; then
+		return
+	fi
+	curl -fsSL -o "$file.tmp" "$BASE/$name.tiktoken"
+	echo "$sum  $file.tmp" | sha256sum -c - >/dev/null
+	mv "$file.tmp" "$file"
+}
+
+fetch cl100k_base 223921b76ee99bde995b7ff738513eef100fb51d18c93597a113bcffe865b2a7
+fetch o200k_base 446a9538cb6c348e3516120d7c08b09f57c36495e2acfffe59a5bf8b0cfb1a2d
@@ -0,0 +1,8 @@
+//go:build tokenizer_estimate
+
+package tokenizer
+
+import "embed"
+
+// tables 不内嵌词表，所有词表都使用估算器，仅用于没有下载词表的开发环境
+var tables embed.FS
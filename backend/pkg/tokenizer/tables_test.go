@@ -0,0 +1,33 @@
+//go:build !tokenizer_estimate
+
+package tokenizer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTables(t *testing.T) {
+	cases := []struct {
+		enc  Encoding
+		text string
+		want []int
+	}{
+		{Cl100kBase, "tiktoken is great!", []int{83, 1609, 5963, 374, 2294, 0}},
+		{Cl100kBase, "2 + 2 = 4", []int{17, 489, 220, 17, 284, 220, 19}},
+		{O200kBase, "tiktoken is great!", []int{83, 8251, 2488, 382, 2212, 0}},
+		{O200kBase, "2 + 2 = 4", []int{17, 659, 220, 17, 314, 220, 19}},
+	}
+	for _, c := range cases {
+		if !Exact(c.enc) {
+			t.Fatalf("%s table not loaded", c.enc)
+		}
+		bpe := Get(c.enc).(*BPE)
+		if got := bpe.Encode(c.text); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s Encode(%q) = %v, want %v", c.enc, c.text, got, c.want)
+		}
+		if got := bpe.Count(c.text); got != len(c.want) {
+			t.Errorf("%s Count(%q) = %d, want %d", c.enc, c.text, got, len(c.want))
+		}
+	}
+}
@@ -0,0 +1,102 @@
+// Package tokenizer 在上游未返回 usage 时，离线计算文本的 token 数
+package tokenizer
+
+import (
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// Tokenizer 计算文本的 token 数
+type Tokenizer interface {
+	Count(text string) int
+}
+
+// Encoding 词表名称
+type Encoding string
+
+const (
+	Cl100kBase Encoding = "cl100k_base" // GPT-4、GPT-3.5，也用于其他模型的近似计算
+	O200kBase  Encoding = "o200k_base"  // GPT-4o、o 系列、GPT-4.1 及之后的模型
+)
+
+var (
+	mu     sync.Mutex
+	loaded = make(map[Encoding]Tokenizer)
+)
+
+// Get 获取词表对应的分词器，未内嵌词表时使用估算器
+func Get(enc Encoding) Tokenizer {
+	mu.Lock()
+	defer mu.Unlock()
+	if t, ok := loaded[enc]; ok {
+		return t
+	}
+	var t Tokenizer = Estimator{o200k: enc == O200kBase}
+	if f, err := tables.Open("tables/" + string(enc) + ".tiktoken"); err != nil {
+		slog.With("encoding", enc).Warn("tokenizer table not embedded, token counts are estimated")
+	} else {
+		defer f.Close()
+		bpe, err := LoadBPE(f, enc == O200kBase)
+		if err != nil {
+			slog.With("encoding", enc).With("error", err).Error("load tokenizer table failed, fallback to estimator")
+		} else {
+			t = bpe
+		}
+	}
+	loaded[enc] = t
+	return t
+}
+
+// Exact 词表是否已内嵌，为 false 时 Get 返回的是估算器
+func Exact(enc Encoding) bool {
+	_, ok := Get(enc).(*BPE)
+	return ok
+}
+
+// modelEncodings 模型名称前缀对应的词表，按顺序匹配
+var modelEncodings = []struct {
+	prefix string
+	enc    Encoding
+}{
+	{"gpt-4o", O200kBase},
+	{"chatgpt-4o", O200kBase},
+	{"gpt-4.1", O200kBase},
+	{"gpt-4.5", O200kBase},
+	{"gpt-5", O200kBase},
+	{"gpt-oss", O200kBase},
+	{"o1", O200kBase},
+	{"o3", O200kBase},
+	{"o4", O200kBase},
+	{"codex", O200kBase},
+	{"gpt-4", Cl100kBase},
+	{"gpt-3.5", Cl100kBase},
+	{"gpt-35", Cl100kBase},
+	{"text-embedding-3", Cl100kBase},
+	{"text-embedding-ada-002", Cl100kBase},
+}
+
+// EncodingForModel 获取模型使用的词表，非 OpenAI 模型没有公开的 tiktoken 词表，使用 cl100k_base 近似
+func EncodingForModel(model string) Encoding {
+	model = strings.ToLower(model)
+	// 去掉 openai/gpt-4o 这类路由前缀
+	if i := strings.LastIndex(model, "/"); i >= 0 {
+		model = model[i+1:]
+	}
+	for _, m := range modelEncodings {
+		if strings.HasPrefix(model, m.prefix) {
+			return m.enc
+		}
+	}
+	return Cl100kBase
+}
+
+// ForModel 获取模型的分词器
+func ForModel(model string) Tokenizer {
+	return Get(EncodingForModel(model))
+}
+
+// Count 计算文本在模型下的 token 数
+func Count(model, text string) int {
+	return ForModel(model).Count(text)
+}
@@ -0,0 +1,95 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	cases := []struct {
+		text  string
+		o200k bool
+		want  []string
+	}{
+		{"Hello world", false, []string{"Hello", " world"}},
+		{"I'm 12345", false, []string{"I", "'m", " ", "123", "45"}},
+		{"a  b\n\nc", false, []string{"a", " ", " b", "\n\n", "c"}},
+		{"foo()\n", false, []string{"foo", "()\n"}},
+		{"HelloWorld", false, []string{"HelloWorld"}},
+		{"你好，世界", false, []string{"你好", "，世界"}},
+		{"HelloWorld", true, []string{"Hello", "World"}},
+		{"don't", true, []string{"don't"}},
+		{"path/to", true, []string{"path", "/to"}},
+		{"x = 1;//\n", true, []string{"x", " =", " ", "1", ";//\n"}},
+	}
+	for _, c := range cases {
+		if got := split(c.text, c.o200k); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("split(%q, %v) = %q, want %q", c.text, c.o200k, got, c.want)
+		}
+	}
+}
+
+func table(tokens ...string) string {
+	var b strings.Builder
+	for i, tk := range tokens {
+		fmt.Fprintf(&b, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(tk)), i)
+	}
+	return b.String()
+}
+
+func TestBPE(t *testing.T) {
+	bpe, err := LoadBPE(strings.NewReader(table("a", "b", "c", " ", "ab", " a", "bc", "abc")), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := bpe.Encode("abc"); !reflect.DeepEqual(got, []int{7}) {
+		t.Errorf("Encode(abc) = %v", got)
+	}
+	// ab 的序号最小先合并，之后合并为 abc，空格无法与 abc 合并
+	if got := bpe.Encode(" abcb"); !reflect.DeepEqual(got, []int{3, 7, 1}) {
+		t.Errorf("Encode( abcb) = %v", got)
+	}
+	if got := bpe.Count("abc abcb"); got != 4 {
+		t.Errorf("Count = %d, want 4", got)
+	}
+
+	if _, err := LoadBPE(strings.NewReader("YQ==\n"), false); err == nil {
+		t.Error("expect error for invalid line")
+	}
+}
+
+func TestEncodingForModel(t *testing.T) {
+	cases := map[string]Encoding{
+		"gpt-4o-mini":      O200kBase,
+		"openai/gpt-4.1":   O200kBase,
+		"o3-mini":          O200kBase,
+		"gpt-4-turbo":      Cl100kBase,
+		"gpt-3.5-turbo":    Cl100kBase,
+		"deepseek-v3":      Cl100kBase,
+		"Qwen2.5-Coder-7B": Cl100kBase,
+	}
+	for model, want := range cases {
+		if got := EncodingForModel(model); got != want {
+			t.Errorf("EncodingForModel(%q) = %s, want %s", model, got, want)
+		}
+	}
+}
+
+func TestEstimator(t *testing.T) {
+	e := Estimator{}
+	cases := map[string]int{
+		"":                     0,
+		"hello":                1,
+		"Hello world":          2,
+		"internationalization": 5,
+		"你好世界":                 4,
+	}
+	for text, want := range cases {
+		if got := e.Count(text); got != want {
+			t.Errorf("Count(%q) = %d, want %d", text, got, want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+/* eslint-disable */
+/* tslint:disable */
+// @ts-nocheck
+/*
+ * ---------------------------------------------------------------
+ * ## THIS FILE WAS GENERATED VIA SWAGGER-TYPESCRIPT-API        ##
+ * ##                                                           ##
+ * ## AUTHOR: acacode                                           ##
+ * ## SOURCE: https://github.com/acacode/swagger-typescript-api ##
+ * ---------------------------------------------------------------
+ */
+
+import request, { ContentType, RequestParams } from "./httpClient";
+import {
+  DomainAuditLogDetail,
+  DomainAuditRetentionSetting,
+  DomainExportAuditReq,
+  DomainListAuditLogResp,
+  DomainUpdateGroupAuditRetentionReq,
+  GetAuditLogInfoParams,
+  GetSearchAuditLogParams,
+  WebResp,
+} from "./types";
+
+/**
+ * @description 导出选中的归档或任务下的所有归档，用于合规审查
+ *
+ * @tags Audit
+ * @name PostExportAuditLog
+ * @summary 导出审计归档
+ * @request POST:/api/v1/audit/export
+ * @response `200` `(DomainAuditLogDetail)[]` OK
+ */
+
+export const postExportAuditLog = (
+  param: DomainExportAuditReq,
+  params: RequestParams = {},
+) =>
+  request<DomainAuditLogDetail[]>({
+    path: `/api/v1/audit/export`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 按用户、模型、时间范围检索审计归档，keyword 在提示词和回复中全文检索
+ *
+ * @tags Audit
+ * @name GetSearchAuditLog
+ * @summary 检索审计归档
+ * @request GET:/api/v1/audit/log
+ * @response `200` `(WebResp & {
+    data?: DomainListAuditLogResp,
+
+})` OK
+ */
+
+export const getSearchAuditLog = (
+  query: GetSearchAuditLogParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainListAuditLogResp;
+    }
+  >({
+    path: `/api/v1/audit/log`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取审计归档详情，包含请求和响应原文
+ *
+ * @tags Audit
+ * @name GetAuditLogInfo
+ * @summary 获取审计归档详情
+ * @request GET:/api/v1/audit/log/info
+ * @response `200` `(WebResp & {
+    data?: DomainAuditLogDetail,
+
+})` OK
+ */
+
+export const getAuditLogInfo = (
+  query: GetAuditLogInfoParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainAuditLogDetail;
+    }
+  >({
+    path: `/api/v1/audit/log/info`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取默认保留策略、用户组的保留策略和当前归档总大小
+ *
+ * @tags Audit
+ * @name GetAuditRetention
+ * @summary 获取归档保留策略
+ * @request GET:/api/v1/audit/retention
+ * @response `200` `(WebResp & {
+    data?: DomainAuditRetentionSetting,
+
+})` OK
+ */
+
+export const getAuditRetention = (params: RequestParams = {}) =>
+  request<
+    WebResp & {
+      data?: DomainAuditRetentionSetting;
+    }
+  >({
+    path: `/api/v1/audit/retention`,
+    method: "GET",
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 更新用户组的归档保留策略，均为 0 时恢复默认配置
+ *
+ * @tags Audit
+ * @name PutUpdateGroupAuditRetention
+ * @summary 更新用户组的归档保留策略
+ * @request PUT:/api/v1/audit/retention/group
+ * @response `200` `WebResp` OK
+ */
+
+export const putUpdateGroupAuditRetention = (
+  param: DomainUpdateGroupAuditRetentionReq,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/audit/retention/group`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
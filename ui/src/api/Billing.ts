@@ -14,12 +14,20 @@ import request, { ContentType, RequestParams } from "./httpClient";
 import {
   DomainChatInfo,
   DomainCompletionInfo,
+  DomainDeadRecord,
+  DomainGroupBudget,
+  DomainListBudgetAlertResp,
   DomainListChatRecordResp,
   DomainListCompletionRecordResp,
+  DomainRecordQueueStat,
+  DomainRetryDeadRecordReq,
+  DomainUpdateGroupBudgetReq,
   GetChatInfoParams,
   GetCompletionInfoParams,
   GetListChatRecordParams,
+  GetListBudgetAlertParams,
   GetListCompletionRecordParams,
+  GetListDeadRecordParams,
   WebResp,
 } from "./types";
 
@@ -142,3 +150,161 @@ export const getListCompletionRecord = (
     format: "json",
     ...params,
   });
+
+/**
+ * @description 获取多次写入数据库失败后转入死信的请求记录
+ *
+ * @tags Billing
+ * @name GetListDeadRecord
+ * @summary 获取写入失败的请求记录
+ * @request GET:/api/v1/billing/record/dead
+ * @response `200` `(WebResp & {
+    data?: (DomainDeadRecord)[],
+
+})` OK
+ */
+
+export const getListDeadRecord = (
+  query: GetListDeadRecordParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainDeadRecord[];
+    }
+  >({
+    path: `/api/v1/billing/record/dead`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 将死信重新放回请求记录队列
+ *
+ * @tags Billing
+ * @name PostRetryDeadRecord
+ * @summary 重新写入请求记录
+ * @request POST:/api/v1/billing/record/dead/retry
+ * @response `200` `WebResp` OK
+ */
+
+export const postRetryDeadRecord = (
+  param: DomainRetryDeadRecordReq,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/billing/record/dead/retry`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取请求记录队列的积压、重试和死信统计
+ *
+ * @tags Billing
+ * @name GetRecordQueueStat
+ * @summary 获取请求记录队列状态
+ * @request GET:/api/v1/billing/record/queue
+ * @response `200` `(WebResp & {
+    data?: DomainRecordQueueStat,
+
+})` OK
+ */
+
+export const getRecordQueueStat = (params: RequestParams = {}) =>
+  request<
+    WebResp & {
+      data?: DomainRecordQueueStat;
+    }
+  >({
+    path: `/api/v1/billing/record/queue`,
+    method: "GET",
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取设置了月度预算的用户组及本月费用
+ *
+ * @tags Billing
+ * @name GetListBudget
+ * @summary 获取用户组预算
+ * @request GET:/api/v1/billing/budget
+ * @response `200` `(WebResp & {
+    data?: (DomainGroupBudget)[],
+
+})` OK
+ */
+
+export const getListBudget = (params: RequestParams = {}) =>
+  request<
+    WebResp & {
+      data?: DomainGroupBudget[];
+    }
+  >({
+    path: `/api/v1/billing/budget`,
+    method: "GET",
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取用户组当月费用超过预算阈值的告警
+ *
+ * @tags Billing
+ * @name GetListBudgetAlert
+ * @summary 获取预算告警
+ * @request GET:/api/v1/billing/budget/alert
+ * @response `200` `(WebResp & {
+    data?: DomainListBudgetAlertResp,
+
+})` OK
+ */
+
+export const getListBudgetAlert = (
+  query: GetListBudgetAlertParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainListBudgetAlertResp;
+    }
+  >({
+    path: `/api/v1/billing/budget/alert`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 设置用户组的月度预算和告警阈值，预算为 0 时取消
+ *
+ * @tags Billing
+ * @name PutUpdateGroupBudget
+ * @summary 设置用户组预算
+ * @request PUT:/api/v1/billing/budget/group
+ * @response `200` `WebResp` OK
+ */
+
+export const putUpdateGroupBudget = (
+  param: DomainUpdateGroupBudgetReq,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/billing/budget/group`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
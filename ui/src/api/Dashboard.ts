@@ -13,6 +13,7 @@
 import request, { ContentType, RequestParams } from "./httpClient";
 import {
   DomainCategoryStat,
+  DomainCodeFindingStat,
   DomainStatistics,
   DomainTimeStat,
   DomainUserCodeRank,
@@ -20,6 +21,7 @@ import {
   DomainUserHeatmapResp,
   DomainUserStat,
   GetCategoryStatDashboardParams,
+  GetCodeFindingStatDashboardParams,
   GetTimeStatDashboardParams,
   GetUserCodeRankDashboardParams,
   GetUserEventsDashboardParams,
@@ -58,6 +60,36 @@ export const getCategoryStatDashboard = (
     ...params,
   });
 
+/**
+ * @description 获取模型生成代码中检测到的安全风险统计
+ *
+ * @tags Dashboard
+ * @name GetCodeFindingStatDashboard
+ * @summary 获取生成代码的安全风险统计
+ * @request GET:/api/v1/dashboard/code-finding-stat
+ * @response `200` `(WebResp & {
+    data?: DomainCodeFindingStat,
+
+})` OK
+ */
+
+export const getCodeFindingStatDashboard = (
+  query: GetCodeFindingStatDashboardParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainCodeFindingStat;
+    }
+  >({
+    path: `/api/v1/dashboard/code-finding-stat`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
 /**
  * @description 获取统计信息
  *
@@ -13,14 +13,23 @@
 import request, { ContentType, RequestParams } from "./httpClient";
 import {
   DeleteDeleteModelParams,
+  DeleteDeleteTransformRuleParams,
   DomainAllModelResp,
   DomainCreateModelReq,
+  DomainCreateTransformRuleReq,
   DomainGetProviderModelListResp,
+  DomainListTransformPolicyResp,
+  DomainListTransformRuleResp,
   DomainModel,
   DomainModelTokenUsageResp,
+  DomainModelUptimeResp,
+  DomainTransformRule,
   DomainUpdateModelReq,
+  DomainUpdateTransformRuleReq,
+  GetGetModelUptimeParams,
   GetGetProviderModelListParams,
   GetGetTokenUsageParams,
+  GetListTransformPolicyParams,
   GetMyModelListParams,
   GithubComChaitinMonkeyCodeBackendDomainCheckModelReq,
   WebResp,
@@ -254,3 +263,172 @@ export const getGetTokenUsage = (
     format: "json",
     ...params,
   });
+
+/**
+ * @description 获取模型可用率历史
+ *
+ * @tags Model
+ * @name GetGetModelUptime
+ * @summary 获取模型可用率历史
+ * @request GET:/api/v1/model/uptime
+ * @response `200` `(WebResp & {
+    data?: DomainModelUptimeResp,
+
+})` OK
+ */
+
+export const getGetModelUptime = (
+  query: GetGetModelUptimeParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainModelUptimeResp;
+    }
+  >({
+    path: `/api/v1/model/uptime`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取请求改写规则和当前策略版本
+ *
+ * @tags Model
+ * @name GetListTransformRule
+ * @summary 获取请求改写规则
+ * @request GET:/api/v1/model/transform
+ * @response `200` `(WebResp & {
+    data?: DomainListTransformRuleResp,
+
+})` OK
+ */
+
+export const getListTransformRule = (params: RequestParams = {}) =>
+  request<
+    WebResp & {
+      data?: DomainListTransformRuleResp;
+    }
+  >({
+    path: `/api/v1/model/transform`,
+    method: "GET",
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 创建请求改写规则，生成新的策略版本
+ *
+ * @tags Model
+ * @name PostCreateTransformRule
+ * @summary 创建请求改写规则
+ * @request POST:/api/v1/model/transform
+ * @response `200` `(WebResp & {
+    data?: DomainTransformRule,
+
+})` OK
+ */
+
+export const postCreateTransformRule = (
+  param: DomainCreateTransformRuleReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainTransformRule;
+    }
+  >({
+    path: `/api/v1/model/transform`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 更新请求改写规则，生成新的策略版本
+ *
+ * @tags Model
+ * @name PutUpdateTransformRule
+ * @summary 更新请求改写规则
+ * @request PUT:/api/v1/model/transform
+ * @response `200` `(WebResp & {
+    data?: DomainTransformRule,
+
+})` OK
+ */
+
+export const putUpdateTransformRule = (
+  param: DomainUpdateTransformRuleReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainTransformRule;
+    }
+  >({
+    path: `/api/v1/model/transform`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 删除请求改写规则，生成新的策略版本
+ *
+ * @tags Model
+ * @name DeleteDeleteTransformRule
+ * @summary 删除请求改写规则
+ * @request DELETE:/api/v1/model/transform
+ * @response `200` `WebResp` OK
+ */
+
+export const deleteDeleteTransformRule = (
+  query: DeleteDeleteTransformRuleParams,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/model/transform`,
+    method: "DELETE",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取改写策略历史版本，每个版本包含当时的全部规则
+ *
+ * @tags Model
+ * @name GetListTransformPolicy
+ * @summary 获取改写策略历史版本
+ * @request GET:/api/v1/model/transform/policy
+ * @response `200` `(WebResp & {
+    data?: DomainListTransformPolicyResp,
+
+})` OK
+ */
+
+export const getListTransformPolicy = (
+  query: GetListTransformPolicyParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainListTransformPolicyResp;
+    }
+  >({
+    path: `/api/v1/model/transform/policy`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
@@ -12,10 +12,34 @@
 
 import request, { ContentType, RequestParams } from "./httpClient";
 import {
+  DeleteSecurityScanningDeletePolicyParams,
+  DeleteSecurityScanningDeleteRulePackParams,
+  DeleteSecurityScanningDeleteSuppressionParams,
+  DomainCreateSecurityRulePackReq,
+  DomainCreateSecurityScanningPolicyReq,
+  DomainCreateSecurityScanningSuppressionReq,
+  DomainImportSARIFResp,
   DomainListSecurityScanningResp,
+  DomainListSecurityScanningSuppressionResp,
+  DomainSecurityRulePack,
+  DomainSecurityRulePackVersion,
+  DomainSecurityScanningComment,
+  DomainSecurityScanningPolicy,
   DomainSecurityScanningRiskDetail,
+  DomainSecurityScanningSuppression,
+  DomainTriageSecurityScanningReq,
+  DomainUpdateSecurityRulePackReq,
+  DomainUpdateSecurityScanningPolicyReq,
+  DomainUploadSecurityRulePackReq,
   GetSecurityScanningDetailParams,
+  GetSecurityScanningExportSarifParams,
+  GetSecurityScanningExportWorkspaceSarifParams,
+  GetSecurityScanningListCommentsParams,
   GetSecurityScanningListParams,
+  GetSecurityScanningListRulePackVersionsParams,
+  GetSecurityScanningListSuppressionsParams,
+  PostSecurityScanningImportSarifParams,
+  SarifLog,
   WebResp,
 } from "./types";
 
@@ -80,3 +104,524 @@ export const getSecurityScanningDetail = (
     format: "json",
     ...params,
   });
+
+/**
+ * @description 导出一次扫描的结果为 SARIF 2.1.0，供代码扫描平台导入
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningExportSarif
+ * @summary 导出扫描结果为 SARIF
+ * @request GET:/api/v1/security/scanning/sarif
+ * @response `200` `SarifLog` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningExportSarif = (
+  query: GetSecurityScanningExportSarifParams,
+  params: RequestParams = {},
+) =>
+  request<SarifLog>({
+    path: `/api/v1/security/scanning/sarif`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 导入外部扫描工具的 SARIF 2.1.0 结果，每个 run 创建一个扫描任务，相对路径按工作区根目录解析
+ *
+ * @tags Security Scanning
+ * @name PostSecurityScanningImportSarif
+ * @summary 导入 SARIF 扫描结果
+ * @request POST:/api/v1/security/scanning/sarif
+ * @response `200` `(WebResp & {
+    data?: DomainImportSARIFResp,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const postSecurityScanningImportSarif = (
+  query: PostSecurityScanningImportSarifParams,
+  param: SarifLog,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainImportSARIFResp;
+    }
+  >({
+    path: `/api/v1/security/scanning/sarif`,
+    method: "POST",
+    query: query,
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 导出工作区每种扫描最近一次成功的结果，每次扫描为一个 run
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningExportWorkspaceSarif
+ * @summary 导出工作区的扫描结果为 SARIF
+ * @request GET:/api/v1/security/scanning/workspace/sarif
+ * @response `200` `SarifLog` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningExportWorkspaceSarif = (
+  query: GetSecurityScanningExportWorkspaceSarifParams,
+  params: RequestParams = {},
+) =>
+  request<SarifLog>({
+    path: `/api/v1/security/scanning/workspace/sarif`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 修改发现的处置状态、处理人并添加评论，标记为误报或接受风险时之后扫描出的相同发现同样被屏蔽
+ *
+ * @tags Security Scanning
+ * @name PutSecurityScanningTriage
+ * @summary 处置扫描发现
+ * @request PUT:/api/v1/security/scanning/triage
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityScanningRiskDetail,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const putSecurityScanningTriage = (
+  param: DomainTriageSecurityScanningReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningRiskDetail;
+    }
+  >({
+    path: `/api/v1/security/scanning/triage`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取扫描发现的处置记录和评论
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningListComments
+ * @summary 获取扫描发现的评论
+ * @request GET:/api/v1/security/scanning/comments
+ * @response `200` `(WebResp & {
+    data?: (DomainSecurityScanningComment)[],
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningListComments = (
+  query: GetSecurityScanningListCommentsParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningComment[];
+    }
+  >({
+    path: `/api/v1/security/scanning/comments`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取屏蔽规则，指定工作区时包含对所有工作区生效的规则
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningListSuppressions
+ * @summary 获取屏蔽规则
+ * @request GET:/api/v1/security/scanning/suppressions
+ * @response `200` `(WebResp & {
+    data?: DomainListSecurityScanningSuppressionResp,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningListSuppressions = (
+  query: GetSecurityScanningListSuppressionsParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainListSecurityScanningSuppressionResp;
+    }
+  >({
+    path: `/api/v1/security/scanning/suppressions`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 按指纹或规则屏蔽扫描发现，已有的待处理发现同时被屏蔽
+ *
+ * @tags Security Scanning
+ * @name PostSecurityScanningCreateSuppression
+ * @summary 创建屏蔽规则
+ * @request POST:/api/v1/security/scanning/suppressions
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityScanningSuppression,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const postSecurityScanningCreateSuppression = (
+  param: DomainCreateSecurityScanningSuppressionReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningSuppression;
+    }
+  >({
+    path: `/api/v1/security/scanning/suppressions`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 删除屏蔽规则，被屏蔽的发现恢复为待处理
+ *
+ * @tags Security Scanning
+ * @name DeleteSecurityScanningDeleteSuppression
+ * @summary 删除屏蔽规则
+ * @request DELETE:/api/v1/security/scanning/suppressions
+ * @response `200` `WebResp` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const deleteSecurityScanningDeleteSuppression = (
+  query: DeleteSecurityScanningDeleteSuppressionParams,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/security/scanning/suppressions`,
+    method: "DELETE",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取自定义扫描规则包列表
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningListRulePacks
+ * @summary 规则包列表
+ * @request GET:/api/v1/security/scanning/rules
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityRulePack[],
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningListRulePacks = (
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityRulePack[];
+    }
+  >({
+    path: `/api/v1/security/scanning/rules`,
+    method: "GET",
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 上传 semgrep 格式的 YAML 规则创建规则包，内容作为第一个版本
+ *
+ * @tags Security Scanning
+ * @name PostSecurityScanningCreateRulePack
+ * @summary 创建规则包
+ * @request POST:/api/v1/security/scanning/rules
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityRulePack,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const postSecurityScanningCreateRulePack = (
+  param: DomainCreateSecurityRulePackReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityRulePack;
+    }
+  >({
+    path: `/api/v1/security/scanning/rules`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 修改规则包的描述、启用状态和扫描使用的版本
+ *
+ * @tags Security Scanning
+ * @name PutSecurityScanningUpdateRulePack
+ * @summary 更新规则包
+ * @request PUT:/api/v1/security/scanning/rules
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityRulePack,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const putSecurityScanningUpdateRulePack = (
+  param: DomainUpdateSecurityRulePackReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityRulePack;
+    }
+  >({
+    path: `/api/v1/security/scanning/rules`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 删除规则包及其所有版本，被扫描策略引用时不能删除
+ *
+ * @tags Security Scanning
+ * @name DeleteSecurityScanningDeleteRulePack
+ * @summary 删除规则包
+ * @request DELETE:/api/v1/security/scanning/rules
+ * @response `200` `WebResp` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const deleteSecurityScanningDeleteRulePack = (
+  query: DeleteSecurityScanningDeleteRulePackParams,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/security/scanning/rules`,
+    method: "DELETE",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取规则包的所有版本
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningListRulePackVersions
+ * @summary 规则包版本列表
+ * @request GET:/api/v1/security/scanning/rules/versions
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityRulePackVersion[],
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningListRulePackVersions = (
+  query: GetSecurityScanningListRulePackVersionsParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityRulePackVersion[];
+    }
+  >({
+    path: `/api/v1/security/scanning/rules/versions`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 上传规则包的新版本，可选择立即用于扫描
+ *
+ * @tags Security Scanning
+ * @name PostSecurityScanningUploadRulePackVersion
+ * @summary 上传规则包版本
+ * @request POST:/api/v1/security/scanning/rules/versions
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityRulePackVersion,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const postSecurityScanningUploadRulePackVersion = (
+  param: DomainUploadSecurityRulePackReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityRulePackVersion;
+    }
+  >({
+    path: `/api/v1/security/scanning/rules/versions`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取扫描策略列表
+ *
+ * @tags Security Scanning
+ * @name GetSecurityScanningListPolicies
+ * @summary 扫描策略列表
+ * @request GET:/api/v1/security/scanning/policies
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityScanningPolicy[],
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getSecurityScanningListPolicies = (
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningPolicy[];
+    }
+  >({
+    path: `/api/v1/security/scanning/policies`,
+    method: "GET",
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 创建扫描策略，扫描请求可以按名称引用
+ *
+ * @tags Security Scanning
+ * @name PostSecurityScanningCreatePolicy
+ * @summary 创建扫描策略
+ * @request POST:/api/v1/security/scanning/policies
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityScanningPolicy,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const postSecurityScanningCreatePolicy = (
+  param: DomainCreateSecurityScanningPolicyReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningPolicy;
+    }
+  >({
+    path: `/api/v1/security/scanning/policies`,
+    method: "POST",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 更新扫描策略
+ *
+ * @tags Security Scanning
+ * @name PutSecurityScanningUpdatePolicy
+ * @summary 更新扫描策略
+ * @request PUT:/api/v1/security/scanning/policies
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityScanningPolicy,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const putSecurityScanningUpdatePolicy = (
+  param: DomainUpdateSecurityScanningPolicyReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningPolicy;
+    }
+  >({
+    path: `/api/v1/security/scanning/policies`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 删除扫描策略
+ *
+ * @tags Security Scanning
+ * @name DeleteSecurityScanningDeletePolicy
+ * @summary 删除扫描策略
+ * @request DELETE:/api/v1/security/scanning/policies
+ * @response `200` `WebResp` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const deleteSecurityScanningDeletePolicy = (
+  query: DeleteSecurityScanningDeletePolicyParams,
+  params: RequestParams = {},
+) =>
+  request<WebResp>({
+    path: `/api/v1/security/scanning/policies`,
+    method: "DELETE",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
@@ -13,8 +13,11 @@
 import request, { ContentType, RequestParams } from "./httpClient";
 import {
   DomainListSecurityScanningResp,
+  DomainSecurityScanningComment,
   DomainSecurityScanningRiskDetail,
+  DomainTriageSecurityScanningReq,
   GetUserSecurityScanningDetailParams,
+  GetUserSecurityScanningListCommentsParams,
   GetUserSecurityScanningListParams,
   WebResp,
 } from "./types";
@@ -80,3 +83,65 @@ export const getUserSecurityScanningDetail = (
     format: "json",
     ...params,
   });
+
+/**
+ * @description 修改发现的处置状态、处理人并添加评论，标记为误报或接受风险时之后扫描出的相同发现同样被屏蔽
+ *
+ * @tags User Security Scanning
+ * @name PutUserSecurityScanningTriage
+ * @summary 处置用户扫描发现
+ * @request PUT:/api/v1/user/security/scanning/triage
+ * @response `200` `(WebResp & {
+    data?: DomainSecurityScanningRiskDetail,
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const putUserSecurityScanningTriage = (
+  param: DomainTriageSecurityScanningReq,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningRiskDetail;
+    }
+  >({
+    path: `/api/v1/user/security/scanning/triage`,
+    method: "PUT",
+    body: param,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
+
+/**
+ * @description 获取用户扫描发现的处置记录和评论
+ *
+ * @tags User Security Scanning
+ * @name GetUserSecurityScanningListComments
+ * @summary 获取用户扫描发现的评论
+ * @request GET:/api/v1/user/security/scanning/comments
+ * @response `200` `(WebResp & {
+    data?: (DomainSecurityScanningComment)[],
+
+})` OK
+ * @response `401` `string` Unauthorized
+ */
+
+export const getUserSecurityScanningListComments = (
+  query: GetUserSecurityScanningListCommentsParams,
+  params: RequestParams = {},
+) =>
+  request<
+    WebResp & {
+      data?: DomainSecurityScanningComment[];
+    }
+  >({
+    path: `/api/v1/user/security/scanning/comments`,
+    method: "GET",
+    query: query,
+    type: ContentType.Json,
+    format: "json",
+    ...params,
+  });
@@ -1,4 +1,5 @@
 export * from './Admin'
+export * from './Audit'
 export * from './Billing'
 export * from './Cli'
 export * from './CodeSnippet'
@@ -23,6 +23,12 @@ export enum GithubComChaitinMonkeyCodeBackendConstsModelStatus {
   ModelStatusInactive = "inactive",
 }
 
+export enum GithubComChaitinMonkeyCodeBackendConstsModelHealth {
+  ModelHealthUnknown = "unknown",
+  ModelHealthHealthy = "healthy",
+  ModelHealthUnhealthy = "unhealthy",
+}
+
 export enum GithubComChaitinMonkeyCodeBackendConstsModelProvider {
   ModelProviderSiliconFlow = "SiliconFlow",
   ModelProviderOpenAI = "OpenAI",
@@ -36,6 +42,7 @@ export enum GithubComChaitinMonkeyCodeBackendConstsModelProvider {
   ModelProviderVolcengine = "Volcengine",
   ModelProviderZhiPu = "ZhiPu",
   ModelProviderGemini = "Gemini",
+  ModelProviderAnthropic = "Anthropic",
   ModelProviderOther = "Other",
 }
 
@@ -80,6 +87,21 @@ export enum ConstsSecurityScanningStatus {
   SecurityScanningStatusRunning = "running",
   SecurityScanningStatusSuccess = "success",
   SecurityScanningStatusFailed = "failed",
+  SecurityScanningStatusSkipped = "skipped",
+}
+
+export enum ConstsSecurityScanningTriageStatus {
+  SecurityScanningTriageOpen = "open",
+  SecurityScanningTriageConfirmed = "confirmed",
+  SecurityScanningTriageFalsePositive = "false_positive",
+  SecurityScanningTriageAcceptedRisk = "accepted_risk",
+  SecurityScanningTriageFixed = "fixed",
+}
+
+export enum ConstsSecurityRuleLevel {
+  SecurityRuleLevelHigh = "HIGH",
+  SecurityRuleLevelMedium = "MEDIUM",
+  SecurityRuleLevelLow = "LOW",
 }
 
 export enum ConstsSecurityScanningRiskLevel {
@@ -88,6 +110,12 @@ export enum ConstsSecurityScanningRiskLevel {
   SecurityScanningRiskLevelSuggest = "suggest",
 }
 
+export enum ConstsSecurityScanningFindingState {
+  SecurityScanningFindingNew = "new",
+  SecurityScanningFindingPersisting = "persisting",
+  SecurityScanningFindingFixed = "fixed",
+}
+
 export enum ConstsSecurityScanningLanguage {
   SecurityScanningLanguageCpp = "C/C++",
   SecurityScanningLanguageJava = "Java",
@@ -114,6 +142,18 @@ export enum ConstsSecurityScanningLanguage {
   SecurityScanningLanguageLua = "Lua",
   SecurityScanningLanguageSecrets = "Secrets",
   SecurityScanningLanguageIaC = "IaC",
+  SecurityScanningLanguageAuto = "auto",
+}
+
+export enum ConstsDLPCategory {
+  DLPCategorySecrets = "Secrets",
+  DLPCategoryPII = "PII",
+}
+
+export enum ConstsDLPAction {
+  DLPActionAlert = "alert",
+  DLPActionRedact = "redact",
+  DLPActionBlock = "block",
 }
 
 export enum ConstsReportAction {
@@ -155,6 +195,13 @@ export enum ConstsAIEmployeePosition {
   AIEmployeePositionTester = "测试工程师",
 }
 
+export enum SarifLevel {
+  LevelError = "error",
+  LevelWarning = "warning",
+  LevelNote = "note",
+  LevelNone = "none",
+}
+
 export interface DomainAIEmployee {
   admin?: DomainAdminUser;
   created_at?: number;
@@ -214,6 +261,79 @@ export interface DomainAllModelResp {
   providers?: DomainProviderModel[];
 }
 
+export interface DomainAuditLog {
+  /** 回复 */
+  completion?: string;
+  /** 创建时间 */
+  created_at?: number;
+  /** 归档ID */
+  id?: string;
+  /** 模型ID */
+  model_id?: string;
+  /** 模型名称 */
+  model_name?: string;
+  /** 模型类型 */
+  model_type?: GithubComChaitinMonkeyCodeBackendConstsModelType;
+  /** 请求路径 */
+  path?: string;
+  /** 提示词 */
+  prompt?: string;
+  /** 压缩前的大小 */
+  raw_size?: number;
+  /** 请求ID */
+  request_id?: string;
+  /** 压缩后的大小 */
+  size?: number;
+  /** 任务ID */
+  task_id?: string;
+  /** 用户ID */
+  user_id?: string;
+}
+
+export interface DomainAuditLogDetail {
+  /** 回复 */
+  completion?: string;
+  /** 创建时间 */
+  created_at?: number;
+  /** 归档ID */
+  id?: string;
+  /** 模型ID */
+  model_id?: string;
+  /** 模型名称 */
+  model_name?: string;
+  /** 模型类型 */
+  model_type?: GithubComChaitinMonkeyCodeBackendConstsModelType;
+  /** 请求路径 */
+  path?: string;
+  /** 提示词 */
+  prompt?: string;
+  /** 压缩前的大小 */
+  raw_size?: number;
+  /** 请求头和请求体 */
+  request?: string;
+  /** 请求ID */
+  request_id?: string;
+  /** 响应头和响应体 */
+  response?: string;
+  /** 压缩后的大小 */
+  size?: number;
+  /** 任务ID */
+  task_id?: string;
+  /** 用户ID */
+  user_id?: string;
+}
+
+export interface DomainAuditRetentionSetting {
+  /** 用户组的保留策略 */
+  groups?: DomainGroupAuditRetention[];
+  /** 归档总大小上限，0 表示不限制 */
+  max_size_mb?: number;
+  /** 默认保留天数，0 表示不按时间清理 */
+  retention_day?: number;
+  /** 当前归档总大小 */
+  total_size?: number;
+}
+
 export interface DomainBatchCreateWorkspaceFileReq {
   /** 文件列表 */
   files: DomainCreateWorkspaceFileReq[];
@@ -246,6 +366,10 @@ export interface DomainChatContent {
   /** 内容 */
   content?: string;
   created_at?: number;
+  /** 生成代码的安全风险，只有 assistant 消息有 */
+  findings?: TypesCodeFinding[];
+  /** 完整的回复，包含推理过程、工具调用和所有 choice，只有 assistant 消息有 */
+  messages?: TypesChoiceMessage[];
   /** 角色，如user: 用户的提问 assistant: 机器人回复 system: 系统消息 */
   role?: ConstsChatRole;
 }
@@ -253,10 +377,14 @@ export interface DomainChatContent {
 export interface DomainChatInfo {
   /** 消息内容 */
   contents?: DomainChatContent[];
+  /** 外发数据防泄漏命中记录 */
+  dlp_hits?: DomainDLPHit[];
   id?: string;
 }
 
 export interface DomainChatRecord {
+  /** 输入中命中提示词缓存的token */
+  cached_tokens?: number;
   /** 创建时间 */
   created_at?: number;
   /** 记录ID */
@@ -267,14 +395,38 @@ export interface DomainChatRecord {
   model?: DomainModel;
   /** 输出token */
   output_tokens?: number;
+  /** 应用的改写策略版本，0 表示未改写 */
+  policy_version?: number;
   /** 问题 */
   question?: string;
+  /** 最近一次请求的结束状态 completed aborted upstream_error */
+  status?: string;
+  /** token 数的来源 reported: 上游返回 estimated: 估算 */
+  usage_source?: string;
   /** 用户 */
   user?: DomainUser;
   /** 工作模式 */
   work_mode?: string;
 }
 
+export interface DomainCodeFindingStat {
+  /** 风险数统计 */
+  findings?: {
+    /** 时间戳 */
+    timestamp?: number;
+    /** 值 */
+    value?: number;
+  }[];
+  /** 按规则统计，分类为规则名称 */
+  rules?: DomainCategoryPoint[];
+  /** 按严重程度统计 */
+  severity?: DomainCategoryPoint[];
+  /** 风险总数 */
+  total_findings?: number;
+  /** 存在风险的回复数 */
+  total_records?: number;
+}
+
 export interface DomainCodeSnippet {
   /** 结构化信息 */
   definition?: Record<string, any>;
@@ -366,6 +518,10 @@ export interface DomainCompletionData {
 export interface DomainCompletionInfo {
   content?: string;
   created_at?: number;
+  /** 外发数据防泄漏命中记录 */
+  dlp_hits?: DomainDLPHit[];
+  /** 生成代码的安全风险 */
+  findings?: TypesCodeFinding[];
   id?: string;
   prompt?: string;
 }
@@ -383,6 +539,8 @@ export interface DomainCompletionRecord {
   output_tokens?: number;
   /** 编程语言 */
   program_language?: string;
+  /** token 数的来源 reported: 上游返回 estimated: 估算 */
+  usage_source?: string;
   /** 用户 */
   user?: DomainUser;
 }
@@ -412,6 +570,21 @@ export interface DomainCreateAdminReq {
   username: string;
 }
 
+export interface DomainCreateTransformRuleReq {
+  /** 改写配置 */
+  config: TypesTransformConfig;
+  /** 是否启用 */
+  enabled?: boolean;
+  /** 用户组ID，为空表示所有用户 */
+  group_id?: string;
+  /** 模型ID，为空表示所有模型 */
+  model_id?: string;
+  /** 规则名称 */
+  name: string;
+  /** 优先级，数值越大越先应用 */
+  priority?: number;
+}
+
 export interface DomainCreateModelReq {
   /** 接口地址 如：https://api.qwen.com */
   api_base: string;
@@ -425,6 +598,8 @@ export interface DomainCreateModelReq {
   model_type?: GithubComChaitinMonkeyCodeBackendConstsModelType;
   /** 高级参数 */
   param?: DomainModelParam;
+  /** 模型价格 */
+  pricing?: DomainModelPricing;
   /** 提供商 */
   provider:
     | "SiliconFlow"
@@ -439,19 +614,63 @@ export interface DomainCreateModelReq {
     | "Volcengine"
     | "ZhiPu"
     | "Gemini"
+    | "Anthropic"
     | "Other";
   /** 模型显示名称 */
   show_name?: string;
 }
 
+export interface DomainCreateSecurityRulePackReq {
+  /** semgrep 格式的 YAML 规则 */
+  content: string;
+  /** 描述 */
+  description?: string;
+  /** 规则包名称，只允许字母、数字和 _.- */
+  name: string;
+}
+
+export interface DomainCreateSecurityScanningPolicyReq {
+  /** 描述 */
+  description?: string;
+  /** 内置语言规则，为空时由扫描请求指定 */
+  language?: ConstsSecurityScanningLanguage;
+  /** 报告的最低置信度，默认 MEDIUM */
+  min_confidence?: ConstsSecurityRuleLevel;
+  /** 报告的最低影响，默认 MEDIUM */
+  min_impact?: ConstsSecurityRuleLevel;
+  /** 策略名称，扫描请求中引用 */
+  name: string;
+  /** 自定义规则包id */
+  rule_pack_ids?: string[];
+}
+
 export interface DomainCreateSecurityScanningReq {
-  /** 扫描语言 */
+  /** 是否强制全量扫描，默认只扫描上一次成功扫描后变化的文件 */
+  full?: boolean;
+  /** 扫描语言，为 auto 时按工作区文件识别语言，为空时使用扫描策略的语言 */
   language?: ConstsSecurityScanningLanguage;
+  /** 扫描策略名称 */
+  policy?: string;
   user_id?: string;
   /** 项目目录 */
   workspace?: string;
 }
 
+export interface DomainCreateSecurityScanningSuppressionReq {
+  /** 规则，指纹为空时按规则屏蔽 */
+  check_id?: string;
+  /** 到期时间，为 0 表示永久 */
+  expires_at?: number;
+  /** 发现指纹 */
+  fingerprint?: string;
+  /** 原因 */
+  reason?: string;
+  /** 误报或接受风险 */
+  status: ConstsSecurityScanningTriageStatus;
+  /** 工作区id，为空表示所有工作区 */
+  workspace_id?: string;
+}
+
 export interface DomainCreateUserGroupReq {
   /** 组名称 */
   name: string;
@@ -524,6 +743,46 @@ export interface DomainCustomOAuthReq {
   userinfo_url?: string;
 }
 
+export interface DomainDeadRecord {
+  /** 写入次数 */
+  attempts?: number;
+  /** 转入死信的时间 */
+  dead_at?: number;
+  /** 最后一次写入的错误 */
+  error?: string;
+  /** 死信ID */
+  id?: string;
+  /** 模型ID */
+  model_id?: string;
+  /** 模型类型 */
+  model_type?: GithubComChaitinMonkeyCodeBackendConstsModelType;
+  /** 请求ID */
+  request_id?: string;
+  /** 原消息ID */
+  source_id?: string;
+  /** 任务ID */
+  task_id?: string;
+  /** 用户ID */
+  user_id?: string;
+}
+
+export interface DomainDLPHit {
+  /** 处理方式 alert:只记录 redact:脱敏 block:拒绝 */
+  action?: ConstsDLPAction;
+  /** 规则类别 Secrets:密钥 PII:个人信息 */
+  category?: ConstsDLPCategory;
+  /** 命中次数 */
+  count?: number;
+  /** 命中时间 */
+  created_at?: number;
+  /** 脱敏后的命中内容 */
+  preview?: string;
+  /** 规则ID */
+  rule_id?: string;
+  /** 规则名称 */
+  rule_name?: string;
+}
+
 export interface DomainDingtalkOAuth {
   /** 钉钉客户端ID */
   client_id?: string;
@@ -560,6 +819,13 @@ export interface DomainFileMeta {
   language?: DomainCodeLanguageType;
 }
 
+export interface DomainExportAuditReq {
+  /** 归档ID列表 */
+  ids?: string[];
+  /** 任务ID列表，导出任务下的所有归档 */
+  task_ids?: string[];
+}
+
 export interface DomainGetAndSaveReq {
   /** 代码文件信息 */
   code_files: DomainFileMeta[];
@@ -573,6 +839,32 @@ export interface DomainGetProviderModelListResp {
   models?: DomainProviderModelListItem[];
 }
 
+export interface DomainGroupAuditRetention {
+  /** 用户组ID */
+  group_id?: string;
+  /** 用户组名称 */
+  group_name?: string;
+  /** 组内用户归档的总大小上限，0 表示不限制 */
+  max_size_mb?: number;
+  /** 保留天数，0 表示使用默认配置 */
+  retention_day?: number;
+}
+
+export interface DomainGroupBudget {
+  /** 货币，为空时使用默认货币 */
+  currency?: string;
+  /** 用户组ID */
+  group_id?: string;
+  /** 用户组名称 */
+  group_name?: string;
+  /** 月度预算 */
+  monthly?: number;
+  /** 本月费用 */
+  spend?: number;
+  /** 告警阈值，为预算的百分比，为空时使用默认阈值 */
+  thresholds?: number[];
+}
+
 export interface DomainGrantGroupReq {
   /** 管理员ID列表 */
   admin_ids: string[];
@@ -623,6 +915,11 @@ export interface DomainIPInfo {
   province?: string;
 }
 
+export interface DomainImportSARIFResp {
+  /** 每个 run 创建的扫描任务id */
+  ids?: string[];
+}
+
 export interface DomainIndexResult {
   definition?: {
     name?: string;
@@ -676,6 +973,20 @@ export interface DomainListAdminUserResp {
   users?: DomainAdminUser[];
 }
 
+export interface DomainListAuditLogResp {
+  has_next_page?: boolean;
+  logs?: DomainAuditLog[];
+  next_token?: string;
+  total_count?: number;
+}
+
+export interface DomainListBudgetAlertResp {
+  alerts?: DomainBudgetAlert[];
+  has_next_page?: boolean;
+  next_token?: string;
+  total_count?: number;
+}
+
 export interface DomainListChatRecordResp {
   has_next_page?: boolean;
   next_token?: string;
@@ -739,6 +1050,26 @@ export interface DomainListSecurityScanningResp {
   total_count?: number;
 }
 
+export interface DomainListSecurityScanningSuppressionResp {
+  has_next_page?: boolean;
+  items?: DomainSecurityScanningSuppression[];
+  next_token?: string;
+  total_count?: number;
+}
+
+export interface DomainListTransformPolicyResp {
+  has_next_page?: boolean;
+  next_token?: string;
+  policies?: DomainTransformPolicy[];
+  total_count?: number;
+}
+
+export interface DomainListTransformRuleResp {
+  rules?: DomainTransformRule[];
+  /** 当前策略版本 */
+  version?: number;
+}
+
 export interface DomainListUserGroupResp {
   groups?: DomainUserGroup[];
   has_next_page?: boolean;
@@ -782,6 +1113,8 @@ export interface DomainLoginResp {
 }
 
 export interface DomainModel {
+  /** 模型别名 */
+  aliases?: string[];
   /** 接口地址 如：https://api.qwen.com */
   api_base?: string;
   /** 接口头 如：Authorization: Bearer sk-xxxx */
@@ -790,8 +1123,12 @@ export interface DomainModel {
   api_key?: string;
   /** 接口版本 如：2023-05-15 */
   api_version?: string;
+  /** 熔断状态 closed:正常 open:熔断 half_open:半开 */
+  breaker?: string;
   /** 创建时间 */
   created_at?: number;
+  /** 健康状态 */
+  health?: DomainModelHealth;
   /** 模型ID */
   id?: string;
   /** 输入token数 */
@@ -808,6 +1145,8 @@ export interface DomainModel {
   output?: number;
   /** 高级参数 */
   param?: DomainModelParam;
+  /** 模型价格 */
+  pricing?: DomainModelPricing;
   /** 提供商 */
   provider?: GithubComChaitinMonkeyCodeBackendConstsModelProvider;
   /** 模型显示名称 */
@@ -816,6 +1155,63 @@ export interface DomainModel {
   status?: GithubComChaitinMonkeyCodeBackendConstsModelStatus;
   /** 更新时间 */
   updated_at?: number;
+  /** 负载均衡权重 */
+  weight?: number;
+}
+
+export interface DomainModelCacheStat {
+  /** 命中提示词缓存的输入token数 */
+  cached_tokens?: number;
+  /** 命中率，百分比 */
+  hit_ratio?: number;
+  /** 输入token数 */
+  input_tokens?: number;
+  /** 模型ID */
+  model_id?: string;
+  /** 模型名称 */
+  model_name?: string;
+}
+
+export interface DomainModelPricing {
+  /** 命中上游提示词缓存的输入价格，每百万 token，0 表示按输入价格计费 */
+  cached_input?: number;
+  /** 货币，ISO 4217 代码，为空时使用默认货币 */
+  currency?: string;
+  /** 输入价格，每百万 token */
+  input?: number;
+  /** 输出价格，每百万 token */
+  output?: number;
+}
+
+export interface DomainBudgetAlert {
+  /** 当月预算 */
+  budget?: number;
+  created_at?: number;
+  /** 货币 */
+  currency?: string;
+  /** 用户组ID */
+  group_id?: string;
+  /** 用户组名称 */
+  group_name?: string;
+  id?: string;
+  /** 告警月份，如 2025-01 */
+  month?: string;
+  /** 触发时的当月费用 */
+  spend?: number;
+  /** 触发的阈值 */
+  threshold?: number;
+}
+
+export interface DomainBillingPlan {
+  created_at?: number;
+  /** 套餐描述 */
+  description?: string;
+  id?: string;
+  /** 套餐名称 */
+  name?: string;
+  /** 套餐授予的 token 数 */
+  quota?: number;
+  updated_at?: number;
 }
 
 export interface DomainModelBasic {
@@ -837,6 +1233,7 @@ export interface DomainModelBasic {
     | "Volcengine"
     | "ZhiPu"
     | "Gemini"
+    | "Anthropic"
     | "Other";
 }
 
@@ -852,15 +1249,52 @@ export interface DomainModelData {
   type?: string;
 }
 
+export interface DomainModelHealth {
+  /** 近24小时探测失败率，百分比 */
+  error_rate?: number;
+  /** 最近一次探测时间 */
+  last_check_at?: number;
+  /** 最近一次探测成功时间 */
+  last_success_at?: number;
+  /** 近24小时平均探测耗时，毫秒 */
+  latency?: number;
+  /** 健康状态 unknown:未知 healthy:健康 unhealthy:不健康 */
+  status?: GithubComChaitinMonkeyCodeBackendConstsModelHealth;
+}
+
 export interface DomainModelParam {
   context_window?: number;
   max_tokens?: number;
   r1_enabled?: boolean;
+  /** 是否缓存响应 */
+  response_cache?: boolean;
+  /** 响应缓存有效秒数，0 表示使用默认配置 */
+  response_cache_ttl?: number;
   support_computer_use?: boolean;
   support_images?: boolean;
   support_prompt_cache?: boolean;
 }
 
+export interface DomainModelUptime {
+  /** 平均探测耗时，毫秒 */
+  latency?: number;
+  /** 成功次数 */
+  success?: number;
+  /** 时间戳 */
+  timestamp?: number;
+  /** 探测次数 */
+  total?: number;
+  /** 可用率，百分比 */
+  uptime?: number;
+}
+
+export interface DomainModelUptimeResp {
+  /** 按精度聚合的可用率 */
+  points?: DomainModelUptime[];
+  /** 统计区间内的可用率，百分比 */
+  uptime?: number;
+}
+
 export interface DomainModelTokenUsage {
   /** 时间戳 */
   timestamp?: number;
@@ -905,6 +1339,29 @@ export interface DomainProviderModelListItem {
   model?: string;
 }
 
+export interface DomainRecordQueueStat {
+  /** 消费者数 */
+  consumers?: number;
+  /** 死信数 */
+  dead?: number;
+  /** 累计转入死信数 */
+  dead_lettered?: number;
+  /** 累计写入失败次数，包括重试 */
+  failed?: number;
+  /** 尚未开始写入的记录数 */
+  lag?: number;
+  /** 队列中的记录数 */
+  length?: number;
+  /** 正在写入或等待重试的记录数 */
+  pending?: number;
+  /** 累计写入成功数 */
+  processed?: number;
+  /** 累计入队数 */
+  published?: number;
+  /** 当前实例入队失败后直接写入的次数 */
+  publish_failed?: number;
+}
+
 export interface DomainRegisterReq {
   /** 邀请码 */
   code: string;
@@ -934,12 +1391,53 @@ export interface DomainReportReq {
   user_input?: string;
 }
 
+export interface DomainRetryDeadRecordReq {
+  /** 死信ID */
+  id: string;
+}
+
 export interface DomainRole {
   description?: string;
   id?: number;
   name?: string;
 }
 
+export interface DomainSecurityRulePack {
+  /** 创建时间 */
+  created_at?: number;
+  /** 创建人 */
+  creator?: string;
+  /** 描述 */
+  description?: string;
+  /** 是否启用 */
+  enabled?: boolean;
+  id?: string;
+  /** 最新上传的版本 */
+  latest_version?: number;
+  /** 规则包名称 */
+  name?: string;
+  /** 更新时间 */
+  updated_at?: number;
+  /** 扫描使用的版本 */
+  version?: number;
+}
+
+export interface DomainSecurityRulePackVersion {
+  /** 内容的 sha256 */
+  checksum?: string;
+  /** semgrep 格式的 YAML 规则 */
+  content?: string;
+  /** 上传时间 */
+  created_at?: number;
+  /** 上传人 */
+  creator?: string;
+  id?: string;
+  /** 包含的规则id */
+  rule_ids?: string[];
+  /** 版本 */
+  version?: number;
+}
+
 export interface DomainSecurityScanningBrief {
   /** 创建时间 */
   created_at?: number;
@@ -949,10 +1447,44 @@ export interface DomainSecurityScanningBrief {
   report_url?: string;
   /** 扫描状态 */
   status?: ConstsSecurityScanningStatus;
+  /** 自动识别语言时每个规则集的子扫描进度 */
+  sub_scans?: TypesSecurityScanningSubScan[];
   /** 项目目录 */
   workspace?: string;
 }
 
+export interface DomainSecurityScanningComment {
+  /** 评论人 */
+  author?: string;
+  /** 评论内容 */
+  content?: string;
+  /** 创建时间 */
+  created_at?: number;
+  id?: string;
+  /** 修改后的处置状态，为空表示未修改 */
+  triage_status?: ConstsSecurityScanningTriageStatus;
+}
+
+export interface DomainSecurityScanningPolicy {
+  /** 创建时间 */
+  created_at?: number;
+  /** 描述 */
+  description?: string;
+  id?: string;
+  /** 内置语言规则 */
+  language?: ConstsSecurityScanningLanguage;
+  /** 报告的最低置信度 */
+  min_confidence?: ConstsSecurityRuleLevel;
+  /** 报告的最低影响 */
+  min_impact?: ConstsSecurityRuleLevel;
+  /** 策略名称 */
+  name?: string;
+  /** 自定义规则包id */
+  rule_pack_ids?: string[];
+  /** 更新时间 */
+  updated_at?: number;
+}
+
 export interface DomainSecurityScanningResult {
   /** 扫描开始时间 */
   created_at?: number;
@@ -960,21 +1492,37 @@ export interface DomainSecurityScanningResult {
   error?: string;
   /** 扫描任务id */
   id?: string;
+  /** 是否为增量扫描 */
+  incremental?: boolean;
   /** 扫描任务 */
   name?: string;
   /** 项目路径 */
   path?: string;
+  /** 扫描策略名称 */
+  policy?: string;
   /** 项目名称 */
   project_name?: string;
   /** 风险结果 */
   risk?: DomainSecurityScanningRiskResult;
+  /** 本次实际扫描的文件数 */
+  scanned_files?: number;
   /** 扫描状态 */
   status?: ConstsSecurityScanningStatus;
+  /** 自动识别语言时每个规则集的子扫描进度 */
+  sub_scans?: TypesSecurityScanningSubScan[];
+  /** 导入的外部扫描工具名称，为空表示内置扫描 */
+  tool?: string;
   /** 用户 */
   user?: DomainUser;
+  /** 工作区id */
+  workspace_id?: string;
 }
 
 export interface DomainSecurityScanningRiskDetail {
+  /** 处理人 */
+  assignee_id?: string;
+  /** 规则 */
+  check_id?: string;
   /** 代码内容 */
   content?: string;
   /** 风险描述 */
@@ -983,6 +1531,8 @@ export interface DomainSecurityScanningRiskDetail {
   end?: TypesPosition;
   /** 风险文件名 */
   filename?: string;
+  /** 发现指纹 */
+  fingerprint?: string;
   /** 修复建议 */
   fix?: string;
   /** 风险id */
@@ -991,23 +1541,71 @@ export interface DomainSecurityScanningRiskDetail {
   level?: ConstsSecurityScanningRiskLevel;
   /** 风险代码行 */
   lines?: string;
+  /** 接受风险的到期时间 */
+  risk_expires_at?: number;
   /** 风险代码行开始位置 */
   start?: TypesPosition;
+  /** 相对上一次扫描的状态 */
+  state?: ConstsSecurityScanningFindingState;
+  /** 处置状态 */
+  triage_status?: ConstsSecurityScanningTriageStatus;
 }
 
 export interface DomainSecurityScanningRiskResult {
   /** 高危数 */
   critical_count?: number;
+  /** 本次修复数 */
+  fixed_count?: number;
   id?: string;
+  /** 本次新增数 */
+  new_count?: number;
   /** 严重数 */
   severe_count?: number;
   /** 建议数 */
   suggest_count?: number;
+  /** 已屏蔽数，不计入以上风险数 */
+  suppress_count?: number;
+}
+
+export interface DomainSecurityScanningSuppression {
+  /** 规则 */
+  check_id?: string;
+  /** 创建时间 */
+  created_at?: number;
+  /** 创建人 */
+  creator?: string;
+  /** 到期时间，为 0 表示永久 */
+  expires_at?: number;
+  /** 发现指纹 */
+  fingerprint?: string;
+  id?: string;
+  /** 原因 */
+  reason?: string;
+  /** 误报或接受风险 */
+  status?: ConstsSecurityScanningTriageStatus;
+  /** 工作区id，为空表示所有工作区 */
+  workspace_id?: string;
+}
+
+export interface DomainRateLimit {
+  /** 每分钟请求数，0 表示不限制 */
+  rpm?: number;
+  /** 每分钟 token 数，0 表示不限制 */
+  tpm?: number;
+}
+
+export interface DomainRateLimitSetting {
+  /** 单个 API Key 的限制 */
+  api_key?: DomainRateLimit;
+  /** 单个用户的限制 */
+  user?: DomainRateLimit;
 }
 
 export interface DomainSetting {
   /** base url 配置，为了支持前置代理 */
   base_url?: string;
+  /** OpenAI 接口限流配置 */
+  rate_limit?: DomainRateLimitSetting;
   /** 创建时间 */
   created_at?: number;
   /** 自定义OAuth接入 */
@@ -1026,6 +1624,35 @@ export interface DomainSetting {
   updated_at?: number;
 }
 
+export interface DomainSpendPoint {
+  /** 用户、用户组或模型ID */
+  id?: string;
+  /** 名称 */
+  name?: string;
+  /** 费用 */
+  value?: number;
+}
+
+export interface DomainSpendStat {
+  /** 货币 */
+  currency?: string;
+  /** 按天统计 */
+  daily?: {
+    /** 时间戳 */
+    timestamp?: number;
+    /** 值 */
+    value?: number;
+  }[];
+  /** 按用户组统计，用户属于多个组时分别计入 */
+  groups?: DomainSpendPoint[];
+  /** 按模型统计 */
+  models?: DomainSpendPoint[];
+  /** 总费用 */
+  total?: number;
+  /** 按用户统计 */
+  users?: DomainSpendPoint[];
+}
+
 export interface DomainStatistics {
   /** 禁用用户数 */
   disabled_users?: number;
@@ -1061,6 +1688,13 @@ export interface DomainTimeStat {
     /** 值 */
     value?: number;
   }[];
+  /** 命中缓存节省的token数统计 */
+  cached_tokens?: {
+    /** 时间戳 */
+    timestamp?: number;
+    /** 值 */
+    value?: number;
+  }[];
   /** 活跃用户数统计 */
   active_users?: {
     /** 时间戳 */
@@ -1089,6 +1723,8 @@ export interface DomainTimeStat {
     /** 值 */
     value?: number;
   }[];
+  /** 各模型的提示词缓存命中率 */
+  prompt_cache?: DomainModelCacheStat[];
   /** 实时token数统计 */
   real_time_tokens?: {
     /** 时间戳 */
@@ -1096,8 +1732,12 @@ export interface DomainTimeStat {
     /** 值 */
     value?: number;
   }[];
+  /** 按货币统计的费用 */
+  spend?: DomainSpendStat[];
   /** 近90天平均接受率 */
   total_accepted_per?: number;
+  /** 近90天命中缓存节省的token数 */
+  total_cached_tokens?: number;
   /** 近90天对话任务数 */
   total_chats?: number;
   /** 近90天补全任务数 */
@@ -1108,6 +1748,50 @@ export interface DomainTimeStat {
   total_users?: number;
 }
 
+export interface DomainTransformPolicy {
+  /** 修改人 */
+  admin_id?: string;
+  /** 创建时间 */
+  created_at?: number;
+  /** 该版本的全部规则 */
+  rules?: TypesTransformRule[];
+  /** 策略版本 */
+  version?: number;
+}
+
+export interface DomainTransformRule {
+  /** 改写配置 */
+  config?: TypesTransformConfig;
+  /** 创建时间 */
+  created_at?: number;
+  /** 是否启用 */
+  enabled?: boolean;
+  /** 用户组ID，为空表示所有用户 */
+  group_id?: string;
+  id?: string;
+  /** 模型ID，为空表示所有模型 */
+  model_id?: string;
+  /** 规则名称 */
+  name?: string;
+  /** 优先级，数值越大越先应用 */
+  priority?: number;
+  /** 更新时间 */
+  updated_at?: number;
+}
+
+export interface DomainTriageSecurityScanningReq {
+  /** 处理人，为空字符串时取消指派，不传时不修改 */
+  assignee_id?: string;
+  /** 评论 */
+  comment?: string;
+  /** 接受风险的到期时间，为 0 表示永久 */
+  expires_at?: number;
+  /** 发现id */
+  id: string;
+  /** 处置状态，为空时不修改 */
+  status?: ConstsSecurityScanningTriageStatus;
+}
+
 export interface DomainUUIDReq {
   id?: string;
 }
@@ -1129,6 +1813,26 @@ export interface DomainUpdateAIEmployeeReq {
   token?: string;
 }
 
+export interface DomainUpdateGroupAuditRetentionReq {
+  /** 用户组ID */
+  id: string;
+  /** 组内用户归档的总大小上限，0 表示不限制 */
+  max_size_mb?: number;
+  /** 保留天数，0 表示使用默认配置 */
+  retention_day?: number;
+}
+
+export interface DomainUpdateGroupBudgetReq {
+  /** 货币，为空时使用默认货币 */
+  currency?: string;
+  /** 用户组ID */
+  id: string;
+  /** 月度预算，0 表示取消预算 */
+  monthly?: number;
+  /** 告警阈值，为预算的百分比，为空时使用默认阈值 */
+  thresholds?: number[];
+}
+
 export interface DomainUpdateModelReq {
   /** 接口地址 如：https://api.qwen.com */
   api_base?: string;
@@ -1142,6 +1846,8 @@ export interface DomainUpdateModelReq {
   model_name?: string;
   /** 高级参数 */
   param?: DomainModelParam;
+  /** 模型价格 */
+  pricing?: DomainModelPricing;
   /** 提供商 */
   provider:
     | "SiliconFlow"
@@ -1156,6 +1862,7 @@ export interface DomainUpdateModelReq {
     | "Volcengine"
     | "ZhiPu"
     | "Gemini"
+    | "Anthropic"
     | "Other";
   /** 模型显示名称 */
   show_name?: string;
@@ -1163,9 +1870,54 @@ export interface DomainUpdateModelReq {
   status?: GithubComChaitinMonkeyCodeBackendConstsModelStatus;
 }
 
+export interface DomainUpdateTransformRuleReq {
+  /** 改写配置 */
+  config?: TypesTransformConfig;
+  /** 是否启用 */
+  enabled?: boolean;
+  /** 用户组ID，为空字符串表示所有用户 */
+  group_id?: string;
+  /** 规则ID */
+  id: string;
+  /** 模型ID，为空字符串表示所有模型 */
+  model_id?: string;
+  /** 规则名称 */
+  name?: string;
+  /** 优先级 */
+  priority?: number;
+}
+
+export interface DomainUpdateSecurityRulePackReq {
+  /** 描述，不传时不修改 */
+  description?: string;
+  /** 是否启用，不传时不修改 */
+  enabled?: boolean;
+  /** 规则包id */
+  id: string;
+  /** 扫描使用的版本，可回退到历史版本，不传时不修改 */
+  version?: number;
+}
+
+export interface DomainUpdateSecurityScanningPolicyReq {
+  /** 描述，不传时不修改 */
+  description?: string;
+  /** 策略id */
+  id: string;
+  /** 内置语言规则，不传时不修改 */
+  language?: ConstsSecurityScanningLanguage;
+  /** 报告的最低置信度，为空时不修改 */
+  min_confidence?: ConstsSecurityRuleLevel;
+  /** 报告的最低影响，为空时不修改 */
+  min_impact?: ConstsSecurityRuleLevel;
+  /** 自定义规则包id，不传时不修改 */
+  rule_pack_ids?: string[];
+}
+
 export interface DomainUpdateSettingReq {
   /** base url 配置，为了支持前置代理 */
   base_url?: string;
+  /** OpenAI 接口限流配置 */
+  rate_limit?: DomainRateLimitSetting;
   /** 自定义OAuth配置 */
   custom_oauth?: DomainCustomOAuthReq;
   /** 钉钉OAuth配置 */
@@ -1180,6 +1932,15 @@ export interface DomainUpdateSettingReq {
   force_two_factor_auth?: boolean;
 }
 
+export interface DomainUploadSecurityRulePackReq {
+  /** 是否立即用于扫描 */
+  activate?: boolean;
+  /** semgrep 格式的 YAML 规则 */
+  content: string;
+  /** 规则包id */
+  id: string;
+}
+
 export interface DomainUpdateUserGroupReq {
   /** 分组id */
   id: string;
@@ -1313,6 +2074,10 @@ export interface DomainUserStat {
   }[];
   /** 编程语言占比 */
   program_language?: DomainCategoryPoint[];
+  /** 各模型的提示词缓存命中率 */
+  prompt_cache?: DomainModelCacheStat[];
+  /** 按货币统计的费用，只包含按天和按模型统计 */
+  spend?: DomainSpendStat[];
   /** 总接受率 */
   total_accepted_per?: number;
   /** 总对话任务数 */
@@ -1367,12 +2132,185 @@ export interface GithubComChaitinMonkeyCodeBackendDomainModelListResp {
   object?: string;
 }
 
+export interface SarifArtifactContent {
+  text?: string;
+}
+
+export interface SarifArtifactLocation {
+  uri?: string;
+  uriBaseId?: string;
+}
+
+export interface SarifAutomationDetails {
+  id?: string;
+}
+
+export interface SarifConfiguration {
+  level?: SarifLevel;
+}
+
+export interface SarifDriver {
+  informationUri?: string;
+  name?: string;
+  rules?: SarifRule[];
+  version?: string;
+}
+
+export interface SarifLocation {
+  physicalLocation?: SarifPhysicalLocation;
+}
+
+export interface SarifLog {
+  $schema?: string;
+  runs?: SarifRun[];
+  version?: string;
+}
+
+export interface SarifMessage {
+  markdown?: string;
+  text?: string;
+}
+
+export interface SarifPhysicalLocation {
+  artifactLocation?: SarifArtifactLocation;
+  region?: SarifRegion;
+}
+
+export interface SarifRegion {
+  charLength?: number;
+  charOffset?: number;
+  endColumn?: number;
+  endLine?: number;
+  snippet?: SarifArtifactContent;
+  startColumn?: number;
+  startLine?: number;
+}
+
+export interface SarifResult {
+  level?: SarifLevel;
+  locations?: SarifLocation[];
+  message?: SarifMessage;
+  partialFingerprints?: Record<string, string>;
+  properties?: Record<string, any>;
+  ruleId?: string;
+  ruleIndex?: number;
+}
+
+export interface SarifRule {
+  defaultConfiguration?: SarifConfiguration;
+  fullDescription?: SarifMessage;
+  help?: SarifMessage;
+  id?: string;
+  name?: string;
+  properties?: Record<string, any>;
+  shortDescription?: SarifMessage;
+}
+
+export interface SarifRun {
+  automationDetails?: SarifAutomationDetails;
+  originalUriBaseIds?: Record<string, SarifArtifactLocation>;
+  properties?: Record<string, any>;
+  results?: SarifResult[];
+  tool?: SarifTool;
+}
+
+export interface SarifTool {
+  driver?: SarifDriver;
+}
+
+export interface TypesChoiceMessage {
+  /** 回复内容 */
+  content?: string;
+  /** 结束原因 */
+  finish_reason?: string;
+  /** choice 下标 */
+  index?: number;
+  /** 推理过程，如 R1 的 reasoning_content */
+  reasoning?: string;
+  /** 工具调用 */
+  tool_calls?: TypesToolCall[];
+}
+
+export interface TypesCodeFinding {
+  /** 风险类别 */
+  category?: string;
+  /** 规则ID */
+  check_id?: string;
+  /** 置信度 */
+  confidence?: string;
+  /** 结束位置 */
+  end?: TypesPosition;
+  /** 影响程度 */
+  impact?: string;
+  /** 风险代码行 */
+  lines?: string;
+  /** 修复建议 */
+  message?: string;
+  /** 严重程度 ERROR WARNING INFO */
+  severity?: string;
+  /** 开始位置，相对整个回复 */
+  start?: TypesPosition;
+  /** 风险名称 */
+  title?: string;
+}
+
 export interface TypesPosition {
   col?: number;
   line?: number;
   offset?: number;
 }
 
+export interface TypesSecurityScanningSubScan {
+  /** 错误信息 */
+  error?: string;
+  /** 识别为该语言的文件数 */
+  files?: number;
+  /** 扫描语言，为空表示扫描策略的自定义规则包 */
+  language?: ConstsSecurityScanningLanguage;
+  /** 本次扫描出的结果数 */
+  results?: number;
+  /** 子扫描状态 */
+  status?: ConstsSecurityScanningStatus;
+}
+
+export interface TypesToolCall {
+  /** 调用参数，JSON 格式 */
+  arguments?: string;
+  /** 调用ID */
+  id?: string;
+  /** 工具名称 */
+  name?: string;
+}
+
+export interface TypesTransformConfig {
+  /** 请求未指定时使用的 max_tokens */
+  default_max_tokens?: number;
+  /** 请求未指定时使用的 temperature */
+  default_temperature?: number;
+  /** temperature 上限 */
+  max_temperature?: number;
+  /** max_tokens 上限 */
+  max_tokens_limit?: number;
+  /** 需要删除的请求参数 */
+  strip_params?: string[];
+  /** 追加到最后面的系统提示词 */
+  system_append?: string;
+  /** 插入到最前面的系统提示词 */
+  system_prepend?: string;
+}
+
+export interface TypesTransformRule {
+  config?: TypesTransformConfig;
+  enabled?: boolean;
+  /** 为空表示所有用户 */
+  group_id?: string;
+  id?: string;
+  /** 为空表示所有模型 */
+  model_id?: string;
+  name?: string;
+  priority?: number;
+}
+
 export interface V1GetContextReq {
   /** 返回结果数量限制，默认10 */
   limit?: number;
@@ -1490,6 +2428,42 @@ export interface GetAiemployeeInfoParams {
   id?: string;
 }
 
+export interface GetSearchAuditLogParams {
+  /** 结束时间 */
+  end?: number;
+  /** 在提示词和回复中全文检索 */
+  keyword?: string;
+  /** 模型ID */
+  model_id?: string;
+  /** 模型类型 */
+  model_type?: GithubComChaitinMonkeyCodeBackendConstsModelType;
+  /** 下一页标识 */
+  next_token?: string;
+  /**
+   * 分页
+   * @min 1
+   * @default 1
+   */
+  page?: number;
+  /**
+   * 每页多少条记录
+   * @min 1
+   * @default 10
+   */
+  size?: number;
+  /** 开始时间 */
+  start?: number;
+  /** 任务ID */
+  task_id?: string;
+  /** 用户ID */
+  user_id?: string;
+}
+
+export interface GetAuditLogInfoParams {
+  /** 归档ID */
+  id: string;
+}
+
 export interface GetChatInfoParams {
   /** 对话记录ID */
   id: string;
@@ -1560,6 +2534,47 @@ export interface V1CliCreateParams {
   command: string;
 }
 
+export interface GetListBudgetAlertParams {
+  /** 用户组ID */
+  group_id?: string;
+  /** 下一页标识 */
+  next_token?: string;
+  /**
+   * 分页
+   * @min 1
+   * @default 1
+   */
+  page?: number;
+  /**
+   * 每页多少条记录
+   * @min 1
+   * @default 10
+   */
+  size?: number;
+}
+
+export interface GetListDeadRecordParams {
+  /** 返回条数，默认 100 */
+  limit?: number;
+}
+
+export interface GetCodeFindingStatDashboardParams {
+  /**
+   * 持续时间 (小时或天数)`
+   * @min 24
+   * @max 90
+   * @default 90
+   */
+  duration?: number;
+  /**
+   * 精度: "hour", "day"
+   * @default "day"
+   */
+  precision: "hour" | "day";
+  /** 用户ID，可选参数 */
+  user_id?: string;
+}
+
 export interface GetCategoryStatDashboardParams {
   /**
    * 持续时间 (小时或天数)`
@@ -1698,6 +2713,45 @@ export interface GetGetTokenUsageParams {
   model_type: "llm" | "coder" | "embedding" | "audio" | "reranker";
 }
 
+export interface GetGetModelUptimeParams {
+  /**
+   * 持续时间 (小时或天数)
+   * @min 1
+   * @max 90
+   * @default 24
+   */
+  duration?: number;
+  /** 模型ID */
+  id: string;
+  /**
+   * 精度: "hour", "day"
+   * @default "hour"
+   */
+  precision: "hour" | "day";
+}
+
+export interface DeleteDeleteTransformRuleParams {
+  /** 规则ID */
+  id: string;
+}
+
+export interface GetListTransformPolicyParams {
+  /** 下一页标识 */
+  next_token?: string;
+  /**
+   * 分页
+   * @min 1
+   * @default 1
+   */
+  page?: number;
+  /**
+   * 每页多少条记录
+   * @min 1
+   * @default 10
+   */
+  size?: number;
+}
+
 export interface GetSecurityScanningListParams {
   /** 作者 */
   author?: string;
@@ -1724,6 +2778,65 @@ export interface GetSecurityScanningDetailParams {
   id: string;
 }
 
+export interface GetSecurityScanningExportSarifParams {
+  /** 扫描任务id */
+  id: string;
+}
+
+export interface PostSecurityScanningImportSarifParams {
+  /** 工作区id */
+  workspace_id: string;
+}
+
+export interface GetSecurityScanningExportWorkspaceSarifParams {
+  /** 工作区id */
+  workspace_id: string;
+}
+
+export interface GetSecurityScanningListCommentsParams {
+  /** 发现id */
+  id: string;
+}
+
+export interface GetSecurityScanningListSuppressionsParams {
+  /** 下一页标识 */
+  next_token?: string;
+  /**
+   * 分页
+   * @min 1
+   * @default 1
+   */
+  page?: number;
+  /**
+   * 每页多少条记录
+   * @min 1
+   * @default 10
+   */
+  size?: number;
+  /** 工作区id */
+  workspace_id?: string;
+}
+
+export interface DeleteSecurityScanningDeleteSuppressionParams {
+  /** 屏蔽规则id */
+  id: string;
+}
+
+export interface DeleteSecurityScanningDeleteRulePackParams {
+  /** 规则包id */
+  id: string;
+}
+
+export interface GetSecurityScanningListRulePackVersionsParams {
+  /** 规则包id */
+  id: string;
+}
+
+export interface DeleteSecurityScanningDeletePolicyParams {
+  /** 策略id */
+  id: string;
+}
+
 export interface GetUserChatInfoParams {
   /** 对话记录ID */
   id: string;
@@ -1904,6 +3017,11 @@ export interface GetUserSecurityScanningDetailParams {
   id: string;
 }
 
+export interface GetUserSecurityScanningListCommentsParams {
+  /** 发现id */
+  id: string;
+}
+
 export interface GetListWorkspaceFilesParams {
   /** 编程语言筛选 */
   language?: string;
@@ -1955,4 +3073,8 @@ export interface GetListSecurityScanningDetailParams {
    * @default 10
    */
   size?: number;
+  /** 相对上一次扫描的状态，为空时返回除已修复外的所有发现 */
+  state?: ConstsSecurityScanningFindingState;
+  /** 处置状态 */
+  triage_status?: ConstsSecurityScanningTriageStatus;
 }
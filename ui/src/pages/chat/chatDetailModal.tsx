@@ -8,6 +8,7 @@ import logo from '@/assets/images/logo.png';
 
 import { useEffect, useState } from 'react';
 import { DomainChatContent, DomainChatRecord } from '@/api/types';
+import { formatMessages } from '@/utils';
 
 const StyledChatList = styled('div')(() => ({
   borderRadius: 4,
@@ -117,7 +118,7 @@ const ChatDetailModal = ({
           {content.map((item, idx) => {
             const isUser = item.role === 'user';
             const name = isUser ? data?.user?.username : 'MonkeyCode';
-            const msg = item.content || '';
+            const msg = formatMessages(item);
             return (
               <StyledChatRow key={idx} isUser={isUser}>
                 <StyledChatUser key={idx} isUser={isUser}>
@@ -148,16 +148,16 @@ const Chat = () => {
       dataIndex: 'input_tokens',
       title: '输入 Token',
       width: 150,
-      render(value: number) {
-        return addCommasToNumber(value);
+      render(value: number, record: DomainChatRecord) {
+        return `${record.usage_source === 'estimated' ? '≈ ' : ''}${addCommasToNumber(value)}`;
       },
     },
     {
       dataIndex: 'output_tokens',
       title: '输出 Token',
       width: 150,
-      render(value: number) {
-        return addCommasToNumber(value);
+      render(value: number, record: DomainChatRecord) {
+        return `${record.usage_source === 'estimated' ? '≈ ' : ''}${addCommasToNumber(value)}`;
       },
     },
     {
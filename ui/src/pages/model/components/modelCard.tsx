@@ -197,6 +197,12 @@ const ModelItem = ({
       >
         <Stack direction='row' alignItems='center'>
           <StyledLabel color={data.is_active ? 'success' : 'disabled'}>{data.is_active ? '正在使用' : '未激活'}</StyledLabel>
+          {data.is_active && data.breaker === 'open' && (
+            <StyledLabel color='error' sx={{ ml: 1 }}>已熔断</StyledLabel>
+          )}
+          {data.is_active && data.breaker === 'half_open' && (
+            <StyledLabel color='warning' sx={{ ml: 1 }}>探测中</StyledLabel>
+          )}
         </Stack>
         <Stack direction='row' sx={{ button: { minWidth: 0 } }} gap={2}>
           {!data.is_active && (
@@ -122,16 +122,16 @@ const Completion = () => {
       dataIndex: 'input_tokens',
       title: '输入 Token',
       width: 140,
-      render(value: number) {
-        return addCommasToNumber(value);
+      render(value: number, record: DomainCompletionRecord) {
+        return `${record.usage_source === 'estimated' ? '≈ ' : ''}${addCommasToNumber(value)}`;
       },
     },
     {
       dataIndex: 'output_tokens',
       title: '输出 Token',
       width: 140,
-      render(value: number) {
-        return addCommasToNumber(value);
+      render(value: number, record: DomainCompletionRecord) {
+        return `${record.usage_source === 'estimated' ? '≈ ' : ''}${addCommasToNumber(value)}`;
       },
     },
     {
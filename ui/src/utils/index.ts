@@ -1,5 +1,6 @@
 import { Decimal } from 'decimal.js';
 import dayjs from 'dayjs';
+import { DomainChatContent } from '@/api/types';
 
 /**
  * 格式化时间
@@ -265,3 +266,33 @@ export const getBaseLanguageId = (languageId: string): string => {
   };
   return map[languageId] || languageId;
 };
+
+/**
+ * 将包含推理过程、工具调用和多个 choice 的回复转换为 Markdown
+ * @param item 对话消息
+ * @returns Markdown 内容
+ */
+export const formatMessages = (item: DomainChatContent) => {
+  const messages = item.messages || [];
+  if (messages.length === 0) return item.content || '';
+  return messages
+    .map((m) => {
+      const parts: string[] = [];
+      if (messages.length > 1) parts.push(`**回复 ${(m.index || 0) + 1}**`);
+      if (m.reasoning) {
+        parts.push(
+          '**推理过程**\n\n' +
+            m.reasoning
+              .split('\n')
+              .map((line) => `> ${line}`)
+              .join('\n')
+        );
+      }
+      if (m.content) parts.push(m.content);
+      (m.tool_calls || []).forEach((t) => {
+        parts.push(`**调用工具 ${t.name}**\n\n\`\`\`json\n${t.arguments || ''}\n\`\`\``);
+      });
+      return parts.join('\n\n');
+    })
+    .join('\n\n---\n\n');
+};